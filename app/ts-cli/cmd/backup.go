@@ -0,0 +1,63 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/openGemini/openGemini/app/ts-cli/geminicli"
+	"github.com/spf13/cobra"
+)
+
+const DEFAULT_META_HOST = "127.0.0.1:8091"
+
+func init() {
+	rootCmd.AddCommand(backupCmd)
+	backupCmd.Flags().StringVar(&options.MetaHost, "meta-host", DEFAULT_META_HOST, "ts-meta http address to back up the meta from, the meta is not backed up if empty.")
+	backupCmd.Flags().StringVar(&options.DataDir, "data-dir", "", "Data directory of the ts-store (the store-data-dir in the config) to back up the data files from.")
+	backupCmd.Flags().StringVarP(&options.Username, "username", "u", "", "Username to connect to ts-meta.")
+	backupCmd.Flags().StringVarP(&options.Password, "password", "p", "", "Password to connect to ts-meta.")
+	backupCmd.Flags().BoolVar(&options.Ssl, "ssl", false, "Use https for connecting to ts-meta.")
+	backupCmd.Flags().StringVar(&options.Database, "database", "", "Database to back up, all databases are backed up if not specified.")
+	backupCmd.Flags().StringVar(&options.RetentionPolicy, "rp", "", "Retention policy of the data files to back up, the meta of the whole database is backed up.")
+	backupCmd.Flags().StringVar(&options.Since, "since", "", "Only back up the data files modified since the time in RFC3339 format, for an incremental backup.")
+	backupCmd.Flags().BoolVar(&options.Hardlink, "hardlink", false, "Hard-link the TSSP files instead of copying them, the backup must be on the same file system.")
+	backupCmd.Flags().StringVar(&options.Path, "path", "", "Directory of the backup.")
+	err := backupCmd.MarkFlagRequired("path")
+	if err != nil {
+		return
+	}
+}
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up the meta and the data files of openGemini",
+	Long: `Back up the meta from ts-meta and the immutable data files (TSSP files and indexes) of a ts-store with a manifest.
+The memtables are not backed up, flush them by: curl -XPOST 'http://<ts-sql>:8086/debug/ctrl?mod=flush', then stop ts-store,
+the backup of the data files is refused while ts-store is running.
+Run the backup on each ts-store node with --data-dir, the meta only needs to be backed up once.
+The meta backup requires an admin user, or runs on the ts-meta node if the authentication is disabled.`,
+	Example: `
+$ ts-cli backup --meta-host=127.0.0.1:8091 --data-dir=/data/openGemini --path=/backup/full
+$ ts-cli backup --meta-host=127.0.0.1:8091 --data-dir=/data/openGemini --database=db0 --since=2024-01-02T00:00:00Z --path=/backup/incr1`,
+	CompletionOptions: cobra.CompletionOptions{
+		DisableDefaultCmd:   true,
+		DisableDescriptions: true,
+		DisableNoDescFlag:   true,
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return geminicli.NewBackuper().Backup(&options)
+	},
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/openGemini/openGemini/app/ts-cli/geminicli"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringVar(&options.Host, "host", DEFAULT_HOST, "ts-sql host to connect to.")
+	exportCmd.Flags().IntVar(&options.Port, "port", DEFAULT_PORT, "ts-sql tcp port to connect to.")
+	exportCmd.Flags().StringVarP(&options.Username, "username", "u", "", "Username to connect to openGemini.")
+	exportCmd.Flags().StringVarP(&options.Password, "password", "p", "", "Password to connect to openGemini.")
+	exportCmd.Flags().BoolVar(&options.Ssl, "ssl", false, "Use https for connecting to openGemini.")
+	exportCmd.Flags().StringVar(&options.Database, "database", "", "Database to export.")
+	exportCmd.Flags().StringVar(&options.RetentionPolicy, "rp", "", "Retention policy to export, the default retention policy is used if not specified.")
+	exportCmd.Flags().StringVar(&options.Measurement, "measurement", "", "Measurement to export, all measurements are exported if not specified.")
+	exportCmd.Flags().StringVar(&options.Start, "start", "", "Start time of the data to export in RFC3339 format, inclusive.")
+	exportCmd.Flags().StringVar(&options.End, "end", "", "End time of the data to export in RFC3339 format, exclusive.")
+	exportCmd.Flags().StringVar(&options.Format, "format", geminicli.ExportFormatLineProtocol, "Export format: lp, csv or parquet.")
+	exportCmd.Flags().BoolVar(&options.Compress, "compress", false, "Compress the output with gzip.")
+	exportCmd.Flags().IntVar(&options.ChunkSize, "chunk-size", geminicli.DefaultExportChunkSize, "Number of rows read by a query.")
+	exportCmd.Flags().StringVar(&options.Path, "path", "", "Path to the output file, or the output directory of the parquet format. Write to stdout if not specified.")
+	err := exportCmd.MarkFlagRequired("database")
+	if err != nil {
+		return
+	}
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export data from openGemini",
+	Long:  `Export data from openGemini in line protocol, CSV or Parquet format. The line protocol output can be imported by the import command`,
+	Example: `
+$ ts-cli export --database=db0 --measurement=cpu --start=2024-01-01T00:00:00Z --end=2024-01-02T00:00:00Z --path=cpu.txt.gz --compress
+$ ts-cli export --database=db0 --rp=autogen --format=parquet --path=./db0`,
+	CompletionOptions: cobra.CompletionOptions{
+		DisableDefaultCmd:   true,
+		DisableDescriptions: true,
+		DisableNoDescFlag:   true,
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := connectCLI(); err != nil {
+			return err
+		}
+		exportCmd := geminicli.NewExporter()
+		if err := exportCmd.Export(&options); err != nil {
+			return err
+		}
+		return nil
+	},
+}
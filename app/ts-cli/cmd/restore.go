@@ -0,0 +1,57 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/openGemini/openGemini/app/ts-cli/geminicli"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(restoreCmd)
+	restoreCmd.Flags().StringVar(&options.MetaHost, "meta-host", "", "ts-meta http address to restore the meta to, the restore runs on the ts-meta node if there is no admin user.")
+	restoreCmd.Flags().StringVar(&options.DataDir, "data-dir", "", "Data directory of the stopped ts-store (the store-data-dir in the config) to restore the data files to.")
+	restoreCmd.Flags().StringVarP(&options.Username, "username", "u", "", "Username to connect to ts-meta.")
+	restoreCmd.Flags().StringVarP(&options.Password, "password", "p", "", "Password to connect to ts-meta.")
+	restoreCmd.Flags().BoolVar(&options.Ssl, "ssl", false, "Use https for connecting to ts-meta.")
+	restoreCmd.Flags().BoolVar(&options.Hardlink, "hardlink", false, "Hard-link the TSSP files instead of copying them, the backup must be on the same file system.")
+	restoreCmd.Flags().StringSliceVar(&options.Paths, "path", nil, "Directories of the full backup and the incremental backups in chronological order, the last backup is restored.")
+	err := restoreCmd.MarkFlagRequired("path")
+	if err != nil {
+		return
+	}
+}
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore the backups into a fresh openGemini cluster",
+	Long: `Restore the backups made by the backup command into a fresh openGemini cluster.
+Restore the meta to ts-meta with --meta-host first, then stop the ts-stores and restore the data files of each ts-store with --data-dir.
+The pts of the backup are assigned to the data nodes in the order of the node ids, restore the data files of a ts-store to the data node with the same order.
+The pts are loaded when the ts-stores are started.`,
+	Example: `
+$ ts-cli restore --meta-host=127.0.0.1:8091 --path=/backup/full,/backup/incr1
+$ ts-cli restore --data-dir=/data/openGemini --path=/backup/full --path=/backup/incr1`,
+	CompletionOptions: cobra.CompletionOptions{
+		DisableDefaultCmd:   true,
+		DisableDescriptions: true,
+		DisableNoDescFlag:   true,
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return geminicli.NewRestorer().Restore(&options)
+	},
+}
@@ -0,0 +1,438 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geminicli
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+const (
+	BackupManifestFile = "manifest.json"
+	BackupMetaFile     = "meta"
+	BackupDataDir      = "data"
+
+	// the data files of the shards and the indexes are in <data-dir>/data/<db>/<pt>/<rp>/
+	storeDataDir = "data"
+	// the files being written have the suffix, they are renamed when they are completed
+	tmpFileSuffix  = ".init"
+	tsspFileSuffix = ".tssp"
+)
+
+// BackupManifest describes a backup. The Files are all the data files at the time of the backup,
+// only the files which are included are copied to the backup, and the others are in the
+// previous backups.
+type BackupManifest struct {
+	Time            time.Time    `json:"time"`
+	Since           time.Time    `json:"since,omitempty"`
+	Database        string       `json:"database,omitempty"`
+	RetentionPolicy string       `json:"retentionPolicy,omitempty"`
+	Meta            bool         `json:"meta"`
+	Files           []BackupFile `json:"files"`
+}
+
+type BackupFile struct {
+	// Path is the path relative to the data directory, with the slash separator
+	Path     string    `json:"path"`
+	Size     int64     `json:"size"`
+	ModTime  time.Time `json:"modTime"`
+	Included bool      `json:"included"`
+}
+
+// Backuper backs up the metadata from ts-meta and the data files of a ts-store. The ts-store must
+// be stopped, otherwise the compaction may replace the data files during the backup, which is
+// refused while the store lock is held by a running ts-store; the memtables and the WAL are not
+// backed up, which are flushed by the flush ctrl command before stopping ts-store. An incremental
+// backup only includes the files modified since a given time.
+type Backuper struct {
+	httpClient   *http.Client
+	manifest     BackupManifest
+	stderrLogger *log.Logger
+}
+
+// NewBackuper will return an initialized Backuper struct
+func NewBackuper() *Backuper {
+	return &Backuper{
+		httpClient:   newMetaHttpClient(),
+		stderrLogger: log.New(os.Stderr, "", log.LstdFlags),
+	}
+}
+
+// Backup backs up the metadata and the data files to the Path specified in the Config
+func (b *Backuper) Backup(clc *CommandLineConfig) error {
+	if clc.Path == "" {
+		return errors.New("execute backup cmd, --path is required")
+	}
+	if clc.MetaHost == "" && clc.DataDir == "" {
+		return errors.New("execute backup cmd, --meta-host or --data-dir is required")
+	}
+	if clc.RetentionPolicy != "" && clc.Database == "" {
+		return errors.New("execute backup cmd, --database is required by --rp")
+	}
+	b.manifest = BackupManifest{
+		Time:            time.Now().UTC(),
+		Database:        clc.Database,
+		RetentionPolicy: clc.RetentionPolicy,
+	}
+	if clc.Since != "" {
+		since, err := time.Parse(time.RFC3339Nano, clc.Since)
+		if err != nil {
+			return fmt.Errorf("invalid since time %q: %s", clc.Since, err)
+		}
+		b.manifest.Since = since.UTC()
+	}
+
+	if _, err := os.Stat(filepath.Join(clc.Path, BackupManifestFile)); err == nil {
+		return fmt.Errorf("backup %s already exists", clc.Path)
+	}
+	if err := os.MkdirAll(clc.Path, 0750); err != nil {
+		return err
+	}
+	if clc.MetaHost != "" {
+		if err := b.backupMeta(clc); err != nil {
+			return err
+		}
+		b.manifest.Meta = true
+	}
+	if clc.DataDir != "" {
+		unlock, err := lockStoreDataDir(clc.DataDir)
+		if err != nil {
+			return err
+		}
+		err = b.backupData(clc)
+		unlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	// the manifest is written at last, a backup without the manifest is incomplete
+	buf, err := json.MarshalIndent(&b.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(filepath.Join(clc.Path, BackupManifestFile), buf, 0640); err != nil {
+		return err
+	}
+
+	included := 0
+	for i := range b.manifest.Files {
+		if b.manifest.Files[i].Included {
+			included++
+		}
+	}
+	b.stderrLogger.Printf("Backed up %d of %d files to %s\n", included, len(b.manifest.Files), clc.Path)
+	return nil
+}
+
+func (b *Backuper) backupMeta(clc *CommandLineConfig) error {
+	buf, err := metaRequest(b.httpClient, clc, http.MethodGet, "/backup", nil)
+	if err != nil {
+		return err
+	}
+	if clc.Database != "" {
+		data := &meta.Data{}
+		if err = data.UnmarshalBinary(buf); err != nil {
+			return err
+		}
+		if data.Database(clc.Database) == nil {
+			return fmt.Errorf("database not found: %s", clc.Database)
+		}
+		data.KeepDatabases(map[string]struct{}{clc.Database: {}})
+		if buf, err = data.MarshalBinary(); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(filepath.Join(clc.Path, BackupMetaFile), buf, 0640)
+}
+
+func (b *Backuper) backupData(clc *CommandLineConfig) error {
+	root := filepath.Join(clc.DataDir, storeDataDir)
+	if _, err := os.Stat(root); err != nil {
+		return err
+	}
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if skipBackupDir(rel, clc.Database, clc.RetentionPolicy) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || strings.HasSuffix(path, tmpFileSuffix) || !inBackupDir(rel) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		file := BackupFile{
+			Path:     filepath.ToSlash(rel),
+			Size:     info.Size(),
+			ModTime:  info.ModTime().UTC(),
+			Included: !info.ModTime().Before(b.manifest.Since),
+		}
+		if file.Included {
+			dst := filepath.Join(clc.Path, BackupDataDir, rel)
+			err = copyBackupFile(path, dst, clc.Hardlink && strings.HasSuffix(path, tsspFileSuffix))
+			if err != nil {
+				return err
+			}
+		}
+		b.manifest.Files = append(b.manifest.Files, file)
+		return nil
+	})
+}
+
+// lockStoreDataDir takes the store lock of the data directory, which fails if ts-store is running
+func lockStoreDataDir(dataDir string) (func(), error) {
+	lock, err := fileops.TryLockFile(filepath.Join(dataDir, config.StoreLockFile))
+	if errors.Is(err, fileops.ErrFileLocked) {
+		return nil, fmt.Errorf("ts-store is running on %s, stop it first", dataDir)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		_ = lock.Close()
+	}, nil
+}
+
+// skipBackupDir returns whether the directory <db>/<pt>/<rp> is not backed up
+func skipBackupDir(rel, database, rp string) bool {
+	if rel == "." {
+		return false
+	}
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	if database != "" && parts[0] != database {
+		return true
+	}
+	return rp != "" && len(parts) >= 3 && parts[2] != rp
+}
+
+// inBackupDir returns whether the file is in a directory of a retention policy
+func inBackupDir(rel string) bool {
+	return strings.Count(filepath.ToSlash(rel), "/") >= 3
+}
+
+// copyBackupFile copies the file, or creates a hard link of it if link is true and the
+// file system supports it
+func copyBackupFile(src, dst string, link bool) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0750); err != nil {
+		return err
+	}
+	if link && os.Link(src, dst) == nil {
+		return nil
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0640)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	if err = out.Sync(); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// Restorer restores the backups into a fresh cluster. The metadata is restored to ts-meta, then
+// the data files are restored to the data directory of each ts-store while it is stopped. The pts
+// are loaded when the ts-stores are started.
+type Restorer struct {
+	httpClient   *http.Client
+	stderrLogger *log.Logger
+}
+
+// NewRestorer will return an initialized Restorer struct
+func NewRestorer() *Restorer {
+	return &Restorer{
+		httpClient:   newMetaHttpClient(),
+		stderrLogger: log.New(os.Stderr, "", log.LstdFlags),
+	}
+}
+
+// Restore restores the last backup of the Paths specified in the Config. The Paths are a full backup
+// followed by the incremental backups based on it.
+func (r *Restorer) Restore(clc *CommandLineConfig) error {
+	if len(clc.Paths) == 0 {
+		return errors.New("execute restore cmd, --path is required")
+	}
+	if clc.MetaHost == "" && clc.DataDir == "" {
+		return errors.New("execute restore cmd, --meta-host or --data-dir is required")
+	}
+	manifests := make([]*BackupManifest, len(clc.Paths))
+	for i, path := range clc.Paths {
+		m, err := readBackupManifest(path)
+		if err != nil {
+			return err
+		}
+		if i > 0 && m.Time.Before(manifests[i-1].Time) {
+			return fmt.Errorf("backup %s is earlier than %s, the backups must be in chronological order", path, clc.Paths[i-1])
+		}
+		if i > 0 && (m.Database != manifests[0].Database || m.RetentionPolicy != manifests[0].RetentionPolicy) {
+			return fmt.Errorf("backup %s and %s are of different databases or retention policies", path, clc.Paths[0])
+		}
+		manifests[i] = m
+	}
+	last := len(clc.Paths) - 1
+
+	if clc.MetaHost != "" {
+		if !manifests[last].Meta {
+			return fmt.Errorf("backup %s has no meta", clc.Paths[last])
+		}
+		buf, err := os.ReadFile(filepath.Join(clc.Paths[last], BackupMetaFile))
+		if err != nil {
+			return err
+		}
+		if _, err = metaRequest(r.httpClient, clc, http.MethodPost, "/restore", buf); err != nil {
+			return err
+		}
+		r.stderrLogger.Printf("Restored meta from %s\n", clc.Paths[last])
+	}
+	if clc.DataDir != "" {
+		unlock, err := lockStoreDataDir(clc.DataDir)
+		if err != nil {
+			return err
+		}
+		err = r.restoreData(clc, manifests)
+		unlock()
+		if err != nil {
+			return err
+		}
+		r.stderrLogger.Printf("Restored %d files to %s\n", len(manifests[last].Files), clc.DataDir)
+	}
+	return nil
+}
+
+// restoreData copies the files of the last backup from the latest backups which include them
+func (r *Restorer) restoreData(clc *CommandLineConfig, manifests []*BackupManifest) error {
+	included := make([]map[string]struct{}, len(manifests))
+	for i, m := range manifests {
+		included[i] = make(map[string]struct{}, len(m.Files))
+		for _, f := range m.Files {
+			if f.Included {
+				included[i][f.Path] = struct{}{}
+			}
+		}
+	}
+
+	root := filepath.Join(clc.DataDir, storeDataDir)
+	last := manifests[len(manifests)-1]
+	for _, f := range last.Files {
+		src := ""
+		for i := len(manifests) - 1; i >= 0; i-- {
+			if _, ok := included[i][f.Path]; ok {
+				src = filepath.Join(clc.Paths[i], BackupDataDir, filepath.FromSlash(f.Path))
+				break
+			}
+		}
+		if src == "" {
+			return fmt.Errorf("file %s is not in the backups, the full backup is required", f.Path)
+		}
+		dst := filepath.Join(root, filepath.FromSlash(f.Path))
+		if _, err := os.Stat(dst); err == nil {
+			return fmt.Errorf("file %s already exists", dst)
+		}
+		if err := copyBackupFile(src, dst, clc.Hardlink && strings.HasSuffix(src, tsspFileSuffix)); err != nil {
+			return err
+		}
+		if err := os.Chtimes(dst, f.ModTime, f.ModTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readBackupManifest(path string) (*BackupManifest, error) {
+	buf, err := os.ReadFile(filepath.Join(path, BackupManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup %s: %s", path, err)
+	}
+	m := &BackupManifest{}
+	if err = json.Unmarshal(buf, m); err != nil {
+		return nil, fmt.Errorf("invalid backup %s: %s", path, err)
+	}
+	return m, nil
+}
+
+func newMetaHttpClient() *http.Client {
+	// #nosec
+	return &http.Client{
+		Timeout:   time.Minute,
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+	}
+}
+
+// metaRequest sends the request to the http service of ts-meta and returns the response body
+func metaRequest(c *http.Client, clc *CommandLineConfig, method, path string, body []byte) ([]byte, error) {
+	scheme := "http"
+	if clc.Ssl {
+		scheme = "https"
+	}
+	req, err := http.NewRequest(method, fmt.Sprintf("%s://%s%s", scheme, clc.MetaHost, path), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if clc.Username != "" {
+		req.SetBasicAuth(clc.Username, clc.Password)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request %s failed, status: %d, %s", path, resp.StatusCode, strings.TrimSpace(string(buf)))
+	}
+	return buf, nil
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geminicli
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMetaServer mocks the backup and restore http service of ts-meta
+func newMetaServer(t *testing.T, restored *[]byte) *httptest.Server {
+	data := &meta.Data{Databases: map[string]*meta.DatabaseInfo{
+		"db0": {Name: "db0", RetentionPolicies: map[string]*meta.RetentionPolicyInfo{}},
+		"db1": {Name: "db1", RetentionPolicies: map[string]*meta.RetentionPolicyInfo{}},
+	}}
+	buf, err := data.MarshalBinary()
+	require.NoError(t, err)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/backup":
+			_, _ = w.Write(buf)
+		case "/restore":
+			b, _ := io.ReadAll(r.Body)
+			if *restored != nil {
+				http.Error(w, meta.ErrRestoreNotEmpty.Error(), http.StatusInternalServerError)
+				return
+			}
+			*restored = b
+		}
+	}))
+}
+
+func writeStoreFile(t *testing.T, dataDir, rel, content string, modTime time.Time) {
+	path := filepath.Join(dataDir, storeDataDir, filepath.FromSlash(rel))
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0750))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0640))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func newTestBackuper() *Backuper {
+	b := NewBackuper()
+	b.stderrLogger.SetOutput(io.Discard)
+	return b
+}
+
+func newTestRestorer() *Restorer {
+	r := NewRestorer()
+	r.stderrLogger.SetOutput(io.Discard)
+	return r
+}
+
+func TestBackuper_Backup_Check(t *testing.T) {
+	b := newTestBackuper()
+	assert.EqualError(t, b.Backup(&CommandLineConfig{}), "execute backup cmd, --path is required")
+	assert.EqualError(t, b.Backup(&CommandLineConfig{Path: t.TempDir()}), "execute backup cmd, --meta-host or --data-dir is required")
+	assert.EqualError(t, b.Backup(&CommandLineConfig{Path: t.TempDir(), DataDir: t.TempDir(), RetentionPolicy: "rp0"}),
+		"execute backup cmd, --database is required by --rp")
+	err := b.Backup(&CommandLineConfig{Path: t.TempDir(), DataDir: t.TempDir(), Since: "2024-01-01"})
+	assert.Contains(t, err.Error(), `invalid since time "2024-01-01"`)
+
+	r := newTestRestorer()
+	assert.EqualError(t, r.Restore(&CommandLineConfig{}), "execute restore cmd, --path is required")
+	assert.EqualError(t, r.Restore(&CommandLineConfig{Paths: []string{t.TempDir()}}), "execute restore cmd, --meta-host or --data-dir is required")
+	err = r.Restore(&CommandLineConfig{Paths: []string{t.TempDir()}, DataDir: t.TempDir()})
+	assert.Contains(t, err.Error(), "invalid backup")
+}
+
+func TestBackupRestore(t *testing.T) {
+	var restored []byte
+	server := newMetaServer(t, &restored)
+	defer server.Close()
+	metaHost := strings.TrimPrefix(server.URL, "http://")
+
+	dataDir := t.TempDir()
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	writeStoreFile(t, dataDir, "db0/0/rp0/1_0_100_1/tssp/cpu_0000/00000001-0000-00000000.tssp", "tssp1", t0)
+	writeStoreFile(t, dataDir, "db0/0/rp0/1_0_100_1/tssp/cpu_0000/00000002-0000-00000000.tssp.init", "init", t0)
+	writeStoreFile(t, dataDir, "db0/0/rp0/index/1_0_100/mergeset/part/items.bin", "index", t0)
+	writeStoreFile(t, dataDir, "db0/0/rp1/2_0_100_2/tssp/cpu_0000/00000001-0000-00000000.tssp", "rp1", t0)
+	writeStoreFile(t, dataDir, "db1/0/rp0/3_0_100_3/tssp/cpu_0000/00000001-0000-00000000.tssp", "db1", t0)
+
+	backupDir := t.TempDir()
+	full := filepath.Join(backupDir, "full")
+	require.NoError(t, newTestBackuper().Backup(&CommandLineConfig{
+		MetaHost:        metaHost,
+		DataDir:         dataDir,
+		Database:        "db0",
+		RetentionPolicy: "rp0",
+		Hardlink:        true,
+		Path:            full,
+	}))
+	m, err := readBackupManifest(full)
+	require.NoError(t, err)
+	assert.True(t, m.Meta)
+	require.Equal(t, 2, len(m.Files))
+	assert.Equal(t, "db0/0/rp0/1_0_100_1/tssp/cpu_0000/00000001-0000-00000000.tssp", m.Files[0].Path)
+	assert.Equal(t, "db0/0/rp0/index/1_0_100/mergeset/part/items.bin", m.Files[1].Path)
+	assert.True(t, m.Files[0].Included && m.Files[1].Included)
+
+	// only db0 is in the meta of the backup
+	data := &meta.Data{}
+	buf, err := os.ReadFile(filepath.Join(full, BackupMetaFile))
+	require.NoError(t, err)
+	require.NoError(t, data.UnmarshalBinary(buf))
+	assert.NotNil(t, data.Database("db0"))
+	assert.Nil(t, data.Database("db1"))
+
+	// the backup exists
+	assert.EqualError(t, newTestBackuper().Backup(&CommandLineConfig{DataDir: dataDir, Path: full}),
+		"backup "+full+" already exists")
+
+	// the compaction replaces the file 1 with the file 3
+	t1 := t0.Add(time.Hour)
+	require.NoError(t, os.Remove(filepath.Join(dataDir, storeDataDir, "db0/0/rp0/1_0_100_1/tssp/cpu_0000/00000001-0000-00000000.tssp")))
+	writeStoreFile(t, dataDir, "db0/0/rp0/1_0_100_1/tssp/cpu_0000/00000003-0000-00000000.tssp", "tssp3", t1)
+	incr := filepath.Join(backupDir, "incr")
+	require.NoError(t, newTestBackuper().Backup(&CommandLineConfig{
+		DataDir:         dataDir,
+		Database:        "db0",
+		RetentionPolicy: "rp0",
+		Since:           t1.Format(time.RFC3339),
+		Path:            incr,
+	}))
+	m, err = readBackupManifest(incr)
+	require.NoError(t, err)
+	assert.False(t, m.Meta)
+	require.Equal(t, 2, len(m.Files))
+	for _, f := range m.Files {
+		assert.Equal(t, strings.HasSuffix(f.Path, "00000003-0000-00000000.tssp"), f.Included, f.Path)
+	}
+
+	// restore the meta
+	r := newTestRestorer()
+	assert.EqualError(t, r.Restore(&CommandLineConfig{MetaHost: metaHost, Paths: []string{full, incr}}),
+		"backup "+incr+" has no meta")
+	require.NoError(t, r.Restore(&CommandLineConfig{MetaHost: metaHost, Paths: []string{full}}))
+	assert.Equal(t, buf, restored)
+	err = r.Restore(&CommandLineConfig{MetaHost: metaHost, Paths: []string{full}})
+	assert.Contains(t, err.Error(), meta.ErrRestoreNotEmpty.Error())
+
+	// restore the data
+	assert.Contains(t, r.Restore(&CommandLineConfig{DataDir: t.TempDir(), Paths: []string{incr, full}}).Error(),
+		"the backups must be in chronological order")
+	assert.EqualError(t, r.Restore(&CommandLineConfig{DataDir: t.TempDir(), Paths: []string{incr}}),
+		"file db0/0/rp0/index/1_0_100/mergeset/part/items.bin is not in the backups, the full backup is required")
+
+	other := filepath.Join(backupDir, "other")
+	require.NoError(t, newTestBackuper().Backup(&CommandLineConfig{DataDir: dataDir, Database: "db0", Path: other}))
+	assert.EqualError(t, r.Restore(&CommandLineConfig{DataDir: t.TempDir(), Paths: []string{full, other}}),
+		"backup "+other+" and "+full+" are of different databases or retention policies")
+
+	restoreDir := t.TempDir()
+	require.NoError(t, r.Restore(&CommandLineConfig{DataDir: restoreDir, Paths: []string{full, incr}}))
+	for path, content := range map[string]string{
+		"db0/0/rp0/1_0_100_1/tssp/cpu_0000/00000003-0000-00000000.tssp": "tssp3",
+		"db0/0/rp0/index/1_0_100/mergeset/part/items.bin":               "index",
+	} {
+		assert.Equal(t, content, readFile(t, filepath.Join(restoreDir, storeDataDir, path), false))
+	}
+	_, err = os.Stat(filepath.Join(restoreDir, storeDataDir, "db0/0/rp0/1_0_100_1/tssp/cpu_0000/00000001-0000-00000000.tssp"))
+	assert.True(t, os.IsNotExist(err))
+
+	// the files exist
+	assert.Contains(t, r.Restore(&CommandLineConfig{DataDir: restoreDir, Paths: []string{full, incr}}).Error(), "already exists")
+}
+
+func TestBackupRestore_StoreRunning(t *testing.T) {
+	dataDir := t.TempDir()
+	writeStoreFile(t, dataDir, "db0/0/rp0/1_0_100_1/tssp/cpu_0000/00000001-0000-00000000.tssp", "tssp1", time.Now())
+	full := filepath.Join(t.TempDir(), "full")
+	require.NoError(t, newTestBackuper().Backup(&CommandLineConfig{DataDir: dataDir, Path: full}))
+
+	// the lock is held by the running ts-store
+	lock, err := fileops.TryLockFile(filepath.Join(dataDir, config.StoreLockFile))
+	require.NoError(t, err)
+	defer lock.Close()
+
+	err = newTestBackuper().Backup(&CommandLineConfig{DataDir: dataDir, Path: filepath.Join(t.TempDir(), "backup")})
+	assert.EqualError(t, err, "ts-store is running on "+dataDir+", stop it first")
+	err = newTestRestorer().Restore(&CommandLineConfig{DataDir: dataDir, Paths: []string{full}})
+	assert.EqualError(t, err, "ts-store is running on "+dataDir+", stop it first")
+}
@@ -66,6 +66,23 @@ type CommandLineConfig struct {
 	// import cmd options
 	Import bool
 	Path   string
+
+	// export cmd options, the Path is the output path
+	RetentionPolicy string
+	Measurement     string
+	Start           string
+	End             string
+	Format          string
+	Compress        bool
+	ChunkSize       int
+
+	// backup and restore cmd options, the Path is the backup directory of the backup cmd,
+	// and the Paths are the backup directories of the restore cmd in chronological order
+	MetaHost string
+	DataDir  string
+	Since    string
+	Hardlink bool
+	Paths    []string
 }
 
 type HttpClient interface {
@@ -0,0 +1,686 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geminicli
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/client"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/parquet"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+const (
+	ExportFormatLineProtocol = "lp"
+	ExportFormatCSV          = "csv"
+	ExportFormatParquet      = "parquet"
+
+	DefaultExportChunkSize = 10000
+)
+
+// field types returned by SHOW FIELD KEYS
+const (
+	fieldTypeFloat    = "float"
+	fieldTypeInteger  = "integer"
+	fieldTypeUnsigned = "unsigned"
+	fieldTypeString   = "string"
+	fieldTypeBoolean  = "boolean"
+)
+
+type measurementSchema struct {
+	name       string
+	tags       []string
+	fields     []string
+	fieldTypes []string
+}
+
+// exportWriter writes the rows of the measurements in a format
+type exportWriter interface {
+	// begin is called before the rows of a measurement are written
+	begin(ms *measurementSchema) error
+	// write writes a row, the tag values and field values are in the order of
+	// the measurement schema, empty tags and nil fields are null
+	write(ms *measurementSchema, ts int64, tags []string, fields []interface{}) error
+	Close() error
+}
+
+// Exporter is the exporter used for exporting data. The query results are read in chunks
+// of ChunkSize rows, so the data is never loaded into memory at once. The line protocol
+// output can be imported by the Importer.
+type Exporter struct {
+	client        HttpClient
+	clientCreator HttpClientCreator
+
+	database        string
+	retentionPolicy string
+	timeCond        string
+	chunkSize       int
+	totalRows       int
+
+	stderrLogger *log.Logger
+}
+
+// NewExporter will return an initialized Exporter struct
+func NewExporter() *Exporter {
+	return &Exporter{
+		clientCreator: defaultHttpClientCreator,
+		stderrLogger:  log.New(os.Stderr, "", log.LstdFlags),
+	}
+}
+
+// Export exports the measurements of the database in the format specified in the Config
+func (e *Exporter) Export(clc *CommandLineConfig) error {
+	if clc.Database == "" {
+		return errors.New("execute export cmd, --database is required")
+	}
+	if clc.Format == "" {
+		clc.Format = ExportFormatLineProtocol
+	}
+	switch clc.Format {
+	case ExportFormatLineProtocol, ExportFormatCSV:
+	case ExportFormatParquet:
+		if clc.Path == "" {
+			return errors.New("execute export cmd, --path is required by the parquet format")
+		}
+	default:
+		return fmt.Errorf("unknown export format %q. format must be lp, csv or parquet", clc.Format)
+	}
+	e.chunkSize = clc.ChunkSize
+	if e.chunkSize <= 0 {
+		e.chunkSize = DefaultExportChunkSize
+	}
+	var err error
+	if e.timeCond, err = parseTimeRange(clc.Start, clc.End); err != nil {
+		return err
+	}
+
+	config, err := parseClientConfig(clc)
+	if err != nil {
+		return err
+	}
+	// the timestamps are always exported in nanoseconds
+	config.Precision = "ns"
+	cli, err := e.clientCreator(*config)
+	if err != nil {
+		return fmt.Errorf("could not create client %s", err)
+	}
+	e.client = cli
+	if _, _, err = e.client.Ping(); err != nil {
+		return err
+	}
+	e.database, e.retentionPolicy = clc.Database, clc.RetentionPolicy
+
+	measurements := []string{clc.Measurement}
+	if clc.Measurement == "" {
+		if measurements, err = e.measurements(); err != nil {
+			return err
+		}
+	}
+
+	w, err := e.newWriter(clc)
+	if err != nil {
+		return err
+	}
+	for _, name := range measurements {
+		if err = e.exportMeasurement(w, name); err != nil {
+			_ = w.Close()
+			return err
+		}
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	e.stderrLogger.Printf("Exported %d rows of %d measurements\n", e.totalRows, len(measurements))
+	return nil
+}
+
+// parseTimeRange converts the RFC3339 start and end time to the condition of the query
+func parseTimeRange(start, end string) (string, error) {
+	cond := ""
+	if start != "" {
+		t, err := time.Parse(time.RFC3339Nano, start)
+		if err != nil {
+			return "", fmt.Errorf("invalid start time %q: %s", start, err)
+		}
+		cond = fmt.Sprintf(" AND time >= %d", t.UnixNano())
+	}
+	if end != "" {
+		t, err := time.Parse(time.RFC3339Nano, end)
+		if err != nil {
+			return "", fmt.Errorf("invalid end time %q: %s", end, err)
+		}
+		cond += fmt.Sprintf(" AND time < %d", t.UnixNano())
+	}
+	return cond, nil
+}
+
+func (e *Exporter) newWriter(clc *CommandLineConfig) (exportWriter, error) {
+	if clc.Format == ExportFormatParquet {
+		if err := os.MkdirAll(clc.Path, 0750); err != nil {
+			return nil, err
+		}
+		return &parquetExportWriter{dir: clc.Path, gzip: clc.Compress}, nil
+	}
+
+	var out io.WriteCloser = nopWriteCloser{os.Stdout}
+	if clc.Path != "" {
+		f, err := os.OpenFile(clc.Path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+		if err != nil {
+			return nil, fmt.Errorf("fail to create file %s, %s", clc.Path, err)
+		}
+		out = f
+	}
+	sw := &streamWriter{out: out}
+	sw.Writer = bufio.NewWriter(out)
+	if clc.Compress {
+		sw.gz = gzip.NewWriter(out)
+		sw.Writer = bufio.NewWriter(sw.gz)
+	}
+
+	if clc.Format == ExportFormatCSV {
+		return &csvExportWriter{streamWriter: sw, w: csv.NewWriter(sw)}, nil
+	}
+	lw := &lpExportWriter{streamWriter: sw}
+	return lw, lw.writeHeader(clc.Database, clc.RetentionPolicy)
+}
+
+func (e *Exporter) query(command string) (*client.Result, error) {
+	resp, err := e.client.QueryContext(context.Background(), client.Query{
+		Command:         command,
+		Database:        e.database,
+		RetentionPolicy: e.retentionPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = resp.Error(); err != nil {
+		return nil, err
+	}
+	if len(resp.Results) == 0 {
+		return &client.Result{}, nil
+	}
+	return &resp.Results[0], nil
+}
+
+// show returns the first n columns of the rows of the SHOW statement
+func (e *Exporter) show(command string, n int) ([][]string, error) {
+	result, err := e.query(command)
+	if err != nil {
+		return nil, err
+	}
+	var rows [][]string
+	for _, s := range result.Series {
+		for _, row := range s.Values {
+			if len(row) < n {
+				return nil, fmt.Errorf("unexpected result of %q", command)
+			}
+			values := make([]string, n)
+			for i := range values {
+				v, ok := row[i].(string)
+				if !ok {
+					return nil, fmt.Errorf("unexpected result of %q", command)
+				}
+				values[i] = v
+			}
+			rows = append(rows, values)
+		}
+	}
+	return rows, nil
+}
+
+func (e *Exporter) measurements() ([]string, error) {
+	rows, err := e.show("SHOW MEASUREMENTS", 1)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(rows))
+	for i, row := range rows {
+		names[i] = row[0]
+	}
+	return names, nil
+}
+
+func (e *Exporter) measurementSchema(name string) (*measurementSchema, error) {
+	ms := &measurementSchema{name: name}
+	rows, err := e.show("SHOW TAG KEYS FROM "+influxql.QuoteIdent(name), 1)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		ms.tags = append(ms.tags, row[0])
+	}
+	sort.Strings(ms.tags)
+
+	if rows, err = e.show("SHOW FIELD KEYS FROM "+influxql.QuoteIdent(name), 2); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		ms.fields = append(ms.fields, row[0])
+		ms.fieldTypes = append(ms.fieldTypes, row[1])
+	}
+	return ms, nil
+}
+
+// exportMeasurement queries the rows of the measurement in chunks split by the time of the last row.
+// The order of the rows with the same time is not stable between the queries, so the rows with the
+// time of the last row of a full chunk are not written with the chunk but queried together at once.
+func (e *Exporter) exportMeasurement(w exportWriter, name string) error {
+	ms, err := e.measurementSchema(name)
+	if err != nil {
+		return err
+	}
+	if len(ms.fields) == 0 {
+		return nil
+	}
+	if err = w.begin(ms); err != nil {
+		return err
+	}
+
+	selectRows := func(cond string, limit int) (*client.Result, error) {
+		command := "SELECT * FROM " + influxql.QuoteIdent(name)
+		if cond += e.timeCond; cond != "" {
+			command += " WHERE " + strings.TrimPrefix(cond, " AND ")
+		}
+		if limit > 0 {
+			command += fmt.Sprintf(" LIMIT %d", limit)
+		}
+		return e.query(command)
+	}
+
+	cursor := ""
+	rows := 0
+	for {
+		result, err := selectRows(cursor, e.chunkSize)
+		if err != nil {
+			return err
+		}
+		count, last, err := lastTime(result)
+		if err != nil {
+			return err
+		}
+		if count < e.chunkSize {
+			n, err := e.writeResult(w, ms, result, math.MaxInt64)
+			if err != nil {
+				return err
+			}
+			rows += n
+			break
+		}
+
+		n, err := e.writeResult(w, ms, result, last)
+		if err != nil {
+			return err
+		}
+		rows += n
+		// the rows with the last time may be split by LIMIT
+		if result, err = selectRows(fmt.Sprintf(" AND time = %d", last), 0); err != nil {
+			return err
+		}
+		if n, err = e.writeResult(w, ms, result, math.MaxInt64); err != nil {
+			return err
+		}
+		rows += n
+		cursor = fmt.Sprintf(" AND time > %d", last)
+	}
+	e.totalRows += rows
+	e.stderrLogger.Printf("Exported %d rows of measurement %s\n", rows, name)
+	return nil
+}
+
+// lastTime returns the number of rows of the result and the time of the last row
+func lastTime(result *client.Result) (int, int64, error) {
+	var count int
+	var last int64
+	for _, s := range result.Series {
+		count += len(s.Values)
+		if len(s.Values) == 0 {
+			continue
+		}
+		timeIdx := indexOf(s.Columns, "time")
+		if timeIdx < 0 {
+			return 0, 0, fmt.Errorf("no time column in the result of measurement %s", s.Name)
+		}
+		ts, err := toInt64(s.Values[len(s.Values)-1][timeIdx])
+		if err != nil {
+			return 0, 0, err
+		}
+		last = ts
+	}
+	return count, last, nil
+}
+
+// writeResult writes the rows of the result before the time until, returns the number of rows written
+func (e *Exporter) writeResult(w exportWriter, ms *measurementSchema, result *client.Result, until int64) (int, error) {
+	var n int
+	tags := make([]string, len(ms.tags))
+	fields := make([]interface{}, len(ms.fields))
+	for _, s := range result.Series {
+		tagIdx := make([]int, len(ms.tags))
+		fieldIdx := make([]int, len(ms.fields))
+		timeIdx := indexOf(s.Columns, "time")
+		if timeIdx < 0 {
+			return 0, fmt.Errorf("no time column in the result of measurement %s", ms.name)
+		}
+		for i, tag := range ms.tags {
+			tagIdx[i] = indexOf(s.Columns, tag)
+		}
+		for i, field := range ms.fields {
+			fieldIdx[i] = indexOf(s.Columns, field)
+		}
+
+		for _, row := range s.Values {
+			ts, err := toInt64(row[timeIdx])
+			if err != nil {
+				return 0, err
+			}
+			if ts >= until {
+				continue
+			}
+			for i, idx := range tagIdx {
+				tags[i] = ""
+				if idx >= 0 && row[idx] != nil {
+					tags[i] = fmt.Sprint(row[idx])
+				}
+			}
+			for i, idx := range fieldIdx {
+				fields[i] = nil
+				if idx >= 0 && row[idx] != nil {
+					if fields[i], err = toFieldValue(row[idx], ms.fieldTypes[i]); err != nil {
+						return 0, fmt.Errorf("field %s of measurement %s: %s", ms.fields[i], ms.name, err)
+					}
+				}
+			}
+			if err = w.write(ms, ts, tags, fields); err != nil {
+				return 0, err
+			}
+			n++
+		}
+	}
+	return n, nil
+}
+
+func indexOf(columns []string, name string) int {
+	for i, col := range columns {
+		if col == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func toInt64(v interface{}) (int64, error) {
+	switch n := v.(type) {
+	case json.Number:
+		return n.Int64()
+	case float64:
+		return int64(n), nil
+	case int64:
+		return n, nil
+	}
+	return 0, fmt.Errorf("invalid integer value %v", v)
+}
+
+func toUint64(v interface{}) (uint64, error) {
+	switch n := v.(type) {
+	case json.Number:
+		return strconv.ParseUint(n.String(), 10, 64)
+	case float64:
+		if n >= 0 {
+			return uint64(n), nil
+		}
+	case uint64:
+		return n, nil
+	}
+	return 0, fmt.Errorf("invalid unsigned value %v", v)
+}
+
+// toFieldValue converts the value decoded from the JSON response to the type of the field
+func toFieldValue(v interface{}, typ string) (interface{}, error) {
+	switch typ {
+	case fieldTypeFloat:
+		switch n := v.(type) {
+		case json.Number:
+			return n.Float64()
+		case float64:
+			return n, nil
+		}
+	case fieldTypeInteger:
+		return toInt64(v)
+	case fieldTypeUnsigned:
+		return toUint64(v)
+	case fieldTypeString:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case fieldTypeBoolean:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported type %s", typ)
+	}
+	return nil, fmt.Errorf("invalid %s value %v", typ, v)
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// streamWriter is the buffered and optionally gzipped output of the text formats
+type streamWriter struct {
+	*bufio.Writer
+	gz  *gzip.Writer
+	out io.WriteCloser
+}
+
+func (w *streamWriter) Close() error {
+	err := w.Flush()
+	if w.gz != nil {
+		if e := w.gz.Close(); err == nil {
+			err = e
+		}
+	}
+	if e := w.out.Close(); err == nil {
+		err = e
+	}
+	return err
+}
+
+// lpExportWriter writes the line protocol in the format read by the Importer
+type lpExportWriter struct {
+	*streamWriter
+	buf []byte
+}
+
+func (w *lpExportWriter) writeHeader(database, retentionPolicy string) error {
+	_, err := fmt.Fprintf(w, "# DDL\nCREATE DATABASE %s\n\n# DML\n# CONTEXT-DATABASE: %s\n",
+		influxql.QuoteIdent(database), database)
+	if err == nil && retentionPolicy != "" {
+		_, err = fmt.Fprintf(w, "# CONTEXT-RETENTION-POLICY: %s\n", retentionPolicy)
+	}
+	return err
+}
+
+func (w *lpExportWriter) begin(*measurementSchema) error {
+	return nil
+}
+
+func (w *lpExportWriter) write(ms *measurementSchema, ts int64, tags []string, fields []interface{}) error {
+	fieldSet := make(models.Fields, len(fields))
+	for i, v := range fields {
+		if v != nil {
+			fieldSet[ms.fields[i]] = v
+		}
+	}
+	if len(fieldSet) == 0 {
+		return nil
+	}
+	tagSet := make(models.Tags, 0, len(tags))
+	for i, v := range tags {
+		if v != "" {
+			tagSet = append(tagSet, models.NewTag([]byte(ms.tags[i]), []byte(v)))
+		}
+	}
+	p, err := models.NewPoint(ms.name, tagSet, fieldSet, time.Unix(0, ts))
+	if err != nil {
+		return err
+	}
+	w.buf = p.AppendString(w.buf[:0])
+	w.buf = append(w.buf, '\n')
+	_, err = w.Write(w.buf)
+	return err
+}
+
+// csvExportWriter writes a header line of name, time, tags and fields for each measurement
+type csvExportWriter struct {
+	*streamWriter
+	w      *csv.Writer
+	record []string
+}
+
+func (w *csvExportWriter) begin(ms *measurementSchema) error {
+	header := append([]string{"name", "time"}, ms.tags...)
+	return w.w.Write(append(header, ms.fields...))
+}
+
+func (w *csvExportWriter) write(ms *measurementSchema, ts int64, tags []string, fields []interface{}) error {
+	w.record = append(w.record[:0], ms.name, strconv.FormatInt(ts, 10))
+	w.record = append(w.record, tags...)
+	for _, v := range fields {
+		switch f := v.(type) {
+		case nil:
+			w.record = append(w.record, "")
+		case float64:
+			w.record = append(w.record, strconv.FormatFloat(f, 'f', -1, 64))
+		case uint64:
+			w.record = append(w.record, strconv.FormatUint(f, 10))
+		default:
+			w.record = append(w.record, fmt.Sprint(f))
+		}
+	}
+	return w.w.Write(w.record)
+}
+
+func (w *csvExportWriter) Close() error {
+	w.w.Flush()
+	err := w.w.Error()
+	if e := w.streamWriter.Close(); err == nil {
+		err = e
+	}
+	return err
+}
+
+// parquetExportWriter writes each measurement to the file <dir>/<measurement>.parquet
+type parquetExportWriter struct {
+	dir  string
+	gzip bool
+	f    *os.File
+	buf  *bufio.Writer
+	w    *parquet.Writer
+	row  []interface{}
+}
+
+func (w *parquetExportWriter) begin(ms *measurementSchema) error {
+	if err := w.Close(); err != nil {
+		return err
+	}
+	schema := parquet.Schema{{Name: "time", Type: parquet.Timestamp, Required: true}}
+	for _, tag := range ms.tags {
+		schema = append(schema, parquet.Field{Name: tag, Type: parquet.String})
+	}
+	for i, field := range ms.fields {
+		f := parquet.Field{Name: field}
+		switch ms.fieldTypes[i] {
+		case fieldTypeFloat:
+			f.Type = parquet.Double
+		case fieldTypeInteger:
+			f.Type = parquet.Int64
+		case fieldTypeUnsigned:
+			f.Type = parquet.Uint64
+		case fieldTypeString:
+			f.Type = parquet.String
+		case fieldTypeBoolean:
+			f.Type = parquet.Boolean
+		default:
+			return fmt.Errorf("unsupported type %s of field %s", ms.fieldTypes[i], field)
+		}
+		schema = append(schema, f)
+	}
+
+	path := filepath.Join(w.dir, url.PathEscape(ms.name)+".parquet")
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+	if err != nil {
+		return fmt.Errorf("fail to create file %s, %s", path, err)
+	}
+	w.f = f
+	w.buf = bufio.NewWriter(f)
+	w.w, err = parquet.NewWriter(w.buf, schema, parquet.Options{Gzip: w.gzip})
+	return err
+}
+
+func (w *parquetExportWriter) write(ms *measurementSchema, ts int64, tags []string, fields []interface{}) error {
+	w.row = append(w.row[:0], ts)
+	for _, v := range tags {
+		if v == "" {
+			w.row = append(w.row, nil)
+		} else {
+			w.row = append(w.row, v)
+		}
+	}
+	w.row = append(w.row, fields...)
+	return w.w.WriteRow(w.row)
+}
+
+// Close closes the file of the current measurement
+func (w *parquetExportWriter) Close() error {
+	if w.f == nil {
+		return nil
+	}
+	var err error
+	if w.w != nil {
+		err = w.w.Close()
+	}
+	if e := w.buf.Flush(); err == nil {
+		err = e
+	}
+	if e := w.f.Close(); err == nil {
+		err = e
+	}
+	w.f, w.w = nil, nil
+	return err
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geminicli
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/apache/arrow/go/v13/parquet/file"
+	"github.com/apache/arrow/go/v13/parquet/pqarrow"
+	"github.com/influxdata/influxdb/client"
+	"github.com/influxdata/influxdb/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exportMockClient returns the rows of measurement cpu, which are paged by the time and LIMIT
+type exportMockClient struct {
+	mockClient
+	queries []string
+	written []string
+	// shuffle changes the order of the rows with the same time between the queries
+	shuffle bool
+}
+
+var exportMockRows = [][]interface{}{
+	{json.Number("1"), "h1", json.Number("1.5"), json.Number("1"), "a b", true},
+	{json.Number("2"), "h1", json.Number("2"), nil, nil, nil},
+	{json.Number("2"), nil, json.Number("3"), json.Number("3"), nil, false},
+	{json.Number("2"), "h2", json.Number("4"), nil, nil, nil},
+	{json.Number("3"), "h,2", nil, json.Number("5"), `"q"`, nil},
+}
+
+func (m *exportMockClient) QueryContext(ctx context.Context, q client.Query) (*client.Response, error) {
+	m.queries = append(m.queries, q.Command)
+	var series []models.Row
+	switch {
+	case q.Command == "SHOW MEASUREMENTS":
+		series = []models.Row{{Columns: []string{"name"}, Values: [][]interface{}{{"cpu"}, {"mem"}}}}
+	case strings.HasPrefix(q.Command, "SHOW TAG KEYS FROM cpu"):
+		series = []models.Row{{Columns: []string{"tagKey"}, Values: [][]interface{}{{"host"}}}}
+	case strings.HasPrefix(q.Command, "SHOW FIELD KEYS FROM cpu"):
+		series = []models.Row{{Columns: []string{"fieldKey", "fieldType"}, Values: [][]interface{}{
+			{"value", "float"}, {"count", "integer"}, {"msg", "string"}, {"ok", "boolean"}}}}
+	case strings.HasPrefix(q.Command, "SELECT * FROM cpu"):
+		var values [][]interface{}
+		for _, row := range exportMockRows {
+			ts, _ := row[0].(json.Number).Int64()
+			if matchTime(q.Command, ts) {
+				values = append(values, row)
+			}
+		}
+		if m.shuffle && len(m.queries)%2 == 0 && len(values) > 2 {
+			values[1], values[2] = values[2], values[1]
+		}
+		if i := strings.Index(q.Command, "LIMIT "); i >= 0 {
+			limit, _ := strconv.Atoi(q.Command[i+6:])
+			if len(values) > limit {
+				values = values[:limit]
+			}
+		}
+		series = []models.Row{{Name: "cpu", Columns: []string{"time", "count", "host", "msg", "ok", "value"}, Values: reorder(values)}}
+	}
+	return &client.Response{Results: []client.Result{{Series: series}}}, nil
+}
+
+// matchTime checks the time by the conditions of the query
+func matchTime(command string, ts int64) bool {
+	fields := strings.Fields(command)
+	for i := 0; i+2 < len(fields); i++ {
+		if fields[i] != "time" {
+			continue
+		}
+		v, _ := strconv.ParseInt(fields[i+2], 10, 64)
+		switch fields[i+1] {
+		case "=":
+			if ts != v {
+				return false
+			}
+		case ">":
+			if ts <= v {
+				return false
+			}
+		case ">=":
+			if ts < v {
+				return false
+			}
+		case "<":
+			if ts >= v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// reorder converts the rows of exportMockRows to the columns order of the query result
+func reorder(rows [][]interface{}) [][]interface{} {
+	values := make([][]interface{}, len(rows))
+	for i, r := range rows {
+		values[i] = []interface{}{r[0], r[3], r[1], r[4], r[5], r[2]}
+	}
+	return values
+}
+
+func (m *exportMockClient) WriteLineProtocol(data, database, retentionPolicy, precision, writeConsistency string) (*client.Response, error) {
+	m.written = append(m.written, strings.Split(data, "\n")...)
+	return &client.Response{}, nil
+}
+
+func newTestExporter(c HttpClient) *Exporter {
+	e := NewExporter()
+	e.clientCreator = func(config client.Config) (HttpClient, error) {
+		return c, nil
+	}
+	e.stderrLogger.SetOutput(io.Discard)
+	return e
+}
+
+func readFile(t *testing.T, path string, gz bool) string {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	if gz {
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		data, err = io.ReadAll(r)
+		require.NoError(t, err)
+	}
+	return string(data)
+}
+
+func TestExporter_Export_Check(t *testing.T) {
+	e := newTestExporter(&exportMockClient{})
+	err := e.Export(&CommandLineConfig{})
+	assert.EqualError(t, err, "execute export cmd, --database is required")
+
+	err = e.Export(&CommandLineConfig{Database: "db0", Format: "json"})
+	assert.EqualError(t, err, `unknown export format "json". format must be lp, csv or parquet`)
+
+	err = e.Export(&CommandLineConfig{Database: "db0", Format: ExportFormatParquet})
+	assert.EqualError(t, err, "execute export cmd, --path is required by the parquet format")
+
+	err = e.Export(&CommandLineConfig{Database: "db0", Start: "2024-01-01"})
+	assert.Contains(t, err.Error(), `invalid start time "2024-01-01"`)
+}
+
+func TestExporter_ExportLineProtocol(t *testing.T) {
+	c := &exportMockClient{shuffle: true}
+	e := newTestExporter(c)
+	path := filepath.Join(t.TempDir(), "cpu.txt.gz")
+	err := e.Export(&CommandLineConfig{
+		Database:        "db0",
+		RetentionPolicy: "rp0",
+		Measurement:     "cpu",
+		Start:           "1970-01-01T00:00:00Z",
+		End:             "1970-01-01T00:00:01Z",
+		Compress:        true,
+		ChunkSize:       2,
+		Path:            path,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 5, e.totalRows)
+	assert.Equal(t, []string{
+		"SHOW TAG KEYS FROM cpu",
+		"SHOW FIELD KEYS FROM cpu",
+		"SELECT * FROM cpu WHERE time >= 0 AND time < 1000000000 LIMIT 2",
+		"SELECT * FROM cpu WHERE time = 2 AND time >= 0 AND time < 1000000000",
+		"SELECT * FROM cpu WHERE time > 2 AND time >= 0 AND time < 1000000000 LIMIT 2",
+	}, c.queries)
+
+	expected := `# DDL
+CREATE DATABASE db0
+
+# DML
+# CONTEXT-DATABASE: db0
+# CONTEXT-RETENTION-POLICY: rp0
+cpu,host=h1 count=1i,msg="a b",ok=true,value=1.5 1
+cpu,host=h1 value=2 2
+cpu,host=h2 value=4 2
+cpu count=3i,ok=false,value=3 2
+cpu,host=h\,2 count=5i,msg="\"q\"" 3
+`
+	assert.Equal(t, expected, readFile(t, path, true))
+
+	// the exported file can be imported
+	ipt := NewImporter()
+	ipt.clientCreator = func(config client.Config) (HttpClient, error) {
+		return c, nil
+	}
+	require.NoError(t, ipt.Import(&CommandLineConfig{Path: path}))
+	assert.Equal(t, 5, ipt.totalInserts)
+	assert.Equal(t, "db0", ipt.database)
+	assert.Equal(t, "rp0", ipt.retentionPolicy)
+	assert.Equal(t, strings.Split(expected, "\n")[6], c.written[0])
+}
+
+func TestExporter_ExportCSV(t *testing.T) {
+	e := newTestExporter(&exportMockClient{})
+	path := filepath.Join(t.TempDir(), "db0.csv")
+	require.NoError(t, e.Export(&CommandLineConfig{Database: "db0", Format: ExportFormatCSV, Path: path}))
+
+	// mem has no fields
+	assert.Equal(t, `name,time,host,value,count,msg,ok
+cpu,1,h1,1.5,1,a b,true
+cpu,2,h1,2,,,
+cpu,2,,3,3,,false
+cpu,2,h2,4,,,
+cpu,3,"h,2",,5,"""q""",
+`, readFile(t, path, false))
+}
+
+func TestExporter_ExportParquet(t *testing.T) {
+	e := newTestExporter(&exportMockClient{})
+	dir := filepath.Join(t.TempDir(), "db0")
+	require.NoError(t, e.Export(&CommandLineConfig{Database: "db0", Format: ExportFormatParquet, Path: dir, Compress: true}))
+
+	data := readFile(t, filepath.Join(dir, "cpu.parquet"), false)
+	assert.True(t, strings.HasPrefix(data, "PAR1"))
+	assert.True(t, strings.HasSuffix(data, "PAR1"))
+	_, err := os.Stat(filepath.Join(dir, "mem.parquet"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+// unsignedMockClient returns the measurement counter with an unsigned field greater than the max int64
+type unsignedMockClient struct {
+	mockClient
+}
+
+func (m *unsignedMockClient) QueryContext(ctx context.Context, q client.Query) (*client.Response, error) {
+	var series []models.Row
+	switch {
+	case q.Command == "SHOW MEASUREMENTS":
+		series = []models.Row{{Columns: []string{"name"}, Values: [][]interface{}{{"counter"}}}}
+	case strings.HasPrefix(q.Command, "SHOW FIELD KEYS FROM counter"):
+		series = []models.Row{{Columns: []string{"fieldKey", "fieldType"}, Values: [][]interface{}{{"total", "unsigned"}}}}
+	case strings.HasPrefix(q.Command, "SELECT * FROM counter"):
+		series = []models.Row{{Name: "counter", Columns: []string{"time", "total"}, Values: [][]interface{}{
+			{json.Number("1"), json.Number("18446744073709551615")},
+			{json.Number("2"), json.Number("9223372036854775808")},
+		}}}
+	}
+	return &client.Response{Results: []client.Result{{Series: series}}}, nil
+}
+
+func TestExporter_ExportUnsigned(t *testing.T) {
+	expected := []uint64{math.MaxUint64, math.MaxInt64 + 1}
+
+	e := newTestExporter(&unsignedMockClient{})
+	path := filepath.Join(t.TempDir(), "db0.txt")
+	require.NoError(t, e.Export(&CommandLineConfig{Database: "db0", Path: path}))
+	lines := strings.Split(readFile(t, path, false), "\n")
+	require.Equal(t, []string{"counter total=18446744073709551615u 1", "counter total=9223372036854775808u 2", ""}, lines[5:])
+	models.EnableUintSupport()
+	for i, line := range lines[5:7] {
+		p, err := models.ParsePointsString(line)
+		require.NoError(t, err)
+		fields, err := p[0].Fields()
+		require.NoError(t, err)
+		assert.Equal(t, expected[i], fields["total"])
+	}
+
+	path = filepath.Join(t.TempDir(), "db0.csv")
+	e = newTestExporter(&unsignedMockClient{})
+	require.NoError(t, e.Export(&CommandLineConfig{Database: "db0", Format: ExportFormatCSV, Path: path}))
+	assert.Equal(t, "name,time,total\ncounter,1,18446744073709551615\ncounter,2,9223372036854775808\n", readFile(t, path, false))
+
+	dir := filepath.Join(t.TempDir(), "db0")
+	e = newTestExporter(&unsignedMockClient{})
+	require.NoError(t, e.Export(&CommandLineConfig{Database: "db0", Format: ExportFormatParquet, Path: dir}))
+	rdr, err := file.OpenParquetFile(filepath.Join(dir, "counter.parquet"), false)
+	require.NoError(t, err)
+	defer rdr.Close()
+	fr, err := pqarrow.NewFileReader(rdr, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	table, err := fr.ReadTable(context.Background())
+	require.NoError(t, err)
+	defer table.Release()
+	col, ok := table.Column(1).Data().Chunk(0).(*array.Uint64)
+	require.True(t, ok)
+	assert.Equal(t, expected, col.Uint64Values())
+}
+
+func TestToFieldValue(t *testing.T) {
+	v, err := toFieldValue(json.Number("1"), fieldTypeFloat)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, v)
+	v, err = toFieldValue(json.Number("1"), fieldTypeInteger)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), v)
+
+	v, err = toFieldValue(json.Number("18446744073709551615"), fieldTypeUnsigned)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), v)
+
+	_, err = toFieldValue(json.Number("1.5"), fieldTypeInteger)
+	assert.Error(t, err)
+	_, err = toFieldValue(json.Number("-1"), fieldTypeUnsigned)
+	assert.Error(t, err)
+	_, err = toFieldValue("a", fieldTypeBoolean)
+	assert.Error(t, err)
+	_, err = toFieldValue(json.Number("1"), "unknown")
+	assert.Error(t, err)
+}
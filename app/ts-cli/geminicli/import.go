@@ -18,6 +18,7 @@ package geminicli
 
 import (
 	"bufio"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
@@ -155,9 +156,16 @@ func (ipt *Importer) Import(clc *CommandLineConfig) error {
 	}
 	defer f.Close()
 
-	var r = f
-	// Get the reader
-	scanner := bufio.NewReader(r)
+	// Get the reader, the gzipped file exported with --compress is detected by the magic number
+	scanner := bufio.NewReader(f)
+	if magic, err := scanner.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gr, err := gzip.NewReader(scanner)
+		if err != nil {
+			return fmt.Errorf("fail to read gzip file %s, %s", clc.Path, err)
+		}
+		defer gr.Close()
+		scanner = bufio.NewReader(gr)
+	}
 
 	// Process the DDL
 	if err := ipt.processDDL(scanner); err != nil {
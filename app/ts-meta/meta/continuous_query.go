@@ -19,6 +19,7 @@ package meta
 import (
 	"container/list"
 	"fmt"
+	"hash/fnv"
 	"sort"
 	"time"
 
@@ -85,6 +86,32 @@ func (s *Store) getContinuousQueryLease(host string) ([]string, error) {
 	return leaseInfo.CQNames, nil
 }
 
+// getRuleGroupLease returns the rule groups which should be evaluated by the specify sql host.
+// Every group is assigned to one alive sql host by the hash of its name, so all the sql hosts
+// loading the same rule files evaluate each group only once.
+func (s *Store) getRuleGroupLease(host string, groups []string) ([]string, error) {
+	if !s.IsLeader() {
+		return nil, raft.ErrNotLeader
+	}
+
+	s.cqLock.Lock()
+	defer s.cqLock.Unlock()
+
+	s.hasRuleLease = true
+	if _, ok := s.cqLease[host]; !ok || len(s.sqlHosts) == 0 {
+		return nil, nil
+	}
+	var leased []string
+	for _, group := range groups {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(group))
+		if s.sqlHosts[int(h.Sum32()%uint32(len(s.sqlHosts)))] == host {
+			leased = append(leased, group)
+		}
+	}
+	return leased, nil
+}
+
 func (s *Store) handlerSql2MetaHeartbeat(host string) error {
 	if !s.IsLeader() {
 		return raft.ErrNotLeader
@@ -162,7 +189,11 @@ func (s *Store) checkSQLNodesHeartbeat() {
 	}
 	s.cacheMu.RUnlock()
 
-	if !hasCQ {
+	s.cqLock.RLock()
+	hasRuleLease := s.hasRuleLease
+	s.cqLock.RUnlock()
+
+	if !hasCQ && !hasRuleLease {
 		return
 	}
 	for {
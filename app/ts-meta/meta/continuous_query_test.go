@@ -78,6 +78,42 @@ func Test_getContinuousQueryLease(t *testing.T) {
 	require.Equal(t, []string{"cq0", "cq1"}, cqs)
 }
 
+func Test_getRuleGroupLease(t *testing.T) {
+	s := &Store{
+		heartbeatInfoList: list.New(),
+		cqLease:           make(map[string]*cqLeaseInfo),
+		closing:           make(chan struct{}),
+		raft:              &MockRaftForCQ{isLeader: false},
+		Logger:            logger.NewLogger(errno.ModuleUnknown).SetZapLogger(zap.NewNop()),
+	}
+	host1, host2 := "127.0.0.1:8086", "127.0.0.2:8086"
+	groups := []string{"a.yml;g0", "a.yml;g1", "a.yml;g2", "b.yml;g0", "b.yml;g1"}
+	_, err := s.getRuleGroupLease(host1, groups)
+	require.Equal(t, raft.ErrNotLeader, err)
+
+	// unknown sql host
+	s.raft = &MockRaftForCQ{isLeader: true}
+	leased, err := s.getRuleGroupLease(host1, groups)
+	require.NoError(t, err)
+	require.Nil(t, leased)
+	require.True(t, s.hasRuleLease)
+
+	// only one sql host, it gets all the groups
+	require.NoError(t, s.handlerSql2MetaHeartbeat(host1))
+	leased, err = s.getRuleGroupLease(host1, groups)
+	require.NoError(t, err)
+	require.Equal(t, groups, leased)
+
+	// every group is assigned to one sql host
+	require.NoError(t, s.handlerSql2MetaHeartbeat(host2))
+	leased1, err := s.getRuleGroupLease(host1, groups)
+	require.NoError(t, err)
+	leased2, err := s.getRuleGroupLease(host2, groups)
+	require.NoError(t, err)
+	require.Equal(t, len(groups), len(leased1)+len(leased2))
+	require.ElementsMatch(t, groups, append(leased1, leased2...))
+}
+
 func Test_handlerSql2MetaHeartbeat(t *testing.T) {
 	s := &Store{
 		heartbeatInfoList: list.New(),
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
@@ -57,6 +58,8 @@ type IStore interface {
 	leaderHTTP() string
 	leadershipTransfer() error
 	SpecialCtlData(cmd string) error
+	backup() ([]byte, error)
+	restore(buf []byte) error
 }
 
 var httpScheme = map[bool]string{
@@ -106,6 +109,43 @@ func (h *httpHandler) WrapHandler(hf http.HandlerFunc) http.Handler {
 	}), h.client, h.config.AuthEnabled)
 }
 
+// wrapAdminHandler serves the request of an admin user. If the authentication is disabled, or there is
+// no admin user yet such as when the metadata is restored into a fresh cluster, only the requests from
+// the local host are served.
+func (h *httpHandler) wrapAdminHandler(hf http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.config.AuthEnabled && h.client.AdminUserExists() {
+			creds, err := httpd.ParseCredentials(r)
+			if err != nil {
+				util.HttpError(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			user, err := h.client.Authenticate(creds.Username, creds.Password)
+			if err != nil {
+				util.HttpError(w, errno.NewError(errno.HttpUnauthorized).Error(), http.StatusUnauthorized)
+				return
+			}
+			if !user.AuthorizeUnrestricted() {
+				util.HttpError(w, "requires admin privilege", http.StatusForbidden)
+				return
+			}
+		} else if !isLoopbackAddr(r.RemoteAddr) {
+			util.HttpError(w, "only allowed from the local host if there is no admin user to authenticate", http.StatusForbidden)
+			return
+		}
+		hf.ServeHTTP(httpd.NewResponseLogger(w), r)
+	})
+}
+
+func isLoopbackAddr(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 // ServeHTTP responds to HTTP request to the handler.
 func (h *httpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
@@ -119,6 +159,8 @@ func (h *httpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			h.WrapHandler(h.serveAnalysisHeartInfo).ServeHTTP(w, r)
 		case "/debug/vars":
 			h.WrapHandler(h.serveExpvar).ServeHTTP(w, r)
+		case "/backup":
+			h.wrapAdminHandler(h.serveBackup).ServeHTTP(w, r)
 		}
 		h.logger.Info("serve get")
 	case "POST":
@@ -142,6 +184,9 @@ func (h *httpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			h.WrapHandler(h.leadershipTransfer).ServeHTTP(w, r)
 		case "/specialCtlData":
 			h.WrapHandler(h.specialCtlData).ServeHTTP(w, r)
+		case "/restore":
+			h.logger.Info("serveRestore")
+			h.wrapAdminHandler(h.serveRestore).ServeHTTP(w, r)
 		}
 		h.logger.Info("serve post")
 	default:
@@ -305,6 +350,37 @@ func (h *httpHandler) userSnapshot(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// get the binary of the metadata for backup
+// do this way:curl -o meta -XGET 'http://127.0.0.1:8091/backup'
+func (h *httpHandler) serveBackup(w http.ResponseWriter, r *http.Request) {
+	b, err := h.store.backup()
+	if err != nil {
+		h.logger.Error("backup meta failed", zap.Error(err))
+		h.httpErr(err, w, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/octet-stream")
+	if _, err = w.Write(b); err != nil {
+		h.logger.Error("backup meta failed", zap.Error(err))
+	}
+}
+
+// restore the metadata of a backup into a cluster without databases
+// do this way:curl -i -XPOST 'http://127.0.0.1:8091/restore' --data-binary @meta
+func (h *httpHandler) serveRestore(w http.ResponseWriter, r *http.Request) {
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.logger.Error("read restore body failed", zap.Error(err))
+		h.httpErr(err, w, http.StatusBadRequest)
+		return
+	}
+
+	err = h.store.restore(b)
+	h.handleResponse(w, err)
+	h.logger.Info("serveRestore finished", zap.Int("size", len(b)), zap.Error(err))
+}
+
 func (h *httpHandler) serveExpvar(w http.ResponseWriter, r *http.Request) {
 	app.SetStatsResponse(h.statisticsPusher, w, r)
 }
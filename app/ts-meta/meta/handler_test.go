@@ -21,6 +21,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -135,6 +136,62 @@ func TestHttpHandler_ServeHTTP(t *testing.T) {
 	assert.Equal(t, node2, mms.GetStore().data.PtView["test"][0].Owner.NodeID)
 }
 
+type backupMockStore struct {
+	MockIStore
+	buf []byte
+	err error
+}
+
+func (s *backupMockStore) backup() ([]byte, error) {
+	return s.buf, s.err
+}
+
+func (s *backupMockStore) restore(buf []byte) error {
+	s.buf = buf
+	return s.err
+}
+
+func TestHttpHandler_BackupRestore(t *testing.T) {
+	store := &backupMockStore{buf: []byte("meta")}
+	h := newHttpHandler(config.NewMeta(), store)
+	newRequest := func(method, target string, body io.Reader) *http.Request {
+		req := httptest.NewRequest(method, target, body)
+		req.RemoteAddr = "127.0.0.1:12345"
+		return req
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newRequest("GET", "/backup", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "meta", w.Body.String())
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newRequest("POST", "/restore", strings.NewReader("backup")))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "backup", string(store.buf))
+
+	store.err = meta2.ErrRestoreNotEmpty
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newRequest("GET", "/backup", nil))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newRequest("POST", "/restore", strings.NewReader("backup")))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), meta2.ErrRestoreNotEmpty.Error())
+
+	// the remote requests are rejected without an admin user to authenticate
+	store.err = nil
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/backup", nil))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", "/restore", strings.NewReader("remote")))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, "backup", string(store.buf))
+}
+
 type MockResponseWriter struct {
 }
 
@@ -205,6 +262,14 @@ func (s *MockIStore) SpecialCtlData(cmd string) error {
 	return nil
 }
 
+func (s *MockIStore) backup() ([]byte, error) {
+	return nil, nil
+}
+
+func (s *MockIStore) restore(buf []byte) error {
+	return nil
+}
+
 func TestServeExpandGroups(t *testing.T) {
 	handler := newHttpHandler(&config.Meta{}, &MockIStore{})
 	handler.serveExpandGroups(&MockResponseWriter{}, nil)
@@ -69,6 +69,8 @@ func New(typ uint8) RPCHandler {
 		return &VerifyDataNodeStatus{}
 	case message.SendSysCtrlToMetaRequestMessage:
 		return &SendSysCtrlToMeta{}
+	case message.GetRuleLeaseRequestMessage:
+		return &GetRuleLease{}
 	default:
 		return nil
 	}
@@ -453,3 +455,22 @@ func (h *SendSysCtrlToMeta) SetRequestMsg(data transport.Codec) error {
 func (h *SendSysCtrlToMeta) Instance() RPCHandler {
 	return &SendSysCtrlToMeta{}
 }
+
+type GetRuleLease struct {
+	BaseHandler
+
+	req *message.GetRuleLeaseRequest
+}
+
+func (h *GetRuleLease) SetRequestMsg(data transport.Codec) error {
+	msg, ok := data.(*message.GetRuleLeaseRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*message.GetRuleLeaseRequest", data)
+	}
+	h.req = msg
+	return nil
+}
+
+func (h *GetRuleLease) Instance() RPCHandler {
+	return &GetRuleLease{}
+}
@@ -450,6 +450,17 @@ func (h *GetContinuousQueryLease) Process() (transport.Codec, error) {
 	return rsp, nil
 }
 
+func (h *GetRuleLease) Process() (transport.Codec, error) {
+	rsp := &message.GetRuleLeaseResponse{}
+	groups, err := h.store.getRuleGroupLease(h.req.Host, h.req.Groups)
+	if err != nil {
+		rsp.Err = err.Error()
+		return rsp, nil
+	}
+	rsp.Groups = groups
+	return rsp, nil
+}
+
 func (h *VerifyDataNodeStatus) Process() (transport.Codec, error) {
 	rsp := &message.VerifyDataNodeStatusResponse{}
 	err := h.store.verifyDataNodeStatus(h.req.NodeID)
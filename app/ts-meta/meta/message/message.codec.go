@@ -1109,6 +1109,58 @@ func (o *GetContinuousQueryLeaseResponse) Instance() transport.Codec {
 	return &GetContinuousQueryLeaseResponse{}
 }
 
+func (o *GetRuleLeaseRequest) Marshal(buf []byte) ([]byte, error) {
+	buf = codec.AppendString(buf, o.Host)
+	buf = codec.AppendStringSlice(buf, o.Groups)
+	return buf, nil
+}
+
+func (o *GetRuleLeaseRequest) Unmarshal(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	o.Host = dec.String()
+	o.Groups = dec.StringSlice()
+	return nil
+}
+
+func (o *GetRuleLeaseRequest) Size() int {
+	size := codec.SizeOfString(o.Host)
+	size += codec.SizeOfStringSlice(o.Groups)
+	return size
+}
+
+func (o *GetRuleLeaseRequest) Instance() transport.Codec {
+	return &GetRuleLeaseRequest{}
+}
+
+func (o *GetRuleLeaseResponse) Marshal(buf []byte) ([]byte, error) {
+	buf = codec.AppendStringSlice(buf, o.Groups)
+	buf = codec.AppendString(buf, o.Err)
+	return buf, nil
+}
+
+func (o *GetRuleLeaseResponse) Unmarshal(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	o.Groups = dec.StringSlice()
+	o.Err = dec.String()
+	return nil
+}
+
+func (o *GetRuleLeaseResponse) Size() int {
+	size := codec.SizeOfStringSlice(o.Groups)
+	size += codec.SizeOfString(o.Err)
+	return size
+}
+
+func (o *GetRuleLeaseResponse) Instance() transport.Codec {
+	return &GetRuleLeaseResponse{}
+}
+
 func (req *VerifyDataNodeStatusRequest) Marshal(buf []byte) ([]byte, error) {
 	buf = codec.AppendUint64(buf, req.NodeID)
 	return buf, nil
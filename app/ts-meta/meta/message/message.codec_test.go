@@ -182,6 +182,38 @@ func Test_VerifyDataNodeStatus_Request_Response(t *testing.T) {
 
 }
 
+func Test_GetRuleLease_Request_Response(t *testing.T) {
+	request := message.GetRuleLeaseRequest{
+		Host:   "127.0.0.1:8086",
+		Groups: []string{"rules.yml;g0", "rules.yml;g1"},
+	}
+	buf, _ := request.Marshal(nil)
+
+	reqMsg := request.Instance()
+	err := reqMsg.Unmarshal(nil)
+	assert.NoError(t, err)
+
+	err = reqMsg.Unmarshal(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, &request, reqMsg)
+	assert.Equal(t, reqMsg.Size(), request.Size())
+
+	response := message.GetRuleLeaseResponse{
+		Groups: []string{"rules.yml;g1"},
+		Err:    "mock error",
+	}
+	buf, _ = response.Marshal(nil)
+
+	respMsg := response.Instance()
+	err = respMsg.Unmarshal(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, &response, respMsg)
+	assert.Equal(t, respMsg.Size(), response.Size())
+
+	assert.NotNil(t, message.MetaMessageBinaryCodec[message.GetRuleLeaseRequestMessage])
+	assert.Equal(t, message.GetRuleLeaseResponseMessage, message.MetaMessageResponseTyp[message.GetRuleLeaseRequestMessage])
+}
+
 func Test_SendSysCtrlToMeta_Request_Response(t *testing.T) {
 	request := message.SendSysCtrlToMetaRequest{
 		Mod:   "failpoint",
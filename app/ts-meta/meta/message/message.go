@@ -259,6 +259,16 @@ type GetContinuousQueryLeaseResponse struct {
 	Err     string
 }
 
+type GetRuleLeaseRequest struct {
+	Host   string
+	Groups []string
+}
+
+type GetRuleLeaseResponse struct {
+	Groups []string
+	Err    string
+}
+
 type VerifyDataNodeStatusRequest struct {
 	NodeID uint64 // datanode node id
 }
@@ -88,6 +88,9 @@ const (
 
 	CreateSqlNodeRequestMessage
 	CreateSqlNodeResponseMessage
+
+	GetRuleLeaseRequestMessage
+	GetRuleLeaseResponseMessage
 )
 
 var MetaMessageBinaryCodec = make(map[uint8]func() transport.Codec, 20)
@@ -136,6 +139,8 @@ func init() {
 	MetaMessageBinaryCodec[VerifyDataNodeStatusResponseMessage] = func() transport.Codec { return &VerifyDataNodeStatusResponse{} }
 	MetaMessageBinaryCodec[SendSysCtrlToMetaRequestMessage] = func() transport.Codec { return &SendSysCtrlToMetaRequest{} }
 	MetaMessageBinaryCodec[SendSysCtrlToMetaResponseMessage] = func() transport.Codec { return &SendSysCtrlToMetaResponse{} }
+	MetaMessageBinaryCodec[GetRuleLeaseRequestMessage] = func() transport.Codec { return &GetRuleLeaseRequest{} }
+	MetaMessageBinaryCodec[GetRuleLeaseResponseMessage] = func() transport.Codec { return &GetRuleLeaseResponse{} }
 
 	MetaMessageResponseTyp = map[uint8]uint8{
 		PingRequestMessage:                    PingResponseMessage,
@@ -158,5 +163,6 @@ func init() {
 		GetContinuousQueryLeaseRequestMessage: GetContinuousQueryLeaseResponseMessage,
 		VerifyDataNodeStatusRequestMessage:    VerifyDataNodeStatusResponseMessage,
 		SendSysCtrlToMetaRequestMessage:       SendSysCtrlToMetaResponseMessage,
+		GetRuleLeaseRequestMessage:            GetRuleLeaseResponseMessage,
 	}
 }
@@ -34,6 +34,7 @@ import (
 	logger2 "github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
 	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
@@ -515,6 +516,14 @@ func (s *MockNetStorage) DeleteMeasurement(node *meta2.DataNode, db, rp, name st
 	return s.DeleteMeasurementFn(node, db, rp, name, shardIds)
 }
 
+func (s *MockNetStorage) DeleteSeries(nodeID uint64, db, rp string, ptIDs []uint32, measurements []string, condition influxql.Expr) error {
+	return nil
+}
+
+func (s *MockNetStorage) DropSeries(nodeID uint64, db, rp string, ptIDs []uint32, measurements []string, condition influxql.Expr) error {
+	return nil
+}
+
 func (s *MockNetStorage) MigratePt(nodeID uint64, data transport.Codec, cb transport.Callback) error {
 	return s.MigratePtFn(nodeID, data, cb)
 }
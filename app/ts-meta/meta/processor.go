@@ -58,6 +58,7 @@ type MetaStoreInterface interface {
 	registerQueryIDOffset(host meta.SQLHost) (uint64, error)
 	handlerSql2MetaHeartbeat(host string) error
 	getContinuousQueryLease(host string) ([]string, error)
+	getRuleGroupLease(host string, groups []string) ([]string, error)
 	verifyDataNodeStatus(nodeID uint64) error
 }
 
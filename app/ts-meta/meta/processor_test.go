@@ -196,6 +196,10 @@ func (s *MockRPCStore) getContinuousQueryLease(host string) ([]string, error) {
 	return nil, nil
 }
 
+func (s *MockRPCStore) getRuleGroupLease(host string, groups []string) ([]string, error) {
+	return nil, nil
+}
+
 func (s *MockRPCStore) verifyDataNodeStatus(nodeID uint64) error {
 	return nil
 }
@@ -403,6 +403,7 @@ type Store struct {
 	heartbeatInfoList *list.List              // the latest heartbeat information for each ts-sql
 	cqLease           map[string]*cqLeaseInfo // sql host to cq lease.
 	sqlHosts          []string                // sorted hostname ["127.0.0.1:8086", "127.0.0.2:8086", "127.0.0.3:8086"]
+	hasRuleLease      bool                    // some sql node has asked for the rule group lease
 	UseIncSyncData    bool
 }
 
@@ -2014,6 +2015,39 @@ func (s *Store) ExpandGroups() error {
 	return s.ApplyCmd(cmd)
 }
 
+// backup returns the binary of the metadata
+func (s *Store) backup() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.MarshalBinary()
+}
+
+// restore restores the metadata of a backup into the cluster, which must have no databases
+func (s *Store) restore(buf []byte) error {
+	if !s.IsLeader() {
+		return errno.NewError(errno.MetaIsNotLeader)
+	}
+	backup := &meta.Data{}
+	if err := backup.UnmarshalBinary(buf); err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	data := s.data.Clone()
+	s.mu.RUnlock()
+	if err := data.Restore(backup); err != nil {
+		return err
+	}
+
+	val := &mproto.SetDataCommand{Data: data.Marshal()}
+	t := mproto.Command_SetDataCommand
+	cmd := &mproto.Command{Type: &t}
+	if err := proto.SetExtension(cmd, mproto.E_SetDataCommand_Command, val); err != nil {
+		return err
+	}
+	return s.ApplyCmd(cmd)
+}
+
 func (s *Store) updatePtVersion(db string, ptId uint32) error {
 	val := &mproto.UpdatePtVersionCommand{
 		Db: proto.String(db),
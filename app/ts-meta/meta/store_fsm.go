@@ -114,6 +114,7 @@ var applyFunc = map[proto2.Command_Type]func(fsm *storeFSM, cmd *proto2.Command)
 	proto2.Command_UpdateRetentionPolicyCommand:     applyUpdateRetentionPolicy,
 	proto2.Command_CreateShardGroupCommand:          applyCreateShardGroup,
 	proto2.Command_DeleteShardGroupCommand:          applyDeleteShardGroup,
+	proto2.Command_DropShardCommand:                 applyDropShard,
 	proto2.Command_CreateSubscriptionCommand:        applyCreateSubscription,
 	proto2.Command_DropSubscriptionCommand:          applyDropSubscription,
 	proto2.Command_CreateUserCommand:                applyCreateUser,
@@ -167,6 +168,8 @@ var applyFunc = map[proto2.Command_Type]func(fsm *storeFSM, cmd *proto2.Command)
 	proto2.Command_UpdateMeasurementCommand:         applyUpdateMeasurement,
 	proto2.Command_UpdateNodeTmpIndexCommand:        applyUpdateNodeTmpIndexCommand,
 	proto2.Command_InsertFilesCommand:               applyInsertFilesCommand,
+	proto2.Command_SetQuotaCommand:                  applySetQuota,
+	proto2.Command_SetMeasurementPrivilegeCommand:   applySetMeasurementPrivilege,
 }
 
 func applyCreateDatabase(fsm *storeFSM, cmd *proto2.Command) interface{} {
@@ -201,6 +204,10 @@ func applyDeleteShardGroup(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyDeleteShardGroupCommand(cmd)
 }
 
+func applyDropShard(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyDropShardCommand(cmd)
+}
+
 func applyCreateSubscription(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyCreateSubscriptionCommand(cmd)
 }
@@ -221,6 +228,14 @@ func applyUpdateUser(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyUpdateUserCommand(cmd)
 }
 
+func applySetQuota(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applySetQuotaCommand(cmd)
+}
+
+func applySetMeasurementPrivilege(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applySetMeasurementPrivilegeCommand(cmd)
+}
+
 func applySetPrivilege(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applySetPrivilegeCommand(cmd)
 }
@@ -605,6 +620,10 @@ func (fsm *storeFSM) applyDeleteShardGroupCommand(cmd *proto2.Command) interface
 	return meta2.ApplyDeleteShardGroup(fsm.data, cmd)
 }
 
+func (fsm *storeFSM) applyDropShardCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyDropShard(fsm.data, cmd)
+}
+
 func (fsm *storeFSM) applyDeleteIndexGroupCommand(cmd *proto2.Command) interface{} {
 	return meta2.ApplyDeleteIndexGroup(fsm.data, cmd)
 }
@@ -633,6 +652,14 @@ func (fsm *storeFSM) applyUpdateUserCommand(cmd *proto2.Command) interface{} {
 	return meta2.ApplyUpdateUser(fsm.data, cmd)
 }
 
+func (fsm *storeFSM) applySetQuotaCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplySetQuota(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applySetMeasurementPrivilegeCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplySetMeasurementPrivilege(fsm.data, cmd)
+}
+
 func (fsm *storeFSM) applySetPrivilegeCommand(cmd *proto2.Command) interface{} {
 	return meta2.ApplySetPrivilege(fsm.data, cmd)
 }
@@ -911,3 +911,39 @@ func Test_DeleteDbForLogkeeper(t *testing.T) {
 		t.Errorf("deleteDatabase failed, err:%+v", err)
 	}
 }
+
+func TestStore_BackupRestore(t *testing.T) {
+	mms, err := NewMockMetaService(t.TempDir(), "127.0.0.1")
+	require.NoError(t, err)
+	defer mms.Close()
+	s := mms.GetStore()
+	require.NoError(t, s.ApplyCmd(GenerateCreateDataNodeCmd("127.0.0.1:8400", "127.0.0.1:8401")))
+
+	backup := &meta2.Data{PtNumPerNode: 1}
+	nodeID, err := backup.CreateDataNode("127.0.0.2:8400", "127.0.0.2:8401", "")
+	require.NoError(t, err)
+	require.NoError(t, backup.CreateDatabase("db0", nil, nil, false, 1, nil))
+	backup.PtView = map[string]meta2.DBPtInfos{
+		"db0": {{Owner: meta2.PtOwner{NodeID: nodeID}, Status: meta2.Online, PtId: 0}},
+	}
+	require.NoError(t, backup.CreateUser("admin", "hash", true, false))
+	buf, err := backup.MarshalBinary()
+	require.NoError(t, err)
+
+	require.NoError(t, s.restore(buf))
+	data := s.GetData()
+	require.NotNil(t, data.Database("db0"))
+	require.Equal(t, 1, len(data.PtView["db0"]))
+	assert2.Equal(t, meta2.Offline, data.PtView["db0"][0].Status)
+	assert2.Equal(t, data.DataNodes[0].ID, data.PtView["db0"][0].Owner.NodeID)
+	assert2.NotNil(t, data.GetUser("admin"))
+
+	assert2.Equal(t, meta2.ErrRestoreNotEmpty, s.restore(buf))
+	assert2.Error(t, s.restore([]byte("invalid")))
+
+	b, err := s.backup()
+	require.NoError(t, err)
+	data = &meta2.Data{}
+	require.NoError(t, data.UnmarshalBinary(b))
+	assert2.NotNil(t, data.Database("db0"))
+}
@@ -220,6 +220,14 @@ func (s *MockNetStorage) DeleteMeasurement(node *meta2.DataNode, db, rp, name st
 	return nil
 }
 
+func (s *MockNetStorage) DeleteSeries(nodeID uint64, db, rp string, ptIDs []uint32, measurements []string, condition influxql.Expr) error {
+	return nil
+}
+
+func (s *MockNetStorage) DropSeries(nodeID uint64, db, rp string, ptIDs []uint32, measurements []string, condition influxql.Expr) error {
+	return nil
+}
+
 func (s *MockNetStorage) MigratePt(uint64, transport.Codec, transport.Callback) error {
 	return nil
 }
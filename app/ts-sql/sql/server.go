@@ -30,6 +30,7 @@ import (
 	"github.com/openGemini/openGemini/coordinator"
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/executor/spdy/transport"
+	"github.com/openGemini/openGemini/lib/audit"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/cpu"
 	"github.com/openGemini/openGemini/lib/errno"
@@ -37,12 +38,14 @@ import (
 	"github.com/openGemini/openGemini/lib/machine"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/quota"
 	"github.com/openGemini/openGemini/lib/statisticsPusher"
 	stat "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/sysconfig"
 	"github.com/openGemini/openGemini/lib/syscontrol"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/auth"
 	coordinator2 "github.com/openGemini/openGemini/lib/util/lifted/influx/coordinator"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
@@ -50,6 +53,8 @@ import (
 	"github.com/openGemini/openGemini/services/arrowflight"
 	"github.com/openGemini/openGemini/services/castor"
 	"github.com/openGemini/openGemini/services/continuousquery"
+	"github.com/openGemini/openGemini/services/otlp"
+	"github.com/openGemini/openGemini/services/rule"
 	"github.com/openGemini/openGemini/services/sherlock"
 	gopscpu "github.com/shirou/gopsutil/v3/cpu"
 	"go.uber.org/zap"
@@ -70,11 +75,15 @@ type Server struct {
 	QueryExecutor     *query.Executor
 	PointsWriter      *coordinator.PointsWriter
 	SubscriberManager *coordinator.SubscriberManager
+	QuotaManager      *quota.Manager
+	AuditLogger       *audit.Logger
 	httpService       *httpd.Service
 
 	arrowFlightService *arrowflight.Service
 	RecordWriter       *coordinator.RecordWriter
 
+	otlpService *otlp.Service
+
 	// joinPeers are the metaservers specified at run time to join this server to
 	metaJoinPeers []string
 
@@ -89,6 +98,8 @@ type Server struct {
 
 	cqService *continuousquery.Service
 
+	ruleService *rule.Service
+
 	ctx          context.Context
 	ctxCancel    context.CancelFunc
 	serfInstance *serf.Serf
@@ -125,6 +136,9 @@ func NewServer(conf config.Config, info app.ServerInfo, logger *Logger.Logger) (
 	s.ctx, s.ctxCancel = context.WithCancel(context.Background())
 	s.httpService.Handler.Version = info.Version
 	s.httpService.Handler.BuildType = "OSS"
+	s.httpService.Handler.Commit = info.Commit
+	s.httpService.Handler.Branch = info.Branch
+	s.httpService.Handler.BuildTime = info.BuildTime
 	s.initMetaClientFn = s.initializeMetaClient
 	s.MetaClient.SetHashAlgo(c.Common.OptHashAlgo)
 
@@ -139,6 +153,10 @@ func NewServer(conf config.Config, info app.ServerInfo, logger *Logger.Logger) (
 
 	s.PointsWriter = coordinator.NewPointsWriter(time.Duration(c.Coordinator.ShardWriterTimeout))
 	s.PointsWriter.TSDBStore = s.TSDBStore
+	s.QuotaManager = quota.NewManager(s.MetaClient)
+	s.httpService.Handler.QuotaManager = s.QuotaManager
+	s.AuditLogger = audit.NewLogger(c.Audit)
+	s.httpService.Handler.AuditLogger = s.AuditLogger
 	go s.PointsWriter.ApplyTimeRangeLimit(c.Coordinator.TimeRangeLimit)
 	coordinator.SetTagLimit(c.Coordinator.TagLimit)
 
@@ -151,6 +169,7 @@ func NewServer(conf config.Config, info app.ServerInfo, logger *Logger.Logger) (
 	syscontrol.SysCtrl.NetStore = store
 	// set query schema limit
 	syscontrol.SetQuerySchemaLimit(c.SelectSpec.QuerySchemaLimit)
+	syscontrol.SetHashJoinRowsLimit(c.SelectSpec.HashJoinRowsLimit)
 	syscontrol.SetParallelQueryInBatch(c.HTTP.ParallelQueryInBatch)
 
 	s.initQueryExecutor(c)
@@ -171,6 +190,22 @@ func NewServer(conf config.Config, info app.ServerInfo, logger *Logger.Logger) (
 		}
 	}
 
+	if c.HTTP.OtlpGrpcEnabled {
+		if s.otlpService, err = otlp.NewService(c.HTTP); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Rule.Enabled {
+		hostname := config.CombineDomain(c.HTTP.Domain, c.HTTP.BindAddress)
+		if s.ruleService, err = rule.NewService(hostname, c.Rule); err != nil {
+			return nil, err
+		}
+		s.ruleService.WithLogger(s.Logger)
+		s.ruleService.QueryExecutor = s.QueryExecutor
+		s.httpService.Handler.RuleManager = s.ruleService
+	}
+
 	s.castorService = castor.NewService(c.Analysis)
 	s.sherlockService = sherlock.NewService(c.Sherlock)
 	s.sherlockService.WithLogger(s.Logger)
@@ -241,6 +276,8 @@ func (s *Server) initQueryExecutor(c *config.TSSql) {
 		StmtExecLogger:          Logger.NewLogger(errno.ModuleQueryEngine).With(zap.String("query", "StatementExecutor")),
 		Hostname:                config.CombineDomain(s.config.HTTP.Domain, s.config.HTTP.BindAddress),
 		SqlConfigs:              c.ShowConfigs(),
+		QuotaManager:            s.QuotaManager,
+		AuditLogger:             s.AuditLogger,
 	}
 	s.QueryExecutor.TaskManager.QueryTimeout = time.Duration(c.Coordinator.QueryTimeout)
 	s.QueryExecutor.TaskManager.LogQueriesAfter = time.Duration(c.Coordinator.LogQueriesAfter)
@@ -317,6 +354,20 @@ func (s *Server) Open() error {
 
 	s.httpService.Handler.QueryExecutor.PointsWriter = s.PointsWriter
 	s.httpService.Handler.PointsWriter = s.PointsWriter
+
+	if s.AuditLogger != nil {
+		s.AuditLogger.PointsWriter = s.PointsWriter
+		s.AuditLogger.Open()
+	}
+
+	// try to open rule service
+	if s.ruleService != nil {
+		s.ruleService.MetaClient = s.MetaClient
+		s.ruleService.PointsWriter = s.PointsWriter
+		if err := s.ruleService.Open(); err != nil {
+			return err
+		}
+	}
 	if s.SubscriberManager != nil {
 		s.httpService.Handler.SubscriberManager = s.SubscriberManager
 		s.SubscriberManager.InitWriters()
@@ -341,11 +392,23 @@ func (s *Server) Open() error {
 
 		s.arrowFlightService.MetaClient = s.MetaClient
 		s.arrowFlightService.RecordWriter = s.RecordWriter
+		s.arrowFlightService.QueryExecutor = s.QueryExecutor
+		s.arrowFlightService.QueryAuthorizer = auth.NewQueryAuthorizer(s.MetaClient)
+		s.arrowFlightService.QuotaManager = s.QuotaManager
 		if err := s.arrowFlightService.Open(); err != nil {
 			return err
 		}
 	}
 
+	if s.otlpService != nil {
+		s.otlpService.MetaClient = s.MetaClient
+		s.otlpService.PointsWriter = s.PointsWriter
+		s.otlpService.QuotaManager = s.QuotaManager
+		if err := s.otlpService.Open(); err != nil {
+			return err
+		}
+	}
+
 	if s.config.HTTP.CPUThreshold > 0 {
 		go s.handleCPUThreshold(s.config.HTTP.CPUThreshold, 5*time.Minute)
 	}
@@ -397,6 +460,10 @@ func (s *Server) Close() error {
 		util.MustClose(s.arrowFlightService)
 	}
 
+	if s.otlpService != nil {
+		util.MustClose(s.otlpService)
+	}
+
 	if s.RecordWriter != nil {
 		util.MustClose(s.RecordWriter)
 	}
@@ -405,6 +472,11 @@ func (s *Server) Close() error {
 		util.MustClose(s.QueryExecutor)
 	}
 
+	// the pending audit events are written before the points writer is closed
+	if s.AuditLogger != nil {
+		util.MustClose(s.AuditLogger)
+	}
+
 	if s.MetaClient != nil {
 		util.MustClose(s.MetaClient)
 	}
@@ -425,6 +497,10 @@ func (s *Server) Close() error {
 		util.MustClose(s.cqService)
 	}
 
+	if s.ruleService != nil {
+		util.MustClose(s.ruleService)
+	}
+
 	return nil
 }
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
@@ -34,6 +35,7 @@ import (
 	"github.com/openGemini/openGemini/engine/mutable"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/cpu"
+	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/httpserver"
 	"github.com/openGemini/openGemini/lib/iodetector"
 	Logger "github.com/openGemini/openGemini/lib/logger"
@@ -43,6 +45,7 @@ import (
 	"github.com/openGemini/openGemini/lib/statisticsPusher"
 	stat "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/syscontrol"
+	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/fs"
 	"github.com/openGemini/openGemini/services"
@@ -57,6 +60,7 @@ type Server struct {
 	err chan error
 
 	storageDataPath  string
+	storageLock      *os.File
 	metaPath         string
 	ingestAddr       string
 	selectAddr       string
@@ -156,6 +160,16 @@ func (s *Server) Open() error {
 	// Mark start-up in log.
 	app.LogStarting("TSStore", &s.info)
 
+	// the lock keeps the offline tools, such as the backup, away from the data files while ts-store is running
+	if err := os.MkdirAll(s.storageDataPath, 0750); err != nil {
+		return err
+	}
+	lock, err := fileops.TryLockFile(filepath.Join(s.storageDataPath, config.StoreLockFile))
+	if err != nil {
+		return fmt.Errorf("lock the store data dir %s: %s", s.storageDataPath, err)
+	}
+	s.storageLock = lock
+
 	s.transServer = transport.NewServer(s.ingestAddr, s.selectAddr)
 	if err := s.transServer.Open(); err != nil {
 		return err
@@ -275,6 +289,9 @@ func (s *Server) Close() error {
 	}
 
 	mutable.NewMemTablePoolManager().Close()
+	if s.storageLock != nil {
+		util.MustClose(s.storageLock)
+	}
 	log.Info("the storage has been stopped")
 	return nil
 }
@@ -70,8 +70,11 @@ type StoreEngine interface {
 	GetShardSplitPoints(string, uint32, uint64, []int64) ([]string, error)
 	SeriesCardinality(string, []uint32, []string, influxql.Expr, influxql.TimeRange) ([]meta.MeasurementCardinalityInfo, error)
 	SeriesExactCardinality(string, []uint32, []string, influxql.Expr, influxql.TimeRange) (map[string]uint64, error)
+	MatchMeasurements(string, []uint32, []string, influxql.Expr, influxql.TimeRange) ([]string, error)
 	TagKeys(string, []uint32, []string, influxql.Expr, influxql.TimeRange) ([]string, error)
 	SeriesKeys(string, []uint32, []string, influxql.Expr, influxql.TimeRange) ([]string, error)
+	DeleteSeries(string, string, []uint32, []string, influxql.Expr, influxql.TimeRange) error
+	DropSeries(string, string, []uint32, []string, influxql.Expr) error
 	TagValues(string, []uint32, map[string][][]byte, influxql.Expr, influxql.TimeRange) (netstorage.TablesTagSets, error)
 	TagValuesCardinality(string, []uint32, map[string][][]byte, influxql.Expr, influxql.TimeRange) (map[string]uint64, error)
 	SendSysCtrlOnNode(*netstorage.SysCtrlRequest) (map[string]string, error)
@@ -466,6 +469,14 @@ func (s *Storage) ExecuteDelete(req *netstorage.DeleteRequest) error {
 	case netstorage.MeasurementDelete:
 		// imply delete measurement
 		return s.engine.DropMeasurement(req.Database, req.Rp, req.Measurement, req.ShardIds)
+	case netstorage.ShardDelete:
+		for _, ptID := range req.PtIds {
+			for _, shardID := range req.ShardIds {
+				if err := s.engine.DropShard(req.Database, ptID, shardID); err != nil {
+					return err
+				}
+			}
+		}
 	}
 	return nil
 }
@@ -526,6 +537,18 @@ func (s *Storage) SeriesKeys(db string, ptIDs []uint32, measurements []string, c
 	return s.engine.SeriesKeys(db, ptIDs, ms, condition, tr)
 }
 
+func (s *Storage) DeleteSeries(db, rp string, ptIDs []uint32, measurements []string, condition influxql.Expr, tr influxql.TimeRange) error {
+	ms := stringSlice2BytesSlice(measurements)
+
+	return s.engine.DeleteSeries(db, rp, ptIDs, ms, condition, tr)
+}
+
+func (s *Storage) DropSeries(db, rp string, ptIDs []uint32, measurements []string, condition influxql.Expr) error {
+	ms := stringSlice2BytesSlice(measurements)
+
+	return s.engine.DropSeries(db, rp, ptIDs, ms, condition)
+}
+
 func (s *Storage) SeriesCardinality(db string, ptIDs []uint32, measurements []string, condition influxql.Expr, tr influxql.TimeRange) ([]meta.MeasurementCardinalityInfo, error) {
 	ms := stringSlice2BytesSlice(measurements)
 	return s.engine.SeriesCardinality(db, ptIDs, ms, condition, tr)
@@ -541,6 +564,12 @@ func (s *Storage) SeriesExactCardinality(db string, ptIDs []uint32, measurements
 	return s.engine.SeriesExactCardinality(db, ptIDs, ms, condition, tr)
 }
 
+func (s *Storage) MatchMeasurements(db string, ptIDs []uint32, measurements []string, condition influxql.Expr, tr influxql.TimeRange) ([]string, error) {
+	ms := stringSlice2BytesSlice(measurements)
+
+	return s.engine.MatchMeasurements(db, ptIDs, ms, condition, tr)
+}
+
 func (s *Storage) GetEngine() netstorage.Engine {
 	return s.engine
 }
@@ -390,9 +390,12 @@ func (s *TagTask) calculateRec(cache *CacheRecord) error {
 				if val == nil {
 					continue
 				}
-				if rec.Schema.Field(callIds[c]).Type == influx.Field_Type_UInt || rec.Schema.Field(callIds[c]).Type == influx.Field_Type_Int {
+				if rec.Schema.Field(callIds[c]).Type == influx.Field_Type_Int {
 					v, _ := val.IntegerValue(i)
 					curVal = float64(v)
+				} else if rec.Schema.Field(callIds[c]).Type == influx.Field_Type_UInt {
+					v, _ := val.UnsignedValue(i)
+					curVal = float64(v)
 				} else if rec.Schema.Field(callIds[c]).Type == influx.Field_Type_Float {
 					curVal, _ = val.FloatValue(i)
 				} else {
@@ -471,7 +474,7 @@ func (s *TagTask) calculateRow(cache *TaskCache) error {
 			} else {
 				for f := range row.Fields {
 					if row.Fields[f].Key == s.fieldCalls[c].Name || row.Fields[f].Key == s.fieldCalls[c].Alias {
-						curVal = row.Fields[f].NumValue
+						curVal = row.Fields[f].FloatValue()
 						break
 					}
 				}
@@ -280,11 +280,17 @@ func (s *TimeTask) calculateRec(cache *CacheRecord) {
 			} else {
 				s.calculateFloatValues(windowIDS, c, call, cache, colVal)
 			}
-		} else if rec.Schema.Field(id).Type == influx.Field_Type_UInt || rec.Schema.Field(id).Type == influx.Field_Type_Int {
+		} else if rec.Schema.Field(id).Type == influx.Field_Type_Int {
 			if call.Call == "count" {
 				s.calculateCountValues(windowIDS, c, call, cache, colVal)
 			} else {
-				s.calculateIntValues(windowIDS, c, call, cache, colVal)
+				calculateIntValues(s, colVal.IntegerValues(), windowIDS, c, call, cache, colVal)
+			}
+		} else if rec.Schema.Field(id).Type == influx.Field_Type_UInt {
+			if call.Call == "count" {
+				s.calculateCountValues(windowIDS, c, call, cache, colVal)
+			} else {
+				calculateIntValues(s, colVal.UnsignedValues(), windowIDS, c, call, cache, colVal)
 			}
 		}
 	}
@@ -341,10 +347,10 @@ func (s *TimeTask) calculateFloatValues(windowIDS []int8, c int, call *streamLib
 	}
 }
 
-func (s *TimeTask) calculateIntValues(windowIDS []int8, c int, call *streamLib.FieldCall, cache *CacheRecord, colVal *record.ColVal) {
+// calculateIntValues calculates the values of the integer and the unsigned columns, the values are aggregated as float64
+func calculateIntValues[T int64 | uint64](s *TimeTask, values []T, windowIDS []int8, c int, call *streamLib.FieldCall, cache *CacheRecord, colVal *record.ColVal) {
 	var lastWindowID int8 = -1
 	var lastIndex int = -1
-	values := colVal.IntegerValues()
 	if colVal.NilCount == 0 {
 		for i := 0; i < colVal.Length(); i++ {
 			if lastWindowID == windowIDS[i] {
@@ -456,7 +462,7 @@ func (s *TimeTask) calculateRow(cache *TaskCache) {
 			if call.Call == "count" && !row.StreamOnly {
 				curVal = 1
 			} else {
-				curVal = row.Fields[f].NumValue
+				curVal = row.Fields[f].FloatValue()
 			}
 			id := base + c
 			s.values[id] = call.SingleThreadFunc(s.values[id], curVal)
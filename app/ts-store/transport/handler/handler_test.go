@@ -90,6 +90,10 @@ func TestBaseHandler_SetMessage(t *testing.T) {
 			typ: netstorage.ShowTagKeysRequestMessage,
 			msg: &netstorage.ShowTagKeysRequest{},
 		},
+		{
+			typ: netstorage.ShowMeasurementsRequestMessage,
+			msg: &netstorage.ShowMeasurementsRequest{},
+		},
 	}
 
 	for _, item := range items {
@@ -284,3 +288,25 @@ func TestShowTagKeys_Process(t *testing.T) {
 	}
 	assert.NoError(t, response.Error())
 }
+
+func TestShowMeasurements_Process(t *testing.T) {
+	db := path.Join(dataPath, "db0")
+	condition := "tag1=tagkey"
+
+	h := newHandler(netstorage.ShowMeasurementsRequestMessage)
+	req := netstorage.ShowMeasurementsRequest{}
+	req.Db = &db
+	req.Condition = &condition
+	req.PtIDs = []uint32{1}
+	req.Measurements = []string{"mst_0000", "mst2_0000"}
+	require.NoError(t, h.SetMessage(&req))
+	st := storage.Storage{}
+	st.SetEngine(&MockEngine{})
+	h.SetStore(&st)
+	rsp, err := h.Process()
+	require.NoError(t, err)
+	response, ok := rsp.(*netstorage.ShowMeasurementsResponse)
+	require.True(t, ok)
+	assert.NoError(t, response.Error())
+	assert.Equal(t, []string{"mst"}, response.Series)
+}
@@ -53,6 +53,8 @@ func newHandler(typ uint8) RPCHandler {
 		return &ShowTagKeys{}
 	case netstorage.RaftMessagesRequestMessage:
 		return &RaftMessages{}
+	case netstorage.ShowMeasurementsRequestMessage:
+		return &ShowMeasurements{}
 	default:
 		return nil
 	}
@@ -92,6 +94,23 @@ func (h *SeriesExactCardinality) SetMessage(msg codec.BinaryCodec) error {
 	return nil
 }
 
+type ShowMeasurements struct {
+	BaseHandler
+
+	req *netstorage.ShowMeasurementsRequest
+	rsp *netstorage.ShowMeasurementsResponse
+}
+
+func (h *ShowMeasurements) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.ShowMeasurementsResponse{}
+	req, ok := msg.(*netstorage.ShowMeasurementsRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.ShowMeasurementsRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
 type SeriesCardinality struct {
 	BaseHandler
 
@@ -34,7 +34,24 @@ import (
 )
 
 func (h *Delete) Process() (codec.BinaryCodec, error) {
-	h.rsp.Err = h.store.ExecuteDelete(h.req)
+	var err *string
+	switch h.req.Type {
+	case netstorage.SeriesDelete:
+		err = processDDL(&h.req.Condition, func(expr influxql.Expr, tr influxql.TimeRange) error {
+			return h.store.DeleteSeries(h.req.Database, h.req.Rp, h.req.PtIds, h.req.Measurements, expr, tr)
+		})
+	case netstorage.SeriesDrop:
+		err = processDDL(&h.req.Condition, func(expr influxql.Expr, _ influxql.TimeRange) error {
+			return h.store.DropSeries(h.req.Database, h.req.Rp, h.req.PtIds, h.req.Measurements, expr)
+		})
+	default:
+		h.rsp.Err = h.store.ExecuteDelete(h.req)
+		return h.rsp, nil
+	}
+
+	if err != nil {
+		h.rsp.Err = errors.New(*err)
+	}
 	return h.rsp, nil
 }
 
@@ -77,6 +94,15 @@ func (h *SeriesExactCardinality) Process() (codec.BinaryCodec, error) {
 	return h.rsp, nil
 }
 
+func (h *ShowMeasurements) Process() (codec.BinaryCodec, error) {
+	h.rsp.Err = processDDL(h.req.Condition, func(expr influxql.Expr, tr influxql.TimeRange) error {
+		var err error
+		h.rsp.Series, err = h.store.MatchMeasurements(*h.req.Db, h.req.PtIDs, h.req.Measurements, expr, tr)
+		return err
+	})
+	return h.rsp, nil
+}
+
 func (h *SeriesKeys) Process() (codec.BinaryCodec, error) {
 	h.rsp.Err = processDDL(h.req.Condition, func(expr influxql.Expr, tr influxql.TimeRange) error {
 		var err error
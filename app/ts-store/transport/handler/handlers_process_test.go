@@ -119,6 +119,10 @@ func (e *MockEngine) TagKeys(_ string, _ []uint32, _ [][]byte, _ influxql.Expr,
 	return []string{"mst,tag1,tag2,tag3", "mst2,tag1,tag2,tag3"}, nil
 }
 
+func (e *MockEngine) MatchMeasurements(_ string, _ []uint32, _ [][]byte, _ influxql.Expr, _ influxql.TimeRange) ([]string, error) {
+	return []string{"mst"}, nil
+}
+
 func (e *MockEngine) SeriesKeys(_ string, _ []uint32, _ [][]byte, _ influxql.Expr, _ influxql.TimeRange) ([]string, error) {
 	return nil, nil
 }
@@ -83,6 +83,18 @@ func (s *MockStoreEngine) SeriesExactCardinality(db string, ptIDs []uint32, meas
 	return nil, nil
 }
 
+func (s *MockStoreEngine) MatchMeasurements(db string, ptIDs []uint32, measurements []string, condition influxql.Expr, tr influxql.TimeRange) ([]string, error) {
+	return nil, nil
+}
+
+func (s *MockStoreEngine) DeleteSeries(db, rp string, ptIDs []uint32, measurements []string, condition influxql.Expr, tr influxql.TimeRange) error {
+	return nil
+}
+
+func (s *MockStoreEngine) DropSeries(db, rp string, ptIDs []uint32, measurements []string, condition influxql.Expr) error {
+	return nil
+}
+
 func (s *MockStoreEngine) SeriesKeys(db string, ptIDs []uint32, measurements []string, condition influxql.Expr, tr influxql.TimeRange) ([]string, error) {
 	return nil, nil
 }
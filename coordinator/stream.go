@@ -241,7 +241,7 @@ func (s *Stream) calculateWindow(rows []*influx.Row, si *meta2.StreamInfo, task
 				// the computation of string type is not supported
 				return fmt.Errorf("the %s string type is not supported for stream task %s", fv.Key, si.Name)
 			}
-			curVal := fv.NumValue
+			curVal := fv.FloatValue()
 			if task.calls[i].Call == "count" {
 				curVal = 1
 			}
@@ -663,6 +663,9 @@ func (s *AggTagSetCursor) buildMinFunc() {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerMin
 		s.functions[column][1] = record.UpdateIntegerMinFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedMin
+		s.functions[column][1] = record.UpdateUnsignedMinFast
 	case influx.Field_Type_Float:
 		s.functions[column][0] = record.UpdateFloatMin
 		s.functions[column][1] = record.UpdateFloatMinFast
@@ -690,6 +693,9 @@ func (s *AggTagSetCursor) buildMinFuncs(i int) {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerColumnMin
 		s.functions[column][1] = record.UpdateIntegerColumnMinFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedColumnMin
+		s.functions[column][1] = record.UpdateUnsignedColumnMinFast
 	case influx.Field_Type_Float:
 		s.functions[column][0] = record.UpdateFloatColumnMin
 		s.functions[column][1] = record.UpdateFloatColumnMinFast
@@ -705,6 +711,9 @@ func (s *AggTagSetCursor) buildMaxFunc() {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerMax
 		s.functions[column][1] = record.UpdateIntegerMaxFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedMax
+		s.functions[column][1] = record.UpdateUnsignedMaxFast
 	case influx.Field_Type_Float:
 		s.functions[column][0] = record.UpdateFloatMax
 		s.functions[column][1] = record.UpdateFloatMaxFast
@@ -726,6 +735,9 @@ func (s *AggTagSetCursor) buildMaxFuncs(i int) {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerColumnMax
 		s.functions[column][1] = record.UpdateIntegerColumnMaxFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedColumnMax
+		s.functions[column][1] = record.UpdateUnsignedColumnMaxFast
 	case influx.Field_Type_Float:
 		s.functions[column][0] = record.UpdateFloatColumnMax
 		s.functions[column][1] = record.UpdateFloatColumnMaxFast
@@ -738,7 +750,7 @@ func (s *AggTagSetCursor) buildMaxFuncs(i int) {
 func (s *AggTagSetCursor) buildFirstFunc() {
 	column := s.GetSchema().FieldIndex(s.aggOps[0].Ref.Val)
 	switch s.GetSchema()[column].Type {
-	case influx.Field_Type_Int:
+	case influx.Field_Type_Int, influx.Field_Type_UInt:
 		s.functions[column][0] = record.UpdateIntegerFirst
 		s.functions[column][1] = record.UpdateIntegerFirstFast
 	case influx.Field_Type_String:
@@ -757,7 +769,7 @@ func (s *AggTagSetCursor) buildFirstFunc() {
 func (s *AggTagSetCursor) buildFirstFuncs(i int) {
 	column := s.GetSchema().FieldIndex(s.aggOps[i].Ref.Val)
 	switch s.GetSchema()[column].Type {
-	case influx.Field_Type_Int:
+	case influx.Field_Type_Int, influx.Field_Type_UInt:
 		s.functions[column][0] = record.UpdateIntegerColumnFirst
 		s.functions[column][1] = record.UpdateIntegerColumnFirstFast
 	case influx.Field_Type_String:
@@ -776,7 +788,7 @@ func (s *AggTagSetCursor) buildFirstFuncs(i int) {
 func (s *AggTagSetCursor) buildLastFunc() {
 	column := s.GetSchema().FieldIndex(s.aggOps[0].Ref.Val)
 	switch s.GetSchema()[column].Type {
-	case influx.Field_Type_Int:
+	case influx.Field_Type_Int, influx.Field_Type_UInt:
 		s.functions[column][0] = record.UpdateIntegerLast
 		s.functions[column][1] = record.UpdateIntegerLastFast
 	case influx.Field_Type_String:
@@ -795,7 +807,7 @@ func (s *AggTagSetCursor) buildLastFunc() {
 func (s *AggTagSetCursor) buildLastFuncs(i int) {
 	column := s.GetSchema().FieldIndex(s.aggOps[i].Ref.Val)
 	switch s.GetSchema()[column].Type {
-	case influx.Field_Type_Int:
+	case influx.Field_Type_Int, influx.Field_Type_UInt:
 		s.functions[column][0] = record.UpdateIntegerColumnLast
 		s.functions[column][1] = record.UpdateIntegerColumnLastFast
 	case influx.Field_Type_String:
@@ -817,6 +829,9 @@ func (s *AggTagSetCursor) buildSumFunc() {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerSum
 		s.functions[column][1] = record.UpdateIntegerSumFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedSum
+		s.functions[column][1] = record.UpdateUnsignedSumFast
 	case influx.Field_Type_Float:
 		s.functions[column][0] = record.UpdateFloatSum
 		s.functions[column][1] = record.UpdateFloatSumFast
@@ -829,6 +844,9 @@ func (s *AggTagSetCursor) buildSumFuncs(i int) {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerSum
 		s.functions[column][1] = record.UpdateIntegerSumFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedSum
+		s.functions[column][1] = record.UpdateUnsignedSumFast
 	case influx.Field_Type_Float:
 		s.functions[column][0] = record.UpdateFloatSum
 		s.functions[column][1] = record.UpdateFloatSumFast
@@ -999,6 +1017,7 @@ func (s *AggTagSetCursor) TimeWindowsInit() {
 		//window function return time range which is left closed and right open, so when use max time, we must minus one.
 		s.intervalStartTime = s.baseAggCursorInfo.maxTime - 1
 	}
+	// the fixed interval is fine here, HasOptimizeAgg keeps the calendar intervals away from this cursor
 	s.intervalRecord.BuildEmptyIntervalRec(s.baseAggCursorInfo.minTime, s.baseAggCursorInfo.maxTime, int64(opt.GetInterval()), s.firstOrLast, opt.HasInterval(), opt.IsAscending(), s.firstOrLastRecIdxs)
 }
 
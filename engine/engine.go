@@ -1121,6 +1121,62 @@ func (e *Engine) SeriesExactCardinality(db string, ptIDs []uint32, measurements
 	return result, nil
 }
 
+// MatchMeasurements returns the names of the measurements which have any series matching the condition,
+// a measurement is not searched again in the other indexes once a matching series is found
+func (e *Engine) MatchMeasurements(db string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) ([]string, error) {
+	e.mu.RLock()
+	var err error
+	if ptIDs, err = e.checkAndAddRefPTSNoLock(db, ptIDs); err != nil {
+		e.mu.RUnlock()
+		return nil, err
+	}
+	defer e.unrefDBPTs(db, ptIDs)
+	pts, ok := e.DBPartitions[db]
+	e.mu.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+
+	matched := make([]bool, len(measurements))
+	var names []string
+	for _, ptID := range ptIDs {
+		pt, ok := pts[ptID]
+		if !ok {
+			continue
+		}
+		pt.mu.RLock()
+		for _, iBuild := range pt.indexBuilder {
+			if len(names) == len(measurements) {
+				break
+			}
+			if !iBuild.Overlaps(tr) {
+				continue
+			}
+			idx, ok := iBuild.GetPrimaryIndex().(*tsi.MergeSetIndex)
+			if !ok {
+				pt.mu.RUnlock()
+				return nil, errors.New("idx nil,some thing wrong with GetPrimaryIndex")
+			}
+			for i, nameWithVer := range measurements {
+				if matched[i] {
+					continue
+				}
+				has, err := idx.HasSeries(nameWithVer, condition)
+				if err != nil {
+					pt.mu.RUnlock()
+					return nil, err
+				}
+				if has {
+					matched[i] = true
+					names = append(names, influx.GetOriginMstName(util.Bytes2str(nameWithVer)))
+				}
+			}
+		}
+		pt.mu.RUnlock()
+	}
+	return names, nil
+}
+
 func (e *Engine) searchIndex(db string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange, fn func(key []byte, keysMap map[string]map[string]struct{}, mstName string)) (map[string]map[string]struct{}, error) {
 	e.mu.RLock()
 	var err error
@@ -18,6 +18,7 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"io"
 	"path"
 	"path/filepath"
@@ -30,6 +31,7 @@ import (
 	"github.com/openGemini/openGemini/engine/immutable"
 	"github.com/openGemini/openGemini/engine/index/tsi"
 	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/netstorage"
 	stat "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
@@ -231,8 +233,210 @@ func (e *Engine) DropMeasurement(db string, rp string, name string, shardIds []u
 	return nil
 }
 
-func (e *Engine) DropSeries(database string, sources []influxql.Source, ptId []uint32, condition influxql.Expr) (int, error) {
-	panic("implement me")
+// DropShard closes the shard and removes its data and wal on disk, the index of the shard
+// is removed too if it is not shared with any other shard of the pt
+func (e *Engine) DropShard(db string, ptId uint32, shardID uint64) error {
+	e.mu.RLock()
+	pt, ok := e.DBPartitions[db][ptId]
+	e.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	pt.mu.RLock()
+	sh, ok := pt.shards[shardID]
+	pt.mu.RUnlock()
+	if !ok {
+		// the shard has been dropped already, DROP SHARD can be re-run
+		return nil
+	}
+
+	var indexID uint64
+	iBuilder := sh.GetIndexBuilder()
+	if iBuilder != nil {
+		indexID = iBuilder.GetIndexID()
+	}
+
+	if err := e.DeleteShard(db, ptId, shardID); err != nil {
+		return err
+	}
+	if iBuilder == nil {
+		return nil
+	}
+
+	pt.mu.RLock()
+	for _, other := range pt.shards {
+		if ib := other.GetIndexBuilder(); ib != nil && ib.GetIndexID() == indexID {
+			pt.mu.RUnlock()
+			return nil
+		}
+	}
+	pt.mu.RUnlock()
+
+	err := e.DeleteIndex(db, ptId, indexID)
+	if errno.Equal(err, errno.IndexNotFound) {
+		return nil
+	}
+	return err
+}
+
+// DropSeries drops the series matching the condition from the retention policy, the rows of the series are
+// marked as deleted in the tsstore shards before the series are deleted from the index, measurements are
+// the names with version
+func (e *Engine) DropSeries(db, rp string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr) error {
+	e.log.Info("start drop series...", zap.String("db", db), zap.String("rp", rp), zap.Uint32s("pts", ptIDs),
+		zap.ByteStrings("names", measurements), zap.Any("condition", condition))
+	start := time.Now()
+
+	e.mu.RLock()
+	var err error
+	if ptIDs, err = e.checkAndAddRefPTSNoLock(db, ptIDs); err != nil {
+		e.mu.RUnlock()
+		return err
+	}
+	defer e.unrefDBPTs(db, ptIDs)
+	pts, ok := e.DBPartitions[db]
+	e.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	for _, ptID := range ptIDs {
+		pt, ok := pts[ptID]
+		if !ok {
+			continue
+		}
+
+		pt.mu.RLock()
+		err = e.dropSeriesOnPT(pt, rp, measurements, condition)
+		pt.mu.RUnlock()
+		if err != nil {
+			e.log.Error("drop series fail", zap.String("db", db), zap.Uint32("pt", ptID), zap.Error(err))
+			return err
+		}
+	}
+
+	e.log.Info("drop series done", zap.String("db", db), zap.Duration("time used", time.Since(start)))
+	return nil
+}
+
+func (e *Engine) dropSeriesOnPT(pt *DBPTInfo, rp string, measurements [][]byte, condition influxql.Expr) error {
+	timeRange := util.TimeRange{Min: influxql.MinTime, Max: influxql.MaxTime}
+	for indexID, iBuild := range pt.indexBuilder {
+		if iBuild.RPName() != rp {
+			continue
+		}
+		idx, ok := iBuild.GetPrimaryIndex().(*tsi.MergeSetIndex)
+		if !ok {
+			return errors.New("idx nil,some thing wrong with GetPrimaryIndex")
+		}
+
+		for _, nameWithVer := range measurements {
+			sids, err := idx.SearchSeriesIDs(nameWithVer, condition)
+			if err != nil {
+				return err
+			}
+			if len(sids) == 0 {
+				continue
+			}
+
+			for id, sh := range pt.shards {
+				if sh.GetEngineType() != config.TSSTORE || sh.GetRPName() != rp || sh.GetIndexBuilder() != iBuild {
+					continue
+				}
+				if err = sh.DeleteSeries(string(nameWithVer), sids, timeRange); err != nil {
+					e.log.Error("delete series from shard fail", zap.Uint64("shard", id),
+						zap.ByteString("name", nameWithVer), zap.Error(err))
+					return err
+				}
+			}
+
+			if err = idx.DropSeries(sids); err != nil {
+				return err
+			}
+			e.log.Info("drop series from index", zap.Uint64("index", indexID),
+				zap.ByteString("name", nameWithVer), zap.Int("series", len(sids)))
+		}
+	}
+	return nil
+}
+
+// DeleteSeries deletes the rows of the series matching the condition within the time range
+// from the shards of the retention policy, measurements are the names with version
+func (e *Engine) DeleteSeries(db, rp string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) error {
+	e.log.Info("start delete series...", zap.String("db", db), zap.String("rp", rp), zap.Uint32s("pts", ptIDs),
+		zap.ByteStrings("names", measurements), zap.Any("condition", condition))
+	start := time.Now()
+
+	e.mu.RLock()
+	var err error
+	if ptIDs, err = e.checkAndAddRefPTSNoLock(db, ptIDs); err != nil {
+		e.mu.RUnlock()
+		return err
+	}
+	defer e.unrefDBPTs(db, ptIDs)
+	pts, ok := e.DBPartitions[db]
+	e.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	timeRange := util.TimeRange{Min: tr.MinTimeNano(), Max: tr.MaxTimeNano()}
+	for _, ptID := range ptIDs {
+		pt, ok := pts[ptID]
+		if !ok {
+			continue
+		}
+
+		pt.mu.RLock()
+		err = e.deleteSeriesOnPT(pt, rp, measurements, condition, &tr, timeRange)
+		pt.mu.RUnlock()
+		if err != nil {
+			e.log.Error("delete series fail", zap.String("db", db), zap.Uint32("pt", ptID), zap.Error(err))
+			return err
+		}
+	}
+
+	e.log.Info("delete series done", zap.String("db", db), zap.Duration("time used", time.Since(start)))
+	return nil
+}
+
+func (e *Engine) deleteSeriesOnPT(pt *DBPTInfo, rp string, measurements [][]byte, condition influxql.Expr, tr *influxql.TimeRange, timeRange util.TimeRange) error {
+	// the series ids of the same index are searched only once
+	seriesIDs := make(map[*tsi.IndexBuilder]map[string][]uint64)
+	for id, sh := range pt.shards {
+		if sh.GetEngineType() != config.TSSTORE || sh.GetRPName() != rp || !sh.Intersect(tr) {
+			continue
+		}
+
+		iBuild := sh.GetIndexBuilder()
+		if iBuild == nil {
+			continue
+		}
+		idx, ok := iBuild.GetPrimaryIndex().(*tsi.MergeSetIndex)
+		if !ok {
+			return errors.New("idx nil,some thing wrong with GetPrimaryIndex")
+		}
+
+		if _, ok = seriesIDs[iBuild]; !ok {
+			seriesIDs[iBuild] = make(map[string][]uint64, len(measurements))
+			for _, nameWithVer := range measurements {
+				sids, err := idx.SearchSeriesIDs(nameWithVer, condition)
+				if err != nil {
+					return err
+				}
+				seriesIDs[iBuild][string(nameWithVer)] = sids
+			}
+		}
+
+		for name, sids := range seriesIDs[iBuild] {
+			if err := sh.DeleteSeries(name, sids, timeRange); err != nil {
+				e.log.Error("delete series from shard fail", zap.Uint64("shard", id), zap.String("name", name), zap.Error(err))
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 func (e *Engine) TagKeys(db string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) ([]string, error) {
@@ -19,6 +19,7 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"os"
 	"path"
 	"path/filepath"
 	"reflect"
@@ -877,6 +878,228 @@ func TestEngine_SeriesCardinality(t *testing.T) {
 	assert(err == nil, "no error expected")
 }
 
+func TestEngine_DropSeries(t *testing.T) {
+	dir := t.TempDir()
+	eng, err := initEngine1(dir, config.TSSTORE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer eng.Close()
+
+	msNames := []string{"cpu"}
+	tm := time.Now().Truncate(time.Second)
+	rows, _, _ := GenDataRecord(msNames, 10, 200, time.Second, tm, false, true, false)
+
+	if err := eng.WriteRows("db0", "rp0", 0, 1, rows, nil); err != nil {
+		t.Fatal(err)
+	}
+	dbInfo := eng.DBPartitions["db0"][0]
+	idx := dbInfo.indexBuilder[659].GetPrimaryIndex().(*tsi.MergeSetIndex)
+	idx.DebugFlush()
+
+	// ignore pt not found
+	err = eng.DropSeries("db0", "rp0", []uint32{0xff}, [][]byte{[]byte(msNames[0])}, nil)
+	require.Equal(t, true, errno.Equal(err, errno.PtNotFound))
+
+	condition := influxql.MustParseExpr(`tagkey1='tagvalue1_1' OR tagkey1='tagvalue1_2'`)
+	influxql.WalkFunc(condition, func(node influxql.Node) {
+		if ref, ok := node.(*influxql.VarRef); ok {
+			ref.Type = influxql.Tag
+		}
+	})
+	require.NoError(t, eng.DropSeries("db0", "rp0", []uint32{0}, [][]byte{[]byte(msNames[0])}, condition))
+	idx.DebugFlush()
+
+	tr := influxql.TimeRange{Min: time.Unix(0, influxql.MinTime).UTC(), Max: time.Unix(0, influxql.MaxTime).UTC()}
+	mcis, err := eng.SeriesCardinality("db0", []uint32{0}, [][]byte{[]byte(msNames[0])}, nil, tr)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(mcis))
+	require.Equal(t, uint64(8), mcis[0].CardinalityInfos[0].Cardinality)
+
+	// the data of the dropped series is marked deleted
+	files, ok := dbInfo.shards[1].GetTableStore().GetTSSPFiles(msNames[0], true)
+	require.True(t, ok)
+	defer immutable.UnrefFilesReader(files.Files()...)
+	defer immutable.UnrefFiles(files.Files()...)
+	require.NotEqual(t, 0, len(files.Files()))
+	for _, f := range files.Files() {
+		require.Equal(t, 2, f.Tombstones().Len())
+	}
+
+	require.NoError(t, eng.DropSeries("db0", "rp0", []uint32{0}, [][]byte{[]byte(msNames[0])}, nil))
+	idx.DebugFlush()
+	mcis, err = eng.SeriesCardinality("db0", []uint32{0}, [][]byte{[]byte(msNames[0])}, nil, tr)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(mcis))
+}
+
+func TestEngine_DropSeries_RetentionPolicy(t *testing.T) {
+	dir := t.TempDir()
+	eng, err := initEngine1(dir, config.TSSTORE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer eng.Close()
+
+	// the same measurement in rp1, with its own index and shard
+	dbInfo := eng.DBPartitions["db0"][0]
+	indexPath := path.Join(dbInfo.path, "rp1", config.IndexFileDirectory,
+		"660_946252800000000000_946857600000000000", "mergeset")
+	require.NoError(t, fileops.MkdirAll(indexPath, 0755))
+	require.NoError(t, dbInfo.OpenIndexes(0, "rp1", config.TSSTORE))
+	lockPath := filepath.Join(eng.dataPath, "LOCK")
+	shardIdent := &meta.ShardIdentifier{ShardID: 2, ShardGroupID: 2, Policy: "rp1", OwnerDb: "db0", OwnerPt: 0}
+	shardDuration := &meta.DurationDescriptor{Tier: util.Hot, TierDuration: time.Hour}
+	tr := &meta.TimeRangeInfo{StartTime: mustParseTime(time.RFC3339Nano, "1999-01-01T01:00:00Z"),
+		EndTime: mustParseTime(time.RFC3339Nano, "2000-01-01T01:00:00Z")}
+	sh := NewShard(eng.dataPath, eng.walPath, &lockPath, shardIdent, shardDuration, tr, DefaultEngineOption, config.TSSTORE, nil)
+	sh.indexBuilder = dbInfo.indexBuilder[660]
+	require.NoError(t, sh.OpenAndEnable(nil))
+	dbInfo.shards[sh.ident.ShardID] = sh
+
+	msNames := []string{"cpu"}
+	rows, _, _ := GenDataRecord(msNames, 10, 200, time.Second, time.Now().Truncate(time.Second), false, true, false)
+	require.NoError(t, eng.WriteRows("db0", "rp0", 0, 1, rows, nil))
+	require.NoError(t, eng.WriteRows("db0", "rp1", 0, 2, rows, nil))
+	for _, id := range []uint64{659, 660} {
+		dbInfo.indexBuilder[id].GetPrimaryIndex().(*tsi.MergeSetIndex).DebugFlush()
+	}
+
+	condition := influxql.MustParseExpr(`tagkey1='tagvalue1_1'`)
+	influxql.WalkFunc(condition, func(node influxql.Node) {
+		if ref, ok := node.(*influxql.VarRef); ok {
+			ref.Type = influxql.Tag
+		}
+	})
+	require.NoError(t, eng.DropSeries("db0", "rp1", []uint32{0}, [][]byte{[]byte(msNames[0])}, condition))
+
+	seriesN := func(indexID uint64) int {
+		idx := dbInfo.indexBuilder[indexID].GetPrimaryIndex().(*tsi.MergeSetIndex)
+		idx.DebugFlush()
+		sids, err := idx.SearchSeriesIDs([]byte(msNames[0]), nil)
+		require.NoError(t, err)
+		return len(sids)
+	}
+	tombstones := func(shardID uint64) int {
+		files, ok := dbInfo.shards[shardID].GetTableStore().GetTSSPFiles(msNames[0], true)
+		if !ok {
+			return 0
+		}
+		defer immutable.UnrefFilesReader(files.Files()...)
+		defer immutable.UnrefFiles(files.Files()...)
+		n := 0
+		for _, f := range files.Files() {
+			n += f.Tombstones().Len()
+		}
+		return n
+	}
+
+	// only the series of rp1 are dropped
+	require.Equal(t, 10, seriesN(659))
+	require.Equal(t, 9, seriesN(660))
+	require.Equal(t, 0, tombstones(1))
+	require.NotEqual(t, 0, tombstones(2))
+}
+
+func TestEngine_DeleteSeries(t *testing.T) {
+	dir := t.TempDir()
+	eng, err := initEngine1(dir, config.TSSTORE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer eng.Close()
+
+	msNames := []string{"cpu"}
+	tm := time.Now().Truncate(time.Second)
+	rows, _, _ := GenDataRecord(msNames, 10, 200, time.Second, tm, false, true, false)
+
+	if err := eng.WriteRows("db0", "rp0", 0, 1, rows, nil); err != nil {
+		t.Fatal(err)
+	}
+	dbInfo := eng.DBPartitions["db0"][0]
+	idx := dbInfo.indexBuilder[659].GetPrimaryIndex().(*tsi.MergeSetIndex)
+	idx.DebugFlush()
+	dbInfo.shards[1].ForceFlush()
+
+	tombstones := func() int {
+		files, ok := dbInfo.shards[1].GetTableStore().GetTSSPFiles(msNames[0], true)
+		require.True(t, ok)
+		defer immutable.UnrefFilesReader(files.Files()...)
+		defer immutable.UnrefFiles(files.Files()...)
+		require.NotEqual(t, 0, len(files.Files()))
+
+		n := 0
+		for _, f := range files.Files() {
+			n += f.Tombstones().Len()
+		}
+		return n
+	}
+
+	condition := influxql.MustParseExpr(`tagkey1='tagvalue1_1'`)
+	influxql.WalkFunc(condition, func(node influxql.Node) {
+		if ref, ok := node.(*influxql.VarRef); ok {
+			ref.Type = influxql.Tag
+		}
+	})
+	tr := influxql.TimeRange{Min: time.Unix(0, influxql.MinTime).UTC(), Max: time.Unix(0, influxql.MaxTime).UTC()}
+
+	// the shards of other retention policies are not touched
+	require.NoError(t, eng.DeleteSeries("db0", "rp1", []uint32{0}, [][]byte{[]byte(msNames[0])}, condition, tr))
+	require.Equal(t, 0, tombstones())
+
+	require.NoError(t, eng.DeleteSeries("db0", "rp0", []uint32{0}, [][]byte{[]byte(msNames[0])}, condition, tr))
+	require.NotEqual(t, 0, tombstones())
+}
+
+func TestEngine_MatchMeasurements(t *testing.T) {
+	dir := t.TempDir()
+	eng, err := initEngine1(dir, config.TSSTORE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer eng.Close()
+
+	msNames := []string{"cpu", "disk"}
+	tm := time.Now().Truncate(time.Second)
+	rows, _, _ := GenDataRecord(msNames, 10, 200, time.Second, tm, false, true, false)
+
+	if err := eng.WriteRows("db0", "rp0", 0, 1, rows, nil); err != nil {
+		t.Fatal(err)
+	}
+	dbInfo := eng.DBPartitions["db0"][0]
+	idx := dbInfo.indexBuilder[659].GetPrimaryIndex().(*tsi.MergeSetIndex)
+	idx.DebugFlush()
+
+	measurements := [][]byte{[]byte("cpu"), []byte("disk"), []byte("not_exist_measurement")}
+
+	// ignore pt not found
+	names, err := eng.MatchMeasurements("db0", []uint32{0xff}, measurements, nil, globalTime)
+	require.Empty(t, names)
+	require.Equal(t, true, errno.Equal(err, errno.PtNotFound))
+
+	tagCondition := func(s string) influxql.Expr {
+		condition := influxql.MustParseExpr(s)
+		influxql.WalkFunc(condition, func(node influxql.Node) {
+			if ref, ok := node.(*influxql.VarRef); ok {
+				ref.Type = influxql.Tag
+			}
+		})
+		return condition
+	}
+
+	names, err = eng.MatchMeasurements("db0", []uint32{0}, measurements, tagCondition(`tagkey1='tagvalue1_1'`), globalTime)
+	require.NoError(t, err)
+	require.Equal(t, []string{"cpu"}, names)
+
+	names, err = eng.MatchMeasurements("db0", []uint32{0}, measurements, tagCondition(`tagkey1='tagvalue1_1' OR tagkey1='tagvalue1_2'`), globalTime)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"cpu", "disk"}, names)
+
+	names, err = eng.MatchMeasurements("db0", []uint32{0}, measurements, tagCondition(`tagkey1='not_exist_value'`), globalTime)
+	require.NoError(t, err)
+	require.Empty(t, names)
+}
+
 func TestEngine_TagValues(t *testing.T) {
 	dir := t.TempDir()
 	eng, err := initEngine1(dir, config.TSSTORE)
@@ -1310,6 +1533,40 @@ func TestEngine_SeriesLimited(t *testing.T) {
 	require.EqualError(t, err, errno.NewError(errno.SeriesLimited, defaultDb, 10, 20).Error())
 }
 
+type mockDatabaseQuotaClient struct {
+	quota *meta.QuotaInfo
+}
+
+func (c *mockDatabaseQuotaClient) DatabaseQuota(name string) *meta.QuotaInfo {
+	return c.quota
+}
+
+func TestEngine_SeriesQuota(t *testing.T) {
+	testDir := t.TempDir()
+
+	sh, err := createShard(defaultDb, defaultRp, defaultPtId, testDir, config.TSSTORE)
+	require.NoError(t, err)
+
+	defer sh.Close()
+	defer sh.indexBuilder.Close()
+
+	client := &mockDatabaseQuotaClient{quota: &meta.QuotaInfo{MaxSeries: 10}}
+	sh.quotaClient = client
+	sh.initSeriesLimiter(0)
+	rows, _, _ := GenDataRecord([]string{"mst"}, 20, 1, 1, time.Now(), false, true, false)
+	err = writeData(sh, rows, true)
+	require.NoError(t, err)
+
+	rows, _, _ = GenDataRecord([]string{"mst"}, 30, 1, 1, time.Now(), false, true, false)
+	err = writeData(sh, rows, true)
+	require.EqualError(t, err, errno.NewError(errno.SeriesLimited, defaultDb, 10, 20).Error())
+
+	// dropping the quota lifts the limit
+	client.quota = nil
+	err = writeData(sh, rows, true)
+	require.NoError(t, err)
+}
+
 func TestEngine_RowCount(t *testing.T) {
 	dir := t.TempDir()
 	eng, err := initEngine1(dir, config.TSSTORE)
@@ -1756,6 +2013,46 @@ func TestEngineDeleteShard(t *testing.T) {
 	assert2.NotNil(t, err)
 }
 
+func TestEngine_DropShard(t *testing.T) {
+	dir := t.TempDir()
+	eng, err := initEngine(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer eng.Close()
+
+	// the index is shared by two shards
+	var otherShard uint64 = defaultShardId + 1
+	msInfo := &meta.MeasurementInfo{EngineType: config.TSSTORE}
+	require.NoError(t, eng.CreateShard(defaultDb, defaultRp, defaultPtId, otherShard, getTimeRangeInfo(), msInfo))
+
+	dbInfo := eng.DBPartitions[defaultDb][defaultPtId]
+	sh := dbInfo.shards[defaultShardId]
+	dataPath, walPath := sh.GetDataPath(), sh.GetWalPath()
+	indexPath := dbInfo.indexBuilder[defaultShardId].Path()
+
+	require.NoError(t, eng.DropShard(defaultDb, defaultPtId, defaultShardId))
+	_, ok := dbInfo.shards[defaultShardId]
+	require.False(t, ok)
+	for _, p := range []string{dataPath, walPath} {
+		_, err = os.Stat(p)
+		require.True(t, os.IsNotExist(err), p)
+	}
+	_, ok = dbInfo.indexBuilder[defaultShardId]
+	require.True(t, ok)
+
+	// the index is removed with the last shard using it
+	require.NoError(t, eng.DropShard(defaultDb, defaultPtId, otherShard))
+	_, ok = dbInfo.indexBuilder[defaultShardId]
+	require.False(t, ok)
+	_, err = os.Stat(indexPath)
+	require.True(t, os.IsNotExist(err))
+
+	// the shard has been dropped already
+	require.NoError(t, eng.DropShard(defaultDb, defaultPtId, defaultShardId))
+	require.NoError(t, eng.DropShard("db_not_exist", defaultPtId, defaultShardId))
+}
+
 func TestEngine_uploadFileInfos(t *testing.T) {
 	dir := t.TempDir()
 	eng, err := initEngine(dir)
@@ -66,6 +66,14 @@ func FloatCountPromMerge(prevPoint, currPoint *Point[float64]) {
 	prevPoint.value += currPoint.value
 }
 
+func GroupPromReduce(c Chunk, values []float64, ordinal, start, end int) (int, float64, bool) {
+	return start, 1, false
+}
+
+func GroupPromMerge(prevPoint, currPoint *Point[float64]) {
+	prevPoint.value = 1
+}
+
 func FloatHistogramQuantilePromReduce(p float64) FloatColReduceHistogramReduce {
 	return func(buckets []bucket) float64 {
 		if math.IsNaN(p) {
@@ -181,3 +189,82 @@ func almostEqual(a, b, epsilon float64) bool {
 	}
 	return diff/math.Min(absSum, math.MaxFloat64) < epsilon
 }
+
+// PromQuantile returns the φ-quantile of the values with linear interpolation between the closest ranks.
+func PromQuantile(q float64, values []float64) float64 {
+	if len(values) == 0 || math.IsNaN(q) {
+		return math.NaN()
+	}
+	if q < 0 {
+		return math.Inf(-1)
+	}
+	if q > 1 {
+		return math.Inf(+1)
+	}
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	n := float64(len(sorted))
+	rank := q * (n - 1)
+	lowerIndex := math.Max(0, math.Floor(rank))
+	upperIndex := math.Min(n-1, lowerIndex+1)
+	weight := rank - math.Floor(rank)
+	return sorted[int(lowerIndex)]*(1-weight) + sorted[int(upperIndex)]*weight
+}
+
+// PromStdvar returns the population variance of the values.
+func PromStdvar(values []float64) float64 {
+	var aux, count, mean float64
+	for _, v := range values {
+		count++
+		delta := v - mean
+		mean += delta / count
+		aux += delta * (v - mean)
+	}
+	return aux / count
+}
+
+// PromChanges returns the number of times the value has changed.
+func PromChanges(values []float64) float64 {
+	var changes float64
+	for i := 1; i < len(values); i++ {
+		prev, curr := values[i-1], values[i]
+		if curr != prev && !(math.IsNaN(curr) && math.IsNaN(prev)) {
+			changes++
+		}
+	}
+	return changes
+}
+
+// PromResets returns the number of counter resets, that is any decrease in value between two consecutive samples.
+func PromResets(values []float64) float64 {
+	var resets float64
+	for i := 1; i < len(values); i++ {
+		if values[i] < values[i-1] {
+			resets++
+		}
+	}
+	return resets
+}
+
+// PromHoltWinters produces a smoothed value of the values by the double exponential smoothing,
+// sf is the smoothing factor and tf is the trend factor, both of them should be in (0, 1).
+// It returns false if there are less than two values.
+func PromHoltWinters(values []float64, sf, tf float64) (float64, bool) {
+	if len(values) < 2 {
+		return 0, false
+	}
+	var s0, s1, b float64
+	s1 = values[0]
+	b = values[1] - values[0]
+	for i := 1; i < len(values); i++ {
+		x := sf * values[i]
+		if i > 1 {
+			b = tf*(s1-s0) + (1-tf)*b
+		}
+		y := (1 - sf) * (s1 + b)
+		s0, s1 = s1, x+y
+	}
+	return s1, true
+}
@@ -18,10 +18,13 @@ package executor
 
 import (
 	"bytes"
+	"math"
 	"testing"
 
 	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIntegerFirstReduce(t *testing.T) {
@@ -324,6 +327,79 @@ func TestBooleanMaxReduce(t *testing.T) {
 	}
 }
 
+func TestUnsignedMinMaxReduce(t *testing.T) {
+	chunk := NewChunkImpl(hybridqp.NewRowDataTypeImpl(
+		influxql.VarRef{Val: "val_uint", Type: influxql.Unsigned},
+		influxql.VarRef{Val: "time", Type: influxql.Integer},
+	), "test")
+
+	// math.MaxUint64, nil, 1, 1<<63
+	c1 := NewColumnImpl(influxql.Unsigned)
+	c1.AppendNilsV2(true, false, true, true)
+	c1.AppendIntegerValues([]int64{-1, 1, -1 << 63})
+
+	chunk.SetTime([]int64{1, 2, 3, 4})
+	chunk.ResetIntervalIndex(0)
+	chunk.AddColumn(c1)
+
+	idx, v, isNil := UnsignedMinReduce(chunk, chunk.Column(0).IntegerValues(), 0, 0, 4)
+	if !(idx == 2 && uint64(v) == 1 && !isNil) {
+		t.Fatal("not expect, idx ", idx, ", v ", uint64(v), ", exist", isNil)
+	}
+
+	idx, v, isNil = UnsignedMaxReduce(chunk, chunk.Column(0).IntegerValues(), 0, 0, 4)
+	if !(idx == 0 && uint64(v) == 1<<64-1 && !isNil) {
+		t.Fatal("not expect, idx ", idx, ", v ", uint64(v), ", exist", isNil)
+	}
+
+	prevPoint, currPoint := newPoint[int64](), newPoint[int64]()
+	prevPoint.Set(0, 1, 1)
+	currPoint.Set(1, 2, -1)
+	UnsignedMinMerge(prevPoint, currPoint)
+	if prevPoint.value != 1 {
+		t.Fatal("not expect, v ", uint64(prevPoint.value))
+	}
+	UnsignedMaxMerge(prevPoint, currPoint)
+	if uint64(prevPoint.value) != 1<<64-1 || prevPoint.time != 2 {
+		t.Fatal("not expect, v ", uint64(prevPoint.value))
+	}
+}
+
+func TestUnsignedSumReduce(t *testing.T) {
+	chunk := NewChunkImpl(hybridqp.NewRowDataTypeImpl(
+		influxql.VarRef{Val: "val_uint", Type: influxql.Unsigned},
+		influxql.VarRef{Val: "time", Type: influxql.Integer},
+	), "test")
+
+	// math.MaxUint64-1, nil, 1, 1
+	c1 := NewColumnImpl(influxql.Unsigned)
+	c1.AppendNilsV2(true, false, true, true)
+	c1.AppendIntegerValues([]int64{-2, 1, 1})
+
+	chunk.SetTime([]int64{1, 2, 3, 4})
+	chunk.ResetIntervalIndex(0)
+	chunk.AddColumn(c1)
+
+	idx, v, isNil := UnsignedSumReduce(chunk, chunk.Column(0).IntegerValues(), 0, 0, 3)
+	if !(idx == 0 && uint64(v) == math.MaxUint64 && !isNil) {
+		t.Fatal("not expect, idx ", idx, ", v ", uint64(v), ", exist", isNil)
+	}
+	require.PanicsWithError(t, errno.NewError(errno.UnsignedSumOverflow).Error(), func() {
+		UnsignedSumReduce(chunk, chunk.Column(0).IntegerValues(), 0, 0, 4)
+	})
+
+	prevPoint, currPoint := newPoint[int64](), newPoint[int64]()
+	prevPoint.Set(0, 1, -2)
+	currPoint.Set(1, 2, 1)
+	UnsignedSumMerge(prevPoint, currPoint)
+	if uint64(prevPoint.value) != math.MaxUint64 {
+		t.Fatal("not expect, v ", uint64(prevPoint.value))
+	}
+	require.PanicsWithError(t, errno.NewError(errno.UnsignedSumOverflow).Error(), func() {
+		UnsignedSumMerge(prevPoint, currPoint)
+	})
+}
+
 func TestStringLastMerge(t *testing.T) {
 	prePoint := newStringPoint()
 	prePoint.Set(0, 1, "string1")
@@ -0,0 +1,91 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"unsafe"
+
+	"github.com/openGemini/openGemini/lib/record"
+)
+
+// The values of the Unsigned columns are stored as the integer values of the columns,
+// count, first and last share the integer functions, min and max compare the values as uint64,
+// sum fails when it overflows uint64 instead of wrapping around.
+
+func unsignedValues(values []int64) []uint64 {
+	return *(*[]uint64)(unsafe.Pointer(&values))
+}
+
+func UnsignedMinReduce(c Chunk, values []int64, ordinal, start, end int) (int, int64, bool) {
+	index, value, isNil := MinReduce[uint64](c, unsignedValues(values), ordinal, start, end)
+	return index, int64(value), isNil
+}
+
+func UnsignedMaxReduce(c Chunk, values []int64, ordinal, start, end int) (int, int64, bool) {
+	index, value, isNil := MaxReduce[uint64](c, unsignedValues(values), ordinal, start, end)
+	return index, int64(value), isNil
+}
+
+func UnsignedMinMerge(prevPoint, currPoint *Point[int64]) {
+	if currPoint.isNil {
+		return
+	}
+	if prevPoint.isNil || (uint64(currPoint.value) < uint64(prevPoint.value)) ||
+		(currPoint.value == prevPoint.value && currPoint.time < prevPoint.time) {
+		prevPoint.Assign(currPoint)
+		prevPoint.isNil = false
+	}
+}
+
+func UnsignedMaxMerge(prevPoint, currPoint *Point[int64]) {
+	if currPoint.isNil {
+		return
+	}
+	if prevPoint.isNil || (uint64(currPoint.value) > uint64(prevPoint.value)) ||
+		(currPoint.value == prevPoint.value && currPoint.time < prevPoint.time) {
+		prevPoint.Assign(currPoint)
+		prevPoint.isNil = false
+	}
+}
+
+func UnsignedSumReduce(c Chunk, values []int64, ordinal, start, end int) (int, int64, bool) {
+	vs, ve := start, end
+	column := c.Column(ordinal)
+	if column.NilCount() != 0 {
+		vs, ve = column.GetRangeValueIndexV2(start, end)
+		if vs == ve {
+			return start, 0, true
+		}
+	}
+	var sum uint64
+	for _, v := range unsignedValues(values[vs:ve]) {
+		sum = record.AddUnsigned(sum, v)
+	}
+	return start, int64(sum), false
+}
+
+func UnsignedSumMerge(prevPoint, currPoint *Point[int64]) {
+	if currPoint.isNil {
+		return
+	}
+	if prevPoint.isNil {
+		prevPoint.Assign(currPoint)
+		prevPoint.isNil = false
+		return
+	}
+	prevPoint.value = int64(record.AddUnsigned(uint64(prevPoint.value), uint64(currPoint.value)))
+}
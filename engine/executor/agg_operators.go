@@ -30,6 +30,7 @@ func init() {
 	RegistryAggOp("min_prom", &MinPromOp{})
 	RegistryAggOp("max_prom", &MaxPromOp{})
 	RegistryAggOp("count_prom", &FloatCountPromOp{})
+	RegistryAggOp("group_prom", &GroupPromOp{})
 	RegistryAggOp("histogram_quantile", &HistogramQuantileOp{})
 	RegistryAggOp("count_values_prom", &CountValuesOp{})
 	RegistryAggOp("stdvar_prom", &PromStdOp{})
@@ -51,6 +52,10 @@ func (c *MinOp) CreateRoutine(params *AggCallFuncParams) (Routine, error) {
 		return NewRoutineImpl(NewIntegerIterator(MinReduce[int64], MinMerge[int64],
 			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		return NewRoutineImpl(NewIntegerIterator(UnsignedMinReduce, UnsignedMinMerge,
+			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		return NewRoutineImpl(NewFloatIterator(MinReduce[float64], MinMerge[float64],
 			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
@@ -79,6 +84,10 @@ func (c *MaxOp) CreateRoutine(params *AggCallFuncParams) (Routine, error) {
 		return NewRoutineImpl(NewIntegerIterator(MaxReduce[int64], MaxMerge[int64],
 			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		return NewRoutineImpl(NewIntegerIterator(UnsignedMaxReduce, UnsignedMaxMerge,
+			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		return NewRoutineImpl(NewFloatIterator(MaxReduce[float64], MaxMerge[float64],
 			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
@@ -165,6 +174,15 @@ func (c *FloatCountPromOp) CreateRoutine(params *AggCallFuncParams) (Routine, er
 	return c.BasePromOp.CreateRoutine(params)
 }
 
+type GroupPromOp struct {
+	BasePromOp
+}
+
+func (c *GroupPromOp) CreateRoutine(params *AggCallFuncParams) (Routine, error) {
+	c.BasePromOp = NewBasePromOp("group_prom", GroupPromReduce, GroupPromMerge)
+	return c.BasePromOp.CreateRoutine(params)
+}
+
 type FloatColReduceHistogramReduce func(floatItem []bucket) (value float64)
 
 type HistogramQuantileOp struct{}
@@ -222,7 +222,7 @@ func NewAlignCoProcessor(rowDataType hybridqp.RowDataType) CoProcessor {
 		switch f.Expr.(*influxql.VarRef).Type {
 		case influxql.Boolean:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewBooleanAlignIterator(), i, i))
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewIntegerAlignIterator(), i, i))
 		case influxql.Float:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewFloatAlignIterator(), i, i))
@@ -392,7 +392,7 @@ func NewCountRoutineImpl(inRowDataType, outRowDataType hybridqp.RowDataType, opt
 	}
 	dataType := inRowDataType.Field(inOrdinal).Expr.(*influxql.VarRef).Type
 	switch dataType {
-	case influxql.Integer:
+	case influxql.Integer, influxql.Unsigned:
 		return NewRoutineImpl(
 			NewIntegerIterator(CountReduce, CountMerge[int64], isSingleCall, inOrdinal, outOrdinal,
 				nil, nil),
@@ -430,6 +430,11 @@ func NewSumRoutineImpl(inRowDataType, outRowDataType hybridqp.RowDataType, opt h
 			NewIntegerIterator(SumReduce[int64], SumMerge[int64], isSingleCall, inOrdinal, outOrdinal,
 				nil, nil),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		return NewRoutineImpl(
+			NewIntegerIterator(UnsignedSumReduce, UnsignedSumMerge, isSingleCall, inOrdinal, outOrdinal,
+				nil, nil),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		return NewRoutineImpl(
 			NewFloatIterator(SumReduce[float64], SumMerge[float64], isSingleCall, inOrdinal, outOrdinal,
@@ -447,7 +452,7 @@ func NewFirstRoutineImpl(inRowDataType, outRowDataType hybridqp.RowDataType, opt
 	}
 	dataType := inRowDataType.Field(inOrdinal).Expr.(*influxql.VarRef).Type
 	switch dataType {
-	case influxql.Integer:
+	case influxql.Integer, influxql.Unsigned:
 		if isSingleCall {
 			return NewRoutineImpl(NewIntegerIterator(FirstReduce[int64], FirstMerge[int64],
 				isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
@@ -496,7 +501,7 @@ func NewLastRoutineImpl(inRowDataType, outRowDataType hybridqp.RowDataType, opt
 	}
 	dataType := inRowDataType.Field(inOrdinal).Expr.(*influxql.VarRef).Type
 	switch dataType {
-	case influxql.Integer:
+	case influxql.Integer, influxql.Unsigned:
 		if isSingleCall {
 			return NewRoutineImpl(NewIntegerIterator(LastReduce[int64], LastMerge[int64],
 				isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
@@ -1017,7 +1022,7 @@ func NewAuxCoProcessor(inRowDataType, outRowDataType hybridqp.RowDataType, opt h
 	inOrdinal, outOrdinal := inRowDataType.FieldIndex(opt.Expr.(*influxql.VarRef).Val), outRowDataType.FieldIndex(opt.Ref.Val)
 	dataType := inRowDataType.Field(inOrdinal).Expr.(*influxql.VarRef).Type
 	switch dataType {
-	case influxql.Integer:
+	case influxql.Integer, influxql.Unsigned:
 		return &AuxProcessor{
 			inOrdinal:     inOrdinal,
 			outOrdinal:    outOrdinal,
@@ -83,6 +83,27 @@ func initIntegerColumnFunc(col Column, bmStart, bmEnd int, ckLen int, dst []inte
 	return dst
 }
 
+func initUnsignedColumnFunc(col Column, bmStart, bmEnd int, ckLen int, dst []interface{}) []interface{} {
+	// fast path
+	if col.NilCount() == 0 {
+		values := col.IntegerValues()[bmStart:bmEnd]
+		for _, v := range values {
+			dst = append(dst, uint64(v))
+		}
+		return dst
+	}
+
+	// slow path
+	for j := bmStart; j < bmEnd; j++ {
+		if col.IsNilV2(j) {
+			dst = append(dst, nil)
+		} else {
+			dst = append(dst, uint64(col.IntegerValue(col.GetValueIndexV2(j))))
+		}
+	}
+	return dst
+}
+
 func initBooleanColumnFunc(col Column, bmStart, bmEnd int, ckLen int, dst []interface{}) []interface{} {
 	// fast path
 	if col.NilCount() == 0 {
@@ -135,12 +156,14 @@ func initTagColumnFunc(col Column, bmStart, bmEnd int, ckLen int, dst []interfac
 }
 
 func initColumnTypeFunc() {
-	GetColValsFn = make(map[influxql.DataType]func(col Column, bmStart, bmEnd int, ckLen int, dst []interface{}) []interface{}, 5)
+	GetColValsFn = make(map[influxql.DataType]func(col Column, bmStart, bmEnd int, ckLen int, dst []interface{}) []interface{}, 6)
 
 	GetColValsFn[influxql.Float] = initFloatColumnFunc
 
 	GetColValsFn[influxql.Integer] = initIntegerColumnFunc
 
+	GetColValsFn[influxql.Unsigned] = initUnsignedColumnFunc
+
 	GetColValsFn[influxql.Boolean] = initBooleanColumnFunc
 
 	GetColValsFn[influxql.String] = initStringColumnFunc
@@ -377,7 +400,7 @@ func (c *ChunkImpl) AppendIntervalFullRows(start, step int64, num int, tag Chunk
 	// append data of column
 	for i := 0; i < c.NumberOfCols(); i++ {
 		switch c.rowDataType.Field(i).Expr.(*influxql.VarRef).Type {
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			c.Column(i).AppendIntegerValues(make([]int64, num))
 		case influxql.Float:
 			c.Column(i).AppendFloatValues(make([]float64, num))
@@ -495,7 +518,7 @@ func (c *ChunkImpl) copy(dst Chunk) Chunk {
 	for i := range dst.RowDataType().Fields() {
 		dataType := dst.RowDataType().Field(i).Expr.(*influxql.VarRef).Type
 		switch dataType {
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			dst.Column(i).AppendIntegerValues(c.Column(i).IntegerValues())
 		case influxql.FloatTuple:
 			dst.Column(i).AppendFloatTuples(c.Column(i).FloatTuples())
@@ -513,7 +536,7 @@ func (c *ChunkImpl) copy(dst Chunk) Chunk {
 	}
 	for i := range dst.Dims() {
 		switch dst.Dim(i).DataType() {
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			dst.Dim(i).AppendIntegerValues(c.Dim(i).IntegerValues())
 		case influxql.FloatTuple:
 			dst.Dim(i).AppendFloatTuples(c.Dim(i).FloatTuples())
@@ -550,7 +573,7 @@ func (c *ChunkImpl) CopyByRowDataType(dst Chunk, fromRt hybridqp.RowDataType, ds
 		} else {
 			dataType := dst.Column(v2).DataType()
 			switch dataType {
-			case influxql.Integer:
+			case influxql.Integer, influxql.Unsigned:
 				dst.Column(v2).AppendIntegerValues(c.Column(v1).IntegerValues())
 			case influxql.FloatTuple:
 				dst.Column(v2).AppendFloatTuples(c.Column(v1).FloatTuples())
@@ -641,6 +664,8 @@ func (c *ChunkImpl) String() string {
 			switch c.Column(i).DataType() {
 			case influxql.Integer:
 				line = append(line, strconv.FormatInt(c.Column(i).IntegerValue(l), 10))
+			case influxql.Unsigned:
+				line = append(line, strconv.FormatUint(uint64(c.Column(i).IntegerValue(l)), 10))
 			case influxql.Float:
 				line = append(line, strconv.FormatFloat(c.Column(i).FloatValue(l), 'f', -1, 64))
 			case influxql.Boolean:
@@ -683,6 +708,24 @@ func (valuer *IntegerFieldValuer) At(col Column, pos int, field *influx.Field) b
 	return true
 }
 
+type UnsignedFieldValuer struct {
+	key string
+	typ int32
+}
+
+func (valuer *UnsignedFieldValuer) At(col Column, pos int, field *influx.Field) bool {
+	if col.IsNilV2(pos) {
+		return false
+	}
+
+	valueIndex := col.GetValueIndexV2(pos)
+
+	field.Key = valuer.key
+	field.Type = valuer.typ
+	field.SetUnsignedValue(uint64(col.IntegerValue(valueIndex)))
+	return true
+}
+
 type FieldValuer interface {
 	At(Column, int, *influx.Field) bool
 }
@@ -748,6 +791,11 @@ func NewFieldValuer(ref *influxql.VarRef) (FieldValuer, error) {
 		valuer.key = ref.Val
 		valuer.typ = influx.Field_Type_Int
 		return valuer, nil
+	case influxql.Unsigned:
+		valuer := &UnsignedFieldValuer{}
+		valuer.key = ref.Val
+		valuer.typ = influx.Field_Type_UInt
+		return valuer, nil
 	case influxql.Float:
 		valuer := &FloatFieldValuer{}
 		valuer.key = ref.Val
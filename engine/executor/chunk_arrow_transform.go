@@ -284,6 +284,8 @@ func buildRecordField(info map[string]*fieldInfo) ([]arrow.Field, *errno.Error)
 			aFields[f.idx] = arrow.Field{Name: f.name, Type: arrow.PrimitiveTypes.Float64}
 		case influxql.Integer:
 			aFields[f.idx] = arrow.Field{Name: f.name, Type: arrow.PrimitiveTypes.Int64}
+		case influxql.Unsigned:
+			aFields[f.idx] = arrow.Field{Name: f.name, Type: arrow.PrimitiveTypes.Uint64}
 		default:
 			return nil, errno.NewError(errno.DtypeNotSupport)
 		}
@@ -388,6 +390,54 @@ func appendArrowInt64(b *array.RecordBuilder, col Column, fieldIndex, seriesStar
 	}
 }
 
+func appendArrowUint64(b *array.RecordBuilder, col Column, fieldIndex, seriesStart, seriesEnd int) {
+	values := unsignedValues(col.IntegerValues())
+	if col.NilCount() == 0 {
+		if seriesEnd == -1 {
+			b.Field(fieldIndex).(*array.Uint64Builder).AppendValues(values[seriesStart:], nil)
+		} else {
+			b.Field(fieldIndex).(*array.Uint64Builder).AppendValues(values[seriesStart:seriesEnd], nil)
+		}
+		return
+	}
+
+	appendCnt := 0
+	for j, val := range values {
+		timeIdx := col.GetTimeIndex(j)
+		if timeIdx < seriesStart {
+			continue
+		}
+		if seriesEnd != -1 && timeIdx >= seriesEnd {
+			break
+		}
+		gap := timeIdx - seriesStart - b.Field(fieldIndex).Len()
+		if gap > 0 {
+			valid := make([]bool, gap+1) // default false
+			valid[gap] = true
+			v := make([]uint64, gap+1)
+			v[gap] = val
+			b.Field(fieldIndex).(*array.Uint64Builder).AppendValues(v, valid)
+			appendCnt += len(v)
+			continue
+		}
+		b.Field(fieldIndex).(*array.Uint64Builder).Append(val)
+		appendCnt += 1
+	}
+
+	var nRow int
+	if seriesEnd == -1 {
+		nRow = col.BitMap().length - seriesStart
+	} else {
+		nRow = seriesEnd - seriesStart
+	}
+	if appendCnt != nRow {
+		gap := nRow - appendCnt
+		valid := make([]bool, gap) // default false
+		v := make([]uint64, gap)
+		b.Field(fieldIndex).(*array.Uint64Builder).AppendValues(v, valid)
+	}
+}
+
 func CopyArrowRecordToChunk(r arrow.Record, c Chunk, fields map[string]struct{}) *errno.Error {
 	// check errInfo, if exist, just return it
 	metaData := r.Schema().Metadata()
@@ -469,6 +519,24 @@ func appendChunkInt64(rCol *array.Int64, cCol Column) {
 	}
 }
 
+func appendChunkUint64(rCol *array.Uint64, cCol Column) {
+	valid := make([]bool, rCol.Len())
+	isNilExist := false
+	for j, val := range rCol.Uint64Values() {
+		if rCol.IsNull(j) {
+			isNilExist = true
+			continue
+		}
+		valid[j] = true
+		cCol.AppendIntegerValue(int64(val))
+	}
+	if isNilExist {
+		cCol.AppendNilsV2(valid...)
+	} else {
+		cCol.AppendManyNotNil(rCol.Len())
+	}
+}
+
 func buildChunkTagsWithFilter(metaData arrow.Metadata) *ChunkTags {
 	// combine tags from both record metadata and chunktags
 	var newTags []influx.Tag
@@ -538,6 +606,15 @@ func copyRecordToChunk(r arrow.Record, c Chunk, fields map[string]struct{}) *err
 				return errno.NewError(errno.DtypeNotMatch, influxql.Integer, c.Column(idx).DataType())
 			}
 			appendChunkInt64(tmp, c.Column(idx))
+		case arrow.PrimitiveTypes.Uint64:
+			tmp, ok := rCol.(*array.Uint64)
+			if !ok {
+				return errno.NewError(errno.TypeAssertFail, arrow.PrimitiveTypes.Uint64)
+			}
+			if c.Column(idx).DataType() != influxql.Unsigned {
+				return errno.NewError(errno.DtypeNotMatch, influxql.Unsigned, c.Column(idx).DataType())
+			}
+			appendChunkUint64(tmp, c.Column(idx))
 		default:
 			return errno.NewError(errno.DtypeNotSupport)
 		}
@@ -561,6 +638,8 @@ func copyChunkToRecord(b *array.RecordBuilder, c Chunk, seriesStart, seriesEnd i
 			appendArrowFloat64(b, col, fIdx[0], seriesStart, seriesEnd)
 		case influxql.Integer:
 			appendArrowInt64(b, col, fIdx[0], seriesStart, seriesEnd)
+		case influxql.Unsigned:
+			appendArrowUint64(b, col, fIdx[0], seriesStart, seriesEnd)
 		default:
 			return errno.NewError(errno.DtypeNotSupport)
 		}
@@ -504,7 +504,7 @@ func (c *ColumnImpl) CheckColumn(length int) {
 		if c.NilCount()+len(c.floatValues) != length {
 			panic("Row in chunk check failed: the number of the data(include nil data) doesn't fit chunk length!")
 		}
-	case influxql.Integer:
+	case influxql.Integer, influxql.Unsigned:
 		if len(c.floatValues) != 0 || len(c.stringBytes) != 0 || len(c.booleanValues) != 0 {
 			panic("Row in chunk check failed: it has wrong datatype, the row's dataType should be int64!")
 		}
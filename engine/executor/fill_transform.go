@@ -44,6 +44,7 @@ type FillTransform struct {
 	startTime            int64
 	endTime              int64
 	interval             int64
+	calendar             bool // the windows vary in length, see query.ProcessorOptions.IsCalendarInterval
 	fillVal              interface{}
 	prevChunk            Chunk
 	newChunk             Chunk
@@ -104,6 +105,7 @@ func NewFillTransform(inRowDataType []hybridqp.RowDataType, outRowDataType []hyb
 		endTime:      endTime,
 		bufChunkNum:  FillBufChunkNum,
 		interval:     int64(opt.Interval.Duration),
+		calendar:     opt.IsCalendarInterval(),
 		Inputs:       make(ChunkPorts, 0, len(inRowDataType)),
 		Outputs:      make(ChunkPorts, 0, len(outRowDataType)),
 		prevReadAts:  make([]int, outRowDataType[0].NumColumn()),
@@ -115,6 +117,11 @@ func NewFillTransform(inRowDataType []hybridqp.RowDataType, outRowDataType []hyb
 		chunkPool:    NewCircularChunkPool(CircularChunkNum, NewChunkBuilder(outRowDataType[0])),
 	}
 
+	if trans.calendar {
+		// the windows vary in length, the linear fill interpolates by the time of the windows
+		trans.interval = 1
+	}
+
 	trans.fillVal = opt.FillValue
 	if opt.Fill == influxql.NullFill {
 		if len(schema.CountField()) > 0 {
@@ -219,9 +226,9 @@ func (trans *FillTransform) getIntervalNum() {
 		return
 	}
 	if trans.opt.Ascending {
-		trans.intervalNum = int((trans.endTime - trans.startTime) / int64(trans.opt.Interval.Duration))
+		trans.intervalNum = trans.windowNum(trans.startTime, trans.endTime)
 	} else {
-		trans.intervalNum = int((trans.startTime - trans.endTime) / int64(trans.opt.Interval.Duration))
+		trans.intervalNum = trans.windowNum(trans.endTime, trans.startTime)
 	}
 	if trans.intervalNum <= 0 {
 		trans.intervalNum = 0
@@ -360,7 +367,7 @@ func (trans *FillTransform) fill(_ context.Context, errs *errno.Errs) {
 		if idx == 0 && len(trans.bufChunk) == 0 && len(trans.opt.Dimensions) == 0 && trans.opt.Fill == influxql.NullFill {
 			windowStart, _ := trans.opt.Window(trans.opt.StartTime)
 			_, windowEnd := trans.opt.Window(trans.opt.EndTime)
-			if (windowEnd-windowStart)/trans.opt.Interval.Duration.Nanoseconds() == int64(c.Len()) {
+			if trans.windowNum(windowStart, windowEnd) == c.Len() {
 				trans.Outputs[0].State <- c
 				continue
 			}
@@ -484,12 +491,12 @@ func (trans *FillTransform) getFillChunkSize(c Chunk) {
 	for i := range c.TagIndex() {
 		if i == firstIdx && trans.prevWindow.name != "" {
 			if i == lastIdx && trans.isSameTag(c) {
-				trans.fillChunkSize += hybridqp.AbsInt(int((c.TimeByIndex(c.NumberOfRows()-1) - trans.prevWindow.time) / int64(trans.opt.Interval.Duration)))
+				trans.fillChunkSize += hybridqp.AbsInt(trans.windowNum(trans.prevWindow.time, c.TimeByIndex(c.NumberOfRows()-1)))
 			} else {
-				trans.fillChunkSize += hybridqp.AbsInt(int((trans.endTime - trans.prevWindow.time) / int64(trans.opt.Interval.Duration)))
+				trans.fillChunkSize += hybridqp.AbsInt(trans.windowNum(trans.prevWindow.time, trans.endTime))
 			}
 		} else if i == lastIdx && trans.isSameTag(c) {
-			trans.fillChunkSize += hybridqp.AbsInt(int((c.TimeByIndex(c.NumberOfRows()-1)-trans.startTime)/int64(trans.opt.Interval.Duration))) + 1
+			trans.fillChunkSize += hybridqp.AbsInt(trans.windowNum(trans.startTime, c.TimeByIndex(c.NumberOfRows()-1))) + 1
 		} else {
 			trans.fillChunkSize += trans.intervalNum + 1
 		}
@@ -516,11 +523,11 @@ func (trans *FillTransform) computeGroup(c Chunk, tagIdxAt, tagStartIdx, tagEndI
 
 	for j := 0; j < fillChunkNum; j++ {
 		if trans.opt.Ascending {
-			startTime = st + int64(j*trans.opt.ChunkSize)*int64(trans.opt.Interval.Duration)
-			endTime = st + int64((j+1)*trans.opt.ChunkSize)*int64(trans.opt.Interval.Duration)
+			startTime = trans.opt.WindowStart(st, int64(j*trans.opt.ChunkSize))
+			endTime = trans.opt.WindowStart(st, int64((j+1)*trans.opt.ChunkSize))
 		} else {
-			startTime = st - int64(j*trans.opt.ChunkSize)*int64(trans.opt.Interval.Duration)
-			endTime = st - int64((j-1)*trans.opt.ChunkSize)*int64(trans.opt.Interval.Duration)
+			startTime = trans.opt.WindowStart(st, -int64(j*trans.opt.ChunkSize))
+			endTime = trans.opt.WindowStart(st, -int64((j-1)*trans.opt.ChunkSize))
 		}
 
 		trans.tmpChunk.SetName(c.Name())
@@ -576,9 +583,9 @@ func (trans *FillTransform) computeSplitOneGroup(c Chunk) {
 
 		if i == firstIdx && trans.prevWindow.name != "" {
 			if trans.opt.Ascending {
-				trans.computeGroup(c, i, tagStartIdx, tagEndIdx, trans.prevWindow.time+int64(trans.opt.Interval.Duration))
+				trans.computeGroup(c, i, tagStartIdx, tagEndIdx, trans.opt.WindowStart(trans.prevWindow.time, 1))
 			} else {
-				trans.computeGroup(c, i, tagStartIdx, tagEndIdx, trans.prevWindow.time-int64(trans.opt.Interval.Duration))
+				trans.computeGroup(c, i, tagStartIdx, tagEndIdx, trans.opt.WindowStart(trans.prevWindow.time, -1))
 			}
 		} else {
 			trans.computeGroup(c, i, tagStartIdx, tagEndIdx, trans.startTime)
@@ -797,7 +804,11 @@ func (trans *FillTransform) updatePrevChunk(c Chunk) {
 	for i := range trans.appendPrevWindowFunc {
 		trans.appendPrevWindowFunc[i](trans.prevChunk, &trans.prevWindow, i)
 	}
-	if trans.opt.Ascending {
+	if trans.calendar && trans.opt.Ascending {
+		trans.window.time = trans.opt.WindowStart(trans.prevWindow.time, 1)
+	} else if trans.calendar {
+		trans.window.time = trans.opt.WindowStart(trans.prevWindow.time, -1)
+	} else if trans.opt.Ascending {
 		trans.window.time, _ = trans.opt.Window(trans.prevWindow.time + trans.opt.Interval.Duration.Nanoseconds())
 	} else {
 		trans.window.time, _ = trans.opt.Window(trans.prevWindow.time - trans.opt.Interval.Duration.Nanoseconds())
@@ -807,6 +818,15 @@ func (trans *FillTransform) updatePrevChunk(c Chunk) {
 }
 
 func (trans *FillTransform) nextWindow() {
+	if trans.calendar {
+		if trans.opt.Ascending {
+			trans.window.time = trans.opt.WindowStart(trans.window.time, 1)
+		} else {
+			trans.window.time = trans.opt.WindowStart(trans.window.time, -1)
+		}
+		return
+	}
+
 	if trans.opt.Ascending {
 		trans.window.time += int64(trans.opt.Interval.Duration)
 	} else {
@@ -826,6 +846,11 @@ func (trans *FillTransform) nextWindow() {
 	}
 }
 
+// windowNum returns the number of the windows from the window starting at start to the window of t.
+func (trans *FillTransform) windowNum(start, t int64) int {
+	return int(trans.opt.WindowIndex(start, t))
+}
+
 func (trans *FillTransform) nextPrevWindow(c Chunk, intervalIndex int) {
 	trans.prevWindow.name = c.Name()
 	trans.prevWindow.tags = c.Tags()[c.TagLen()-1]
@@ -913,7 +938,7 @@ func NewPreviousFillProcessor(rowDataType hybridqp.RowDataType) []FillProcessor
 	fillProcessor := make([]FillProcessor, rowDataType.NumColumn())
 	for i, f := range rowDataType.Fields() {
 		switch f.Expr.(*influxql.VarRef).Type {
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			fillProcessor[i] = NewIntegerPreviousFillProcessor(i, i)
 		case influxql.Float:
 			fillProcessor[i] = NewFloatPreviousFillProcessor(i, i)
@@ -933,7 +958,7 @@ func NewNullFillProcessor(rowDataType hybridqp.RowDataType, schema *QuerySchema)
 	fillProcessor := make([]FillProcessor, rowDataType.NumColumn())
 	for i, f := range rowDataType.Fields() {
 		switch f.Expr.(*influxql.VarRef).Type {
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			if m[i] {
 				fillProcessor[i] = NewIntegerNumberFillProcessor(i, i)
 			} else {
@@ -957,7 +982,7 @@ func NewNumberFillProcessor(rowDataType hybridqp.RowDataType) []FillProcessor {
 	fillProcessor := make([]FillProcessor, rowDataType.NumColumn())
 	for i, f := range rowDataType.Fields() {
 		switch f.Expr.(*influxql.VarRef).Type {
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			fillProcessor[i] = NewIntegerNumberFillProcessor(i, i)
 		case influxql.Float:
 			fillProcessor[i] = NewFloatNumberFillProcessor(i, i)
@@ -1008,7 +1033,7 @@ func NewPrevWindowFunc(rowDataType hybridqp.RowDataType) ([]func(prev Chunk, win
 	for i := range rowDataType.Fields() {
 		dataType := rowDataType.Field(i).Expr.(*influxql.VarRef).Type
 		switch dataType {
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			appendFunc[i] = appendIntegerPrevWindowFunc
 			updateFunc[i] = updateIntegerPrevWindowFunc
 		case influxql.Float:
@@ -1122,7 +1147,7 @@ func NewPrevValuesFunc(rowDataType hybridqp.RowDataType) []func(prev Chunk, prev
 	for i := range rowDataType.Fields() {
 		dataType := rowDataType.Field(i).Expr.(*influxql.VarRef).Type
 		switch dataType {
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			updateFunc[i] = updateIntegerPrevValuesFunc
 		case influxql.Float:
 			updateFunc[i] = updateFloatPrevValuesFunc
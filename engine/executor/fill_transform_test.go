@@ -1699,3 +1699,76 @@ func TestFillTransformFillNumberDescendingSplitOneGroup(t *testing.T) {
 		schema,
 	)
 }
+
+func TestFillTransform_CalendarInterval(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	// the midnight of 2024-01 to 2024-04 in New York, the DST starts on 2024-03-10
+	months := []int64{1704085200000000000, 1706763600000000000, 1709269200000000000, 1711944000000000000}
+
+	for _, tc := range []struct {
+		ascending bool
+		chunkSize int
+		times     []int64
+		values    []int64
+	}{
+		{ascending: true, chunkSize: 4, times: months, values: []int64{1, 0, 3, 0}},
+		{ascending: true, chunkSize: 1, times: months, values: []int64{1, 0, 3, 0}},
+		{ascending: false, chunkSize: 4, times: []int64{months[3], months[2], months[1], months[0]}, values: []int64{0, 3, 0, 1}},
+		{ascending: false, chunkSize: 1, times: []int64{months[3], months[2], months[1], months[0]}, values: []int64{0, 3, 0, 1}},
+	} {
+		rowDataType := buildRowDataTypeBug1217()
+		in := executor.NewChunkBuilder(rowDataType).NewChunk("mst")
+		in.AppendTagsAndIndexes([]executor.ChunkTags{*ParseChunkTags("country=a")}, []int{0})
+		in.AppendIntervalIndexes([]int{0, 1})
+		if tc.ascending {
+			in.AppendTimes([]int64{months[0], months[2]})
+			in.Column(0).AppendIntegerValues([]int64{1, 3})
+			in.Column(1).AppendIntegerValues([]int64{1, 3})
+		} else {
+			in.AppendTimes([]int64{months[2], months[0]})
+			in.Column(0).AppendIntegerValues([]int64{3, 1})
+			in.Column(1).AppendIntegerValues([]int64{3, 1})
+		}
+		in.Column(0).AppendManyNotNil(2)
+		in.Column(1).AppendManyNotNil(2)
+
+		opt := query.ProcessorOptions{
+			Dimensions: []string{"country"},
+			StartTime:  months[0],
+			EndTime:    months[3] + 29*24*int64(time.Hour),
+			Ascending:  tc.ascending,
+			Interval:   hybridqp.Interval{Duration: influxql.AverageMonth, Months: 1},
+			Location:   loc,
+			ChunkSize:  tc.chunkSize,
+			Fill:       influxql.NumberFill,
+			FillValue:  int64(0),
+		}
+		schema := executor.NewQuerySchema(createFillFieldsBug1217(), []string{"age", "height"}, &opt, nil)
+		schema.SetOpt(&opt)
+
+		source := NewSourceFromMultiChunk(rowDataType, []executor.Chunk{in})
+		trans, err := executor.NewFillTransform([]hybridqp.RowDataType{rowDataType}, []hybridqp.RowDataType{rowDataType}, nil, schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sink := NewNilSink(rowDataType)
+		executor.Connect(source.Output, trans.Inputs[0])
+		executor.Connect(trans.Outputs[0], sink.Input)
+		executors := executor.NewPipelineExecutor(executor.Processors{source, trans, sink})
+		if err = executors.Execute(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		executors.Release()
+
+		var times, values []int64
+		for _, c := range sink.Chunks {
+			times = append(times, c.Time()...)
+			values = append(values, c.Column(0).IntegerValues()...)
+		}
+		assert.Equal(t, times, tc.times)
+		assert.Equal(t, values, tc.values)
+	}
+}
@@ -102,6 +102,13 @@ func (c *FullJoinTransformCreator) Create(plan LogicalPlan, _ *query.ProcessorOp
 		inRowDataTypes = append(inRowDataTypes, inPlan.RowDataType())
 	}
 	joinCase := plan.Schema().(*QuerySchema).joinCases[0]
+	if joinCase.JoinType != influxql.FullJoin {
+		p, err := NewHashJoinTransform(inRowDataTypes, plan.RowDataType(), joinCase, plan.Schema().(*QuerySchema))
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	}
 	p, err := NewFullJoinTransform(inRowDataTypes, plan.RowDataType(), joinCase, plan.Schema().(*QuerySchema))
 	return p, err
 }
@@ -622,7 +629,7 @@ func (trans *FullJoinTransform) appendNilSeriesVal(oDataType influxql.DataType,
 			val := true
 			ocolumn.AppendBooleanValue(val)
 		}
-	case influxql.Integer:
+	case influxql.Integer, influxql.Unsigned:
 		{
 			var val int64 = 0
 			ocolumn.AppendIntegerValue(val)
@@ -662,7 +669,7 @@ func (trans *FullJoinTransform) appendSeriesVal(oDataType influxql.DataType, i i
 			val := column.BooleanValue(startIndex)
 			ocolumn.AppendBooleanValue(val)
 		}
-	case influxql.Integer:
+	case influxql.Integer, influxql.Unsigned:
 		{
 			val := column.IntegerValue(startIndex)
 			ocolumn.AppendIntegerValue(val)
@@ -90,7 +90,7 @@ func (trans *GroupByTransform) initTransformMapping() error {
 
 func (trans *GroupByTransform) initTransParent(vr *influxql.VarRef, i int) {
 	switch vr.Type {
-	case influxql.Integer:
+	case influxql.Integer, influxql.Unsigned:
 		trans.transparents[i] = TransparentForwardIntegerColumn
 	case influxql.Float:
 		trans.transparents[i] = TransparentForwardFloatColumn
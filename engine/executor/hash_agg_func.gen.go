@@ -281,6 +281,8 @@ func NewHeapFunc(inRowDataType, outRowDataType hybridqp.RowDataType, exprOpt []h
 			sortFuncs = append(sortFuncs, NewFloatSortEle)
 		case influxql.Integer:
 			sortFuncs = append(sortFuncs, NewIntegerSortEle)
+		case influxql.Unsigned:
+			sortFuncs = append(sortFuncs, NewUnsignedSortEle)
 		case influxql.Boolean:
 			sortFuncs = append(sortFuncs, NewBoolSortEle)
 		case influxql.String, influxql.Tag:
@@ -345,7 +345,7 @@ func (trans *HashAggTransform) initIntervalWindow() error {
 	} else if trans.opt.StartTime != influxql.MinTime && trans.opt.EndTime != influxql.MaxTime {
 		trans.fixSizeInterval = true
 		if trans.opt.HasInterval() {
-			trans.fixIntervalNum = uint64((trans.intervalEndTime-trans.intervalStartTime)/int64(trans.opt.GetInterval())) + 1
+			trans.fixIntervalNum = uint64(trans.opt.WindowIndex(trans.intervalStartTime, trans.intervalEndTime)) + 1
 		} else {
 			trans.fixIntervalNum = 1
 		}
@@ -839,6 +839,12 @@ func (trans *HashAggTransform) computeIntervalKeys() {
 	}
 	times := trans.bufChunk.Time()
 	trans.bufIntervalKeys = trans.bufIntervalKeysMPool.AllocIntervalKeys(len(times))
+	if trans.opt.IsCalendarInterval() {
+		for i, time := range times {
+			trans.bufIntervalKeys[i], _ = trans.opt.Window(time)
+		}
+		return
+	}
 	for i, time := range times {
 		intervalStartTime := (time-trans.intervalStartTime)/int64(trans.opt.GetInterval())*int64(trans.opt.GetInterval()) + trans.intervalStartTime
 		trans.bufIntervalKeys[i] = intervalStartTime
@@ -853,7 +859,7 @@ func (trans *HashAggTransform) mapIntervalKeys(groupIds []uint64) ([]uint64, err
 	if trans.fixSizeInterval {
 		for i, endLoc := range trans.batchEndLocs {
 			intervalStartTime := trans.bufIntervalKeys[endLoc-1]
-			intervalId := uint64((intervalStartTime - trans.intervalStartTime) / int64(trans.opt.GetInterval()))
+			intervalId := uint64(trans.opt.WindowIndex(trans.intervalStartTime, intervalStartTime))
 			intervalIds[i] = intervalId
 		}
 	} else {
@@ -1016,7 +1022,7 @@ func (trans *HashAggTransform) generateFixIntervalNullOrNumFillOutput() {
 				chunk = trans.outputChunkPool.GetChunk()
 				chunk.SetName(trans.bufChunk.Name())
 			}
-			startTime += int64(trans.opt.GetInterval())
+			startTime = trans.opt.WindowStart(startTime, 1)
 		}
 	}
 	trans.sendChunk(chunk)
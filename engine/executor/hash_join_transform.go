@@ -0,0 +1,414 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/sysconfig"
+	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"go.uber.org/zap"
+)
+
+const (
+	hashJoinTransformName = "HashJoinTransform"
+	hashJoinKeySeparator  = "\x00"
+)
+
+// hashJoinRow locates a row of the chunks read from the build side.
+type hashJoinRow struct {
+	chunk Chunk
+	index int
+}
+
+// hashJoinSeries is a series of the build side, the rows are indexed by time.
+type hashJoinSeries map[int64][]hashJoinRow
+
+// HashJoinTransform implements the inner, left outer and right outer join of two subqueries.
+// The whole build side is read into a hash table keyed by the values of the join tags, then
+// the probe side is streamed and every row is joined with the rows of the matched series at
+// the same time. The probe side is the left subquery except for the right outer join, and its
+// rows without a match are kept for the outer joins.
+type HashJoinTransform struct {
+	BaseProcessor
+	inputs      []*ChunkPort
+	output      *ChunkPort
+	outputChunk Chunk
+	chunkPool   *CircularChunkPool
+
+	joinType      influxql.JoinType
+	joinCondition influxql.Expr
+	leftNewName   string
+	rightNewName  string
+	newName       string
+	buildSide     int
+	probeSide     int
+	joinTags      [2][]string // the join tags of the left and right side, in the order of the condition
+	fieldSide     []int       // the input side of the output columns
+	fieldIndex    []int       // the column index of the output columns in the input chunks
+	table         map[string]hashJoinSeries
+	tableRows     int
+	rowsLimit     int // the rows limit of the hash table, no limit if it is not positive
+
+	schema      *QuerySchema
+	opt         *query.ProcessorOptions
+	workTracing *tracing.Span
+	joinLogger  *logger.Logger
+}
+
+func NewHashJoinTransform(inRowDataTypes []hybridqp.RowDataType, outRowDataType hybridqp.RowDataType,
+	joinCase *influxql.Join, schema *QuerySchema) (*HashJoinTransform, error) {
+	trans := &HashJoinTransform{
+		output:        NewChunkPort(outRowDataType),
+		chunkPool:     NewCircularChunkPool(CircularChunkNum, NewChunkBuilder(outRowDataType)),
+		joinType:      joinCase.JoinType,
+		joinCondition: joinCase.Condition,
+		leftNewName:   joinCase.LSrc.(*influxql.SubQuery).Alias,
+		rightNewName:  joinCase.RSrc.(*influxql.SubQuery).Alias,
+		buildSide:     1,
+		probeSide:     0,
+		table:         make(map[string]hashJoinSeries),
+		schema:        schema,
+		opt:           schema.opt.(*query.ProcessorOptions),
+		rowsLimit:     sysconfig.GetHashJoinRowsLimit(),
+		joinLogger:    logger.NewLogger(errno.ModuleQueryEngine),
+	}
+	if trans.joinType == influxql.RightOuterJoin {
+		trans.buildSide, trans.probeSide = 0, 1
+	}
+	for i := range inRowDataTypes {
+		trans.inputs = append(trans.inputs, NewChunkPort(inRowDataTypes[i]))
+	}
+	if trans.leftNewName == trans.rightNewName {
+		return nil, errno.NewError(errno.UnsupportedConditionInJoin, trans.joinType, "the alias of the subqueries must be different")
+	}
+	trans.newName = trans.leftNewName + "," + trans.rightNewName
+	trans.outputChunk = trans.chunkPool.GetChunk()
+
+	if err := trans.initFieldMap(); err != nil {
+		return nil, err
+	}
+	if err := trans.initJoinTags(trans.joinCondition); err != nil {
+		return nil, err
+	}
+	if err := trans.checkJoinTags(); err != nil {
+		return nil, err
+	}
+	return trans, nil
+}
+
+// initFieldMap finds the input column of every output column by the alias prefix of the
+// selected field, such as m1.f1.
+func (trans *HashJoinTransform) initFieldMap() error {
+	valFieldMap := make(map[string]string, len(trans.schema.mapping))
+	for k, v := range trans.schema.mapping {
+		valFieldMap[v.Val] = k.(*influxql.VarRef).Val
+	}
+	for _, outField := range trans.output.RowDataType.Fields() {
+		outVal := outField.Expr.(*influxql.VarRef).Val
+		field := valFieldMap[outVal]
+		side := -1
+		if strings.HasPrefix(field, trans.leftNewName+".") {
+			side = 0
+		} else if strings.HasPrefix(field, trans.rightNewName+".") {
+			side = 1
+		}
+		if side < 0 {
+			return errno.NewError(errno.UnsupportedConditionInJoin, trans.joinType, "the field "+field+" is not from the joined subqueries")
+		}
+		index := trans.inputs[side].RowDataType.FieldIndex(outVal)
+		if index < 0 {
+			return errno.NewError(errno.UnsupportedConditionInJoin, trans.joinType, "the field "+field+" is not found")
+		}
+		trans.fieldSide = append(trans.fieldSide, side)
+		trans.fieldIndex = append(trans.fieldIndex, index)
+	}
+	return nil
+}
+
+// initJoinTags collects the tags of the equality conditions, such as m1.tag1 = m2.tag1.
+func (trans *HashJoinTransform) initJoinTags(cond influxql.Expr) error {
+	switch expr := cond.(type) {
+	case *influxql.ParenExpr:
+		return trans.initJoinTags(expr.Expr)
+	case *influxql.BinaryExpr:
+		if expr.Op == influxql.AND {
+			if err := trans.initJoinTags(expr.LHS); err != nil {
+				return err
+			}
+			return trans.initJoinTags(expr.RHS)
+		}
+		if expr.Op != influxql.EQ {
+			break
+		}
+		lhs, lok := expr.LHS.(*influxql.VarRef)
+		rhs, rok := expr.RHS.(*influxql.VarRef)
+		if !lok || !rok {
+			break
+		}
+		lSide, lTag := trans.splitJoinTag(lhs.Val)
+		rSide, rTag := trans.splitJoinTag(rhs.Val)
+		if lSide < 0 || rSide < 0 || lSide == rSide {
+			break
+		}
+		trans.joinTags[lSide] = append(trans.joinTags[lSide], lTag)
+		trans.joinTags[rSide] = append(trans.joinTags[rSide], rTag)
+		return nil
+	}
+	return errno.NewError(errno.UnsupportedConditionInJoin, trans.joinType, trans.joinCondition)
+}
+
+func (trans *HashJoinTransform) splitJoinTag(val string) (int, string) {
+	if strings.HasPrefix(val, trans.leftNewName+".") {
+		return 0, val[len(trans.leftNewName)+1:]
+	}
+	if strings.HasPrefix(val, trans.rightNewName+".") {
+		return 1, val[len(trans.rightNewName)+1:]
+	}
+	return -1, ""
+}
+
+// checkJoinTags requires the join tags of both sides to cover the group by tags, so a series
+// of the probe side matches at most one series of the build side.
+func (trans *HashJoinTransform) checkJoinTags() error {
+	dims := trans.schema.opt.GetOptDimension()
+	for side := range trans.joinTags {
+		for _, dim := range dims {
+			if !ContainDim(trans.joinTags[side], dim) {
+				return errno.NewError(errno.UnsupportedConditionInJoin, trans.joinType, trans.joinCondition)
+			}
+		}
+		for _, tag := range trans.joinTags[side] {
+			if !ContainDim(dims, tag) {
+				return errno.NewError(errno.UnsupportedConditionInJoin, trans.joinType, trans.joinCondition)
+			}
+		}
+	}
+	return nil
+}
+
+func (trans *HashJoinTransform) joinKey(side int, tags *ChunkTags) string {
+	var sb strings.Builder
+	for i, tag := range trans.joinTags[side] {
+		if i > 0 {
+			sb.WriteString(hashJoinKeySeparator)
+		}
+		val, _ := tags.GetChunkTagValue(tag)
+		sb.WriteString(val)
+	}
+	return sb.String()
+}
+
+func (trans *HashJoinTransform) Name() string {
+	return hashJoinTransformName
+}
+
+func (trans *HashJoinTransform) Explain() []ValuePair {
+	return nil
+}
+
+func (trans *HashJoinTransform) Close() {
+	trans.output.Close()
+}
+
+func (trans *HashJoinTransform) Work(ctx context.Context) (err error) {
+	span := trans.StartSpan("[hashJoinTransform] TotalWorkCost", false)
+	trans.workTracing = tracing.Start(span, "cost_for_hashjoin", false)
+	defer func() {
+		if e := recover(); e != nil {
+			err = errno.NewError(errno.RecoverPanic, e)
+			trans.joinLogger.Error(err.Error(), zap.String("query", hashJoinTransformName),
+				zap.Uint64("query_id", trans.opt.QueryId))
+		}
+		trans.Close()
+		tracing.Finish(span, trans.workTracing)
+	}()
+
+	for {
+		select {
+		case c, ok := <-trans.inputs[trans.buildSide].State:
+			if !ok {
+				return trans.probe(ctx)
+			}
+			if err := trans.build(c.Clone()); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// build adds the rows of the chunk into the hash table, the chunk is cloned because the
+// chunks of the input are reused. The join fails once the rows of the hash table exceed the
+// hash-join-rows-limit, instead of holding the whole build side in memory.
+func (trans *HashJoinTransform) build(c Chunk) error {
+	trans.tableRows += c.NumberOfRows()
+	if trans.rowsLimit > 0 && trans.tableRows > trans.rowsLimit {
+		return errno.NewError(errno.HashJoinRowsExceeded, trans.joinType, trans.rowsLimit)
+	}
+	tags := c.Tags()
+	tagIndex := c.TagIndex()
+	for i := range tags {
+		start, end := tagIndex[i], c.NumberOfRows()
+		if i+1 < len(tagIndex) {
+			end = tagIndex[i+1]
+		}
+		key := trans.joinKey(trans.buildSide, &tags[i])
+		series, ok := trans.table[key]
+		if !ok {
+			series = make(hashJoinSeries)
+			trans.table[key] = series
+		}
+		for j := start; j < end; j++ {
+			t := c.TimeByIndex(j)
+			series[t] = append(series[t], hashJoinRow{chunk: c, index: j})
+		}
+	}
+	return nil
+}
+
+func (trans *HashJoinTransform) probe(ctx context.Context) error {
+	for {
+		select {
+		case c, ok := <-trans.inputs[trans.probeSide].State:
+			if !ok {
+				trans.sendChunk()
+				return nil
+			}
+			trans.probeChunk(c)
+			trans.sendChunk()
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (trans *HashJoinTransform) probeChunk(c Chunk) {
+	tags := c.Tags()
+	tagIndex := c.TagIndex()
+	outer := trans.joinType == influxql.LeftOuterJoin || trans.joinType == influxql.RightOuterJoin
+	for i := range tags {
+		start, end := tagIndex[i], c.NumberOfRows()
+		if i+1 < len(tagIndex) {
+			end = tagIndex[i+1]
+		}
+		series := trans.table[trans.joinKey(trans.probeSide, &tags[i])]
+		appended := false
+		for j := start; j < end; j++ {
+			t := c.TimeByIndex(j)
+			matched := series[t]
+			if len(matched) == 0 && !outer {
+				continue
+			}
+			if !appended {
+				trans.appendSeriesKey(tags[i])
+				appended = true
+			}
+			if len(matched) == 0 {
+				trans.appendRow(t, c, j, nil, 0)
+			}
+			for _, row := range matched {
+				trans.appendRow(t, c, j, row.chunk, row.index)
+			}
+			if trans.outputChunk.NumberOfRows() >= trans.opt.ChunkSizeNum() {
+				trans.sendChunk()
+				appended = false
+			}
+		}
+	}
+}
+
+func (trans *HashJoinTransform) appendSeriesKey(tags ChunkTags) {
+	index := trans.outputChunk.NumberOfRows()
+	keys, vals := tags.GetChunkTagAndValues()
+	trans.outputChunk.SetName(trans.newName)
+	trans.outputChunk.AppendTagsAndIndex(*NewChunkTagsByTagKVs(keys, vals), index)
+	trans.outputChunk.AppendIntervalIndex(index)
+}
+
+// appendRow joins the row of the probe side with the row of the build side, the columns of
+// the build side are nil if the build chunk is nil.
+func (trans *HashJoinTransform) appendRow(t int64, probe Chunk, probeIndex int, build Chunk, buildIndex int) {
+	trans.outputChunk.AppendTime(t)
+	for i, col := range trans.outputChunk.Columns() {
+		if trans.fieldSide[i] == trans.probeSide {
+			appendJoinValue(col, probe.Column(trans.fieldIndex[i]), probeIndex, t)
+		} else if build != nil {
+			appendJoinValue(col, build.Column(trans.fieldIndex[i]), buildIndex, t)
+		} else {
+			col.AppendNil()
+		}
+	}
+}
+
+func appendJoinValue(dst Column, src Column, index int, t int64) {
+	if src.IsNilV2(index) {
+		dst.AppendNil()
+		return
+	}
+	dst.AppendColumnTime(t)
+	index = src.GetValueIndexV2(index)
+	switch src.DataType() {
+	case influxql.Float:
+		dst.AppendFloatValue(src.FloatValue(index))
+	case influxql.Integer, influxql.Unsigned:
+		dst.AppendIntegerValue(src.IntegerValue(index))
+	case influxql.Boolean:
+		dst.AppendBooleanValue(src.BooleanValue(index))
+	case influxql.String, influxql.Tag:
+		dst.AppendStringValue(src.StringValue(index))
+	}
+	dst.AppendNotNil()
+}
+
+func (trans *HashJoinTransform) sendChunk() {
+	if trans.outputChunk.Len() <= 0 {
+		return
+	}
+	for _, col := range trans.outputChunk.Columns() {
+		col.NilsV2().SetLen(trans.outputChunk.NumberOfRows())
+	}
+	trans.output.State <- trans.outputChunk
+	trans.outputChunk = trans.chunkPool.GetChunk()
+}
+
+func (trans *HashJoinTransform) GetOutputs() Ports {
+	return Ports{trans.output}
+}
+
+func (trans *HashJoinTransform) GetInputs() Ports {
+	ports := make(Ports, 0, len(trans.inputs))
+	for _, input := range trans.inputs {
+		ports = append(ports, input)
+	}
+	return ports
+}
+
+func (trans *HashJoinTransform) GetOutputNumber(_ Port) int {
+	return 0
+}
+
+func (trans *HashJoinTransform) GetInputNumber(_ Port) int {
+	return 0
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/sysconfig"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runHashJoinTransform(t *testing.T, joinType influxql.JoinType, left, right []executor.Chunk) []executor.Chunk {
+	source1 := NewSourceFromMultiChunk(buildInRowDataType(), left)
+	source2 := NewSourceFromMultiChunk(buildInRowDataType(), right)
+	joinCase := buildJoinCase()
+	joinCase.JoinType = joinType
+	outputRowDataType := buildOutputRowDataType()
+	trans, err := executor.NewHashJoinTransform([]hybridqp.RowDataType{source1.Output.RowDataType, source2.Output.RowDataType},
+		outputRowDataType, joinCase, buildFullJoinSchema())
+	require.NoError(t, err)
+
+	var outputs []executor.Chunk
+	sink := NewSinkFromFunction(outputRowDataType, func(chunk executor.Chunk) error {
+		outputs = append(outputs, chunk.Clone())
+		return nil
+	})
+	executor.Connect(source1.Output, trans.GetInputs()[0])
+	executor.Connect(source2.Output, trans.GetInputs()[1])
+	executor.Connect(trans.GetOutputs()[0], sink.Input)
+	executors := executor.NewPipelineExecutor(executor.Processors{source1, source2, trans, sink})
+	require.NoError(t, executors.Execute(context.Background()))
+	executors.Release()
+	return outputs
+}
+
+func TestHashJoinTransform_InnerJoin(t *testing.T) {
+	outputs := runHashJoinTransform(t, influxql.InnerJoin,
+		[]executor.Chunk{BuildInChunk1("m1")},
+		[]executor.Chunk{BuildInChunk2("m2"), BuildInChunk4("m2")})
+	require.Equal(t, 1, len(outputs))
+	chunk := outputs[0]
+	assert.Equal(t, "m1,m2", chunk.Name())
+	assert.Equal(t, []int64{1, 2}, chunk.Time())
+	assert.Equal(t, 1, chunk.TagLen())
+	assert.Equal(t, []float64{1, 2}, chunk.Column(0).FloatValues())
+	assert.Equal(t, []int64{1, 2}, chunk.Column(3).IntegerValues())
+}
+
+func TestHashJoinTransform_LeftOuterJoin(t *testing.T) {
+	outputs := runHashJoinTransform(t, influxql.LeftOuterJoin,
+		[]executor.Chunk{BuildInChunk1("m1")},
+		[]executor.Chunk{BuildInChunk2("m2"), BuildInChunk4("m2")})
+	require.Equal(t, 1, len(outputs))
+	chunk := outputs[0]
+	assert.Equal(t, []int64{1, 2, 3}, chunk.Time())
+	assert.Equal(t, []float64{1, 2, 3}, chunk.Column(0).FloatValues())
+	assert.Equal(t, []int64{1, 2}, chunk.Column(3).IntegerValues())
+	assert.False(t, chunk.Column(3).IsNilV2(1))
+	assert.True(t, chunk.Column(3).IsNilV2(2))
+}
+
+func TestHashJoinTransform_RightOuterJoin(t *testing.T) {
+	outputs := runHashJoinTransform(t, influxql.RightOuterJoin,
+		[]executor.Chunk{BuildInChunk1("m1")},
+		[]executor.Chunk{BuildInChunk2("m2"), BuildInChunk4("m2")})
+	require.Equal(t, 2, len(outputs))
+	assert.Equal(t, []int64{1, 2}, outputs[0].Time())
+	assert.Equal(t, []float64{1, 2}, outputs[0].Column(0).FloatValues())
+
+	chunk := outputs[1]
+	assert.Equal(t, []int64{6, 7}, chunk.Time())
+	assert.Equal(t, 0, len(chunk.Column(0).FloatValues()))
+	assert.True(t, chunk.Column(0).IsNilV2(0))
+	assert.Equal(t, []int64{6, 7}, chunk.Column(3).IntegerValues())
+	_, vals := chunk.Tags()[0].GetChunkTagAndValues()
+	assert.Equal(t, []string{"tag1val2"}, vals)
+}
+
+func TestHashJoinTransform_RowsLimit(t *testing.T) {
+	sysconfig.SetHashJoinRowsLimit(2)
+	defer sysconfig.SetHashJoinRowsLimit(0)
+
+	source1 := NewSourceFromMultiChunk(buildInRowDataType(), []executor.Chunk{BuildInChunk1("m1")})
+	source2 := NewSourceFromMultiChunk(buildInRowDataType(), []executor.Chunk{BuildInChunk2("m2"), BuildInChunk4("m2")})
+	outputRowDataType := buildOutputRowDataType()
+	trans, err := executor.NewHashJoinTransform([]hybridqp.RowDataType{source1.Output.RowDataType, source2.Output.RowDataType},
+		outputRowDataType, buildJoinCase(), buildFullJoinSchema())
+	require.NoError(t, err)
+	sink := NewSinkFromFunction(outputRowDataType, func(chunk executor.Chunk) error {
+		return nil
+	})
+	executor.Connect(source1.Output, trans.GetInputs()[0])
+	executor.Connect(source2.Output, trans.GetInputs()[1])
+	executor.Connect(trans.GetOutputs()[0], sink.Input)
+	executors := executor.NewPipelineExecutor(executor.Processors{source1, source2, trans, sink})
+	err = executors.Execute(context.Background())
+	require.True(t, errno.Equal(err, errno.HashJoinRowsExceeded), err)
+	executors.Release()
+}
+
+func TestHashJoinTransform_UnsupportedCondition(t *testing.T) {
+	inRowDataTypes := []hybridqp.RowDataType{buildInRowDataType(), buildInRowDataType()}
+	joinCase := buildJoinCase()
+	joinCase.JoinType = influxql.InnerJoin
+	joinCase.Condition = influxql.MustParseExpr("m1.tag1 > m2.tag1")
+	_, err := executor.NewHashJoinTransform(inRowDataTypes, buildOutputRowDataType(), joinCase, buildFullJoinSchema())
+	assert.Error(t, err)
+
+	// the join tags must be the group by tags
+	joinCase.Condition = influxql.MustParseExpr("m1.tag2 = m2.tag2")
+	_, err = executor.NewHashJoinTransform(inRowDataTypes, buildOutputRowDataType(), joinCase, buildFullJoinSchema())
+	assert.Error(t, err)
+
+	joinCase.Condition = buildJoinCondition()
+	joinCase.RSrc.(*influxql.SubQuery).Alias = "m1"
+	_, err = executor.NewHashJoinTransform(inRowDataTypes, buildOutputRowDataType(), joinCase, buildFullJoinSchema())
+	assert.Error(t, err)
+}
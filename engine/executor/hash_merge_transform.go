@@ -143,7 +143,7 @@ func (trans *HashMergeTransform) initNewResultFuncs() error {
 		switch dt {
 		case influxql.Float:
 			trans.newResultFuncs = append(trans.newResultFuncs, NewHashMergeFloatColumn)
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			trans.newResultFuncs = append(trans.newResultFuncs, NewHashMergeIntegerColumn)
 		case influxql.Boolean:
 			trans.newResultFuncs = append(trans.newResultFuncs, NewHashMergeBooleanColumn)
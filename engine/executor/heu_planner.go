@@ -961,6 +961,7 @@ func initSqlHeuInstruction() {
 	AddRule(mapDescToRule, NewAggPushDownToSubQueryRule(""))
 	AddRule(mapDescToRule, NewAggToProjectInSubQueryRule(""))
 	AddRule(mapDescToRule, NewReaderUpdateInSubQueryRule(""))
+	AddRule(mapDescToRule, NewFilterPushDownToJoinRule(""))
 
 	AddRule(mapDescToRule, NewLimitPushdownToExchangeRule(""))
 	AddRule(mapDescToRule, NewLimitPushdownToReaderRule(""))
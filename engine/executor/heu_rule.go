@@ -17,6 +17,8 @@ limitations under the License.
 package executor
 
 import (
+	"strings"
+
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/logger"
@@ -33,6 +35,7 @@ var (
 	_ OptRule = &AggPushDownToSubQueryRule{}
 	_ OptRule = &ReaderUpdateInSubQueryRule{}
 	_ OptRule = &AggToProjectInSubQueryRule{}
+	_ OptRule = &FilterPushDownToJoinRule{}
 )
 
 type LimitPushdownToExchangeRule struct {
@@ -1588,3 +1591,160 @@ func (r *IncHashAggRule) OnMatch(call *OptRuleCall) {
 	clone.CountToSum()
 	call.TransformTo(clone)
 }
+
+type FilterPushDownToJoinRule struct {
+	OptRuleBase
+}
+
+func NewFilterPushDownToJoinRule(description string) *FilterPushDownToJoinRule {
+	mr := &FilterPushDownToJoinRule{}
+	if description == "" {
+		description = GetType(mr)
+	}
+
+	builder := NewOptRuleOperandBuilderBase()
+	builder.AnyInput((&LogicalFullJoin{}).Type())
+	input := builder.Operand()
+	builder.OneInput((&LogicalFilter{}).Type(), input)
+
+	mr.Initialize(mr, builder.Operand(), description)
+	return mr
+}
+
+func (r *FilterPushDownToJoinRule) Catagory() OptRuleCatagory {
+	return RULE_SUBQUERY
+}
+
+func (r *FilterPushDownToJoinRule) ToString() string {
+	return GetTypeName(r)
+}
+
+func (r *FilterPushDownToJoinRule) Equals(rhs OptRule) bool {
+	rr, ok := rhs.(*FilterPushDownToJoinRule)
+
+	if !ok {
+		return false
+	}
+
+	if r == rr {
+		return true
+	}
+
+	if r.Catagory() == rr.Catagory() && r.OptRuleBase.Equals(&(rr.OptRuleBase)) {
+		return true
+	}
+
+	return false
+}
+
+// OnMatch pushes the conjuncts of the filter above the join to the inputs of the join. The
+// conjuncts on the tags are pushed to both inputs, because the joined series have the same tags.
+// The conjuncts on the fields of one input are pushed to the input if its rows are not kept by
+// the outer join when they do not match.
+func (r *FilterPushDownToJoinRule) OnMatch(call *OptRuleCall) {
+	filter, ok := call.Node(0).(*LogicalFilter)
+	if !ok {
+		logger.GetLogger().Warn("FilterPushDownToJoinRule OnMatch failed, call Node 0 isn't *LogicalFilter")
+		return
+	}
+	join, ok := call.Node(1).(*LogicalFullJoin)
+	if !ok {
+		logger.GetLogger().Warn("FilterPushDownToJoinRule OnMatch failed, call Node 1 isn't *LogicalFullJoin")
+		return
+	}
+	schema, ok := join.Schema().(*QuerySchema)
+	if !ok || len(schema.joinCases) != 1 {
+		return
+	}
+	joinCase := schema.joinCases[0]
+
+	var pushed [2][]influxql.Expr
+	var remain []influxql.Expr
+	for _, expr := range splitJoinFilterConjuncts(filter.Schema().Options().GetCondition(), nil) {
+		left, right := joinFilterSides(expr, schema, joinCase)
+		switch {
+		case !left && !right:
+			pushed[0] = append(pushed[0], expr)
+			pushed[1] = append(pushed[1], expr)
+		case left && !right && joinCase.JoinType != influxql.FullJoin && joinCase.JoinType != influxql.RightOuterJoin:
+			pushed[0] = append(pushed[0], expr)
+		case right && !left && joinCase.JoinType != influxql.FullJoin && joinCase.JoinType != influxql.LeftOuterJoin:
+			pushed[1] = append(pushed[1], expr)
+		default:
+			remain = append(remain, expr)
+		}
+	}
+	if len(pushed[0]) == 0 && len(pushed[1]) == 0 {
+		return
+	}
+
+	children := join.Children()
+	for i := range pushed {
+		if len(pushed[i]) == 0 {
+			continue
+		}
+		childSchema, ok := children[i].Schema().(*QuerySchema)
+		if !ok {
+			return
+		}
+		children[i] = NewLogicalFilter(children[i], newJoinFilterSchema(childSchema, joinFilterConjuncts(pushed[i])))
+	}
+	var node hybridqp.QueryNode = NewLogicalFullJoin(children[0], children[1], join.condition, join.Schema())
+	if len(remain) > 0 {
+		node = NewLogicalFilter(node, newJoinFilterSchema(filter.Schema().(*QuerySchema), joinFilterConjuncts(remain)))
+	}
+	call.TransformTo(node)
+}
+
+func splitJoinFilterConjuncts(expr influxql.Expr, dst []influxql.Expr) []influxql.Expr {
+	switch e := expr.(type) {
+	case nil:
+		return dst
+	case *influxql.ParenExpr:
+		return splitJoinFilterConjuncts(e.Expr, dst)
+	case *influxql.BinaryExpr:
+		if e.Op == influxql.AND {
+			dst = splitJoinFilterConjuncts(e.LHS, dst)
+			return splitJoinFilterConjuncts(e.RHS, dst)
+		}
+	}
+	return append(dst, expr)
+}
+
+func joinFilterConjuncts(exprs []influxql.Expr) influxql.Expr {
+	cond := exprs[0]
+	for _, expr := range exprs[1:] {
+		cond = &influxql.BinaryExpr{Op: influxql.AND, LHS: cond, RHS: expr}
+	}
+	return cond
+}
+
+// joinFilterSides returns whether the expression refers to the fields of the left and right input of the join.
+func joinFilterSides(expr influxql.Expr, schema *QuerySchema, joinCase *influxql.Join) (bool, bool) {
+	names := make(map[string]string, len(schema.mapping))
+	for k, v := range schema.mapping {
+		if ref, ok := k.(*influxql.VarRef); ok {
+			names[v.Val] = ref.Val
+		}
+	}
+	leftPrefix := joinCase.LSrc.(*influxql.SubQuery).Alias + "."
+	rightPrefix := joinCase.RSrc.(*influxql.SubQuery).Alias + "."
+
+	var left, right bool
+	influxql.WalkFunc(expr, func(n influxql.Node) {
+		ref, ok := n.(*influxql.VarRef)
+		if !ok {
+			return
+		}
+		name := ref.Val
+		if field, ok := names[name]; ok {
+			name = field
+		}
+		if strings.HasPrefix(name, leftPrefix) {
+			left = true
+		} else if strings.HasPrefix(name, rightPrefix) {
+			right = true
+		}
+	})
+	return left, right
+}
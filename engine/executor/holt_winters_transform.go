@@ -636,7 +636,7 @@ func (trans *HoltWintersTransform) appendValue(ocol Column, icol Column, start i
 	datatype := icol.DataType()
 	start = icol.GetValueIndexV2(start)
 	switch datatype {
-	case influxql.Integer:
+	case influxql.Integer, influxql.Unsigned:
 		ocol.AppendIntegerValue(icol.IntegerValue(start))
 	case influxql.Float:
 		ocol.AppendFloatValue(icol.FloatValue(start))
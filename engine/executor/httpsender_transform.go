@@ -43,6 +43,7 @@ type HttpChunkSender struct {
 	opt      *query.ProcessorOptions
 
 	rowsGenerator *RowsGenerator
+	columnTypes   []influxql.DataType
 }
 
 func NewHttpChunkSender(opt *query.ProcessorOptions) *HttpChunkSender {
@@ -121,6 +122,7 @@ func (w *HttpChunkSender) GenRows(chunk Chunk) {
 	}
 
 	statistics.ExecutorStat.SinkRows.Push(int64(chunk.NumberOfRows()))
+	w.initColumnTypes(chunk.RowDataType())
 	rows := w.rowsGenerator.Generate(chunk, w.opt.Location)
 	if w.opt.Except {
 		for i := 0; i < len(rows); i++ {
@@ -169,6 +171,7 @@ func (w *HttpChunkSender) GetRows(chunk Chunk) models.Rows {
 	}
 
 	statistics.ExecutorStat.SinkRows.Push(int64(chunk.NumberOfRows()))
+	w.initColumnTypes(chunk.RowDataType())
 	w.RowChunk.RowsInit(chunk)
 	w.RowChunk.Series = w.RowChunk.RowsGen(chunk)
 	s := w.RowChunk.Series
@@ -226,10 +229,28 @@ func (w *HttpChunkSender) GetRows(chunk Chunk) models.Rows {
 	return w.buffRows
 }
 
+// initColumnTypes keeps the types of the columns of the rows, the time column comes first
+func (w *HttpChunkSender) initColumnTypes(rdt hybridqp.RowDataType) {
+	if w.columnTypes != nil || rdt == nil {
+		return
+	}
+	w.columnTypes = make([]influxql.DataType, 0, len(rdt.Fields())+1)
+	w.columnTypes = append(w.columnTypes, influxql.Time)
+	for _, f := range rdt.Fields() {
+		dType := influxql.Unknown
+		if ref, ok := f.Expr.(*influxql.VarRef); ok {
+			dType = ref.Type
+		}
+		w.columnTypes = append(w.columnTypes, dType)
+	}
+}
+
 func (w *HttpChunkSender) sendRows(rows models.Rows, partial bool) {
 	rc := query.RowsChan{
-		Rows:    rows,
-		Partial: partial,
+		Rows:        rows,
+		Partial:     partial,
+		Dimensions:  w.opt.Dimensions,
+		ColumnTypes: w.columnTypes,
 	}
 
 	if w.opt.AbortChan == nil {
@@ -451,6 +472,8 @@ func (g *RowsGenerator) GetColValue(col2 Column, idx int) interface{} {
 		return col.FloatValue(idx)
 	case influxql.Integer:
 		return col.IntegerValue(idx)
+	case influxql.Unsigned:
+		return uint64(col.IntegerValue(idx))
 	case influxql.Boolean:
 		return col.BooleanValue(idx)
 	case influxql.String, influxql.Tag:
@@ -357,6 +357,27 @@ func TestGetRows(t *testing.T) {
 	}
 }
 
+func TestHttpChunkSender_ColumnTypes(t *testing.T) {
+	fields := mockFieldsAndTags()
+	refs := varRefsFromFields(fields)
+	inRowDataType := hybridqp.NewRowDataTypeImpl(refs...)
+
+	rowsChan := make(chan query.RowsChan, 1)
+	sender := executor.NewHttpChunkSender(&query.ProcessorOptions{
+		ChunkedSize: 10000,
+		Dimensions:  []string{"t1_tag"},
+		RowsChan:    rowsChan,
+	})
+	sender.Write(genChunk(inRowDataType), true)
+
+	rc := <-rowsChan
+	require.NotEmpty(t, rc.Rows)
+	require.Equal(t, []string{"t1_tag"}, rc.Dimensions)
+	require.Equal(t, []influxql.DataType{influxql.Time, influxql.Float, influxql.Integer, influxql.Boolean,
+		influxql.String, influxql.Tag, influxql.Tag}, rc.ColumnTypes)
+	require.Equal(t, len(rc.Rows[0].Columns), len(rc.ColumnTypes))
+}
+
 func BenchmarkHttpChunkSender_GetRows(b *testing.B) {
 	fields := mockFieldsAndTags()
 	refs := varRefsFromFields(fields)
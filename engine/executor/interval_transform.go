@@ -193,7 +193,7 @@ func NewIntervalCoProcessor(rowDataType hybridqp.RowDataType) CoProcessor {
 		switch f.Expr.(*influxql.VarRef).Type {
 		case influxql.Boolean:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewBooleanIntervalIterator(), i, i))
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewIntegerIntervalIterator(), i, i))
 		case influxql.Float:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewFloatIntervalIterator(), i, i))
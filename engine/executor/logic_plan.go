@@ -62,6 +62,7 @@ var (
 	_ LogicalPlan = &LogicalColumnStoreReader{}
 	_ LogicalPlan = &LogicalJoin{}
 	_ LogicalPlan = &LogicalBinOp{}
+	_ LogicalPlan = &LogicalPromSubQuery{}
 )
 
 type AggLevel uint8
@@ -2632,6 +2633,13 @@ func (b *LogicalPlanBuilderImpl) HoltWinters() LogicalPlanBuilder {
 	return b
 }
 
+func (b *LogicalPlanBuilderImpl) PromSubQuery(call *influxql.PromSubCall) LogicalPlanBuilder {
+	last := b.stack.Pop()
+	plan := NewLogicalPromSubQuery(last, b.schema, call)
+	b.stack.Push(plan)
+	return b
+}
+
 func (b *LogicalPlanBuilderImpl) CountDistinct() LogicalPlanBuilder {
 	if b.schema.CountDistinct() != nil {
 		last := b.stack.Pop()
@@ -3695,6 +3703,93 @@ func (p *LogicalHoltWinters) Digest() string {
 	return string(p.digestName)
 }
 
+// LogicalPromSubQuery evaluates a PromQL range function over the result of a subquery at every step.
+type LogicalPromSubQuery struct {
+	LogicalPlanSingle
+	call       *influxql.PromSubCall
+	calls      map[string]*influxql.Call
+	callsOrder []string
+}
+
+func NewLogicalPromSubQuery(input hybridqp.QueryNode, schema hybridqp.Catalog, call *influxql.PromSubCall) *LogicalPromSubQuery {
+	p := &LogicalPromSubQuery{
+		LogicalPlanSingle: *NewLogicalPlanSingle(input, schema),
+		call:              call,
+		calls:             make(map[string]*influxql.Call),
+		callsOrder:        make([]string, 0, len(schema.Calls())),
+	}
+
+	var ok bool
+	for k, c := range p.schema.Calls() {
+		p.calls[k], ok = influxql.CloneExpr(c).(*influxql.Call)
+		if !ok {
+			logger.GetLogger().Warn("NewLogicalPromSubQuery call type isn't *influxql.Call")
+		}
+		p.callsOrder = append(p.callsOrder, k)
+	}
+	sort.Strings(p.callsOrder)
+
+	p.init()
+	return p
+}
+
+// impl me
+func (p *LogicalPromSubQuery) New(inputs []hybridqp.QueryNode, schema hybridqp.Catalog, eTrait []hybridqp.Trait) hybridqp.QueryNode {
+	return nil
+}
+
+func (p *LogicalPromSubQuery) DeriveOperations() {
+	p.init()
+}
+
+func (p *LogicalPromSubQuery) init() {
+	refs := make([]influxql.VarRef, 0, len(p.callsOrder))
+	p.ops = make([]hybridqp.ExprOptions, 0, len(p.callsOrder))
+	for _, k := range p.callsOrder {
+		ref := p.schema.Mapping()[p.schema.Calls()[k]]
+		refs = append(refs, ref)
+		p.ops = append(p.ops, hybridqp.ExprOptions{Expr: influxql.CloneExpr(p.calls[k]), Ref: ref})
+	}
+	p.rt = hybridqp.NewRowDataTypeImpl(refs...)
+}
+
+func (p *LogicalPromSubQuery) Call() *influxql.PromSubCall {
+	return p.call
+}
+
+func (p *LogicalPromSubQuery) Clone() hybridqp.QueryNode {
+	clone := &LogicalPromSubQuery{}
+	*clone = *p
+	clone.calls = make(map[string]*influxql.Call, len(p.calls))
+	for k, c := range p.calls {
+		clone.calls[k] = influxql.CloneExpr(c).(*influxql.Call)
+	}
+	clone.callsOrder = make([]string, len(p.callsOrder))
+	copy(clone.callsOrder, p.callsOrder)
+	clone.id = hybridqp.GenerateNodeId()
+	return clone
+}
+
+func (p *LogicalPromSubQuery) Explain(writer LogicalPlanWriter) {
+	p.ExplainIterms(writer)
+	writer.Explain(p)
+}
+
+func (p *LogicalPromSubQuery) Type() string {
+	return GetType(p)
+}
+
+func (p *LogicalPromSubQuery) Digest() string {
+	if p.digest {
+		return string(p.digestName)
+	}
+	p.digest = true
+	p.digestName = p.digestName[:0]
+	p.digestName = encoding.MarshalUint32(p.digestName, uint32(p.LogicPlanType()))
+	p.digestName = encoding.MarshalUint64(p.digestName, p.inputs[0].ID())
+	return string(p.digestName)
+}
+
 // Digest format: printf("%s(%d)[%d](%s)(%s)", name, typ, id, fields, calls)
 func buildDigest(buf *bytes.Buffer, name string, typ int, id uint64, fields influxql.Fields,
 	calls map[string]*influxql.Call, callsOrder []string) {
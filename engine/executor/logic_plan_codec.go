@@ -290,6 +290,10 @@ func (p *LogicalBinOp) LogicPlanType() internal.LogicPlanType {
 	return internal.LogicPlanType_LogicalBinOp
 }
 
+func (p *LogicalPromSubQuery) LogicPlanType() internal.LogicPlanType {
+	return internal.LogicPlanType_LogicalPromSubQuery
+}
+
 func (p *LogicalHashAgg) String() string {
 	return "LogicalHashAgg"
 }
@@ -453,6 +457,10 @@ func (p *LogicalBinOp) String() string {
 	return "LogicalBinOp"
 }
 
+func (p *LogicalPromSubQuery) String() string {
+	return "LogicalPromSubQuery"
+}
+
 func MarshalBinary(q hybridqp.QueryNode) ([]byte, error) {
 	switch p := q.(type) {
 	case *HeuVertex:
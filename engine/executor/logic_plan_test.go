@@ -29,6 +29,7 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func ComparePlanNode(a, b hybridqp.QueryNode) bool {
@@ -1005,6 +1006,95 @@ func Test_BuildFullJoinQueryPlant(t *testing.T) {
 	}
 }
 
+func buildJoinFilterPlan(t *testing.T, joinType influxql.JoinType, cond string) hybridqp.QueryNode {
+	fields := []*influxql.Field{
+		{Expr: &influxql.VarRef{Val: "m1.f1", Type: influxql.Float}},
+		{Expr: &influxql.VarRef{Val: "m2.f1", Type: influxql.Float}},
+	}
+	newSubQuery := func(alias string) *influxql.SubQuery {
+		return &influxql.SubQuery{Statement: &influxql.SelectStatement{
+			Fields:  []*influxql.Field{{Expr: &influxql.VarRef{Val: "f1", Type: influxql.Float}, Alias: alias + ".f1"}},
+			Sources: []influxql.Source{&influxql.Measurement{Database: "db0", Name: "mst"}},
+		}, Alias: alias}
+	}
+	sources := []influxql.Source{newSubQuery("m1"), newSubQuery("m2")}
+	joinCases := []*influxql.Join{{
+		LSrc:      newSubQuery("m1"),
+		RSrc:      newSubQuery("m2"),
+		Condition: influxql.MustParseExpr("m1.tk1 = m2.tk1"),
+		JoinType:  joinType,
+	}}
+	stmt := &influxql.SelectStatement{
+		Fields:     fields,
+		Sources:    sources,
+		JoinSource: joinCases,
+	}
+	opt := query.ProcessorOptions{Dimensions: []string{"tk1"}, Condition: influxql.MustParseExpr(cond)}
+	schema := executor.NewQuerySchemaWithJoinCase(fields, sources, []string{"tk1", "f1"}, &opt, joinCases, nil, nil)
+	creator := NewMockShardGroup()
+	table := NewTable("mst")
+	table.AddDataTypes(map[string]influxql.DataType{"f1": influxql.Float})
+	creator.AddShard(table)
+	plan, err := executor.BuildFullJoinQueryPlan(context.Background(), creator, stmt, schema)
+	require.NoError(t, err)
+	return plan
+}
+
+func Test_BuildJoinQueryPlanWithFilter(t *testing.T) {
+	plan := buildJoinFilterPlan(t, influxql.InnerJoin, "m1.f1 > 1 AND m2.f1 < 3 AND m1.tk1 = 'a'")
+	filter, ok := plan.(*executor.LogicalFilter)
+	require.True(t, ok)
+	assert.Equal(t, "val0::float > 1 AND val1::float < 3 AND tk1 = 'a'", filter.Schema().Options().GetCondition().String())
+	_, ok = filter.Children()[0].(*executor.LogicalFullJoin)
+	require.True(t, ok)
+
+	cases := []struct {
+		joinType influxql.JoinType
+		left     string
+		right    string
+		remain   string
+	}{
+		{influxql.InnerJoin, "val0::float > 1 AND tk1 = 'a'", "val1::float < 3 AND tk1 = 'a'", ""},
+		{influxql.LeftOuterJoin, "val0::float > 1 AND tk1 = 'a'", "tk1 = 'a'", "val1::float < 3"},
+		{influxql.RightOuterJoin, "tk1 = 'a'", "val1::float < 3 AND tk1 = 'a'", "val0::float > 1"},
+	}
+	for _, c := range cases {
+		plan = buildJoinFilterPlan(t, c.joinType, "m1.f1 > 1 AND m2.f1 < 3 AND m1.tk1 = 'a'")
+		planner := executor.BuildHeuristicPlanner()
+		planner.SetRoot(plan)
+		best := planner.FindBestExp()
+
+		if c.remain != "" {
+			filter, ok := best.(*executor.LogicalFilter)
+			require.True(t, ok, c.joinType.String())
+			assert.Equal(t, c.remain, filter.Schema().Options().GetCondition().String())
+			best = filter.Children()[0]
+		}
+		join, ok := best.(*executor.LogicalFullJoin)
+		require.True(t, ok, c.joinType.String())
+		for i, expected := range []string{c.left, c.right} {
+			filter, ok := join.Children()[i].(*executor.LogicalFilter)
+			require.True(t, ok, c.joinType.String())
+			assert.Equal(t, expected, filter.Schema().Options().GetCondition().String())
+		}
+	}
+
+	// the conjunct on both inputs is kept above the join
+	plan = buildJoinFilterPlan(t, influxql.InnerJoin, "m1.f1 > m2.f1")
+	planner := executor.BuildHeuristicPlanner()
+	planner.SetRoot(plan)
+	_, ok = planner.FindBestExp().(*executor.LogicalFilter)
+	assert.True(t, ok)
+
+	// the condition of the full join is pushed down to both inputs when the plan is built
+	plan = buildJoinFilterPlan(t, influxql.FullJoin, "m1.f1 > 1 AND m1.tk1 = 'a'")
+	_, ok = plan.(*executor.LogicalFullJoin)
+	require.True(t, ok)
+	for _, child := range plan.Children() {
+		assert.NotNil(t, child.Schema().Options().GetCondition())
+	}
+}
+
 func Test_ExplainNode(t *testing.T) {
 	planWriter := executor.NewLogicalPlanWriterImpl(&strings.Builder{})
 	opt := query.ProcessorOptions{}
@@ -253,6 +253,8 @@ func getRowValue(column Column, index int) interface{} {
 	switch column.DataType() {
 	case influxql.Integer:
 		return column.IntegerValue(index)
+	case influxql.Unsigned:
+		return uint64(column.IntegerValue(index))
 	case influxql.Float:
 		return column.FloatValue(index)
 	case influxql.Boolean:
@@ -272,6 +274,12 @@ func AppendRowValue(column Column, value interface{}) {
 		} else {
 			panic("expect integer value")
 		}
+	case influxql.Unsigned:
+		if v, ok := value.(uint64); ok {
+			column.AppendIntegerValue(int64(v))
+		} else {
+			panic("expect unsigned value")
+		}
 	case influxql.Float:
 		if v, ok := value.(float64); ok {
 			column.AppendFloatValue(v)
@@ -326,6 +334,10 @@ func (c *ChunkValuer) Value(key string) (interface{}, bool) {
 
 func (c *ChunkValuer) ValueNormal(key string) (interface{}, bool) {
 	fieldIndex := c.ref.RowDataType().FieldIndex(key)
+	if fieldIndex < 0 && key == "time" {
+		// the time argument of the date functions
+		return c.ref.TimeByIndex(c.index), true
+	}
 	column := c.ref.Columns()[fieldIndex]
 	if column.IsNilV2(c.index) {
 		return nil, false
@@ -399,6 +411,9 @@ func (trans *MaterializeTransform) createTransparents(ops []hybridqp.ExprOptions
 			if err != nil {
 				panic(err)
 			}
+			if f == nil {
+				f = TransString(vr)
+			}
 			transparents[i] = f
 		}
 	}
@@ -429,6 +444,7 @@ func NewMaterializeTransform(inRowDataType hybridqp.RowDataType, outRowDataType
 		Valuer: influxql.MultiValuer(
 			op.Valuer{},
 			query.MathValuer{},
+			query.DateValuer{Location: opt.Location},
 			query.StringValuer{},
 			LabelValuer{},
 			PromTimeValuer{},
@@ -621,6 +637,8 @@ func (re *ResultEval) appendLen(l int, dataType influxql.DataType) {
 		re.appendFloatLen(l)
 	case influxql.Integer:
 		re.appendIntLen(l)
+	case influxql.Unsigned:
+		re.appendUintLen(l)
 	case influxql.Boolean:
 		re.appendBoolLen(l)
 	}
@@ -636,6 +654,8 @@ func (re *ResultEval) copy(column *ColumnImpl) {
 			copy(re.floatValue, column.floatValues)
 		case influxql.Integer:
 			copy(re.integerValue, column.integerValues)
+		case influxql.Unsigned:
+			copy(re.uintValue, unsignedValues(column.integerValues))
 		case influxql.Boolean:
 			copy(re.booleanValue, column.booleanValues)
 		}
@@ -663,6 +683,16 @@ func (re *ResultEval) copy(column *ColumnImpl) {
 			re.integerValue[i] = column.IntegerValue(k)
 			k = k + 1
 		}
+	case influxql.Unsigned:
+		for i := 0; i < column.Length(); i++ {
+			if column.IsNilV2(i) {
+				re.isNil[i] = true
+				continue
+			}
+			re.isNil[i] = false
+			re.uintValue[i] = uint64(column.IntegerValue(k))
+			k = k + 1
+		}
 	case influxql.Boolean:
 		for i := 0; i < column.Length(); i++ {
 			if column.IsNilV2(i) {
@@ -682,6 +712,8 @@ func (res *ResultEval) copyTo(l int, dst *ColumnImpl) {
 		res.copyToForFloat(l, dst)
 	case influxql.Integer:
 		res.copyToForInteger(l, dst)
+	case influxql.Unsigned:
+		res.copyToForUnsigned(l, dst)
 	case influxql.Boolean:
 		res.copyToForBoolean(l, dst)
 	}
@@ -730,6 +762,24 @@ func (res *ResultEval) copyToForInteger(l int, dst *ColumnImpl) {
 	}
 }
 
+func (res *ResultEval) copyToForUnsigned(l int, dst *ColumnImpl) {
+	for index := 0; index < l; {
+		num := 0
+		for index < l && res.IsNil(index) {
+			index += 1
+			num += 1
+		}
+		dst.AppendManyNil(num)
+		num = 0
+		for index < l && !res.IsNil(index) {
+			dst.AppendIntegerValue(int64(res.getUint64(index)))
+			index += 1
+			num += 1
+		}
+		dst.AppendManyNotNil(num)
+	}
+}
+
 func (res *ResultEval) copyToForBoolean(l int, dst *ColumnImpl) {
 	for index := 0; index < l; {
 		num := 0
@@ -949,3 +949,46 @@ func BenchmarkMaterializeTransform(b *testing.B) {
 	executor.Release()
 
 }
+
+func TestMaterializeTransform_StringFunctions(t *testing.T) {
+	outRowDataType := hybridqp.NewRowDataTypeImpl(
+		influxql.VarRef{Val: "upper", Type: influxql.String},
+		influxql.VarRef{Val: "position", Type: influxql.Integer},
+		influxql.VarRef{Val: "starts_with", Type: influxql.Boolean},
+		influxql.VarRef{Val: "concat", Type: influxql.String},
+	)
+	ops := []hybridqp.ExprOptions{
+		{Expr: influxql.MustParseExpr(`upper("name"::string)`), Ref: influxql.VarRef{Val: "upper", Type: influxql.String}},
+		{Expr: influxql.MustParseExpr(`position("name"::string, 'a')`), Ref: influxql.VarRef{Val: "position", Type: influxql.Integer}},
+		{Expr: influxql.MustParseExpr(`starts_with("name"::string, 'a')`), Ref: influxql.VarRef{Val: "starts_with", Type: influxql.Boolean}},
+		{Expr: influxql.MustParseExpr(`concat('@', "name"::string)`), Ref: influxql.VarRef{Val: "concat", Type: influxql.String}},
+	}
+	// the calls over a string column with literal arguments are evaluated over the whole column
+	for i, op := range ops {
+		assert.Equal(t, i < 3, executor.TransString(op.Expr.(*influxql.Call)) != nil)
+	}
+	opt := query.ProcessorOptions{ChunkSize: 100}
+	schema := executor.NewQuerySchema(nil, nil, &opt, nil)
+	trans := executor.NewMaterializeTransform(buildMaterializeInRowDataType(), outRowDataType, ops, &opt, nil, schema)
+
+	var output executor.Chunk
+	source := NewSourceFromSingleChunk(buildMaterializeInRowDataType(), []executor.Chunk{buildMaterializeChunk()})
+	sink := NewSinkFromFunction(outRowDataType, func(chunk executor.Chunk) error {
+		output = chunk.Clone()
+		return nil
+	})
+	executor.Connect(source.Output, trans.GetInputs()[0])
+	executor.Connect(trans.GetOutputs()[0], sink.Input)
+	pipeline := executor.NewPipelineExecutor(executor.Processors{source, trans, sink})
+	assert.NoError(t, pipeline.Execute(context.Background()))
+	pipeline.Release()
+
+	// the name is nil in the third row
+	assert.Equal(t, []string{"ADA", "JERRY", "TOM", "ASHE"}, output.Column(0).StringValuesV2(nil))
+	assert.True(t, output.Column(0).IsNilV2(2))
+	assert.Equal(t, []int64{1, 0, 0, 1}, output.Column(1).IntegerValues())
+	assert.True(t, output.Column(1).IsNilV2(2))
+	assert.Equal(t, []bool{true, false, false, true}, output.Column(2).BooleanValues())
+	assert.Equal(t, []string{"@ada", "@jerry", "@tom", "@ashe"}, output.Column(3).StringValuesV2(nil))
+	assert.True(t, output.Column(3).IsNilV2(2))
+}
@@ -979,7 +979,7 @@ func FixedMergeColumnsIteratorHelper(rowDataType hybridqp.RowDataType) CoProcess
 		switch f.Expr.(*influxql.VarRef).Type {
 		case influxql.Boolean:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewBooleanMergeIterator(), i, i))
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewInt64MergeIterator(), i, i))
 		case influxql.Float:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewFloat64MergeIterator(), i, i))
@@ -998,7 +998,7 @@ func FixedColumnsIteratorHelper(rowDataType hybridqp.RowDataType) CoProcessor {
 		switch f.Expr.(*influxql.VarRef).Type {
 		case influxql.Boolean:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewBooleanLimitIterator(), i, i))
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewInt64LimitIterator(), i, i))
 		case influxql.Float:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewFloat64LimitIterator(), i, i))
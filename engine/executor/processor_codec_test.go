@@ -51,7 +51,7 @@ func TestProcessorCodec(t *testing.T) {
 		FieldAux:    nil,
 		TagAux:      nil,
 		Sources:     nil,
-		Interval:    hybridqp.Interval{Duration: 5, Offset: 100},
+		Interval:    hybridqp.Interval{Duration: 5, Offset: 100, Months: 2},
 		Dimensions:  []string{"id", "tid"},
 		GroupBy:     map[string]struct{}{"id": {}, "tid": {}},
 		Location:    time.FixedZone("Asia/Shanghai", 0),
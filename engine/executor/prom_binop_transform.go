@@ -102,7 +102,8 @@ type BinOpTransform struct {
 	primaryLoc        int
 	secondaryLoc      int
 	primaryChunks     []Chunk
-	primaryMap        map[string]*GroupLocs // <matchGroupKey, tagsGroupsPerMatchGroup>
+	primaryMap        map[string]*GroupLocs         // <matchGroupKey, tagsGroupsPerMatchGroup>
+	primaryTimes      map[string]map[int64]struct{} // <matchGroupKey, times of points>, used by set operations
 	resultMap         map[string]interface{}
 	preTags           string
 	primaryChunkNum   int
@@ -149,6 +150,7 @@ func NewBinOpTransform(inRowDataTypes []hybridqp.RowDataType, outRowDataType hyb
 		opt:               schema.opt.(*query.ProcessorOptions),
 		streamBinOpLogger: logger.NewLogger(errno.ModuleQueryEngine),
 		primaryMap:        make(map[string]*GroupLocs),
+		primaryTimes:      make(map[string]map[int64]struct{}),
 		resultMap:         make(map[string]interface{}),
 		resultTagKeys:     make([]string, 0),
 		resultTagValues:   make([]string, 0),
@@ -390,9 +392,27 @@ func (trans *BinOpTransform) addPrimaryMatchTags(tags ChunkTags, loc, startRow i
 	return nil
 }
 
-func (trans *BinOpTransform) addPrimaryMatchTagsSimple(tags ChunkTags, loc, startRow int) {
+// addPrimaryMatchTagsSimple records the times of the points by the match tags,
+// set operations match the points of both sides at the same time.
+func (trans *BinOpTransform) addPrimaryMatchTagsSimple(c Chunk, tags ChunkTags, loc int) {
 	matchTags, _, _ := trans.computeMatchTags(tags)
-	trans.primaryMap[matchTags] = nil
+	times, ok := trans.primaryTimes[matchTags]
+	if !ok {
+		times = make(map[int64]struct{})
+		trans.primaryTimes[matchTags] = times
+	}
+	start, end := trans.groupRange(c, loc)
+	for _, t := range c.Time()[start:end] {
+		times[t] = struct{}{}
+	}
+}
+
+func (trans *BinOpTransform) groupRange(c Chunk, loc int) (int, int) {
+	start := c.TagIndex()[loc]
+	if loc == c.TagLen()-1 {
+		return start, c.Len()
+	}
+	return start, c.TagIndex()[loc+1]
 }
 
 func (trans *BinOpTransform) addPrimaryMap(c Chunk) error {
@@ -412,16 +432,14 @@ func (trans *BinOpTransform) addPrimaryMap(c Chunk) error {
 // 1.no copy; 2.no primayMap dup check 3.no primaryGroupLocs to mark
 func (trans *BinOpTransform) addPrimaryMapSimple(c Chunk) {
 	for i := range c.Tags() {
-		startRow := c.TagIndex()[i]
-		trans.addPrimaryMatchTagsSimple(c.Tags()[i], i, startRow)
+		trans.addPrimaryMatchTagsSimple(c, c.Tags()[i], i)
 	}
 }
 
 func (trans *BinOpTransform) addPrimaryMapAndResultSimple(c Chunk) {
 	for i := range c.Tags() {
-		startRow := c.TagIndex()[i]
-		trans.addPrimaryMatchTagsSimple(c.Tags()[i], i, startRow)
-		trans.computeMatchResultSimple(c, i, &c.Tags()[i])
+		trans.addPrimaryMatchTagsSimple(c, c.Tags()[i], i)
+		trans.computeMatchResultSimple(c, i, &c.Tags()[i], nil)
 	}
 }
 
@@ -452,14 +470,14 @@ func (trans *BinOpTransform) AddResult(secondaryChunk Chunk) error {
 
 // 1.no compute resultTags; 2.no computeMatchResult by both side input; 3.no resultMap dup err check
 func (trans *BinOpTransform) AddResultSimple(secondaryChunk Chunk) {
-	var ok bool
 	tags := secondaryChunk.Tags()
 	for i := range tags {
 		matchTags, _, _ := trans.computeMatchTags(tags[i])
-		if _, ok = trans.primaryMap[matchTags]; ok == trans.skipFlag {
+		times, ok := trans.primaryTimes[matchTags]
+		if !ok && !trans.skipFlag {
 			continue
 		}
-		trans.computeMatchResultSimple(secondaryChunk, i, &tags[i])
+		trans.computeMatchResultSimple(secondaryChunk, i, &tags[i], times)
 	}
 }
 
@@ -510,16 +528,28 @@ func (trans *BinOpTransform) computeMatchResult(primaryGroups *GroupLocs, second
 	return nil
 }
 
-func (trans *BinOpTransform) computeMatchResultSimple(secondaryChunk Chunk, secondaryGroupLoc int, tags *ChunkTags) {
-	trans.addOutPutTagsSimple(tags)
-	var start, end int
-	start = secondaryChunk.TagIndex()[secondaryGroupLoc]
-	if secondaryGroupLoc == secondaryChunk.TagLen()-1 {
-		end = secondaryChunk.Len()
-	} else {
-		end = secondaryChunk.TagIndex()[secondaryGroupLoc+1]
+// computeMatchResultSimple outputs the points of the group whose time is in the primary times or not,
+// depending on the skipFlag. All the points are output if times is nil.
+func (trans *BinOpTransform) computeMatchResultSimple(secondaryChunk Chunk, secondaryGroupLoc int, tags *ChunkTags, times map[int64]struct{}) {
+	start, end := trans.groupRange(secondaryChunk, secondaryGroupLoc)
+	if times == nil {
+		trans.addOutPutTagsSimple(tags, trans.outputChunk.Len())
+		trans.addOutputVals(secondaryChunk.Time()[start:end], secondaryChunk.Columns()[0].FloatValues()[start:end])
+		trans.SendChunk()
+		return
+	}
+	preOutSize := trans.outputChunk.Len()
+	values := secondaryChunk.Columns()[0].FloatValues()
+	for ; start < end; start++ {
+		t := secondaryChunk.Time()[start]
+		if _, ok := times[t]; ok == trans.skipFlag {
+			continue
+		}
+		trans.addOutputVal(t, values[start])
+	}
+	if trans.outputChunk.Len() > preOutSize {
+		trans.addOutPutTagsSimple(tags, preOutSize)
 	}
-	trans.addOutputVals(secondaryChunk.Time()[start:end], secondaryChunk.Columns()[0].FloatValues()[start:end])
 	trans.SendChunk()
 }
 
@@ -532,10 +562,10 @@ func (trans *BinOpTransform) addOutPutTags(preOutSize int) {
 	trans.outputChunk.AddIntervalIndex(preOutSize)
 }
 
-func (trans *BinOpTransform) addOutPutTagsSimple(tags *ChunkTags) {
+func (trans *BinOpTransform) addOutPutTagsSimple(tags *ChunkTags, preOutSize int) {
 	tagSet := NewChunkTagsDeepCopy(tags.subset, tags.offsets)
-	trans.outputChunk.AddTagAndIndex(*tagSet, trans.outputChunk.Len())
-	trans.outputChunk.AddIntervalIndex(trans.outputChunk.Len())
+	trans.outputChunk.AddTagAndIndex(*tagSet, preOutSize)
+	trans.outputChunk.AddIntervalIndex(preOutSize)
 }
 
 func BinOpADD(lVal, rVal float64) (float64, bool) {
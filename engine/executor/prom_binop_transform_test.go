@@ -768,3 +768,100 @@ func TestPromBinOpTransform27(t *testing.T) {
 	}
 	PromBinOpTransformTestBase(t, []executor.Chunk{chunk1}, []executor.Chunk{chunk2}, para, BuildBinOpResult13()[0])
 }
+
+func BuildBinOpRangeInChunk1() executor.Chunk {
+	rowDataType := buildPromBinOpOutputRowDataType()
+	b := executor.NewChunkBuilder(rowDataType)
+	chunk := b.NewChunk("m1")
+	chunk.AppendTimes([]int64{1, 2, 3, 1, 2, 3})
+	chunk.AddTagAndIndex(*ParseChunkTags("tk1=1,tk3=1"), 0)
+	chunk.AddTagAndIndex(*ParseChunkTags("tk1=1,tk3=3"), 3)
+	chunk.AddIntervalIndex(0)
+	chunk.AddIntervalIndex(3)
+	chunk.Column(0).AppendFloatValues([]float64{1, 2, 3, 4, 5, 6})
+	chunk.Column(0).AppendManyNotNil(6)
+	return chunk
+}
+
+func BuildBinOpRangeInChunk2() executor.Chunk {
+	rowDataType := buildPromBinOpOutputRowDataType()
+	b := executor.NewChunkBuilder(rowDataType)
+	chunk := b.NewChunk("m2")
+	chunk.AppendTimes([]int64{2, 1, 2})
+	chunk.AddTagAndIndex(*ParseChunkTags("tk2=2,tk3=3"), 0)
+	chunk.AddTagAndIndex(*ParseChunkTags("tk2=2,tk3=5"), 1)
+	chunk.AddIntervalIndex(0)
+	chunk.AddIntervalIndex(1)
+	chunk.Column(0).AppendFloatValues([]float64{10, 20, 30})
+	chunk.Column(0).AppendManyNotNil(3)
+	return chunk
+}
+
+func PromBinOpTransformCollect(chunks1, chunks2 []executor.Chunk, para *influxql.BinOp) []executor.Chunk {
+	source1 := NewSourceFromMultiChunk(chunks1[0].RowDataType(), chunks1)
+	source2 := NewSourceFromMultiChunk(chunks2[0].RowDataType(), chunks2)
+	inRowDataTypes := []hybridqp.RowDataType{source1.Output.RowDataType, source2.Output.RowDataType}
+	outRowDataType := buildPromBinOpOutputRowDataType()
+	trans, err := executor.NewBinOpTransform(inRowDataTypes, outRowDataType, buildPromBinOpSchema(), para)
+	if err != nil {
+		panic(err)
+	}
+	var outputs []executor.Chunk
+	sink := NewSinkFromFunction(outRowDataType, func(chunk executor.Chunk) error {
+		outputs = append(outputs, chunk.Clone())
+		return nil
+	})
+	executor.Connect(source1.Output, trans.GetInputs()[0])
+	executor.Connect(source2.Output, trans.GetInputs()[1])
+	executor.Connect(trans.GetOutputs()[0], sink.Input)
+	executors := executor.NewPipelineExecutor(executor.Processors{source1, source2, trans, sink})
+	executors.Execute(context.Background())
+	executors.Release()
+	return outputs
+}
+
+// set operations match the points of both sides at the same time in range queries
+func TestPromBinOpTransformSetOperationOfRangeQuery(t *testing.T) {
+	b := executor.NewChunkBuilder(buildPromBinOpOutputRowDataType())
+
+	// vector and on(tk3) vector
+	and := b.NewChunk("")
+	and.AppendTimes([]int64{2})
+	and.AddTagAndIndex(*ParseChunkTags("tk1=1,tk3=3"), 0)
+	AppendFloatValues(and, 0, []float64{5}, []bool{true})
+
+	// vector unless on(tk3) vector
+	unless := b.NewChunk("")
+	unless.AppendTimes([]int64{1, 2, 3, 1, 3})
+	unless.AddTagAndIndex(*ParseChunkTags("tk1=1,tk3=1"), 0)
+	unless.AddTagAndIndex(*ParseChunkTags("tk1=1,tk3=3"), 3)
+	AppendFloatValues(unless, 0, []float64{1, 2, 3, 4, 6}, []bool{true, true, true, true, true})
+
+	// vector or on(tk3) vector
+	or := b.NewChunk("")
+	or.AppendTimes([]int64{1, 2, 3, 1, 2, 3, 1, 2})
+	or.AddTagAndIndex(*ParseChunkTags("tk1=1,tk3=1"), 0)
+	or.AddTagAndIndex(*ParseChunkTags("tk1=1,tk3=3"), 3)
+	or.AddTagAndIndex(*ParseChunkTags("tk2=2,tk3=5"), 6)
+	AppendFloatValues(or, 0, []float64{1, 2, 3, 4, 5, 6, 20, 30}, []bool{true, true, true, true, true, true, true, true})
+
+	for opType, expect := range map[int]executor.Chunk{parser.LAND: and, parser.LUNLESS: unless, parser.LOR: or} {
+		para := &influxql.BinOp{
+			OpType:    opType,
+			On:        true,
+			MatchKeys: []string{"tk3"},
+			MatchCard: influxql.ManyToMany,
+		}
+		outputs := PromBinOpTransformCollect([]executor.Chunk{BuildBinOpRangeInChunk1()}, []executor.Chunk{BuildBinOpRangeInChunk2()}, para)
+		if assert.Equal(t, 1, len(outputs)) {
+			PromResultCompare(outputs[0], expect, t)
+		}
+	}
+
+	// vector and on(tk3) vector without the points at the same time
+	para := &influxql.BinOp{OpType: parser.LAND, On: true, MatchKeys: []string{"tk3"}, MatchCard: influxql.ManyToMany}
+	chunk2 := BuildBinOpRangeInChunk2()
+	chunk2.ResetTime(0, 4)
+	outputs := PromBinOpTransformCollect([]executor.Chunk{BuildBinOpRangeInChunk1()}, []executor.Chunk{chunk2}, para)
+	assert.Equal(t, 0, len(outputs))
+}
@@ -0,0 +1,532 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+)
+
+const (
+	PromSubQueryTransformName = "PromSubQueryTransform"
+)
+
+// promSubQueryFunc computes the value of a range function at the evaluation time ts
+// over the points of one series within the range of the subquery.
+type promSubQueryFunc func(times []int64, values []float64, ts int64) (float64, bool)
+
+type promSubQuerySeries struct {
+	name   string
+	tags   ChunkTags
+	times  []int64
+	values []float64
+}
+
+func (s *promSubQuerySeries) Len() int {
+	return len(s.times)
+}
+
+func (s *promSubQuerySeries) Less(i, j int) bool {
+	return s.times[i] < s.times[j]
+}
+
+func (s *promSubQuerySeries) Swap(i, j int) {
+	s.times[i], s.times[j] = s.times[j], s.times[i]
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+}
+
+// PromSubQueryTransform buffers the points of the subquery per series and evaluates
+// the range function at every step of the outer query once the input is drained.
+type PromSubQueryTransform struct {
+	BaseProcessor
+
+	input       *ChunkPort
+	output      *ChunkPort
+	builder     *ChunkBuilder
+	outputChunk Chunk
+	opt         *query.ProcessorOptions
+	call        *influxql.PromSubCall
+	fn          promSubQueryFunc
+	inOrdinal   int
+
+	series    []*promSubQuerySeries
+	seriesIdx map[string]int
+
+	workTracing *tracing.Span
+}
+
+type PromSubQueryTransformCreator struct {
+}
+
+func (c *PromSubQueryTransformCreator) Create(plan LogicalPlan, opt *query.ProcessorOptions) (Processor, error) {
+	p, ok := plan.(*LogicalPromSubQuery)
+	if !ok {
+		return nil, fmt.Errorf("logicalplan isnot promSubQuery")
+	}
+	return NewPromSubQueryTransform(plan.Children()[0].RowDataType(), plan.RowDataType(), plan.RowExprOptions(), opt, p.Call())
+}
+
+var _ = RegistryTransformCreator(&LogicalPromSubQuery{}, &PromSubQueryTransformCreator{})
+
+func NewPromSubQueryTransform(inRowDataType, outRowDataType hybridqp.RowDataType, ops []hybridqp.ExprOptions,
+	opt *query.ProcessorOptions, call *influxql.PromSubCall) (*PromSubQueryTransform, error) {
+	if len(ops) != 1 {
+		return nil, errno.NewError(errno.UnsupportedPromExpr)
+	}
+	expr, ok := ops[0].Expr.(*influxql.Call)
+	if !ok || len(expr.Args) == 0 {
+		return nil, errno.NewError(errno.UnsupportedPromExpr)
+	}
+	ref, ok := expr.Args[0].(*influxql.VarRef)
+	if !ok {
+		return nil, fmt.Errorf("expected field argument in %s()", expr.Name)
+	}
+	inOrdinal := inRowDataType.FieldIndex(ref.Val)
+	if inOrdinal < 0 {
+		return nil, fmt.Errorf("%s is not exist in input row data type", ref.Val)
+	}
+	fn, err := newPromSubQueryFunc(expr, call)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PromSubQueryTransform{
+		input:     NewChunkPort(inRowDataType),
+		output:    NewChunkPort(outRowDataType),
+		builder:   NewChunkBuilder(outRowDataType),
+		opt:       opt,
+		call:      call,
+		fn:        fn,
+		inOrdinal: inOrdinal,
+		seriesIdx: make(map[string]int),
+	}, nil
+}
+
+func (trans *PromSubQueryTransform) Name() string {
+	return PromSubQueryTransformName
+}
+
+func (trans *PromSubQueryTransform) Explain() []ValuePair {
+	return nil
+}
+
+func (trans *PromSubQueryTransform) Close() {
+	trans.output.Close()
+}
+
+func (trans *PromSubQueryTransform) Release() error {
+	return nil
+}
+
+func (trans *PromSubQueryTransform) Work(ctx context.Context) error {
+	span := trans.StartSpan("[PromSubQuery]TotalWorkCost", false)
+	trans.workTracing = tracing.Start(span, "cost_for_prom_subquery", false)
+	defer func() {
+		trans.Close()
+		tracing.Finish(span, trans.workTracing)
+	}()
+
+	for {
+		select {
+		case chunk, ok := <-trans.input.State:
+			if !ok {
+				tracing.SpanElapsed(trans.workTracing, trans.evaluate)
+				return nil
+			}
+			tracing.SpanElapsed(trans.workTracing, func() {
+				trans.append(chunk)
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (trans *PromSubQueryTransform) append(chunk Chunk) {
+	col := chunk.Column(trans.inOrdinal)
+	tagIndex := chunk.TagIndex()
+	for i, tags := range chunk.Tags() {
+		start, end := tagIndex[i], chunk.Len()
+		if i < len(tagIndex)-1 {
+			end = tagIndex[i+1]
+		}
+		s := trans.getSeries(chunk.Name(), tags)
+		for j := start; j < end; j++ {
+			if col.IsNilV2(j) {
+				continue
+			}
+			idx := col.GetValueIndexV2(j)
+			switch col.DataType() {
+			case influxql.Float:
+				s.values = append(s.values, col.FloatValue(idx))
+			case influxql.Integer:
+				s.values = append(s.values, float64(col.IntegerValue(idx)))
+			case influxql.Unsigned:
+				s.values = append(s.values, float64(uint64(col.IntegerValue(idx))))
+			default:
+				continue
+			}
+			s.times = append(s.times, chunk.TimeByIndex(j))
+		}
+	}
+}
+
+func (trans *PromSubQueryTransform) getSeries(name string, tags ChunkTags) *promSubQuerySeries {
+	key := name + string(tags.subset)
+	if i, ok := trans.seriesIdx[key]; ok {
+		return trans.series[i]
+	}
+	s := &promSubQuerySeries{name: name, tags: *NewChunkTagsDeepCopy(tags.subset, tags.offsets)}
+	trans.seriesIdx[key] = len(trans.series)
+	trans.series = append(trans.series, s)
+	return s
+}
+
+func (trans *PromSubQueryTransform) evaluate() {
+	step := trans.call.Interval
+	rng, offset := int64(trans.call.Range), int64(trans.call.Offset)
+	for _, s := range trans.series {
+		if !sort.IsSorted(s) {
+			sort.Stable(s)
+		}
+		var start, end int
+		hasTags := false
+		for ts := trans.call.StartTime; ts <= trans.call.EndTime; ts += step {
+			// the points within [ts-offset-range, ts-offset]
+			for start < len(s.times) && s.times[start] < ts-offset-rng {
+				start++
+			}
+			for end < len(s.times) && s.times[end] <= ts-offset {
+				end++
+			}
+			if start < end {
+				if v, ok := trans.fn(s.times[start:end], s.values[start:end], ts); ok {
+					trans.appendPoint(s, ts, v, &hasTags)
+				}
+			}
+			if step <= 0 {
+				break
+			}
+		}
+	}
+	if trans.outputChunk != nil && trans.outputChunk.Len() > 0 {
+		trans.output.State <- trans.outputChunk
+	}
+	trans.outputChunk = nil
+}
+
+func (trans *PromSubQueryTransform) appendPoint(s *promSubQuerySeries, ts int64, v float64, hasTags *bool) {
+	if trans.outputChunk != nil && (trans.outputChunk.Len() >= trans.opt.ChunkSize || trans.outputChunk.Name() != s.name) {
+		trans.output.State <- trans.outputChunk
+		trans.outputChunk = nil
+	}
+	if trans.outputChunk == nil {
+		trans.outputChunk = trans.builder.NewChunk(s.name)
+		*hasTags = false
+	}
+	if !*hasTags {
+		trans.outputChunk.AddTagAndIndex(s.tags, trans.outputChunk.Len())
+		trans.outputChunk.AddIntervalIndex(trans.outputChunk.Len())
+		*hasTags = true
+	}
+	trans.outputChunk.AppendTime(ts)
+	col := trans.outputChunk.Column(0)
+	if col.DataType() == influxql.Integer {
+		col.AppendIntegerValue(int64(v))
+	} else {
+		col.AppendFloatValue(v)
+	}
+	col.AppendManyNotNil(1)
+}
+
+func (trans *PromSubQueryTransform) GetOutputs() Ports {
+	return Ports{trans.output}
+}
+
+func (trans *PromSubQueryTransform) GetInputs() Ports {
+	return Ports{trans.input}
+}
+
+func (trans *PromSubQueryTransform) GetOutputNumber(_ Port) int {
+	return 0
+}
+
+func (trans *PromSubQueryTransform) GetInputNumber(_ Port) int {
+	return 0
+}
+
+func promSubQueryLiteral(call *influxql.Call, i int) (float64, error) {
+	if len(call.Args) <= i {
+		return 0, fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", call.Name, i+1, len(call.Args))
+	}
+	switch arg := call.Args[i].(type) {
+	case *influxql.NumberLiteral:
+		return arg.Val, nil
+	case *influxql.IntegerLiteral:
+		return float64(arg.Val), nil
+	default:
+		return 0, errno.NewError(errno.UnsupportedDataType, "argument of "+call.Name, arg.String())
+	}
+}
+
+func newPromSubQueryFunc(call *influxql.Call, sub *influxql.PromSubCall) (promSubQueryFunc, error) {
+	switch call.Name {
+	case "sum_over_time":
+		return promSumOverTime, nil
+	case "avg_over_time":
+		return promAvgOverTime, nil
+	case "max_over_time":
+		return promMaxOverTime, nil
+	case "min_over_time":
+		return promMinOverTime, nil
+	case "count_over_time":
+		return promCountOverTime, nil
+	case "last_over_time":
+		return func(_ []int64, values []float64, _ int64) (float64, bool) {
+			return values[len(values)-1], true
+		}, nil
+	case "present_over_time":
+		return func(_ []int64, _ []float64, _ int64) (float64, bool) {
+			return 1, true
+		}, nil
+	case "stddev_over_time":
+		return func(_ []int64, values []float64, _ int64) (float64, bool) {
+			return math.Sqrt(PromStdvar(values)), true
+		}, nil
+	case "stdvar_over_time":
+		return func(_ []int64, values []float64, _ int64) (float64, bool) {
+			return PromStdvar(values), true
+		}, nil
+	case "changes":
+		return func(_ []int64, values []float64, _ int64) (float64, bool) {
+			return PromChanges(values), true
+		}, nil
+	case "resets":
+		return func(_ []int64, values []float64, _ int64) (float64, bool) {
+			return PromResets(values), true
+		}, nil
+	case "quantile_over_time":
+		q, err := promSubQueryLiteral(call, 1)
+		if err != nil {
+			return nil, err
+		}
+		return func(_ []int64, values []float64, _ int64) (float64, bool) {
+			return PromQuantile(q, values), true
+		}, nil
+	case "holt_winters_prom":
+		sf, err := promSubQueryLiteral(call, 1)
+		if err != nil {
+			return nil, err
+		}
+		tf, err := promSubQueryLiteral(call, 2)
+		if err != nil {
+			return nil, err
+		}
+		return func(_ []int64, values []float64, _ int64) (float64, bool) {
+			return PromHoltWinters(values, sf, tf)
+		}, nil
+	case "rate_prom":
+		return promExtrapolatedRate(sub, true, true), nil
+	case "increase":
+		return promExtrapolatedRate(sub, true, false), nil
+	case "delta_prom":
+		return promExtrapolatedRate(sub, false, false), nil
+	case "irate_prom":
+		return promInstantValue(true), nil
+	case "idelta_prom":
+		return promInstantValue(false), nil
+	case "deriv":
+		return promDeriv, nil
+	case "predict_linear":
+		duration, err := promSubQueryLiteral(call, 1)
+		if err != nil {
+			return nil, err
+		}
+		return func(times []int64, values []float64, ts int64) (float64, bool) {
+			if len(values) < 2 {
+				return 0, false
+			}
+			slope, intercept := promLinearRegression(times, values, ts)
+			return slope*duration + intercept, true
+		}, nil
+	default:
+		return nil, errno.NewError(errno.UnsupportedPromExpr)
+	}
+}
+
+func promSumOverTime(_ []int64, values []float64, _ int64) (float64, bool) {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum, true
+}
+
+func promAvgOverTime(_ []int64, values []float64, _ int64) (float64, bool) {
+	var mean, count float64
+	for _, v := range values {
+		count++
+		if math.IsInf(mean, 0) {
+			if math.IsInf(v, 0) && (mean > 0) == (v > 0) {
+				continue
+			}
+			if !math.IsInf(v, 0) && !math.IsNaN(v) {
+				continue
+			}
+		}
+		mean += v/count - mean/count
+	}
+	return mean, true
+}
+
+func promMaxOverTime(_ []int64, values []float64, _ int64) (float64, bool) {
+	max := values[0]
+	for _, v := range values[1:] {
+		if v > max || math.IsNaN(max) {
+			max = v
+		}
+	}
+	return max, true
+}
+
+func promMinOverTime(_ []int64, values []float64, _ int64) (float64, bool) {
+	min := values[0]
+	for _, v := range values[1:] {
+		if v < min || math.IsNaN(min) {
+			min = v
+		}
+	}
+	return min, true
+}
+
+func promCountOverTime(_ []int64, values []float64, _ int64) (float64, bool) {
+	return float64(len(values)), true
+}
+
+// promExtrapolatedRate implements rate, increase and delta, extrapolating the result
+// to the edges of the range when the samples do not cover it.
+func promExtrapolatedRate(sub *influxql.PromSubCall, isCounter, isRate bool) promSubQueryFunc {
+	return func(times []int64, values []float64, ts int64) (float64, bool) {
+		if len(values) < 2 {
+			return 0, false
+		}
+		rangeStart := ts - int64(sub.Range+sub.Offset)
+		rangeEnd := ts - int64(sub.Offset)
+		first, last := 0, len(values)-1
+
+		resultValue := values[last] - values[first]
+		if isCounter {
+			var lastValue float64
+			for _, v := range values {
+				if v < lastValue {
+					resultValue += lastValue
+				}
+				lastValue = v
+			}
+		}
+
+		durationToStart := float64(times[first]-rangeStart) / float64(time.Second)
+		durationToEnd := float64(rangeEnd-times[last]) / float64(time.Second)
+		sampledInterval := float64(times[last]-times[first]) / float64(time.Second)
+		averageDurationBetweenSamples := sampledInterval / float64(len(values)-1)
+
+		if isCounter && resultValue > 0 && values[first] >= 0 {
+			// counters cannot be negative, so do not extrapolate below zero
+			durationToZero := sampledInterval * (values[first] / resultValue)
+			if durationToZero < durationToStart {
+				durationToStart = durationToZero
+			}
+		}
+
+		extrapolationThreshold := averageDurationBetweenSamples * 1.1
+		extrapolateToInterval := sampledInterval
+		if durationToStart < extrapolationThreshold {
+			extrapolateToInterval += durationToStart
+		} else {
+			extrapolateToInterval += averageDurationBetweenSamples / 2
+		}
+		if durationToEnd < extrapolationThreshold {
+			extrapolateToInterval += durationToEnd
+		} else {
+			extrapolateToInterval += averageDurationBetweenSamples / 2
+		}
+		resultValue = resultValue * (extrapolateToInterval / sampledInterval)
+		if isRate {
+			resultValue = resultValue / sub.Range.Seconds()
+		}
+		return resultValue, true
+	}
+}
+
+// promInstantValue implements irate and idelta from the last two samples.
+func promInstantValue(isRate bool) promSubQueryFunc {
+	return func(times []int64, values []float64, _ int64) (float64, bool) {
+		if len(values) < 2 {
+			return 0, false
+		}
+		last, prev := len(values)-1, len(values)-2
+		resultValue := values[last] - values[prev]
+		if isRate && values[last] < values[prev] {
+			// counter reset
+			resultValue = values[last]
+		}
+		sampledInterval := times[last] - times[prev]
+		if sampledInterval == 0 {
+			return 0, false
+		}
+		if isRate {
+			resultValue /= float64(sampledInterval) / float64(time.Second)
+		}
+		return resultValue, true
+	}
+}
+
+func promDeriv(times []int64, values []float64, _ int64) (float64, bool) {
+	if len(values) < 2 {
+		return 0, false
+	}
+	slope, _ := promLinearRegression(times, values, times[0])
+	return slope, true
+}
+
+// promLinearRegression returns the least squares slope per second and the intercept at interceptTime.
+func promLinearRegression(times []int64, values []float64, interceptTime int64) (float64, float64) {
+	var n, sumX, sumY, sumXY, sumX2 float64
+	for i, v := range values {
+		x := float64(times[i]-interceptTime) / float64(time.Second)
+		n += 1.0
+		sumY += v
+		sumX += x
+		sumXY += x * v
+		sumX2 += x * x
+	}
+	covXY := sumXY - sumX*sumY/n
+	varX := sumX2 - sumX*sumX/n
+
+	slope := covXY / varX
+	intercept := sumY/n - slope*sumX/n
+	return slope, intercept
+}
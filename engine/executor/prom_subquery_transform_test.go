@@ -0,0 +1,208 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type promSubQueryPoint struct {
+	tags  string
+	time  int64
+	value float64
+}
+
+func buildPromSubQueryInChunk(name string, tags []string, times [][]int64, values [][]float64) executor.Chunk {
+	rt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "value", Type: influxql.Float})
+	chunk := executor.NewChunkBuilder(rt).NewChunk(name)
+	for i := range tags {
+		chunk.AddTagAndIndex(*ParseChunkTags(tags[i]), chunk.Len())
+		chunk.AddIntervalIndex(chunk.Len())
+		for j := range times[i] {
+			chunk.AppendTime(times[i][j] * int64(time.Second))
+		}
+		chunk.Column(0).AppendFloatValues(values[i])
+		chunk.Column(0).AppendManyNotNil(len(values[i]))
+	}
+	return chunk
+}
+
+func testPromSubQueryTransform(t *testing.T, call *influxql.Call, sub *influxql.PromSubCall, chunks ...executor.Chunk) []promSubQueryPoint {
+	inRt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "value", Type: influxql.Float})
+	ref := influxql.VarRef{Val: "val0", Type: influxql.Float}
+	outRt := hybridqp.NewRowDataTypeImpl(ref)
+	ops := []hybridqp.ExprOptions{{Expr: call, Ref: ref}}
+	opt := &query.ProcessorOptions{ChunkSize: 2, PromQuery: true}
+
+	trans, err := executor.NewPromSubQueryTransform(inRt, outRt, ops, opt, sub)
+	require.NoError(t, err)
+
+	var points []promSubQueryPoint
+	source := NewSourceFromMultiChunk(inRt, chunks)
+	sink := NewSinkFromFunction(outRt, func(chunk executor.Chunk) error {
+		tagIndex := chunk.TagIndex()
+		for i, tags := range chunk.Tags() {
+			end := chunk.Len()
+			if i < len(tagIndex)-1 {
+				end = tagIndex[i+1]
+			}
+			for j := tagIndex[i]; j < end; j++ {
+				points = append(points, promSubQueryPoint{
+					tags:  string(tags.GetTag()),
+					time:  chunk.TimeByIndex(j) / int64(time.Second),
+					value: chunk.Column(0).FloatValue(j),
+				})
+			}
+		}
+		return nil
+	})
+	executor.Connect(source.Output, trans.GetInputs()[0])
+	executor.Connect(trans.GetOutputs()[0], sink.Input)
+	processors := executor.Processors{source, trans, sink}
+	executors := executor.NewPipelineExecutor(processors)
+	require.NoError(t, executors.Execute(context.Background()))
+	executors.Release()
+	return points
+}
+
+func promSubCall(start, end, step, rng, offset int64) *influxql.PromSubCall {
+	return &influxql.PromSubCall{
+		StartTime: start * int64(time.Second),
+		EndTime:   end * int64(time.Second),
+		Interval:  step * int64(time.Second),
+		Range:     time.Duration(rng) * time.Second,
+		Offset:    time.Duration(offset) * time.Second,
+	}
+}
+
+func promSubQueryValues(points []promSubQueryPoint) []float64 {
+	values := make([]float64, 0, len(points))
+	for _, p := range points {
+		values = append(values, p.value)
+	}
+	return values
+}
+
+func TestPromSubQueryTransform_OverTime(t *testing.T) {
+	value := &influxql.VarRef{Val: "value", Type: influxql.Float}
+	newChunk := func() executor.Chunk {
+		return buildPromSubQueryInChunk("metric", []string{"a=1"},
+			[][]int64{{0, 10, 20, 30, 40, 50}}, [][]float64{{1, 5, 3, 2, 4, 6}})
+	}
+
+	// instant query at 10s: sum_over_time(metric[50s:10s])
+	points := testPromSubQueryTransform(t, &influxql.Call{Name: "sum_over_time", Args: []influxql.Expr{value}}, promSubCall(10, 10, 0, 50, 0), newChunk())
+	assert.Equal(t, []promSubQueryPoint{{tags: string(ParseChunkTags("a=1").GetTag()), time: 10, value: 6}}, points)
+
+	// range query from 20s to 50s with step 10s: max_over_time(metric[20s:10s])
+	points = testPromSubQueryTransform(t, &influxql.Call{Name: "max_over_time", Args: []influxql.Expr{value}}, promSubCall(20, 50, 10, 20, 0), newChunk())
+	assert.Equal(t, []float64{5, 5, 4, 6}, promSubQueryValues(points))
+	assert.Equal(t, int64(50), points[3].time)
+
+	// sum_over_time(metric[20s:10s] offset 10s) at 50s
+	points = testPromSubQueryTransform(t, &influxql.Call{Name: "sum_over_time", Args: []influxql.Expr{value}}, promSubCall(50, 50, 0, 20, 10), newChunk())
+	assert.Equal(t, []float64{9}, promSubQueryValues(points))
+
+	cases := []struct {
+		call   *influxql.Call
+		expect float64
+	}{
+		{call: &influxql.Call{Name: "min_over_time", Args: []influxql.Expr{value}}, expect: 1},
+		{call: &influxql.Call{Name: "avg_over_time", Args: []influxql.Expr{value}}, expect: 3.5},
+		{call: &influxql.Call{Name: "count_over_time", Args: []influxql.Expr{value}}, expect: 6},
+		{call: &influxql.Call{Name: "stddev_over_time", Args: []influxql.Expr{value}}, expect: math.Sqrt(17.5 / 6)},
+		{call: &influxql.Call{Name: "quantile_over_time", Args: []influxql.Expr{value, &influxql.NumberLiteral{Val: 0.5}}}, expect: 3.5},
+		{call: &influxql.Call{Name: "quantile_over_time", Args: []influxql.Expr{value, &influxql.NumberLiteral{Val: 2}}}, expect: math.Inf(1)},
+		{call: &influxql.Call{Name: "idelta_prom", Args: []influxql.Expr{value}}, expect: 2},
+		{call: &influxql.Call{Name: "stdvar_over_time", Args: []influxql.Expr{value}}, expect: 17.5 / 6},
+		{call: &influxql.Call{Name: "last_over_time", Args: []influxql.Expr{value}}, expect: 6},
+		{call: &influxql.Call{Name: "present_over_time", Args: []influxql.Expr{value}}, expect: 1},
+		{call: &influxql.Call{Name: "changes", Args: []influxql.Expr{value}}, expect: 5},
+		{call: &influxql.Call{Name: "resets", Args: []influxql.Expr{value}}, expect: 2},
+		{call: &influxql.Call{Name: "holt_winters_prom", Args: []influxql.Expr{value, &influxql.NumberLiteral{Val: 0.5}, &influxql.NumberLiteral{Val: 0.5}}}, expect: 5.703125},
+	}
+	for _, c := range cases {
+		points = testPromSubQueryTransform(t, c.call, promSubCall(50, 50, 0, 50, 0), newChunk())
+		assert.InDeltaSlice(t, []float64{c.expect}, promSubQueryValues(points), 1e-9, c.call.String())
+	}
+}
+
+func TestPromSubQueryTransform_Rate(t *testing.T) {
+	value := &influxql.VarRef{Val: "value", Type: influxql.Float}
+	newChunks := func() []executor.Chunk {
+		// the series are split across chunks, values of a=2 have a counter reset at 40s
+		return []executor.Chunk{
+			buildPromSubQueryInChunk("", []string{"a=1", "a=2"},
+				[][]int64{{0, 10, 20, 30}, {0, 10, 20}}, [][]float64{{0, 50, 100, 150}, {0, 20, 40}}),
+			buildPromSubQueryInChunk("", []string{"a=1", "a=2"},
+				[][]int64{{40, 50, 60}, {30, 40, 50, 60}}, [][]float64{{200, 250, 300}, {60, 20, 40, 60}}),
+		}
+	}
+
+	points := testPromSubQueryTransform(t, &influxql.Call{Name: "rate_prom", Args: []influxql.Expr{value}}, promSubCall(60, 60, 0, 60, 0), newChunks()...)
+	assert.Equal(t, []float64{5, 2}, promSubQueryValues(points))
+	assert.NotEqual(t, points[0].tags, points[1].tags)
+
+	points = testPromSubQueryTransform(t, &influxql.Call{Name: "increase", Args: []influxql.Expr{value}}, promSubCall(60, 60, 0, 60, 0), newChunks()...)
+	assert.Equal(t, []float64{300, 120}, promSubQueryValues(points))
+
+	points = testPromSubQueryTransform(t, &influxql.Call{Name: "delta_prom", Args: []influxql.Expr{value}}, promSubCall(60, 60, 0, 60, 0), newChunks()...)
+	assert.Equal(t, []float64{300, 60}, promSubQueryValues(points))
+
+	points = testPromSubQueryTransform(t, &influxql.Call{Name: "irate_prom", Args: []influxql.Expr{value}}, promSubCall(60, 60, 0, 60, 0), newChunks()...)
+	assert.Equal(t, []float64{5, 2}, promSubQueryValues(points))
+
+	points = testPromSubQueryTransform(t, &influxql.Call{Name: "deriv", Args: []influxql.Expr{value}}, promSubCall(60, 60, 0, 30, 0), newChunks()...)
+	assert.InDeltaSlice(t, []float64{5, 0.2}, promSubQueryValues(points), 1e-9)
+
+	points = testPromSubQueryTransform(t, &influxql.Call{Name: "predict_linear", Args: []influxql.Expr{value, &influxql.IntegerLiteral{Val: 60}}}, promSubCall(60, 60, 0, 60, 0), newChunks()...)
+	assert.InDelta(t, 600, points[0].value, 1e-9)
+
+	// a single point in the range has no rate
+	points = testPromSubQueryTransform(t, &influxql.Call{Name: "rate_prom", Args: []influxql.Expr{value}}, promSubCall(60, 60, 0, 5, 0), newChunks()...)
+	assert.Empty(t, points)
+}
+
+func TestPromSubQueryTransform_Error(t *testing.T) {
+	inRt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "value", Type: influxql.Float})
+	ref := influxql.VarRef{Val: "val0", Type: influxql.Float}
+	outRt := hybridqp.NewRowDataTypeImpl(ref)
+	opt := &query.ProcessorOptions{ChunkSize: 1024}
+	sub := promSubCall(10, 10, 0, 10, 0)
+
+	ops := []hybridqp.ExprOptions{{Expr: &influxql.Call{Name: "holt_winters", Args: []influxql.Expr{&influxql.VarRef{Val: "value"}}}, Ref: ref}}
+	_, err := executor.NewPromSubQueryTransform(inRt, outRt, ops, opt, sub)
+	assert.Error(t, err)
+
+	ops = []hybridqp.ExprOptions{{Expr: &influxql.Call{Name: "sum_over_time", Args: []influxql.Expr{&influxql.VarRef{Val: "unknown"}}}, Ref: ref}}
+	_, err = executor.NewPromSubQueryTransform(inRt, outRt, ops, opt, sub)
+	assert.Error(t, err)
+
+	ops = []hybridqp.ExprOptions{{Expr: &influxql.Call{Name: "quantile_over_time", Args: []influxql.Expr{&influxql.VarRef{Val: "value"}}}, Ref: ref}}
+	_, err = executor.NewPromSubQueryTransform(inRt, outRt, ops, opt, sub)
+	assert.Error(t, err)
+}
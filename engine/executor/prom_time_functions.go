@@ -26,6 +26,7 @@ func init() {
 	RegistryPromTimeFunction("year_prom", &yearPromFunc{})
 	RegistryPromTimeFunction("time_prom", &timePromFunc{})
 	RegistryPromTimeFunction("vector_prom", &vectorPromFunc{})
+	RegistryPromTimeFunction("timestamp_prom", &timestampPromFunc{})
 	RegistryPromTimeFunction("month_prom", &monthPromFunc{})
 	RegistryPromTimeFunction("day_of_month_prom", &dayOfMonthPromFunc{})
 	RegistryPromTimeFunction("day_of_week_prom", &dayOfWeekPromFunc{})
@@ -84,6 +85,19 @@ func (s *vectorPromFunc) CallFunc(name string, args []interface{}) (interface{},
 	return nil, true
 }
 
+// timestampPromFunc returns the time of the point, the first argument is the value of the point
+// and the last one is the time in seconds.
+type timestampPromFunc struct{}
+
+func (s *timestampPromFunc) CallFunc(name string, args []interface{}) (interface{}, bool) {
+	if len(args) > 1 && args[0] != nil {
+		if iVal, ok := args[len(args)-1].(float64); ok {
+			return iVal, true
+		}
+	}
+	return nil, true
+}
+
 type monthPromFunc struct{}
 
 func (s *monthPromFunc) CallFunc(name string, args []interface{}) (interface{}, bool) {
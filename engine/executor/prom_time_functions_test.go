@@ -39,3 +39,11 @@ func TestPromTimeFuncstions(t *testing.T) {
 		assert.Equal(t, act, nil)
 	}
 }
+
+func TestPromTimestampFunction(t *testing.T) {
+	valuer := executor.PromTimeValuer{}
+	act, _ := valuer.Call("timestamp_prom", []interface{}{2.0, 60.0})
+	assert.Equal(t, 60.0, act)
+	act, _ = valuer.Call("timestamp_prom", []interface{}{nil, 60.0})
+	assert.Equal(t, nil, act)
+}
@@ -903,3 +903,105 @@ func TestMockTSDBSystemWhenLocalStore(t *testing.T) {
 		})
 	}
 }
+
+func TestCalendarInterval(t *testing.T) {
+	// 2024-01-15, 2024-01-20, 2024-03-15 and 2024-04-01 01:00 in New York, the DST starts on 2024-03-10
+	times := []int64{1705320000000000000, 1705752000000000000, 1710504000000000000, 1711947600000000000}
+	ddl := func(c *Catalog) error {
+		db, err := c.CreateDatabase("db0", "rp0")
+		if err != nil {
+			return err
+		}
+		for _, name := range []string{"mst0", "mst1"} {
+			mst := NewTable(name)
+			mst.AddDataTypes(map[string]influxql.DataType{"t": influxql.Tag, "v_int": influxql.Integer})
+			db.AddTable(mst)
+		}
+		return nil
+	}
+	dml := func(s *Storage) error {
+		rdt := hybridqp.NewRowDataTypeImpl(
+			influxql.VarRef{Val: "t", Type: influxql.String},
+			influxql.VarRef{Val: "v_int", Type: influxql.Integer})
+		chunk := executor.NewChunkBuilder(rdt).NewChunk("mst0")
+		chunk.AppendTimes(times)
+		chunk.Column(0).AppendStringValues([]string{"a", "a", "a", "a"})
+		chunk.Column(0).AppendManyNotNil(4)
+		chunk.Column(1).AppendIntegerValues([]int64{1, 2, 3, 4})
+		chunk.Column(1).AppendManyNotNil(4)
+		pts := influx.PointTags{influx.Tag{Key: "t", Value: "a"}}
+		s.Write("db0.rp0.mst0", &pts, chunk)
+
+		// 2024-03-15 08:00 in New York
+		chunk = executor.NewChunkBuilder(rdt).NewChunk("mst1")
+		chunk.AppendTimes(times[2:3])
+		chunk.Column(0).AppendStringValues([]string{"a"})
+		chunk.Column(0).AppendManyNotNil(1)
+		chunk.Column(1).AppendIntegerValues([]int64{3})
+		chunk.Column(1).AppendManyNotNil(1)
+		s.Write("db0.rp0.mst1", &pts, chunk)
+		return nil
+	}
+
+	for _, tc := range []struct {
+		name      string
+		sql       string
+		validator func([]executor.Chunk)
+	}{
+		{
+			name: "group by months in time zone",
+			sql: "SELECT count(v_int) FROM db0.rp0.mst0 WHERE time >= '2024-01-01T05:00:00Z' AND time < '2024-05-01T04:00:00Z' " +
+				"GROUP BY time(1mo) fill(0) tz('America/New_York')",
+			validator: func(results []executor.Chunk) {
+				assert.Equal(t, len(results), 1)
+				// the midnight of the months in New York, April is in the daylight saving time
+				assert.Equal(t, results[0].Time(), []int64{1704085200000000000, 1706763600000000000, 1709269200000000000, 1711944000000000000})
+				assert.Equal(t, results[0].Columns()[0].IntegerValues(), []int64{2, 0, 1, 1})
+			},
+		},
+		{
+			name: "group by days across the daylight saving time",
+			sql: "SELECT count(v_int) FROM db0.rp0.mst1 WHERE time >= '2024-03-09T05:00:00Z' AND time < '2024-03-16T04:00:00Z' " +
+				"GROUP BY time(1d) fill(0) tz('America/New_York')",
+			validator: func(results []executor.Chunk) {
+				assert.Equal(t, len(results), 1)
+				assert.Equal(t, results[0].Time(), []int64{1709960400000000000, 1710046800000000000, 1710129600000000000,
+					1710216000000000000, 1710302400000000000, 1710388800000000000, 1710475200000000000})
+				assert.Equal(t, results[0].Columns()[0].IntegerValues(), []int64{0, 0, 0, 0, 0, 0, 1})
+			},
+		},
+		{
+			name: "date functions in time zone",
+			sql:  "SELECT v_int, date_part('month', time), date_trunc('day', time), format_time(time, '2006-01-02 15:04') FROM db0.rp0.mst0 tz('America/New_York')",
+			validator: func(results []executor.Chunk) {
+				assert.Equal(t, len(results), 1)
+				assert.Equal(t, results[0].Columns()[1].IntegerValues(), []int64{1, 1, 3, 4})
+				assert.Equal(t, results[0].Columns()[2].IntegerValues()[3], int64(1711944000000000000))
+				assert.Equal(t, results[0].Columns()[3].StringValuesV2(nil), []string{"2024-01-15 07:00", "2024-01-20 07:00", "2024-03-15 08:00", "2024-04-01 01:00"})
+			},
+		},
+		{
+			name: "format the windows of months",
+			sql: "SELECT sum(v_int), format_time(time, '2006-01') FROM db0.rp0.mst0 WHERE time >= '2024-01-01T05:00:00Z' AND time < '2024-05-01T04:00:00Z' " +
+				"GROUP BY time(1mo) fill(none) tz('America/New_York')",
+			validator: func(results []executor.Chunk) {
+				assert.Equal(t, len(results), 1)
+				assert.Equal(t, results[0].Columns()[0].IntegerValues(), []int64{3, 3, 4})
+				assert.Equal(t, results[0].Columns()[1].StringValuesV2(nil), []string{"2024-01", "2024-03", "2024-04"})
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tsdb := NewTSDBSystem()
+			if err := tsdb.DDL(ddl); err != nil {
+				t.Error(err)
+			}
+			if err := tsdb.DML(dml); err != nil {
+				t.Error(err)
+			}
+			if err := tsdb.ExecSQL(tc.sql, tc.validator, nil, false); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
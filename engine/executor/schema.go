@@ -433,6 +433,10 @@ func (qs *QuerySchema) HasRowCount() bool {
 }
 
 func (qs *QuerySchema) HasOptimizeAgg() bool {
+	// the file cursors slot the windows by the fixed interval
+	if qs.Options().IsCalendarInterval() {
+		return false
+	}
 	if qs.MatchPreAgg() {
 		return true
 	}
@@ -971,6 +975,15 @@ func (qs *QuerySchema) Visit(n influxql.Node) influxql.Visitor {
 		}
 		if qs.isStringFunction(n) {
 			qs.AddString(key, n)
+			if query.IsDateFunction(n.Name) {
+				// the time of the rows is not a column
+				for _, arg := range n.Args {
+					if ref, ok := arg.(*influxql.VarRef); !ok || ref.Val != "time" {
+						influxql.Walk(qs, arg)
+					}
+				}
+				return nil
+			}
 			return qs
 		}
 		if qs.isLabelFunction(n) {
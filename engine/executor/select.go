@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/openGemini/openGemini/engine/hybridqp"
@@ -368,12 +369,18 @@ func BuildFullJoinQueryPlan(ctx context.Context, qc query.LogicalPlanCreator, st
 	}
 	var joinConditon influxql.Expr
 	joinNodes := make([]hybridqp.QueryNode, 0, len(stmt.Sources))
+	// the condition of the hash joins is filtered above the join, and pushed down by the FilterPushDownToJoinRule,
+	// the condition of the full join is pushed down to both inputs as before.
+	filterAboveJoin := joinCases[0].JoinType != influxql.FullJoin
 
 	for i := range stmt.Sources {
 		source := influxql.CloneSource(stmt.Sources[i])
 		optSource := influxql.Sources{source}
 		childOpt := schema.opt.(*query.ProcessorOptions).Clone()
 		childOpt.UpdateSources(optSource)
+		if filterAboveJoin {
+			childOpt.Condition = nil
+		}
 		s := NewQuerySchemaWithSources(stmt.Fields, influxql.Sources{stmt.Sources[i]}, stmt.ColumnNames(), childOpt, nil)
 		child, err := BuildSources(ctx, qc, influxql.Sources{stmt.Sources[i]}, s, false)
 		if err != nil {
@@ -388,7 +395,46 @@ func BuildFullJoinQueryPlan(ctx context.Context, qc query.LogicalPlanCreator, st
 	if len(joinNodes) == 0 {
 		return nil, nil
 	}
-	return NewLogicalFullJoin(joinNodes[0], joinNodes[1], joinConditon, schema), nil
+	join := NewLogicalFullJoin(joinNodes[0], joinNodes[1], joinConditon, schema)
+	if !filterAboveJoin || schema.opt.GetCondition() == nil {
+		return join, nil
+	}
+	cond := rewriteJoinFilterCondition(schema.opt.GetCondition(), schema)
+	return NewLogicalFilter(join, newJoinFilterSchema(schema, cond)), nil
+}
+
+// rewriteJoinFilterCondition rewrites the condition of the join query to the columns of the join output.
+// The selected fields, such as m1.f1, are replaced by their symbols, and the group by tags qualified
+// by the alias of the subquery, such as m1.tag1, are replaced by the tags.
+func rewriteJoinFilterCondition(cond influxql.Expr, schema *QuerySchema) influxql.Expr {
+	symbols := make(map[string]influxql.VarRef, len(schema.mapping))
+	for k, v := range schema.mapping {
+		if ref, ok := k.(*influxql.VarRef); ok {
+			symbols[ref.Val] = v
+		}
+	}
+	dims := schema.opt.GetOptDimension()
+	return influxql.RewriteExpr(influxql.CloneExpr(cond), func(expr influxql.Expr) influxql.Expr {
+		ref, ok := expr.(*influxql.VarRef)
+		if !ok {
+			return expr
+		}
+		if symbol, ok := symbols[ref.Val]; ok {
+			return &influxql.VarRef{Val: symbol.Val, Type: symbol.Type}
+		}
+		if i := strings.Index(ref.Val, "."); i > 0 && ContainDim(dims, ref.Val[i+1:]) {
+			return &influxql.VarRef{Val: ref.Val[i+1:], Type: ref.Type}
+		}
+		return expr
+	})
+}
+
+// newJoinFilterSchema returns the schema of the filter above or below the join with the condition.
+func newJoinFilterSchema(schema *QuerySchema, cond influxql.Expr) *QuerySchema {
+	opt := schema.opt.(*query.ProcessorOptions).Clone()
+	opt.Condition = cond
+	return NewQuerySchemaWithJoinCase(schema.queryFields, schema.sources, schema.columnNames, opt, schema.joinCases,
+		schema.unnestCases, nil)
 }
 
 func BuildBinOpQueryPlan(ctx context.Context, qc query.LogicalPlanCreator, stmt *influxql.SelectStatement, schema *QuerySchema) (hybridqp.QueryNode, error) {
@@ -541,6 +587,10 @@ func buildQueryPlan(ctx context.Context, stmt *influxql.SelectStatement, qc quer
 	}
 	builder.Push(sp)
 
+	if stmt.PromSubCall != nil {
+		builder.PromSubQuery(stmt.PromSubCall)
+	}
+
 	buildNodes(builder, schema, s)
 
 	return builder.Build()
@@ -263,7 +263,7 @@ func AppendColumnsIteratorHelper(rowDataType hybridqp.RowDataType) CoProcessor {
 		switch f.Expr.(*influxql.VarRef).Type {
 		case influxql.Boolean:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewBooleanAppendIterator(), i, i))
-		case influxql.Integer:
+		case influxql.Integer, influxql.Unsigned:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewInt64AppendIterator(), i, i))
 		case influxql.Float:
 			tranCoProcessor.AppendRoutine(NewRoutineImpl(NewFloat64AppendIterator(), i, i))
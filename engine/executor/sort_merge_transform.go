@@ -210,6 +210,12 @@ func AuxHelper(opt query.ProcessorOptions, rt hybridqp.RowDataType, filedMap map
 						colIndex:  rt.FieldIndex(value.Val),
 						isTag:     false,
 						name:      keyValue})
+				case influxql.Unsigned:
+					AuxCompareHelpers = append(AuxCompareHelpers, &SortedMergeAuxHelper{
+						auxHelper: UnsignedAscendingAuxHelper,
+						colIndex:  rt.FieldIndex(value.Val),
+						isTag:     false,
+						name:      keyValue})
 				case influxql.Float:
 					AuxCompareHelpers = append(AuxCompareHelpers, &SortedMergeAuxHelper{
 						auxHelper: Float64AscendingAuxHelper,
@@ -247,6 +253,12 @@ func AuxHelper(opt query.ProcessorOptions, rt hybridqp.RowDataType, filedMap map
 						colIndex:  rt.FieldIndex(value.Val),
 						isTag:     false,
 						name:      keyValue})
+				case influxql.Unsigned:
+					AuxCompareHelpers = append(AuxCompareHelpers, &SortedMergeAuxHelper{
+						auxHelper: UnsignedDescendingAuxHelper,
+						colIndex:  rt.FieldIndex(value.Val),
+						isTag:     false,
+						name:      keyValue})
 				case influxql.Float:
 					AuxCompareHelpers = append(AuxCompareHelpers, &SortedMergeAuxHelper{
 						auxHelper: Float64DescendingAuxHelper,
@@ -0,0 +1,90 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+// The values of the Unsigned columns are stored as the integer values of the columns,
+// the sort elements and the aux helpers compare them as uint64.
+
+type unsignedSortEle struct {
+	val      uint64
+	validVal bool
+}
+
+func NewUnsignedSortEle() sortEleMsg {
+	return &unsignedSortEle{
+		val:      0,
+		validVal: false,
+	}
+}
+
+func (ele *unsignedSortEle) LessThan(oele sortEleMsg) int {
+	other := oele.(*unsignedSortEle)
+	if ele.validVal && other.validVal {
+		if ele.val < other.val {
+			return less
+		} else if ele.val == other.val {
+			return eq
+		}
+		return greater
+	}
+	if !ele.validVal && other.validVal {
+		return less
+	} else if !ele.validVal && !other.validVal {
+		return eq
+	}
+	return greater
+}
+
+func (ele *unsignedSortEle) SetVal(col Column, startLoc int) {
+	if col.IsNilV2(startLoc) {
+		return
+	}
+	ele.validVal = true
+	if col.NilCount() != 0 {
+		startLoc = col.GetValueIndexV2(startLoc)
+	}
+	ele.val = uint64(col.IntegerValue(startLoc))
+}
+
+func (ele *unsignedSortEle) AppendToCol(col Column) {
+	if ele.validVal {
+		col.AppendIntegerValue(int64(ele.val))
+	}
+	col.AppendNilsV2(ele.validVal)
+}
+
+func (ele *unsignedSortEle) Clone() sortEleMsg {
+	return ele
+}
+
+func UnsignedAscendingAuxHelper(x, y Column, i, j int) (bool, bool) {
+	xv := uint64(x.IntegerValue(x.GetValueIndexV2(i)))
+	yv := uint64(y.IntegerValue(y.GetValueIndexV2(j)))
+	if xv == yv {
+		return true, false
+	}
+	return false, xv < yv
+}
+
+func UnsignedDescendingAuxHelper(x, y Column, i, j int) (bool, bool) {
+	xv := uint64(x.IntegerValue(x.GetValueIndexV2(i)))
+	yv := uint64(y.IntegerValue(y.GetValueIndexV2(j)))
+	if xv == yv {
+		return true, false
+	}
+	return false, xv > yv
+}
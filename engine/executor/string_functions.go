@@ -21,6 +21,7 @@ import (
 
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 )
 
 type StringValuer struct{}
@@ -120,3 +121,42 @@ func SubStrThreeParaFunc(srcStr string, start, subStrLen int64) string {
 	copy(newStr, oriStr)
 	return util.Bytes2str(newStr)
 }
+
+// TransString returns the function evaluating the string function c over the whole column, if the
+// first argument of c is a string column and the others are literals. Otherwise nil is returned and
+// c is evaluated row by row by the valuer.
+func TransString(c *influxql.Call) func(dst Column, src Chunk, index []int) {
+	call, ok := query.GetStringBatchCall(c)
+	if !ok {
+		return nil
+	}
+	return func(dst Column, src Chunk, index []int) {
+		srcCol := src.Column(index[0])
+		values, offsets := srcCol.GetStringBytes()
+		switch call.Type {
+		case influxql.String:
+			b, offs := dst.GetStringBytes()
+			for i := range offsets {
+				offs = append(offs, uint32(len(b)))
+				b = call.String(b, stringValueAt(values, offsets, i))
+			}
+			dst.SetStringValues(b, offs)
+		case influxql.Integer:
+			for i := range offsets {
+				dst.AppendIntegerValue(call.Integer(stringValueAt(values, offsets, i)))
+			}
+		case influxql.Boolean:
+			for i := range offsets {
+				dst.AppendBooleanValue(call.Boolean(stringValueAt(values, offsets, i)))
+			}
+		}
+		AdjustNils(dst, srcCol, 0, srcCol.Length())
+	}
+}
+
+func stringValueAt(values []byte, offsets []uint32, i int) string {
+	if i == len(offsets)-1 {
+		return util.Bytes2str(values[offsets[i]:])
+	}
+	return util.Bytes2str(values[offsets[i]:offsets[i+1]])
+}
@@ -79,7 +79,7 @@ func (trans *SubQueryTransform) initTransformMapping() error {
 			trans.mapTransToName[i] = vr.Val
 			trans.mapTransToIn[i] = trans.input.RowDataType.FieldIndex(vr.Val)
 			switch vr.Type {
-			case influxql.Integer:
+			case influxql.Integer, influxql.Unsigned:
 				trans.transparents[i] = TransparentForwardIntegerColumn
 			case influxql.Float:
 				trans.transparents[i] = TransparentForwardFloatColumn
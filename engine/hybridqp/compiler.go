@@ -44,6 +44,7 @@ type Options interface {
 	Window(t int64) (start, end int64)
 	GetGroupBy() map[string]struct{}
 	GetInterval() time.Duration
+	IsCalendarInterval() bool
 	IsGroupByAllDims() bool
 	GetSourcesNames() []string
 	GetMeasurements() []*influxql.Measurement
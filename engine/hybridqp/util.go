@@ -48,6 +48,9 @@ func panicInPackage(err error) {
 type Interval struct {
 	Duration time.Duration
 	Offset   time.Duration
+	// Months is the number of calendar months of an interval such as 3mo, the windows then
+	// start on the first day of the months and Duration is only their average length.
+	Months int64
 }
 
 // IsZero returns true if the interval has no duration.
@@ -179,6 +179,15 @@ func (c *ChunkIterator) Close() {
 }
 
 func (c *ChunkIterator) Next() bool {
+	for c.next() {
+		if c.purgeDeletedRows() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ChunkIterator) next() bool {
 	if c.err != nil {
 		return false
 	}
@@ -240,6 +249,29 @@ func (c *ChunkIterator) readRecord() error {
 	return nil
 }
 
+// purgeDeletedRows physically removes the rows deleted by tombstones from the chunk,
+// returns false if all rows of the chunk are deleted
+func (c *ChunkIterator) purgeDeletedRows() bool {
+	ts := c.r.Tombstones()
+	if !ts.HasSeries(c.id) {
+		return true
+	}
+
+	rec := ts.FilterRecord(c.id, c.merge)
+	if rec == nil {
+		return false
+	}
+	if rec == c.merge {
+		return true
+	}
+
+	c.merge.Reset()
+	c.merge.SetSchema(c.fields)
+	c.merge.ReserveColVal(len(c.fields))
+	c.merge.AppendRec(rec, 0, rec.RowNums())
+	return true
+}
+
 func (c *ChunkIterator) GetSeriesID() uint64 {
 	return c.id
 }
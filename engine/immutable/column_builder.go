@@ -54,11 +54,12 @@ type ColumnBuilder struct {
 	colMeta  *ColumnMeta
 	segCol   []record.ColVal
 
-	intPreAggBuilder    PreAggBuilder
-	floatPreAggBuilder  PreAggBuilder
-	stringPreAggBuilder PreAggBuilder
-	boolPreAggBuilder   PreAggBuilder
-	timePreAggBuilder   PreAggBuilder
+	intPreAggBuilder      PreAggBuilder
+	unsignedPreAggBuilder PreAggBuilder
+	floatPreAggBuilder    PreAggBuilder
+	stringPreAggBuilder   PreAggBuilder
+	boolPreAggBuilder     PreAggBuilder
+	timePreAggBuilder     PreAggBuilder
 
 	encodeMode EncodeColumnMode
 	coder      *encoding.CoderContext
@@ -85,6 +86,9 @@ func (b *ColumnBuilder) resetPreAgg() {
 	if b.intPreAggBuilder != nil {
 		b.intPreAggBuilder.reset()
 	}
+	if b.unsignedPreAggBuilder != nil {
+		b.unsignedPreAggBuilder.reset()
+	}
 	if b.floatPreAggBuilder != nil {
 		b.floatPreAggBuilder.reset()
 	}
@@ -115,6 +119,15 @@ func (b *ColumnBuilder) initEncoder(ref record.Field) error {
 		}
 		b.intPreAggBuilder.reset()
 		return nil
+	case influx.Field_Type_UInt:
+		if b.coder.GetIntCoder() == nil {
+			b.coder.SetIntCoder(encoding.GetIntCoder())
+		}
+		if b.unsignedPreAggBuilder == nil {
+			b.unsignedPreAggBuilder = acquireColumnBuilder(influx.Field_Type_UInt)
+		}
+		b.unsignedPreAggBuilder.reset()
+		return nil
 	case influx.Field_Type_Float:
 		if b.coder.GetFloatCoder() == nil {
 			b.coder.SetFloatCoder(encoding.GetFloatCoder())
@@ -150,11 +163,22 @@ func (b *ColumnBuilder) initEncoder(ref record.Field) error {
 }
 
 func (b *ColumnBuilder) encIntegerColumn(timeCols []record.ColVal, segCols []record.ColVal, offset int64) error {
-	var err error
 	if b.intPreAggBuilder == nil {
 		b.intPreAggBuilder = acquireColumnBuilder(influx.Field_Type_Int)
 	}
-	b.intPreAggBuilder.reset()
+	return b.encIntegerBlocks(timeCols, segCols, offset, encoding.BlockInteger, b.intPreAggBuilder)
+}
+
+func (b *ColumnBuilder) encUnsignedColumn(timeCols []record.ColVal, segCols []record.ColVal, offset int64) error {
+	if b.unsignedPreAggBuilder == nil {
+		b.unsignedPreAggBuilder = acquireColumnBuilder(influx.Field_Type_UInt)
+	}
+	return b.encIntegerBlocks(timeCols, segCols, offset, encoding.BlockUnsigned, b.unsignedPreAggBuilder)
+}
+
+func (b *ColumnBuilder) encIntegerBlocks(timeCols []record.ColVal, segCols []record.ColVal, offset int64, typ uint8, preAggBuilder PreAggBuilder) error {
+	var err error
+	preAggBuilder.reset()
 
 	for i := range segCols {
 		segCol := &segCols[i]
@@ -166,7 +190,7 @@ func (b *ColumnBuilder) encIntegerColumn(timeCols []record.ColVal, segCols []rec
 		}
 
 		times := tmCol.IntegerValues()
-		b.intPreAggBuilder.addValues(segCol, times)
+		preAggBuilder.addValues(segCol, times)
 		m := &b.colMeta.entries[i+b.position] // cur entry pos
 		m.setOffset(offset)
 		pos := len(b.data)
@@ -178,8 +202,12 @@ func (b *ColumnBuilder) encIntegerColumn(timeCols []record.ColVal, segCols []rec
 			b.data = append(b.data, encoding.BlockIntegerOne)
 			b.data = append(b.data, segCol.Val...)
 		} else {
-			b.data = EncodeColumnHeader(segCol, b.data, encoding.BlockInteger)
-			b.data, err = encoding.EncodeIntegerBlock(segCol.Val, b.data, b.coder)
+			b.data = EncodeColumnHeader(segCol, b.data, typ)
+			if typ == encoding.BlockUnsigned {
+				b.data, err = encoding.EncodeUnsignedBlock(segCol.Val, b.data, b.coder)
+			} else {
+				b.data, err = encoding.EncodeIntegerBlock(segCol.Val, b.data, b.coder)
+			}
 			if err != nil {
 				b.log.Error("encode integer value fail", zap.Error(err))
 				return err
@@ -194,7 +222,7 @@ func (b *ColumnBuilder) encIntegerColumn(timeCols []record.ColVal, segCols []rec
 		offset += int64(size)
 	}
 
-	b.colMeta.preAgg = b.intPreAggBuilder.marshal(b.colMeta.preAgg[:0])
+	b.colMeta.preAgg = preAggBuilder.marshal(b.colMeta.preAgg[:0])
 
 	return err
 }
@@ -378,6 +406,8 @@ func (b *ColumnBuilder) encode(ref record.Field, timeCols []record.ColVal, dataO
 	switch ref.Type {
 	case influx.Field_Type_Int:
 		err = b.encIntegerColumn(timeCols, b.segCol, dataOffset)
+	case influx.Field_Type_UInt:
+		err = b.encUnsignedColumn(timeCols, b.segCol, dataOffset)
 	case influx.Field_Type_Float:
 		err = b.encFloatColumn(timeCols, b.segCol, dataOffset)
 	case influx.Field_Type_String:
@@ -419,6 +449,8 @@ func (b *ColumnBuilder) BuildPreAgg() {
 		} else {
 			builder = b.intPreAggBuilder
 		}
+	case influx.Field_Type_UInt:
+		builder = b.unsignedPreAggBuilder
 	default:
 		panic(b.colMeta.ty)
 	}
@@ -254,6 +254,8 @@ func readFromPreAgg(ty byte, agg []byte, offset int) (interface{}, int64, bool)
 		return numberenc.UnmarshalFloat64(agg[offset:]), numberenc.UnmarshalInt64(agg[timeOffset:]), true
 	case influx.Field_Type_Int:
 		return numberenc.UnmarshalInt64(agg[offset:]), numberenc.UnmarshalInt64(agg[timeOffset:]), true
+	case influx.Field_Type_UInt:
+		return numberenc.UnmarshalUint64(agg[offset:]), numberenc.UnmarshalInt64(agg[timeOffset:]), true
 	default:
 		break
 	}
@@ -297,6 +297,11 @@ func (l *Location) readData(filterOpts *FilterOptions, dst, filterRec *record.Re
 		tracing.SpanElapsed(l.ctx.filterSpan, func() {
 			if rec != nil {
 				oriRowCount += rec.RowNums()
+				// filter the rows deleted by tombstones
+				rec = l.r.Tombstones().FilterRecord(l.meta.sid, rec)
+			}
+
+			if rec != nil {
 				if l.ctx.Ascending {
 					rec = FilterByTime(rec, l.ctx.tr)
 				} else {
@@ -96,11 +96,35 @@ func (mt *mergeTool) merge(ctx *MergeContext) {
 		return
 	}
 
+	// the stream merge reads copies of the files with tombstones in which the deleted rows are purged
+	orderFiles, orderTemps, dropped, err := mt.mts.purgeTombstonesToTemp(ctx.mst, order.Files(), mt.lg)
+	if err != nil {
+		mt.zlg.Error("failed to purge tombstones", zap.Error(err))
+		return
+	}
+	defer mt.mts.removeTempFiles(orderTemps)
+
+	unorderedFiles, unorderedTemps, _, err := mt.mts.purgeTombstonesToTemp(ctx.mst, unordered.Files(), mt.lg)
+	if err != nil {
+		mt.zlg.Error("failed to purge tombstones", zap.Error(err))
+		return
+	}
+	defer mt.mts.removeTempFiles(unorderedTemps)
+
+	// all rows of these ordered files are deleted
+	mt.mts.deleteFilesOf(ctx.mst, true, dropped...)
+	if len(orderFiles) == 0 {
+		// no ordered file is left to merge the unordered data into, the next merge moves it
+		mt.zlg.Info("all rows of the ordered files are deleted, merge later")
+		success = true
+		return
+	}
+
 	func() {
 		mt.stat.StatOrderFile(ctx.order.size, ctx.order.Len())
 		mt.stat.StatOutOfOrderFile(ctx.unordered.size, ctx.unordered.Len())
 
-		mergedFiles, err := mt.execute(ctx.mst, order, unordered)
+		mergedFiles, err := mt.execute(ctx.mst, orderFiles, unorderedFiles)
 		if err != nil {
 			mt.zlg.Error("failed to merge unordered files", zap.Error(err))
 			return
@@ -132,9 +156,9 @@ func (mt *mergeTool) getTSSPFiles(ctx *MergeContext) (*TSSPFiles, *TSSPFiles, er
 	return order, unordered, err
 }
 
-func (mt *mergeTool) execute(mst string, order, unordered *TSSPFiles) (*TSSPFiles, error) {
+func (mt *mergeTool) execute(mst string, order, unordered []TSSPFile) (*TSSPFiles, error) {
 	ur := NewUnorderedReader(mt.lg)
-	ur.AddFiles(unordered.Files())
+	ur.AddFiles(unordered)
 
 	var err error
 	performers := NewMergePerformers(ur)
@@ -152,7 +176,7 @@ func (mt *mergeTool) execute(mst string, order, unordered *TSSPFiles) (*TSSPFile
 		}
 	}()
 
-	for _, f := range order.Files() {
+	for _, f := range order {
 		sw := mt.mts.NewStreamWriteFile(mst)
 		if err = sw.InitMergedFile(f); err != nil {
 			return nil, err
@@ -257,18 +281,37 @@ func (mt *mergeTool) mergeSelfStreamMode(ctx *MergeContext) {
 		return
 	}
 
+	purged, temps, dropped, err := mt.mts.purgeTombstonesToTemp(ctx.mst, files.Files(), mt.lg)
+	if err != nil {
+		mt.zlg.Error("failed to purge tombstones", zap.Error(err))
+		return
+	}
+	defer mt.mts.removeTempFiles(temps)
+
+	if len(purged) == 0 {
+		// all rows of the files are deleted
+		mt.mts.deleteUnorderedFiles(ctx.mst, files.Files())
+		success = true
+		return
+	}
+
 	statistics.NewMergeStatistics().AddMergeSelfTotal(1)
+	// the merged file replaces the first file whose rows are not all deleted
 	order := &TSSPFiles{}
 	unordered := &TSSPFiles{}
-
-	order.Append(files.Files()[0])
-	unordered.Append(files.Files()[1:]...)
+	for _, f := range files.Files() {
+		if order.Len() == 0 && !containsFile(dropped, f) {
+			order.Append(f)
+			continue
+		}
+		unordered.Append(f)
+	}
 
 	func() {
 		mt.stat.StatOrderFile(0, 0)
 		mt.stat.StatOutOfOrderFile(ctx.unordered.size, ctx.unordered.Len())
 
-		mergedFiles, err := mt.execute(ctx.mst, order, unordered)
+		mergedFiles, err := mt.execute(ctx.mst, purged[:1], purged[1:])
 		if err != nil {
 			mt.zlg.Error("failed to merge unordered files", zap.Error(err))
 			return
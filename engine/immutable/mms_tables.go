@@ -77,6 +77,7 @@ type TablesStore interface {
 	LevelCompact(level uint16, shid uint64) error
 	FullCompact(shid uint64) error
 	SetAddFunc(addFunc func(int64))
+	SetIndexMergeSet(idx IndexMergeSet)
 	LoadSequencer()
 	GetRowCountsBySid(measurement string, sid uint64) (int64, error)
 	AddRowCountsBySid(measurement string, sid uint64, rowCounts int64)
@@ -84,6 +85,9 @@ type TablesStore interface {
 	GetTableFileNum(string, bool) int
 	GetMstFileStat() *stats.FileStat
 	DropMeasurement(ctx context.Context, name string) error
+	DeleteSeries(name string, sids []uint64, tr util.TimeRange) error
+	PurgeTombstones() error
+	ConvertToParquet()
 	GetFileSeq() uint64
 	DisableCompAndMerge()
 	EnableCompAndMerge()
@@ -148,6 +152,7 @@ type MmsTables struct {
 	logger          *logger.Logger
 	ImmTable        ImmTable
 	obsOpt          *obs.ObsOptions
+	idx             IndexMergeSet // look up the tags of the series for the Parquet files
 
 	Conf *Config
 
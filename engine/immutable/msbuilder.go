@@ -1317,6 +1317,11 @@ func (b *MsBuilder) releaseEncoders() {
 					colBuilder.intPreAggBuilder = nil
 				}
 
+				if colBuilder.unsignedPreAggBuilder != nil {
+					colBuilder.unsignedPreAggBuilder.release()
+					colBuilder.unsignedPreAggBuilder = nil
+				}
+
 				if colBuilder.floatPreAggBuilder != nil {
 					colBuilder.floatPreAggBuilder.release()
 					colBuilder.floatPreAggBuilder = nil
@@ -0,0 +1,354 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/parquet"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// parquetIndexKey is the key of the index in the key-value metadata of the Parquet files, the
+// query engine locates the rows of the series and the columns of the fields by the index
+const parquetIndexKey = "opengemini.index"
+
+var errParquetIndex = errors.New("invalid index of parquet file")
+
+// parquetSeries is the range of the rows of a series in the Parquet file
+type parquetSeries struct {
+	sid   uint64
+	first int64
+	rows  int64
+}
+
+// marshalParquetIndex encodes the columns of the fields and the rows of the series as varints
+func marshalParquetIndex(fields map[string]int, series []parquetSeries) string {
+	var dst []byte
+	dst = binary.AppendUvarint(dst, uint64(len(fields)))
+	for _, name := range sortedKeys(fields) {
+		dst = binary.AppendUvarint(dst, uint64(len(name)))
+		dst = append(dst, name...)
+		dst = binary.AppendUvarint(dst, uint64(fields[name]))
+	}
+	dst = binary.AppendUvarint(dst, uint64(len(series)))
+	for i := range series {
+		dst = binary.AppendUvarint(dst, series[i].sid)
+		dst = binary.AppendUvarint(dst, uint64(series[i].first))
+		dst = binary.AppendUvarint(dst, uint64(series[i].rows))
+	}
+	return base64.StdEncoding.EncodeToString(dst)
+}
+
+func unmarshalParquetIndex(s string) (map[string]int, map[uint64]parquetSeries, error) {
+	src, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	next := func() uint64 {
+		v, n := binary.Uvarint(src)
+		if n <= 0 {
+			err = errParquetIndex
+			return 0
+		}
+		src = src[n:]
+		return v
+	}
+
+	n := next()
+	fields := make(map[string]int)
+	for i := uint64(0); i < n && err == nil; i++ {
+		size := next()
+		if err != nil || size > uint64(len(src)) {
+			return nil, nil, errParquetIndex
+		}
+		name := string(src[:size])
+		src = src[size:]
+		fields[name] = int(next())
+	}
+
+	n = next()
+	series := make(map[uint64]parquetSeries)
+	for i := uint64(0); i < n && err == nil; i++ {
+		s := parquetSeries{sid: next(), first: int64(next()), rows: int64(next())}
+		series[s.sid] = s
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return fields, series, nil
+}
+
+// parquetFileReader reads the segments of the chunks of a TSSP file from the Parquet file converted from it
+type parquetFileReader struct {
+	r      *parquet.Reader
+	fields map[string]int
+	series map[uint64]parquetSeries
+
+	mu    sync.Mutex
+	sid   uint64  // the series of the cached times
+	times []int64 // the segments of a chunk are usually read one after another
+}
+
+func openParquetFile(tsspFile string, lockPath *string) (*parquetFileReader, error) {
+	var lock fileops.FileLockOption
+	if lockPath != nil {
+		lock = fileops.FileLockOption(*lockPath)
+	}
+	pri := fileops.FilePriorityOption(fileops.IO_PRIORITY_ULTRA_HIGH)
+	fd, err := fileops.Open(parquetFileName(tsspFile), lock, pri)
+	if err != nil {
+		return nil, err
+	}
+	r, err := parquet.NewReader(fd)
+	if err != nil {
+		_ = fd.Close()
+		return nil, err
+	}
+
+	index, ok := r.Metadata(parquetIndexKey)
+	if !ok {
+		_ = r.Close()
+		return nil, errParquetIndex
+	}
+	fields, series, err := unmarshalParquetIndex(index)
+	if err != nil {
+		_ = r.Close()
+		return nil, err
+	}
+	return &parquetFileReader{r: r, fields: fields, series: series}, nil
+}
+
+// ReadData reads the columns of the schema of dst in the segment of the chunk, like tsspFileReader.ReadData
+func (r *parquetFileReader) ReadData(cm *ChunkMeta, segment int, dst *record.Record, ascending bool) (*record.Record, error) {
+	start, end, err := r.segmentRows(cm, segment)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := dst.Schema
+	fieldMatched := false
+	for i := range schema[:len(schema)-1] {
+		ref := &schema[i]
+		if cm.columnIndex(ref) < 0 {
+			continue
+		}
+		fieldMatched = true
+
+		column := 0
+		if ref.Name != record.TimeField {
+			var ok bool
+			if column, ok = r.fields[ref.Name]; !ok {
+				return nil, fmt.Errorf("field %s is not in the parquet file", ref.Name)
+			}
+		}
+		if err = r.readColumn(column, ref.Type, start, end, dst.Column(i), ascending); err != nil {
+			return nil, err
+		}
+	}
+	if !fieldMatched {
+		return nil, nil
+	}
+
+	if err = r.readColumn(0, influx.Field_Type_Int, start, end, dst.TimeColumn(), ascending); err != nil {
+		return nil, err
+	}
+	dst.TryPadColumn()
+	return dst, nil
+}
+
+// segmentRows returns the rows of the segment in the Parquet file, which are the rows of the
+// series in the time range of the segment
+func (r *parquetFileReader) segmentRows(cm *ChunkMeta, segment int) (int64, int64, error) {
+	s, ok := r.series[cm.sid]
+	if !ok {
+		return 0, 0, fmt.Errorf("series %d is not in the parquet file", cm.sid)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.sid != s.sid || r.times == nil {
+		r.times = r.times[:0]
+		err := r.r.ReadColumn(0, s.first, s.first+s.rows, func(arr arrow.Array) error {
+			ts, ok := arr.(*array.Timestamp)
+			if !ok {
+				return fmt.Errorf("invalid type %s of time column", arr.DataType())
+			}
+			for i := 0; i < ts.Len(); i++ {
+				r.times = append(r.times, int64(ts.Value(i)))
+			}
+			return nil
+		})
+		if err != nil {
+			r.times = nil
+			return 0, 0, err
+		}
+		r.sid = s.sid
+	}
+
+	tr := cm.timeRange[segment]
+	lo := sort.Search(len(r.times), func(i int) bool { return r.times[i] >= tr.minTime() })
+	hi := sort.Search(len(r.times), func(i int) bool { return r.times[i] > tr.maxTime() })
+	return s.first + int64(lo), s.first + int64(hi), nil
+}
+
+// readColumn replaces the values of col with the values of the column in the rows [start, end)
+func (r *parquetFileReader) readColumn(column, typ int, start, end int64, col *record.ColVal, ascending bool) error {
+	var arrays []arrow.Array
+	defer func() {
+		for _, arr := range arrays {
+			arr.Release()
+		}
+	}()
+	err := r.r.ReadColumn(column, start, end, func(arr arrow.Array) error {
+		arr.Retain()
+		arrays = append(arrays, arr)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	col.Init()
+	if ascending {
+		for _, arr := range arrays {
+			for i := 0; i < arr.Len(); i++ {
+				if err = appendParquetValue(col, typ, arr, i); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	for a := len(arrays) - 1; a >= 0; a-- {
+		for i := arrays[a].Len() - 1; i >= 0; i-- {
+			if err = appendParquetValue(col, typ, arrays[a], i); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func appendParquetValue(col *record.ColVal, typ int, arr arrow.Array, i int) error {
+	null := arr.IsNull(i)
+	ok := true
+	switch typ {
+	case influx.Field_Type_Float:
+		var values *array.Float64
+		if values, ok = arr.(*array.Float64); ok && null {
+			col.AppendFloatNull()
+		} else if ok {
+			col.AppendFloat(values.Value(i))
+		}
+	case influx.Field_Type_Int:
+		switch values := arr.(type) {
+		case *array.Int64:
+			if null {
+				col.AppendIntegerNull()
+			} else {
+				col.AppendInteger(values.Value(i))
+			}
+		case *array.Timestamp:
+			col.AppendInteger(int64(values.Value(i)))
+		default:
+			ok = false
+		}
+	case influx.Field_Type_UInt:
+		var values *array.Uint64
+		if values, ok = arr.(*array.Uint64); ok && null {
+			col.AppendUnsignedNull()
+		} else if ok {
+			col.AppendUnsigned(values.Value(i))
+		}
+	case influx.Field_Type_Boolean:
+		var values *array.Boolean
+		if values, ok = arr.(*array.Boolean); ok && null {
+			col.AppendBooleanNull()
+		} else if ok {
+			col.AppendBoolean(values.Value(i))
+		}
+	case influx.Field_Type_String:
+		var values *array.String
+		if values, ok = arr.(*array.String); ok && null {
+			col.AppendStringNull()
+		} else if ok {
+			col.AppendString(values.Value(i))
+		}
+	default:
+		ok = false
+	}
+	if !ok {
+		return fmt.Errorf("invalid type %s of parquet column for field type %d", arr.DataType(), typ)
+	}
+	return nil
+}
+
+func (r *parquetFileReader) Close() error {
+	return r.r.Close()
+}
+
+// parquetSource opens the Parquet file of a TSSP file at the first read. It is reset when the
+// Parquet file is converted, renamed or removed, so the next read opens the file again.
+type parquetSource struct {
+	mu     sync.Mutex
+	opened bool // the file is opened, or it can not be opened
+	reader *parquetFileReader
+}
+
+// get returns nil if the TSSP file has no Parquet file
+func (s *parquetSource) get(tsspFile string, lockPath *string) *parquetFileReader {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.opened {
+		return s.reader
+	}
+
+	s.opened = true
+	r, err := openParquetFile(tsspFile, lockPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Error("open parquet file fail, read the tssp file instead", zap.String("file", tsspFile), zap.Error(err))
+		}
+		return nil
+	}
+	s.reader = r
+	return r
+}
+
+func (s *parquetSource) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.reader != nil {
+		if err := s.reader.Close(); err != nil {
+			log.Error("close parquet file fail", zap.Error(err))
+		}
+		s.reader = nil
+	}
+	s.opened = false
+}
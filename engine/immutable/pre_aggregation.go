@@ -19,6 +19,7 @@ package immutable
 import (
 	"fmt"
 	"math"
+	"math/bits"
 	"sync"
 	"unsafe"
 
@@ -55,14 +56,15 @@ type PreAggBuilder interface {
 }
 
 var (
-	integerPreAggPool = sync.Pool{}
-	floatPreAggPool   = sync.Pool{}
-	boolPreAggPool    = sync.Pool{}
-	stringPreAggPool  = sync.Pool{}
-	timePreAggPool    = sync.Pool{}
-	SegmentLen        = (Segment{}).bytes()
-	ColumnMetaLenMin  = (ColumnMeta{}).bytes(1)
-	ChunkMetaMinLen   = (&ChunkMeta{}).minBytes()
+	integerPreAggPool  = sync.Pool{}
+	unsignedPreAggPool = sync.Pool{}
+	floatPreAggPool    = sync.Pool{}
+	boolPreAggPool     = sync.Pool{}
+	stringPreAggPool   = sync.Pool{}
+	timePreAggPool     = sync.Pool{}
+	SegmentLen         = (Segment{}).bytes()
+	ColumnMetaLenMin   = (ColumnMeta{}).bytes(1)
+	ChunkMetaMinLen    = (&ChunkMeta{}).minBytes()
 )
 
 const (
@@ -72,20 +74,22 @@ const (
 )
 
 type PreAggBuilders struct {
-	intBuilder    PreAggBuilder
-	floatBuilder  PreAggBuilder
-	stringBuilder PreAggBuilder
-	boolBuilder   PreAggBuilder
-	timeBuilder   PreAggBuilder
+	intBuilder      PreAggBuilder
+	unsignedBuilder PreAggBuilder
+	floatBuilder    PreAggBuilder
+	stringBuilder   PreAggBuilder
+	boolBuilder     PreAggBuilder
+	timeBuilder     PreAggBuilder
 }
 
 func newPreAggBuilders() *PreAggBuilders {
 	b := &PreAggBuilders{
-		intBuilder:    acquireColumnBuilder(influx.Field_Type_Int),
-		floatBuilder:  acquireColumnBuilder(influx.Field_Type_Float),
-		stringBuilder: acquireColumnBuilder(influx.Field_Type_String),
-		boolBuilder:   acquireColumnBuilder(influx.Field_Type_Boolean),
-		timeBuilder:   acquireTimePreAggBuilder(),
+		intBuilder:      acquireColumnBuilder(influx.Field_Type_Int),
+		unsignedBuilder: acquireColumnBuilder(influx.Field_Type_UInt),
+		floatBuilder:    acquireColumnBuilder(influx.Field_Type_Float),
+		stringBuilder:   acquireColumnBuilder(influx.Field_Type_String),
+		boolBuilder:     acquireColumnBuilder(influx.Field_Type_Boolean),
+		timeBuilder:     acquireTimePreAggBuilder(),
 	}
 	b.reset()
 	return b
@@ -107,8 +111,17 @@ func (b *PreAggBuilders) IntegerBuilder() *IntegerPreAgg {
 	return builder
 }
 
+func (b *PreAggBuilders) UnsignedBuilder() *UnsignedPreAgg {
+	builder, ok := b.unsignedBuilder.(*UnsignedPreAgg)
+	if !ok || builder == nil {
+		builder = NewUnsignedPreAgg()
+	}
+	return builder
+}
+
 func (b *PreAggBuilders) reset() {
 	b.intBuilder.reset()
+	b.unsignedBuilder.reset()
 	b.floatBuilder.reset()
 	b.stringBuilder.reset()
 	b.boolBuilder.reset()
@@ -122,6 +135,8 @@ func (b *PreAggBuilders) Release() {
 
 	ReleaseColumnBuilder(b.intBuilder)
 	b.intBuilder = nil
+	ReleaseColumnBuilder(b.unsignedBuilder)
+	b.unsignedBuilder = nil
 	ReleaseColumnBuilder(b.floatBuilder)
 	b.floatBuilder = nil
 	ReleaseColumnBuilder(b.stringBuilder)
@@ -139,6 +154,8 @@ func (b *PreAggBuilders) aggBuilder(ref *record.Field) PreAggBuilder {
 			return b.timeBuilder
 		}
 		return b.intBuilder
+	case influx.Field_Type_UInt:
+		return b.unsignedBuilder
 	case influx.Field_Type_Float:
 		return b.floatBuilder
 	case influx.Field_Type_String:
@@ -165,6 +182,12 @@ func acquireColumnBuilder(ty int) PreAggBuilder {
 			return NewIntegerPreAgg()
 		}
 		return v.(*IntegerPreAgg)
+	case influx.Field_Type_UInt:
+		v := unsignedPreAggPool.Get()
+		if v == nil {
+			return NewUnsignedPreAgg()
+		}
+		return v.(*UnsignedPreAgg)
 	case influx.Field_Type_Float:
 		v := floatPreAggPool.Get()
 		if v == nil {
@@ -338,6 +361,125 @@ func (m *IntegerPreAgg) merge(other *IntegerPreAgg) {
 	m.values[countIndex] += other.values[countIndex]
 }
 
+// UnsignedPreAgg has the same layout as IntegerPreAgg,
+// the min, max and sum are the bits of the uint64 values.
+// The sum saturates at MaxUint64 when it overflows, the readers then sum the data instead.
+type UnsignedPreAgg struct {
+	IntegerPreAgg
+}
+
+func NewUnsignedPreAgg() *UnsignedPreAgg {
+	m := &UnsignedPreAgg{IntegerPreAgg{values: make([]int64, countIndex+1)}}
+	m.reset()
+	return m
+}
+
+func (m *UnsignedPreAgg) reset() {
+	m.IntegerPreAgg.reset()
+	m.setMin(math.MaxUint64)
+	m.setMax(0)
+}
+
+func (m *UnsignedPreAgg) minV() uint64 { return uint64(m.values[minIndex]) }
+func (m *UnsignedPreAgg) maxV() uint64 { return uint64(m.values[maxIndex]) }
+func (m *UnsignedPreAgg) sumV() uint64 { return uint64(m.values[sumIndex]) }
+
+func (m *UnsignedPreAgg) setMin(v uint64) { m.values[minIndex] = int64(v) }
+func (m *UnsignedPreAgg) setMax(v uint64) { m.values[maxIndex] = int64(v) }
+
+func (m *UnsignedPreAgg) min() (interface{}, int64) {
+	return m.minV(), m.values[minTIndex]
+}
+
+func (m *UnsignedPreAgg) max() (interface{}, int64) {
+	return m.maxV(), m.values[maxTIndex]
+}
+
+func (m *UnsignedPreAgg) sum() interface{} {
+	return m.sumV()
+}
+
+func (m *UnsignedPreAgg) addValues(col *record.ColVal, times []int64) {
+	values := col.UnsignedValues()
+	valLen := len(values)
+	agg := m.values
+	for i, j := 0, 0; i < col.Len; i++ {
+		if col.NilCount > 0 && col.IsNil(i) {
+			continue
+		}
+
+		v := values[j]
+		j++
+		if m.minV() > v {
+			m.setMin(v)
+			agg[minTIndex] = times[i]
+		}
+		if m.maxV() < v {
+			m.setMax(v)
+			agg[maxTIndex] = times[i]
+		}
+
+		m.addSumUnsigned(v)
+	}
+
+	agg[countIndex] += int64(valLen)
+}
+
+func (m *UnsignedPreAgg) release() {
+	m.reset()
+	unsignedPreAggPool.Put(m)
+}
+
+func (m *UnsignedPreAgg) addMin(value float64, tm int64) {
+	m.addMinUnsigned(uint64(value), tm)
+}
+
+func (m *UnsignedPreAgg) addMax(value float64, tm int64) {
+	m.addMaxUnsigned(uint64(value), tm)
+}
+
+func (m *UnsignedPreAgg) addMinUnsigned(v uint64, tm int64) {
+	if v < m.minV() {
+		m.setMin(v)
+		m.values[minTIndex] = tm
+	} else if m.minV() == v {
+		if tm < m.values[minTIndex] {
+			m.values[minTIndex] = tm
+		}
+	}
+}
+
+func (m *UnsignedPreAgg) addMaxUnsigned(v uint64, tm int64) {
+	if v > m.maxV() {
+		m.setMax(v)
+		m.values[maxTIndex] = tm
+	} else if m.maxV() == v {
+		if tm < m.values[maxTIndex] {
+			m.values[maxTIndex] = tm
+		}
+	}
+}
+
+func (m *UnsignedPreAgg) addSum(v float64) { m.addSumUnsigned(uint64(v)) }
+
+func (m *UnsignedPreAgg) addSumUnsigned(v uint64) {
+	sum, carry := bits.Add64(m.sumV(), v, 0)
+	if carry != 0 {
+		sum = math.MaxUint64
+	}
+	m.values[sumIndex] = int64(sum)
+}
+
+// sumSaturated returns true if the sum may have overflowed and has to be computed from the data
+func (m *UnsignedPreAgg) sumSaturated() bool { return m.sumV() == math.MaxUint64 }
+
+func (m *UnsignedPreAgg) merge(other *UnsignedPreAgg) {
+	m.addMinUnsigned(other.minV(), other.values[minTIndex])
+	m.addMaxUnsigned(other.maxV(), other.values[maxTIndex])
+	m.addSumUnsigned(other.sumV())
+	m.values[countIndex] += other.values[countIndex]
+}
+
 // FloatPreAgg If you change the order of the elements in the structure,
 // remember to modify marshal() and unmarshal() as well.
 type FloatPreAgg struct {
@@ -17,6 +17,7 @@ limitations under the License.
 package immutable
 
 import (
+	"math"
 	"testing"
 
 	"github.com/openGemini/openGemini/lib/record"
@@ -47,6 +48,38 @@ func TestIntegerPreAgg(t *testing.T) {
 	require.Equal(t, int64(6), time)
 }
 
+func TestUnsignedPreAgg(t *testing.T) {
+	agg := NewUnsignedPreAgg()
+	col := &record.ColVal{}
+	col.AppendUnsigneds(math.MaxUint64, 1)
+	col.AppendUnsignedNull()
+	col.AppendUnsigned(math.MaxInt64 + 1)
+	agg.addValues(col, []int64{1, 2, 3, 4})
+
+	val, tm := agg.max()
+	require.Equal(t, uint64(math.MaxUint64), val.(uint64))
+	require.Equal(t, int64(1), tm)
+
+	val, tm = agg.min()
+	require.Equal(t, uint64(1), val.(uint64))
+	require.Equal(t, int64(2), tm)
+
+	other := NewUnsignedPreAgg()
+	col.Init()
+	col.AppendUnsigned(0)
+	other.addValues(col, []int64{5})
+	agg.merge(other)
+
+	val, tm = agg.min()
+	require.Equal(t, uint64(0), val.(uint64))
+	require.Equal(t, int64(5), tm)
+	require.Equal(t, uint64(math.MaxUint64), agg.sum().(uint64))
+	require.True(t, agg.sumSaturated())
+	require.Equal(t, int64(4), agg.count())
+
+	assertPreAggCodec(t, agg, NewUnsignedPreAgg())
+}
+
 func TestFloatPreAgg(t *testing.T) {
 	agg := NewFloatPreAgg()
 	col := &record.ColVal{}
@@ -194,6 +194,23 @@ func readMinRowIndex(callRef *record.Field, callCol, timeCol *record.ColVal, met
 			meta.SetMin(min, t)
 			isSet = true
 		}
+	case influx.Field_Type_UInt:
+		min := uint64(math.MaxUint64)
+		for i := rowIdxStart; i < rowIdxStop; i++ {
+			v, isNil := callCol.UnsignedValue(i)
+			if !isNil && v < min {
+				min = v
+				rowIndex = i
+				seen = true
+			}
+		}
+
+		origMin, _ := meta.Min()
+		if seen && (IsInterfaceNil(origMin) || origMin.(uint64) > min) {
+			t, _ := timeCol.IntegerValue(rowIndex)
+			meta.SetMin(min, t)
+			isSet = true
+		}
 	case influx.Field_Type_Float:
 		min := math.MaxFloat64
 		for i := rowIdxStart; i < rowIdxStop; i++ {
@@ -290,6 +307,23 @@ func readMaxRowIndex(callRef *record.Field, callCol, timeCol *record.ColVal, met
 			meta.SetMax(max, t)
 			isSet = true
 		}
+	case influx.Field_Type_UInt:
+		max := uint64(0)
+		for i := rowIdxStart; i < rowIdxStop; i++ {
+			v, isNil := callCol.UnsignedValue(i)
+			if !isNil && (!seen || v > max) {
+				max = v
+				rowIndex = i
+				seen = true
+			}
+		}
+
+		origMax, _ := meta.Max()
+		if seen && (IsInterfaceNil(origMax) || origMax.(uint64) < max) {
+			t, _ := timeCol.IntegerValue(rowIndex)
+			meta.SetMax(max, t)
+			isSet = true
+		}
 	case influx.Field_Type_Float:
 		max := -math.MaxFloat64
 		for i := rowIdxStart; i < rowIdxStop; i++ {
@@ -353,6 +387,11 @@ func getColumnValue(ref *record.Field, col *record.ColVal, rowIndex int) interfa
 		if isNil {
 			return nil
 		}
+	case influx.Field_Type_UInt:
+		value, isNil = col.UnsignedValue(rowIndex)
+		if isNil {
+			return nil
+		}
 	case influx.Field_Type_Float:
 		value, isNil = col.FloatValue(rowIndex)
 		if isNil {
@@ -382,6 +421,14 @@ func reserveColumnValue(ref *record.Field, col *record.ColVal, rowIndex int) {
 		} else {
 			col.AppendIntegerNull()
 		}
+	case influx.Field_Type_UInt:
+		value, isNil := col.UnsignedValue(rowIndex)
+		col.Init()
+		if !isNil {
+			col.AppendUnsigned(value)
+		} else {
+			col.AppendUnsignedNull()
+		}
 	case influx.Field_Type_Float:
 		value, isNil := col.FloatValue(rowIndex)
 		col.Init()
@@ -414,6 +461,9 @@ func setColumnDefaultValue(ref *record.Field, col *record.ColVal) {
 	case influx.Field_Type_Int:
 		col.Init()
 		col.AppendInteger(int64(0))
+	case influx.Field_Type_UInt:
+		col.Init()
+		col.AppendUnsigned(uint64(0))
 	case influx.Field_Type_Float:
 		col.Init()
 		col.AppendFloat(float64(0))
@@ -452,6 +502,25 @@ func sumRangeValues(ref *record.Field, col *record.ColVal, rowIdxStart, rowIdxSt
 			sum += s
 		}
 		meta.SetSum(sum)
+	case influx.Field_Type_UInt:
+		var sum uint64
+		values := col.SubUnsignedValues(rowIdxStart, rowIdxStop)
+		if len(values) == 0 {
+			return
+		}
+		for _, n := range values {
+			sum = record.AddUnsigned(sum, n)
+		}
+
+		s := meta.Sum()
+		if !IsInterfaceNil(s) {
+			s, ok := s.(uint64)
+			if !ok {
+				panic("meta Sum isn't uint64 type")
+			}
+			sum = record.AddUnsigned(sum, s)
+		}
+		meta.SetSum(sum)
 	case influx.Field_Type_Float:
 		var sum float64
 		values := col.SubFloatValues(rowIdxStart, rowIdxStop)
@@ -500,6 +569,33 @@ func appendIntegerColumn(nilBitmap []byte, bitmapOffset uint32, encData []byte,
 	return nil
 }
 
+func appendUnsignedColumn(nilBitmap []byte, bitmapOffset uint32, encData []byte, nilCount uint32, col *record.ColVal, ctx *ReadContext) error {
+	col.Init()
+	if len(encData) != 0 {
+		values, err := encoding.DecodeUnsignedBlock(encData, &col.Val, ctx.coderCtx)
+		if err != nil {
+			return err
+		}
+
+		rows := len(values) + int(nilCount)
+		col.ReserveBitmap(len(col.Val))
+		col.AppendBitmap(nilBitmap, int(bitmapOffset), rows, 0, rows)
+
+		if !ctx.Ascending {
+			_ = reverseValues(values)
+			col.Bitmap = record.ReverseBitMap(col.Bitmap, uint32(col.BitMapOffset), rows)
+		}
+
+		col.Len += rows
+		col.NilCount += int(nilCount)
+	} else {
+		rows := int(nilCount)
+		col.Append(nil, nil, nilBitmap, int(bitmapOffset), rows, int(nilCount), influx.Field_Type_UInt, 0, rows, 0, 0)
+	}
+
+	return nil
+}
+
 func appendFloatColumn(nilBitmap []byte, bitmapOffset uint32, encData []byte, nilCount uint32, col *record.ColVal, ctx *ReadContext) error {
 	col.Init()
 	if len(encData) != 0 {
@@ -593,6 +689,7 @@ var decFuncs = make(map[int]func(nilBitmap []byte, bitmapOffset uint32, encData
 
 func InitDecFunctions() {
 	decFuncs[influx.Field_Type_Int] = appendIntegerColumn
+	decFuncs[influx.Field_Type_UInt] = appendUnsignedColumn
 	decFuncs[influx.Field_Type_Float] = appendFloatColumn
 	decFuncs[influx.Field_Type_Boolean] = appendBooleanColumn
 	decFuncs[influx.Field_Type_String] = appendStringColumn
@@ -855,7 +952,8 @@ func getValues(rec *record.Record, filterOption *BaseFilterOptions, validCountsL
 		if rec.Schema[id].Type == influx.Field_Type_Float {
 			Floatvalues[k] = rec.ColVals[id].FloatValues()
 		}
-		if rec.Schema[id].Type == influx.Field_Type_Int {
+		if rec.Schema[id].Type == influx.Field_Type_Int || rec.Schema[id].Type == influx.Field_Type_UInt {
+			// the unsigned values share the layout of the integer values
 			Integervalues[k] = rec.ColVals[id].IntegerValues()
 		}
 		if rec.Schema[id].Type == influx.Field_Type_Boolean {
@@ -891,6 +989,7 @@ func FilterByField(rec *record.Record, filterRec *record.Record, filterOption *B
 	valuer := influxql.ValuerEval{
 		Valuer: influxql.MultiValuer(
 			query.MathValuer{},
+			query.StringValuer{},
 			influxql.FilterMapValuer(filterOption.FiltersMap),
 		),
 	}
@@ -979,6 +1078,14 @@ func initIgnoreTypeFun() {
 		filterMap.SetFilterMapValue(name, Integervalue[validCount])
 	}
 
+	ignoreTypeFun[influx.Field_Type_UInt] = func(filterMap influxql.FilterMapValuer, name string, i int, col record.ColVal, validCount int, Integervalue []int64, Floatvalue []float64, Boolvalue []bool) {
+		if col.IsNil(i) {
+			filterMap.SetFilterMapValue(name, (*uint64)(nil))
+			return
+		}
+		filterMap.SetFilterMapValue(name, uint64(Integervalue[validCount]))
+	}
+
 	ignoreTypeFun[influx.Field_Type_Float] = func(filterMap influxql.FilterMapValuer, name string, i int, col record.ColVal, validCount int, Integervalue []int64, Floatvalue []float64, Boolvalue []bool) {
 		if col.IsNil(i) {
 			filterMap.SetFilterMapValue(name, (*float64)(nil))
@@ -1005,7 +1112,7 @@ func initIgnoreTypeFun() {
 	}
 }
 
-func reverseValues[T int64 | float64 | bool](values []T) []T {
+func reverseValues[T int64 | uint64 | float64 | bool](values []T) []T {
 	for i, j := 0, len(values)-1; i < j; {
 		values[i], values[j] = values[j], values[i]
 		i++
@@ -1064,6 +1171,8 @@ func readAuxData(cm *ChunkMeta, segment int, rowIndex int, dst *record.Record, c
 				col.AppendFloatNull()
 			case influx.Field_Type_Int:
 				col.AppendIntegerNull()
+			case influx.Field_Type_UInt:
+				col.AppendUnsignedNull()
 			case influx.Field_Type_String:
 				col.AppendStringNull()
 			case influx.Field_Type_Boolean:
@@ -1340,13 +1449,22 @@ func readSumCount(cm *ChunkMeta, ref *record.Field, dst *record.Record, ctx *Rea
 	timeCol := dst.TimeColumn()
 	meta := &dst.ColMeta[dstIdx]
 
-	if cm.allRowsInRange(ctx.tr) {
-		cb := ctx.preAggBuilders.aggBuilder(ref)
+	usePreAgg := cm.allRowsInRange(ctx.tr)
+	var cb PreAggBuilder
+	if usePreAgg {
+		cb = ctx.preAggBuilders.aggBuilder(ref)
 		_, err := cb.unmarshal(colMeta.preAgg)
 		if err != nil {
 			log.Error("unmarshal pre-agg data fail", zap.Error(err))
 			return err
 		}
+		// the saturated sum of the unsigned values is summed from the data to detect the overflow
+		if u, ok := cb.(*UnsignedPreAgg); ok && isSum && u.sumSaturated() {
+			usePreAgg = false
+		}
+	}
+
+	if usePreAgg {
 		if isSum {
 			meta.SetSum(cb.sum())
 		} else {
@@ -1499,6 +1617,18 @@ func minMeta(newRec, baseRec *record.Record, idx int) bool {
 		} else {
 			return false
 		}
+	case uint64:
+		base, ok := baseRecV.(uint64)
+		if !ok {
+			panic("meta Min isn't uint64 type")
+		}
+		if newRecV.(uint64) > base || (newRecV.(uint64) == base && newRecTime > baseRecTime) {
+			newRec.RecMeta.ColMeta[idx].SetMin(baseRecV, baseRecTime)
+			newRec.ColVals = baseRec.CopyColVals()
+			return true
+		} else {
+			return false
+		}
 	case float64:
 		base, ok := baseRecV.(float64)
 		if !ok {
@@ -1568,6 +1698,18 @@ func maxMeta(newRec, baseRec *record.Record, idx int) bool {
 		} else {
 			return false
 		}
+	case uint64:
+		base, ok := baseRecV.(uint64)
+		if !ok {
+			panic("meta Max isn't uint64 type")
+		}
+		if newRecV.(uint64) < base || (newRecV.(uint64) == base && newRecTime > baseRecTime) {
+			newRec.RecMeta.ColMeta[idx].SetMax(baseRecV, baseRecTime)
+			newRec.ColVals = baseRec.CopyColVals()
+			return true
+		} else {
+			return false
+		}
 	case float64:
 		base, ok := baseRecV.(float64)
 		if !ok {
@@ -1664,6 +1806,13 @@ func sumMeta(newRec, baseRec *record.Record, idx int) {
 		}
 		newRec.RecMeta.ColMeta[idx].SetSum(base + newRecV.(int64))
 		return
+	case uint64:
+		base, ok := baseRecV.(uint64)
+		if !ok {
+			panic("meta sum isn't uint64 type")
+		}
+		newRec.RecMeta.ColMeta[idx].SetSum(record.AddUnsigned(base, newRecV.(uint64)))
+		return
 	case float64:
 		base, ok := baseRecV.(float64)
 		if !ok {
@@ -1709,6 +1858,13 @@ func compareMin(newRecV, baseRecV interface{}) bool {
 		}
 
 		return newRecV.(int64) < base
+	case uint64:
+		base, ok := baseRecV.(uint64)
+		if !ok {
+			return true
+		}
+
+		return newRecV.(uint64) < base
 	case float64:
 		base, ok := baseRecV.(float64)
 		if !ok {
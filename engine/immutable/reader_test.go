@@ -18,6 +18,7 @@ package immutable
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/influxdata/influxdb/pkg/testing/assert"
@@ -286,6 +287,10 @@ func (m MocTsspFile) MetaIndexItemNum() int64 {
 	return 0
 }
 
+func (m MocTsspFile) Tombstones() *TombstoneSet {
+	return nil
+}
+
 func (m MocTsspFile) AddToEvictList(level uint16) {
 	return
 }
@@ -352,6 +357,41 @@ func TestDecodeColumnData(t *testing.T) {
 	}
 }
 
+func TestDecodeUnsignedColumnData(t *testing.T) {
+	timeCol := &record.ColVal{}
+	timeCol.AppendIntegers(1, 2, 3, 4)
+
+	col := &record.ColVal{}
+	col.AppendUnsigneds(math.MaxUint64, 1)
+	col.AppendUnsignedNull()
+	col.AppendUnsigned(math.MaxInt64 + 1)
+
+	ref := record.Field{Name: "foo", Type: influx.Field_Type_UInt}
+	ctx := &ReadContext{
+		coderCtx:  &encoding.CoderContext{},
+		Ascending: true,
+	}
+	builder := ColumnBuilder{}
+	builder.colMeta = &ColumnMeta{name: "foo", ty: uint8(ref.Type), entries: make([]Segment, 1)}
+	builder.coder = &encoding.CoderContext{}
+	require.NoError(t, builder.encUnsignedColumn([]record.ColVal{*timeCol}, []record.ColVal{*col}, 0))
+
+	other := &record.ColVal{}
+	require.NoError(t, decodeColumnData(&ref, builder.data, other, ctx, false))
+	require.Equal(t, col.UnsignedValues(), other.UnsignedValues())
+	require.Equal(t, 1, other.NilCount)
+
+	preAgg := builder.unsignedPreAggBuilder
+	minV, minTime := preAgg.min()
+	maxV, maxTime := preAgg.max()
+	require.Equal(t, uint64(1), minV.(uint64))
+	require.Equal(t, int64(2), minTime)
+	require.Equal(t, uint64(math.MaxUint64), maxV.(uint64))
+	require.Equal(t, int64(1), maxTime)
+	require.Equal(t, uint64(math.MaxUint64), preAgg.sum().(uint64))
+	require.True(t, preAgg.(*UnsignedPreAgg).sumSaturated())
+}
+
 // fix #BUG2023110300631
 func TestDecodeStringColumnDataOfTimeDesc1(t *testing.T) {
 	timeCol := &record.ColVal{}
@@ -781,3 +821,18 @@ func TestFilterByField(t *testing.T) {
 	filterBitMap.Reset()
 	filterRec.Reuse()
 }
+
+func TestFilterByField_StringFunction(t *testing.T) {
+	rec := preparePreAggBaseRec1()
+	filterOption := &BaseFilterOptions{
+		CondFunctions: &binaryfilterfunc.ConditionImpl{},
+		FieldsIdx:     []int{0, 1, 2},
+		RedIdxMap:     map[int]struct{}{},
+		FiltersMap:    make(map[string]*influxql.FilterMapValue),
+	}
+	filterBitMap := bitmap.NewFilterBitmap(filterOption.CondFunctions.NumFilter())
+	condition := influxql.MustParseExpr("upper(direction) = 'IN'")
+	result := FilterByField(rec, record.NewRecord(rec.Schema, false), filterOption, condition, nil, nil, filterBitMap, nil)
+	require.Equal(t, 4, result.RowNums())
+	require.Equal(t, []int64{5, 6, 7, 8}, result.Column(0).IntegerValues())
+}
@@ -189,6 +189,11 @@ func (reader *SegmentSequenceReader) getLogsBy(result *record.Record) ([]map[str
 			for k, col := range cols {
 				logs[k][v.Name] = col
 			}
+		case influx.Field_Type_UInt:
+			cols := result.Column(index).UnsignedValues()
+			for k, col := range cols {
+				logs[k][v.Name] = col
+			}
 		case influx.Field_Type_Boolean:
 			cols := result.Column(index).BooleanValues()
 			for k, col := range cols {
@@ -233,6 +233,8 @@ type MockTSSPFileSeqIterator struct {
 	GetFileReaderRefFn      func() int64
 	RenameOnObsFn           func(obsName string, tmp bool, opt *obs.ObsOptions) error
 	ChunkMetaCompressModeFn func() uint8
+	TombstonesFn            func() *TombstoneSet
+	AddTombstonesFn         func(items []Tombstone) error
 }
 
 func (file *MockTSSPFileSeqIterator) Path() string           { return file.PathFn() }
@@ -305,6 +307,12 @@ func (file *MockTSSPFileSeqIterator) RenameOnObs(obsName string, tmp bool, opt *
 func (file *MockTSSPFileSeqIterator) ChunkMetaCompressMode() uint8 {
 	return file.ChunkMetaCompressModeFn()
 }
+func (file *MockTSSPFileSeqIterator) Tombstones() *TombstoneSet {
+	return file.TombstonesFn()
+}
+func (file *MockTSSPFileSeqIterator) AddTombstones(items []Tombstone) error {
+	return file.AddTombstonesFn(items)
+}
 
 type MockChunkMetasReader struct {
 	ReadChunkMetasFn func(f TSSPFile, idx int) ([]byte, []uint32, error)
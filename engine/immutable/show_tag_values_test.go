@@ -301,6 +301,8 @@ type MockTableStore struct {
 	GetOutOfOrderFileNumFn   func() int
 	GetMstFileStatFn         func() *stats.FileStat
 	DropMeasurementFn        func(ctx context.Context, name string) error
+	DeleteSeriesFn           func(name string, sids []uint64, tr util.TimeRange) error
+	PurgeTombstonesFn        func() error
 	GetFileSeqFn             func() uint64
 	DisableCompAndMergeFn    func()
 	EnableCompAndMergeFn     func()
@@ -428,6 +430,13 @@ func (s *MockTableStore) GetMstFileStat() *stats.FileStat {
 func (s *MockTableStore) DropMeasurement(ctx context.Context, name string) error {
 	return s.DropMeasurementFn(ctx, name)
 }
+func (s *MockTableStore) DeleteSeries(name string, sids []uint64, tr util.TimeRange) error {
+	return s.DeleteSeriesFn(name, sids, tr)
+}
+func (s *MockTableStore) ConvertToParquet() {}
+func (s *MockTableStore) PurgeTombstones() error {
+	return s.PurgeTombstonesFn()
+}
 func (s *MockTableStore) GetFileSeq() uint64 {
 	return s.GetFileSeqFn()
 }
@@ -103,6 +103,9 @@ func (t *SortLimitCursor) Next() (*record.Record, comm.SeriesInfoIntf, error) {
 				case influx.Field_Type_Int:
 					value, _ := re.Column(k).IntegerValue(i)
 					data = append(data, value)
+				case influx.Field_Type_UInt:
+					value, _ := re.Column(k).UnsignedValue(i)
+					data = append(data, value)
 				case influx.Field_Type_String:
 					value, _ := re.Column(k).StringValueUnsafe(i)
 					data = append(data, value)
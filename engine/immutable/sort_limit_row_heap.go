@@ -75,6 +75,8 @@ func (rs *SortLimitRows) PopToRec() *record.Record {
 					r.ColVals[i].AppendFloatNull()
 				case influx.Field_Type_Int:
 					r.ColVals[i].AppendIntegerNull()
+				case influx.Field_Type_UInt:
+					r.ColVals[i].AppendUnsignedNull()
 				case influx.Field_Type_String:
 					r.ColVals[i].AppendStringNull()
 				case influx.Field_Type_Boolean:
@@ -89,6 +91,8 @@ func (rs *SortLimitRows) PopToRec() *record.Record {
 				r.ColVals[i].AppendFloat(data[i].(float64))
 			case influx.Field_Type_Int:
 				r.ColVals[i].AppendInteger(data[i].(int64))
+			case influx.Field_Type_UInt:
+				r.ColVals[i].AppendUnsigned(data[i].(uint64))
 			case influx.Field_Type_String:
 				r.ColVals[i].AppendString(data[i].(string))
 			case influx.Field_Type_Boolean:
@@ -125,6 +129,10 @@ func compareRows(sortIndex []int, schema record.Schemas, row1, row2 []interface{
 			if !CompareT(row1[index].(int64), row2[index].(int64), isAscending) {
 				return false
 			}
+		case influx.Field_Type_UInt:
+			if !CompareT(row1[index].(uint64), row2[index].(uint64), isAscending) {
+				return false
+			}
 		case influx.Field_Type_String:
 			if !CompareT(row1[index].(string), row2[index].(string), isAscending) {
 				return false
@@ -136,7 +144,7 @@ func compareRows(sortIndex []int, schema record.Schemas, row1, row2 []interface{
 	return true
 }
 
-func CompareT[T int | int64 | float64 | string](s1, s2 T, isAscending bool) bool {
+func CompareT[T int | int64 | uint64 | float64 | string](s1, s2 T, isAscending bool) bool {
 	if isAscending {
 		return s1 < s2
 	}
@@ -1204,6 +1204,11 @@ func (b *ColumnBuilder) encodeColumn(segCols []record.ColVal, tmCols []record.Co
 			if len(tmCols) != 0 {
 				b.intPreAggBuilder.addValues(segCol, tmCols[i].IntegerValues())
 			}
+		case influx.Field_Type_UInt:
+			b.data, err = encoding.EncodeUnsignedBlock(segCol.Val, b.data, b.coder)
+			if len(tmCols) != 0 {
+				b.unsignedPreAggBuilder.addValues(segCol, tmCols[i].IntegerValues())
+			}
 		default:
 			panic(ref)
 		}
@@ -1266,6 +1271,46 @@ func (c *StreamIterators) mergeIntegerPreAgg(cm *ColumnMeta, ref *record.Field,
 	return nil
 }
 
+func (c *StreamIterators) mergeUnsignedPreAgg(cm *ColumnMeta, ref *record.Field, fieldIndex []int) error {
+	ab, ok := c.colBuilder.unsignedPreAggBuilder.(*UnsignedPreAgg)
+	if !ok || ab == nil {
+		ab = NewUnsignedPreAgg()
+	}
+
+	if c.chunkSegments > c.Conf.maxSegmentLimit {
+		cm.preAgg = ab.marshal(cm.preAgg[:0])
+		return nil
+	}
+
+	aggBuilder := c.ctx.preAggBuilders.UnsignedBuilder()
+	aggBuilder.reset()
+	for i := 0; i < len(c.chunkItrs); i++ {
+		itr := c.chunkItrs[i]
+		idx := fieldIndex[i]
+		if idx >= 0 {
+			srcMeta := &itr.curtChunkMeta.colMeta[idx]
+			ab.reset()
+			if i == 0 {
+				if _, err := aggBuilder.unmarshal(srcMeta.preAgg); err != nil {
+					c.log.Error("unmarshal preagg fail", zap.String("column", ref.String()))
+					return err
+				}
+				continue
+			}
+
+			if _, err := ab.unmarshal(srcMeta.preAgg); err != nil {
+				c.log.Error("unmarshal preagg fail", zap.String("column", ref.String()))
+				return err
+			}
+
+			aggBuilder.merge(ab)
+		}
+	}
+
+	cm.preAgg = aggBuilder.marshal(cm.preAgg[:0])
+	return nil
+}
+
 func (c *StreamIterators) mergeFloatPreAgg(cm *ColumnMeta, ref *record.Field, fieldIndex []int) error {
 	ab, ok := c.colBuilder.floatPreAggBuilder.(*FloatPreAgg)
 	if !ok || ab == nil {
@@ -1397,6 +1442,8 @@ func (c *StreamIterators) genColumnPreAgg(cm *ColumnMeta, fieldIndex []int) erro
 		} else {
 			err = c.mergeIntegerPreAgg(cm, ref, fieldIndex)
 		}
+	case influx.Field_Type_UInt:
+		err = c.mergeUnsignedPreAgg(cm, ref, fieldIndex)
 	case influx.Field_Type_Float:
 		err = c.mergeFloatPreAgg(cm, ref, fieldIndex)
 	case influx.Field_Type_Boolean:
@@ -0,0 +1,497 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"fmt"
+	"hash/crc32"
+	"os"
+	"sync"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/fileops"
+	Log "github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/numberenc"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	"go.uber.org/zap"
+)
+
+const (
+	// TombstoneFileSuffix is the suffix of the file which records the deleted rows of a tssp file.
+	// The tombstone file is named after the tssp file it belongs to, e.g. 00000001-0000-00000000.tssp.tomb
+	TombstoneFileSuffix = ".tomb"
+
+	tombstoneItemSize = 8 * 3
+	tombstoneCRCLen   = 4
+)
+
+// Tombstone marks the rows of a series within [MinTime, MaxTime] as deleted
+type Tombstone struct {
+	SeriesID uint64
+	MinTime  int64
+	MaxTime  int64
+}
+
+// TombstoneSet is the set of tombstones of a tssp file.
+// Tombstones only apply to the data existing in the file when the delete is executed,
+// the data written later will be flushed to new files and is not affected.
+type TombstoneSet struct {
+	mu    sync.RWMutex
+	items map[uint64][]Tombstone
+}
+
+func NewTombstoneSet() *TombstoneSet {
+	return &TombstoneSet{items: make(map[uint64][]Tombstone)}
+}
+
+func (ts *TombstoneSet) Add(items ...Tombstone) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	for i := range items {
+		ts.items[items[i].SeriesID] = append(ts.items[items[i].SeriesID], items[i])
+	}
+}
+
+func (ts *TombstoneSet) Len() int {
+	if ts == nil {
+		return 0
+	}
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	n := 0
+	for _, items := range ts.items {
+		n += len(items)
+	}
+	return n
+}
+
+func (ts *TombstoneSet) Items() []Tombstone {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	dst := make([]Tombstone, 0, len(ts.items))
+	for _, items := range ts.items {
+		dst = append(dst, items...)
+	}
+	return dst
+}
+
+// HasSeries returns true if some rows of the series are deleted
+func (ts *TombstoneSet) HasSeries(sid uint64) bool {
+	if ts == nil {
+		return false
+	}
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	_, ok := ts.items[sid]
+	return ok
+}
+
+func (ts *TombstoneSet) Deleted(sid uint64, tm int64) bool {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	return deleted(ts.items[sid], tm)
+}
+
+func deleted(items []Tombstone, tm int64) bool {
+	for i := range items {
+		if items[i].MinTime <= tm && tm <= items[i].MaxTime {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterRecord removes the deleted rows of the series from rec.
+// rec is returned directly if none of its rows are deleted, nil is returned if all rows are deleted
+func (ts *TombstoneSet) FilterRecord(sid uint64, rec *record.Record) *record.Record {
+	if ts == nil || rec == nil || rec.RowNums() == 0 {
+		return rec
+	}
+
+	ts.mu.RLock()
+	items := ts.items[sid]
+	ts.mu.RUnlock()
+	if len(items) == 0 {
+		return rec
+	}
+
+	times := rec.Times()
+	var dst *record.Record
+	start := 0
+	for i := range times {
+		if !deleted(items, times[i]) {
+			continue
+		}
+		if dst == nil {
+			dst = record.NewRecordBuilder(rec.Schema)
+			dst.RecMeta = rec.RecMeta
+		}
+		dst.AppendRec(rec, start, i)
+		start = i + 1
+	}
+
+	if dst == nil {
+		return rec
+	}
+	dst.AppendRec(rec, start, len(times))
+	if dst.RowNums() == 0 {
+		return nil
+	}
+	return dst
+}
+
+func (ts *TombstoneSet) marshal(dst []byte) []byte {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	for _, items := range ts.items {
+		for i := range items {
+			dst = numberenc.MarshalUint64Append(dst, items[i].SeriesID)
+			dst = numberenc.MarshalUint64Append(dst, uint64(items[i].MinTime))
+			dst = numberenc.MarshalUint64Append(dst, uint64(items[i].MaxTime))
+		}
+	}
+	return numberenc.MarshalUint32Append(dst, crc32.ChecksumIEEE(dst))
+}
+
+func (ts *TombstoneSet) unmarshal(src []byte) error {
+	if len(src) < tombstoneCRCLen || (len(src)-tombstoneCRCLen)%tombstoneItemSize != 0 {
+		return fmt.Errorf("invalid tombstone data size %d", len(src))
+	}
+
+	data := src[:len(src)-tombstoneCRCLen]
+	if crc32.ChecksumIEEE(data) != numberenc.UnmarshalUint32(src[len(data):]) {
+		return fmt.Errorf("invalid tombstone data crc")
+	}
+
+	for len(data) > 0 {
+		ts.Add(Tombstone{
+			SeriesID: numberenc.UnmarshalUint64(data),
+			MinTime:  int64(numberenc.UnmarshalUint64(data[8:])),
+			MaxTime:  int64(numberenc.UnmarshalUint64(data[16:])),
+		})
+		data = data[tombstoneItemSize:]
+	}
+	return nil
+}
+
+func tombstoneFileName(tsspFile string) string {
+	return tsspFile + TombstoneFileSuffix
+}
+
+// readTombstoneFile loads the tombstones of the tssp file, nil is returned if the file has no tombstones
+func readTombstoneFile(tsspFile string, lockPath *string) (*TombstoneSet, error) {
+	name := tombstoneFileName(tsspFile)
+	if _, err := fileops.Stat(name); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var lock fileops.FileLockOption
+	if lockPath != nil {
+		lock = fileops.FileLockOption(*lockPath)
+	}
+	buf, err := fileops.ReadFile(name, lock)
+	if err != nil {
+		return nil, err
+	}
+
+	ts := NewTombstoneSet()
+	if err = ts.unmarshal(buf); err != nil {
+		return nil, fmt.Errorf("read tombstone file %s failed: %v", name, err)
+	}
+	return ts, nil
+}
+
+// writeTombstoneFile writes the tombstones to a temporary file first and renames it,
+// so a crash never leaves a partial tombstone file behind
+func writeTombstoneFile(tsspFile string, ts *TombstoneSet, lockPath *string) error {
+	var lock fileops.FileLockOption
+	if lockPath != nil {
+		lock = fileops.FileLockOption(*lockPath)
+	}
+	name := tombstoneFileName(tsspFile)
+	tmp := name + tmpFileSuffix
+	if err := fileops.WriteFile(tmp, ts.marshal(nil), 0640, lock); err != nil {
+		return err
+	}
+	return fileops.RenameFile(tmp, name, lock)
+}
+
+func removeTombstoneFile(tsspFile string, lockPath *string) error {
+	var lock fileops.FileLockOption
+	if lockPath != nil {
+		lock = fileops.FileLockOption(*lockPath)
+	}
+	err := fileops.Remove(tombstoneFileName(tsspFile), lock)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func hasTombstones(files []TSSPFile) bool {
+	for _, f := range files {
+		if f.Tombstones().Len() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteSeries marks the rows of the series within the time range as deleted in all files of the measurement.
+// Compaction and merge must be stopped before, otherwise the deleted rows may be written back
+// by a compaction which has read them before the tombstones are added
+func (m *MmsTables) DeleteSeries(name string, sids []uint64, tr util.TimeRange) error {
+	if len(sids) == 0 {
+		return nil
+	}
+
+	for _, isOrder := range []bool{true, false} {
+		files, ok := m.GetTSSPFiles(name, isOrder)
+		if !ok {
+			continue
+		}
+
+		err := func() error {
+			defer UnrefFilesReader(files.Files()...)
+			defer UnrefFiles(files.Files()...)
+
+			for _, f := range files.Files() {
+				items, err := newTombstones(f, sids, tr)
+				if err != nil {
+					return err
+				}
+				if err = f.AddTombstones(items); err != nil {
+					return err
+				}
+			}
+			return nil
+		}()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTombstones(f TSSPFile, sids []uint64, tr util.TimeRange) ([]Tombstone, error) {
+	if contains, err := f.ContainsByTime(tr); err != nil || !contains {
+		return nil, err
+	}
+
+	var items []Tombstone
+	for _, sid := range sids {
+		contains, err := f.ContainsValue(sid, tr)
+		if err != nil {
+			return nil, err
+		}
+		if contains {
+			items = append(items, Tombstone{SeriesID: sid, MinTime: tr.Min, MaxTime: tr.Max})
+		}
+	}
+	return items, nil
+}
+
+// PurgeTombstones rewrites the files with tombstones, the deleted rows are physically removed by the rewriting
+func (m *MmsTables) PurgeTombstones() error {
+	for _, isOrder := range []bool{true, false} {
+		for name, files := range m.tombstonedFiles(isOrder) {
+			for _, f := range files {
+				if err := m.purgeFileTombstones(name, f, isOrder); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (m *MmsTables) tombstonedFiles(isOrder bool) map[string][]TSSPFile {
+	ret := make(map[string][]TSSPFile)
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	tables := m.Order
+	if !isOrder {
+		tables = m.OutOfOrder
+	}
+	for name, files := range tables {
+		files.RLock()
+		for _, f := range files.Files() {
+			if f.Tombstones().Len() > 0 {
+				ret[name] = append(ret[name], f)
+			}
+		}
+		files.RUnlock()
+	}
+	return ret
+}
+
+func (m *MmsTables) purgeFileTombstones(name string, f TSSPFile, isOrder bool) error {
+	// DisableCompAndMerge waits for the rewriting, the tombstones added after it are not lost by replacing the file
+	m.wg.Add(1)
+	defer m.wg.Done()
+	if m.isClosed() || m.isCompMergeStopped() || !m.CompactionEnabled() {
+		return ErrCompStopped
+	}
+
+	path := []string{f.Path()}
+	if !m.acquire(path) {
+		// the file is being compacted, the compaction purges the deleted rows
+		return nil
+	}
+	defer m.CompactDone(path)
+
+	orderWg, inorderWg := m.refMmsTable(name, true)
+	defer m.unrefMmsTable(orderWg, inorderWg)
+
+	lg := Log.NewLogger(errno.ModuleCompact)
+	merged, err := m.rewriteWithoutDeleted(name, f, lg)
+	if err != nil {
+		return err
+	}
+
+	if merged != nil {
+		lg.Info("purge tombstones", zap.String("mst", name), zap.String("file", path[0]),
+			zap.String("new file", merged.Path()))
+		return m.ReplaceFiles(name, []TSSPFile{f}, []TSSPFile{merged}, isOrder)
+	}
+
+	// all rows of the file are deleted
+	m.deleteFilesOf(name, isOrder, f)
+	return nil
+}
+
+// rewriteWithoutDeleted writes the rows of the file which are not deleted by tombstones to a temporary file,
+// nil is returned if all rows are deleted
+func (m *MmsTables) rewriteWithoutDeleted(name string, f TSSPFile, lg *Log.Logger) (TSSPFile, error) {
+	ms := NewMergeSelf(m, lg)
+	defer ms.Stop()
+	m.Listen(ms.signal, ms.Stop)
+
+	return ms.Merge(name, []TSSPFile{f})
+}
+
+// purgeTombstonesToTemp returns the files in which each file with tombstones is replaced by a temporary copy
+// without the deleted rows, the files whose rows are all deleted are left out and returned as dropped.
+// The merge and the stream compaction read the copies, so the deleted rows are never written back,
+// the copies must be removed by removeTempFiles when the files are no longer read
+func (m *MmsTables) purgeTombstonesToTemp(name string, files []TSSPFile, lg *Log.Logger) (dst, temps, dropped []TSSPFile, err error) {
+	dst = make([]TSSPFile, 0, len(files))
+	for _, f := range files {
+		if f.Tombstones().Len() == 0 {
+			dst = append(dst, f)
+			continue
+		}
+
+		merged, err := m.rewriteWithoutDeleted(name, f, lg)
+		if err != nil {
+			m.removeTempFiles(temps)
+			return nil, nil, nil, err
+		}
+		if merged == nil {
+			dropped = append(dropped, f)
+			continue
+		}
+
+		lg.Info("purge tombstones before merge", zap.String("mst", name), zap.String("file", f.Path()),
+			zap.String("temp file", merged.Path()))
+		dst = append(dst, merged)
+		temps = append(temps, merged)
+	}
+	return dst, temps, dropped, nil
+}
+
+// purgeCompactTombstones makes the compaction read the copies of the files with tombstones
+// in which the deleted rows are purged, the copies are returned to be removed after the compaction
+func (m *MmsTables) purgeCompactTombstones(group *FilesInfo, lg *Log.Logger) ([]TSSPFile, error) {
+	if !hasTombstones(group.oldFiles) {
+		return nil, nil
+	}
+
+	purged := make(map[TSSPFile]TSSPFile)
+	var temps []TSSPFile
+	for _, itr := range group.compIts {
+		if itr.r.Tombstones().Len() == 0 {
+			continue
+		}
+		merged, err := m.rewriteWithoutDeleted(group.name, itr.r, lg)
+		if err != nil {
+			m.removeTempFiles(temps)
+			return nil, err
+		}
+		purged[itr.r] = merged
+		if merged != nil {
+			temps = append(temps, merged)
+		}
+	}
+
+	itrs := group.compIts[:0]
+	for _, itr := range group.compIts {
+		merged, ok := purged[itr.r]
+		if !ok {
+			itrs = append(itrs, itr)
+			continue
+		}
+
+		itr.Close()
+		if merged == nil {
+			// all rows of the file are deleted
+			continue
+		}
+		itr = NewFileIterator(merged, CLog)
+		if !itr.NextChunkMeta() {
+			itr.Close()
+			continue
+		}
+		itrs = append(itrs, itr)
+	}
+	group.compIts = itrs
+	return temps, nil
+}
+
+func containsFile(files []TSSPFile, f TSSPFile) bool {
+	for i := range files {
+		if files[i] == f {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MmsTables) removeTempFiles(files []TSSPFile) {
+	for _, f := range files {
+		m.removeFile(f)
+	}
+}
+
+// deleteFilesOf deletes the files whose rows are all deleted from the table
+func (m *MmsTables) deleteFilesOf(name string, isOrder bool, files ...TSSPFile) {
+	tfs, ok := m.getTSSPFiles(name, isOrder)
+	if !ok {
+		return
+	}
+	tfs.lock.Lock()
+	defer tfs.lock.Unlock()
+	for _, f := range files {
+		tfs.deleteFile(f)
+		m.removeFile(f)
+	}
+}
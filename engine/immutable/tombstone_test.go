@@ -0,0 +1,182 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTombstoneSet_FilterRecord(t *testing.T) {
+	var begin int64 = 1e12
+	rg := newRecordGenerator(begin, defaultInterval, false)
+	rec := rg.generate(getDefaultSchemas(), 10)
+
+	ts := immutable.NewTombstoneSet()
+	require.True(t, ts.FilterRecord(100, rec) == rec)
+
+	ts.Add(immutable.Tombstone{SeriesID: 100, MinTime: begin + 2*defaultInterval, MaxTime: begin + 4*defaultInterval},
+		immutable.Tombstone{SeriesID: 100, MinTime: begin + 9*defaultInterval, MaxTime: begin + 20*defaultInterval})
+	require.True(t, ts.HasSeries(100))
+	require.False(t, ts.HasSeries(101))
+	require.True(t, ts.Deleted(100, begin+3*defaultInterval))
+	require.False(t, ts.Deleted(100, begin+5*defaultInterval))
+
+	require.True(t, ts.FilterRecord(101, rec) == rec)
+
+	got := ts.FilterRecord(100, rec)
+	require.Equal(t, 6, got.RowNums())
+	require.Equal(t, []int64{begin, begin + defaultInterval, begin + 5*defaultInterval,
+		begin + 6*defaultInterval, begin + 7*defaultInterval, begin + 8*defaultInterval}, got.Times())
+
+	ts.Add(immutable.Tombstone{SeriesID: 100, MinTime: begin, MaxTime: begin + 10*defaultInterval})
+	require.Nil(t, ts.FilterRecord(100, rec))
+	require.Equal(t, 3, ts.Len())
+}
+
+func TestMmsTables_DeleteSeries(t *testing.T) {
+	var begin int64 = 1e12
+	defer beforeTest(t, 0)()
+
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	defer mh.store.Close()
+	rg := newRecordGenerator(begin, defaultInterval, true)
+
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	mh.addRecord(101, rg.generate(getDefaultSchemas(), 10))
+	mh.addRecord(102, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+
+	tr := util.TimeRange{Min: begin, Max: begin + 4*defaultInterval}
+	require.NoError(t, mh.store.DeleteSeries("mst", []uint64{100, 101}, tr))
+	require.NoError(t, mh.store.DeleteSeries("mst", []uint64{101}, util.TimeRange{Min: begin, Max: begin + 10*defaultInterval}))
+
+	files := mh.store.Order["mst"].Files()
+	require.Equal(t, 1, len(files))
+	require.Equal(t, 3, files[0].Tombstones().Len())
+	_, err := os.Stat(files[0].Path() + immutable.TombstoneFileSuffix)
+	require.NoError(t, err)
+
+	// the tombstones are loaded together with the tssp file
+	lockPath := ""
+	reopened, err := immutable.OpenTSSPFile(files[0].Path(), &lockPath, true, false)
+	require.NoError(t, err)
+	require.Equal(t, 3, reopened.Tombstones().Len())
+	require.NoError(t, reopened.Close())
+
+	assertRows := func() {
+		got := mh.readMergedRecord()
+		require.Equal(t, 2, len(got))
+		require.Equal(t, 5, got[100].RowNums())
+		require.Equal(t, 10, got[102].RowNums())
+		_, ok := got[101]
+		require.False(t, ok)
+	}
+	assertRows()
+
+	// the files are not rewritten while the compaction is stopped by deleting
+	mh.store.DisableCompAndMerge()
+	require.ErrorIs(t, mh.store.PurgeTombstones(), immutable.ErrCompStopped)
+	require.Equal(t, 3, mh.store.Order["mst"].Files()[0].Tombstones().Len())
+	mh.store.EnableCompAndMerge()
+
+	require.NoError(t, mh.store.PurgeTombstones())
+	files = mh.store.Order["mst"].Files()
+	require.Equal(t, 1, len(files))
+	require.Equal(t, 0, files[0].Tombstones().Len())
+	assertRows()
+}
+
+func TestMergeOutOfOrder_PurgeTombstones(t *testing.T) {
+	var begin int64 = 1e12
+	defer beforeTest(t, 0)()
+
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	defer mh.store.Close()
+	rg := newRecordGenerator(begin, defaultInterval, true)
+
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	mh.addRecord(101, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+
+	rg.setBegin(begin + 1)
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	mh.addRecord(101, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToUnordered())
+
+	require.NoError(t, mh.store.DeleteSeries("mst", []uint64{100}, util.TimeRange{Min: begin, Max: begin + 4*defaultInterval + 1}))
+	require.NoError(t, mh.store.DeleteSeries("mst", []uint64{101}, util.TimeRange{Min: begin, Max: begin + 20*defaultInterval}))
+
+	require.NoError(t, mh.mergeAndCompact(true))
+
+	// the deleted rows are not written back by the merge
+	require.Equal(t, 0, mh.store.GetOutOfOrderFileNum())
+	for _, f := range mh.store.Order["mst"].Files() {
+		require.Equal(t, 0, f.Tombstones().Len())
+	}
+	got := mh.readMergedRecord()
+	require.Equal(t, 1, len(got))
+	require.Equal(t, 10, got[100].RowNums())
+	assertNoTempFiles(t, saveDir)
+}
+
+func TestStreamCompact_PurgeTombstones(t *testing.T) {
+	var begin int64 = 1e12
+	defer beforeTest(t, 0)()
+	defer immutable.SetMergeFlag4TsStore(util.AutoCompact)
+
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	defer mh.store.Close()
+	rg := newRecordGenerator(begin, defaultInterval, true)
+
+	for i := 0; i < 4; i++ {
+		mh.addRecord(100, rg.incrBegin(20).generate(getDefaultSchemas(), 10))
+		mh.addRecord(101, rg.generate(getDefaultSchemas(), 10))
+		require.NoError(t, mh.saveToOrder())
+	}
+
+	// delete the rows of the first two files of series 100
+	require.NoError(t, mh.store.DeleteSeries("mst", []uint64{100}, util.TimeRange{Min: begin, Max: begin + 50*defaultInterval}))
+
+	immutable.SetMergeFlag4TsStore(util.StreamingCompact)
+	require.NoError(t, mh.store.FullCompact(1))
+	mh.store.Wait()
+
+	files := mh.store.Order["mst"].Files()
+	require.Equal(t, 1, len(files))
+	require.Equal(t, 0, files[0].Tombstones().Len())
+	got := mh.readMergedRecord()
+	require.Equal(t, 20, got[100].RowNums())
+	require.Equal(t, 40, got[101].RowNums())
+	assertNoTempFiles(t, saveDir)
+}
+
+// the copies of the files read by the merge and the compaction are removed
+func assertNoTempFiles(t *testing.T, dir string) {
+	require.NoError(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && strings.HasSuffix(path, immutable.GetTmpFileSuffix()) {
+			t.Errorf("temporary file %s is not removed", path)
+		}
+		return err
+	}))
+}
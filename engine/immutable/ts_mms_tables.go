@@ -90,6 +90,15 @@ func (t *tsImmTableImpl) compactToLevel(m *MmsTables, group FilesInfo, full, isN
 		newFiles, compactErr = m.compact(compItrs, group.oldFiles, group.toLevel, true, lcLog)
 		compItrs.Close()
 	} else {
+		// the stream compaction copies the column segments, the deleted rows are purged before
+		temps, err := m.purgeCompactTombstones(&group, lcLog)
+		if err != nil {
+			group.compIts.Close()
+			lcLog.Error("purge tombstones fail", zap.Error(err))
+			return err
+		}
+		defer m.removeTempFiles(temps)
+
 		compItrs := m.NewStreamIterators(group)
 		if compItrs == nil {
 			group.compIts.Close()
@@ -24,6 +24,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/cpu"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/logger"
@@ -108,6 +109,10 @@ type TSSPFile interface {
 	RenameOnObs(obsName string, tmp bool, opt *obs.ObsOptions) error
 
 	ChunkMetaCompressMode() uint8
+
+	// Tombstones returns the deleted rows of the file, nil if nothing is deleted
+	Tombstones() *TombstoneSet
+	AddTombstones(items []Tombstone) error
 }
 
 type TSSPFiles struct {
@@ -267,6 +272,9 @@ type tsspFile struct {
 
 	memEle *list.Element // lru node
 	reader FileReader
+
+	tombstones *TombstoneSet
+	parquet    parquetSource // reads the segments from the Parquet file converted from the file
 }
 
 func OpenTSSPFile(name string, lockPath *string, isOrder bool, cacheData bool) (TSSPFile, error) {
@@ -291,11 +299,18 @@ func OpenTSSPFile(name string, lockPath *string, isOrder bool, cacheData bool) (
 		return nil, err
 	}
 
+	tombstones, err := readTombstoneFile(name, lockPath)
+	if err != nil {
+		_ = fr.Close()
+		return nil, err
+	}
+
 	return &tsspFile{
-		name:   fileName,
-		reader: fr,
-		ref:    1,
-		lock:   lockPath,
+		name:       fileName,
+		reader:     fr,
+		ref:        1,
+		lock:       lockPath,
+		tombstones: tombstones,
 	}, nil
 }
 
@@ -442,6 +457,7 @@ func (f *tsspFile) FreeFileHandle() error {
 	if f.stopped() {
 		return nil
 	}
+	f.parquet.reset()
 	if err := f.reader.FreeFileHandle(); err != nil {
 		return err
 	}
@@ -511,9 +527,21 @@ func (f *tsspFile) ReadAt(cm *ChunkMeta, segment int, dst *record.Record, decs *
 		return nil, err
 	}
 
+	if len(decs.ops) == 0 && f.name.order && config.GetStoreConfig().TSSPToParquetQuery && f.tombstones.Len() == 0 {
+		if r := f.parquet.get(f.reader.FileName(), f.lock); r != nil {
+			return r.ReadData(cm, segment, dst, decs.Ascending)
+		}
+	}
 	return f.reader.ReadData(cm, segment, dst, decs, ioPriority)
 }
 
+// resetParquetReader makes the next read open the Parquet file again
+func (f *tsspFile) resetParquetReader() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.parquet.reset()
+}
+
 func (f *tsspFile) FileStat() *Trailer {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
@@ -577,7 +605,25 @@ func (f *tsspFile) Rename(newName string) error {
 	if f.stopped() {
 		return errFileClosed
 	}
-	return f.reader.Rename(newName)
+
+	oldName := f.reader.FileName()
+	f.parquet.reset()
+	if err := f.reader.Rename(newName); err != nil {
+		return err
+	}
+	if err := renameParquetFile(oldName, newName, f.lock); err != nil {
+		return err
+	}
+	if f.tombstones.Len() == 0 {
+		return nil
+	}
+
+	// the tombstones of a file being retired are still kept in memory for the running queries
+	if IsTempleFile(newName) {
+		return removeTombstoneFile(oldName, f.lock)
+	}
+	lock := fileops.FileLockOption(*f.lock)
+	return fileops.RenameFile(tombstoneFileName(oldName), tombstoneFileName(newName), lock)
 }
 
 func (f *tsspFile) UpdateLevel(level uint16) {
@@ -599,6 +645,7 @@ func (f *tsspFile) Remove() error {
 		order := f.name.order
 
 		log.Debug("remove file", zap.String("file", name))
+		f.parquet.reset()
 		_ = f.reader.Close()
 		lock := fileops.FileLockOption(*f.lock)
 		err := fileops.Remove(name, lock)
@@ -608,6 +655,14 @@ func (f *tsspFile) Remove() error {
 			f.mu.Unlock()
 			return err
 		}
+		if f.tombstones.Len() > 0 {
+			if err = removeTombstoneFile(name, f.lock); err != nil {
+				log.Error("remove tombstone file fail", zap.String("file", name), zap.Error(err))
+			}
+		}
+		if err = removeParquetFile(name, f.lock); err != nil {
+			log.Error("remove parquet file fail", zap.String("file", name), zap.Error(err))
+		}
 		f.mu.Unlock()
 
 		evict := memSize > 0
@@ -640,6 +695,7 @@ func (f *tsspFile) Close() error {
 	f.wg.Wait()
 
 	f.mu.Lock()
+	f.parquet.reset()
 	_ = f.reader.Close()
 	f.mu.Unlock()
 
@@ -816,6 +872,38 @@ func (f *tsspFile) ChunkMetaCompressMode() uint8 {
 	return f.reader.ChunkMetaCompressMode()
 }
 
+func (f *tsspFile) Tombstones() *TombstoneSet {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.tombstones
+}
+
+// AddTombstones persists the tombstones before making them visible to readers
+func (f *tsspFile) AddTombstones(items []Tombstone) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.stopped() {
+		return errFileClosed
+	}
+
+	ts := NewTombstoneSet()
+	if f.tombstones != nil {
+		ts.Add(f.tombstones.Items()...)
+	}
+	ts.Add(items...)
+	if err := writeTombstoneFile(f.reader.FileName(), ts, f.lock); err != nil {
+		return err
+	}
+	f.tombstones = ts
+	// the Parquet file still has the deleted rows, it is converted again after the tombstones are purged
+	f.parquet.reset()
+	return removeParquetFile(f.reader.FileName(), f.lock)
+}
+
 var (
 	_ TSSPFile = (*tsspFile)(nil)
 )
@@ -0,0 +1,494 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/fileops"
+	Log "github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/parquet"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/scheduler"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+const (
+	parquetFileSuffix = ".parquet"
+
+	// a row group holds the whole series until it is full, the series larger than
+	// a row group are split by the time
+	parquetRowGroupRows = parquet.DefaultRowGroupSize
+	parquetBufferSize   = 1024 * 1024
+)
+
+// parquetFileName returns the name of the Parquet file converted from the TSSP file, which is next to the TSSP file
+func parquetFileName(tsspFile string) string {
+	return strings.TrimSuffix(tsspFile, tsspFileSuffix) + parquetFileSuffix
+}
+
+// renameParquetFile keeps the Parquet file along with the TSSP file. The Parquet file is removed
+// if the TSSP file is renamed to a temporary file, which is being retired
+func renameParquetFile(oldName, newName string, lockPath *string) error {
+	if IsTempleFile(oldName) {
+		// the new TSSP files have no Parquet files
+		return nil
+	}
+	if IsTempleFile(newName) {
+		return removeParquetFile(oldName, lockPath)
+	}
+
+	var lock fileops.FileLockOption
+	if lockPath != nil {
+		lock = fileops.FileLockOption(*lockPath)
+	}
+	err := fileops.RenameFile(parquetFileName(oldName), parquetFileName(newName), lock)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func removeParquetFile(tsspFile string, lockPath *string) error {
+	if IsTempleFile(tsspFile) {
+		return nil
+	}
+
+	var lock fileops.FileLockOption
+	if lockPath != nil {
+		lock = fileops.FileLockOption(*lockPath)
+	}
+	err := fileops.Remove(parquetFileName(tsspFile), lock)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// SetIndexMergeSet sets the index to look up the tags of the series, which are written to the Parquet files
+func (m *MmsTables) SetIndexMergeSet(idx IndexMergeSet) {
+	m.idx = idx
+}
+
+// ConvertToParquet converts the TSSP files at or above the level of TSSPToParquetLevel to Parquet files.
+// The conversions run in the background as tasks of the compaction scheduler, a failed conversion is
+// retried in the next round. The files with tombstones are converted after the deleted rows are purged.
+func (m *MmsTables) ConvertToParquet() {
+	level := config.GetStoreConfig().TSSPToParquetLevel
+	if level == 0 || m.ImmTable == nil || m.ImmTable.GetEngineType() != config.TSSTORE {
+		return
+	}
+
+	var tasks []scheduler.Task
+	for _, c := range m.parquetCandidates(level) {
+		if _, err := fileops.Stat(parquetFileName(c.f.Path())); err == nil {
+			c.f.UnrefFileReader()
+			c.f.Unref()
+			continue
+		}
+		tasks = append(tasks, newParquetTask(m, c.name, c.f))
+	}
+	if len(tasks) > 0 {
+		m.scheduler.ExecuteBatch(tasks, m.stopCompMerge)
+	}
+}
+
+type parquetCandidate struct {
+	name string
+	f    TSSPFile
+}
+
+// parquetCandidates returns the referenced files which may need to be converted
+func (m *MmsTables) parquetCandidates(level uint16) []parquetCandidate {
+	var ret []parquetCandidate
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for name, files := range m.Order {
+		files.RLock()
+		for _, f := range files.Files() {
+			if lv, _ := f.LevelAndSequence(); lv < level || !f.IsOrder() || f.Tombstones().Len() > 0 {
+				continue
+			}
+			f.Ref()
+			f.RefFileReader()
+			ret = append(ret, parquetCandidate{name: name, f: f})
+		}
+		files.RUnlock()
+	}
+	return ret
+}
+
+// ParquetTask converts a TSSP file to a Parquet file, the file is acquired so it is not
+// compacted during the conversion
+type ParquetTask struct {
+	scheduler.BaseTask
+
+	table *MmsTables
+	name  string
+	file  TSSPFile
+	path  []string
+}
+
+func newParquetTask(m *MmsTables, name string, f TSSPFile) *ParquetTask {
+	task := &ParquetTask{
+		table: m,
+		name:  name,
+		file:  f,
+		path:  []string{f.Path()},
+	}
+	task.Init("parquet_" + task.path[0])
+	task.OnFinish(func() {
+		f.UnrefFileReader()
+		f.Unref()
+	})
+	return task
+}
+
+func (t *ParquetTask) BeforeExecute() bool {
+	if !t.table.acquire(t.path) {
+		// the file is being compacted, the new file is converted in the next round
+		return false
+	}
+	t.OnFinish(func() {
+		t.table.CompactDone(t.path)
+	})
+	return true
+}
+
+func (t *ParquetTask) Execute() {
+	m := t.table
+	if m.isClosed() || m.isCompMergeStopped() || t.file.Path() != t.path[0] {
+		return
+	}
+
+	lg := Log.NewLogger(errno.ModuleCompact)
+	if err := m.writeParquetFile(t.file, lg); err != nil {
+		compactStat.AddErrors(1)
+		lg.Error("convert tssp file to parquet fail", zap.String("mst", t.name), zap.String("file", t.path[0]), zap.Error(err))
+	}
+}
+
+func (m *MmsTables) writeParquetFile(f TSSPFile, lg *Log.Logger) error {
+	f.Ref()
+	f.RefFileReader()
+	defer func() {
+		f.UnrefFileReader()
+		f.Unref()
+	}()
+
+	c := &parquetConverter{m: m, f: f, lg: lg}
+	if err := c.scan(); err != nil {
+		return err
+	}
+
+	var lock fileops.FileLockOption
+	if m.lock != nil {
+		lock = fileops.FileLockOption(*m.lock)
+	}
+	name := parquetFileName(f.Path())
+	tmp := name + tmpFileSuffix
+	pri := fileops.FilePriorityOption(fileops.IO_PRIORITY_LOW_READ)
+	fd, err := fileops.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0640, lock, pri)
+	if err != nil {
+		return err
+	}
+
+	err = c.write(fd)
+	if err == nil {
+		err = fd.Sync()
+	}
+	if closeErr := fd.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && parquetFileName(f.Path()) != name {
+		err = fmt.Errorf("file %s is renamed during the conversion", name)
+	}
+	if err == nil && f.Tombstones().Len() > 0 {
+		err = fmt.Errorf("rows of file %s are deleted during the conversion", f.Path())
+	}
+	if err != nil {
+		_ = fileops.Remove(tmp, lock)
+		return err
+	}
+	if err = fileops.RenameFile(tmp, name, lock); err != nil {
+		return err
+	}
+	if tf, ok := f.(*tsspFile); ok {
+		// the queries read the new Parquet file
+		tf.resetParquetReader()
+	}
+	return nil
+}
+
+type parquetTag struct {
+	key, value string
+}
+
+// parquetConverter writes the rows of a TSSP file to a Parquet file, ordered by the series and the time.
+// The columns are the time, the tags and the fields, the tags are dictionary encoded.
+type parquetConverter struct {
+	m  *MmsTables
+	f  TSSPFile
+	lg *Log.Logger
+
+	tags       map[uint64][][]parquetTag // the tag sets of the series, more than one if the series has tag arrays
+	series     []parquetSeries           // the series in the order of the file
+	schema     parquet.Schema
+	tagIndex   map[string]int // the index of the columns of the tags in the schema
+	fieldIndex map[string]int
+}
+
+// scan reads the chunk metas to build the schema of the file, and looks up the tags of the series
+func (c *parquetConverter) scan() error {
+	c.tags = make(map[uint64][][]parquetTag)
+	tagKeys := make(map[string]struct{})
+	fields := make(map[string]int)
+
+	var metas []ChunkMeta
+	var buf []byte
+	n := int(c.f.MetaIndexItemNum())
+	for i := 0; i < n; i++ {
+		mi, err := c.f.MetaIndexAt(i)
+		if err != nil {
+			return err
+		}
+		metas, err = c.f.ReadChunkMetaData(i, mi, metas[:0], fileops.IO_PRIORITY_LOW_READ)
+		if err != nil {
+			return err
+		}
+
+		for k := range metas {
+			cm := &metas[k]
+			rows, err := chunkRows(cm)
+			if err != nil {
+				return err
+			}
+			c.series = append(c.series, parquetSeries{sid: cm.sid, rows: rows})
+			for j := range cm.colMeta {
+				if name := cm.colMeta[j].Name(); name != record.TimeField {
+					fields[name] = int(cm.colMeta[j].ty)
+				}
+			}
+			if c.m.idx == nil {
+				continue
+			}
+
+			sid := cm.sid
+			err = c.m.idx.GetSeries(sid, buf[:0], nil, func(key *influx.SeriesKey) {
+				tags := make([]parquetTag, len(key.TagSet))
+				for t := range key.TagSet {
+					tags[t] = parquetTag{key: string(key.TagSet[t].Key), value: string(key.TagSet[t].Value)}
+					tagKeys[tags[t].key] = struct{}{}
+				}
+				c.tags[sid] = append(c.tags[sid], tags)
+			})
+			if err != nil && !errno.Equal(err, errno.ErrSearchSeriesKey) {
+				return err
+			}
+		}
+	}
+
+	c.schema = append(c.schema, parquet.Field{Name: record.TimeField, Type: parquet.Timestamp, Required: true})
+	c.tagIndex = make(map[string]int, len(tagKeys))
+	for _, key := range sortedKeys(tagKeys) {
+		c.tagIndex[key] = len(c.schema)
+		c.schema = append(c.schema, parquet.Field{Name: key, Type: parquet.String, Dictionary: true})
+	}
+	c.fieldIndex = make(map[string]int, len(fields))
+	for _, name := range sortedKeys(fields) {
+		// the field has the same name as a tag, it is renamed as the column of the queries
+		column := name
+		for i := 1; c.hasColumn(column); i++ {
+			column = fmt.Sprintf("%s_%d", name, i)
+		}
+		c.fieldIndex[name] = len(c.schema)
+		c.schema = append(c.schema, parquet.Field{Name: column, Type: parquetType(fields[name])})
+	}
+
+	// the rows of the series are repeated for every tag set
+	var first int64
+	for i := range c.series {
+		c.series[i].first = first
+		copies := int64(len(c.tags[c.series[i].sid]))
+		if copies == 0 {
+			copies = 1
+		}
+		first += c.series[i].rows * copies
+	}
+	return nil
+}
+
+// chunkRows returns the number of rows of the chunk, which is kept by the pre-aggregation of the time column
+func chunkRows(cm *ChunkMeta) (int64, error) {
+	tp := &TimePreAgg{}
+	if _, err := tp.unmarshal(cm.timeMeta().preAgg); err != nil {
+		return 0, err
+	}
+	return tp.count(), nil
+}
+
+func (c *parquetConverter) hasColumn(name string) bool {
+	for i := range c.schema {
+		if c.schema[i].Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *parquetConverter) write(fd fileops.File) error {
+	bw := bufio.NewWriterSize(fd, parquetBufferSize)
+	w, err := parquet.NewWriter(bw, c.schema, parquet.Options{
+		RowGroupSize: parquetRowGroupRows,
+		Gzip:         true,
+		Metadata:     map[string]string{parquetIndexKey: marshalParquetIndex(c.fieldIndex, c.series)},
+	})
+	if err != nil {
+		return err
+	}
+
+	itr := NewChunkIterator(NewFileIterator(c.f, c.lg))
+	itr.WithLog(c.lg)
+	defer itr.Close()
+
+	row := make([]interface{}, len(c.schema))
+	var readers []parquetFieldReader
+	for n := 0; itr.Next(); n++ {
+		if c.m.isClosed() || c.m.isCompMergeStopped() {
+			return ErrCompStopped
+		}
+
+		rec := itr.GetRecord()
+		rows := rec.RowNums()
+		// the index of the file is written before the rows
+		if n >= len(c.series) || c.series[n].sid != itr.GetSeriesID() || c.series[n].rows != int64(rows) {
+			return fmt.Errorf("rows of series %d mismatch the chunk meta of file %s", itr.GetSeriesID(), c.f.Path())
+		}
+		tagSets := c.tags[itr.GetSeriesID()]
+		if len(tagSets) == 0 {
+			tagSets = [][]parquetTag{nil}
+		}
+		if w.Buffered() > 0 && w.Buffered()+rows*len(tagSets) > parquetRowGroupRows {
+			if err = w.Flush(); err != nil {
+				return err
+			}
+		}
+
+		times := rec.Times()
+		for _, tags := range tagSets {
+			readers = c.fieldReaders(readers[:0], rec)
+			for i := range row {
+				row[i] = nil
+			}
+			for _, tag := range tags {
+				row[c.tagIndex[tag.key]] = tag.value
+			}
+
+			for r := 0; r < rows; r++ {
+				row[0] = times[r]
+				for i := range readers {
+					row[readers[i].column] = readers[i].value(r)
+				}
+				if err = w.WriteRow(row); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if itr.err != nil {
+		return itr.err
+	}
+
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func (c *parquetConverter) fieldReaders(dst []parquetFieldReader, rec *record.Record) []parquetFieldReader {
+	for i := 0; i < rec.ColNums()-1; i++ {
+		column, ok := c.fieldIndex[rec.Schema[i].Name]
+		if !ok {
+			continue
+		}
+		dst = append(dst, parquetFieldReader{column: column, typ: rec.Schema[i].Type, col: rec.Column(i)})
+	}
+	return dst
+}
+
+// parquetFieldReader reads the values of a column of the record row by row
+type parquetFieldReader struct {
+	column int
+	typ    int
+	col    *record.ColVal
+	next   int // the index of the next value, the nulls are not in the values
+}
+
+func (r *parquetFieldReader) value(row int) interface{} {
+	if r.col.IsNil(row) {
+		return nil
+	}
+	if r.typ == influx.Field_Type_String {
+		v, _ := r.col.StringValue(row)
+		return v
+	}
+
+	i := r.next
+	r.next++
+	switch r.typ {
+	case influx.Field_Type_Float:
+		return r.col.FloatValues()[i]
+	case influx.Field_Type_Int:
+		return r.col.IntegerValues()[i]
+	case influx.Field_Type_UInt:
+		return r.col.UnsignedValues()[i]
+	case influx.Field_Type_Boolean:
+		return r.col.BooleanValues()[i]
+	}
+	return nil
+}
+
+func parquetType(typ int) parquet.Type {
+	switch typ {
+	case influx.Field_Type_Float:
+		return parquet.Double
+	case influx.Field_Type_Int:
+		return parquet.Int64
+	case influx.Field_Type_UInt:
+		return parquet.Uint64
+	case influx.Field_Type_Boolean:
+		return parquet.Boolean
+	default:
+		return parquet.String
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
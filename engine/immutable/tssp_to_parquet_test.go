@@ -0,0 +1,334 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+type parquetTestIndex map[uint64][]influx.TagKV
+
+func (idx parquetTestIndex) GetSeries(sid uint64, _ []byte, _ influxql.Expr, callback func(key *influx.SeriesKey)) error {
+	tags, ok := idx[sid]
+	if !ok {
+		return errno.NewError(errno.ErrSearchSeriesKey)
+	}
+	callback(&influx.SeriesKey{Measurement: []byte("mst"), TagSet: tags})
+	return nil
+}
+
+// parquetFiles returns the contents of the Parquet files in the directory of the measurement by the names
+func parquetFiles(t *testing.T, dir string) map[string][]byte {
+	files := make(map[string][]byte)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".parquet") {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		files[strings.TrimSuffix(filepath.Base(path), ".parquet")] = data
+		return err
+	})
+	require.NoError(t, err)
+	return files
+}
+
+func TestFullCompact_TSSPToParquet(t *testing.T) {
+	var begin int64 = 1e12
+	defer beforeTest(t, 10)()
+	schemas := getDefaultSchemas()
+
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	defer mh.store.Close()
+	mh.store.SetIndexMergeSet(parquetTestIndex{
+		100: {{Key: []byte("host"), Value: []byte("server-a")}, {Key: []byte("int"), Value: []byte("tag-int")}},
+		101: {{Key: []byte("host"), Value: []byte("server-b")}},
+	})
+	rg := newRecordGenerator(begin, defaultInterval, true)
+
+	conf := config.GetStoreConfig()
+	conf.TSSPToParquetLevel = 1
+	defer func() {
+		conf.TSSPToParquetLevel = 0
+	}()
+
+	for i := 0; i < 3; i++ {
+		rg.setBegin(begin).incrBegin(i * 20)
+		mh.addRecord(100, rg.generate(schemas, 10))
+		mh.addRecord(101, rg.generate(schemas, 10))
+		// the series is not in the index
+		mh.addRecord(102, rg.generate(schemas, 10))
+		require.NoError(t, mh.saveToOrder())
+	}
+	require.Equal(t, 0, len(parquetFiles(t, saveDir)))
+
+	require.NoError(t, mh.store.FullCompact(1))
+	mh.store.Wait()
+
+	files := mh.store.Order["mst"].Files()
+	require.Equal(t, 1, len(files))
+	lv, _ := files[0].LevelAndSequence()
+	require.Equal(t, uint16(1), lv)
+	// the files are converted in the background, not by the compaction
+	require.Equal(t, 0, len(parquetFiles(t, saveDir)))
+
+	mh.store.ConvertToParquet()
+	mh.store.Wait()
+	parquets := parquetFiles(t, saveDir)
+	require.Equal(t, 1, len(parquets))
+	data, ok := parquets[strings.TrimSuffix(filepath.Base(files[0].Path()), ".tssp")]
+	require.True(t, ok)
+	require.Equal(t, "PAR1", string(data[:4]))
+	require.Equal(t, "PAR1", string(data[len(data)-4:]))
+
+	// the schema and the statistics of the tags are in the footer, which is not compressed
+	size := binary.LittleEndian.Uint32(data[len(data)-8:])
+	footer := data[len(data)-8-int(size) : len(data)-8]
+	for _, s := range []string{"time", "host", "int", "int_1", "float", "boolean", "string", "server-a", "server-b", "tag-int"} {
+		require.True(t, bytes.Contains(footer, []byte(s)), s)
+	}
+
+	// the new file at the level is converted
+	rg.setBegin(begin).incrBegin(100)
+	mh.addRecord(100, rg.generate(schemas, 10))
+	require.NoError(t, mh.saveToOrder())
+	require.NoError(t, mh.store.FullCompact(1))
+	mh.store.Wait()
+	mh.store.ConvertToParquet()
+	mh.store.Wait()
+
+	files = mh.store.Order["mst"].Files()
+	require.Equal(t, 2, len(files))
+	parquets = parquetFiles(t, saveDir)
+	require.Equal(t, 2, len(parquets))
+	for _, f := range files {
+		_, ok = parquets[strings.TrimSuffix(filepath.Base(f.Path()), ".tssp")]
+		require.True(t, ok)
+	}
+
+	// the Parquet files of the compacted files are removed
+	conf.TSSPToParquetLevel = 0
+	require.NoError(t, mh.store.FullCompact(1))
+	mh.store.Wait()
+	require.Equal(t, 1, len(mh.store.Order["mst"].Files()))
+	require.Equal(t, 0, len(parquetFiles(t, saveDir)))
+}
+
+func TestDeleteSeries_TSSPToParquet(t *testing.T) {
+	var begin int64 = 1e12
+	defer beforeTest(t, 10)()
+	schemas := getDefaultSchemas()
+
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	defer mh.store.Close()
+	mh.store.SetIndexMergeSet(parquetTestIndex{
+		100: {{Key: []byte("host"), Value: []byte("server-a")}},
+		101: {{Key: []byte("host"), Value: []byte("server-b")}},
+	})
+	rg := newRecordGenerator(begin, defaultInterval, true)
+
+	conf := config.GetStoreConfig()
+	conf.TSSPToParquetLevel = 1
+	defer func() {
+		conf.TSSPToParquetLevel = 0
+	}()
+
+	for i := 0; i < 2; i++ {
+		rg.setBegin(begin).incrBegin(i * 20)
+		mh.addRecord(100, rg.generate(schemas, 10))
+		mh.addRecord(101, rg.generate(schemas, 10))
+		require.NoError(t, mh.saveToOrder())
+	}
+	require.NoError(t, mh.store.FullCompact(1))
+	mh.store.Wait()
+	mh.store.ConvertToParquet()
+	mh.store.Wait()
+	require.Equal(t, 1, len(parquetFiles(t, saveDir)))
+
+	// the Parquet file with the deleted rows is removed, and not converted until the tombstones are purged
+	require.NoError(t, mh.store.DeleteSeries("mst", []uint64{101}, util.TimeRange{Min: begin, Max: begin + 100*defaultInterval}))
+	require.Equal(t, 0, len(parquetFiles(t, saveDir)))
+	mh.store.ConvertToParquet()
+	mh.store.Wait()
+	require.Equal(t, 0, len(parquetFiles(t, saveDir)))
+
+	require.NoError(t, mh.store.PurgeTombstones())
+	mh.store.ConvertToParquet()
+	mh.store.Wait()
+
+	files := mh.store.Order["mst"].Files()
+	require.Equal(t, 1, len(files))
+	parquets := parquetFiles(t, saveDir)
+	require.Equal(t, 1, len(parquets))
+	data, ok := parquets[strings.TrimSuffix(filepath.Base(files[0].Path()), ".tssp")]
+	require.True(t, ok)
+	size := binary.LittleEndian.Uint32(data[len(data)-8:])
+	footer := data[len(data)-8-int(size) : len(data)-8]
+	require.True(t, bytes.Contains(footer, []byte("server-a")))
+	require.False(t, bytes.Contains(footer, []byte("server-b")))
+}
+
+// readSegments returns the values of the segments of the chunks in the file, row by row
+func readSegments(t *testing.T, f immutable.TSSPFile, ascending bool, fields []string) [][]interface{} {
+	var rows [][]interface{}
+	var metas []immutable.ChunkMeta
+	for i := 0; i < int(f.MetaIndexItemNum()); i++ {
+		mi, err := f.MetaIndexAt(i)
+		require.NoError(t, err)
+		metas, err = f.ReadChunkMetaData(i, mi, metas[:0], fileops.IO_PRIORITY_LOW_READ)
+		require.NoError(t, err)
+
+		for k := range metas {
+			cm := &metas[k]
+			var schema record.Schemas
+			for _, name := range fields {
+				typ := influx.Field_Type_Float
+				for _, col := range cm.GetColMeta() {
+					if col.Name() == name {
+						typ = int(col.Type())
+					}
+				}
+				schema = append(schema, record.Field{Name: name, Type: typ})
+			}
+			schema = append(schema, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
+
+			for s := 0; s < cm.SegmentCount(); s++ {
+				rec, err := f.ReadAt(cm, s, record.NewRecordBuilder(schema), immutable.NewReadContext(ascending), fileops.IO_PRIORITY_LOW_READ)
+				require.NoError(t, err)
+				if rec != nil {
+					rows = append(rows, recordRows(rec)...)
+				}
+			}
+		}
+	}
+	return rows
+}
+
+func recordRows(rec *record.Record) [][]interface{} {
+	rows := make([][]interface{}, rec.RowNums())
+	for r := range rows {
+		for i := range rec.Schema {
+			col := rec.Column(i)
+			var v interface{}
+			switch rec.Schema[i].Type {
+			case influx.Field_Type_Float:
+				v = col.FloatValues()
+			case influx.Field_Type_Int:
+				v = col.IntegerValues()
+			case influx.Field_Type_Boolean:
+				v = col.BooleanValues()
+			case influx.Field_Type_String:
+				v = col.StringValues(nil)
+			}
+			if col.IsNil(r) {
+				rows[r] = append(rows[r], nil)
+				continue
+			}
+			rows[r] = append(rows[r], rowValue(v, col.ValidCount(0, r)))
+		}
+	}
+	return rows
+}
+
+func rowValue(values interface{}, i int) interface{} {
+	switch v := values.(type) {
+	case []float64:
+		return v[i]
+	case []int64:
+		return v[i]
+	case []bool:
+		return v[i]
+	case []string:
+		return v[i]
+	}
+	return nil
+}
+
+func TestReadAt_TSSPToParquetQuery(t *testing.T) {
+	var begin int64 = 1e12
+	defer beforeTest(t, 10)()
+	schemas := getDefaultSchemas()
+
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	defer mh.store.Close()
+	mh.store.SetIndexMergeSet(parquetTestIndex{
+		100: {{Key: []byte("host"), Value: []byte("server-a")}},
+		101: {{Key: []byte("host"), Value: []byte("server-b")}},
+	})
+	rg := newRecordGenerator(begin, defaultInterval, true)
+
+	conf := config.GetStoreConfig()
+	conf.TSSPToParquetLevel = 1
+	defer func() {
+		conf.TSSPToParquetLevel = 0
+		conf.TSSPToParquetQuery = false
+	}()
+
+	for i := 0; i < 3; i++ {
+		rg.setBegin(begin).incrBegin(i * 30)
+		mh.addRecord(100, rg.generate(schemas, 25))
+		mh.addRecord(101, rg.generate(schemas, 25))
+		// the series is not in the index
+		mh.addRecord(102, rg.generate(schemas, 25))
+		require.NoError(t, mh.saveToOrder())
+	}
+	require.NoError(t, mh.store.FullCompact(1))
+	mh.store.Wait()
+	mh.store.ConvertToParquet()
+	mh.store.Wait()
+	require.Equal(t, 1, len(parquetFiles(t, saveDir)))
+
+	files := mh.store.Order["mst"].Files()
+	require.Equal(t, 1, len(files))
+	f := files[0]
+
+	all := []string{"boolean", "float", "int", "string"}
+	partial := []string{"float", "none"}
+	read := func(query bool, ascending bool, fields []string) [][]interface{} {
+		conf.TSSPToParquetQuery = query
+		return readSegments(t, f, ascending, fields)
+	}
+	for _, ascending := range []bool{true, false} {
+		for _, fields := range [][]string{all, partial, {"none"}} {
+			expected := read(false, ascending, fields)
+			require.Equal(t, expected, read(true, ascending, fields))
+			if fields[0] != "none" {
+				require.Equal(t, 3*3*25, len(expected))
+			}
+		}
+	}
+
+	// the file is read from the TSSP file after the Parquet file is removed
+	expected := read(false, true, all)
+	require.NoError(t, os.Remove(strings.TrimSuffix(f.Path(), ".tssp")+".parquet"))
+	require.NoError(t, f.FreeFileHandle())
+	require.Equal(t, expected, read(true, true, all))
+}
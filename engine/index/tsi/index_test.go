@@ -797,6 +797,71 @@ func TestDeleteTSIDs(t *testing.T) {
 	})
 }
 
+func TestDropSeries(t *testing.T) {
+	path := t.TempDir()
+	idx, idxBuilder := getTestIndexAndBuilder(path, config.TSSTORE)
+	defer idxBuilder.Close()
+	CreateIndexByPts(idx)
+
+	name := []byte("mn-1_0000")
+	mIndex := idx.(*MergeSetIndex)
+	f := func(expectedCount uint64, expectedSeriesKeys []string) {
+		count, err := mIndex.SeriesCardinality(name, nil, defaultTR)
+		require.NoError(t, err)
+		require.Equal(t, expectedCount, count)
+
+		dst, err := mIndex.SearchSeries(nil, name, nil, defaultTR)
+		require.NoError(t, err)
+		keys := make([]string, 0, len(dst))
+		for _, key := range dst {
+			keys = append(keys, string(key))
+			influx.PutBytesBuffer(key)
+		}
+		sort.Strings(keys)
+		require.Equal(t, expectedSeriesKeys, keys)
+	}
+
+	sids, err := mIndex.SearchSeriesIDs(name, MustParseExpr(`tk1='value1'`))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(sids))
+	require.NoError(t, mIndex.DropSeries(sids))
+
+	f(3, []string{
+		"mn-1_0000,tk1=value11,tk2=value2,tk3=value33",
+		"mn-1_0000,tk1=value11,tk2=value22,tk3=value3",
+		"mn-1_0000,tk1=value11,tk2=value22,tk3=value33",
+	})
+
+	t.Run("WriteDroppedSeriesAgain", func(t *testing.T) {
+		CreateIndexByPts(idx, "mn-1,tk1=value1,tk2=value2,tk3=value3")
+		f(4, []string{
+			"mn-1_0000,tk1=value1,tk2=value2,tk3=value3",
+			"mn-1_0000,tk1=value11,tk2=value2,tk3=value33",
+			"mn-1_0000,tk1=value11,tk2=value22,tk3=value3",
+			"mn-1_0000,tk1=value11,tk2=value22,tk3=value33",
+		})
+
+		newSids, err := mIndex.SearchSeriesIDs(name, MustParseExpr(`tk1='value1'`))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(newSids))
+		require.NotContains(t, sids, newSids[0])
+	})
+
+	t.Run("IndexReopen", func(t *testing.T) {
+		require.NoError(t, idx.Close())
+		require.NoError(t, idx.Open())
+
+		// the dropped series is not created again
+		CreateIndexByPts(idx, "mn-1,tk1=value1,tk2=value2,tk3=value3")
+		f(4, []string{
+			"mn-1_0000,tk1=value1,tk2=value2,tk3=value3",
+			"mn-1_0000,tk1=value11,tk2=value2,tk3=value33",
+			"mn-1_0000,tk1=value11,tk2=value22,tk3=value3",
+			"mn-1_0000,tk1=value11,tk2=value22,tk3=value33",
+		})
+	})
+}
+
 func TestSearchTagValues(t *testing.T) {
 	path := t.TempDir()
 	idx, idxBuilder := getTestIndexAndBuilder(path, config.TSSTORE)
@@ -556,9 +556,10 @@ func (idx *MergeSetIndex) getSeriesIdBySeriesKey(seriesKeyWithVersion []byte) (u
 	if err != nil {
 		return 0, err
 	}
-	if exist {
+	if exist && !idx.getDeletedTSIDs().Has(tsid) {
 		return tsid, nil
 	}
+	tsid = 0
 
 	hitRatioStat.AddSeriesKeyToTSIDCacheGetMissTotal(1)
 	// bf check process, if not exist then add to mem bf
@@ -1222,6 +1223,46 @@ func (idx *MergeSetIndex) SearchSeriesKeys(series [][]byte, name []byte, conditi
 	return idx.SearchSeries(series, name, condition, DefaultTR)
 }
 
+// SearchSeriesIDs returns the ids of the series of the measurement matching the tag condition
+func (idx *MergeSetIndex) SearchSeriesIDs(name []byte, condition influxql.Expr) ([]uint64, error) {
+	if !idx.isOpen {
+		if err := idx.Open(); err != nil {
+			return nil, err
+		}
+	}
+	return idx.searchTSIDs(name, condition, DefaultTR)
+}
+
+// HasSeries returns true if the measurement has any series matching the tag condition,
+// the series keys are looked up only until the first matching one
+func (idx *MergeSetIndex) HasSeries(name []byte, condition influxql.Expr) (bool, error) {
+	if !idx.isOpen {
+		if err := idx.Open(); err != nil {
+			return false, err
+		}
+	}
+	tsids, err := idx.searchTSIDs(name, condition, DefaultTR)
+	if err != nil {
+		return false, err
+	}
+
+	var combineKey []byte
+	var combineKeys [][]byte
+	var isExpectSeries []bool
+	for i := range tsids {
+		combineKeys, _, isExpectSeries, err = idx.searchSeriesWithTagArray(tsids[i], combineKeys, nil, combineKey, isExpectSeries, condition, false)
+		if err != nil {
+			return false, err
+		}
+		for j := range combineKeys {
+			if isExpectSeries[j] {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
 func (idx *MergeSetIndex) SearchTagValues(name []byte, tagKeys [][]byte, condition influxql.Expr) ([][]string, error) {
 	if len(tagKeys) == 0 {
 		return nil, nil
@@ -1498,6 +1539,14 @@ func (idx *MergeSetIndex) DeleteTSIDs(name []byte, condition influxql.Expr, tr T
 	return idx.deleteTSIDs(tsids)
 }
 
+// DropSeries deletes the series from the index, a dropped series gets a new id if it is written again
+func (idx *MergeSetIndex) DropSeries(tsids []uint64) error {
+	if len(tsids) == 0 {
+		return nil
+	}
+	return idx.deleteTSIDs(tsids)
+}
+
 func (idx *MergeSetIndex) deleteTSIDs(tsids []uint64) error {
 	ii := idxItemsPool.Get()
 	defer idxItemsPool.Put(ii)
@@ -97,13 +97,18 @@ func (is *indexSearch) getTSIDBySeriesKey(indexkey []byte) (uint64, error) {
 	kb.B = append(kb.B, indexkey...)
 	kb.B = append(kb.B, kvSeparatorChar)
 	ts.Seek(kb.B)
-	if ts.NextItem() {
+	// a dropped series gets a new tsid when it is written again, so skip the deleted ones
+	deleted := is.idx.getDeletedTSIDs()
+	for ts.NextItem() {
 		if !bytes.HasPrefix(ts.Item, kb.B) {
 			// Nothing found.
 			return 0, io.EOF
 		}
 		v := ts.Item[len(kb.B):]
 		pid := encoding.UnmarshalUint64(v)
+		if deleted.Has(pid) {
+			continue
+		}
 
 		// Found valid dst.
 		return pid, nil
@@ -1393,6 +1398,7 @@ func (is *indexSearch) getSeriesCount(prefix []byte) (uint64, error) {
 	ts := &is.ts
 	mp := &is.mp
 	ts.Seek(prefix)
+	deleted := is.idx.getDeletedTSIDs()
 	var seriesCount uint64
 	for ts.NextItem() {
 		item := ts.Item
@@ -1409,7 +1415,17 @@ func (is *indexSearch) getSeriesCount(prefix []byte) (uint64, error) {
 		if err := mp.InitOnlyTail(item, tail); err != nil {
 			return 0, err
 		}
-		seriesCount += uint64(mp.TSIDsLen())
+		if deleted.Len() == 0 {
+			seriesCount += uint64(mp.TSIDsLen())
+			continue
+		}
+
+		mp.ParseTSIDs()
+		for _, tsid := range mp.TSIDs {
+			if !deleted.Has(tsid) {
+				seriesCount++
+			}
+		}
 	}
 	return seriesCount, nil
 }
@@ -356,13 +356,18 @@ var transColumnFun map[influxql.DataType]func(recColumn *record.ColVal, column e
 var copyColumnFun map[influxql.DataType]func(srcColumn executor.Column, dstColumn executor.Column)
 
 func initTransColMetaFun() {
-	transColMetaFun = make(map[influxql.DataType]func(value interface{}, column executor.Column), 4)
+	transColMetaFun = make(map[influxql.DataType]func(value interface{}, column executor.Column), 6)
 
 	transColMetaFun[influxql.Integer] = func(value interface{}, column executor.Column) {
 		column.AppendIntegerValue(value.(int64))
 		column.AppendNotNil()
 	}
 
+	transColMetaFun[influxql.Unsigned] = func(value interface{}, column executor.Column) {
+		column.AppendIntegerValue(int64(value.(uint64)))
+		column.AppendNotNil()
+	}
+
 	transColMetaFun[influxql.Float] = func(value interface{}, column executor.Column) {
 		column.AppendFloatValue(value.(float64))
 		column.AppendNotNil()
@@ -385,12 +390,17 @@ func initTransColMetaFun() {
 }
 
 func initTransColAuxFun() {
-	transColAuxFun = make(map[influxql.DataType]func(recColumn *record.ColVal, column executor.Column), 4)
+	transColAuxFun = make(map[influxql.DataType]func(recColumn *record.ColVal, column executor.Column), 6)
 	transColAuxFun[influxql.Integer] = func(recColumn *record.ColVal, column executor.Column) {
 		values := recColumn.IntegerValues()
 		column.AppendIntegerValues(values)
 	}
 
+	transColAuxFun[influxql.Unsigned] = func(recColumn *record.ColVal, column executor.Column) {
+		values := recColumn.IntegerValues()
+		column.AppendIntegerValues(values)
+	}
+
 	transColAuxFun[influxql.Float] = func(recColumn *record.ColVal, column executor.Column) {
 		values := recColumn.FloatValues()
 		column.AppendFloatValues(values)
@@ -422,12 +432,17 @@ func initTransColAuxFun() {
 }
 
 func initTransColumnFun() {
-	transColumnFun = make(map[influxql.DataType]func(recColumn *record.ColVal, column executor.Column), 4)
+	transColumnFun = make(map[influxql.DataType]func(recColumn *record.ColVal, column executor.Column), 6)
 	transColumnFun[influxql.Integer] = func(recColumn *record.ColVal, column executor.Column) {
 		values := recColumn.IntegerValues()
 		column.SetIntegerValues(values)
 	}
 
+	transColumnFun[influxql.Unsigned] = func(recColumn *record.ColVal, column executor.Column) {
+		values := recColumn.IntegerValues()
+		column.SetIntegerValues(values)
+	}
+
 	transColumnFun[influxql.Float] = func(recColumn *record.ColVal, column executor.Column) {
 		values := recColumn.FloatValues()
 		column.SetFloatValues(values)
@@ -458,12 +473,17 @@ func initTransColumnFun() {
 }
 
 func initCopyColumnFun() {
-	copyColumnFun = make(map[influxql.DataType]func(srcColumn executor.Column, dstColumn executor.Column), 5)
+	copyColumnFun = make(map[influxql.DataType]func(srcColumn executor.Column, dstColumn executor.Column), 6)
 	copyColumnFun[influxql.Integer] = func(srcColumn executor.Column, dstColumn executor.Column) {
 		values := srcColumn.IntegerValues()
 		dstColumn.SetIntegerValues(values)
 	}
 
+	copyColumnFun[influxql.Unsigned] = func(srcColumn executor.Column, dstColumn executor.Column) {
+		values := srcColumn.IntegerValues()
+		dstColumn.SetIntegerValues(values)
+	}
+
 	copyColumnFun[influxql.Float] = func(srcColumn executor.Column, dstColumn executor.Column) {
 		values := srcColumn.FloatValues()
 		dstColumn.SetFloatValues(values)
@@ -486,8 +506,8 @@ func initCopyColumnFun() {
 }
 
 func validColumnType(dataType influxql.DataType) bool {
-	if dataType == influxql.Integer || dataType == influxql.Float || dataType == influxql.Boolean ||
-		dataType == influxql.String || dataType == influxql.Tag {
+	if dataType == influxql.Integer || dataType == influxql.Unsigned || dataType == influxql.Float ||
+		dataType == influxql.Boolean || dataType == influxql.String || dataType == influxql.Tag {
 		return true
 	}
 	return false
@@ -831,6 +851,9 @@ func (r *ChunkReader) Work(ctx context.Context) error {
 			}
 			iterCount++
 			rowCount += ch.Len()
+			if err = r.schema.Options().(*query.ProcessorOptions).AddPoints(ch.NumberOfRows()); err != nil {
+				return err
+			}
 
 			failpoint.Inject("fixture-on-chunkreader", nil)
 
@@ -1883,6 +1883,94 @@ func Test_PreAggregation_FullData_SingleCall(t *testing.T) {
 	}
 }
 
+func Test_PreAggregation_CalendarMonths(t *testing.T) {
+	testDir := t.TempDir()
+	executor.RegistryTransformCreator(&executor.LogicalReader{}, &ChunkReader{})
+	msNames := []string{"cpu"}
+	startTime := mustParseTime(time.RFC3339Nano, "2021-01-01T00:00:00Z")
+	// one point of value 1 every day from 2021-01-01 to 2021-03-31
+	pts, _, _ := GenDataRecord(msNames, 1, 90, 24*time.Hour, startTime, true, false, true)
+
+	fields := map[string]influxql.DataType{
+		"field2_int":    influxql.Integer,
+		"field3_bool":   influxql.Boolean,
+		"field4_float":  influxql.Float,
+		"field1_string": influxql.String,
+	}
+
+	sh, _ := createShard("db0", "rp0", 1, testDir, config.TSSTORE)
+	defer sh.Close()
+	defer sh.indexBuilder.Close()
+	if err := sh.WriteRows(pts, nil); err != nil {
+		t.Fatal(err)
+	}
+	sh.ForceFlush()
+	time.Sleep(time.Second * 1)
+
+	shardGroup := &mockShardGroup{
+		sh:     sh,
+		Fields: fields,
+	}
+
+	months := []int64{
+		mustParseTime(time.RFC3339Nano, "2021-01-01T00:00:00Z").UnixNano(),
+		mustParseTime(time.RFC3339Nano, "2021-02-01T00:00:00Z").UnixNano(),
+		mustParseTime(time.RFC3339Nano, "2021-03-01T00:00:00Z").UnixNano(),
+	}
+	for _, enable := range []bool{true, false} {
+		executor.EnableFileCursor(enable)
+		t.Run(fmt.Sprintf("file cursor %v", enable), func(t *testing.T) {
+			ctx := context.Background()
+			stmt := MustParseSelectStatement(`SELECT sum(field2_int) FROM cpu GROUP BY time(1mo)`)
+			stmt, _ = stmt.RewriteFields(shardGroup, true, false)
+			stmt.OmitTime = true
+			opt, err := query.NewProcessorOptionsStmt(stmt, query.SelectOptions{ChunkSize: 1024})
+			require.NoError(t, err)
+			require.Equal(t, int64(1), opt.Interval.Months)
+			opt.Name = msNames[0]
+			opt.Sources = influxql.Sources{&influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: msNames[0]}}
+			opt.StartTime = months[0]
+			opt.EndTime = mustParseTime(time.RFC3339Nano, "2021-04-01T00:00:00Z").UnixNano() - 1
+			querySchema := executor.NewQuerySchema(stmt.Fields, stmt.ColumnNames(), &opt, nil)
+			require.False(t, querySchema.HasOptimizeAgg())
+
+			cursors, err := sh.CreateCursor(ctx, querySchema)
+			require.NoError(t, err)
+			var keyCursors []interface{}
+			for _, cur := range cursors {
+				keyCursors = append(keyCursors, cur)
+			}
+
+			// the series are aggregated by the store
+			seriesPlan := executor.NewLogicalSeries(querySchema)
+			aggPlan := executor.NewLogicalAggregate(seriesPlan, querySchema)
+			ex := executor.NewLogicalExchange(aggPlan, executor.SERIES_EXCHANGE, nil, querySchema)
+			outputRowDataType := ex.RowDataType()
+			readerOps := make([]hybridqp.ExprOptions, 0, outputRowDataType.NumColumn())
+			for _, f := range outputRowDataType.Fields() {
+				ref := f.Expr.(*influxql.VarRef)
+				readerOps = append(readerOps, hybridqp.ExprOptions{Expr: ref, Ref: *ref})
+			}
+			chunkReader := NewChunkReader(outputRowDataType, readerOps, ex, querySchema, keyCursors, false)
+			defer chunkReader.Release()
+
+			outPutPort := executor.NewChunkPort(outputRowDataType)
+			chunkReader.GetOutputs()[0].Connect(outPutPort)
+			go func() {
+				chunkReader.Work(ctx)
+			}()
+
+			var times, sums []int64
+			for ck := range outPutPort.State {
+				times = append(times, ck.Time()...)
+				sums = append(sums, ck.Column(0).IntegerValues()...)
+			}
+			require.Equal(t, months, times)
+			require.Equal(t, []int64{31, 28, 31}, sums)
+		})
+	}
+}
+
 func Test_PreAggregation_MissingData_SingleCall(t *testing.T) {
 	testDir := t.TempDir()
 	executor.RegistryTransformCreator(&executor.LogicalReader{}, &ChunkReader{})
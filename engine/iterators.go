@@ -87,6 +87,10 @@ func init() {
 		colVal.AppendIntegerNulls(count)
 	}
 
+	AppendManyNils[influx.Field_Type_UInt] = func(colVal *record.ColVal, count int) {
+		colVal.AppendUnsignedNulls(count)
+	}
+
 	AppendManyNils[influx.Field_Type_Boolean] = func(colVal *record.ColVal, count int) {
 		colVal.AppendBooleanNulls(count)
 	}
@@ -561,6 +565,23 @@ func (i *idKeyCursorContext) hasFieldCondition() bool {
 	return len(i.filterOption.FieldsIdx) > 0
 }
 
+func (i *idKeyCursorContext) hasTombstones() bool {
+	if i.readers == nil {
+		return false
+	}
+	for _, f := range i.readers.Orders {
+		if f.Tombstones().Len() > 0 {
+			return true
+		}
+	}
+	for _, f := range i.readers.OutOfOrders {
+		if f.Tombstones().Len() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (i *idKeyCursorContext) RefFiles() {
 	for _, f := range i.readers.Orders {
 		f.Ref()
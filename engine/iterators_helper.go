@@ -109,6 +109,11 @@ func matchPreAgg(schema *executor.QuerySchema, ctx *idKeyCursorContext) bool {
 		return false
 	}
 
+	// the pre-aggregated data of the files with deleted rows is outdated
+	if ctx.hasTombstones() {
+		return false
+	}
+
 	if schema.Options().GetHintType() == hybridqp.ExactStatisticQuery {
 		return false
 	}
@@ -241,6 +246,8 @@ func (r *recordIter) readMemTableMetaRecord(ops []*comm.CallOption) {
 		switch r.record.Schema[idx].Type {
 		case influx.Field_Type_Int:
 			r.setIntColumnMeta(timeCol, idx, r.record, ops)
+		case influx.Field_Type_UInt:
+			r.setUnsignedColumnMeta(timeCol, idx, r.record, ops)
 		case influx.Field_Type_String, influx.Field_Type_Tag:
 			r.setStringColumnMeta(timeCol, idx, r.record, ops)
 		case influx.Field_Type_Float:
@@ -254,9 +261,16 @@ func (r *recordIter) readMemTableMetaRecord(ops []*comm.CallOption) {
 }
 
 func (r *recordIter) setIntColumnMeta(timeColVals *record.ColVal, idx int, rec *record.Record, ops []*comm.CallOption) {
+	setIntegerColumnMeta(r, rec.ColVals[idx].IntegerValues(), timeColVals, idx, rec, ops)
+}
+
+func (r *recordIter) setUnsignedColumnMeta(timeColVals *record.ColVal, idx int, rec *record.Record, ops []*comm.CallOption) {
+	setIntegerColumnMeta(r, rec.ColVals[idx].UnsignedValues(), timeColVals, idx, rec, ops)
+}
+
+func setIntegerColumnMeta[T int64 | uint64](r *recordIter, cols []T, timeColVals *record.ColVal, idx int, rec *record.Record, ops []*comm.CallOption) {
 	timeCols := timeColVals.IntegerValues()
 	colVals := rec.ColVals[idx]
-	cols := colVals.IntegerValues()
 	if cols == nil {
 		if len(ops) == 1 {
 			r.reset()
@@ -264,7 +278,8 @@ func (r *recordIter) setIntColumnMeta(timeColVals *record.ColVal, idx int, rec *
 		return
 	}
 
-	var minV, maxV, minVTime, maxVTime, sumV, countV int64
+	var minV, maxV, sumV T
+	var minVTime, maxVTime, countV int64
 	var colIndex, lastIndex, firstIndex, minIndex, maxIndex int
 	nilCount := 0
 	colIndex = -1
@@ -522,7 +537,7 @@ func setSchemaColVal(field *record.Field, col *record.ColVal, rowIndex int) {
 			col.Init()
 			col.AppendFloat(value)
 		}
-	case influx.Field_Type_Int:
+	case influx.Field_Type_Int, influx.Field_Type_UInt:
 		value, isNil := col.IntegerValue(rowIndex)
 		if !isNil {
 			col.Init()
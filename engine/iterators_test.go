@@ -18,6 +18,7 @@ package engine_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -3182,3 +3183,46 @@ func TestGetIntersectTimeRange(t *testing.T) {
 		})
 	}
 }
+
+func TestChunkReader_MaxPointN(t *testing.T) {
+	schema := record.Schemas{
+		record.Field{Type: influx.Field_Type_Float, Name: "value"},
+		record.Field{Type: influx.Field_Type_Int, Name: "time"},
+	}
+	var srcRecords []*record.Record
+	for i := int64(0); i < 3; i++ {
+		rec := record.NewRecordBuilder(schema)
+		rec.RecMeta = &record.RecMeta{}
+		rec.ColVals[0].AppendFloats(1.1, 2.2)
+		rec.AppendTime(2*i+1, 2*i+2)
+		srcRecords = append(srcRecords, rec)
+	}
+
+	ref := influxql.VarRef{Val: "value", Type: influxql.Float}
+	opt := &query.ProcessorOptions{ChunkSize: 2, Ascending: true}
+	opt.SetPromQuery(true)
+	opt.SetMaxPointN(5)
+	querySchema := executor.NewQuerySchema(influxql.Fields{{Expr: &ref}}, []string{"value"}, opt, nil)
+	rowDataType := hybridqp.NewRowDataTypeImpl(ref)
+	ops := []hybridqp.ExprOptions{{Expr: &ref, Ref: ref}}
+	cursor := newReaderKeyCursor(srcRecords)
+	cursor.schema = schema
+	reader := engine.NewChunkReader(rowDataType, ops, nil, querySchema, []interface{}{cursor}, false)
+	output := executor.NewChunkPort(rowDataType)
+	reader.GetOutputs()[0].Connect(output)
+
+	var rows int
+	done := make(chan struct{})
+	go func() {
+		for ck := range output.State {
+			rows += ck.NumberOfRows()
+		}
+		close(done)
+	}()
+	err := reader.Work(context.Background())
+	<-done
+
+	// the third record exceeds the limit and is not sent
+	assert.Equal(t, err, query.ErrPromTooManySamples)
+	assert.Equal(t, rows, 4)
+}
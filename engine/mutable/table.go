@@ -600,9 +600,12 @@ func checkSchemaIsSameWithTag(msSchema record.Schemas, fields []influx.Field, ta
 }
 
 func (t *MemTable) appendFieldToCol(col *record.ColVal, field *influx.Field, size *int64) error {
-	if field.Type == influx.Field_Type_Int || field.Type == influx.Field_Type_UInt {
+	if field.Type == influx.Field_Type_Int {
 		col.AppendInteger(int64(field.NumValue))
 		*size += int64(util.Int64SizeBytes)
+	} else if field.Type == influx.Field_Type_UInt {
+		col.AppendUnsigned(field.UnsignedValue())
+		*size += int64(util.Uint64SizeBytes)
 	} else if field.Type == influx.Field_Type_Float {
 		col.AppendFloat(field.NumValue)
 		*size += int64(util.Float64SizeBytes)
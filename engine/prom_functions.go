@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/record"
@@ -40,6 +41,13 @@ func init() {
 	RegistryPromFunction("predict_linear", &PredictLinearOp{})
 	RegistryPromFunction("delta_prom", &deltaOp{})
 	RegistryPromFunction("idelta_prom", &ideltaOp{})
+	RegistryPromFunction("present_over_time", &presentOp{})
+	RegistryPromFunction("stddev_over_time", &stddevOp{})
+	RegistryPromFunction("stdvar_over_time", &stdvarOp{})
+	RegistryPromFunction("quantile_over_time", &quantileOp{})
+	RegistryPromFunction("changes", &changesOp{})
+	RegistryPromFunction("resets", &resetsOp{})
+	RegistryPromFunction("holt_winters_prom", &holtWintersOp{})
 }
 
 type PromFunction interface {
@@ -154,6 +162,87 @@ func (o *ideltaOp) CreateRoutine(p *PromFuncParam) (Routine, error) {
 	return NewRoutineImpl(newFloatRateReducer(floatIRateReduce, floatIRateMerge(false), floatIRateUpdate), p.inOrdinal, p.outOrdinal), nil
 }
 
+type presentOp struct{}
+
+func (o *presentOp) CreateRoutine(p *PromFuncParam) (Routine, error) {
+	return NewRoutineImpl(newFloatIncReducer(floatPromPresentReduce, floatPromPresentMergeFunc), p.inOrdinal, p.outOrdinal), nil
+}
+
+type stddevOp struct{}
+
+func (o *stddevOp) CreateRoutine(p *PromFuncParam) (Routine, error) {
+	return NewRoutineImpl(newFloatSliceReducer(floatPromDerivReduce, floatSliceValueMerge(func(values []float64) (float64, bool) {
+		return math.Sqrt(executor.PromStdvar(values)), true
+	})), p.inOrdinal, p.outOrdinal), nil
+}
+
+type stdvarOp struct{}
+
+func (o *stdvarOp) CreateRoutine(p *PromFuncParam) (Routine, error) {
+	return NewRoutineImpl(newFloatSliceReducer(floatPromDerivReduce, floatSliceValueMerge(func(values []float64) (float64, bool) {
+		return executor.PromStdvar(values), true
+	})), p.inOrdinal, p.outOrdinal), nil
+}
+
+type quantileOp struct{}
+
+func (o *quantileOp) CreateRoutine(p *PromFuncParam) (Routine, error) {
+	q, err := promFuncLiteralArg(p, 1, "quantile_over_time")
+	if err != nil {
+		return nil, err
+	}
+	return NewRoutineImpl(newFloatSliceReducer(floatPromDerivReduce, floatSliceValueMerge(func(values []float64) (float64, bool) {
+		return executor.PromQuantile(q, values), true
+	})), p.inOrdinal, p.outOrdinal), nil
+}
+
+type changesOp struct{}
+
+func (o *changesOp) CreateRoutine(p *PromFuncParam) (Routine, error) {
+	return NewRoutineImpl(newFloatSliceReducer(floatPromDerivReduce, floatSliceValueMerge(func(values []float64) (float64, bool) {
+		return executor.PromChanges(values), true
+	})), p.inOrdinal, p.outOrdinal), nil
+}
+
+type resetsOp struct{}
+
+func (o *resetsOp) CreateRoutine(p *PromFuncParam) (Routine, error) {
+	return NewRoutineImpl(newFloatSliceReducer(floatPromDerivReduce, floatSliceValueMerge(func(values []float64) (float64, bool) {
+		return executor.PromResets(values), true
+	})), p.inOrdinal, p.outOrdinal), nil
+}
+
+type holtWintersOp struct{}
+
+func (o *holtWintersOp) CreateRoutine(p *PromFuncParam) (Routine, error) {
+	sf, err := promFuncLiteralArg(p, 1, "holt_winters")
+	if err != nil {
+		return nil, err
+	}
+	tf, err := promFuncLiteralArg(p, 2, "holt_winters")
+	if err != nil {
+		return nil, err
+	}
+	return NewRoutineImpl(newFloatSliceReducer(floatPromDerivReduce, floatSliceValueMerge(func(values []float64) (float64, bool) {
+		return executor.PromHoltWinters(values, sf, tf)
+	})), p.inOrdinal, p.outOrdinal), nil
+}
+
+// promFuncLiteralArg returns the i-th argument of the function, which must be a number literal.
+func promFuncLiteralArg(p *PromFuncParam, i int, name string) (float64, error) {
+	if i >= len(p.args) {
+		return 0, errno.NewError(errno.UnsupportedDataType, "argument of "+name, "nil")
+	}
+	switch arg := p.args[i].(type) {
+	case *influxql.IntegerLiteral:
+		return float64(arg.Val), nil
+	case *influxql.NumberLiteral:
+		return arg.Val, nil
+	default:
+		return 0, errno.NewError(errno.UnsupportedDataType, "argument of "+name, arg.String())
+	}
+}
+
 func newPromFuncProcessor(inSchema, outSchema record.Schemas, exprOpt []hybridqp.ExprOptions) (CoProcessor, error) {
 	coProcessor := NewCoProcessorImpl()
 	var inField, outField string
@@ -163,7 +252,11 @@ func newPromFuncProcessor(inSchema, outSchema record.Schemas, exprOpt []hybridqp
 			inField, outField = expr.Args[0].(*influxql.VarRef).Val, exprOpt[i].Ref.Val
 			inOrdinal, outOrdinal := inSchema.FieldIndex(inField), outSchema.FieldIndex(outField)
 			param := &PromFuncParam{inOrdinal: inOrdinal, outOrdinal: outOrdinal, args: expr.Args}
-			routine, err := GetPromFunction(expr.Name).CreateRoutine(param)
+			fn := GetPromFunction(expr.Name)
+			if fn == nil {
+				return nil, fmt.Errorf("unsupported prom function %s", expr.Name)
+			}
+			routine, err := fn.CreateRoutine(param)
 			if err != nil {
 				return nil, err
 			}
@@ -310,6 +403,17 @@ func floatPromLastMergeFunc(prevValue float64, currValue float64, prevCount, cur
 	return currValue, prevCount + currCount
 }
 
+func floatPromPresentReduce(times []int64, values []float64, start, end int) (int64, float64, bool) {
+	if start == end {
+		return 0, 0, true
+	}
+	return times[end-1], 1, false
+}
+
+func floatPromPresentMergeFunc(prevValue float64, currValue float64, prevCount, currCount int) (float64, int) {
+	return 1, prevCount + currCount
+}
+
 func floatPromRateReduce(times []int64, values []float64, start, end int) ([]int64, []float64, bool) {
 	if start >= end {
 		return []int64{}, []float64{}, true
@@ -526,6 +630,22 @@ type floatBuffer struct {
 	s, e   int
 }
 
+// floatSliceValueMerge builds the FloatSliceMergeFunc of the functions only depending on the values in the window.
+func floatSliceValueMerge(fn func(values []float64) (float64, bool)) FloatSliceMergeFunc {
+	return func(_, _ []int64, prevV, currV []float64, _ int64, _ int, _ *ReducerParams) (float64, bool) {
+		values := currV
+		if len(prevV) > 0 {
+			values = make([]float64, 0, len(prevV)+len(currV))
+			values = append(append(values, prevV...), currV...)
+		}
+		if len(values) == 0 {
+			return 0, true
+		}
+		v, ok := fn(values)
+		return v, !ok
+	}
+}
+
 func newFloatBuffer() *floatBuffer {
 	return &floatBuffer{}
 }
@@ -872,7 +992,7 @@ func (r *floatSliceReducer) populateByPrevious(outRecord *record.Record, outOrdi
 			}
 			n++
 		}
-		if n-m >= 2 {
+		if n > m {
 			r.doMiddleWindow(outRecord, outOrdinal, param, te, r.ringBuf.times[m:n], []int64{}, r.ringBuf.values[m:n], []float64{}, n-m)
 		} else {
 			break
@@ -903,7 +1023,7 @@ func (r *floatSliceReducer) populateByLast(outRecord *record.Record, outOrdinal
 			r.ringBuf.s++
 		}
 		if r.ringBuf.s < bufCount {
-			r.doMiddleWindow(outRecord, outOrdinal, param, rangeEnd, r.ringBuf.times[r.ringBuf.s:rowNum], []int64{}, r.ringBuf.values[r.ringBuf.s:rowNum], []float64{}, rowNum+bufCount-r.ringBuf.s)
+			r.doMiddleWindow(outRecord, outOrdinal, param, rangeEnd, r.ringBuf.times[r.ringBuf.s:bufCount], times[:rowNum], r.ringBuf.values[r.ringBuf.s:bufCount], values[:rowNum], rowNum+bufCount-r.ringBuf.s)
 		}
 	}
 }
@@ -1245,3 +1245,75 @@ func TestPredictLinearFunctions(t *testing.T) {
 		testRangeVectorCursor(t, inSchema, outSchema, srcRecs4, dstRecs5, exprOpt, querySchema)
 	})
 }
+
+func TestChangesAndResetsFunctions(t *testing.T) {
+	querySchema := executor.NewQuerySchema(nil, nil, opt1, nil)
+	times := [][]int64{
+		{2000000000, 4000000000, 6000000000},
+		{8000000000, 10000000000, 12000000000},
+		{14000000000, 16000000000, 18000000000},
+	}
+	for _, tt := range []struct {
+		name   string
+		values [][]float64
+	}{
+		{name: "changes", values: [][]float64{{0, 1, 2}, {1, 2, 2}, {2, 1, 0}}},
+		{name: "resets", values: [][]float64{{0, 1, 1}, {0, 0, 0}, {0, 0, 0}}},
+	} {
+		exprOpt := []hybridqp.ExprOptions{
+			{
+				Expr: &influxql.Call{Name: tt.name, Args: []influxql.Expr{hybridqp.MustParseExpr("float")}},
+				Ref:  influxql.VarRef{Val: "float", Type: influx.Field_Type_Float},
+			},
+		}
+		var dstRecs []*record.Record
+		for i := range times {
+			dstRecs = append(dstRecs, genRec(inSchema, []int{1, 1, 1}, tt.values[i], times[i]))
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			testRangeVectorCursor(t, inSchema, outSchema, srcRecs4, dstRecs, exprOpt, querySchema)
+		})
+	}
+}
+
+func TestPresentFunctions(t *testing.T) {
+	exprOpt := []hybridqp.ExprOptions{
+		{
+			Expr: &influxql.Call{Name: "present_over_time", Args: []influxql.Expr{hybridqp.MustParseExpr("float")}},
+			Ref:  influxql.VarRef{Val: "float", Type: influx.Field_Type_Float},
+		},
+	}
+
+	var dstRecs1 []*record.Record
+	dstRecs1 = append(dstRecs1,
+		genRec(inSchema,
+			[]int{1, 1, 1, 1, 1},
+			[]float64{1, 1, 1, 1, 1},
+			[]int64{2000000000, 4000000000, 6000000000, 8000000000, 10000000000}),
+	)
+	querySchema := executor.NewQuerySchema(nil, nil, opt3, nil)
+	t.Run("present_function1", func(t *testing.T) {
+		testRangeVectorCursor(t, inSchema, outSchema, srcRecs2, dstRecs1, exprOpt, querySchema)
+	})
+}
+
+func TestQuantileFunctions(t *testing.T) {
+	exprOpt := []hybridqp.ExprOptions{
+		{
+			Expr: &influxql.Call{Name: "quantile_over_time", Args: []influxql.Expr{hybridqp.MustParseExpr("float"), &influxql.NumberLiteral{Val: 0.5}}},
+			Ref:  influxql.VarRef{Val: "float", Type: influx.Field_Type_Float},
+		},
+	}
+
+	var dstRecs1 []*record.Record
+	dstRecs1 = append(dstRecs1,
+		genRec(inSchema,
+			[]int{1, 1, 1, 1, 1},
+			[]float64{2, 2.5, 4, 5, 5.5},
+			[]int64{2000000000, 4000000000, 6000000000, 8000000000, 10000000000}),
+	)
+	querySchema := executor.NewQuerySchema(nil, nil, opt3, nil)
+	t.Run("quantile_function1", func(t *testing.T) {
+		testRangeVectorCursor(t, inSchema, outSchema, srcRecs2, dstRecs1, exprOpt, querySchema)
+	})
+}
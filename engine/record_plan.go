@@ -1011,8 +1011,10 @@ func (r *FileSequenceAggregator) Aggregate() {
 		r.currSid = rec.GetSid()
 		r.file = rec.GetTsspFile()
 		r.newSeq = rec.GetSeq()
-		r.tr.Min, _ = r.schema.Options().Window(rec.GetTr().Min - int64(r.schema.Options().GetInterval()))
-		r.tr.Max, _ = r.schema.Options().Window(rec.GetTr().Max + int64(r.schema.Options().GetInterval()))
+		// the previous and the next windows of the time range
+		r.tr.Min, _ = r.schema.Options().Window(rec.GetTr().Min)
+		r.tr.Min, _ = r.schema.Options().Window(r.tr.Min - 1)
+		r.tr.Max = nextWindow(r.schema.Options(), rec.GetTr().Max)
 		if err = r.AggregateSameSchema(); err != nil {
 			panic("peek record fail")
 		}
@@ -1241,9 +1243,9 @@ func AppendRecWithNilRows(rec, re *record.Record, opt hybridqp.Options, seriesSt
 		}
 		end, _ := opt.Window(times[i])
 
-		step := (end - seriesStart) / int64(opt.GetInterval())
-		for j := int64(1); j < step; j++ {
-			AppendNilRowWithTime(rec, seriesStart+int64(opt.GetInterval())*j)
+		// walk the windows instead of adding the interval, the calendar windows vary in length
+		for t := nextWindow(opt, seriesStart); t < end; t = nextWindow(opt, t) {
+			AppendNilRowWithTime(rec, t)
 		}
 		rec.AppendRec(re, i, i+1)
 		rec.Times()[rec.RowNums()-1] = end
@@ -1251,9 +1253,8 @@ func AppendRecWithNilRows(rec, re *record.Record, opt hybridqp.Options, seriesSt
 	if last {
 		// append tail times
 		rEnd := rec.Time(rec.RowNums() - 1)
-		step := (seriesEnd - rEnd) / int64(opt.GetInterval())
-		for j := int64(1); j < step; j++ {
-			AppendNilRowWithTime(rec, rEnd+int64(opt.GetInterval())*j)
+		for t := nextWindow(opt, rEnd); nextWindow(opt, t) <= seriesEnd && t < influxql.MaxTime; t = nextWindow(opt, t) {
+			AppendNilRowWithTime(rec, t)
 		}
 	}
 	if rec.Time(0) < shardStart {
@@ -1261,12 +1262,18 @@ func AppendRecWithNilRows(rec, re *record.Record, opt hybridqp.Options, seriesSt
 	}
 }
 
+// nextWindow returns the start time of the window after the window of t.
+func nextWindow(opt hybridqp.Options, t int64) int64 {
+	_, end := opt.Window(t)
+	return end
+}
+
 func AppendNilRowWithTime(rec *record.Record, t int64) {
 	for i := 0; i < rec.Len()-1; i++ {
 		switch rec.Schema[i].Type {
 		case influx.Field_Type_Float:
 			rec.ColVals[i].AppendFloatNull()
-		case influx.Field_Type_Int:
+		case influx.Field_Type_Int, influx.Field_Type_UInt:
 			rec.ColVals[i].AppendIntegerNull()
 		case influx.Field_Type_Boolean:
 			rec.ColVals[i].AppendBooleanNull()
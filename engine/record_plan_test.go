@@ -2022,6 +2022,10 @@ func (m MocTsspFile) MetaIndexItemNum() int64 {
 	return 0
 }
 
+func (m MocTsspFile) Tombstones() *immutable.TombstoneSet {
+	return nil
+}
+
 func (m MocTsspFile) AddToEvictList(level uint16) {
 	return
 }
@@ -2356,6 +2360,34 @@ func TestAppendRecWithNilRows(t *testing.T) {
 		}
 	}
 }
+
+func TestAppendRecWithNilRows_CalendarMonths(t *testing.T) {
+	month := func(y int, m time.Month) int64 {
+		return time.Date(y, m, 1, 0, 0, 0, 0, time.UTC).UnixNano()
+	}
+	schema := record.Schemas{
+		record.Field{Type: influx.Field_Type_Int, Name: "int"},
+		record.Field{Type: influx.Field_Type_Int, Name: "time"},
+	}
+	srcRec := record.NewRecord(schema, true)
+	srcRec.ColVals[0].AppendIntegers(1, 2)
+	srcRec.RecMeta.Times = make([][]int64, 1)
+	srcRec.RecMeta.Times[0] = []int64{1, 2}
+	srcRec.AppendTime(month(2024, time.February)+1, month(2024, time.May)+1)
+
+	dstRec := record.NewRecord(schema, true)
+	dstRec.RecMeta.Times = make([][]int64, 1)
+	opt := &query.ProcessorOptions{
+		Interval:  hybridqp.Interval{Duration: influxql.AverageMonth, Months: 1},
+		Ascending: true,
+	}
+	AppendRecWithNilRows(dstRec, srcRec, opt, month(2024, time.January), month(2024, time.August), month(2024, time.January), true)
+	require.Equal(t, []int64{
+		month(2024, time.February), month(2024, time.March), month(2024, time.April),
+		month(2024, time.May), month(2024, time.June), month(2024, time.July),
+	}, dstRec.Times())
+}
+
 func Test_Create(t *testing.T) {
 	testDir := t.TempDir()
 	executor.RegistryTransformCreator(&executor.LogicalTSSPScan{}, &TsspSequenceReader{})
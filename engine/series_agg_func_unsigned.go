@@ -0,0 +1,78 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"unsafe"
+
+	"github.com/openGemini/openGemini/lib/record"
+)
+
+// The unsigned columns are reduced by the integer reducers, only min and max
+// compare the values as uint64 and sum fails when it overflows uint64.
+
+func unsignedValues(values []int64) []uint64 {
+	return *(*[]uint64)(unsafe.Pointer(&values))
+}
+
+func unsignedMinReduce(cv *record.ColVal, values []int64, start, end int) (int, int64, bool) {
+	minValue, minIndex := cv.MinUnsignedValue(unsignedValues(values), start, end)
+	if minIndex == -1 {
+		return 0, 0, true
+	}
+	return minIndex, int64(minValue), false
+}
+
+func unsignedMinMerge(prevBuf, currBuf *integerColBuf) {
+	if uint64(currBuf.value) < uint64(prevBuf.value) {
+		prevBuf.index = currBuf.index
+		prevBuf.time = currBuf.time
+		prevBuf.value = currBuf.value
+	}
+}
+
+func unsignedMaxReduce(cv *record.ColVal, values []int64, start, end int) (int, int64, bool) {
+	maxValue, maxIndex := cv.MaxUnsignedValue(unsignedValues(values), start, end)
+	if maxIndex == -1 {
+		return 0, 0, true
+	}
+	return maxIndex, int64(maxValue), false
+}
+
+func unsignedMaxMerge(prevBuf, currBuf *integerColBuf) {
+	if uint64(currBuf.value) > uint64(prevBuf.value) {
+		prevBuf.index = currBuf.index
+		prevBuf.time = currBuf.time
+		prevBuf.value = currBuf.value
+	}
+}
+
+func unsignedSumReduce(cv *record.ColVal, values []int64, start, end int) (int, int64, bool) {
+	if cv.Length()+cv.NilCount == 0 {
+		return start, 0, true
+	}
+	start, end = cv.GetValIndexRange(start, end)
+	var sum uint64
+	for _, v := range unsignedValues(values[start:end]) {
+		sum = record.AddUnsigned(sum, v)
+	}
+	return start, int64(sum), start == end
+}
+
+func unsignedSumMerge(prevBuf, currBuf *integerColBuf) {
+	prevBuf.value = int64(record.AddUnsigned(uint64(prevBuf.value), uint64(currBuf.value)))
+}
@@ -94,7 +94,7 @@ func newCountRoutineImpl(inSchema, outSchema record.Schemas, opt hybridqp.ExprOp
 	}
 	dataType := inSchema.Field(inOrdinal).Type
 	switch dataType {
-	case influx.Field_Type_Int:
+	case influx.Field_Type_Int, influx.Field_Type_UInt:
 		return NewRoutineImpl(
 			newIntegerColIntegerReducer(integerCountReduce, integerCountMerge, auxProcessors),
 			inOrdinal,
@@ -137,6 +137,12 @@ func newSumRoutineImpl(inSchema, outSchema record.Schemas, opt hybridqp.ExprOpti
 			inOrdinal,
 			outOrdinal,
 		)
+	case influx.Field_Type_UInt:
+		return NewRoutineImpl(
+			newIntegerColIntegerReducer(unsignedSumReduce, unsignedSumMerge, auxProcessors),
+			inOrdinal,
+			outOrdinal,
+		)
 	case influx.Field_Type_Float:
 		return NewRoutineImpl(
 			newFloatColFloatReducer(floatSumReduce, floatSumMerge, auxProcessors),
@@ -162,6 +168,12 @@ func newMinRoutineImpl(inSchema, outSchema record.Schemas, opt hybridqp.ExprOpti
 			inOrdinal,
 			outOrdinal,
 		)
+	case influx.Field_Type_UInt:
+		return NewRoutineImpl(
+			newIntegerColIntegerReducer(unsignedMinReduce, unsignedMinMerge, auxProcessors),
+			inOrdinal,
+			outOrdinal,
+		)
 	case influx.Field_Type_Float:
 		return NewRoutineImpl(
 			newFloatColFloatReducer(floatMinReduce, floatMinMerge, auxProcessors),
@@ -193,6 +205,12 @@ func newMaxRoutineImpl(inSchema, outSchema record.Schemas, opt hybridqp.ExprOpti
 			inOrdinal,
 			outOrdinal,
 		)
+	case influx.Field_Type_UInt:
+		return NewRoutineImpl(
+			newIntegerColIntegerReducer(unsignedMaxReduce, unsignedMaxMerge, auxProcessors),
+			inOrdinal,
+			outOrdinal,
+		)
 	case influx.Field_Type_Float:
 		return NewRoutineImpl(
 			newFloatColFloatReducer(floatMaxReduce, floatMaxMerge, auxProcessors),
@@ -218,7 +236,7 @@ func newFirstRoutineImpl(inSchema, outSchema record.Schemas, opt hybridqp.ExprOp
 	}
 	dataType := inSchema.Field(inOrdinal).Type
 	switch dataType {
-	case influx.Field_Type_Int:
+	case influx.Field_Type_Int, influx.Field_Type_UInt:
 		return NewRoutineImpl(
 			newIntegerTimeColIntegerReducer(integerFirstReduce, integerFirstMerge, auxProcessors),
 			inOrdinal,
@@ -255,7 +273,7 @@ func newLastRoutineImpl(inSchema, outSchema record.Schemas, opt hybridqp.ExprOpt
 	}
 	dataType := inSchema.Field(inOrdinal).Type
 	switch dataType {
-	case influx.Field_Type_Int:
+	case influx.Field_Type_Int, influx.Field_Type_UInt:
 		return NewRoutineImpl(
 			newIntegerTimeColIntegerReducer(integerLastReduce, integerLastMerge, auxProcessors),
 			inOrdinal,
@@ -292,7 +310,7 @@ func newDistinctRoutineImpl(inSchema, outSchema record.Schemas, opt hybridqp.Exp
 	}
 	dataType := inSchema.Field(inOrdinal).Type
 	switch dataType {
-	case influx.Field_Type_Int:
+	case influx.Field_Type_Int, influx.Field_Type_UInt:
 		return NewRoutineImpl(
 			newIntegerColIntegerDistinctReducer(),
 			inOrdinal,
@@ -400,7 +418,7 @@ func newAuxProcessor(inSchema, outSchema record.Schemas, opt hybridqp.ExprOption
 	}
 	dataType := inSchema.Field(inOrdinal).Type
 	switch dataType {
-	case influx.Field_Type_Int:
+	case influx.Field_Type_Int, influx.Field_Type_UInt:
 		return &auxProcessor{
 			inOrdinal:     inOrdinal,
 			outOrdinal:    outOrdinal,
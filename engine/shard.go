@@ -136,7 +136,8 @@ type Shard interface {
 	Close() error
 	ChangeShardTierToWarm()
 	DropMeasurement(ctx context.Context, name string) error
-	GetSplitPoints(idxes []int64) ([]string, error) // only work for tsstore (depends on sid)
+	DeleteSeries(name string, sids []uint64, tr util.TimeRange) error // only work for tsstore
+	GetSplitPoints(idxes []int64) ([]string, error)                   // only work for tsstore (depends on sid)
 
 	// get private member
 	GetDataPath() string
@@ -216,6 +217,7 @@ type shard struct {
 	activeTbl          *mutable.MemTable
 	snapshotTbl        *mutable.MemTable
 	snapshotWg         sync.WaitGroup
+	deleteLock         sync.Mutex // serializes DeleteSeries which stops and restarts the compaction
 	immTables          immutable.TablesStore
 	indexBuilder       *tsi.IndexBuilder
 	skIdx              *ski.ShardKeyIndex
@@ -253,6 +255,9 @@ type shard struct {
 	obsOpt     *obs.ObsOptions
 
 	seriesLimit uint64
+	// max_series quota of the database, as last seen by the series limiter
+	seriesQuota uint64
+	quotaClient databaseQuotaClient
 	//lint:ignore U1000 use for replication feature
 	summary *summaryInfo
 
@@ -430,19 +435,38 @@ func (s *shard) writeCols(cols *record.Record, binaryCols []byte, mst string) er
 	return nil
 }
 
+// databaseQuotaClient is implemented by the meta client which knows the quotas of the databases.
+type databaseQuotaClient interface {
+	DatabaseQuota(name string) *meta.QuotaInfo
+}
+
 func (s *shard) initSeriesLimiter(limit uint64) {
-	if limit == 0 || s.indexBuilder == nil {
+	if s.indexBuilder == nil || (limit == 0 && s.quotaClient == nil) {
 		return
 	}
 
 	s.indexBuilder.SetSeriesLimiter(func() error {
-		if limit > s.immTables.SeriesTotal() {
+		limit := s.currentSeriesLimit(limit)
+		if limit == 0 || limit > s.immTables.SeriesTotal() {
 			return nil
 		}
 		return errno.NewError(errno.SeriesLimited, s.ident.OwnerDb, limit, s.immTables.SeriesTotal())
 	})
 }
 
+// currentSeriesLimit returns the smaller of the configured limit and the max_series quota of the database.
+func (s *shard) currentSeriesLimit(limit uint64) uint64 {
+	if s.quotaClient == nil {
+		return limit
+	}
+	quota := uint64(s.quotaClient.DatabaseQuota(s.ident.OwnerDb).GetMaxSeries())
+	atomic.StoreUint64(&s.seriesQuota, quota)
+	if quota > 0 && (limit == 0 || quota < limit) {
+		return quota
+	}
+	return limit
+}
+
 func (s *shard) NewShardKeyIdx(shardType, dataPath string, lockPath *string) error {
 	if shardType != influxql.RANGE {
 		return nil
@@ -595,6 +619,12 @@ func (s *shard) Compact() error {
 		if !s.immTables.CompactionEnabled() {
 			return nil
 		}
+		if s.engineType == config.TSSTORE {
+			if err := s.immTables.PurgeTombstones(); err != nil {
+				log.Error("purge tombstones error", zap.Uint64("shid", id), zap.Error(err))
+			}
+			s.immTables.ConvertToParquet()
+		}
 		nowTime := fasttime.UnixTimestamp()
 		lastWrite := s.LastWriteTime()
 		d := nowTime - lastWrite
@@ -744,7 +774,7 @@ func putMstWriteRecordCtx(mw *mstWriteRecordCtx) {
 }
 
 func (s *shard) addRowCountsBySid(msName string, sid uint64, rowCounts int64) {
-	if config.GetStoreConfig().UnorderedOnly || (!s.ident.IsRangeMode() && s.seriesLimit == 0) {
+	if config.GetStoreConfig().UnorderedOnly || (!s.ident.IsRangeMode() && s.seriesLimit == 0 && atomic.LoadUint64(&s.seriesQuota) == 0) {
 		return
 	}
 
@@ -774,7 +804,7 @@ func calculateMemSize(rows influx.Rows) int64 {
 				memCost += int64(len(rows[i].Fields[j].StrValue))
 			} else if rows[i].Fields[j].Type == influx.Field_Type_Boolean {
 				memCost += int64(util.BooleanSizeBytes)
-			} else if rows[i].Fields[j].Type == influx.Field_Type_Int {
+			} else if rows[i].Fields[j].Type == influx.Field_Type_Int || rows[i].Fields[j].Type == influx.Field_Type_UInt {
 				memCost += int64(util.Uint64SizeBytes)
 			}
 		}
@@ -1106,6 +1136,12 @@ func (s *shard) Open(client metaclient.MetaClient) error {
 			s.log.Error("open index failed", zap.Uint64("id", s.ident.ShardID), zap.Uint64("opId", s.opId), zap.Error(err))
 			return err
 		}
+		if rel := s.indexBuilder.Relations; len(rel) > 0 && rel[0] != nil {
+			// the tags of the series are written to the Parquet files converted from the TSSP files
+			if idx, ok := s.indexBuilder.GetPrimaryIndex().(*tsi.MergeSetIndex); ok {
+				s.immTables.SetIndexMergeSet(idx)
+			}
+		}
 	}
 
 	if err = s.DownSampleRecover(client); err != nil {
@@ -1128,6 +1164,9 @@ func (s *shard) Open(client metaclient.MetaClient) error {
 	s.log.Info("open immutable done", zap.Uint64("id", s.ident.ShardID), zap.Duration("time used", time.Since(start)),
 		zap.Int64("maxTime", maxTime), zap.Uint64("opId", s.opId))
 
+	if qc, ok := client.(databaseQuotaClient); ok {
+		s.quotaClient = qc
+	}
 	s.initSeriesLimiter(s.seriesLimit)
 	return nil
 }
@@ -1791,6 +1830,29 @@ func (s *shard) DropMeasurement(ctx context.Context, name string) error {
 	return s.immTables.DropMeasurement(ctx, name)
 }
 
+// DeleteSeries adds tombstones for the rows of the series within the time range,
+// the rows are filtered out by queries and physically removed by compaction later
+func (s *shard) DeleteSeries(name string, sids []uint64, tr util.TimeRange) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.replayingWal {
+		return fmt.Errorf("async replay wal not finish")
+	}
+
+	// the tombstones only apply to files, flush the data in mem first
+	s.ForceFlush()
+
+	// a concurrent DeleteSeries must not restart the compaction while the tombstones are being added
+	s.deleteLock.Lock()
+	defer s.deleteLock.Unlock()
+	if s.immTables.CompactionEnabled() {
+		s.immTables.DisableCompAndMerge()
+		defer s.immTables.EnableCompAndMerge()
+	}
+	return s.immTables.DeleteSeries(name, sids, tr)
+}
+
 func (s *shard) GetStatistics(buffer []byte) ([]byte, error) {
 	s.mu.RLock()
 	if s.closed.Closed() {
@@ -0,0 +1,255 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// operations recorded in the audit log
+const (
+	Statement      = "statement"
+	Authentication = "authentication"
+	SysCtrl        = "sysctrl"
+)
+
+// results of the operations
+const (
+	Success = "success"
+	Failure = "failure"
+)
+
+const (
+	// flushInterval is the interval at which the events are written to the audit measurement.
+	flushInterval = time.Second
+
+	// maxPendingEvents is the number of events waiting to be written to the audit measurement,
+	// the events are dropped from the measurement, but not from the file, once it is reached.
+	maxPendingEvents = 10000
+)
+
+// Event is a record of the audit log.
+type Event struct {
+	Time      time.Time `json:"time"`
+	Operation string    `json:"operation"`
+	User      string    `json:"user"`
+	ClientIP  string    `json:"client_ip"`
+	Database  string    `json:"database,omitempty"`
+	Statement string    `json:"statement"`
+	Result    string    `json:"result"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// NewEvent returns an event of the operation, the result is a failure if err is not nil.
+func NewEvent(operation, user, clientIP, database, statement string, err error) *Event {
+	e := &Event{
+		Time:      time.Now().UTC(),
+		Operation: operation,
+		User:      user,
+		ClientIP:  clientIP,
+		Database:  database,
+		Statement: statement,
+		Result:    Success,
+	}
+	if err != nil {
+		e.Result = Failure
+		e.Error = err.Error()
+	}
+	return e
+}
+
+func (e *Event) row(measurement string) influx.Row {
+	r := influx.Row{
+		Name:      measurement,
+		Timestamp: e.Time.UnixNano(),
+		Tags: influx.PointTags{
+			{Key: "operation", Value: e.Operation},
+			{Key: "result", Value: e.Result},
+			{Key: "user", Value: e.User},
+		},
+	}
+	for _, f := range [...][2]string{
+		{"client_ip", e.ClientIP},
+		{"database", e.Database},
+		{"error", e.Error},
+		{"statement", e.Statement},
+	} {
+		if f[1] != "" {
+			r.Fields = append(r.Fields, influx.Field{Key: f[0], Type: influx.Field_Type_String, StrValue: f[1]})
+		}
+	}
+	return r
+}
+
+// IsAudited returns true if the statement changes the schema, the users or the data,
+// or if it requires the admin privilege.
+func IsAudited(stmt influxql.Statement) bool {
+	privileges, err := stmt.RequiredPrivileges()
+	if err != nil {
+		return true
+	}
+	for _, p := range privileges {
+		if p.Admin || p.Privilege != influxql.ReadPrivilege {
+			return true
+		}
+	}
+	return false
+}
+
+type PointsWriter interface {
+	RetryWritePointRows(database, retentionPolicy string, points []influx.Row) error
+}
+
+// Logger writes the audit events to a rotating file as JSON lines, and to the audit measurement
+// if a database is configured. All methods of a nil *Logger are no-ops.
+type Logger struct {
+	conf   config.AuditConfig
+	logger *logger.Logger
+
+	mu  sync.Mutex
+	out io.WriteCloser
+
+	events  chan *Event
+	closing chan struct{}
+	wg      sync.WaitGroup
+
+	PointsWriter PointsWriter
+}
+
+// NewLogger returns the audit logger of the config, nil if the audit log is disabled.
+func NewLogger(conf config.AuditConfig) *Logger {
+	if !conf.Enabled {
+		return nil
+	}
+	maxSize := int(conf.MaxSize / (1024 * 1024))
+	if maxSize < 1 {
+		maxSize = 1
+	}
+	return &Logger{
+		conf:   conf,
+		logger: logger.NewLogger(errno.ModuleUnknown).With(zap.String("service", "audit")),
+		out: &lumberjack.Logger{
+			Filename:   conf.Path,
+			MaxSize:    maxSize,
+			MaxBackups: conf.MaxNum,
+			MaxAge:     conf.MaxAge,
+			Compress:   conf.CompressEnabled,
+		},
+	}
+}
+
+// Open starts writing the events to the audit measurement.
+func (l *Logger) Open() {
+	if l == nil || l.conf.Database == "" || l.PointsWriter == nil {
+		return
+	}
+	l.events = make(chan *Event, maxPendingEvents)
+	l.closing = make(chan struct{})
+	l.wg.Add(1)
+	go l.run()
+}
+
+// Log records the event.
+func (l *Logger) Log(e *Event) {
+	if l == nil {
+		return
+	}
+	buf, err := json.Marshal(e)
+	if err != nil {
+		l.logger.Error("marshal audit event failed", zap.Error(err))
+		return
+	}
+	buf = append(buf, '\n')
+
+	l.mu.Lock()
+	_, err = l.out.Write(buf)
+	l.mu.Unlock()
+	if err != nil {
+		l.logger.Error("write audit log failed", zap.Error(err), zap.String("path", l.conf.Path))
+	}
+
+	if l.events == nil {
+		return
+	}
+	select {
+	case l.events <- e:
+	default:
+		l.logger.Warn("too many pending audit events, the event is not written to the measurement",
+			zap.String("operation", e.Operation), zap.String("user", e.User))
+	}
+}
+
+func (l *Logger) run() {
+	defer l.wg.Done()
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	var rows []influx.Row
+	flush := func() {
+		if len(rows) == 0 {
+			return
+		}
+		if err := l.PointsWriter.RetryWritePointRows(l.conf.Database, l.conf.RetentionPolicy, rows); err != nil {
+			l.logger.Error("write audit events failed", zap.Error(err), zap.String("db", l.conf.Database), zap.Int("events", len(rows)))
+		}
+		rows = nil
+	}
+	for {
+		select {
+		case e := <-l.events:
+			rows = append(rows, e.row(l.conf.Measurement))
+		case <-ticker.C:
+			flush()
+		case <-l.closing:
+			for {
+				select {
+				case e := <-l.events:
+					rows = append(rows, e.row(l.conf.Measurement))
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// Close writes the pending events and closes the audit log file.
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+	if l.closing != nil {
+		close(l.closing)
+		l.wg.Wait()
+		l.closing = nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.out.Close()
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/audit"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPointsWriter struct {
+	mu   sync.Mutex
+	db   string
+	rows []influx.Row
+}
+
+func (w *mockPointsWriter) RetryWritePointRows(database, _ string, rows []influx.Row) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.db = database
+	w.rows = append(w.rows, rows...)
+	return nil
+}
+
+func readEvents(t *testing.T, path string) []audit.Event {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var events []audit.Event
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e audit.Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		events = append(events, e)
+	}
+	return events
+}
+
+func TestLogger(t *testing.T) {
+	conf := config.NewAuditConfig()
+	require.Nil(t, audit.NewLogger(conf))
+
+	conf.Enabled = true
+	conf.Path = filepath.Join(t.TempDir(), "audit.log")
+	conf.Database = "audit_db"
+	require.NoError(t, conf.Validate())
+
+	w := &mockPointsWriter{}
+	l := audit.NewLogger(conf)
+	l.PointsWriter = w
+	l.Open()
+	l.Log(audit.NewEvent(audit.Statement, "admin", "127.0.0.1", "db0", "DROP DATABASE db0", nil))
+	l.Log(audit.NewEvent(audit.Authentication, "user1", "127.0.0.1", "", "POST /query", errors.New("authorization failed")))
+	require.NoError(t, l.Close())
+
+	events := readEvents(t, conf.Path)
+	require.Equal(t, 2, len(events))
+	require.Equal(t, "DROP DATABASE db0", events[0].Statement)
+	require.Equal(t, audit.Success, events[0].Result)
+	require.Equal(t, audit.Authentication, events[1].Operation)
+	require.Equal(t, audit.Failure, events[1].Result)
+	require.Equal(t, "authorization failed", events[1].Error)
+
+	require.Equal(t, "audit_db", w.db)
+	require.Equal(t, 2, len(w.rows))
+	require.Equal(t, config.DefaultAuditMeasurement, w.rows[0].Name)
+	require.Equal(t, "user", w.rows[0].Tags[2].Key)
+	require.Equal(t, "admin", w.rows[0].Tags[2].Value)
+	require.Equal(t, 3, len(w.rows[0].Fields))
+	require.Equal(t, 3, len(w.rows[1].Fields))
+
+	var nilLogger *audit.Logger
+	nilLogger.Open()
+	nilLogger.Log(&audit.Event{})
+	require.NoError(t, nilLogger.Close())
+}
+
+func TestIsAudited(t *testing.T) {
+	for s, exp := range map[string]bool{
+		`SELECT value FROM cpu`:                 false,
+		`SHOW MEASUREMENTS`:                     false,
+		`SELECT value INTO cpu_copy FROM cpu`:   true,
+		`CREATE DATABASE db0`:                   true,
+		`DROP MEASUREMENT cpu`:                  true,
+		`SET PASSWORD FOR user1 = 'Abcd@12345'`: true,
+		`GRANT READ ON db0 TO user1`:            true,
+		`KILL QUERY 1`:                          true,
+		`SHOW USERS`:                            true,
+	} {
+		p := influxql.NewYyParser(influxql.NewParser(strings.NewReader(s)).GetScanner(), nil)
+		p.ParseTokens()
+		q, err := p.GetQuery()
+		require.NoError(t, err, s)
+		require.Equal(t, exp, audit.IsAudited(q.Statements[0]), s)
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"path/filepath"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	// DefaultAuditFileName is the name of the audit log file in the log directory.
+	DefaultAuditFileName = "audit.log"
+
+	// DefaultAuditMeasurement is the measurement written with the audit events.
+	DefaultAuditMeasurement = "audit"
+)
+
+// AuditConfig is the configuration for the audit log of the DDL, authentication and privileged operations.
+type AuditConfig struct {
+	Enabled bool `toml:"enabled"`
+
+	// Path is the audit log file, the events are written as JSON lines.
+	Path            string    `toml:"path"`
+	MaxSize         toml.Size `toml:"max-size"`
+	MaxNum          int       `toml:"max-num"`
+	MaxAge          int       `toml:"max-age"`
+	CompressEnabled bool      `toml:"compress-enabled"`
+
+	// Database and RetentionPolicy are where the events are also written to the audit measurement,
+	// the events are only written to the file if Database is empty.
+	Database        string `toml:"database"`
+	RetentionPolicy string `toml:"retention-policy"`
+	Measurement     string `toml:"measurement"`
+}
+
+// NewAuditConfig returns a new instance of AuditConfig with defaults.
+func NewAuditConfig() AuditConfig {
+	return AuditConfig{
+		Enabled:         false,
+		Path:            filepath.Join(openGeminiDir(), DefaultSubPath, DefaultAuditFileName),
+		MaxSize:         toml.Size(DefaultMaxSize),
+		MaxNum:          DefaultMaxNum,
+		MaxAge:          DefaultMaxAge,
+		CompressEnabled: DefaultCompressEnabled,
+		Measurement:     DefaultAuditMeasurement,
+	}
+}
+
+// Validate returns an error if the config is invalid.
+func (c AuditConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Path == "" {
+		return errors.New("audit path must not be empty")
+	}
+	if c.MaxSize <= 0 {
+		return errors.New("audit max-size must be positive")
+	}
+	if c.MaxNum <= 0 {
+		return errors.New("audit max-num must be positive")
+	}
+	if c.MaxAge <= 0 {
+		return errors.New("audit max-age must be positive")
+	}
+	if c.Database != "" && c.Measurement == "" {
+		return errors.New("audit measurement must not be empty")
+	}
+	return nil
+}
+
+func (c *AuditConfig) ShowConfigs() map[string]interface{} {
+	return map[string]interface{}{
+		"audit.enabled":          c.Enabled,
+		"audit.path":             c.Path,
+		"audit.max-size":         rewriteMaxSize(c.MaxSize),
+		"audit.max-num":          c.MaxNum,
+		"audit.max-age":          c.MaxAge,
+		"audit.compress-enabled": c.CompressEnabled,
+		"audit.database":         c.Database,
+		"audit.retention-policy": c.RetentionPolicy,
+		"audit.measurement":      c.Measurement,
+	}
+}
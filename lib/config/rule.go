@@ -0,0 +1,106 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	// DefaultRuleEvaluationInterval is the default interval at which the rule groups are evaluated.
+	DefaultRuleEvaluationInterval = time.Minute
+
+	// DefaultRuleResendDelay is the default delay before resending the firing alerts to the webhook.
+	DefaultRuleResendDelay = time.Minute
+
+	// DefaultRuleDatabase is the default database queried by the rules and written with the results.
+	DefaultRuleDatabase = "prometheus"
+)
+
+// RuleConfig is the configuration for the Prometheus rule evaluation service.
+type RuleConfig struct {
+	Enabled bool `toml:"enabled"`
+
+	// RuleFiles is the list of Prometheus rule files, the file name may contain the glob pattern.
+	RuleFiles []string `toml:"rule-files"`
+
+	// EvaluationInterval is the interval of the rule groups which don't specify one.
+	EvaluationInterval toml.Duration `toml:"evaluation-interval"`
+
+	// Database and RetentionPolicy are where the rules query and write the results to.
+	Database        string `toml:"database"`
+	RetentionPolicy string `toml:"retention-policy"`
+
+	// AlertmanagerURL is the Alertmanager-compatible endpoint which receives the firing alerts, e.g.
+	// http://127.0.0.1:9093/api/v2/alerts. Alerts are not delivered if it is empty.
+	AlertmanagerURL string `toml:"alertmanager-url"`
+
+	// ResendDelay is the minimum time to wait before resending an alert to the Alertmanager.
+	ResendDelay toml.Duration `toml:"resend-delay"`
+
+	// ExternalURL is used as the generator URL of the alerts.
+	ExternalURL string `toml:"external-url"`
+}
+
+// NewRuleConfig returns a new instance of RuleConfig with defaults.
+func NewRuleConfig() RuleConfig {
+	return RuleConfig{
+		Enabled:            false,
+		EvaluationInterval: toml.Duration(DefaultRuleEvaluationInterval),
+		Database:           DefaultRuleDatabase,
+		ResendDelay:        toml.Duration(DefaultRuleResendDelay),
+	}
+}
+
+// Validate returns an error if the config is invalid.
+func (c RuleConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if time.Duration(c.EvaluationInterval) < time.Second {
+		return errors.New("rule evaluation interval must be at least 1 second")
+	}
+	if c.Database == "" {
+		return errors.New("rule database must not be empty")
+	}
+	for _, u := range []string{c.AlertmanagerURL, c.ExternalURL} {
+		if u == "" {
+			continue
+		}
+		if _, err := url.Parse(u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *RuleConfig) ShowConfigs() map[string]interface{} {
+	return map[string]interface{}{
+		"rules.enabled":             c.Enabled,
+		"rules.rule-files":          c.RuleFiles,
+		"rules.evaluation-interval": c.EvaluationInterval,
+		"rules.database":            c.Database,
+		"rules.retention-policy":    c.RetentionPolicy,
+		"rules.alertmanager-url":    c.AlertmanagerURL,
+		"rules.resend-delay":        c.ResendDelay,
+		"rules.external-url":        c.ExternalURL,
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RuleConfig_Validate(t *testing.T) {
+	c := NewRuleConfig()
+	c.EvaluationInterval = -5
+	require.NoError(t, c.Validate())
+
+	c.Enabled = true
+	require.EqualError(t, c.Validate(), "rule evaluation interval must be at least 1 second")
+	c.EvaluationInterval = toml.Duration(time.Second)
+
+	c.Database = ""
+	require.EqualError(t, c.Validate(), "rule database must not be empty")
+	c.Database = DefaultRuleDatabase
+
+	c.AlertmanagerURL = "http://127.0.0.1:9093/api/v2/alerts"
+	require.NoError(t, c.Validate())
+
+	c.ExternalURL = ":invalid"
+	require.Error(t, c.Validate())
+}
@@ -20,12 +20,17 @@ const (
 	DefaultSeriesCount = 0
 
 	DefaultFieldsCount = 0
+
+	DefaultHashJoinRowsCount = 0
 )
 
 type SelectSpecConfig struct {
 	EnableWhenExceed bool `toml:"enable-query-when-exceed"`
 	QuerySeriesLimit int  `toml:"query-series-limit"`
 	QuerySchemaLimit int  `toml:"query-schema-limit"`
+
+	// HashJoinRowsLimit is the maximum number of rows read into the hash table of an inner, left or right join.
+	HashJoinRowsLimit int `toml:"hash-join-rows-limit"`
 }
 
 func NewSelectSpecConfig() SelectSpecConfig {
@@ -33,5 +38,7 @@ func NewSelectSpecConfig() SelectSpecConfig {
 		EnableWhenExceed: false,
 		QuerySeriesLimit: DefaultSeriesCount,
 		QuerySchemaLimit: DefaultFieldsCount,
+
+		HashJoinRowsLimit: DefaultHashJoinRowsCount,
 	}
 }
@@ -91,6 +91,8 @@ type TSSql struct {
 	Subscriber Subscriber `toml:"subscriber"`
 
 	ContinuousQuery ContinuousQueryConfig `toml:"continuous_queries"`
+	Rule            RuleConfig            `toml:"rules"`
+	Audit           AuditConfig           `toml:"audit"`
 	Data            Store                 `toml:"data"`
 }
 
@@ -108,6 +110,8 @@ func NewTSSql(enableGossip bool) *TSSql {
 	c.SelectSpec = NewSelectSpecConfig()
 	c.Subscriber = NewSubscriber()
 	c.ContinuousQuery = NewContinuousQueryConfig()
+	c.Rule = NewRuleConfig()
+	c.Audit = NewAuditConfig()
 	c.Gossip = NewGossip(enableGossip)
 	return c
 }
@@ -142,6 +146,9 @@ func (c *TSSql) Corrector(cpuNum, cpuAllocRatio int) {
 	if c.SelectSpec.QuerySchemaLimit == 0 {
 		c.SelectSpec.QuerySchemaLimit = cpuNum * 500
 	}
+	if c.SelectSpec.HashJoinRowsLimit == 0 {
+		c.SelectSpec.HashJoinRowsLimit = cpuNum * 100000
+	}
 
 	if c.ContinuousQuery.MaxProcessCQNumber == 0 {
 		maxProcessCQNumber := cpuNum * cpuAllocRatio / 3
@@ -169,6 +176,8 @@ func (c *TSSql) Validate() error {
 		c.Sherlock,
 		c.Subscriber,
 		c.ContinuousQuery,
+		c.Rule,
+		c.Audit,
 	}
 
 	for _, item := range items {
@@ -217,6 +226,12 @@ func (c *TSSql) ShowConfigs() map[string]interface{} {
 	for k, v := range c.ContinuousQuery.ShowConfigs() {
 		sqlConfig[k] = v
 	}
+	for k, v := range c.Rule.ShowConfigs() {
+		sqlConfig[k] = v
+	}
+	for k, v := range c.Audit.ShowConfigs() {
+		sqlConfig[k] = v
+	}
 	for k, v := range c.HTTP.ShowConfigs() {
 		sqlConfig[k] = v
 	}
@@ -59,6 +59,10 @@ const (
 	DataDirectory      = "data"
 	WalDirectory       = "wal"
 	MetaDirectory      = "meta"
+
+	// StoreLockFile is locked by the running ts-store in the store-data-dir, the offline tools such as
+	// the backup refuse to run while it is locked.
+	StoreLockFile = "store.lock"
 )
 
 var storeConfig = Store{
@@ -289,6 +293,8 @@ type Store struct {
 
 	// the level of the TSSP file to be converted to a Parquet. 0: not convert
 	TSSPToParquetLevel uint16 `toml:"tssp-to-parquet-level"`
+	// the queries read the data of the converted TSSP files from the Parquet files
+	TSSPToParquetQuery bool `toml:"tssp-to-parquet-query"`
 }
 
 // NewStore returns the default configuration for tsdb.
@@ -108,6 +108,7 @@ const (
 	SeriesBucketLacks            = 1126
 	ChunkReaderCursor            = 1127
 	ApplyFuncErr                 = 1128
+	UnsignedSumOverflow          = 1129
 )
 
 // promql2influxql
@@ -160,6 +161,7 @@ const (
 	WrongScrollId           = 1516
 	ScrollIdIllegal         = 1517
 	SetValueFailed          = 1518
+	QueryQuotaExceeded      = 1519
 )
 
 // store engine error codes
@@ -231,6 +233,8 @@ const (
 	ErrIncAggIterID                = 3017
 	ErrInputTimeExceedTimeRange    = 3018
 	FailedPutNodeMaxIterNum        = 3019
+	UnsupportedConditionInJoin     = 3020
+	HashJoinRowsExceeded           = 3021
 )
 
 // meta
@@ -342,6 +346,7 @@ const (
 	WritePointPrimaryKeyErr      = 5034
 	KeyWordConflictErr           = 5035
 	MeasurementNameTooLong       = 5036
+	WriteQuotaExceeded           = 5037
 )
 
 // write interface
@@ -96,6 +96,7 @@ var messageMap = map[Errno]*Message{
 	WritePointPrimaryKeyErr:      newFatalMessage("checkSchema: write point is not match the number of primary key. mst: %s,  expect:%d but:%d", ModuleWrite),
 	KeyWordConflictErr:           newFatalMessage("column name conflict with key word. mst: %s,  conflict column name :%s", ModuleWrite),
 	MeasurementNameTooLong:       newWarnMessage("measurement name is :%s. upper limit: %d; current: %d", ModuleWrite),
+	WriteQuotaExceeded:           newWarnMessage("%s quota of %s %q exceeded. upper limit: %d", ModuleWrite),
 
 	// write interface error codes
 	InvalidLogDataType:              newWarnMessage("invalid log data type value", ModuleWriteInterface),
@@ -174,6 +175,7 @@ var messageMap = map[Errno]*Message{
 	InvalidQueryStat:             newWarnMessage("invalid query stat", ModuleQueryEngine),
 	ErrQueryNotFound:             newWarnMessage("no such query id: %d", ModuleQueryEngine),
 	ErrQueryKilled:               newWarnMessage("query(%d) killed", ModuleQueryEngine),
+	UnsignedSumOverflow:          newWarnMessage("the sum of the unsigned values overflows uint64", ModuleQueryEngine),
 
 	// store engine error codes
 	CreateIndexFailPointRowType:        newFatalMessage("create index failed due to rows are not belong to type PointRow", ModuleIndex),
@@ -244,6 +246,8 @@ var messageMap = map[Errno]*Message{
 	ErrIncAggIterID:                newFatalMessage("the iterID is not equal to the expected. actual: %d, expected: %d", ModuleQueryEngine),
 	ErrInputTimeExceedTimeRange:    newFatalMessage("input time exceeds the query time range. start=%d, end=%d, time=%d", ModuleQueryEngine),
 	FailedPutNodeMaxIterNum:        newFatalMessage("failed to put the max iter num for the inc query, queryID=%s. [Node]", ModuleQueryEngine),
+	UnsupportedConditionInJoin:     newWarnMessage("unsupported condition in %s: %s", ModuleQueryEngine),
+	HashJoinRowsExceeded:           newWarnMessage("hash-join-rows-limit exceeded in %s: %d", ModuleQueryEngine),
 	ApplyFuncErr:                   newWarnMessage("applyFuncErr, func=%s, err=%s", ModuleQueryEngine),
 
 	// query interface error codes
@@ -265,6 +269,7 @@ var messageMap = map[Errno]*Message{
 	WrongScrollId:           newWarnMessage("wrong scroll_id", ModuleQueryInterface),
 	ScrollIdIllegal:         newWarnMessage("scroll_id value is illegal", ModuleQueryInterface),
 	SetValueFailed:          newWarnMessage("set value failed", ModuleQueryInterface),
+	QueryQuotaExceeded:      newWarnMessage("%s quota of %s %q exceeded. upper limit: %d", ModuleQueryInterface),
 
 	// meta error codes
 	InvalidTagKey:           newWarnMessage(`tag key can't be time, measurement is '%s'`, ModuleMeta),
@@ -369,7 +374,7 @@ var messageMap = map[Errno]*Message{
 	WatchFileTimeout: newWarnMessage("watch file timeout", ModuleStat),
 
 	// castor error codes
-	DtypeNotSupport:          newNoticeMessage("only support integer\\float type", ModuleCastor),
+	DtypeNotSupport:          newNoticeMessage("only support integer\\unsigned\\float type", ModuleCastor),
 	DtypeNotMatch:            newNoticeMessage("dtype type not match, expect:%v, got:%v", ModuleCastor),
 	NumOfFieldNotEqual:       newNoticeMessage("number of field not equal between input and output", ModuleCastor),
 	TimestampNotFound:        newNoticeMessage("timestamp not found in response", ModuleCastor),
@@ -431,6 +431,27 @@ func (c *GetCqLeaseCallback) Handle(data interface{}) error {
 	return nil
 }
 
+type GetRuleLeaseCallback struct {
+	BaseCallback
+	Groups []string
+}
+
+func (c *GetRuleLeaseCallback) Handle(data interface{}) error {
+	metaMsg, err := c.Trans2MetaMsg(data)
+	if err != nil {
+		return err
+	}
+	msg, ok := metaMsg.Data().(*message.GetRuleLeaseResponse)
+	if !ok {
+		return fmt.Errorf("data is not a GetRuleLeaseResponse, got type %T", metaMsg.Data())
+	}
+	if msg.Err != "" {
+		return fmt.Errorf("get rule lease callback error: %s", msg.Err)
+	}
+	c.Groups = msg.Groups
+	return nil
+}
+
 type VerifyDataNodeStatusCallback struct {
 	BaseCallback
 }
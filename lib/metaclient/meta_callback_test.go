@@ -130,6 +130,24 @@ func TestGetCqLeaseCallbackResponse(t *testing.T) {
 	assert.EqualError(t, err, "get cq lease callback error: mock error")
 }
 
+func TestGetRuleLeaseCallbackResponse(t *testing.T) {
+	callback := &metaclient.GetRuleLeaseCallback{}
+	msg := message.NewMetaMessage(message.GetRuleLeaseResponseMessage, &message.GetRuleLeaseResponse{Groups: []string{"g1", "g2"}})
+	err := callback.Handle(msg)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"g1", "g2"}, callback.Groups)
+
+	// wrong message
+	badMsg := message.NewMetaMessage(message.UnknownMessage, &message.PingResponse{})
+	err = callback.Handle(badMsg)
+	assert.EqualError(t, err, "data is not a GetRuleLeaseResponse, got type *message.PingResponse")
+
+	// wrong message
+	badMsg2 := message.NewMetaMessage(message.GetRuleLeaseResponseMessage, &message.GetRuleLeaseResponse{Err: "mock error"})
+	err = callback.Handle(badMsg2)
+	assert.EqualError(t, err, "get rule lease callback error: mock error")
+}
+
 func TestVerifyDataNodeStatusCallbackResponse(t *testing.T) {
 	callback := &metaclient.VerifyDataNodeStatusCallback{}
 	msg := message.NewMetaMessage(message.VerifyDataNodeStatusResponseMessage, &message.VerifyDataNodeStatusResponse{})
@@ -171,6 +171,7 @@ type MetaClient interface {
 	AliveReadNodes() ([]meta2.DataNode, error)
 	DeleteDataNode(id uint64) error
 	DeleteMetaNode(id uint64) error
+	DeleteShardGroup(database, policy string, id uint64, deleteType int32) error
 	DropShard(id uint64) error
 	DropSubscription(database, rp, name string) error
 	DropUser(name string) error
@@ -182,8 +183,11 @@ type MetaClient interface {
 	ShardGroupsByTimeRange(database, policy string, min, max time.Time) (a []meta2.ShardGroupInfo, err error)
 	UpdateRetentionPolicy(database, name string, rpu *meta2.RetentionPolicyUpdate, makeDefault bool) error
 	UpdateUser(name, password string) error
+	SetQuota(user, database string, quota *meta2.QuotaInfo) error
 	UserPrivilege(username, database string) (*originql.Privilege, error)
 	UserPrivileges(username string) (map[string]originql.Privilege, error)
+	SetMeasurementPrivilege(username, database, measurement string, p originql.Privilege, condition string) error
+	UserMeasurementPrivileges(username string) ([]*meta2.MeasurementPrivilege, error)
 	Users() []meta2.UserInfo
 	MarkDatabaseDelete(name string) error
 	MarkRetentionPolicyDelete(database, name string) error
@@ -206,6 +210,7 @@ type MetaClient interface {
 	ShowCluster() models.Rows
 	ShowClusterWithCondition(nodeType string, ID uint64) (models.Rows, error)
 	GetAliveShards(database string, sgi *meta2.ShardGroupInfo) []int
+	PruneGroupsCommand(shardGroup bool, id uint64) error
 	NewDownSamplePolicy(database, name string, info *meta2.DownSamplePolicyInfo) error
 	DropDownSamplePolicy(database, name string, dropAll bool) error
 	ShowDownSamplePolicies(database string) (models.Rows, error)
@@ -379,6 +384,7 @@ var applyFunc = map[proto2.Command_Type]func(c *Client, op *proto2.Command) erro
 	proto2.Command_UpdateRetentionPolicyCommand:     applyUpdateRetentionPolicy,
 	proto2.Command_CreateShardGroupCommand:          applyCreateShardGroup,
 	proto2.Command_DeleteShardGroupCommand:          applyDeleteShardGroup,
+	proto2.Command_DropShardCommand:                 applyDropShard,
 	proto2.Command_CreateSubscriptionCommand:        applyCreateSubscription,
 	proto2.Command_DropSubscriptionCommand:          applyDropSubscription,
 	proto2.Command_CreateUserCommand:                applyCreateUser,
@@ -423,6 +429,8 @@ var applyFunc = map[proto2.Command_Type]func(c *Client, op *proto2.Command) erro
 	proto2.Command_RemoveNodeCommand:                applyRemoveNode,
 	proto2.Command_UpdateReplicationCommand:         applyUpdateReplication,
 	proto2.Command_UpdateMeasurementCommand:         applyUpdateMeasurement,
+	proto2.Command_SetQuotaCommand:                  applySetQuota,
+	proto2.Command_SetMeasurementPrivilegeCommand:   applySetMeasurementPrivilege,
 }
 
 type authRcd struct {
@@ -851,7 +859,8 @@ func (c *Client) GetMeasurements(m *influxql.Measurement) ([]*meta2.MeasurementI
 
 	if m.Regex != nil {
 		rpi.EachMeasurements(func(msti *meta2.MeasurementInfo) {
-			if m.Regex.Val.Match([]byte(influx.GetOriginMstName(msti.Name))) {
+			originName := influx.GetOriginMstName(msti.Name)
+			if !meta2.IsHiddenMeasurement(originName) && m.Regex.Val.MatchString(originName) {
 				measurements = append(measurements, msti)
 			}
 		})
@@ -860,7 +869,9 @@ func (c *Client) GetMeasurements(m *influxql.Measurement) ([]*meta2.MeasurementI
 		})
 	} else if m.Name == "" {
 		for _, msti := range rpi.Measurements {
-			measurements = append(measurements, msti)
+			if !meta2.IsHiddenMeasurement(influx.GetOriginMstName(msti.Name)) {
+				measurements = append(measurements, msti)
+			}
 		}
 	} else {
 		msti, err := rpi.GetMeasurement(m.Name)
@@ -1880,6 +1891,38 @@ func (c *Client) UpdateUser(name, password string) error {
 	)
 }
 
+// SetQuota replaces the quota of a user or a database, a nil quota drops it.
+func (c *Client) SetQuota(user, database string, quota *meta2.QuotaInfo) error {
+	cmd := &proto2.SetQuotaCommand{
+		User:     proto.String(user),
+		Database: proto.String(database),
+	}
+	if quota != nil {
+		cmd.Quota = quota.Marshal()
+	}
+	return c.retryUntilExec(proto2.Command_SetQuotaCommand, proto2.E_SetQuotaCommand_Command, cmd)
+}
+
+// UserQuota returns a copy of the quota of the user, nil if the user is unlimited.
+func (c *Client) UserQuota(name string) *meta2.QuotaInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if u := c.cacheData.GetUser(name); u != nil {
+		return u.Quota.Clone()
+	}
+	return nil
+}
+
+// DatabaseQuota returns a copy of the quota of the database, nil if the database is unlimited.
+func (c *Client) DatabaseQuota(name string) *meta2.QuotaInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if dbi := c.cacheData.Database(name); dbi != nil {
+		return dbi.Quota.Clone()
+	}
+	return nil
+}
+
 func (c *Client) isValidName(user string) error {
 	if len(user) < minUsernameLen || len(user) > maxUsernameLen {
 		return errno.NewError(errno.InvalidUsernameLen, minUsernameLen, maxUsernameLen)
@@ -1998,6 +2041,28 @@ func (c *Client) SetPrivilege(username, database string, p originql.Privilege) e
 	)
 }
 
+// SetMeasurementPrivilege grants a privilege on a measurement of the database, or on all its measurements
+// if measurement is empty, limited to the series matching the condition. NoPrivileges drops the grant.
+func (c *Client) SetMeasurementPrivilege(username, database, measurement string, p originql.Privilege, condition string) error {
+	return c.retryUntilExec(proto2.Command_SetMeasurementPrivilegeCommand, proto2.E_SetMeasurementPrivilegeCommand_Command,
+		&proto2.SetMeasurementPrivilegeCommand{
+			Username:    proto.String(username),
+			Database:    proto.String(database),
+			Measurement: proto.String(measurement),
+			Privilege:   proto.Int32(int32(p)),
+			Condition:   proto.String(condition),
+		},
+	)
+}
+
+// UserMeasurementPrivileges returns the measurement privileges of a user.
+func (c *Client) UserMeasurementPrivileges(username string) ([]*meta2.MeasurementPrivilege, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.cacheData.UserMeasurementPrivileges(username)
+}
+
 // SetAdminPrivilege sets or unsets admin privilege to the given username.
 func (c *Client) SetAdminPrivilege(username string, admin bool) error {
 	return c.retryUntilExec(proto2.Command_SetAdminPrivilegeCommand, proto2.E_SetAdminPrivilegeCommand_Command,
@@ -2333,14 +2398,13 @@ func (c *Client) ShardsByTimeRange(sources influxql.Sources, tmin, tmax time.Tim
 	return a, nil
 }
 
-// DropShard deletes a shard by ID.
+// DropShard marks a shard as deleted by ID.
 func (c *Client) DropShard(id uint64) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	data := c.cacheData.Clone()
-	data.DropShard(id)
-	return nil
+	cmd := &proto2.DropShardCommand{
+		ID: proto.Uint64(id),
+	}
+	_, err := c.retryExec(proto2.Command_DropShardCommand, proto2.E_DropShardCommand_Command, cmd)
+	return err
 }
 
 // CreateShardGroup creates a shard group on a database and policy for a given timestamp.
@@ -2426,6 +2490,9 @@ func (c *Client) getAliveShardsForWAF(database string, sgi *meta2.ShardGroupInfo
 	c.mu.RLock()
 	aliveShardIdxes := make([]int, 0, len(sgi.Shards))
 	for i := range sgi.Shards {
+		if sgi.Shards[i].MarkDelete {
+			continue
+		}
 		if c.cacheData.PtView[database][sgi.Shards[i].Owners[0]].Status == meta2.Online {
 			aliveShardIdxes = append(aliveShardIdxes, i)
 		}
@@ -2445,6 +2512,9 @@ func (c *Client) getAliveShardsForSSAndRep(database string, sgi *meta2.ShardGrou
 	aliveShardIdxes := make([]int, 0, len(sgi.Shards)/replicaN)
 	ptView := c.cacheData.PtView[database]
 	for i := range sgi.Shards {
+		if sgi.Shards[i].MarkDelete {
+			continue
+		}
 		for _, ptId := range sgi.Shards[i].Owners {
 			if replicaN == 1 {
 				aliveShardIdxes = append(aliveShardIdxes, i)
@@ -3273,6 +3343,10 @@ func applyDeleteShardGroup(c *Client, cmd *proto2.Command) error {
 	return meta2.ApplyDeleteShardGroup(c.cacheData, cmd)
 }
 
+func applyDropShard(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyDropShard(c.cacheData, cmd)
+}
+
 func applyCreateSubscription(c *Client, cmd *proto2.Command) error {
 	return meta2.ApplyCreateSubscription(c.cacheData, cmd)
 }
@@ -3293,6 +3367,14 @@ func applyUpdateUser(c *Client, cmd *proto2.Command) error {
 	return meta2.ApplyUpdateUser(c.cacheData, cmd)
 }
 
+func applySetQuota(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplySetQuota(c.cacheData, cmd)
+}
+
+func applySetMeasurementPrivilege(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplySetMeasurementPrivilege(c.cacheData, cmd)
+}
+
 func applySetPrivilege(c *Client, cmd *proto2.Command) error {
 	return meta2.ApplySetPrivilege(c.cacheData, cmd)
 }
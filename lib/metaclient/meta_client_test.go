@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -778,6 +779,52 @@ func TestClient_GetMeasurements(t *testing.T) {
 	}
 }
 
+func TestClient_HiddenMeasurements(t *testing.T) {
+	c := &Client{
+		cacheData: &meta2.Data{
+			Databases: map[string]*meta2.DatabaseInfo{"test": {
+				Name: "test",
+				RetentionPolicies: map[string]*meta2.RetentionPolicyInfo{
+					"rp0": {
+						Name: "rp0",
+						Measurements: map[string]*meta2.MeasurementInfo{
+							"cpu_0000":                meta2.NewMeasurementInfo("cpu_0000", "cpu", config.TSSTORE, 1),
+							"__prom_metadata___0000":  meta2.NewMeasurementInfo("__prom_metadata___0000", meta2.PromMetadataMeasurement, config.TSSTORE, 2),
+							"__prom_exemplars___0000": meta2.NewMeasurementInfo("__prom_exemplars___0000", meta2.PromExemplarMeasurement, config.TSSTORE, 3),
+						},
+						MstVersions: map[string]meta2.MeasurementVer{
+							meta2.PromMetadataMeasurement: {NameWithVersion: "__prom_metadata___0000"},
+						},
+					},
+				}}},
+		},
+	}
+	all := &influxql.Measurement{Regex: &influxql.RegexLiteral{Val: regexp.MustCompile(".+")}}
+
+	names, err := c.Measurements("test", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cpu"}, names)
+	names, err = c.Measurements("test", influxql.Measurements{all})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cpu"}, names)
+
+	mis, err := c.MatchMeasurements("test", influxql.Measurements{{Name: meta2.PromMetadataMeasurement}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(mis))
+
+	ms, err := c.GetMeasurements(&influxql.Measurement{Database: "test", RetentionPolicy: "rp0"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ms))
+	assert.Equal(t, "cpu_0000", ms[0].Name)
+	ms, err = c.GetMeasurements(&influxql.Measurement{Database: "test", RetentionPolicy: "rp0", Regex: all.Regex})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ms))
+	assert.Equal(t, "cpu_0000", ms[0].Name)
+	ms, err = c.GetMeasurements(&influxql.Measurement{Database: "test", RetentionPolicy: "rp0", Name: meta2.PromMetadataMeasurement})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ms))
+}
+
 func TestClient_Stream_GetStreamInfos(t *testing.T) {
 	c := &Client{
 		cacheData: &meta2.Data{
@@ -2360,6 +2407,7 @@ var newPbFunc = map[proto2.Command_Type]func() (interface{}, *proto.ExtensionDes
 	proto2.Command_UpdateRetentionPolicyCommand:     newUpdateRetentionPolicyPb,
 	proto2.Command_CreateShardGroupCommand:          newCreateShardGroupPb,
 	proto2.Command_DeleteShardGroupCommand:          newDeleteShardGroupPb,
+	proto2.Command_DropShardCommand:                 newDropShardPb,
 	proto2.Command_CreateSubscriptionCommand:        newCreateSubscriptionPb,
 	proto2.Command_DropSubscriptionCommand:          newDropSubscriptionPb,
 	proto2.Command_CreateUserCommand:                newCreateUserPb,
@@ -2411,6 +2459,8 @@ var newPbFunc = map[proto2.Command_Type]func() (interface{}, *proto.ExtensionDes
 	proto2.Command_RemoveNodeCommand:                newRemoveNodePb,
 	proto2.Command_UpdateReplicationCommand:         newUpdateReplicationPb,
 	proto2.Command_UpdateMeasurementCommand:         newUpdateMeasurementPb,
+	proto2.Command_SetQuotaCommand:                  newSetQuotaPb,
+	proto2.Command_SetMeasurementPrivilegeCommand:   newSetMeasurementPrivilegePb,
 }
 
 func newCreateDatabasePb() (interface{}, *proto.ExtensionDesc) {
@@ -2471,6 +2521,12 @@ func newDeleteShardGroupPb() (interface{}, *proto.ExtensionDesc) {
 	}, proto2.E_DeleteShardGroupCommand_Command
 }
 
+func newDropShardPb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.DropShardCommand{
+		ID: proto.Uint64(1),
+	}, proto2.E_DropShardCommand_Command
+}
+
 func newCreateSubscriptionPb() (interface{}, *proto.ExtensionDesc) {
 	return &proto2.CreateSubscriptionCommand{
 		Database: proto.String("db0"),
@@ -2714,6 +2770,22 @@ func newUpdateMeasurementPb() (interface{}, *proto.ExtensionDesc) {
 	return &proto2.UpdateMeasurementCommand{}, proto2.E_UpdateMeasurementCommand_Command
 }
 
+func newSetQuotaPb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.SetQuotaCommand{
+		Database: proto.String("ds"),
+		Quota:    &proto2.QuotaInfo{MaxSeries: proto.Int64(100)},
+	}, proto2.E_SetQuotaCommand_Command
+}
+
+func newSetMeasurementPrivilegePb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.SetMeasurementPrivilegeCommand{
+		Username:    proto.String("user1"),
+		Database:    proto.String("ds"),
+		Measurement: proto.String("cpu"),
+		Privilege:   proto.Int32(1),
+	}, proto2.E_SetMeasurementPrivilegeCommand_Command
+}
+
 func BuildCmd(t proto2.Command_Type) *proto2.Command {
 	cmd1, ext := newPbFunc[t]()
 	cmd2 := &proto2.Command{Type: &t}
@@ -135,6 +135,48 @@ func (c *Client) getCQLease(currentServer int, host string) ([]string, error) {
 	return callback.CQNames, err
 }
 
+// GetRuleLease returns the rule groups which should be evaluated by the sql host.
+func (c *Client) GetRuleLease(host string, groups []string) ([]string, error) {
+	startTime := time.Now()
+	currentServer := connectedServer
+	var err error
+	var leased []string
+	for {
+		c.mu.RLock()
+		select {
+		case <-c.closing:
+			c.mu.RUnlock()
+			return nil, nil
+		default:
+		}
+
+		if currentServer >= len(c.metaServers) {
+			currentServer = 0
+		}
+		c.mu.RUnlock()
+		leased, err = c.getRuleLease(currentServer, host, groups)
+		if err == nil {
+			break
+		}
+
+		c.logger.Debug("get rule lease failed", zap.String("sql host", host), zap.Error(err), zap.Duration("duration", time.Since(startTime)))
+		if time.Since(startTime).Seconds() > float64(len(c.metaServers))*HttpReqTimeout.Seconds() {
+			break
+		}
+		time.Sleep(errSleep)
+
+		currentServer++
+	}
+	return leased, err
+}
+
+func (c *Client) getRuleLease(currentServer int, host string, groups []string) ([]string, error) {
+	callback := &GetRuleLeaseCallback{}
+	msg := message.NewMetaMessage(message.GetRuleLeaseRequestMessage, &message.GetRuleLeaseRequest{Host: host, Groups: groups})
+	err := c.SendRPCMsg(currentServer, msg, callback)
+	return callback.Groups, err
+}
+
 // BatchUpdateContinuousQueryStat reports all continuous queries state
 func (c *Client) BatchUpdateContinuousQueryStat(cqStats map[string]int64) error {
 	cmd := &proto2.ContinuousQueryReportCommand{}
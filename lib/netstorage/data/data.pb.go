@@ -537,6 +537,9 @@ type DeleteRequest struct {
 	ShardIDs             []uint64 `protobuf:"varint,4,rep,name=ShardIDs" json:"ShardIDs,omitempty"`
 	DeleteType           *int32   `protobuf:"varint,5,req,name=DeleteType" json:"DeleteType,omitempty"`
 	PtId                 *uint32  `protobuf:"varint,6,opt,name=PtId" json:"PtId,omitempty"`
+	Msts                 []string `protobuf:"bytes,7,rep,name=Msts" json:"Msts,omitempty"`
+	PtIds                []uint32 `protobuf:"varint,8,rep,name=PtIds" json:"PtIds,omitempty"`
+	Condition            *string  `protobuf:"bytes,9,opt,name=Condition" json:"Condition,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -608,6 +611,27 @@ func (m *DeleteRequest) GetPtId() uint32 {
 	return 0
 }
 
+func (m *DeleteRequest) GetMsts() []string {
+	if m != nil {
+		return m.Msts
+	}
+	return nil
+}
+
+func (m *DeleteRequest) GetPtIds() []uint32 {
+	if m != nil {
+		return m.PtIds
+	}
+	return nil
+}
+
+func (m *DeleteRequest) GetCondition() string {
+	if m != nil && m.Condition != nil {
+		return *m.Condition
+	}
+	return ""
+}
+
 type DeleteResponse struct {
 	Err                  *string  `protobuf:"bytes,1,opt,name=Err" json:"Err,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1412,71 +1436,73 @@ func init() {
 func init() { proto.RegisterFile("lib/netstorage/data/data.proto", fileDescriptor_2aaddb15866ce618) }
 
 var fileDescriptor_2aaddb15866ce618 = []byte{
-	// 1054 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x06, 0x49, 0xc9, 0x8d, 0x46, 0xb1, 0x62, 0xd3, 0x3f, 0x20, 0xe4, 0xd4, 0x25, 0x78, 0x52,
-	0x03, 0x43, 0x02, 0x0c, 0x14, 0x4d, 0x53, 0x20, 0x68, 0xf4, 0x83, 0x40, 0x48, 0xd5, 0x2a, 0x2b,
-	0xa3, 0x87, 0xa0, 0x28, 0xb0, 0x32, 0xd7, 0xca, 0x22, 0x14, 0xc9, 0xee, 0xae, 0x52, 0x0b, 0xed,
-	0xa1, 0xaf, 0xd0, 0x3e, 0x40, 0x4f, 0x7d, 0x93, 0xde, 0xfa, 0x54, 0xc5, 0xfe, 0x90, 0x5c, 0xc9,
-	0x16, 0x8a, 0xf4, 0xd2, 0x8b, 0xb0, 0x33, 0xdc, 0x99, 0xf9, 0x66, 0xe6, 0x9b, 0x59, 0xc1, 0x79,
-	0x42, 0xe7, 0xbd, 0x94, 0x08, 0x2e, 0x32, 0x86, 0x17, 0xa4, 0x17, 0x63, 0x81, 0xd5, 0x4f, 0x37,
-	0x67, 0x99, 0xc8, 0xfc, 0x47, 0xd5, 0xb7, 0xae, 0x54, 0xb7, 0x2f, 0xa4, 0xc1, 0x4a, 0xd0, 0xa4,
-	0x97, 0xd0, 0x1b, 0x41, 0xe2, 0x1e, 0x4d, 0x6f, 0x92, 0xd5, 0x6d, 0x6f, 0x49, 0x04, 0xee, 0x29,
-	0x1b, 0x75, 0xd4, 0xe6, 0xd1, 0xcf, 0x70, 0x38, 0x23, 0x8c, 0x12, 0xfe, 0x8a, 0xac, 0x39, 0x22,
-	0x3f, 0xae, 0x08, 0x17, 0x7e, 0x0b, 0xdc, 0xe1, 0x3c, 0x70, 0x42, 0xb7, 0xd3, 0x40, 0xee, 0x70,
-	0xee, 0x1f, 0x43, 0x7d, 0x2a, 0xc6, 0x43, 0x1e, 0xb8, 0xa1, 0xd7, 0xd9, 0x47, 0x5a, 0xf0, 0x23,
-	0x78, 0x38, 0x21, 0x98, 0xaf, 0x18, 0x59, 0x92, 0x54, 0xf0, 0xc0, 0x0b, 0xbd, 0x4e, 0x03, 0x6d,
-	0xe8, 0xfc, 0xc7, 0xd0, 0xb8, 0xce, 0xd2, 0x98, 0x0a, 0x9a, 0xa5, 0x41, 0x2d, 0x74, 0x3a, 0x0d,
-	0x54, 0x29, 0xa2, 0xe7, 0xe0, 0xdb, 0xc1, 0x79, 0x9e, 0xa5, 0x9c, 0xf8, 0xa7, 0xb0, 0xa7, 0xb5,
-	0x81, 0xa3, 0x3c, 0x1a, 0xc9, 0x3f, 0x00, 0x6f, 0xc4, 0x58, 0xe0, 0x2a, 0x2f, 0xf2, 0x18, 0xfd,
-	0x02, 0xfe, 0xec, 0x6d, 0xf6, 0xd3, 0x15, 0x5e, 0xfc, 0x1f, 0xe8, 0x5f, 0xc0, 0xd1, 0x46, 0x74,
-	0x03, 0x3f, 0x80, 0x8f, 0x8c, 0xca, 0xe0, 0x2f, 0xc4, 0x7b, 0x12, 0x78, 0x09, 0x27, 0x03, 0x46,
-	0xb0, 0x20, 0x43, 0x2c, 0x70, 0x1f, 0x73, 0xb2, 0x2b, 0x87, 0x16, 0xb8, 0xb9, 0x08, 0xdc, 0xd0,
-	0xed, 0xec, 0x23, 0x37, 0x57, 0xdf, 0x59, 0x1e, 0x78, 0xfa, 0x3b, 0xcb, 0xa3, 0x27, 0x70, 0xba,
-	0xed, 0xc8, 0xc0, 0x31, 0x41, 0x9d, 0x2a, 0xe8, 0x1f, 0x0e, 0xb4, 0x66, 0x6b, 0x3e, 0x10, 0x2c,
-	0x29, 0xc2, 0x1d, 0x80, 0x37, 0xc9, 0x62, 0x13, 0x4f, 0x1e, 0xfd, 0xaf, 0xa0, 0x3e, 0xc5, 0x0c,
-	0x2f, 0x55, 0xd1, 0x9a, 0x97, 0x4f, 0xba, 0x5b, 0x34, 0xeb, 0x6e, 0x7a, 0xe8, 0xaa, 0xcb, 0xa3,
-	0x54, 0xb0, 0x35, 0xd2, 0x86, 0xed, 0xa7, 0x00, 0x95, 0x52, 0x46, 0x78, 0x47, 0xd6, 0x05, 0x8c,
-	0x77, 0x64, 0x2d, 0xdb, 0xf2, 0x1e, 0x27, 0x2b, 0x62, 0xea, 0xa1, 0x85, 0x67, 0xee, 0x53, 0x27,
-	0xfa, 0xd3, 0x81, 0x47, 0xa5, 0xfb, 0xed, 0x34, 0x5c, 0x93, 0x86, 0x3f, 0x84, 0x3d, 0x44, 0xf8,
-	0x2a, 0x11, 0x06, 0xe2, 0xc5, 0x6e, 0x88, 0xda, 0x47, 0x57, 0x5f, 0xd7, 0x20, 0x8d, 0x6d, 0xfb,
-	0x0b, 0x68, 0x5a, 0xea, 0x0f, 0x82, 0x99, 0x43, 0xfb, 0x25, 0x11, 0xb3, 0xb7, 0x98, 0xc5, 0xb3,
-	0x3c, 0xa1, 0x62, 0x9a, 0xd1, 0x54, 0x6c, 0xb0, 0xb0, 0x5f, 0x76, 0xb0, 0xef, 0xfb, 0x50, 0x93,
-	0xc4, 0x33, 0x3d, 0x54, 0x67, 0x49, 0x15, 0x65, 0x3e, 0x1e, 0xaa, 0x56, 0xd6, 0x50, 0x21, 0xca,
-	0xa8, 0xe3, 0xf8, 0x96, 0xf0, 0xa0, 0x16, 0x7a, 0x1d, 0x0f, 0x69, 0x21, 0x7a, 0x0d, 0x67, 0xf7,
-	0x46, 0x34, 0x35, 0x0a, 0xa1, 0x69, 0xa9, 0x0d, 0xfb, 0x6c, 0xd5, 0x3d, 0x0c, 0xfc, 0xcd, 0x81,
-	0xfd, 0x21, 0x49, 0x88, 0x20, 0xbb, 0x80, 0xb7, 0xc0, 0x45, 0xb9, 0x31, 0x71, 0x51, 0xae, 0xb8,
-	0xc2, 0x45, 0xe0, 0x69, 0x1f, 0x13, 0x2e, 0xfc, 0x36, 0x3c, 0x30, 0xb8, 0x35, 0xde, 0x1a, 0x2a,
-	0x65, 0xff, 0x1c, 0x40, 0xbb, 0xbf, 0x5a, 0xe7, 0x24, 0xa8, 0x87, 0x6e, 0xa7, 0x8e, 0x2c, 0x8d,
-	0x29, 0x4b, 0x1c, 0xec, 0x85, 0x8e, 0x29, 0x4b, 0x1c, 0x45, 0xd0, 0x2a, 0x20, 0xed, 0x24, 0xf1,
-	0x5f, 0x0e, 0x1c, 0x9b, 0xe9, 0xfb, 0x4e, 0x76, 0xe4, 0x03, 0xa7, 0xff, 0xb3, 0x6a, 0x48, 0x3d,
-	0xc5, 0x9e, 0xb3, 0x3b, 0xec, 0x99, 0xe0, 0xbc, 0x18, 0xed, 0x72, 0x82, 0x1f, 0x43, 0x63, 0xb0,
-	0xbd, 0x10, 0x4a, 0x85, 0x0c, 0xf5, 0x35, 0x5d, 0x52, 0x11, 0xd4, 0x43, 0xa7, 0x53, 0x47, 0x5a,
-	0x90, 0xd5, 0x19, 0x52, 0x9e, 0xb1, 0x98, 0x30, 0x95, 0xe5, 0x03, 0x54, 0xca, 0xd1, 0x1c, 0x4e,
-	0xb6, 0x92, 0xd8, 0x95, 0xb0, 0xff, 0x39, 0xec, 0xe9, 0x3b, 0x86, 0xee, 0x9f, 0xdc, 0x01, 0x5c,
-	0x7a, 0x99, 0x25, 0xf4, 0x9a, 0x20, 0x73, 0x3d, 0xea, 0x03, 0x54, 0xa9, 0x48, 0x8e, 0x58, 0x2b,
-	0xce, 0xd4, 0xc9, 0x56, 0xc9, 0x8e, 0xa8, 0xba, 0xb8, 0x8a, 0x3e, 0xea, 0x1c, 0xfd, 0x00, 0xad,
-	0x4d, 0xef, 0xff, 0xcd, 0x8f, 0x5c, 0xed, 0x26, 0x09, 0xbd, 0x6e, 0x0b, 0x8c, 0x7f, 0x3b, 0x10,
-	0x8c, 0x6e, 0xf1, 0xb5, 0x18, 0x60, 0x16, 0xd3, 0x14, 0x27, 0x54, 0xac, 0xcb, 0x5a, 0x7c, 0x0f,
-	0x4d, 0x4b, 0xad, 0x68, 0xdd, 0xbc, 0x7c, 0x76, 0x27, 0xfd, 0x5d, 0xf6, 0x5d, 0x4b, 0xa7, 0x67,
-	0xdf, 0x76, 0x77, 0x77, 0x24, 0xda, 0xcf, 0xe1, 0x60, 0xdb, 0xe4, 0xdf, 0xf6, 0x42, 0xcd, 0xde,
-	0x0b, 0xbf, 0x3a, 0xd0, 0x98, 0x8a, 0x82, 0x8f, 0x67, 0xe0, 0x4e, 0x75, 0x7d, 0x9a, 0x97, 0x4d,
-	0xfd, 0xe8, 0x76, 0x87, 0xf3, 0xa9, 0x40, 0xee, 0x54, 0xa8, 0x2a, 0xd2, 0x05, 0xc3, 0x66, 0x3c,
-	0x5c, 0x35, 0x1e, 0xb6, 0x4a, 0x56, 0xf1, 0xdb, 0x7c, 0x1c, 0x9b, 0xfd, 0xa0, 0xce, 0xd2, 0xea,
-	0x45, 0x42, 0xdf, 0x93, 0x41, 0x96, 0xa6, 0xe3, 0x58, 0xf1, 0xb0, 0x86, 0x6c, 0x55, 0x74, 0x0e,
-	0x20, 0x11, 0xec, 0x9c, 0x9e, 0xdf, 0x1d, 0x78, 0xf8, 0x7a, 0x45, 0xd8, 0x7a, 0x74, 0x4b, 0xc6,
-	0xe9, 0x4d, 0x26, 0x37, 0x91, 0x92, 0xc7, 0x43, 0x05, 0xb5, 0x86, 0x0a, 0x51, 0x02, 0x98, 0x89,
-	0xa5, 0x7e, 0x7b, 0x1a, 0x48, 0x9d, 0x15, 0xa5, 0xb1, 0xc0, 0x73, 0xcc, 0x89, 0x79, 0x83, 0x4a,
-	0x59, 0x8e, 0x48, 0x9f, 0x2c, 0x68, 0x7a, 0x45, 0x97, 0x24, 0xa8, 0x85, 0x6e, 0xc7, 0x43, 0x95,
-	0x42, 0x5a, 0xa2, 0x55, 0x3a, 0x13, 0x58, 0x14, 0xcb, 0xa0, 0x94, 0xa3, 0x37, 0xfa, 0x3d, 0x95,
-	0x81, 0xa9, 0x35, 0x0a, 0x03, 0xd8, 0xb7, 0xa1, 0x72, 0x43, 0x80, 0x8f, 0xef, 0x10, 0xc0, 0xbe,
-	0x85, 0x36, 0x6d, 0xa2, 0x0b, 0x38, 0x78, 0x45, 0x93, 0x44, 0x29, 0x8b, 0xce, 0xec, 0xcc, 0x39,
-	0x1a, 0xc1, 0xa1, 0x75, 0xbb, 0x7a, 0xd7, 0x47, 0x8c, 0x0d, 0xb2, 0x98, 0xa8, 0x4a, 0xee, 0xa3,
-	0x42, 0x94, 0xac, 0x1e, 0x31, 0x36, 0xe1, 0x0b, 0xc3, 0x22, 0x23, 0x45, 0x5d, 0x38, 0x9e, 0x91,
-	0x05, 0x23, 0x0b, 0x2c, 0xc8, 0x37, 0x59, 0x5c, 0x6e, 0xd8, 0x53, 0xd8, 0x93, 0xe2, 0x38, 0x36,
-	0x71, 0x8d, 0x14, 0x7d, 0x0a, 0x27, 0x5b, 0xf7, 0x77, 0x36, 0x90, 0xc2, 0x11, 0xc2, 0x37, 0x62,
-	0x42, 0x38, 0xc7, 0x8b, 0x6a, 0xf9, 0xd9, 0x8d, 0xd1, 0xb7, 0xab, 0xc6, 0x14, 0x9b, 0xd6, 0xad,
-	0x36, 0xad, 0xfc, 0x13, 0x64, 0xbb, 0x51, 0x4b, 0xfd, 0x21, 0xda, 0xd0, 0xc9, 0x2c, 0x36, 0x43,
-	0x55, 0x7f, 0xd3, 0x4c, 0xd6, 0x8e, 0x9d, 0x75, 0xff, 0xe8, 0xcd, 0x61, 0xf7, 0xcb, 0xad, 0xde,
-	0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x93, 0x89, 0xca, 0xc6, 0x0a, 0x00, 0x00,
+	// 1078 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0x96, 0xed, 0x64, 0xdb, 0x9c, 0xec, 0xa6, 0x5b, 0xef, 0x8f, 0xac, 0x2c, 0x2c, 0x96, 0xaf,
+	0x42, 0xb5, 0x4a, 0xa4, 0x95, 0x10, 0xa5, 0x48, 0x15, 0xcd, 0x8f, 0xaa, 0xa8, 0x04, 0xd2, 0xc9,
+	0x8a, 0x8b, 0x0a, 0x21, 0x4d, 0xd6, 0xb3, 0xe9, 0xa8, 0x8e, 0x6d, 0x66, 0x26, 0x65, 0x23, 0xb8,
+	0xe0, 0x19, 0x78, 0x00, 0xae, 0x78, 0x13, 0xee, 0x78, 0x03, 0xde, 0x06, 0xcd, 0x8f, 0xed, 0x49,
+	0x76, 0x23, 0x54, 0x6e, 0xb8, 0x89, 0xe6, 0x7c, 0x9e, 0x73, 0xce, 0x77, 0x7e, 0x27, 0x70, 0x9e,
+	0xd0, 0x79, 0x2f, 0x25, 0x82, 0x8b, 0x8c, 0xe1, 0x05, 0xe9, 0xc5, 0x58, 0x60, 0xf5, 0xd3, 0xcd,
+	0x59, 0x26, 0x32, 0xff, 0x51, 0xf5, 0xad, 0x2b, 0xe1, 0xf6, 0x85, 0x54, 0x58, 0x09, 0x9a, 0xf4,
+	0x12, 0x7a, 0x23, 0x48, 0xdc, 0xa3, 0xe9, 0x4d, 0xb2, 0xba, 0xed, 0x2d, 0x89, 0xc0, 0x3d, 0xa5,
+	0xa3, 0x8e, 0x5a, 0x3d, 0xfa, 0x19, 0x1e, 0xcf, 0x08, 0xa3, 0x84, 0xbf, 0x22, 0x6b, 0x8e, 0xc8,
+	0x8f, 0x2b, 0xc2, 0x85, 0xdf, 0x02, 0x77, 0x38, 0x0f, 0x9c, 0xd0, 0xed, 0x34, 0x90, 0x3b, 0x9c,
+	0xfb, 0xc7, 0x50, 0x9f, 0x8a, 0xf1, 0x90, 0x07, 0x6e, 0xe8, 0x75, 0x0e, 0x90, 0x16, 0xfc, 0x08,
+	0xf6, 0x27, 0x04, 0xf3, 0x15, 0x23, 0x4b, 0x92, 0x0a, 0x1e, 0x78, 0xa1, 0xd7, 0x69, 0xa0, 0x0d,
+	0xcc, 0xff, 0x08, 0x1a, 0xd7, 0x59, 0x1a, 0x53, 0x41, 0xb3, 0x34, 0xa8, 0x85, 0x4e, 0xa7, 0x81,
+	0x2a, 0x20, 0x7a, 0x0e, 0xbe, 0xed, 0x9c, 0xe7, 0x59, 0xca, 0x89, 0x7f, 0x0a, 0x7b, 0x1a, 0x0d,
+	0x1c, 0x65, 0xd1, 0x48, 0xfe, 0x21, 0x78, 0x23, 0xc6, 0x02, 0x57, 0x59, 0x91, 0xc7, 0xe8, 0x17,
+	0xf0, 0x67, 0x6f, 0xb3, 0x9f, 0xae, 0xf0, 0xe2, 0xff, 0x60, 0xff, 0x02, 0x8e, 0x36, 0xbc, 0x1b,
+	0xfa, 0x01, 0x3c, 0x30, 0x90, 0xe1, 0x5f, 0x88, 0xf7, 0x04, 0xf0, 0x12, 0x4e, 0x06, 0x8c, 0x60,
+	0x41, 0x86, 0x58, 0xe0, 0x3e, 0xe6, 0x64, 0x57, 0x0c, 0x2d, 0x70, 0x73, 0x11, 0xb8, 0xa1, 0xdb,
+	0x39, 0x40, 0x6e, 0xae, 0xbe, 0xb3, 0x3c, 0xf0, 0xf4, 0x77, 0x96, 0x47, 0x4f, 0xe0, 0x74, 0xdb,
+	0x90, 0xa1, 0x63, 0x9c, 0x3a, 0x95, 0xd3, 0xdf, 0x1d, 0x68, 0xcd, 0xd6, 0x7c, 0x20, 0x58, 0x52,
+	0xb8, 0x3b, 0x04, 0x6f, 0x92, 0xc5, 0xc6, 0x9f, 0x3c, 0xfa, 0x5f, 0x41, 0x7d, 0x8a, 0x19, 0x5e,
+	0xaa, 0xa4, 0x35, 0x2f, 0x9f, 0x74, 0xb7, 0xda, 0xac, 0xbb, 0x69, 0xa1, 0xab, 0x2e, 0x8f, 0x52,
+	0xc1, 0xd6, 0x48, 0x2b, 0xb6, 0x9f, 0x02, 0x54, 0xa0, 0xf4, 0xf0, 0x8e, 0xac, 0x0b, 0x1a, 0xef,
+	0xc8, 0x5a, 0x96, 0xe5, 0x3d, 0x4e, 0x56, 0xc4, 0xe4, 0x43, 0x0b, 0xcf, 0xdc, 0xa7, 0x4e, 0xf4,
+	0x87, 0x03, 0x8f, 0x4a, 0xf3, 0xdb, 0x61, 0xb8, 0x26, 0x0c, 0x7f, 0x08, 0x7b, 0x88, 0xf0, 0x55,
+	0x22, 0x0c, 0xc5, 0x8b, 0xdd, 0x14, 0xb5, 0x8d, 0xae, 0xbe, 0xae, 0x49, 0x1a, 0xdd, 0xf6, 0x17,
+	0xd0, 0xb4, 0xe0, 0x0f, 0xa2, 0x99, 0x43, 0xfb, 0x25, 0x11, 0xb3, 0xb7, 0x98, 0xc5, 0xb3, 0x3c,
+	0xa1, 0x62, 0x9a, 0xd1, 0x54, 0x6c, 0x74, 0x61, 0xbf, 0xac, 0x60, 0xdf, 0xf7, 0xa1, 0x26, 0x1b,
+	0xcf, 0xd4, 0x50, 0x9d, 0x65, 0xab, 0x28, 0xf5, 0xf1, 0x50, 0x95, 0xb2, 0x86, 0x0a, 0x51, 0x7a,
+	0x1d, 0xc7, 0xb7, 0x84, 0x07, 0xb5, 0xd0, 0xeb, 0x78, 0x48, 0x0b, 0xd1, 0x6b, 0x38, 0xbb, 0xd7,
+	0xa3, 0xc9, 0x51, 0x08, 0x4d, 0x0b, 0x36, 0xdd, 0x67, 0x43, 0xf7, 0x74, 0xe0, 0xdf, 0x0e, 0x1c,
+	0x0c, 0x49, 0x42, 0x04, 0xd9, 0x45, 0xbc, 0x05, 0x2e, 0xca, 0x8d, 0x8a, 0x8b, 0x72, 0xd5, 0x2b,
+	0x5c, 0x04, 0x9e, 0xb6, 0x31, 0xe1, 0xc2, 0x6f, 0xc3, 0x43, 0xc3, 0x5b, 0xf3, 0xad, 0xa1, 0x52,
+	0xf6, 0xcf, 0x01, 0xb4, 0xf9, 0xab, 0x75, 0x4e, 0x82, 0x7a, 0xe8, 0x76, 0xea, 0xc8, 0x42, 0x4c,
+	0x5a, 0xe2, 0x60, 0x2f, 0x74, 0x4c, 0x5a, 0x62, 0x89, 0x4d, 0xb8, 0xe0, 0xc1, 0x03, 0x15, 0x80,
+	0x3a, 0x9b, 0x21, 0x8e, 0x79, 0xf0, 0xb0, 0x1c, 0xe2, 0x58, 0x0d, 0xe8, 0xa0, 0x1c, 0xd0, 0x86,
+	0x1e, 0xd0, 0x12, 0x88, 0x22, 0x68, 0x15, 0xa1, 0xed, 0x1c, 0x86, 0x3f, 0x1d, 0x38, 0x36, 0x53,
+	0xfc, 0x9d, 0xac, 0xec, 0x07, 0x6e, 0x91, 0xcf, 0xaa, 0x61, 0xf7, 0x54, 0x17, 0x9e, 0xdd, 0xe9,
+	0xc2, 0x09, 0xce, 0xcd, 0x95, 0x6a, 0x13, 0x6c, 0xf0, 0xae, 0x6d, 0xf1, 0x96, 0xae, 0xbe, 0xa6,
+	0x4b, 0x2a, 0x82, 0x7a, 0xe8, 0x74, 0xea, 0x48, 0x0b, 0x32, 0xcb, 0x43, 0xca, 0x33, 0x16, 0x13,
+	0xa6, 0xb2, 0xf5, 0x10, 0x95, 0x72, 0x34, 0x87, 0x93, 0xad, 0x20, 0x76, 0x05, 0xec, 0x7f, 0x0e,
+	0x7b, 0xfa, 0x8e, 0x19, 0x9b, 0x4f, 0xee, 0x10, 0x2e, 0xad, 0xcc, 0x12, 0x7a, 0x4d, 0x90, 0xb9,
+	0x1e, 0xf5, 0x01, 0xaa, 0x50, 0x64, 0xaf, 0x59, 0xab, 0xd2, 0xe4, 0xc9, 0x86, 0x64, 0x15, 0x55,
+	0x5e, 0x5c, 0x5d, 0x45, 0x79, 0x8e, 0x7e, 0x80, 0xd6, 0xa6, 0xf5, 0xff, 0x66, 0x47, 0x3e, 0x11,
+	0x26, 0x08, 0xbd, 0xb6, 0x0b, 0x8e, 0x7f, 0x39, 0x10, 0x8c, 0x6e, 0xf1, 0xb5, 0x18, 0x60, 0x16,
+	0xd3, 0x14, 0x27, 0x54, 0xac, 0xcb, 0x5c, 0x7c, 0x0f, 0x4d, 0x0b, 0x56, 0xe3, 0xd1, 0xbc, 0x7c,
+	0x76, 0x27, 0xfc, 0x5d, 0xfa, 0x5d, 0x0b, 0xd3, 0x3b, 0xc4, 0x36, 0x77, 0x77, 0xb4, 0xda, 0xcf,
+	0xe1, 0x70, 0x5b, 0xe5, 0xdf, 0xf6, 0x4b, 0xcd, 0xde, 0x2f, 0xbf, 0x3a, 0xd0, 0x98, 0x8a, 0xa2,
+	0x1f, 0xcf, 0xc0, 0x9d, 0xea, 0xfc, 0x34, 0x2f, 0x9b, 0xfa, 0xf1, 0xee, 0x0e, 0xe7, 0x53, 0x81,
+	0xdc, 0xa9, 0x50, 0x59, 0xa4, 0x0b, 0x86, 0xcd, 0x98, 0xb9, 0x6a, 0xcc, 0x6c, 0x48, 0x66, 0xf1,
+	0xdb, 0x7c, 0x1c, 0x9b, 0x3d, 0xa3, 0xce, 0x52, 0xeb, 0x45, 0x42, 0xdf, 0x93, 0x41, 0x96, 0xa6,
+	0xe3, 0x58, 0xf5, 0x61, 0x0d, 0xd9, 0x50, 0x74, 0x0e, 0x30, 0x15, 0x45, 0x02, 0xee, 0x99, 0x9e,
+	0xdf, 0x1c, 0xd8, 0x7f, 0xbd, 0x22, 0x6c, 0x3d, 0xba, 0x25, 0xe3, 0xf4, 0x26, 0x93, 0x1b, 0x4d,
+	0xc9, 0xe3, 0xa1, 0xa2, 0x5a, 0x43, 0x85, 0x28, 0x09, 0xcc, 0xc4, 0x52, 0xbf, 0x61, 0x0d, 0xa4,
+	0xce, 0xaa, 0xa5, 0xb1, 0xc0, 0x73, 0xcc, 0x89, 0x79, 0xcb, 0x4a, 0x59, 0x8e, 0x48, 0x9f, 0x2c,
+	0x68, 0x7a, 0x45, 0x97, 0x24, 0xa8, 0x85, 0x6e, 0xc7, 0x43, 0x15, 0x20, 0x35, 0xd1, 0x2a, 0x9d,
+	0x09, 0x2c, 0x8a, 0xa5, 0x52, 0xca, 0xd1, 0x1b, 0xfd, 0x2e, 0x4b, 0xc7, 0xd4, 0x1a, 0x85, 0x01,
+	0x1c, 0xd8, 0x54, 0xb9, 0x69, 0x80, 0x8f, 0xef, 0x34, 0x80, 0x7d, 0x0b, 0x6d, 0xea, 0x44, 0x17,
+	0x70, 0xf8, 0x8a, 0x26, 0x89, 0x02, 0x8b, 0xca, 0xec, 0x8c, 0x39, 0x1a, 0xc1, 0x63, 0xeb, 0x76,
+	0xf5, 0xff, 0x60, 0xc4, 0xd8, 0x20, 0x8b, 0x89, 0xca, 0xe4, 0x01, 0x2a, 0x44, 0xd9, 0xd5, 0x23,
+	0xc6, 0x26, 0x7c, 0x61, 0xba, 0xc8, 0x48, 0x51, 0x17, 0x8e, 0x67, 0x64, 0xc1, 0xc8, 0x02, 0x0b,
+	0xf2, 0x4d, 0x16, 0x97, 0x9b, 0xfa, 0x14, 0xf6, 0xa4, 0x38, 0x8e, 0x8d, 0x5f, 0x23, 0x45, 0x9f,
+	0xc2, 0xc9, 0xd6, 0xfd, 0x9d, 0x05, 0xa4, 0x70, 0x84, 0xf0, 0x8d, 0x98, 0x10, 0xce, 0xf1, 0xa2,
+	0x5a, 0x7e, 0x76, 0x61, 0xf4, 0xed, 0xaa, 0x30, 0xc5, 0xc6, 0x76, 0xad, 0x8d, 0x1d, 0xc1, 0xbe,
+	0x6d, 0x46, 0x3d, 0x0e, 0xfb, 0x68, 0x03, 0x93, 0x51, 0x6c, 0xba, 0xaa, 0xfe, 0xee, 0x99, 0xa8,
+	0x1d, 0x3b, 0xea, 0xfe, 0xd1, 0x9b, 0xc7, 0xdd, 0x2f, 0xb7, 0x6a, 0xf3, 0xcf, 0x00, 0x6b, 0x03,
+	0xea, 0x21, 0x0e, 0x0b, 0x00, 0x00,
 }
@@ -91,15 +91,19 @@ type Engine interface {
 
 	DropMeasurement(db string, rp string, name string, shardIds []uint64) error
 
+	DropShard(db string, ptId uint32, shardID uint64) error
+
 	TagKeys(db string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) ([]string, error)
 
 	SeriesKeys(db string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) ([]string, error)
 	SeriesCardinality(db string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) ([]meta.MeasurementCardinalityInfo, error)
 	SeriesExactCardinality(db string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) (map[string]uint64, error)
+	MatchMeasurements(db string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) ([]string, error)
 
 	TagValues(db string, ptId []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange) (TablesTagSets, error)
 	TagValuesCardinality(db string, ptIDs []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange) (map[string]uint64, error)
-	DropSeries(database string, sources []influxql.Source, ptId []uint32, condition influxql.Expr) (int, error)
+	DropSeries(db, rp string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr) error
+	DeleteSeries(db, rp string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) error
 
 	DbPTRef(db string, ptId uint32) error
 	DbPTUnref(db string, ptId uint32)
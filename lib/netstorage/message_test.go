@@ -126,6 +126,15 @@ func TestDeleteRequestMessage(t *testing.T) {
 	assert.Empty(t, other.Measurement, "expected value of Measurement is empty, got: %v", other.Measurement)
 	assert.Empty(t, other.ShardIds, "expected value of ShardIds is empty, got: %+v", other.ShardIds)
 	assert.Empty(t, other.Rp, "expected value of Rp is empty, got: %+v", other.Rp)
+
+	req, other = makeDeleteRequestMessage(t, netstorage.ShardDelete)
+	assert.Empty(t, other.Measurement, "expected value of Measurement is empty, got: %v", other.Measurement)
+	assert.Equal(t, req.Rp, other.Rp)
+	assert.Equal(t, req.ShardIds, other.ShardIds)
+
+	req, other = makeDeleteRequestMessage(t, netstorage.SeriesDelete)
+	assert.Equal(t, req.Database, other.Database)
+	assert.Equal(t, req.Rp, other.Rp)
 }
 
 func TestShowTagValuesRequest(t *testing.T) {
@@ -62,6 +62,9 @@ const (
 
 	RaftMessagesRequestMessage
 	RaftMessagesResponseMessage
+
+	ShowMeasurementsRequestMessage
+	ShowMeasurementsResponseMessage
 )
 
 var MessageBinaryCodec = make(map[uint8]func() codec.BinaryCodec, 20)
@@ -94,6 +97,8 @@ func init() {
 	MessageBinaryCodec[ShowTagKeysResponseMessage] = func() codec.BinaryCodec { return &ShowTagKeysResponse{} }
 	MessageBinaryCodec[RaftMessagesRequestMessage] = func() codec.BinaryCodec { return &RaftMessagesRequest{} }
 	MessageBinaryCodec[RaftMessagesResponseMessage] = func() codec.BinaryCodec { return &RaftMessagesResponse{} }
+	MessageBinaryCodec[ShowMeasurementsRequestMessage] = func() codec.BinaryCodec { return &ShowMeasurementsRequest{} }
+	MessageBinaryCodec[ShowMeasurementsResponseMessage] = func() codec.BinaryCodec { return &ShowMeasurementsResponse{} }
 
 	MessageResponseTyp = map[uint8]uint8{
 		SeriesKeysRequestMessage:               SeriesKeysResponseMessage,
@@ -108,5 +113,6 @@ func init() {
 		KillQueryRequestMessage:                KillQueryResponseMessage,
 		ShowTagKeysRequestMessage:              ShowTagKeysResponseMessage,
 		RaftMessagesRequestMessage:             RaftMessagesResponseMessage,
+		ShowMeasurementsRequestMessage:         ShowMeasurementsResponseMessage,
 	}
 }
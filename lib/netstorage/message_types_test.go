@@ -44,6 +44,7 @@ func TestMessageTypes(t *testing.T) {
 		store.ShowQueriesRequestMessage:              {&store.ShowQueriesRequest{}, &store.ShowQueriesResponse{}},
 		store.KillQueryRequestMessage:                {&store.KillQueryRequest{}, &store.KillQueryResponse{}},
 		store.ShowTagKeysRequestMessage:              {&store.ShowTagKeysRequest{}, &store.ShowTagKeysResponse{}},
+		store.ShowMeasurementsRequestMessage:         {&store.ShowMeasurementsRequest{}, &store.ShowMeasurementsResponse{}},
 	}
 
 	for typ, items := range data {
@@ -35,6 +35,9 @@ const (
 	DatabaseDelete DeleteType = iota
 	RetentionPolicyDelete
 	MeasurementDelete
+	SeriesDelete
+	SeriesDrop
+	ShardDelete
 )
 
 type RunStateType int32
@@ -52,12 +55,26 @@ type DeleteRequest struct {
 	ShardIds    []uint64
 	Type        DeleteType
 	PtId        uint32
+
+	// used by SeriesDelete, SeriesDrop and ShardDelete
+	Measurements []string
+	PtIds        []uint32
+	Condition    string
 }
 
 func (ddr *DeleteRequest) MarshalBinary() ([]byte, error) {
 	dr := &internal2.DeleteRequest{DB: proto.String(ddr.Database)}
 	dr.DeleteType = proto.Int(int(ddr.Type))
 	switch ddr.Type {
+	case SeriesDelete, SeriesDrop:
+		dr.Rp = proto.String(ddr.Rp)
+		dr.Msts = ddr.Measurements
+		dr.PtIds = ddr.PtIds
+		dr.Condition = proto.String(ddr.Condition)
+	case ShardDelete:
+		dr.Rp = proto.String(ddr.Rp)
+		dr.ShardIDs = ddr.ShardIds
+		dr.PtIds = ddr.PtIds
 	case MeasurementDelete:
 		dr.Mst = proto.String(ddr.Measurement)
 		dr.ShardIDs = ddr.ShardIds
@@ -80,6 +97,17 @@ func (ddr *DeleteRequest) UnmarshalBinary(data []byte) error {
 	}
 	ddr.Type = DeleteType(pb.GetDeleteType())
 	switch ddr.Type {
+	case SeriesDelete, SeriesDrop:
+		ddr.Database = pb.GetDB()
+		ddr.Rp = pb.GetRp()
+		ddr.Measurements = pb.GetMsts()
+		ddr.PtIds = pb.GetPtIds()
+		ddr.Condition = pb.GetCondition()
+	case ShardDelete:
+		ddr.Database = pb.GetDB()
+		ddr.Rp = pb.GetRp()
+		ddr.ShardIds = pb.GetShardIDs()
+		ddr.PtIds = pb.GetPtIds()
 	case MeasurementDelete:
 		ddr.Measurement = pb.GetMst()
 		ddr.ShardIds = pb.GetShardIDs()
@@ -191,6 +219,15 @@ type SeriesExactCardinalityResponse struct {
 	ExactCardinalityResponse
 }
 
+type ShowMeasurementsRequest struct {
+	SeriesKeysRequest
+}
+
+// ShowMeasurementsResponse returns the names of the matched measurements in Series
+type ShowMeasurementsResponse struct {
+	SeriesKeysResponse
+}
+
 type ShowTagValuesCardinalityRequest struct {
 	ShowTagValuesRequest
 }
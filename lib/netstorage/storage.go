@@ -58,6 +58,7 @@ type Storage interface {
 	ShowSeries(nodeID uint64, db string, ptId []uint32, measurements []string, condition influxql.Expr) ([]string, error)
 	SeriesCardinality(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) ([]meta2.MeasurementCardinalityInfo, error)
 	SeriesExactCardinality(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) (map[string]uint64, error)
+	ShowMeasurements(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) ([]string, error)
 
 	SendQueryRequestOnNode(nodeID uint64, req SysCtrlRequest) (map[string]string, error)
 	SendSysCtrlOnNode(nodID uint64, req SysCtrlRequest) (map[string]string, error)
@@ -67,6 +68,8 @@ type Storage interface {
 	DeleteDatabase(node *meta2.DataNode, database string, pt uint32) error
 	DeleteRetentionPolicy(node *meta2.DataNode, db string, rp string, pt uint32) error
 	DeleteMeasurement(node *meta2.DataNode, db string, rp string, name string, shardIds []uint64) error
+	DeleteSeries(nodeID uint64, db, rp string, ptIDs []uint32, measurements []string, condition influxql.Expr) error
+	DropSeries(nodeID uint64, db, rp string, ptIDs []uint32, measurements []string, condition influxql.Expr) error
 	MigratePt(nodeID uint64, data transport.Codec, cb transport.Callback) error
 
 	GetQueriesOnNode(nodeID uint64) ([]*QueryExeInfo, error)
@@ -146,6 +149,42 @@ func (s *NetStorage) DeleteMeasurement(node *meta2.DataNode, db string, rp strin
 	return s.HandleDeleteReq(node, deleteReq)
 }
 
+func (s *NetStorage) DeleteSeries(nodeID uint64, db, rp string, ptIDs []uint32, measurements []string, condition influxql.Expr) error {
+	return s.seriesDeleteRequest(nodeID, SeriesDelete, db, rp, ptIDs, measurements, condition)
+}
+
+func (s *NetStorage) DropSeries(nodeID uint64, db, rp string, ptIDs []uint32, measurements []string, condition influxql.Expr) error {
+	return s.seriesDeleteRequest(nodeID, SeriesDrop, db, rp, ptIDs, measurements, condition)
+}
+
+func (s *NetStorage) seriesDeleteRequest(nodeID uint64, typ DeleteType, db, rp string, ptIDs []uint32, measurements []string, condition influxql.Expr) error {
+	deleteReq := &DeleteRequest{
+		Type:         typ,
+		Database:     db,
+		Rp:           rp,
+		Measurements: measurements,
+		PtIds:        ptIDs,
+	}
+	if condition != nil {
+		deleteReq.Condition = condition.String()
+	}
+	return s.deleteRequest(nodeID, deleteReq)
+}
+
+func (s *NetStorage) deleteRequest(nodeID uint64, deleteReq *DeleteRequest) error {
+	v, err := s.ddlRequestWithNodeId(nodeID, DeleteRequestMessage, deleteReq)
+	if err != nil {
+		return err
+	}
+
+	resp, ok := v.(*DeleteResponse)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.DeleteResponse", v)
+	}
+
+	return resp.Err
+}
+
 func (s *NetStorage) DeleteRetentionPolicy(node *meta2.DataNode, db string, rp string, pt uint32) error {
 	deleteReq := &DeleteRequest{
 		Type:     RetentionPolicyDelete,
@@ -333,6 +372,29 @@ func (s *NetStorage) SeriesExactCardinality(nodeID uint64, db string, dbPts []ui
 	return resp.Cardinality, resp.Error()
 }
 
+// ShowMeasurements returns the measurements which have any series matching the condition on the node
+func (s *NetStorage) ShowMeasurements(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) ([]string, error) {
+	req := &ShowMeasurementsRequest{}
+	req.Db = proto.String(db)
+	req.PtIDs = dbPts
+	req.Measurements = measurements
+	if condition != nil {
+		req.Condition = proto.String(condition.String())
+	}
+
+	v, err := s.ddlRequestWithNodeId(nodeID, ShowMeasurementsRequestMessage, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, ok := v.(*ShowMeasurementsResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.ShowMeasurementsResponse", v)
+	}
+
+	return resp.Series, resp.Error()
+}
+
 func (s *NetStorage) ShowTagKeys(nodeID uint64, db string, ptIDs []uint32, measurements []string, condition influxql.Expr) ([]string, error) {
 	req := &ShowTagKeysRequest{}
 	req.Db = proto.String(db)
@@ -378,7 +440,14 @@ func (s *NetStorage) ShowSeries(nodeID uint64, db string, ptIDs []uint32, measur
 }
 
 func (s *NetStorage) DropShard(nodeID uint64, database, rpName string, dbPts []uint32, shardID uint64) error {
-	return nil
+	deleteReq := &DeleteRequest{
+		Type:     ShardDelete,
+		Database: database,
+		Rp:       rpName,
+		PtIds:    dbPts,
+		ShardIds: []uint64{shardID},
+	}
+	return s.deleteRequest(nodeID, deleteReq)
 }
 
 func (s *NetStorage) SendQueryRequestOnNode(nodeID uint64, req SysCtrlRequest) (map[string]string, error) {
@@ -18,6 +18,7 @@ package opentelemetry
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -54,10 +55,16 @@ type InfluxRowsWriter interface {
 	RetryWritePointRows(database, retentionPolicy string, points []influx.Row) error
 }
 
+type otlpRequest interface {
+	UnmarshalProto(data []byte) error
+	UnmarshalJSON(data []byte) error
+}
+
 type otelConext struct {
 	// read from request
 	br      bufio.Reader
 	ReqBuf  []byte
+	JSON    bool // request body is encoded in JSON instead of protobuf
 	ptrace  ptraceotlp.Request
 	pmetric pmetricotlp.Request
 	plog    plogotlp.Request
@@ -75,23 +82,32 @@ type otelConext struct {
 	logger *logger.Logger
 }
 
+// Read reads the whole request body at once, a negative contentLength means the length
+// is unknown, e.g. the body is gzip compressed. Read returns false once the body has been read.
 func (octx *otelConext) Read(contentLength int) bool {
 	if octx.err != nil {
 		return false
 	}
-	if contentLength > cap(octx.ReqBuf) {
-		octx.ReqBuf = append(octx.ReqBuf, make([]byte, contentLength)...)
-	}
-	octx.ReqBuf = octx.ReqBuf[:contentLength]
-	n, err := io.ReadFull(&octx.br, octx.ReqBuf)
-	octx.ReqBuf = octx.ReqBuf[:n]
-	if err != nil {
-		if err != io.EOF {
-			octx.err = fmt.Errorf("cannot read influx line protocol data: %w", octx.err)
-			return false
+
+	var err error
+	if contentLength >= 0 {
+		if contentLength > cap(octx.ReqBuf) {
+			octx.ReqBuf = append(octx.ReqBuf[:0], make([]byte, contentLength)...)
 		}
-		return true
+		octx.ReqBuf = octx.ReqBuf[:contentLength]
+		var n int
+		n, err = io.ReadFull(&octx.br, octx.ReqBuf)
+		octx.ReqBuf = octx.ReqBuf[:n]
+	} else {
+		buf := bytes.NewBuffer(octx.ReqBuf[:0])
+		_, err = buf.ReadFrom(&octx.br)
+		octx.ReqBuf = buf.Bytes()
+	}
+	if err != nil && err != io.EOF {
+		octx.err = fmt.Errorf("cannot read otlp data: %w", err)
+		return false
 	}
+	octx.err = io.EOF
 	return true
 }
 
@@ -105,6 +121,7 @@ func (octx *otelConext) Error() error {
 
 func (octx *otelConext) reset() {
 	octx.ReqBuf = octx.ReqBuf[:0]
+	octx.JSON = false
 	octx.err = nil
 
 	octx.Database = ""
@@ -113,14 +130,18 @@ func (octx *otelConext) reset() {
 }
 
 func (octx *otelConext) WriteTraces(ctx context.Context) error {
-	// TODO: only support proto, next step is json
 	octx.ptrace = ptraceotlp.NewRequest() // new every time
-	if err := octx.ptrace.UnmarshalProto(octx.ReqBuf); err != nil {
+	if err := octx.unmarshal(octx.ptrace); err != nil {
 		octx.err = err
 		return nil
 	}
+	return octx.ExportTraces(ctx, octx.ptrace.Traces())
+}
 
-	err := octx.PtraceWriter.WriteTraces(ctx, octx.ptrace.Traces(), octx)
+// ExportTraces converts the decoded traces to rows and writes them, the conversion error
+// is kept in Error() and only the write error is returned
+func (octx *otelConext) ExportTraces(ctx context.Context, td ptrace.Traces) error {
+	err := octx.PtraceWriter.WriteTraces(ctx, td, octx)
 	if err != nil {
 		if strings.Contains(err.Error(), "failed to convert OTLP span to line protocol") {
 			octx.err = err
@@ -132,14 +153,18 @@ func (octx *otelConext) WriteTraces(ctx context.Context) error {
 }
 
 func (octx *otelConext) WriteMetrics(ctx context.Context) error {
-	// TODO: only support proto, next step is json
 	octx.pmetric = pmetricotlp.NewRequest() // new every time
-	if err := octx.pmetric.UnmarshalProto(octx.ReqBuf); err != nil {
+	if err := octx.unmarshal(octx.pmetric); err != nil {
 		octx.err = err
 		return nil
 	}
+	return octx.ExportMetrics(ctx, octx.pmetric.Metrics())
+}
 
-	err := octx.PmetricWriter.WriteMetrics(ctx, octx.pmetric.Metrics(), octx)
+// ExportMetrics converts the decoded metrics to rows and writes them, the conversion error
+// is kept in Error() and only the write error is returned
+func (octx *otelConext) ExportMetrics(ctx context.Context, md pmetric.Metrics) error {
+	err := octx.PmetricWriter.WriteMetrics(ctx, md, octx)
 	if err != nil {
 		if strings.Contains(err.Error(), "failed to convert OTLP metric to line protocol") {
 			octx.err = err
@@ -151,14 +176,18 @@ func (octx *otelConext) WriteMetrics(ctx context.Context) error {
 }
 
 func (octx *otelConext) WriteLogs(ctx context.Context) error {
-	// TODO: only support proto, next step is json
 	octx.plog = plogotlp.NewRequest() // new every time
-	if err := octx.plog.UnmarshalProto(octx.ReqBuf); err != nil {
+	if err := octx.unmarshal(octx.plog); err != nil {
 		octx.err = err
 		return nil
 	}
+	return octx.ExportLogs(ctx, octx.plog.Logs())
+}
 
-	err := octx.PlogWriter.WriteLogs(ctx, octx.plog.Logs(), octx)
+// ExportLogs converts the decoded logs to rows and writes them, the conversion error
+// is kept in Error() and only the write error is returned
+func (octx *otelConext) ExportLogs(ctx context.Context, ld plog.Logs) error {
+	err := octx.PlogWriter.WriteLogs(ctx, ld, octx)
 	if err != nil {
 		if strings.Contains(err.Error(), "failed to convert OTLP log record to line protocol") {
 			octx.err = err
@@ -169,6 +198,13 @@ func (octx *otelConext) WriteLogs(ctx context.Context) error {
 	return nil
 }
 
+func (octx *otelConext) unmarshal(req otlpRequest) error {
+	if octx.JSON {
+		return req.UnmarshalJSON(octx.ReqBuf)
+	}
+	return req.UnmarshalProto(octx.ReqBuf)
+}
+
 // NewBatch impl otel2influx.InfluxWriter
 func (octx *otelConext) NewBatch() otel2influx.InfluxWriter {
 	return octx
@@ -0,0 +1,207 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parquet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	pq "github.com/apache/arrow/go/v13/parquet"
+	"github.com/apache/arrow/go/v13/parquet/file"
+	"github.com/apache/arrow/go/v13/parquet/pqarrow"
+)
+
+// Reader reads the columns of a Parquet file by the range of rows. The columns of the row group
+// read last are kept in memory, as the adjacent rows are usually read one after another.
+// A Reader is safe for concurrent use.
+type Reader struct {
+	mu      sync.Mutex
+	rdr     *file.Reader
+	fr      *pqarrow.FileReader
+	schema  Schema
+	offsets []int64 // the first row of every row group, followed by the number of rows of the file
+
+	rowGroup int                 // the row group of the cached columns
+	columns  map[int]arrow.Array // the cached columns by index
+}
+
+// NewReader opens a Parquet file, r is closed by Reader.Close
+func NewReader(r pq.ReaderAtSeeker) (*Reader, error) {
+	rdr, err := file.NewParquetReader(r)
+	if err != nil {
+		return nil, err
+	}
+	fr, err := pqarrow.NewFileReader(rdr, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		_ = rdr.Close()
+		return nil, err
+	}
+	arrowSchema, err := fr.Schema()
+	if err != nil {
+		_ = rdr.Close()
+		return nil, err
+	}
+
+	schema := make(Schema, len(arrowSchema.Fields()))
+	for i, f := range arrowSchema.Fields() {
+		typ, ok := typeOf(f.Type)
+		if !ok {
+			_ = rdr.Close()
+			return nil, fmt.Errorf("parquet: unsupported type %s of field %q", f.Type, f.Name)
+		}
+		schema[i] = Field{Name: f.Name, Type: typ, Required: !f.Nullable}
+	}
+
+	offsets := make([]int64, rdr.NumRowGroups()+1)
+	for i := 0; i < rdr.NumRowGroups(); i++ {
+		offsets[i+1] = offsets[i] + rdr.MetaData().RowGroup(i).NumRows()
+	}
+	return &Reader{
+		rdr:      rdr,
+		fr:       fr,
+		schema:   schema,
+		offsets:  offsets,
+		rowGroup: -1,
+	}, nil
+}
+
+func typeOf(dt arrow.DataType) (Type, bool) {
+	switch dt.ID() {
+	case arrow.BOOL:
+		return Boolean, true
+	case arrow.INT64:
+		return Int64, true
+	case arrow.UINT64:
+		return Uint64, true
+	case arrow.FLOAT64:
+		return Double, true
+	case arrow.STRING:
+		return String, true
+	case arrow.TIMESTAMP:
+		return Timestamp, true
+	default:
+		return 0, false
+	}
+}
+
+func (r *Reader) Schema() Schema {
+	return r.schema
+}
+
+func (r *Reader) NumRows() int64 {
+	return r.offsets[len(r.offsets)-1]
+}
+
+// Metadata returns the value of the key in the key-value metadata of the file
+func (r *Reader) Metadata(key string) (string, bool) {
+	v := r.rdr.MetaData().KeyValueMetadata().FindValue(key)
+	if v == nil {
+		return "", false
+	}
+	return *v, true
+}
+
+// ReadColumn calls fn with the values of the column in the rows [start, end), one array
+// per row group. The arrays are released after fn returns.
+func (r *Reader) ReadColumn(column int, start, end int64, fn func(arrow.Array) error) error {
+	if column < 0 || column >= len(r.schema) {
+		return fmt.Errorf("parquet: column %d out of range %d", column, len(r.schema))
+	}
+	if start < 0 || end > r.NumRows() || start > end {
+		return fmt.Errorf("parquet: rows [%d, %d) out of range %d", start, end, r.NumRows())
+	}
+
+	for start < end {
+		rg := sort.Search(len(r.offsets)-1, func(i int) bool { return r.offsets[i+1] > start }) // the row group of start
+		arr, err := r.column(rg, column)
+		if err != nil {
+			return err
+		}
+
+		n := end
+		if n > r.offsets[rg+1] {
+			n = r.offsets[rg+1]
+		}
+		data := array.NewSlice(arr, start-r.offsets[rg], n-r.offsets[rg])
+		err = fn(data)
+		data.Release()
+		arr.Release()
+		if err != nil {
+			return err
+		}
+		start = n
+	}
+	return nil
+}
+
+// column returns the column of the row group, which must be released after use
+func (r *Reader) column(rowGroup, column int) (arrow.Array, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.rowGroup != rowGroup {
+		r.releaseColumns()
+		r.rowGroup = rowGroup
+		r.columns = make(map[int]arrow.Array, len(r.schema))
+	}
+	if arr, ok := r.columns[column]; ok {
+		arr.Retain()
+		return arr, nil
+	}
+
+	tbl, err := r.fr.ReadRowGroups(context.Background(), []int{column}, []int{rowGroup})
+	if err != nil {
+		return nil, err
+	}
+	defer tbl.Release()
+
+	chunks := tbl.Column(0).Data().Chunks()
+	var arr arrow.Array
+	if len(chunks) == 1 {
+		arr = chunks[0]
+		arr.Retain()
+	} else {
+		arr, err = array.Concatenate(chunks, memory.DefaultAllocator)
+		if err != nil {
+			return nil, err
+		}
+	}
+	r.columns[column] = arr
+	arr.Retain()
+	return arr, nil
+}
+
+func (r *Reader) releaseColumns() {
+	for _, arr := range r.columns {
+		arr.Release()
+	}
+	r.columns = nil
+}
+
+// Close releases the cached columns and closes the file
+func (r *Reader) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.releaseColumns()
+	r.rowGroup = -1
+	return r.rdr.Close()
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parquet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReader(t *testing.T) {
+	schema := Schema{
+		{Name: "time", Type: Timestamp, Required: true},
+		{Name: "host", Type: String, Dictionary: true},
+		{Name: "value", Type: Double},
+		{Name: "count", Type: Uint64},
+	}
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, schema, Options{RowGroupSize: 4, Metadata: map[string]string{"k": "v"}})
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		var value interface{}
+		if i%3 != 0 {
+			value = float64(i)
+		}
+		require.NoError(t, w.WriteRow([]interface{}{int64(i), "h1", value, uint64(i)}))
+	}
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, r.Close())
+	}()
+
+	assert.Equal(t, Schema{
+		{Name: "time", Type: Timestamp, Required: true},
+		{Name: "host", Type: String},
+		{Name: "value", Type: Double},
+		{Name: "count", Type: Uint64},
+	}, r.Schema())
+	assert.Equal(t, int64(10), r.NumRows())
+	v, ok := r.Metadata("k")
+	assert.True(t, ok)
+	assert.Equal(t, "v", v)
+	_, ok = r.Metadata("none")
+	assert.False(t, ok)
+
+	// the rows span three row groups
+	var times []int64
+	var chunks int
+	require.NoError(t, r.ReadColumn(0, 2, 9, func(arr arrow.Array) error {
+		chunks++
+		for i := 0; i < arr.Len(); i++ {
+			times = append(times, int64(arr.(*array.Timestamp).Value(i)))
+		}
+		return nil
+	}))
+	assert.Equal(t, []int64{2, 3, 4, 5, 6, 7, 8}, times)
+	assert.Equal(t, 3, chunks)
+
+	// the columns of the cached row group
+	var values []interface{}
+	require.NoError(t, r.ReadColumn(2, 8, 10, func(arr arrow.Array) error {
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsNull(i) {
+				values = append(values, nil)
+				continue
+			}
+			values = append(values, arr.(*array.Float64).Value(i))
+		}
+		return nil
+	}))
+	assert.Equal(t, []interface{}{8.0, nil}, values)
+
+	var counts []uint64
+	require.NoError(t, r.ReadColumn(3, 9, 10, func(arr arrow.Array) error {
+		counts = append(counts, arr.(*array.Uint64).Value(0))
+		return nil
+	}))
+	assert.Equal(t, []uint64{9}, counts)
+
+	require.NoError(t, r.ReadColumn(1, 5, 5, func(arr arrow.Array) error {
+		t.Fatal("no rows are read")
+		return nil
+	}))
+	assert.Error(t, r.ReadColumn(4, 0, 1, func(arrow.Array) error { return nil }))
+	assert.Error(t, r.ReadColumn(0, 9, 11, func(arrow.Array) error { return nil }))
+}
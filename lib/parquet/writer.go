@@ -0,0 +1,342 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package parquet writes Parquet files with a flat schema row by row. The rows are buffered
+// by column and written as row groups by the Parquet writer of Apache Arrow. The column chunks
+// except the Uint64 ones carry the min/max statistics, so the readers can skip the row groups.
+// The Reader reads the columns of the files back by the range of rows.
+package parquet
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	pq "github.com/apache/arrow/go/v13/parquet"
+	"github.com/apache/arrow/go/v13/parquet/compress"
+	"github.com/apache/arrow/go/v13/parquet/pqarrow"
+)
+
+const (
+	createdBy           = "openGemini"
+	DefaultRowGroupSize = 64 * 1024
+)
+
+type Type int
+
+const (
+	Boolean Type = iota
+	Int64
+	Double
+	String
+	// Timestamp is stored as INT64 nanoseconds since the Unix epoch, in UTC
+	Timestamp
+	// Uint64 is stored as INT64 annotated as an unsigned integer
+	Uint64
+)
+
+func (t Type) String() string {
+	switch t {
+	case Boolean:
+		return "boolean"
+	case Int64:
+		return "int64"
+	case Double:
+		return "double"
+	case String:
+		return "string"
+	case Timestamp:
+		return "timestamp"
+	case Uint64:
+		return "uint64"
+	default:
+		return "unknown"
+	}
+}
+
+func (t Type) arrowType() arrow.DataType {
+	switch t {
+	case Boolean:
+		return arrow.FixedWidthTypes.Boolean
+	case Int64:
+		return arrow.PrimitiveTypes.Int64
+	case Double:
+		return arrow.PrimitiveTypes.Float64
+	case String:
+		return arrow.BinaryTypes.String
+	case Timestamp:
+		return &arrow.TimestampType{Unit: arrow.Nanosecond, TimeZone: "UTC"}
+	default:
+		return arrow.PrimitiveTypes.Uint64
+	}
+}
+
+type Field struct {
+	Name string
+	Type Type
+	// Required columns can not contain nulls
+	Required bool
+	// Dictionary encodes the values of the String columns by a dictionary of the distinct
+	// values, for the columns with a few distinct values such as the tags
+	Dictionary bool
+}
+
+type Schema []Field
+
+type Options struct {
+	// RowGroupSize is the max number of rows of a row group
+	RowGroupSize int
+	// Gzip compresses the data pages with gzip
+	Gzip bool
+	// Metadata is written to the key-value metadata of the file
+	Metadata map[string]string
+}
+
+// writerOnly hides the Close method of the underlying writer, which is closed by the caller
+type writerOnly struct {
+	io.Writer
+}
+
+// Writer writes the rows to a parquet file. The rows are buffered in memory by
+// column until a row group is full, so the memory used is limited by RowGroupSize.
+type Writer struct {
+	schema  Schema
+	opt     Options
+	fw      *pqarrow.FileWriter
+	builder *array.RecordBuilder
+	rows    int
+	closed  bool
+}
+
+func NewWriter(w io.Writer, schema Schema, opt Options) (*Writer, error) {
+	if len(schema) == 0 {
+		return nil, errors.New("parquet: empty schema")
+	}
+	if opt.RowGroupSize <= 0 {
+		opt.RowGroupSize = DefaultRowGroupSize
+	}
+	codec := compress.Codecs.Uncompressed
+	if opt.Gzip {
+		codec = compress.Codecs.Gzip
+	}
+	props := []pq.WriterProperty{
+		pq.WithCreatedBy(createdBy),
+		pq.WithMaxRowGroupLength(int64(opt.RowGroupSize)),
+		pq.WithCompression(codec),
+		pq.WithStats(true),
+		pq.WithDictionaryDefault(false),
+	}
+
+	names := make(map[string]struct{}, len(schema))
+	fields := make([]arrow.Field, len(schema))
+	for i, f := range schema {
+		if f.Name == "" {
+			return nil, errors.New("parquet: empty field name")
+		}
+		if _, ok := names[f.Name]; ok {
+			return nil, fmt.Errorf("parquet: duplicate field %q", f.Name)
+		}
+		if f.Type < Boolean || f.Type > Uint64 {
+			return nil, fmt.Errorf("parquet: unknown type of field %q", f.Name)
+		}
+		if f.Dictionary {
+			if f.Type != String {
+				return nil, fmt.Errorf("parquet: dictionary encoding of %s field %q", f.Type, f.Name)
+			}
+			props = append(props, pq.WithDictionaryFor(f.Name, true))
+		}
+		if f.Type == Uint64 {
+			// the min/max of the nullable unsigned columns are compared as the signed values by
+			// the writer of Apache Arrow v13, no statistics is better than the wrong statistics
+			props = append(props, pq.WithStatsFor(f.Name, false))
+		}
+		names[f.Name] = struct{}{}
+		fields[i] = arrow.Field{Name: f.Name, Type: f.Type.arrowType(), Nullable: !f.Required}
+	}
+
+	var md *arrow.Metadata
+	if len(opt.Metadata) > 0 {
+		keys := make([]string, 0, len(opt.Metadata))
+		for k := range opt.Metadata {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		values := make([]string, len(keys))
+		for i, k := range keys {
+			values[i] = opt.Metadata[k]
+		}
+		m := arrow.NewMetadata(keys, values)
+		md = &m
+	}
+	arrowSchema := arrow.NewSchema(fields, md)
+	fw, err := pqarrow.NewFileWriter(arrowSchema, writerOnly{w}, pq.NewWriterProperties(props...), pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, err
+	}
+	return &Writer{
+		schema:  schema,
+		opt:     opt,
+		fw:      fw,
+		builder: array.NewRecordBuilder(memory.DefaultAllocator, arrowSchema),
+	}, nil
+}
+
+// WriteRow appends a row, the values are in the order of the schema and nil is null.
+// Values of Int64 can be any signed integer, values of Timestamp can be int64 or time.Time.
+func (w *Writer) WriteRow(values []interface{}) error {
+	if w.closed {
+		return errors.New("parquet: write to closed writer")
+	}
+	if len(values) != len(w.schema) {
+		return fmt.Errorf("parquet: got %d values, expected %d", len(values), len(w.schema))
+	}
+	// validate the row first, so a bad row does not leave the columns with different lengths
+	for i, v := range values {
+		if err := w.check(&w.schema[i], v); err != nil {
+			return err
+		}
+	}
+	for i, v := range values {
+		appendValue(w.builder.Field(i), w.schema[i].Type, v)
+	}
+	w.rows++
+	if w.rows >= w.opt.RowGroupSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+func (w *Writer) check(f *Field, v interface{}) error {
+	if v == nil {
+		if f.Required {
+			return fmt.Errorf("parquet: null value of required field %q", f.Name)
+		}
+		return nil
+	}
+	var ok bool
+	switch f.Type {
+	case Boolean:
+		_, ok = v.(bool)
+	case Int64:
+		_, ok = toInt64(v)
+	case Uint64:
+		_, ok = v.(uint64)
+	case Double:
+		switch v.(type) {
+		case float64, float32:
+			ok = true
+		}
+	case String:
+		switch v.(type) {
+		case string, []byte:
+			ok = true
+		}
+	case Timestamp:
+		if _, ok = v.(time.Time); !ok {
+			_, ok = v.(int64)
+		}
+	}
+	if !ok {
+		return fmt.Errorf("parquet: invalid value %v (%T) of %s field %q", v, v, f.Type, f.Name)
+	}
+	return nil
+}
+
+func toInt64(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case int:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int16:
+		return int64(n), true
+	case int8:
+		return int64(n), true
+	}
+	return 0, false
+}
+
+// appendValue appends the value checked by Writer.check to the builder of the column
+func appendValue(b array.Builder, typ Type, v interface{}) {
+	if v == nil {
+		b.AppendNull()
+		return
+	}
+	switch typ {
+	case Boolean:
+		b.(*array.BooleanBuilder).Append(v.(bool))
+	case Int64:
+		n, _ := toInt64(v)
+		b.(*array.Int64Builder).Append(n)
+	case Uint64:
+		b.(*array.Uint64Builder).Append(v.(uint64))
+	case Double:
+		if f, ok := v.(float32); ok {
+			b.(*array.Float64Builder).Append(float64(f))
+		} else {
+			b.(*array.Float64Builder).Append(v.(float64))
+		}
+	case String:
+		if s, ok := v.(string); ok {
+			b.(*array.StringBuilder).Append(s)
+		} else {
+			b.(*array.StringBuilder).BinaryBuilder.Append(v.([]byte))
+		}
+	case Timestamp:
+		n, ok := v.(int64)
+		if !ok {
+			n = v.(time.Time).UnixNano()
+		}
+		b.(*array.TimestampBuilder).Append(arrow.Timestamp(n))
+	}
+}
+
+// Buffered returns the number of the rows not flushed
+func (w *Writer) Buffered() int {
+	return w.rows
+}
+
+// Flush writes the buffered rows as a row group
+func (w *Writer) Flush() error {
+	if w.rows == 0 {
+		return nil
+	}
+	rec := w.builder.NewRecord()
+	defer rec.Release()
+	w.rows = 0
+	return w.fw.Write(rec)
+}
+
+// Close flushes the buffered rows and writes the footer, the underlying writer is not closed
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	err := w.Flush()
+	w.closed = true
+	w.builder.Release()
+	if e := w.fw.Close(); err == nil {
+		err = e
+	}
+	return err
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parquet
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	pq "github.com/apache/arrow/go/v13/parquet"
+	"github.com/apache/arrow/go/v13/parquet/compress"
+	"github.com/apache/arrow/go/v13/parquet/file"
+	"github.com/apache/arrow/go/v13/parquet/metadata"
+	"github.com/apache/arrow/go/v13/parquet/pqarrow"
+	"github.com/apache/arrow/go/v13/parquet/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openFile(t *testing.T, data []byte) *file.Reader {
+	rdr, err := file.NewParquetReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = rdr.Close() })
+	return rdr
+}
+
+// readTable reads the file back by the reader of Apache Arrow
+func readTable(t *testing.T, rdr *file.Reader) arrow.Table {
+	fr, err := pqarrow.NewFileReader(rdr, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	table, err := fr.ReadTable(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(table.Release)
+	return table
+}
+
+// column returns the values of a column as a slice, nil is null
+func column(t *testing.T, table arrow.Table, i int) []interface{} {
+	var values []interface{}
+	for _, chunk := range table.Column(i).Data().Chunks() {
+		for j := 0; j < chunk.Len(); j++ {
+			if chunk.IsNull(j) {
+				values = append(values, nil)
+				continue
+			}
+			switch a := chunk.(type) {
+			case *array.Boolean:
+				values = append(values, a.Value(j))
+			case *array.Int64:
+				values = append(values, a.Value(j))
+			case *array.Uint64:
+				values = append(values, a.Value(j))
+			case *array.Float64:
+				values = append(values, a.Value(j))
+			case *array.String:
+				values = append(values, a.Value(j))
+			case *array.Timestamp:
+				values = append(values, int64(a.Value(j)))
+			default:
+				t.Fatalf("unexpected array %T", chunk)
+			}
+		}
+	}
+	return values
+}
+
+func chunkStats(t *testing.T, rdr *file.Reader, rowGroup, col int) (*metadata.ColumnChunkMetaData, metadata.TypedStatistics) {
+	rg := rdr.MetaData().RowGroup(rowGroup)
+	cc, err := rg.ColumnChunk(col)
+	require.NoError(t, err)
+	stats, err := cc.Statistics()
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+	return cc, stats
+}
+
+func TestWriter(t *testing.T) {
+	for _, gz := range []bool{false, true} {
+		var buf bytes.Buffer
+		fields := Schema{
+			{Name: "time", Type: Timestamp, Required: true},
+			{Name: "host", Type: String},
+			{Name: "value", Type: Double},
+			{Name: "count", Type: Int64},
+			{Name: "ok", Type: Boolean},
+		}
+		w, err := NewWriter(&buf, fields, Options{RowGroupSize: 2, Gzip: gz})
+		require.NoError(t, err)
+		ts := time.Unix(0, 1629129600000000000)
+		require.NoError(t, w.WriteRow([]interface{}{ts, "h1", 1.5, int64(1), true}))
+		require.NoError(t, w.WriteRow([]interface{}{ts.UnixNano() + 1, nil, nil, 2, false}))
+		require.NoError(t, w.WriteRow([]interface{}{ts.UnixNano() + 2, []byte("h2"), float32(2.5), nil, nil}))
+		assert.Error(t, w.WriteRow([]interface{}{nil, "h3", 1.0, 1, true}))
+		assert.Error(t, w.WriteRow([]interface{}{ts, "h3", "1", 1, true}))
+		assert.Error(t, w.WriteRow([]interface{}{ts}))
+		require.NoError(t, w.Close())
+		require.NoError(t, w.Close())
+		assert.Error(t, w.WriteRow([]interface{}{ts, "h3", 1.0, 1, true}))
+
+		rdr := openFile(t, buf.Bytes())
+		md := rdr.MetaData()
+		assert.Equal(t, int64(3), md.NumRows)
+		assert.Contains(t, md.GetCreatedBy(), createdBy)
+		require.Equal(t, 2, rdr.NumRowGroups())
+		assert.Equal(t, int64(2), md.RowGroup(0).NumRows())
+		assert.Equal(t, int64(1), md.RowGroup(1).NumRows())
+
+		// timestamp(NANOS, isAdjustedToUTC)
+		require.Equal(t, len(fields), md.Schema.NumColumns())
+		tsType, ok := md.Schema.Column(0).LogicalType().(*schema.TimestampLogicalType)
+		require.True(t, ok)
+		assert.True(t, tsType.IsAdjustedToUTC())
+		assert.Equal(t, schema.TimeUnitNanos, tsType.TimeUnit())
+		assert.Equal(t, pq.Repetitions.Required, md.Schema.Column(0).SchemaNode().RepetitionType())
+		for i, f := range fields {
+			assert.Equal(t, f.Name, md.Schema.Column(i).Name())
+		}
+
+		codec := compress.Codecs.Uncompressed
+		if gz {
+			codec = compress.Codecs.Gzip
+		}
+		cc, _ := chunkStats(t, rdr, 0, 0)
+		assert.Equal(t, codec, cc.Compression())
+
+		table := readTable(t, rdr)
+		assert.Equal(t, int64(3), table.NumRows())
+		assert.Equal(t, []interface{}{ts.UnixNano(), ts.UnixNano() + 1, ts.UnixNano() + 2}, column(t, table, 0))
+		assert.Equal(t, []interface{}{"h1", nil, "h2"}, column(t, table, 1))
+		assert.Equal(t, []interface{}{1.5, nil, 2.5}, column(t, table, 2))
+		assert.Equal(t, []interface{}{int64(1), int64(2), nil}, column(t, table, 3))
+		assert.Equal(t, []interface{}{true, false, nil}, column(t, table, 4))
+	}
+}
+
+func TestWriter_StatisticsAndDictionary(t *testing.T) {
+	var buf bytes.Buffer
+	fields := Schema{
+		{Name: "time", Type: Timestamp, Required: true},
+		{Name: "host", Type: String, Dictionary: true},
+		{Name: "value", Type: Double},
+		{Name: "region", Type: String},
+	}
+	w, err := NewWriter(&buf, fields, Options{Gzip: true})
+	require.NoError(t, err)
+	require.NoError(t, w.WriteRow([]interface{}{int64(1), "b", 2.0, "r1"}))
+	require.NoError(t, w.WriteRow([]interface{}{int64(2), "a", math.NaN(), "r2"}))
+	require.NoError(t, w.WriteRow([]interface{}{int64(3), "b", nil, "r3"}))
+	require.NoError(t, w.WriteRow([]interface{}{int64(4), nil, -1.5, "r3"}))
+	assert.Equal(t, 4, w.Buffered())
+	require.NoError(t, w.Flush())
+	assert.Equal(t, 0, w.Buffered())
+	require.NoError(t, w.Close())
+
+	rdr := openFile(t, buf.Bytes())
+	require.Equal(t, 1, rdr.NumRowGroups())
+
+	_, stats := chunkStats(t, rdr, 0, 0)
+	assert.Equal(t, int64(0), stats.NullCount())
+	assert.Equal(t, int64(1), stats.(*metadata.Int64Statistics).Min())
+	assert.Equal(t, int64(4), stats.(*metadata.Int64Statistics).Max())
+
+	host, stats := chunkStats(t, rdr, 0, 1)
+	assert.Equal(t, int64(1), stats.NullCount())
+	assert.Equal(t, "a", string(stats.(*metadata.ByteArrayStatistics).Min()))
+	assert.Equal(t, "b", string(stats.(*metadata.ByteArrayStatistics).Max()))
+	// only the columns marked by Dictionary are dictionary encoded
+	assert.True(t, host.HasDictionaryPage())
+	assert.Contains(t, host.Encodings(), pq.Encodings.RLEDict)
+
+	// NaN is not in the statistics
+	_, stats = chunkStats(t, rdr, 0, 2)
+	assert.Equal(t, int64(1), stats.NullCount())
+	assert.Equal(t, -1.5, stats.(*metadata.Float64Statistics).Min())
+	assert.Equal(t, 2.0, stats.(*metadata.Float64Statistics).Max())
+
+	region, _ := chunkStats(t, rdr, 0, 3)
+	assert.False(t, region.HasDictionaryPage())
+	assert.NotContains(t, region.Encodings(), pq.Encodings.RLEDict)
+
+	table := readTable(t, rdr)
+	assert.Equal(t, []interface{}{"b", "a", "b", nil}, column(t, table, 1))
+	values := column(t, table, 2)
+	assert.True(t, math.IsNaN(values[1].(float64)))
+	assert.Equal(t, []interface{}{"r1", "r2", "r3", "r3"}, column(t, table, 3))
+
+	// all values are null
+	buf.Reset()
+	w, err = NewWriter(&buf, Schema{{Name: "host", Type: String, Dictionary: true}}, Options{})
+	require.NoError(t, err)
+	require.NoError(t, w.WriteRow([]interface{}{nil}))
+	require.NoError(t, w.Close())
+	rdr = openFile(t, buf.Bytes())
+	_, stats = chunkStats(t, rdr, 0, 0)
+	assert.Equal(t, int64(1), stats.NullCount())
+	assert.False(t, stats.HasMinMax())
+	assert.Equal(t, []interface{}{nil}, column(t, readTable(t, rdr), 0))
+}
+
+func TestNewWriterError(t *testing.T) {
+	_, err := NewWriter(io.Discard, nil, Options{})
+	assert.Error(t, err)
+	_, err = NewWriter(io.Discard, Schema{{Name: "a"}, {Name: "a"}}, Options{})
+	assert.Error(t, err)
+	_, err = NewWriter(io.Discard, Schema{{Name: ""}}, Options{})
+	assert.Error(t, err)
+	_, err = NewWriter(io.Discard, Schema{{Name: "a", Type: Type(100)}}, Options{})
+	assert.Error(t, err)
+	_, err = NewWriter(io.Discard, Schema{{Name: "a", Type: Int64, Dictionary: true}}, Options{})
+	assert.Error(t, err)
+}
+
+func TestWriter_Uint64(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, Schema{{Name: "value", Type: Uint64}}, Options{})
+	require.NoError(t, err)
+	require.NoError(t, w.WriteRow([]interface{}{uint64(math.MaxUint64)}))
+	require.NoError(t, w.WriteRow([]interface{}{uint64(1)}))
+	require.Error(t, w.WriteRow([]interface{}{int64(1)}))
+	require.NoError(t, w.Close())
+
+	rdr := openFile(t, buf.Bytes())
+	col := rdr.MetaData().Schema.Column(0)
+	assert.Equal(t, pq.Types.Int64, col.PhysicalType())
+	intType, ok := col.LogicalType().(*schema.IntLogicalType)
+	require.True(t, ok)
+	assert.Equal(t, int8(64), intType.BitWidth())
+	assert.False(t, intType.IsSigned())
+
+	// no statistics of the unsigned columns
+	cc, err := rdr.MetaData().RowGroup(0).ColumnChunk(0)
+	require.NoError(t, err)
+	ok, err = cc.StatsSet()
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.Equal(t, []interface{}{uint64(math.MaxUint64), uint64(1)}, column(t, readTable(t, rdr), 0))
+}
@@ -0,0 +1,416 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package quota enforces the limits set by SET QUOTA on users and databases.
+//
+// The quotas are enforced by every ts-sql node on its own, the usage is not shared between the nodes:
+// each node allows the full rate and the full number of concurrent queries, so N ts-sql nodes behind a
+// load balancer admit up to N times the limits in total. max_series is enforced by every shard of the store nodes.
+package quota
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// names of the limits, as used by SET QUOTA and SHOW QUOTAS
+const (
+	PointsPerSecond      = "points_per_second"
+	BytesPerSecond       = "bytes_per_second"
+	MaxConcurrentQueries = "max_concurrent_queries"
+	MaxSeries            = "max_series"
+	MaxQueryResultBytes  = "max_query_result_bytes"
+)
+
+var Names = []string{PointsPerSecond, BytesPerSecond, MaxConcurrentQueries, MaxSeries, MaxQueryResultBytes}
+
+// Set assigns the limit called name in q.
+func Set(q *meta2.QuotaInfo, name string, value int64) error {
+	if value < 0 {
+		return fmt.Errorf("invalid value %d for quota %s", value, name)
+	}
+	switch name {
+	case PointsPerSecond:
+		q.PointsPerSecond = value
+	case BytesPerSecond:
+		q.BytesPerSecond = value
+	case MaxConcurrentQueries:
+		q.MaxConcurrentQueries = value
+	case MaxSeries:
+		q.MaxSeries = value
+	case MaxQueryResultBytes:
+		q.MaxQueryResultBytes = value
+	default:
+		return fmt.Errorf("unknown quota %s", name)
+	}
+	return nil
+}
+
+// Get returns the limit called name in q, 0 if unlimited.
+func Get(q *meta2.QuotaInfo, name string) int64 {
+	if q == nil {
+		return 0
+	}
+	switch name {
+	case PointsPerSecond:
+		return q.PointsPerSecond
+	case BytesPerSecond:
+		return q.BytesPerSecond
+	case MaxConcurrentQueries:
+		return q.MaxConcurrentQueries
+	case MaxSeries:
+		return q.MaxSeries
+	case MaxQueryResultBytes:
+		return q.MaxQueryResultBytes
+	default:
+		return 0
+	}
+}
+
+type Kind uint8
+
+const (
+	User Kind = iota
+	Database
+)
+
+func (k Kind) String() string {
+	if k == User {
+		return "user"
+	}
+	return "database"
+}
+
+// Source looks up the quotas stored in meta, it is implemented by *metaclient.Client.
+type Source interface {
+	UserQuota(name string) *meta2.QuotaInfo
+	DatabaseQuota(name string) *meta2.QuotaInfo
+}
+
+// the usage of a user or a database is dropped once it has not been used for usageIdleTimeout,
+// the idle usages are looked for at most once every usageSweepInterval
+const (
+	usageIdleTimeout   = 10 * time.Minute
+	usageSweepInterval = time.Minute
+)
+
+// Manager enforces the quotas of users and databases on this node, see the package comment for the
+// limits of enforcing them per node. All methods of a nil *Manager are no-ops.
+type Manager struct {
+	source Source
+
+	mu        sync.Mutex
+	usages    [2]map[string]*usage
+	lastSweep time.Time
+}
+
+func NewManager(source Source) *Manager {
+	return &Manager{
+		source: source,
+		usages: [2]map[string]*usage{{}, {}},
+	}
+}
+
+// Usage is the current consumption of a user or a database.
+type Usage struct {
+	PointsPerSecond   int64
+	BytesPerSecond    int64
+	ConcurrentQueries int64
+	QueryResultBytes  int64
+}
+
+// Get returns the usage of the limit called name, -1 if it is not tracked on this node.
+func (u Usage) Get(name string) int64 {
+	switch name {
+	case PointsPerSecond:
+		return u.PointsPerSecond
+	case BytesPerSecond:
+		return u.BytesPerSecond
+	case MaxConcurrentQueries:
+		return u.ConcurrentQueries
+	case MaxQueryResultBytes:
+		return u.QueryResultBytes
+	default:
+		return -1
+	}
+}
+
+func (m *Manager) quota(kind Kind, name string) *meta2.QuotaInfo {
+	if kind == User {
+		return m.source.UserQuota(name)
+	}
+	return m.source.DatabaseQuota(name)
+}
+
+func (m *Manager) usage(kind Kind, name string) *usage {
+	now := time.Now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if now.Sub(m.lastSweep) >= usageSweepInterval {
+		m.sweep(now)
+	}
+	u, ok := m.usages[kind][name]
+	if !ok {
+		u = &usage{}
+		m.usages[kind][name] = u
+	}
+	u.lastUsed = now
+	return u
+}
+
+// sweep drops the usages which are idle, so the users and databases which are no longer
+// written or queried do not stay in memory. m.mu must be held.
+func (m *Manager) sweep(now time.Time) {
+	m.lastSweep = now
+	for kind := range m.usages {
+		for name, u := range m.usages[kind] {
+			if u.idle(now) {
+				delete(m.usages[kind], name)
+			}
+		}
+	}
+}
+
+// Usage returns the current usage of the user or the database.
+func (m *Manager) Usage(kind Kind, name string) Usage {
+	if m == nil {
+		return Usage{}
+	}
+	m.mu.Lock()
+	u, ok := m.usages[kind][name]
+	m.mu.Unlock()
+	if !ok {
+		return Usage{}
+	}
+	now := time.Now()
+	return Usage{
+		PointsPerSecond:   u.points.rate(now),
+		BytesPerSecond:    u.bytes.rate(now),
+		ConcurrentQueries: atomic.LoadInt64(&u.queries),
+		QueryResultBytes:  atomic.LoadInt64(&u.resultBytes),
+	}
+}
+
+// writeBucket is a rate quota consumed by a write
+type writeBucket struct {
+	counter *rateCounter
+	limit   int64
+	n       int64
+	quota   string
+	kind    Kind
+	name    string
+}
+
+// AllowWrite consumes points and bytes from the write quotas of the user and the database.
+// Nothing is consumed unless all the quotas allow the write.
+func (m *Manager) AllowWrite(user, database string, points, bytes int64) error {
+	if m == nil {
+		return nil
+	}
+	buckets := make([]writeBucket, 0, 4)
+	for kind, name := range [2]string{user, database} {
+		if name == "" {
+			continue
+		}
+		q := m.quota(Kind(kind), name)
+		u := m.usage(Kind(kind), name)
+		if points > 0 {
+			buckets = append(buckets, writeBucket{&u.points, q.GetPointsPerSecond(), points, PointsPerSecond, Kind(kind), name})
+		}
+		if bytes > 0 {
+			buckets = append(buckets, writeBucket{&u.bytes, q.GetBytesPerSecond(), bytes, BytesPerSecond, Kind(kind), name})
+		}
+	}
+
+	// the counters are always locked in the same order: user before database, points before bytes
+	for i := range buckets {
+		buckets[i].counter.mu.Lock()
+		defer buckets[i].counter.mu.Unlock()
+	}
+	now := time.Now()
+	for _, b := range buckets {
+		if !b.counter.allowed(now, b.limit, b.n) {
+			return errno.NewError(errno.WriteQuotaExceeded, b.quota, b.kind, b.name, b.limit)
+		}
+	}
+	for _, b := range buckets {
+		b.counter.take(now, b.n)
+	}
+	return nil
+}
+
+// BeginQuery takes a query slot of the user and the database, the returned Query must be released with Done.
+func (m *Manager) BeginQuery(user, database string) (*Query, error) {
+	if m == nil {
+		return nil, nil
+	}
+	query := &Query{}
+	for kind, name := range [2]string{user, database} {
+		if name == "" {
+			continue
+		}
+		q := m.quota(Kind(kind), name)
+		u := m.usage(Kind(kind), name)
+		n := atomic.AddInt64(&u.queries, 1)
+		if limit := q.GetMaxConcurrentQueries(); limit > 0 && n > limit {
+			atomic.AddInt64(&u.queries, -1)
+			query.Done()
+			return nil, errno.NewError(errno.QueryQuotaExceeded, MaxConcurrentQueries, Kind(kind), name, limit)
+		}
+		query.owners = append(query.owners, owner{kind: Kind(kind), name: name, usage: u, resultLimit: q.GetMaxQueryResultBytes()})
+	}
+	return query, nil
+}
+
+type owner struct {
+	kind        Kind
+	name        string
+	usage       *usage
+	resultLimit int64
+}
+
+// Query tracks the resources held by a running query.
+// All methods of a nil *Query are no-ops.
+type Query struct {
+	owners      []owner
+	resultBytes int64
+	done        bool
+}
+
+// Grow accounts n more bytes of the result buffered for the query, it fails if the result exceeds
+// the max_query_result_bytes quota. The size is estimated from the values of the result rows, it does not
+// cover the memory used to execute the query.
+func (q *Query) Grow(n int64) error {
+	if q == nil || q.done {
+		return nil
+	}
+	q.resultBytes += n
+	for i := range q.owners {
+		atomic.AddInt64(&q.owners[i].usage.resultBytes, n)
+	}
+	for _, o := range q.owners {
+		if o.resultLimit > 0 && q.resultBytes > o.resultLimit {
+			return errno.NewError(errno.QueryQuotaExceeded, MaxQueryResultBytes, o.kind, o.name, o.resultLimit)
+		}
+	}
+	return nil
+}
+
+// Done releases the query slot and the result bytes of the query.
+func (q *Query) Done() {
+	if q == nil || q.done {
+		return
+	}
+	q.done = true
+	for i := range q.owners {
+		atomic.AddInt64(&q.owners[i].usage.queries, -1)
+		atomic.AddInt64(&q.owners[i].usage.resultBytes, -q.resultBytes)
+	}
+}
+
+type usage struct {
+	points      rateCounter
+	bytes       rateCounter
+	queries     int64
+	resultBytes int64
+	// lastUsed is protected by Manager.mu
+	lastUsed time.Time
+}
+
+// idle reports whether the usage can be dropped without losing a running query or an unpaid write debt.
+func (u *usage) idle(now time.Time) bool {
+	return now.Sub(u.lastUsed) >= usageIdleTimeout && atomic.LoadInt64(&u.queries) == 0 &&
+		u.points.repaid(now) && u.bytes.repaid(now)
+}
+
+// rateCounter limits a per-second rate with a token bucket and measures the rate of the last second.
+// The bucket holds at most limit tokens, a batch larger than limit is allowed once the bucket is full
+// and leaves the bucket in debt, so the later batches wait until the debt is repaid.
+type rateCounter struct {
+	mu      sync.Mutex
+	limit   int64
+	tokens  float64
+	updated time.Time
+
+	second int64
+	count  int64
+	last   int64
+}
+
+// allowed refills the bucket up to now and reports whether n can be taken, c.mu must be held.
+func (c *rateCounter) allowed(now time.Time, limit, n int64) bool {
+	if limit <= 0 {
+		c.limit = 0
+		return true
+	}
+	if c.limit != limit {
+		c.limit, c.tokens, c.updated = limit, float64(limit), now
+	}
+	if elapsed := now.Sub(c.updated); elapsed > 0 {
+		c.tokens = math.Min(float64(limit), c.tokens+elapsed.Seconds()*float64(limit))
+		c.updated = now
+	}
+	need := n
+	if need > limit {
+		need = limit
+	}
+	return c.tokens >= float64(need)
+}
+
+// take consumes n after allowed has returned true, c.mu must be held.
+func (c *rateCounter) take(now time.Time, n int64) {
+	if c.limit > 0 {
+		c.tokens -= float64(n)
+	}
+	c.roll(now.Unix())
+	c.count += n
+}
+
+// repaid reports whether the bucket is full again at now, a new counter starts with a full bucket.
+func (c *rateCounter) repaid(now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.limit <= 0 {
+		return true
+	}
+	return c.tokens+now.Sub(c.updated).Seconds()*float64(c.limit) >= float64(c.limit)
+}
+
+func (c *rateCounter) roll(second int64) {
+	if second == c.second {
+		return
+	}
+	if second == c.second+1 {
+		c.last = c.count
+	} else {
+		c.last = 0
+	}
+	c.second, c.count = second, 0
+}
+
+// rate returns the amount consumed during the last complete second.
+func (c *rateCounter) rate(now time.Time) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.roll(now.Unix())
+	return c.last
+}
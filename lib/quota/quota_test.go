@@ -0,0 +1,154 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/quota"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSource struct {
+	users     map[string]*meta2.QuotaInfo
+	databases map[string]*meta2.QuotaInfo
+}
+
+func (s *mockSource) UserQuota(name string) *meta2.QuotaInfo {
+	return s.users[name]
+}
+
+func (s *mockSource) DatabaseQuota(name string) *meta2.QuotaInfo {
+	return s.databases[name]
+}
+
+func TestSetQuota(t *testing.T) {
+	q := &meta2.QuotaInfo{}
+	for i, name := range quota.Names {
+		require.NoError(t, quota.Set(q, name, int64(i+1)))
+		require.Equal(t, int64(i+1), quota.Get(q, name))
+	}
+	require.Error(t, quota.Set(q, "unknown", 1))
+	require.Error(t, quota.Set(q, quota.MaxSeries, -1))
+	require.Equal(t, int64(0), quota.Get(nil, quota.MaxSeries))
+}
+
+func TestManager_AllowWrite(t *testing.T) {
+	m := quota.NewManager(&mockSource{
+		users:     map[string]*meta2.QuotaInfo{"user1": {PointsPerSecond: 100}},
+		databases: map[string]*meta2.QuotaInfo{"db0": {BytesPerSecond: 1000}},
+	})
+
+	require.NoError(t, m.AllowWrite("user1", "", 60, 10))
+	err := m.AllowWrite("user1", "", 60, 10)
+	require.True(t, errno.Equal(err, errno.WriteQuotaExceeded))
+
+	require.NoError(t, m.AllowWrite("", "db0", 0, 800))
+	err = m.AllowWrite("", "db0", 0, 800)
+	require.True(t, errno.Equal(err, errno.WriteQuotaExceeded))
+
+	// a batch larger than the limit is allowed when nothing has been written recently
+	m2 := quota.NewManager(&mockSource{users: map[string]*meta2.QuotaInfo{"user1": {PointsPerSecond: 100}}})
+	require.NoError(t, m2.AllowWrite("user1", "", 250, 0))
+	err = m2.AllowWrite("user1", "", 1, 0)
+	require.True(t, errno.Equal(err, errno.WriteQuotaExceeded))
+
+	// unlimited
+	require.NoError(t, m.AllowWrite("user2", "db1", 1e9, 1e9))
+
+	var nilManager *quota.Manager
+	require.NoError(t, nilManager.AllowWrite("user1", "db0", 1e9, 1e9))
+	require.Equal(t, quota.Usage{}, nilManager.Usage(quota.User, "user1"))
+}
+
+func TestManager_AllowWrite_NoPartialConsume(t *testing.T) {
+	m := quota.NewManager(&mockSource{
+		users:     map[string]*meta2.QuotaInfo{"user1": {PointsPerSecond: 100}},
+		databases: map[string]*meta2.QuotaInfo{"db0": {PointsPerSecond: 1000, BytesPerSecond: 1000}},
+	})
+	require.NoError(t, m.AllowWrite("", "db0", 10, 1000))
+
+	// the bytes quota of the database rejects the write, the points of the user and the database are kept
+	err := m.AllowWrite("user1", "db0", 100, 100)
+	require.True(t, errno.Equal(err, errno.WriteQuotaExceeded))
+	require.NoError(t, m.AllowWrite("user1", "", 100, 0))
+	require.NoError(t, m.AllowWrite("", "db0", 990, 0))
+}
+
+func TestManager_Usage(t *testing.T) {
+	m := quota.NewManager(&mockSource{})
+	require.Equal(t, quota.Usage{}, m.Usage(quota.User, "user1"))
+
+	// write at the beginning of a second, the rate is reported once the second is complete
+	second := time.Now().Unix()
+	for time.Now().Unix() == second {
+		time.Sleep(time.Millisecond)
+	}
+	require.NoError(t, m.AllowWrite("user1", "", 10, 100))
+	require.Equal(t, int64(0), m.Usage(quota.User, "user1").PointsPerSecond)
+
+	for time.Now().Unix() == second+1 {
+		time.Sleep(time.Millisecond)
+	}
+	u := m.Usage(quota.User, "user1")
+	require.Equal(t, int64(10), u.PointsPerSecond)
+	require.Equal(t, int64(100), u.BytesPerSecond)
+	require.Equal(t, int64(10), u.Get(quota.PointsPerSecond))
+	require.Equal(t, int64(-1), u.Get(quota.MaxSeries))
+}
+
+func TestManager_Query(t *testing.T) {
+	m := quota.NewManager(&mockSource{
+		users:     map[string]*meta2.QuotaInfo{"user1": {MaxConcurrentQueries: 1}},
+		databases: map[string]*meta2.QuotaInfo{"db0": {MaxConcurrentQueries: 2, MaxQueryResultBytes: 100}},
+	})
+
+	q1, err := m.BeginQuery("user1", "db0")
+	require.NoError(t, err)
+	_, err = m.BeginQuery("user1", "db0")
+	require.True(t, errno.Equal(err, errno.QueryQuotaExceeded))
+
+	q2, err := m.BeginQuery("user2", "db0")
+	require.NoError(t, err)
+	_, err = m.BeginQuery("user3", "db0")
+	require.True(t, errno.Equal(err, errno.QueryQuotaExceeded))
+	require.Equal(t, int64(2), m.Usage(quota.Database, "db0").ConcurrentQueries)
+	require.Equal(t, int64(1), m.Usage(quota.User, "user1").ConcurrentQueries)
+
+	require.NoError(t, q1.Grow(60))
+	require.NoError(t, q2.Grow(60))
+	require.Equal(t, int64(120), m.Usage(quota.Database, "db0").QueryResultBytes)
+	err = q1.Grow(60)
+	require.True(t, errno.Equal(err, errno.QueryQuotaExceeded))
+
+	q1.Done()
+	q1.Done()
+	q2.Done()
+	require.Equal(t, quota.Usage{}, m.Usage(quota.Database, "db0"))
+	require.Equal(t, quota.Usage{}, m.Usage(quota.User, "user1"))
+
+	q3, err := m.BeginQuery("user1", "db0")
+	require.NoError(t, err)
+	q3.Done()
+
+	var nilQuery *quota.Query
+	require.NoError(t, nilQuery.Grow(1e9))
+	nilQuery.Done()
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
+)
+
+type dbSource map[string]*meta2.QuotaInfo
+
+func (s dbSource) UserQuota(name string) *meta2.QuotaInfo {
+	return nil
+}
+
+func (s dbSource) DatabaseQuota(name string) *meta2.QuotaInfo {
+	return s[name]
+}
+
+func TestManager_SweepIdleUsage(t *testing.T) {
+	m := NewManager(dbSource{"db1": {PointsPerSecond: 10}})
+	require.NoError(t, m.AllowWrite("", "db0", 100, 100))
+	require.NoError(t, m.AllowWrite("", "db1", 10000, 0))
+	q, err := m.BeginQuery("", "db2")
+	require.NoError(t, err)
+	require.Len(t, m.usages[Database], 3)
+
+	now := time.Now()
+	m.sweep(now.Add(usageIdleTimeout / 2))
+	require.Len(t, m.usages[Database], 3)
+
+	// db1 is still in debt and db2 runs a query
+	m.sweep(now.Add(usageIdleTimeout))
+	require.Len(t, m.usages[Database], 2)
+	require.NotContains(t, m.usages[Database], "db0")
+
+	q.Done()
+	m.sweep(now.Add(1001 * time.Second))
+	require.Len(t, m.usages[Database], 0)
+}
@@ -205,6 +205,27 @@ func TestMinValue(t *testing.T) {
 	}
 }
 
+func TestUnsignedValue(t *testing.T) {
+	cv := &record.ColVal{}
+	cv.AppendUnsigneds(1<<63, 3)
+	cv.AppendUnsignedNull()
+	cv.AppendUnsigned(1<<64 - 1)
+
+	values := cv.UnsignedValues()
+	require.Equal(t, []uint64{1 << 63, 3, 1<<64 - 1}, values)
+
+	v, idx := cv.MaxUnsignedValue(values, 0, cv.Len)
+	require.Equal(t, uint64(1<<64-1), v)
+	require.Equal(t, 3, idx)
+
+	v, idx = cv.MinUnsignedValue(values, 0, cv.Len)
+	require.Equal(t, uint64(3), v)
+	require.Equal(t, 1, idx)
+
+	_, isNil := cv.UnsignedValue(2)
+	require.True(t, isNil)
+}
+
 func TestFirstValue(t *testing.T) {
 	schema := record.Schemas{
 		record.Field{Type: influx.Field_Type_Int, Name: "int"},
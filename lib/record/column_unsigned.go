@@ -0,0 +1,89 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"math/bits"
+
+	"github.com/openGemini/openGemini/lib/errno"
+)
+
+// The unsigned values have the same layout as the integer values, the columns of
+// Field_Type_UInt are copied, merged and encoded as the integer columns, only the
+// comparison and the conversion of the values differ.
+
+func (cv *ColVal) AppendUnsigneds(values ...uint64) {
+	appendValues(cv, values...)
+}
+
+func (cv *ColVal) AppendUnsigned(v uint64) {
+	appendValue(cv, v)
+}
+
+func (cv *ColVal) AppendUnsignedNulls(count int) {
+	appendNulls(cv, count)
+}
+
+func (cv *ColVal) AppendUnsignedNull() {
+	appendNull(cv)
+}
+
+func (cv *ColVal) UnsignedValues() []uint64 {
+	return values[uint64](cv)
+}
+
+func (cv *ColVal) SubUnsignedValues(start, end int) []uint64 {
+	return subValues[uint64](cv, start, end)
+}
+
+func (cv *ColVal) UnsignedValue(i int) (uint64, bool) {
+	return value(cv, cv.UnsignedValues(), i)
+}
+
+func (cv *ColVal) MaxUnsignedValue(values []uint64, start, end int) (uint64, int) {
+	return maxValue(values, start, end, cv)
+}
+
+func (cv *ColVal) MinUnsignedValue(values []uint64, start, end int) (uint64, int) {
+	return minValue(values, start, end, cv)
+}
+
+func (cv *ColVal) MaxUnsignedValues(values []uint64, start, end int) (uint64, []int) {
+	return maxValues(values, start, end, cv)
+}
+
+func (cv *ColVal) MinUnsignedValues(values []uint64, start, end int) (uint64, []int) {
+	return minValues(values, start, end, cv)
+}
+
+func (cv *ColVal) UnsignedValueWithNullReserve(index int) (uint64, bool) {
+	return cv.UnsignedValues()[index], cv.IsNil(index)
+}
+
+func (cv *ColVal) UpdateUnsignedValue(v uint64, isNil bool, row int) {
+	updateValue(cv, v, isNil, row)
+}
+
+// AddUnsigned returns the sum of x and y. It panics with UnsignedSumOverflow if the sum overflows
+// uint64, the panic is recovered by the transforms and returned as the error of the query.
+func AddUnsigned(x, y uint64) uint64 {
+	sum, carry := bits.Add64(x, y, 0)
+	if carry != 0 {
+		panic(errno.NewError(errno.UnsignedSumOverflow))
+	}
+	return sum
+}
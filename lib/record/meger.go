@@ -67,7 +67,7 @@ func (mcv *MergeColVal) appendSequence(src *MergeColVal, typ, limit int) {
 	switch typ {
 	case influx.Field_Type_String:
 		mcv.col.appendStringCol(src.col, src.offset, limit)
-	case influx.Field_Type_Int, influx.Field_Type_Float, influx.Field_Type_Boolean:
+	case influx.Field_Type_Int, influx.Field_Type_UInt, influx.Field_Type_Float, influx.Field_Type_Boolean:
 		mcv.col.appendBytes(src.col, typ, src.valid, src.valid+valid)
 	default:
 		panic("error type")
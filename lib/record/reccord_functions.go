@@ -473,6 +473,58 @@ func UpdateIntegerMaxFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRec
 	updateIntegerMaxImpl(v, iRec, rec, iRecColumn, recRow, iRecRow)
 }
 
+func UpdateUnsignedMin(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v, isNil := rec.ColVals[recColumn].UnsignedValue(recRow)
+	if isNil {
+		return
+	}
+	updateUnsignedMinImpl(v, iRec, rec, iRecColumn, recRow, iRecRow)
+}
+
+func updateUnsignedMinImpl(v uint64, iRec, rec *Record, iRecColumn, recRow, iRecRow int) {
+	srcVal, isSrcNil := iRec.ColVals[iRecColumn].UnsignedValueWithNullReserve(iRecRow)
+	if srcVal < v && !isSrcNil {
+		return
+	}
+	t1, _ := iRec.ColVals[len(iRec.Schema)-1].IntegerValueWithNullReserve(iRecRow)
+	t2, _ := rec.ColVals[len(rec.Schema)-1].IntegerValue(recRow)
+	if srcVal == v && t1 <= t2 && !isSrcNil {
+		return
+	}
+	iRec.UpdateIntervalRecRow(rec, recRow, iRecRow)
+}
+
+func UpdateUnsignedMinFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v := rec.ColVals[recColumn].UnsignedValues()[recRow]
+	updateUnsignedMinImpl(v, iRec, rec, iRecColumn, recRow, iRecRow)
+}
+
+func UpdateUnsignedMax(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v, isNil := rec.ColVals[recColumn].UnsignedValue(recRow)
+	if isNil {
+		return
+	}
+	updateUnsignedMaxImpl(v, iRec, rec, iRecColumn, recRow, iRecRow)
+}
+
+func updateUnsignedMaxImpl(v uint64, iRec, rec *Record, iRecColumn, recRow, iRecRow int) {
+	srcVal, isSrcNil := iRec.ColVals[iRecColumn].UnsignedValueWithNullReserve(iRecRow)
+	if srcVal > v && !isSrcNil {
+		return
+	}
+	t1, _ := iRec.ColVals[len(iRec.Schema)-1].IntegerValueWithNullReserve(iRecRow)
+	t2, _ := rec.ColVals[len(rec.Schema)-1].IntegerValue(recRow)
+	if srcVal == v && t1 <= t2 && !isSrcNil {
+		return
+	}
+	iRec.UpdateIntervalRecRow(rec, recRow, iRecRow)
+}
+
+func UpdateUnsignedMaxFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v := rec.ColVals[recColumn].UnsignedValues()[recRow]
+	updateUnsignedMaxImpl(v, iRec, rec, iRecColumn, recRow, iRecRow)
+}
+
 func UpdateFloatMin(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
 	v, isNil := rec.ColVals[recColumn].FloatValue(recRow)
 	if isNil {
@@ -619,6 +671,48 @@ func UpdateIntegerColumnMaxFast(iRec, rec *Record, recColumn, iRecColumn, recRow
 	updateIntegerColumnMaxImpl(v, iRec, iRecColumn, iRecRow)
 }
 
+func UpdateUnsignedColumnMin(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v, isNil := rec.ColVals[recColumn].UnsignedValue(recRow)
+	if isNil {
+		return
+	}
+	updateUnsignedColumnMinImpl(v, iRec, iRecColumn, iRecRow)
+}
+
+func updateUnsignedColumnMinImpl(v uint64, iRec *Record, iRecColumn, iRecRow int) {
+	srcVal, isSrcNil := iRec.ColVals[iRecColumn].UnsignedValueWithNullReserve(iRecRow)
+	if srcVal <= v && !isSrcNil {
+		return
+	}
+	iRec.ColVals[iRecColumn].UpdateUnsignedValue(v, false, iRecRow)
+}
+
+func UpdateUnsignedColumnMinFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v := rec.ColVals[recColumn].UnsignedValues()[recRow]
+	updateUnsignedColumnMinImpl(v, iRec, iRecColumn, iRecRow)
+}
+
+func UpdateUnsignedColumnMax(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v, isNil := rec.ColVals[recColumn].UnsignedValue(recRow)
+	if isNil {
+		return
+	}
+	updateUnsignedColumnMaxImpl(v, iRec, iRecColumn, iRecRow)
+}
+
+func updateUnsignedColumnMaxImpl(v uint64, iRec *Record, iRecColumn, iRecRow int) {
+	srcVal, isSrcNil := iRec.ColVals[iRecColumn].UnsignedValueWithNullReserve(iRecRow)
+	if srcVal >= v && !isSrcNil {
+		return
+	}
+	iRec.ColVals[iRecColumn].UpdateUnsignedValue(v, false, iRecRow)
+}
+
+func UpdateUnsignedColumnMaxFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v := rec.ColVals[recColumn].UnsignedValues()[recRow]
+	updateUnsignedColumnMaxImpl(v, iRec, iRecColumn, iRecRow)
+}
+
 func UpdateFloatColumnMin(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
 	v, isNil := rec.ColVals[recColumn].FloatValue(recRow)
 	if isNil {
@@ -721,6 +815,24 @@ func UpdateIntegerSumFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRec
 	updateIntegerSumImpl(v, iRec, iRecColumn, iRecRow)
 }
 
+func UpdateUnsignedSum(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v, isNil := rec.ColVals[recColumn].UnsignedValue(recRow)
+	if isNil {
+		return
+	}
+	updateUnsignedSumImpl(v, iRec, iRecColumn, iRecRow)
+}
+
+func updateUnsignedSumImpl(v uint64, iRec *Record, iRecColumn, iRecRow int) {
+	srcVal, _ := iRec.ColVals[iRecColumn].UnsignedValueWithNullReserve(iRecRow)
+	iRec.ColVals[iRecColumn].UpdateUnsignedValue(AddUnsigned(v, srcVal), false, iRecRow)
+}
+
+func UpdateUnsignedSumFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v := rec.ColVals[recColumn].UnsignedValues()[recRow]
+	updateUnsignedSumImpl(v, iRec, iRecColumn, iRecRow)
+}
+
 func UpdateFloatSum(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
 	v, isNil := rec.ColVals[recColumn].FloatValue(recRow)
 	if isNil {
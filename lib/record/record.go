@@ -43,12 +43,14 @@ func init() {
 	intervalRecUpdateFunctions[influx.Field_Type_String] = stringUpdateFunction
 	intervalRecUpdateFunctions[influx.Field_Type_Tag] = stringUpdateFunction
 	intervalRecUpdateFunctions[influx.Field_Type_Int] = integerUpdateFunction
+	intervalRecUpdateFunctions[influx.Field_Type_UInt] = integerUpdateFunction
 	intervalRecUpdateFunctions[influx.Field_Type_Float] = floatUpdateFunction
 	intervalRecUpdateFunctions[influx.Field_Type_Boolean] = booleanUpdateFunction
 
 	recTransAppendFunctions[influx.Field_Type_String] = recStringAppendFunction
 	recTransAppendFunctions[influx.Field_Type_Tag] = recStringAppendFunction
 	recTransAppendFunctions[influx.Field_Type_Int] = recIntegerAppendFunction
+	recTransAppendFunctions[influx.Field_Type_UInt] = recIntegerAppendFunction
 	recTransAppendFunctions[influx.Field_Type_Float] = recFloatAppendFunction
 	recTransAppendFunctions[influx.Field_Type_Boolean] = recBooleanAppendFunction
 }
@@ -966,6 +968,8 @@ func (rec *Record) String() string {
 			line = fmt.Sprintf("field(%v):%#v\n", f.Name, rec.Column(i).BooleanValues())
 		case influx.Field_Type_Int:
 			line = fmt.Sprintf("field(%v):%#v\n", f.Name, rec.Column(i).IntegerValues())
+		case influx.Field_Type_UInt:
+			line = fmt.Sprintf("field(%v):%#v\n", f.Name, rec.Column(i).UnsignedValues())
 		}
 
 		sb.WriteString(line)
@@ -980,7 +984,7 @@ func (rec *Record) ReserveColumnRows(rows int) {
 		col := &rec.ColVals[i]
 		l := len(col.Val)
 		switch schema.Type {
-		case influx.Field_Type_Float, influx.Field_Type_Int:
+		case influx.Field_Type_Float, influx.Field_Type_Int, influx.Field_Type_UInt:
 			size := rows * 8
 			if cap(col.Val) < size {
 				newCol := make([]byte, size)
@@ -1271,7 +1275,7 @@ func (rec *Record) AppendIntervalEmptyRow(rowTime int64, initRecMeta bool, first
 			rec.ColVals[i].AppendFloatNullReserve()
 		case influx.Field_Type_String, influx.Field_Type_Tag:
 			rec.ColVals[i].AppendStringNull()
-		case influx.Field_Type_Int:
+		case influx.Field_Type_Int, influx.Field_Type_UInt:
 			rec.ColVals[i].AppendIntegerNullReserve()
 		case influx.Field_Type_Boolean:
 			rec.ColVals[i].AppendBooleanNullReserve()
@@ -32,6 +32,7 @@ var zeroBuf = make([]byte, 1024)
 
 func init() {
 	typeSize[influx.Field_Type_Int] = util.Int64SizeBytes
+	typeSize[influx.Field_Type_UInt] = util.Uint64SizeBytes
 	typeSize[influx.Field_Type_Float] = util.Float64SizeBytes
 	typeSize[influx.Field_Type_Boolean] = util.BooleanSizeBytes
 }
@@ -42,6 +43,8 @@ func ToModelTypes(ty influxql.DataType) int {
 		return influx.Field_Type_String
 	case influxql.Integer:
 		return influx.Field_Type_Int
+	case influxql.Unsigned:
+		return influx.Field_Type_UInt
 	case influxql.Float:
 		return influx.Field_Type_Float
 	case influxql.Boolean:
@@ -61,6 +64,8 @@ func ToInfluxqlTypes(ty int) influxql.DataType {
 		return influxql.Tag
 	case influx.Field_Type_Int:
 		return influxql.Integer
+	case influx.Field_Type_UInt:
+		return influxql.Unsigned
 	case influx.Field_Type_Float:
 		return influxql.Float
 	case influx.Field_Type_Boolean:
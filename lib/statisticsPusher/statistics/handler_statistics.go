@@ -24,6 +24,7 @@ type HandlerStatistics struct {
 	WriteRequests                int64
 	Write400ErrRequests          int64
 	Write500ErrRequests          int64
+	Write429ErrRequests          int64
 	PingRequests                 int64
 	StatusRequests               int64
 	WriteRequestBytesReceived    int64
@@ -65,6 +66,7 @@ const (
 	statWriteRequest                 = "writeReq"                // Number of write requests serverd.
 	statWrite400ErrRequest           = "write400ErrReq"          // Number of write 400 requests occur error.
 	statWrite500ErrRequest           = "write500ErrReq"          // Number of write 500 requests occur error.
+	statWrite429ErrRequest           = "write429ErrReq"          // Number of write requests rejected by the quotas.
 	statPingRequest                  = "pingReq"                 // Number of ping requests served.
 	statStatusRequest                = "statusReq"               // Number of status requests served.
 	statWriteRequestBytesIn          = "writeReqBytesIn"         // Sum of all bytes in write requests.
@@ -127,6 +129,7 @@ func genHandlerValueMap() map[string]interface{} {
 		statWriteRequest:                 atomic.LoadInt64(&HandlerStat.WriteRequests),
 		statWrite400ErrRequest:           atomic.LoadInt64(&HandlerStat.Write400ErrRequests),
 		statWrite500ErrRequest:           atomic.LoadInt64(&HandlerStat.Write500ErrRequests),
+		statWrite429ErrRequest:           atomic.LoadInt64(&HandlerStat.Write429ErrRequests),
 		statPingRequest:                  atomic.LoadInt64(&HandlerStat.PingRequests),
 		statStatusRequest:                atomic.LoadInt64(&HandlerStat.StatusRequests),
 		statWriteRequestBytesIn:          atomic.LoadInt64(&HandlerStat.WriteRequestBytesIn),
@@ -29,7 +29,8 @@ var (
 	OnPrintLogicalPlan        int64 = 1
 	OnForceBroadcastQuery     int64 = 1
 
-	querySchemaLimit int = 0 // query schema upper bound
+	querySchemaLimit  int = 0 // query schema upper bound
+	hashJoinRowsLimit int = 0 // rows upper bound of the hash table of a join
 )
 
 func SetEnableBinaryTreeMerge(enabled int64) {
@@ -71,3 +72,11 @@ func SetQuerySchemaLimit(limit int) {
 func GetQuerySchemaLimit() int {
 	return querySchemaLimit
 }
+
+func SetHashJoinRowsLimit(limit int) {
+	hashJoinRowsLimit = limit
+}
+
+func GetHashJoinRowsLimit() int {
+	return hashJoinRowsLimit
+}
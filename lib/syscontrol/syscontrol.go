@@ -174,6 +174,10 @@ func SetQuerySchemaLimit(limit int) {
 	sysconfig.SetQuerySchemaLimit(limit)
 }
 
+func SetHashJoinRowsLimit(limit int) {
+	sysconfig.SetHashJoinRowsLimit(limit)
+}
+
 func SetQueryEnabledWhenExceedSeries(enabled bool) {
 	queryEnabledWhenExceedSeries = enabled
 }
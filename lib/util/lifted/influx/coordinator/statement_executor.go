@@ -36,12 +36,14 @@ import (
 	originql "github.com/influxdata/influxql"
 	"github.com/openGemini/openGemini/coordinator"
 	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/lib/audit"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/index"
 	"github.com/openGemini/openGemini/lib/logger"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/quota"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/syscontrol"
 	"github.com/openGemini/openGemini/lib/tokenizer"
@@ -66,6 +68,12 @@ const (
 
 var streamSupportMap = map[string]bool{"min": true, "max": true, "sum": true, "count": true}
 
+// selectRestrictor is implemented by the users who can be granted privileges on measurements,
+// it checks the measurements read by a statement and adds the conditions of the privileges.
+type selectRestrictor interface {
+	RestrictSelect(stmt *influxql.SelectStatement) error
+}
+
 // StatementExecutor executes a statement in the query.
 type StatementExecutor struct {
 	MetaClient meta.MetaClient
@@ -98,6 +106,12 @@ type StatementExecutor struct {
 	// hostname for show configs statement
 	Hostname   string
 	SqlConfigs map[string]interface{}
+
+	// QuotaManager reports the quota usage of this node for SHOW QUOTAS.
+	QuotaManager *quota.Manager
+
+	// AuditLogger records the DDL and privileged statements, it is nil if the audit log is disabled.
+	AuditLogger *audit.Logger
 }
 
 type combinedRunState uint8
@@ -199,12 +213,32 @@ func (e *StatementExecutor) Close() error {
 }
 
 // ExecuteStatement executes the given statement with the given execution context.
+// The audited statements are recorded here rather than in the meta client: the users and privileges
+// of the meta data are only changed by the CREATE/DROP USER, SET PASSWORD, GRANT and REVOKE statements
+// executed by this executor, and their strings have the passwords redacted.
 func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query.ExecutionContext, seq int) error {
+	if e.AuditLogger == nil || !audit.IsAudited(stmt) {
+		return e.executeStatement(stmt, ctx, seq)
+	}
+	// the statement is printed before it is executed, as the execution may modify it
+	stmtString := stmt.String()
+	err := e.executeStatement(stmt, ctx, seq)
+	opt := &ctx.ExecutionOptions
+	e.AuditLogger.Log(audit.NewEvent(audit.Statement, opt.UserName, opt.ClientAddr, opt.Database, stmtString, err))
+	return err
+}
+
+func (e *StatementExecutor) executeStatement(stmt influxql.Statement, ctx *query.ExecutionContext, seq int) error {
 	e.MaxQueryParallel = int(atomic.LoadInt32(&syscontrol.QueryParallel))
 	stmtString := stmt.String()
 
 	// Select statements are handled separately so that they can be streamed.
 	if stmt, ok := stmt.(*influxql.SelectStatement); ok {
+		if r, ok := ctx.ExecutionOptions.Authorizer.(selectRestrictor); ok {
+			if err := r.RestrictSelect(stmt); err != nil {
+				return err
+			}
+		}
 		begin := time.Now()
 		err := e.retryExecuteSelectStatement(stmt, ctx, seq)
 		dur := time.Since(begin)
@@ -279,7 +313,9 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		}
 		err = e.executeCreateUserStatement(stmt)
 	case *influxql.DeleteSeriesStatement:
-		return meta2.ErrUnsupportCommand
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
 		_, err = e.retryExecuteStatement(stmt, ctx, seq)
 	case *influxql.DropDatabaseStatement:
 		if ctx.ReadOnly {
@@ -292,7 +328,6 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		}
 		_, err = e.retryExecuteStatement(stmt, ctx, seq)
 	case *influxql.DropSeriesStatement:
-		return meta2.ErrUnsupportCommand
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
@@ -303,7 +338,10 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		}
 		_, err = e.retryExecuteStatement(stmt, ctx, seq)
 	case *influxql.DropShardStatement:
-		return meta2.ErrUnsupportCommand
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeDropShardStatement(stmt)
 	case *influxql.DropSubscriptionStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
@@ -330,6 +368,10 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
+		if stmt.Measurement != "" {
+			err = e.revokeMeasurementPrivilege(stmt.User, stmt.On, stmt.Measurement, originql.Privilege(stmt.Privilege))
+			break
+		}
 		// TODO: transform to `github.com/influxdata/influxql` RevokeStatement
 		stmt1 := originql.RevokeStatement{
 			Privilege: originql.Privilege(stmt.Privilege),
@@ -337,6 +379,10 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 			User:      stmt.User,
 		}
 		err = e.executeRevokeStatement(&stmt1)
+		if err == nil {
+			// the privilege on all the series of the database is revoked as well
+			err = e.revokeMeasurementPrivilege(stmt.User, stmt.On, "", originql.Privilege(stmt.Privilege))
+		}
 	case *influxql.RevokeAdminStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
@@ -351,15 +397,9 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 	case *influxql.ShowMeasurementKeysStatement:
 		rows, err = e.executeShowMeasurementKeysStatement(stmt)
 	case *influxql.ShowMeasurementsStatement:
-		if stmt.Condition != nil {
-			return meta2.ErrUnsupportCommand
-		}
 		_, err = e.retryExecuteStatement(stmt, ctx, seq)
 		return err
 	case *influxql.ShowMeasurementCardinalityStatement:
-		if stmt.Condition != nil {
-			return meta2.ErrUnsupportCommand
-		}
 		rows, err = e.retryExecuteStatement(stmt, ctx, seq)
 	case *influxql.ShowRetentionPoliciesStatement:
 		rows, err = e.executeShowRetentionPoliciesStatement(stmt)
@@ -396,6 +436,18 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeSetPasswordUserStatement(stmt)
+	case *influxql.SetQuotaStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeSetQuotaStatement(stmt)
+	case *influxql.DropQuotaStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeDropQuotaStatement(stmt)
+	case *influxql.ShowQuotasStatement:
+		rows, err = e.executeShowQuotasStatement(stmt)
 	case *influxql.ShowQueriesStatement:
 		rows, err = e.executeShowQueriesStatement()
 	case *influxql.KillQueryStatement:
@@ -469,6 +521,10 @@ func (e *StatementExecutor) retryExecuteStatement(stmt influxql.Statement, ctx *
 			err = e.executeDropDatabaseStatement(stmt)
 		case *influxql.DropMeasurementStatement:
 			err = e.executeDropMeasurementStatement(stmt, ctx.Database)
+		case *influxql.DeleteSeriesStatement:
+			err = e.executeDeleteSeriesStatement(stmt, ctx.Database)
+		case *influxql.DropSeriesStatement:
+			err = e.executeDropSeriesStatement(stmt, ctx.Database)
 		case *influxql.DropRetentionPolicyStatement:
 			err = e.executeDropRetentionPolicyStatement(stmt)
 		case *influxql.ShowTagKeysStatement:
@@ -789,6 +845,10 @@ func isValidContinuousQueryStatement(query string) error {
 	} else if interval == 0 {
 		return fmt.Errorf("GROUP BY time duration must be greater than 0s")
 	}
+	// the continuous queries are scheduled by fixed intervals
+	if q.Source.GroupByMonths() > 0 {
+		return errors.New("GROUP BY time of calendar months is not supported by continuous queries")
+	}
 
 	// check interval and ResampleFor/ResampleEvery
 	if q.ResampleFor != 0 {
@@ -868,6 +928,141 @@ func (e *StatementExecutor) executeDropMeasurementStatement(stmt *influxql.DropM
 	return e.MetaClient.MarkMeasurementDelete(database, stmt.RpName, stmt.Name)
 }
 
+// executeDeleteSeriesStatement deletes the rows of each retention policy separately,
+// so that only the shards of the retention policy owning the measurement are touched
+func (e *StatementExecutor) executeDeleteSeriesStatement(stmt *influxql.DeleteSeriesStatement, database string) error {
+	rpNames, err := e.matchDeleteMeasurements(database, stmt.Sources, stmt.Condition)
+	if err != nil {
+		return err
+	}
+
+	for rp, names := range rpNames {
+		e.StmtExecLogger.Info("start delete series", zap.String("db", database), zap.String("rp", rp),
+			zap.Strings("measurements", names), zap.Any("condition", stmt.Condition))
+		err = e.MetaExecutor.EachDBNodes(database, func(nodeID uint64, pts []uint32) error {
+			return e.NetStorage.DeleteSeries(nodeID, database, rp, pts, names, stmt.Condition)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *StatementExecutor) executeDropSeriesStatement(stmt *influxql.DropSeriesStatement, database string) error {
+	if influxql.HasTimeExpr(stmt.Condition) {
+		return errors.New("DROP SERIES doesn't support time in WHERE clause")
+	}
+
+	rpNames, err := e.matchDeleteMeasurements(database, stmt.Sources, stmt.Condition)
+	if err != nil {
+		return err
+	}
+
+	// the names with version are the same in every retention policy, so each one is dropped separately
+	for rp, names := range rpNames {
+		e.StmtExecLogger.Info("start drop series", zap.String("db", database), zap.String("rp", rp),
+			zap.Strings("measurements", names), zap.Any("condition", stmt.Condition))
+		err = e.MetaExecutor.EachDBNodes(database, func(nodeID uint64, pts []uint32) error {
+			return e.NetStorage.DropSeries(nodeID, database, rp, pts, names, stmt.Condition)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchDeleteMeasurements returns the names with version of the measurements matching the sources,
+// grouped by retention policy
+func (e *StatementExecutor) matchDeleteMeasurements(database string, sources influxql.Sources, cond influxql.Expr) (map[string][]string, error) {
+	if _, err := e.MetaClient.Database(database); err != nil {
+		return nil, err
+	}
+
+	mis, err := e.MetaClient.MatchMeasurements(database, sources.Measurements())
+	if err != nil {
+		return nil, err
+	}
+
+	rpNames := make(map[string][]string)
+	for key, m := range mis {
+		if err = checkDeleteCondition(cond, m); err != nil {
+			return nil, err
+		}
+		// the key is rp.nameWithVer
+		rp := key[:len(key)-len(m.Name)-1]
+		rpNames[rp] = append(rpNames[rp], m.Name)
+	}
+	return rpNames, nil
+}
+
+// checkDeleteCondition only tags and time are allowed in the WHERE clause of DELETE
+func checkDeleteCondition(cond influxql.Expr, mst *meta2.MeasurementInfo) error {
+	var err error
+	influxql.WalkFunc(cond, func(node influxql.Node) {
+		ref, ok := node.(*influxql.VarRef)
+		if !ok || err != nil || strings.ToLower(ref.Val) == "time" {
+			return
+		}
+		if typ, ok := mst.Schema[ref.Val]; ok && typ != influx.Field_Type_Tag {
+			err = fmt.Errorf("fields not supported in WHERE clause during deletion: %s", ref.Val)
+		}
+	})
+	return err
+}
+
+// executeDropShardStatement marks the shard as deleted in meta first to stop writing and querying,
+// then drops it on the data nodes owning it. The shard group is kept in meta until the data nodes
+// have dropped the shard, so the statement can be re-run if a data node fails.
+func (e *StatementExecutor) executeDropShardStatement(stmt *influxql.DropShardStatement) error {
+	database, policy, sgi := e.MetaClient.ShardOwner(stmt.ID)
+	if sgi == nil {
+		return nil
+	}
+
+	sgID := sgi.ID
+	lastShard := true
+	owners := make(map[uint32]struct{})
+	for i := range sgi.Shards {
+		if sgi.Shards[i].ID != stmt.ID {
+			lastShard = lastShard && sgi.Shards[i].MarkDelete
+			continue
+		}
+		for _, pt := range sgi.Shards[i].Owners {
+			owners[pt] = struct{}{}
+		}
+	}
+
+	e.StmtExecLogger.Info("start drop shard", zap.String("db", database), zap.String("rp", policy),
+		zap.Uint64("shard", stmt.ID))
+	if err := e.MetaClient.DropShard(stmt.ID); err != nil {
+		return err
+	}
+
+	err := e.MetaExecutor.EachDBNodes(database, func(nodeID uint64, pts []uint32) error {
+		ownerPts := make([]uint32, 0, len(pts))
+		for _, pt := range pts {
+			if _, ok := owners[pt]; ok {
+				ownerPts = append(ownerPts, pt)
+			}
+		}
+		if len(ownerPts) == 0 {
+			return nil
+		}
+		return e.NetStorage.DropShard(nodeID, database, policy, ownerPts, stmt.ID)
+	})
+	if err != nil || !lastShard {
+		return err
+	}
+
+	// all the shards of the shard group have been dropped, remove the shard group from meta
+	if err = e.MetaClient.DeleteShardGroup(database, policy, sgID, meta2.MarkDelete); err != nil {
+		return err
+	}
+	return e.MetaClient.PruneGroupsCommand(true, stmt.ID)
+}
+
 func (e *StatementExecutor) executeDropRetentionPolicyStatement(stmt *influxql.DropRetentionPolicyStatement) error {
 	e.StmtExecLogger.Info("start delete rp ", zap.String("db", stmt.Database), zap.String("rp", stmt.Name))
 	dbi, _ := e.MetaClient.Database(stmt.Database)
@@ -976,7 +1171,18 @@ func (e *StatementExecutor) executeExplainAnalyzeStatement(q *influxql.ExplainSt
 }
 
 func (e *StatementExecutor) executeGrantStatement(stmt *influxql.GrantStatement) error {
-	return e.MetaClient.SetPrivilege(stmt.User, stmt.On, originql.Privilege(stmt.Privilege))
+	if stmt.Measurement == "" && stmt.Condition == nil {
+		return e.MetaClient.SetPrivilege(stmt.User, stmt.On, originql.Privilege(stmt.Privilege))
+	}
+
+	var condition string
+	if stmt.Condition != nil {
+		if err := meta2.ValidatePrivilegeCondition(stmt.Condition); err != nil {
+			return err
+		}
+		condition = stmt.Condition.String()
+	}
+	return e.MetaClient.SetMeasurementPrivilege(stmt.User, stmt.On, stmt.Measurement, originql.Privilege(stmt.Privilege), condition)
 }
 
 func (e *StatementExecutor) executeGrantAdminStatement(stmt *influxql.GrantAdminStatement) error {
@@ -999,6 +1205,20 @@ func (e *StatementExecutor) executeRevokeStatement(stmt *originql.RevokeStatemen
 	return e.MetaClient.SetPrivilege(stmt.User, stmt.On, priv)
 }
 
+// revokeMeasurementPrivilege clears the revoked privilege from the measurement privilege of the user, if any.
+func (e *StatementExecutor) revokeMeasurementPrivilege(user, database, measurement string, revoked originql.Privilege) error {
+	privileges, err := e.MetaClient.UserMeasurementPrivileges(user)
+	if err != nil {
+		return err
+	}
+	for _, mp := range privileges {
+		if mp.Database == database && mp.Measurement == measurement {
+			return e.MetaClient.SetMeasurementPrivilege(user, database, measurement, mp.Privilege&^revoked, mp.Condition)
+		}
+	}
+	return nil
+}
+
 func (e *StatementExecutor) executeRevokeAdminStatement(stmt *influxql.RevokeAdminStatement) error {
 	return e.MetaClient.SetAdminPrivilege(stmt.User, false)
 }
@@ -1007,6 +1227,26 @@ func (e *StatementExecutor) executeSetPasswordUserStatement(q *influxql.SetPassw
 	return e.MetaClient.UpdateUser(q.Name, q.Password)
 }
 
+func (e *StatementExecutor) executeSetQuotaStatement(stmt *influxql.SetQuotaStatement) error {
+	q := &meta2.QuotaInfo{}
+	for _, opt := range stmt.Options {
+		if err := quota.Set(q, strings.ToLower(opt.Name), opt.Value); err != nil {
+			return err
+		}
+	}
+	if stmt.OnUser {
+		return e.MetaClient.SetQuota(stmt.Name, "", q)
+	}
+	return e.MetaClient.SetQuota("", stmt.Name, q)
+}
+
+func (e *StatementExecutor) executeDropQuotaStatement(stmt *influxql.DropQuotaStatement) error {
+	if stmt.OnUser {
+		return e.MetaClient.SetQuota(stmt.Name, "", nil)
+	}
+	return e.MetaClient.SetQuota("", stmt.Name, nil)
+}
+
 func (e *StatementExecutor) retryExecuteSelectStatement(stmt *influxql.SelectStatement, ctx *query.ExecutionContext, seq int) error {
 	var err error
 
@@ -1106,8 +1346,10 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 				break
 			}
 			result := &query.Result{
-				Series:  rowsChan.Rows,
-				Partial: rowsChan.Partial,
+				Series:      rowsChan.Rows,
+				Partial:     rowsChan.Partial,
+				Dimensions:  rowsChan.Dimensions,
+				ColumnTypes: rowsChan.ColumnTypes,
 			}
 			// Send results or exit if closing.
 			if err := ctx.Send(result, seq); err != nil {
@@ -1143,11 +1385,15 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 }
 
 func (e *StatementExecutor) GetOptions(opt query.ExecutionOptions, rowsChan chan query.RowsChan) query.SelectOptions {
+	maxPointN := e.MaxSelectPointN
+	if opt.MaxPointN > 0 {
+		maxPointN = opt.MaxPointN
+	}
 	return query.SelectOptions{
 		NodeID:                  opt.NodeID,
 		MaxSeriesN:              e.MaxSelectSeriesN,
 		MaxFieldsN:              e.MaxSelectFieldsN,
-		MaxPointN:               e.MaxSelectPointN,
+		MaxPointN:               maxPointN,
 		MaxBucketsN:             e.MaxSelectBucketsN,
 		Authorizer:              opt.Authorizer,
 		MaxQueryMem:             e.MaxQueryMem,
@@ -1368,7 +1614,20 @@ func (e *StatementExecutor) executeShowGrantsForUserStatement(q *influxql.ShowGr
 	for d, p := range priv {
 		row.Values = append(row.Values, []interface{}{d, p.String()})
 	}
-	return []*models.Row{row}, nil
+	rows := models.Rows{row}
+
+	mps, err := e.MetaClient.UserMeasurementPrivileges(q.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(mps) > 0 {
+		row = &models.Row{Columns: []string{"database", "measurement", "privilege", "condition"}}
+		for _, mp := range mps {
+			row.Values = append(row.Values, []interface{}{mp.Database, mp.Measurement, mp.Privilege.String(), mp.Condition})
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
 }
 
 func (e *StatementExecutor) executeShowMeasurementsStatement(q *influxql.ShowMeasurementsStatement, ctx *query.ExecutionContext, seq int) error {
@@ -1380,7 +1639,13 @@ func (e *StatementExecutor) executeShowMeasurementsStatement(q *influxql.ShowMea
 		mms = influxql.Measurements{q.Source.(*influxql.Measurement)}
 	}
 
-	measurements, err := e.MetaClient.Measurements(q.Database, mms)
+	var measurements []string
+	var err error
+	if q.Condition != nil {
+		measurements, err = e.measurementsWithCondition(q.Database, mms, q.Condition)
+	} else {
+		measurements, err = e.MetaClient.Measurements(q.Database, mms)
+	}
 	if err != nil {
 		return err
 	}
@@ -1412,6 +1677,17 @@ func (e *StatementExecutor) executeShowMeasurementCardinalityStatement(stmt *inf
 		mms = stmt.Sources.Measurements()
 	}
 
+	if stmt.Condition != nil {
+		measurements, err := e.measurementsWithCondition(stmt.Database, mms, stmt.Condition)
+		if err != nil {
+			return nil, err
+		}
+		return []*models.Row{{
+			Columns: []string{"count"},
+			Values:  [][]interface{}{{len(measurements)}},
+		}}, nil
+	}
+
 	measurements, err := e.MetaClient.MatchMeasurements(stmt.Database, mms)
 	if err != nil {
 		return nil, err
@@ -1422,6 +1698,49 @@ func (e *StatementExecutor) executeShowMeasurementCardinalityStatement(stmt *inf
 	}}, nil
 }
 
+// measurementsWithCondition returns the sorted names of the measurements which have series matching the condition,
+// the tag predicates are evaluated by the index of the stores and the time range limits the indexes to search
+func (e *StatementExecutor) measurementsWithCondition(database string, mms influxql.Measurements, cond influxql.Expr) ([]string, error) {
+	mis, err := e.MetaClient.MatchMeasurements(database, mms)
+	if err != nil {
+		return nil, err
+	}
+	if len(mis) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(mis))
+	for _, m := range mis {
+		names = append(names, m.Name)
+	}
+
+	matched := make(map[string]struct{}, len(names))
+	lock := new(sync.Mutex)
+	err = e.MetaExecutor.EachDBNodes(database, func(nodeID uint64, pts []uint32) error {
+		nodeNames, err := e.NetStorage.ShowMeasurements(nodeID, database, pts, names, cond)
+		lock.Lock()
+		defer lock.Unlock()
+		if err != nil {
+			return err
+		}
+		for _, name := range nodeNames {
+			matched[name] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		e.StmtExecLogger.Error("failed to match measurements with condition", zap.Error(err))
+		return nil, err
+	}
+
+	measurements := make([]string, 0, len(matched))
+	for name := range matched {
+		measurements = append(measurements, name)
+	}
+	sort.Strings(measurements)
+	return measurements, nil
+}
+
 func (e *StatementExecutor) executeShowRetentionPoliciesStatement(q *influxql.ShowRetentionPoliciesStatement) (models.Rows, error) {
 	if q.Database == "" {
 		return nil, coordinator.ErrDatabaseNameRequired
@@ -1511,14 +1830,27 @@ func (e *StatementExecutor) executeShowFieldKeys(q *influxql.ShowFieldKeysStatem
 }
 
 func (e *StatementExecutor) executeShowFieldKeyCardinality(q *influxql.ShowFieldKeyCardinalityStatement, ctx *query.ExecutionContext, seq int) error {
-	if q.Condition != nil {
-		return meta2.ErrUnsupportCommand
-	}
 	if q.Database == "" {
 		return coordinator.ErrDatabaseNameRequired
 	}
 
-	fieldKeys, err := e.FieldKeys(q.Database, q.Sources.Measurements())
+	mms := q.Sources.Measurements()
+	if q.Condition != nil {
+		// only the measurements which have series matching the condition are counted
+		names, err := e.measurementsWithCondition(q.Database, mms, q.Condition)
+		if err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			return ctx.Send(&query.Result{}, seq)
+		}
+		mms = make(influxql.Measurements, 0, len(names))
+		for _, name := range names {
+			mms = append(mms, &influxql.Measurement{Name: name})
+		}
+	}
+
+	fieldKeys, err := e.FieldKeys(q.Database, mms)
 	if err != nil {
 		return err
 	}
@@ -1622,15 +1954,22 @@ func (e *StatementExecutor) executeShowTagKeys(q *influxql.ShowTagKeysStatement,
 }
 
 func (e *StatementExecutor) executeShowTagKeyCardinality(q *influxql.ShowTagKeyCardinalityStatement, ctx *query.ExecutionContext, seq int) error {
-	if q.Condition != nil {
-		return meta2.ErrUnsupportCommand
-	}
-
 	if q.Database == "" {
 		return coordinator.ErrDatabaseNameRequired
 	}
 
-	tagKeys, err := e.TagKeys(q.Database, q.Sources.Measurements(), q.Condition)
+	var tagKeys netstorage.TableTagKeys
+	var err error
+	if q.Condition != nil {
+		exec := coordinator.NewShowTagKeysExecutor(e.StmtExecLogger, e.MetaClient, e.MetaExecutor, e.NetStorage)
+		tagKeys, err = exec.Execute(&influxql.ShowTagKeysStatement{
+			Database:  q.Database,
+			Sources:   q.Sources,
+			Condition: q.Condition,
+		})
+	} else {
+		tagKeys, err = e.TagKeys(q.Database, q.Sources.Measurements(), q.Condition)
+	}
 	if err != nil {
 		return err
 	}
@@ -1886,6 +2225,49 @@ func (e *StatementExecutor) executeShowUsersStatement(q *influxql.ShowUsersState
 	return []*models.Row{row}, nil
 }
 
+// executeShowQuotasStatement lists every limit of the users and the databases with its usage on this node,
+// the usage of max_series is only known by the store nodes and is left empty.
+// The quotas are enforced by each ts-sql node separately, the node tag tells which node the usage belongs to.
+func (e *StatementExecutor) executeShowQuotasStatement(q *influxql.ShowQuotasStatement) (models.Rows, error) {
+	row := &models.Row{
+		Tags:    map[string]string{"node": e.Hostname},
+		Columns: []string{"type", "name", "quota", "limit", "node_usage"},
+	}
+	appendQuota := func(kind quota.Kind, name string, qi *meta2.QuotaInfo) {
+		usage := e.QuotaManager.Usage(kind, name)
+		for _, n := range quota.Names {
+			limit := quota.Get(qi, n)
+			if limit == 0 {
+				continue
+			}
+			var used interface{}
+			if v := usage.Get(n); v >= 0 {
+				used = v
+			}
+			row.Values = append(row.Values, []interface{}{kind.String(), name, n, limit, used})
+		}
+	}
+
+	for _, ui := range e.MetaClient.Users() {
+		if ui.Quota != nil {
+			appendQuota(quota.User, ui.Name, ui.Quota)
+		}
+	}
+
+	databases := e.MetaClient.Databases()
+	names := make([]string, 0, len(databases))
+	for name, dbi := range databases {
+		if dbi.Quota != nil && !dbi.MarkDeleted {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		appendQuota(quota.Database, name, databases[name].Quota)
+	}
+	return []*models.Row{row}, nil
+}
+
 func (e *StatementExecutor) executeShowQueriesStatement() (models.Rows, error) {
 	nodes, err := e.MetaClient.DataNodes()
 	if err != nil {
@@ -1,16 +1,25 @@
 package coordinator
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	originql "github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/coordinator"
+	"github.com/openGemini/openGemini/lib/audit"
+	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	Logger "github.com/openGemini/openGemini/lib/logger"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/quota"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
@@ -366,3 +375,320 @@ func TestStatementExecutor_executeCreateContinuousQueryStatement(t *testing.T) {
 	cqQuery := stmt.String()
 	assert.Equal(t, `CREATE CONTINUOUS QUERY cq0 ON db0 RESAMPLE EVERY 10m FOR 1h BEGIN SELECT "field"::integer INTO db1..mst1 FROM db0.rp0.mst0 GROUP BY time(1m) END`, cqQuery)
 }
+
+type mockShowMetaClient struct {
+	MockMetaClient
+}
+
+func (m *mockShowMetaClient) Database(name string) (*meta2.DatabaseInfo, error) {
+	return &meta2.DatabaseInfo{Name: name}, nil
+}
+
+func (m *mockShowMetaClient) GetNodePtsMap(database string) (map[uint64][]uint32, error) {
+	return map[uint64][]uint32{1: {0}, 2: {1}}, nil
+}
+
+func (m *mockShowMetaClient) MatchMeasurements(database string, ms influxql.Measurements) (map[string]*meta2.MeasurementInfo, error) {
+	return map[string]*meta2.MeasurementInfo{
+		"rp0.cpu_0000":  {Name: "cpu_0000"},
+		"rp0.mem_0000":  {Name: "mem_0000"},
+		"rp0.disk_0000": {Name: "disk_0000"},
+	}, nil
+}
+
+type mockShowNS struct {
+	netstorage.NetStorage
+}
+
+func (s *mockShowNS) ShowMeasurements(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) ([]string, error) {
+	if condition == nil {
+		return nil, errors.New("condition is required")
+	}
+	if nodeID == 1 {
+		return []string{"cpu"}, nil
+	}
+	return []string{"cpu", "disk"}, nil
+}
+
+func TestStatementExecutor_ShowMeasurementsWithCondition(t *testing.T) {
+	mc := &mockShowMetaClient{}
+	me := coordinator.NewMetaExecutor()
+	me.MetaClient = mc
+	e := StatementExecutor{MetaClient: mc, MetaExecutor: me, NetStorage: &mockShowNS{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+
+	cond := influxql.MustParseExpr(`host='server01'`)
+	names, err := e.measurementsWithCondition("db0", nil, cond)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cpu", "disk"}, names)
+
+	rows, err := e.executeShowMeasurementCardinalityStatement(&influxql.ShowMeasurementCardinalityStatement{
+		Database:  "db0",
+		Condition: cond,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rows))
+	assert.Equal(t, [][]interface{}{{2}}, rows[0].Values)
+}
+
+type mockDropShardMetaClient struct {
+	mockShowMetaClient
+	sgi    *meta2.ShardGroupInfo
+	pruned []uint64
+}
+
+func (m *mockDropShardMetaClient) ShardOwner(shardID uint64) (string, string, *meta2.ShardGroupInfo) {
+	if m.sgi == nil || m.sgi.Shard(shardID) == nil {
+		return "", "", nil
+	}
+	return "db0", "rp0", m.sgi
+}
+
+func (m *mockDropShardMetaClient) DropShard(id uint64) error {
+	m.sgi.Shard(id).MarkDelete = true
+	return nil
+}
+
+func (m *mockDropShardMetaClient) DeleteShardGroup(database, policy string, id uint64, deleteType int32) error {
+	if database != "db0" || policy != "rp0" || id != m.sgi.ID || deleteType != meta2.MarkDelete {
+		return fmt.Errorf("unexpected delete shard group %s.%s %d", database, policy, id)
+	}
+	m.sgi.DeletedAt = time.Now()
+	return nil
+}
+
+func (m *mockDropShardMetaClient) PruneGroupsCommand(shardGroup bool, id uint64) error {
+	if !shardGroup || m.sgi.DeletedAt.IsZero() {
+		return fmt.Errorf("unexpected prune groups %v %d", shardGroup, id)
+	}
+	m.pruned = append(m.pruned, id)
+	m.sgi = nil
+	return nil
+}
+
+type mockDropShardNS struct {
+	netstorage.NetStorage
+	err   error
+	nodes []uint64
+}
+
+func (s *mockDropShardNS) DropShard(nodeID uint64, database, rpName string, dbPts []uint32, shardID uint64) error {
+	if s.err != nil {
+		return s.err
+	}
+	if database != "db0" || rpName != "rp0" || len(dbPts) != 1 || uint64(dbPts[0])+2 != shardID {
+		return fmt.Errorf("unexpected drop shard %s.%s %v %d", database, rpName, dbPts, shardID)
+	}
+	s.nodes = append(s.nodes, nodeID)
+	return nil
+}
+
+func TestStatementExecutor_DropShard(t *testing.T) {
+	mc := &mockDropShardMetaClient{sgi: &meta2.ShardGroupInfo{ID: 1, Shards: []meta2.ShardInfo{
+		{ID: 2, Owners: []uint32{0}},
+		{ID: 3, Owners: []uint32{1}},
+	}}}
+	me := coordinator.NewMetaExecutor()
+	me.MetaClient = mc
+	ns := &mockDropShardNS{}
+	e := StatementExecutor{MetaClient: mc, MetaExecutor: me, NetStorage: ns, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+
+	assert.NoError(t, e.executeDropShardStatement(&influxql.DropShardStatement{ID: 3}))
+	assert.True(t, mc.sgi.Shard(3).MarkDelete)
+	assert.Equal(t, []uint64{2}, ns.nodes)
+	assert.Empty(t, mc.pruned)
+
+	// the data node fails to drop the last shard, the shard group is kept so the statement can be re-run
+	ns.err = fmt.Errorf("drop shard failed")
+	assert.EqualError(t, e.executeDropShardStatement(&influxql.DropShardStatement{ID: 2}), "drop shard failed")
+	assert.NotNil(t, mc.sgi)
+	assert.True(t, mc.sgi.Shard(2).MarkDelete)
+	assert.Empty(t, mc.pruned)
+
+	ns.err = nil
+	assert.NoError(t, e.executeDropShardStatement(&influxql.DropShardStatement{ID: 2}))
+	assert.Equal(t, []uint64{2, 1}, ns.nodes)
+	assert.Equal(t, []uint64{2}, mc.pruned)
+
+	// the shard does not exist or has been dropped already
+	assert.NoError(t, e.executeDropShardStatement(&influxql.DropShardStatement{ID: 100}))
+	assert.Equal(t, []uint64{2}, mc.pruned)
+}
+
+type mockQuotaMetaClient struct {
+	meta.MetaClient
+	users     map[string]*meta2.QuotaInfo
+	databases map[string]*meta2.QuotaInfo
+}
+
+func (m *mockQuotaMetaClient) SetQuota(user, database string, q *meta2.QuotaInfo) error {
+	if user != "" {
+		m.users[user] = q
+	} else {
+		m.databases[database] = q
+	}
+	return nil
+}
+
+func (m *mockQuotaMetaClient) UserQuota(name string) *meta2.QuotaInfo {
+	return m.users[name]
+}
+
+func (m *mockQuotaMetaClient) DatabaseQuota(name string) *meta2.QuotaInfo {
+	return m.databases[name]
+}
+
+func (m *mockQuotaMetaClient) Users() []meta2.UserInfo {
+	var users []meta2.UserInfo
+	for name, q := range m.users {
+		users = append(users, meta2.UserInfo{Name: name, Quota: q})
+	}
+	return users
+}
+
+func (m *mockQuotaMetaClient) Databases() map[string]*meta2.DatabaseInfo {
+	databases := make(map[string]*meta2.DatabaseInfo)
+	for name, q := range m.databases {
+		databases[name] = &meta2.DatabaseInfo{Name: name, Quota: q}
+	}
+	return databases
+}
+
+func TestStatementExecutor_Quota(t *testing.T) {
+	mc := &mockQuotaMetaClient{users: map[string]*meta2.QuotaInfo{}, databases: map[string]*meta2.QuotaInfo{}}
+	e := StatementExecutor{MetaClient: mc, QuotaManager: quota.NewManager(mc), StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown),
+		Hostname: "127.0.0.1:8086"}
+
+	assert.NoError(t, e.executeSetQuotaStatement(&influxql.SetQuotaStatement{OnUser: true, Name: "user1", Options: []influxql.QuotaOption{
+		{Name: "POINTS_PER_SECOND", Value: 100},
+		{Name: "max_concurrent_queries", Value: 2},
+	}}))
+	assert.NoError(t, e.executeSetQuotaStatement(&influxql.SetQuotaStatement{Name: "db0", Options: []influxql.QuotaOption{
+		{Name: "max_series", Value: 1000},
+	}}))
+	assert.Error(t, e.executeSetQuotaStatement(&influxql.SetQuotaStatement{Name: "db0", Options: []influxql.QuotaOption{
+		{Name: "max_fields", Value: 1000},
+	}}))
+	assert.Equal(t, &meta2.QuotaInfo{PointsPerSecond: 100, MaxConcurrentQueries: 2}, mc.users["user1"])
+	assert.Equal(t, &meta2.QuotaInfo{MaxSeries: 1000}, mc.databases["db0"])
+
+	q, err := e.QuotaManager.BeginQuery("user1", "db0")
+	assert.NoError(t, err)
+	defer q.Done()
+
+	rows, err := e.executeShowQuotasStatement(&influxql.ShowQuotasStatement{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rows))
+	assert.Equal(t, []string{"type", "name", "quota", "limit", "node_usage"}, rows[0].Columns)
+	assert.Equal(t, map[string]string{"node": "127.0.0.1:8086"}, rows[0].Tags)
+	assert.Equal(t, [][]interface{}{
+		{"user", "user1", "points_per_second", int64(100), int64(0)},
+		{"user", "user1", "max_concurrent_queries", int64(2), int64(1)},
+		{"database", "db0", "max_series", int64(1000), nil},
+	}, rows[0].Values)
+
+	assert.NoError(t, e.executeDropQuotaStatement(&influxql.DropQuotaStatement{OnUser: true, Name: "user1"}))
+	assert.Nil(t, mc.users["user1"])
+}
+
+type mockPrivilegeMetaClient struct {
+	meta.MetaClient
+	data *meta2.Data
+}
+
+func (m *mockPrivilegeMetaClient) SetPrivilege(username, database string, p originql.Privilege) error {
+	return m.data.SetPrivilege(username, database, p)
+}
+
+func (m *mockPrivilegeMetaClient) UpdateUser(name, password string) error {
+	return nil
+}
+
+func (m *mockPrivilegeMetaClient) UserPrivilege(username, database string) (*originql.Privilege, error) {
+	return m.data.UserPrivilege(username, database)
+}
+
+func (m *mockPrivilegeMetaClient) UserPrivileges(username string) (map[string]originql.Privilege, error) {
+	return m.data.UserPrivileges(username)
+}
+
+func (m *mockPrivilegeMetaClient) SetMeasurementPrivilege(username, database, measurement string, p originql.Privilege, condition string) error {
+	return m.data.SetMeasurementPrivilege(username, database, measurement, p, condition)
+}
+
+func (m *mockPrivilegeMetaClient) UserMeasurementPrivileges(username string) ([]*meta2.MeasurementPrivilege, error) {
+	return m.data.UserMeasurementPrivileges(username)
+}
+
+func TestStatementExecutor_MeasurementPrivilege(t *testing.T) {
+	mc := &mockPrivilegeMetaClient{data: &meta2.Data{
+		Users:     []meta2.UserInfo{{Name: "user1"}},
+		Databases: map[string]*meta2.DatabaseInfo{"db0": {Name: "db0"}},
+	}}
+	e := StatementExecutor{MetaClient: mc, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+
+	tenant := &influxql.BinaryExpr{Op: influxql.EQ, LHS: &influxql.VarRef{Val: "tenant"}, RHS: &influxql.StringLiteral{Val: "acme"}}
+	assert.NoError(t, e.executeGrantStatement(&influxql.GrantStatement{Privilege: influxql.WritePrivilege, On: "db0", User: "user1"}))
+	assert.NoError(t, e.executeGrantStatement(&influxql.GrantStatement{Privilege: influxql.AllPrivileges, On: "db0", Measurement: "cpu", Condition: tenant, User: "user1"}))
+	assert.NoError(t, e.executeGrantStatement(&influxql.GrantStatement{Privilege: influxql.ReadPrivilege, On: "db0", Condition: tenant, User: "user1"}))
+	assert.Error(t, e.executeGrantStatement(&influxql.GrantStatement{Privilege: influxql.ReadPrivilege, On: "db0", User: "user1",
+		Condition: &influxql.BinaryExpr{Op: influxql.GT, LHS: &influxql.VarRef{Val: "value"}, RHS: &influxql.IntegerLiteral{Val: 1}}}))
+
+	rows, err := e.executeShowGrantsForUserStatement(&influxql.ShowGrantsForUserStatement{Name: "user1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rows))
+	assert.Equal(t, [][]interface{}{{"db0", "WRITE"}}, rows[0].Values)
+	assert.Equal(t, []string{"database", "measurement", "privilege", "condition"}, rows[1].Columns)
+	assert.Equal(t, [][]interface{}{
+		{"db0", "", "READ", "tenant = 'acme'"},
+		{"db0", "cpu", "ALL PRIVILEGES", "tenant = 'acme'"},
+	}, rows[1].Values)
+
+	// revoking a privilege from the measurement keeps the others and the condition
+	assert.NoError(t, e.revokeMeasurementPrivilege("user1", "db0", "cpu", originql.ReadPrivilege))
+	mps, _ := mc.UserMeasurementPrivileges("user1")
+	assert.Equal(t, 2, len(mps))
+	assert.Equal(t, originql.WritePrivilege, mps[1].Privilege)
+	assert.Equal(t, "tenant = 'acme'", mps[1].Condition)
+
+	// revoking the privilege on the database revokes it from all the series of the database
+	assert.NoError(t, e.executeRevokeStatement(&originql.RevokeStatement{Privilege: originql.ReadPrivilege, On: "db0", User: "user1"}))
+	assert.NoError(t, e.revokeMeasurementPrivilege("user1", "db0", "", originql.ReadPrivilege))
+	mps, _ = mc.UserMeasurementPrivileges("user1")
+	assert.Equal(t, 1, len(mps))
+	assert.Equal(t, "cpu", mps[0].Measurement)
+	assert.NoError(t, e.revokeMeasurementPrivilege("user1", "db0", "mem", originql.ReadPrivilege))
+}
+
+func TestStatementExecutor_Audit(t *testing.T) {
+	conf := config.NewAuditConfig()
+	conf.Enabled = true
+	conf.Path = filepath.Join(t.TempDir(), "audit.log")
+	mc := &mockPrivilegeMetaClient{data: &meta2.Data{
+		Users:     []meta2.UserInfo{{Name: "user1"}},
+		Databases: map[string]*meta2.DatabaseInfo{"db0": {Name: "db0"}},
+	}}
+	e := StatementExecutor{MetaClient: mc, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown), AuditLogger: audit.NewLogger(conf)}
+
+	ctx := &query.ExecutionContext{
+		Context:          context.Background(),
+		Results:          make(chan *query.Result, 3),
+		ExecutionOptions: query.ExecutionOptions{Database: "db0", UserName: "admin", ClientAddr: "10.0.0.1"},
+	}
+	assert.NoError(t, e.ExecuteStatement(&influxql.GrantStatement{Privilege: influxql.ReadPrivilege, On: "db0", User: "user1"}, ctx, 0))
+	assert.Error(t, e.ExecuteStatement(&influxql.GrantStatement{Privilege: influxql.ReadPrivilege, On: "db0", User: "user2"}, ctx, 1))
+	assert.NoError(t, e.ExecuteStatement(&influxql.SetPasswordUserStatement{Name: "user1", Password: "Secret@12345"}, ctx, 2))
+	assert.NoError(t, e.AuditLogger.Close())
+
+	buf, err := os.ReadFile(conf.Path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(buf), "Secret@12345")
+	lines := strings.Split(strings.TrimSpace(string(buf)), "\n")
+	assert.Equal(t, 3, len(lines))
+	var ev audit.Event
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &ev))
+	assert.Equal(t, audit.Event{Time: ev.Time, Operation: audit.Statement, User: "admin", ClientIP: "10.0.0.1", Database: "db0",
+		Statement: "GRANT READ ON db0 TO user1", Result: audit.Success}, ev)
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &ev))
+	assert.Equal(t, audit.Failure, ev.Result)
+	assert.Equal(t, meta2.ErrUserNotFound.Error(), ev.Error)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"net/http"
+
+	"github.com/openGemini/openGemini/lib/audit"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+// auditRequest records the request as an operation of the audit log. Only the parameters of the sysctrl
+// requests are recorded, the parameters of the other requests may contain the credentials, or the
+// statements with passwords such as CREATE USER, and are never recorded.
+func (h *Handler) auditRequest(operation, user string, r *http.Request, err error) {
+	if h.AuditLogger == nil {
+		return
+	}
+	q := r.URL.Query()
+	stmt := r.Method + " " + r.URL.Path
+	if operation == audit.SysCtrl {
+		q.Del("u")
+		q.Del("p")
+		if len(q) > 0 {
+			stmt += "?" + q.Encode()
+		}
+	}
+	h.AuditLogger.Log(audit.NewEvent(operation, user, clientAddr(r), q.Get("db"), stmt, err))
+}
+
+// auditStatements records the audited statements of a query which is not executed, the executed statements
+// are recorded by the statement executor.
+func (h *Handler) auditStatements(user string, r *http.Request, db string, q *influxql.Query, err error) {
+	if h.AuditLogger == nil {
+		return
+	}
+	for _, stmt := range q.Statements {
+		if audit.IsAudited(stmt) {
+			h.AuditLogger.Log(audit.NewEvent(audit.Statement, user, clientAddr(r), db, stmt.String(), err))
+		}
+	}
+}
@@ -21,6 +21,9 @@ const (
 	// DefaultFlightAddress is the default address to bind to.
 	DefaultFlightAddress = ":8087"
 
+	// DefaultOtlpGrpcAddress is the default address to bind the OTLP gRPC receiver to.
+	DefaultOtlpGrpcAddress = ":4317"
+
 	// DefaultRealm is the default realm sent back when issuing a basic auth challenge.
 	DefaultRealm = "InfluxDB"
 
@@ -38,6 +41,9 @@ const (
 
 	DefaultBlockSize   = 64 * 1024
 	DefaultMaxLineSize = 1024 * 1024
+
+	// DefaultPromMaxSamples is the maximum number of samples a PromQL query can load, the same as Prometheus.
+	DefaultPromMaxSamples = 50000000
 )
 
 // Config represents a configuration for a HTTP service.
@@ -47,6 +53,9 @@ type Config struct {
 	FlightEnabled           bool           `toml:"flight-enabled"`
 	FlightAuthEnabled       bool           `toml:"flight-auth-enabled"`
 	FlightChFactor          int            `toml:"flight-ch-factor"`
+	OtlpGrpcAddress         string         `toml:"otlp-grpc-address"`
+	OtlpGrpcEnabled         bool           `toml:"otlp-grpc-enabled"`
+	OtlpGrpcAuthEnabled     bool           `toml:"otlp-grpc-auth-enabled"`
 	Domain                  string         `toml:"domain"`
 	AuthEnabled             bool           `toml:"auth-enabled"`
 	WeakPwdPath             string         `toml:"weakpwd-path"`
@@ -89,6 +98,7 @@ type Config struct {
 	TimeFilterProtection    bool           `toml:"time-filter-protection"`
 	CPUThreshold            int            `toml:"cpu-threshold"`
 	MaxLineSize             int            `toml:"max-line-size"`
+	PromMaxSamples          int            `toml:"prom-max-samples"`
 }
 
 func CombineDomain(domain, addr string) string {
@@ -118,6 +128,9 @@ func NewConfig() Config {
 		FlightEnabled:           false,
 		FlightAuthEnabled:       false,
 		FlightChFactor:          2,
+		OtlpGrpcAddress:         DefaultOtlpGrpcAddress,
+		OtlpGrpcEnabled:         false,
+		OtlpGrpcAuthEnabled:     false,
 		LogEnabled:              true,
 		PprofEnabled:            true,
 		DebugPprofEnabled:       false,
@@ -138,6 +151,7 @@ func NewConfig() Config {
 		ReadBlockSize:           toml.Size(DefaultBlockSize),
 		TimeFilterProtection:    false,
 		MaxLineSize:             DefaultMaxLineSize,
+		PromMaxSamples:          DefaultPromMaxSamples,
 	}
 }
 
@@ -149,6 +163,9 @@ func (c Config) Validate() error {
 	if c.FlightAddress == "" {
 		return errors.New("http arrowflight-address must be specified")
 	}
+	if c.OtlpGrpcEnabled && c.OtlpGrpcAddress == "" {
+		return errors.New("http otlp-grpc-address must be specified")
+	}
 	if c.MaxConnectionLimit < 0 {
 		return errors.New("http max-connection-limit can not be negative")
 	}
@@ -170,6 +187,9 @@ func (c Config) Validate() error {
 	if c.MaxBodySize < 0 {
 		return errors.New("http max-body-size can not be negative")
 	}
+	if c.PromMaxSamples < 0 {
+		return errors.New("http prom-max-samples can not be negative")
+	}
 	return nil
 }
 
@@ -180,6 +200,9 @@ func (c *Config) ShowConfigs() map[string]interface{} {
 		"http.flight-enabled":                  c.FlightEnabled,
 		"http.flight-auth-enabled":             c.FlightAuthEnabled,
 		"http.flight-ch-factor":                c.FlightChFactor,
+		"http.otlp-grpc-address":               c.OtlpGrpcAddress,
+		"http.otlp-grpc-enabled":               c.OtlpGrpcEnabled,
+		"http.otlp-grpc-auth-enabled":          c.OtlpGrpcAuthEnabled,
 		"http.domain":                          c.Domain,
 		"http.auth-enabled":                    c.AuthEnabled,
 		"http.weakpwd-path":                    c.WeakPwdPath,
@@ -220,6 +243,7 @@ func (c *Config) ShowConfigs() map[string]interface{} {
 		"http.read-block-size":                 c.ReadBlockSize,
 		"http.time-filter-protection":          c.TimeFilterProtection,
 		"http.cpu-threshold":                   c.CPUThreshold,
+		"http.prom-max-samples":                c.PromMaxSamples,
 	}
 }
 
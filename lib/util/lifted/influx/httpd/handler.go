@@ -2,6 +2,7 @@ package httpd
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	json2 "encoding/json"
 	"errors"
@@ -24,9 +25,11 @@ import (
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/services/httpd"
 	"github.com/influxdata/influxdb/uuid"
+	originql "github.com/influxdata/influxql"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/openGemini/openGemini/app"
 	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/audit"
 	config2 "github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/cpu"
 	"github.com/openGemini/openGemini/lib/errno"
@@ -35,6 +38,7 @@ import (
 	"github.com/openGemini/openGemini/lib/netstorage"
 	"github.com/openGemini/openGemini/lib/obs"
 	"github.com/openGemini/openGemini/lib/opentelemetry"
+	"github.com/openGemini/openGemini/lib/quota"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/statisticsPusher"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
@@ -47,10 +51,14 @@ import (
 	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services/rule"
 	"github.com/pingcap/failpoint"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/prompb"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 	"go.uber.org/zap"
 	"golang.org/x/time/rate"
 )
@@ -121,6 +129,9 @@ type Handler struct {
 	mux       *mux.Router
 	Version   string
 	BuildType string
+	Commit    string
+	Branch    string
+	BuildTime string
 
 	MetaClient interface {
 		Database(name string) (*meta2.DatabaseInfo, error)
@@ -179,6 +190,18 @@ type Handler struct {
 
 	SubscriberManager
 
+	// RuleManager serves the Prometheus rules and alerts, it is nil if the rule evaluation is disabled.
+	RuleManager interface {
+		Rules(typ string) *rule.RuleDiscovery
+		Alerts() *rule.AlertDiscovery
+	}
+
+	// QuotaManager enforces the write and query quotas of users and databases, it is nil if quotas are not enforced.
+	QuotaManager *quota.Manager
+
+	// AuditLogger records the authentications and the system controls, it is nil if the audit log is disabled.
+	AuditLogger *audit.Logger
+
 	Config           *config.Config
 	Logger           *logger.Logger
 	CLFLogger        *zap.Logger
@@ -191,6 +214,9 @@ type Handler struct {
 	slowQueries      chan *hybridqp.SelectDuration
 	StatisticsPusher *statisticsPusher.StatisticsPusher
 	SQLConfig        *config2.TSSql
+
+	startTime    time.Time
+	promMetadata *promMetadataCache
 }
 
 // NewHandler returns a new instance of handler with routes.
@@ -203,6 +229,8 @@ func NewHandler(c config.Config) *Handler {
 		requestTracker: httpd.NewRequestTracker(),
 		slowQueries:    make(chan *hybridqp.SelectDuration, 256),
 		QueryExecutor:  query.NewExecutor(cpu.GetCpuNum()),
+		startTime:      time.Now(),
+		promMetadata:   newPromMetadataCache(),
 	}
 
 	// Limit the number of concurrent & enqueued write requests.
@@ -313,6 +341,34 @@ func NewHandler(c config.Config) *Handler {
 			"prometheus-metadata-query", // Prometheus metadata query
 			"GET", "/api/v1/metadata", true, true, h.servePromQueryMetaData,
 		},
+		Route{
+			"prometheus-buildinfo-query", // Prometheus build information
+			"GET", "/api/v1/status/buildinfo", false, true, h.servePromBuildInfo,
+		},
+		Route{
+			"prometheus-runtimeinfo-query", // Prometheus runtime information
+			"GET", "/api/v1/status/runtimeinfo", false, true, h.servePromRuntimeInfo,
+		},
+		Route{
+			"prometheus-tsdb-status-query", // Prometheus tsdb status
+			"GET", "/api/v1/status/tsdb", true, true, h.servePromTSDBStatus,
+		},
+		Route{
+			"prometheus-format-query", // Prometheus query formatting
+			"GET", "/api/v1/format_query", false, true, h.servePromFormatQuery,
+		},
+		Route{
+			"prometheus-format-query", // Prometheus query formatting
+			"POST", "/api/v1/format_query", false, true, h.servePromFormatQuery,
+		},
+		Route{
+			"prometheus-rules-query", // Prometheus recording and alerting rules
+			"GET", "/api/v1/rules", false, true, h.servePromRules,
+		},
+		Route{
+			"prometheus-alerts-query", // Prometheus active alerts
+			"GET", "/api/v1/alerts", false, true, h.servePromAlerts,
+		},
 		Route{
 			"prometheus-write-metric-store", // Prometheus remote write
 			"POST", "/prometheus/{metric_store}/api/v1/prom/write", false, true, h.servePromWriteWithMetricStore,
@@ -365,6 +421,22 @@ func NewHandler(c config.Config) *Handler {
 			"sysCtrl",
 			"POST", "/debug/ctrl", false, true, h.serveSysCtrl,
 		},
+		Route{
+			"write-v2", // InfluxDB 2.x compatible write API
+			"POST", "/api/v2/write", true, writeLogEnabled, h.serveWriteV2,
+		},
+		Route{
+			"otlp-traces-write", // OpenTelemetry traces ingest
+			"POST", "/v1/traces", false, writeLogEnabled, h.serveTracesWrite,
+		},
+		Route{
+			"otlp-metrics-write", // OpenTelemetry metrics ingest
+			"POST", "/v1/metrics", false, writeLogEnabled, h.serveMetricsWrite,
+		},
+		Route{
+			"otlp-logs-write", // OpenTelemetry logs ingest
+			"POST", "/v1/logs", false, writeLogEnabled, h.serveLogsWrite,
+		},
 	}...)
 	if config2.IsLogKeeper() {
 		h.AddRoutes([]Route{
@@ -543,7 +615,7 @@ func (h *Handler) AddRoutes(routes ...Route) {
 		if r.Method == http.MethodPost {
 			switch r.Pattern {
 			case "/write", "/api/v1/prom/write", "/repo/{repository}/logstreams/{logStream}/records",
-				"/api/streams/{repository}/{logStream}/upload":
+				"/api/streams/{repository}/{logStream}/upload", "/v1/traces", "/v1/metrics", "/v1/logs", "/api/v2/write":
 				handler = h.writeThrottler.Handler(handler)
 			case "/query", "/api/v1/prom/query":
 				handler = h.queryThrottler.Handler(handler)
@@ -563,6 +635,9 @@ func (h *Handler) AddRoutes(routes ...Route) {
 			}
 		}
 
+		if r.Pattern == "/api/v2/write" {
+			handler = v2ResponseWriterFilter(handler)
+		}
 		handler = h.responseWriter(handler)
 		if r.Gzipped {
 			handler = gzipFilter(handler)
@@ -624,23 +699,30 @@ func isInternalDatabase(dbName string) bool {
 func (h *Handler) serveSysCtrl(w http.ResponseWriter, r *http.Request, user meta2.User) {
 	h.requestTracker.Add(r, user)
 
+	var userID string
+	if user != nil {
+		userID = user.ID()
+	}
 	// Check authorization.
 	if h.Config.AuthEnabled {
 		if user == nil {
 			// no users in system
 			h.httpError(w, "error authorizing query: create admin user first or disable authentication", http.StatusForbidden)
 			h.Logger.Error("error authorizing query: create admin user first or disable authentication")
+			h.auditRequest(audit.SysCtrl, userID, r, errors.New("create admin user first or disable authentication"))
 			return
 		}
 		if !user.AuthorizeUnrestricted() {
 			h.httpError(w, "error authorizing, requires admin privilege only", http.StatusForbidden)
 			h.Logger.Error("exec error! authorizing query", zap.Any("r", r), zap.String("userID", user.ID()))
+			h.auditRequest(audit.SysCtrl, userID, r, errors.New("requires admin privilege only"))
 			return
 		}
 		h.Logger.Info("execute sys ctrl by admin user", zap.String("userID", user.ID()))
 	}
 
-	h.serveDebug(w, r)
+	err := h.serveDebug(w, r)
+	h.auditRequest(audit.SysCtrl, userID, r, err)
 }
 
 func (h *Handler) getQueryFromRequest(r *http.Request, param *QueryParam, user meta2.User) string {
@@ -883,6 +965,30 @@ func (h *Handler) updateStmtId2Result(r *query.Result, stmtID2Result map[int]*qu
 	return true
 }
 
+// estimateResultSize roughly estimates the size of a buffered result, which is limited by the max_query_result_bytes quota.
+func estimateResultSize(r *query.Result) int64 {
+	var size int64
+	for _, s := range r.Series {
+		size += int64(len(s.Name))
+		for k, v := range s.Tags {
+			size += int64(len(k) + len(v))
+		}
+		for _, c := range s.Columns {
+			size += int64(len(c))
+		}
+		for _, values := range s.Values {
+			for _, v := range values {
+				if str, ok := v.(string); ok {
+					size += int64(len(str))
+				} else {
+					size += 8
+				}
+			}
+		}
+	}
+	return size
+}
+
 func (h *Handler) getStmtResult(stmtID2Result map[int]*query.Result) Response {
 	resp := Response{Results: make([]*query.Result, 0, len(stmtID2Result))}
 	var keys []int
@@ -1067,13 +1173,26 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 		}()
 	}
 
+	var userID string
+	if user != nil {
+		userID = user.ID()
+	}
+
 	// Check authorization.
 	err = h.checkAuthorization(user, q, db)
 	if err != nil {
+		h.auditStatements(userID, r, db, q, err)
 		h.httpError(rw, "error authorizing query: "+err.Error(), http.StatusForbidden)
 		return
 	}
 
+	qt, err := h.QuotaManager.BeginQuery(userID, db)
+	if err != nil {
+		h.httpError(rw, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+	defer qt.Done()
+
 	// Parse chunk size. Use default if not provided or unparsable.
 	chunked, chunkSize, innerChunkSize, err := h.parseChunkSize(r)
 	if err != nil {
@@ -1093,6 +1212,8 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 		ParallelQuery:   atomic.LoadInt32(&syscontrol.ParallelQueryInBatch) == 1,
 		Quiet:           true,
 		Authorizer:      h.getAuthorizer(user),
+		UserName:        userID,
+		ClientAddr:      clientAddr(r),
 	}
 
 	// Make sure if the client disconnects we signal the query to abort
@@ -1167,6 +1288,10 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 		}
 
 		rows = h.getResultRowsCnt(r, rows)
+		if err := qt.Grow(estimateResultSize(r)); err != nil {
+			stmtID2Result[r.StatementID] = &query.Result{StatementID: r.StatementID, Err: err}
+			break
+		}
 		if !h.updateStmtId2Result(r, stmtID2Result) {
 			continue
 		}
@@ -1247,6 +1372,12 @@ func (h *Handler) logRowsIfNecessary(rows []influx.Row, ReqBuf []byte) {
 
 // serveWrite receives incoming series data in line protocol format and writes it to the database.
 func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	urlValues := r.URL.Query()
+	h.writeLineProtocol(w, r, user, urlValues.Get("db"), urlValues.Get("rp"), urlValues.Get("precision"))
+}
+
+// writeLineProtocol writes the line protocol of the request body to the database and retention policy.
+func (h *Handler) writeLineProtocol(w http.ResponseWriter, r *http.Request, user meta2.User, database, rp, precision string) {
 	atomic.AddInt64(&statistics.HandlerStat.WriteRequests, 1)
 	atomic.AddInt64(&statistics.HandlerStat.ActiveWriteRequests, 1)
 	atomic.AddInt64(&statistics.HandlerStat.WriteRequestBytesIn, r.ContentLength)
@@ -1268,8 +1399,6 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 		return
 	}
 
-	urlValues := r.URL.Query()
-	database := urlValues.Get("db")
 	if database == "" {
 		err := errno.NewError(errno.HttpDatabaseNotFound)
 		h.Logger.Error("serveWrite", zap.Error(err))
@@ -1286,6 +1415,8 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 		return
 	}
 
+	// seriesUser is set if the user is only granted to write some measurements or series of the database
+	var seriesUser meta2.User
 	if h.Config.AuthEnabled {
 		if user == nil {
 			h.httpError(w, fmt.Sprintf("user is required to write to database %q", database), http.StatusForbidden)
@@ -1295,7 +1426,9 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 			return
 		}
 
-		if err := h.WriteAuthorizer.AuthorizeWrite(user.ID(), database); err != nil {
+		if err := h.WriteAuthorizer.AuthorizeWrite(user.ID(), database); err != nil && user.HasMeasurementPrivilege(originql.WritePrivilege, database) {
+			seriesUser = user
+		} else if err != nil {
 			err := errno.NewError(errno.HttpForbidden)
 			h.httpError(w, fmt.Sprintf("%q user is not authorized to write to database %q", user.ID(), database), http.StatusForbidden)
 			h.Logger.Error("write error:user is not authorized to write to database", zap.Error(err), zap.String("db", database), zap.String("user", user.ID()))
@@ -1333,8 +1466,6 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 		}
 	}
 
-	precision := urlValues.Get("precision")
-
 	tsMultiplier := int64(1)
 	switch precision {
 	case "ns":
@@ -1357,7 +1488,6 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 	var numPtsParse, numPtsInsert int
 
 	readBlockSize := int(h.Config.ReadBlockSize)
-	rp := urlValues.Get("rp")
 	for ctx.Read(readBlockSize) {
 		numPtsParse++
 		uw := influx.GetUnmarshalWork()
@@ -1372,7 +1502,14 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 			if atomic.LoadInt32(&syscontrol.LogRowsRuleSwitch) == 1 {
 				h.logRowsIfNecessary(rows, uw.ReqBuf)
 			}
-			if err = h.PointsWriter.RetryWritePointRows(db, rp, rows); err != nil {
+			err = authorizeSeriesWrite(seriesUser, db, rows)
+			if err == nil {
+				err = h.allowWrite(user, db, len(rows), len(uw.ReqBuf))
+			}
+			if err == nil {
+				err = h.PointsWriter.RetryWritePointRows(db, rp, rows)
+			}
+			if err != nil {
 				ctx.ErrLock.Lock()
 				if ctx.CallbackErr == nil {
 					ctx.CallbackErr = err
@@ -1414,7 +1551,11 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 		return
 	}
 	if err := ctx.CallbackErr; err != nil {
-		if influxdb.IsClientError(err) {
+		if errno.Equal(err, errno.WriteQuotaExceeded) {
+			atomic.AddInt64(&statistics.HandlerStat.PointsWrittenFail, int64(numPtsInsert))
+			h.writeQuotaExceeded(w, err, database)
+			return
+		} else if influxdb.IsClientError(err) {
 			atomic.AddInt64(&statistics.HandlerStat.PointsWrittenFail, int64(numPtsInsert))
 			h.Logger.Error("write client error:WritePointsWithContext", zap.Error(err), zap.String("db", database))
 			h.httpError(w, err.Error(), http.StatusBadRequest)
@@ -1451,6 +1592,45 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 	h.writeHeader(w, http.StatusNoContent)
 }
 
+// authorizeSeriesWrite checks the rows against the measurement privileges of the user.
+func authorizeSeriesWrite(user meta2.User, db string, rows []influx.Row) error {
+	if user == nil {
+		return nil
+	}
+	var tags models.Tags
+	for i := range rows {
+		tags = tags[:0]
+		for _, tag := range rows[i].Tags {
+			tags = append(tags, models.NewTag([]byte(tag.Key), []byte(tag.Value)))
+		}
+		if !user.AuthorizeSeriesWrite(db, []byte(rows[i].Name), tags) {
+			return &meta2.ErrAuthorize{
+				Database: db,
+				Message:  fmt.Sprintf("%q user is not authorized to write to measurement %q of database %q", user.ID(), rows[i].Name, db),
+			}
+		}
+	}
+	return nil
+}
+
+// allowWrite checks the write quotas of the user and the database.
+// It is called by the write protocol handlers only, so internal writers such as
+// continuous queries, rules and audit logs are not throttled.
+func (h *Handler) allowWrite(user meta2.User, db string, points, bytes int) error {
+	var userID string
+	if user != nil {
+		userID = user.ID()
+	}
+	return h.QuotaManager.AllowWrite(userID, db, int64(points), int64(bytes))
+}
+
+// writeQuotaExceeded responds to a write request rejected by the quotas
+func (h *Handler) writeQuotaExceeded(w http.ResponseWriter, err error, db string) {
+	h.httpError(w, err.Error(), http.StatusTooManyRequests)
+	h.Logger.Error("write error:quota exceeded", zap.Error(err), zap.String("db", db))
+	atomic.AddInt64(&statistics.HandlerStat.Write429ErrRequests, 1)
+}
+
 // serveOptions returns an empty response to comply with OPTIONS pre-flight requests
 func (h *Handler) serveOptions(w http.ResponseWriter, r *http.Request) {
 	h.writeHeader(w, http.StatusNoContent)
@@ -1555,6 +1735,32 @@ func convertToEpoch(r *query.Result, epoch string) {
 		}
 	}
 }
+
+const (
+	// OTLPDatabaseHeader and OTLPRetentionPolicyHeader select the database and retention policy of the
+	// OTLP requests when the db and rp query parameters are not set
+	OTLPDatabaseHeader        = "X-Geminidb-Database"
+	OTLPRetentionPolicyHeader = "X-Geminidb-Retention-Policy"
+
+	otlpProtobufContentType = "application/x-protobuf"
+	otlpJSONContentType     = "application/json"
+)
+
+type otlpResponse interface {
+	MarshalProto() ([]byte, error)
+	MarshalJSON() ([]byte, error)
+}
+
+// gzipBody returns the gzip reader back to the pool on close
+type gzipBody struct {
+	*gzip.Reader
+}
+
+func (b gzipBody) Close() error {
+	PutGzipReader(b.Reader)
+	return nil
+}
+
 func (h *Handler) serveOTLP(w http.ResponseWriter, r *http.Request, user meta2.User) (io.ReadCloser, string, error) {
 	atomic.AddInt64(&statistics.HandlerStat.WriteRequests, 1)
 	atomic.AddInt64(&statistics.HandlerStat.ActiveWriteRequests, 1)
@@ -1566,7 +1772,19 @@ func (h *Handler) serveOTLP(w http.ResponseWriter, r *http.Request, user meta2.U
 	}(time.Now())
 	h.requestTracker.Add(r, user)
 
+	switch otlpContentType(r) {
+	case otlpProtobufContentType, otlpJSONContentType:
+	default:
+		err := fmt.Errorf("unsupported content type %q", r.Header.Get("Content-Type"))
+		h.httpError(w, err.Error(), http.StatusUnsupportedMediaType)
+		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		return nil, "", err
+	}
+
 	database := r.URL.Query().Get("db")
+	if database == "" {
+		database = r.Header.Get(OTLPDatabaseHeader)
+	}
 	if database == "" {
 		h.httpError(w, "database is required", http.StatusBadRequest)
 		return nil, "", fmt.Errorf("database is required")
@@ -1607,9 +1825,93 @@ func (h *Handler) serveOTLP(w http.ResponseWriter, r *http.Request, user meta2.U
 		}
 	}
 
+	// Handle gzip decoding of the body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		zr, err := GetGzipReader(body)
+		if err != nil {
+			h.httpError(w, err.Error(), http.StatusBadRequest)
+			h.Logger.Error("write otlp error: handle gzip decoding of the body err", zap.Error(err), zap.String("db", database))
+			atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+			return nil, "", err
+		}
+		return gzipBody{zr}, database, nil
+	}
+
 	return body, database, nil
 }
 
+func otlpContentType(r *http.Request) string {
+	ct := r.Header.Get("Content-Type")
+	if ct == "" {
+		return otlpProtobufContentType
+	}
+	if i := strings.IndexByte(ct, ';'); i >= 0 {
+		ct = ct[:i]
+	}
+	return strings.TrimSpace(strings.ToLower(ct))
+}
+
+// otlpContentLength returns -1 if the length of the decoded body is unknown
+func otlpContentLength(r *http.Request) int {
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		return -1
+	}
+	return int(r.ContentLength)
+}
+
+func otlpRetentionPolicy(r *http.Request) string {
+	if rp := r.URL.Query().Get("rp"); rp != "" {
+		return rp
+	}
+	return r.Header.Get(OTLPRetentionPolicyHeader)
+}
+
+// otlpError responds with the read or parse error of the OTLP request
+func (h *Handler) otlpError(w http.ResponseWriter, err error, signal, database string) {
+	if errors.Is(err, errTruncated) {
+		h.httpError(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		return
+	}
+	h.Logger.Error("write otlp "+signal+" error", zap.Error(err), zap.String("db", database))
+	h.httpError(w, err.Error(), http.StatusBadRequest)
+	atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+}
+
+// otlpQuotaWriter checks the points quotas before writing the rows converted from an OTLP request
+type otlpQuotaWriter struct {
+	h    *Handler
+	user meta2.User
+	err  error
+}
+
+func (qw *otlpQuotaWriter) RetryWritePointRows(database, retentionPolicy string, rows []influx.Row) error {
+	if err := qw.h.allowWrite(qw.user, database, len(rows), 0); err != nil {
+		qw.err = err
+		return err
+	}
+	return qw.h.PointsWriter.RetryWritePointRows(database, retentionPolicy, rows)
+}
+
+// writeOTLPResponse writes the export response encoded in the same content type as the request
+func (h *Handler) writeOTLPResponse(w http.ResponseWriter, resp otlpResponse, isJSON bool) {
+	var buf []byte
+	var err error
+	if isJSON {
+		w.Header().Set("Content-Type", otlpJSONContentType)
+		buf, err = resp.MarshalJSON()
+	} else {
+		w.Header().Set("Content-Type", otlpProtobufContentType)
+		buf, err = resp.MarshalProto()
+	}
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeHeader(w, http.StatusOK)
+	_, _ = w.Write(buf)
+}
+
 // serveTracesWrite receives data in the openTelemetry collector and writes it
 // to the database
 func (h *Handler) serveTracesWrite(w http.ResponseWriter, r *http.Request, user meta2.User) {
@@ -1617,19 +1919,30 @@ func (h *Handler) serveTracesWrite(w http.ResponseWriter, r *http.Request, user
 	if err != nil {
 		return
 	}
+	defer util.MustClose(body)
 
 	ctx := context.Background()
 
+	qw := &otlpQuotaWriter{h: h, user: user}
 	octx := opentelemetry.GetOtelContext(body)
-	octx.Writer = h.PointsWriter
+	octx.Writer = qw
 	octx.Database = database
-	octx.RetentionPolicy = r.URL.Query().Get("rp")
+	octx.RetentionPolicy = otlpRetentionPolicy(r)
+	octx.JSON = otlpContentType(r) == otlpJSONContentType
 	defer opentelemetry.PutOtelContext(octx)
 
-	for octx.Read(int(r.ContentLength)) {
+	if octx.Read(otlpContentLength(r)) {
+		if err := h.allowWrite(user, database, 0, len(octx.ReqBuf)); err != nil {
+			h.writeQuotaExceeded(w, err, database)
+			return
+		}
 		// Convert the OTLP remote write request to Influx Points
 		start := time.Now()
 		err := octx.WriteTraces(ctx)
+		if qw.err != nil {
+			h.writeQuotaExceeded(w, qw.err, database)
+			return
+		}
 		if err != nil {
 			h.Logger.Error("write otlp traces failed", zap.Error(err))
 			h.httpError(w, err.Error(), http.StatusInternalServerError)
@@ -1641,12 +1954,10 @@ func (h *Handler) serveTracesWrite(w http.ResponseWriter, r *http.Request, user
 	}
 
 	if err := octx.Error(); err != nil {
-		h.Logger.Error("write otlp traces error", zap.Error(err), zap.String("db", database))
-		h.httpError(w, err.Error(), http.StatusBadRequest)
-		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		h.otlpError(w, err, "traces", database)
 		return
 	}
-	h.writeHeader(w, http.StatusNoContent)
+	h.writeOTLPResponse(w, ptraceotlp.NewResponse(), octx.JSON)
 }
 
 // serveMetricsWrite receives OTLP metrics data and writes it to the database
@@ -1655,19 +1966,30 @@ func (h *Handler) serveMetricsWrite(w http.ResponseWriter, r *http.Request, user
 	if err != nil {
 		return
 	}
+	defer util.MustClose(body)
 
 	ctx := context.Background()
 
+	qw := &otlpQuotaWriter{h: h, user: user}
 	octx := opentelemetry.GetOtelContext(body)
-	octx.Writer = h.PointsWriter
+	octx.Writer = qw
 	octx.Database = database
-	octx.RetentionPolicy = r.URL.Query().Get("rp")
+	octx.RetentionPolicy = otlpRetentionPolicy(r)
+	octx.JSON = otlpContentType(r) == otlpJSONContentType
 	defer opentelemetry.PutOtelContext(octx)
 
-	if octx.Read(int(r.ContentLength)) {
+	if octx.Read(otlpContentLength(r)) {
+		if err := h.allowWrite(user, database, 0, len(octx.ReqBuf)); err != nil {
+			h.writeQuotaExceeded(w, err, database)
+			return
+		}
 		// Convert the OTLP remote write request to Influx Points
 		start := time.Now()
 		err := octx.WriteMetrics(ctx)
+		if qw.err != nil {
+			h.writeQuotaExceeded(w, qw.err, database)
+			return
+		}
 		if err != nil {
 			h.Logger.Error("write otlp metrics failed", zap.Error(err))
 			h.httpError(w, err.Error(), http.StatusInternalServerError)
@@ -1679,12 +2001,10 @@ func (h *Handler) serveMetricsWrite(w http.ResponseWriter, r *http.Request, user
 	}
 
 	if err := octx.Error(); err != nil {
-		h.Logger.Error("write otlp metrics error", zap.Error(err), zap.String("db", database))
-		h.httpError(w, err.Error(), http.StatusBadRequest)
-		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		h.otlpError(w, err, "metrics", database)
 		return
 	}
-	h.writeHeader(w, http.StatusNoContent)
+	h.writeOTLPResponse(w, pmetricotlp.NewResponse(), octx.JSON)
 }
 
 // serveLogsWrite receives OTLP logs data and writes it to the database
@@ -1693,19 +2013,30 @@ func (h *Handler) serveLogsWrite(w http.ResponseWriter, r *http.Request, user me
 	if err != nil {
 		return
 	}
+	defer util.MustClose(body)
 
 	ctx := context.Background()
 
+	qw := &otlpQuotaWriter{h: h, user: user}
 	octx := opentelemetry.GetOtelContext(body)
-	octx.Writer = h.PointsWriter
+	octx.Writer = qw
 	octx.Database = database
-	octx.RetentionPolicy = r.URL.Query().Get("rp")
+	octx.RetentionPolicy = otlpRetentionPolicy(r)
+	octx.JSON = otlpContentType(r) == otlpJSONContentType
 	defer opentelemetry.PutOtelContext(octx)
 
-	for octx.Read(int(r.ContentLength)) {
+	if octx.Read(otlpContentLength(r)) {
+		if err := h.allowWrite(user, database, 0, len(octx.ReqBuf)); err != nil {
+			h.writeQuotaExceeded(w, err, database)
+			return
+		}
 		// Convert the OTLP remote write request to Influx Points
 		start := time.Now()
 		err := octx.WriteLogs(ctx)
+		if qw.err != nil {
+			h.writeQuotaExceeded(w, qw.err, database)
+			return
+		}
 		if err != nil {
 			h.Logger.Error("write otlp logs failed", zap.Error(err))
 			h.httpError(w, err.Error(), http.StatusInternalServerError)
@@ -1717,12 +2048,10 @@ func (h *Handler) serveLogsWrite(w http.ResponseWriter, r *http.Request, user me
 	}
 
 	if err := octx.Error(); err != nil {
-		h.Logger.Error("write otlp logs error", zap.Error(err), zap.String("db", database))
-		h.httpError(w, err.Error(), http.StatusBadRequest)
-		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		h.otlpError(w, err, "logs", database)
 		return
 	}
-	h.writeHeader(w, http.StatusNoContent)
+	h.writeOTLPResponse(w, plogotlp.NewResponse(), octx.JSON)
 }
 
 func (h *Handler) serveFluxQuery(w http.ResponseWriter, r *http.Request, user meta2.User) {
@@ -1914,9 +2243,14 @@ func authenticate(inner func(http.ResponseWriter, *http.Request, meta2.User), h
 			creds, err := ParseCredentials(r)
 			if err != nil {
 				atomic.AddInt64(&statistics.HandlerStat.AuthenticationFailures, 1)
+				h.auditRequest(audit.Authentication, "", r, err)
 				h.httpError(w, err.Error(), http.StatusUnauthorized)
 				return
 			}
+			authFailed := func(msg string) {
+				h.auditRequest(audit.Authentication, creds.Username, r, errors.New(msg))
+				h.httpError(w, msg, http.StatusUnauthorized)
+			}
 
 			switch creds.Method {
 			case UserAuthentication:
@@ -1926,7 +2260,7 @@ func authenticate(inner func(http.ResponseWriter, *http.Request, meta2.User), h
 					err := errno.NewError(errno.HttpUnauthorized)
 					log := logger.NewLogger(errno.ModuleHTTP)
 					log.Error(errMsg, zap.Error(err))
-					h.httpError(w, errMsg, http.StatusUnauthorized)
+					authFailed(errMsg)
 					return
 				}
 
@@ -1940,13 +2274,13 @@ func authenticate(inner func(http.ResponseWriter, *http.Request, meta2.User), h
 					err := errno.NewError(errno.HttpUnauthorized)
 					log := logger.NewLogger(errno.ModuleHTTP)
 					log.Error(errMsg, zap.Error(err))
-					h.httpError(w, errMsg, http.StatusUnauthorized)
+					authFailed(errMsg)
 					return
 				}
 			case BearerAuthentication:
 				if h.Config.SharedSecret == "" {
 					atomic.AddInt64(&statistics.HandlerStat.AuthenticationFailures, 1)
-					h.httpError(w, ErrBearerAuthDisabled.Error(), http.StatusUnauthorized)
+					authFailed(ErrBearerAuthDisabled.Error())
 					return
 				}
 				keyLookupFn := func(token *jwt.Token) (interface{}, error) {
@@ -1960,10 +2294,10 @@ func authenticate(inner func(http.ResponseWriter, *http.Request, meta2.User), h
 				// Parse and validate the token.
 				token, err := jwt.Parse(creds.Token, keyLookupFn)
 				if err != nil {
-					h.httpError(w, err.Error(), http.StatusUnauthorized)
+					authFailed(err.Error())
 					return
 				} else if !token.Valid {
-					h.httpError(w, "invalid token", http.StatusUnauthorized)
+					authFailed("invalid token")
 					return
 				}
 
@@ -1976,30 +2310,32 @@ func authenticate(inner func(http.ResponseWriter, *http.Request, meta2.User), h
 
 				// Make sure an expiration was set on the token.
 				if exp, ok := claims["exp"].(float64); !ok || exp <= 0.0 {
-					h.httpError(w, "token expiration required", http.StatusUnauthorized)
+					authFailed("token expiration required")
 					return
 				}
 
 				// Get the username from the token.
 				username, ok := claims["username"].(string)
 				if !ok {
-					h.httpError(w, "username in token must be a string", http.StatusUnauthorized)
+					authFailed("username in token must be a string")
 					return
 				} else if username == "" {
-					h.httpError(w, "token must contain a username", http.StatusUnauthorized)
+					authFailed("token must contain a username")
 					return
 				}
 
 				// Lookup user in the metastore.
 				if user, err = h.MetaClient.User(username); err != nil {
+					h.auditRequest(audit.Authentication, username, r, err)
 					h.httpError(w, err.Error(), http.StatusUnauthorized)
 					return
 				} else if user == nil {
+					h.auditRequest(audit.Authentication, username, r, meta2.ErrUserNotFound)
 					h.httpError(w, meta2.ErrUserNotFound.Error(), http.StatusUnauthorized)
 					return
 				}
 			default:
-				h.httpError(w, "unsupported authentication", http.StatusUnauthorized)
+				authFailed("unsupported authentication")
 			}
 
 		}
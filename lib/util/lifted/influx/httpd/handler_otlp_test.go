@@ -0,0 +1,227 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/services/httpd"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/quota"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+)
+
+type mockOTLPMetaClient struct {
+	mockMetaClient
+}
+
+func (mockOTLPMetaClient) Database(name string) (*meta.DatabaseInfo, error) {
+	if name != "db0" {
+		return nil, fmt.Errorf("database not found: %s", name)
+	}
+	return &meta.DatabaseInfo{Name: name}, nil
+}
+
+func (mockOTLPMetaClient) GetShardGroupByTimeRange(repoName, streamName string, min, max time.Time) ([]*meta.ShardGroupInfo, error) {
+	return nil, nil
+}
+
+func (mockOTLPMetaClient) RevertRetentionPolicyDelete(database, name string) error {
+	return nil
+}
+
+func (mockOTLPMetaClient) User(username string) (meta.User, error) {
+	return nil, nil
+}
+
+func (mockOTLPMetaClient) TagArrayEnabled(db string) bool {
+	return false
+}
+
+func (mockOTLPMetaClient) UpdateMeasurement(db, rp, mst string, options *meta.Options) error {
+	return nil
+}
+
+type mockOTLPPointsWriter struct {
+	rp   string
+	rows influx.Rows
+}
+
+func (w *mockOTLPPointsWriter) RetryWritePointRows(database, retentionPolicy string, points []influx.Row) error {
+	w.rp = retentionPolicy
+	w.rows = append(w.rows, points...)
+	return nil
+}
+
+func newOTLPHandler(w *mockOTLPPointsWriter) *Handler {
+	return &Handler{
+		requestTracker: httpd.NewRequestTracker(),
+		Logger:         logger.NewLogger(errno.ModuleHTTP),
+		Config:         &config.Config{},
+		MetaClient:     &mockOTLPMetaClient{},
+		PointsWriter:   w,
+	}
+}
+
+func TestHandler_OTLP_Traces(t *testing.T) {
+	req := ptraceotlp.NewRequest()
+	span := req.Traces().ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("test_span")
+	span.SetTraceID(pcommon.NewTraceID([16]byte{1}))
+	span.SetSpanID(pcommon.NewSpanID([8]byte{2}))
+	span.SetStartTimestamp(1678898440000000000)
+	span.SetEndTimestamp(1678898440000001000)
+	buf, err := req.MarshalProto()
+	require.NoError(t, err)
+
+	pw := &mockOTLPPointsWriter{}
+	h := newOTLPHandler(pw)
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/v1/traces", bytes.NewReader(buf))
+	r.Header.Set("Content-Type", "application/x-protobuf")
+	r.Header.Set(OTLPDatabaseHeader, "db0")
+	r.Header.Set(OTLPRetentionPolicyHeader, "rp0")
+	h.serveTracesWrite(w, r, nil)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/x-protobuf", w.Header().Get("Content-Type"))
+	assert.Equal(t, "rp0", pw.rp)
+	assert.NotEqual(t, 0, len(pw.rows))
+}
+
+func TestHandler_OTLP_MetricsJSONGzip(t *testing.T) {
+	data := `{"resourceMetrics":[{"resource":{},"scopeMetrics":[{"scope":{},"metrics":[{"name":"test_metric","gauge":{"dataPoints":[{"startTimeUnixNano":"1678812040000000000","timeUnixNano":"1678898440000000000","asInt":"1314520"}]}}]}]}]}`
+	var body bytes.Buffer
+	zw := gzip.NewWriter(&body)
+	_, err := zw.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	pw := &mockOTLPPointsWriter{}
+	h := newOTLPHandler(pw)
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/v1/metrics?db=db0&rp=rp1", &body)
+	r.Header.Set("Content-Type", "application/json; charset=utf-8")
+	r.Header.Set("Content-Encoding", "gzip")
+	h.serveMetricsWrite(w, r, nil)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.NoError(t, pmetricotlp.NewResponse().UnmarshalJSON(w.Body.Bytes()))
+	assert.Equal(t, "rp1", pw.rp)
+	assert.NotEqual(t, 0, len(pw.rows))
+}
+
+func TestHandler_OTLP_Logs(t *testing.T) {
+	req := plogotlp.NewRequest()
+	lr := req.Logs().ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(1678898440000000000)
+	lr.Body().SetStringVal("hello")
+	buf, err := req.MarshalProto()
+	require.NoError(t, err)
+
+	pw := &mockOTLPPointsWriter{}
+	h := newOTLPHandler(pw)
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/v1/logs?db=db0", bytes.NewReader(buf))
+	r.Header.Set("Content-Type", "application/x-protobuf")
+	h.serveLogsWrite(w, r, nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotEqual(t, 0, len(pw.rows))
+
+	// invalid body
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodPost, "/v1/logs?db=db0", bytes.NewReader([]byte("invalid")))
+	r.Header.Set("Content-Type", "application/json")
+	h.serveLogsWrite(w, r, nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_OTLP_WriteQuota(t *testing.T) {
+	req := plogotlp.NewRequest()
+	lr := req.Logs().ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(1678898440000000000)
+	lr.Body().SetStringVal("hello")
+	buf, err := req.MarshalProto()
+	require.NoError(t, err)
+
+	pw := &mockOTLPPointsWriter{}
+	h := newOTLPHandler(pw)
+	h.QuotaManager = quota.NewManager(&mockQuotaSource{dbs: map[string]*meta.QuotaInfo{"db0": {PointsPerSecond: 1}}})
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/v1/logs?db=db0", bytes.NewReader(buf))
+	h.serveLogsWrite(w, r, nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	n := len(pw.rows)
+	assert.NotEqual(t, 0, n)
+
+	write400, write429 := statistics.HandlerStat.Write400ErrRequests, statistics.HandlerStat.Write429ErrRequests
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodPost, "/v1/logs?db=db0", bytes.NewReader(buf))
+	h.serveLogsWrite(w, r, nil)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, n, len(pw.rows))
+	assert.Equal(t, write400, statistics.HandlerStat.Write400ErrRequests)
+	assert.Equal(t, write429+1, statistics.HandlerStat.Write429ErrRequests)
+}
+
+func TestHandler_OTLP_BadRequest(t *testing.T) {
+	h := newOTLPHandler(&mockOTLPPointsWriter{})
+
+	// database is required
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/v1/traces", nil)
+	h.serveTracesWrite(w, r, nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// database not found
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodPost, "/v1/traces?db=db1", nil)
+	h.serveTracesWrite(w, r, nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodPost, "/v1/traces?db=db0", nil)
+	r.Header.Set("Content-Type", "text/plain")
+	h.serveTracesWrite(w, r, nil)
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+
+	// the body is too large
+	h.Config.MaxBodySize = 8
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodPost, "/v1/traces?db=db0", bytes.NewReader(make([]byte, 16)))
+	r.ContentLength = -1
+	h.serveTracesWrite(w, r, nil)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
@@ -27,8 +27,6 @@ import (
 	"sync/atomic"
 	"time"
 
-	prompb2 "github.com/VictoriaMetrics/VictoriaMetrics/lib/prompb"
-	Parser "github.com/VictoriaMetrics/VictoriaMetrics/lib/protoparser/promremotewrite"
 	"github.com/golang/snappy"
 	"github.com/influxdata/influxdb"
 	"github.com/influxdata/influxdb/models"
@@ -113,21 +111,38 @@ func (h *Handler) servePromWriteBase(w http.ResponseWriter, r *http.Request, use
 		}
 	}
 
-	err := Parser.ParseStream(body, func(tss []prompb2.TimeSeries) error {
+	protoMsg, err := promWriteProtoMsg(r.Header.Get("Content-Type"))
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var samples, histograms, exemplars int
+	err = parsePromWriteRequest(body, protoMsg, func(wr *promWriteRequest) error {
+		if err := h.writePromMetadata(db, rp, wr.Metadata); err != nil {
+			h.Logger.Error("write prometheus metric metadata failed", zap.String("db", db), zap.Error(err))
+		}
+
 		var maxPoints int
 		var err error
-		for _, ts := range tss {
+		for _, ts := range wr.Timeseries {
 			maxPoints += len(ts.Samples)
 		}
+		maxPoints += wr.exemplars
 
 		rs := pool.GetRows(maxPoints)
 		defer pool.PutRows(rs)
-		*rs, err = tansFunc(mst, *rs, tss)
+		*rs, err = tansFunc(mst, *rs, wr.Timeseries)
 		if err != nil {
 			h.httpError(w, err.Error(), http.StatusBadRequest)
 			return err
 		}
+		*rs = appendPromExemplarRows(*rs, wr.Exemplars)
 
+		if err = h.allowWrite(user, db, len(*rs), wr.size); err != nil {
+			h.writeQuotaExceeded(w, err, db)
+			return err
+		}
 		if err = h.PointsWriter.RetryWritePointRows(db, rp, *rs); influxdb.IsClientError(err) {
 			h.httpError(w, err.Error(), http.StatusBadRequest)
 		} else if influxdb.IsAuthorizationError(err) {
@@ -135,6 +150,7 @@ func (h *Handler) servePromWriteBase(w http.ResponseWriter, r *http.Request, use
 		} else if err != nil {
 			h.httpError(w, err.Error(), http.StatusInternalServerError)
 		}
+		samples, histograms, exemplars = wr.samples, wr.histograms, wr.exemplars
 		return err
 	})
 
@@ -142,6 +158,11 @@ func (h *Handler) servePromWriteBase(w http.ResponseWriter, r *http.Request, use
 		h.httpError(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	if protoMsg == promRemoteWriteV2 {
+		w.Header().Set(promWrittenSamplesHeader, strconv.Itoa(samples))
+		w.Header().Set(promWrittenHistogramsHeader, strconv.Itoa(histograms))
+		w.Header().Set(promWrittenExemplarsHeader, strconv.Itoa(exemplars))
+	}
 	h.writeHeader(w, http.StatusNoContent)
 }
 
@@ -449,6 +470,17 @@ func (h *Handler) servePromBaseQuery(w http.ResponseWriter, r *http.Request, use
 		return
 	}
 
+	var userID string
+	if user != nil {
+		userID = user.ID()
+	}
+	qt, err := h.QuotaManager.BeginQuery(userID, db)
+	if err != nil {
+		respondError(w, &apiError{errorQuotaExceeded, err}, nil)
+		return
+	}
+	defer qt.Done()
+
 	// PromQL2InfluxQL: there are two conversion methods.
 	// Method 1: is to convert the AST of the Promql to the Influxql query string and then perform the Influxql parsing.
 	// Method 2: is to directly convert the AST of the Promql to the AST of the Influxql.
@@ -508,6 +540,7 @@ func (h *Handler) servePromBaseQuery(w http.ResponseWriter, r *http.Request, use
 		Quiet:           true,
 		Authorizer:      h.getAuthorizer(user),
 		IsPromQuery:     true,
+		MaxPointN:       h.Config.PromMaxSamples,
 	}
 
 	// Make sure if the client disconnects we signal the query to abort
@@ -540,23 +573,26 @@ func (h *Handler) servePromBaseQuery(w http.ResponseWriter, r *http.Request, use
 		return
 	}
 
+	// The matrix result is written series by series without being materialised.
+	if canStreamPromResult(rw, expr, promCommand) {
+		receiver := &promql2influxql.Receiver{DropMetric: transpiler.DropMetric(), RemoveTableName: transpiler.RemoveTableName()}
+		h.streamPromResult(rw, resultCh, promCommand, receiver, qt)
+		return
+	}
+
 	// if we're not chunking, this will be the in memory buffer for all results before sending to client
 	stmtID2Result := make(map[int]*query.Result)
 
-	// Status header is OK once this point is reached.
-	// Attempt to flush the header immediately so the client gets the header information
-	// and knows the query was accepted.
-	h.writeHeader(rw, http.StatusOK)
-	if w, ok := w.(http.Flusher); ok {
-		w.Flush()
-	}
-
 	// pull all results from the channel
 	for result := range resultCh {
 		// Ignore nil results.
 		if result == nil {
 			continue
 		}
+		if err := qt.Grow(estimateResultSize(result)); err != nil {
+			respondError(w, &apiError{errorQuotaExceeded, err}, nil)
+			return
+		}
 		if !h.updateStmtId2Result(result, stmtID2Result) {
 			continue
 		}
@@ -567,6 +603,9 @@ func (h *Handler) servePromBaseQuery(w http.ResponseWriter, r *http.Request, use
 	if !ok {
 		return
 	}
+	// The status header is written after the results are collected, so the errors above are
+	// responded with the proper status code.
+	h.writeHeader(rw, http.StatusOK)
 	n, _ := rw.WritePromResponse(resp)
 	atomic.AddInt64(&statistics.HandlerStat.QueryRequestBytesTransmitted, int64(n))
 }
@@ -798,15 +837,6 @@ func (h *Handler) servePromBaseMetaQuery(w http.ResponseWriter, r *http.Request,
 	return stmtID2Result, true
 }
 
-// servePromQueryMetaData Executes a metadata query of the PromQL and returns the query result.
-func (h *Handler) servePromQueryMetaData(w http.ResponseWriter, r *http.Request, user meta2.User) {
-	// TODO query metadata
-}
-
-// servePromQueryMetaDataWithMetricStore Executes a metadata query of the PromQL and returns the query result.
-func (h *Handler) servePromQueryMetaDataWithMetricStore(w http.ResponseWriter, r *http.Request, user meta2.User) {
-}
-
 type PromTimeValuer struct {
 	tmpTime int64
 }
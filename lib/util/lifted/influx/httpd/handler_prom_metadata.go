@@ -0,0 +1,244 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/prometheus/prometheus/prompb"
+)
+
+const (
+	// PromMetadataMeasurement stores the metric metadata sent by the Prometheus remote write.
+	// It is hidden from the measurement lists, so it never shows up in the metric names.
+	PromMetadataMeasurement = meta2.PromMetadataMeasurement
+
+	promMetadataMetric = "metric"
+	promMetadataType   = "type"
+	promMetadataHelp   = "help"
+	promMetadataUnit   = "unit"
+)
+
+// promMetadataCache remembers the last written metadata of each metric, so that the metadata
+// resent periodically by Prometheus is only stored when it changes.
+type promMetadataCache struct {
+	mu    sync.Mutex
+	items map[string]string
+}
+
+func newPromMetadataCache() *promMetadataCache {
+	return &promMetadataCache{items: make(map[string]string)}
+}
+
+// update records the metadata and returns whether it is different from the last one.
+func (c *promMetadataCache) update(db, rp string, md *prompb.MetricMetadata) bool {
+	if c == nil {
+		return true
+	}
+	key := db + "\x00" + rp + "\x00" + md.MetricFamilyName
+	value := promMetadataTypeName(md.Type) + "\x00" + md.Help + "\x00" + md.Unit
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.items[key] == value {
+		return false
+	}
+	c.items[key] = value
+	return true
+}
+
+// forget removes the metadata, so that they will be written again.
+func (c *promMetadataCache) forget(db, rp string, mds []prompb.MetricMetadata) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := range mds {
+		delete(c.items, db+"\x00"+rp+"\x00"+mds[i].MetricFamilyName)
+	}
+}
+
+func promMetadataTypeName(typ prompb.MetricMetadata_MetricType) string {
+	return strings.ToLower(typ.String())
+}
+
+// writePromMetadata stores the changed metric metadata into PromMetadataMeasurement.
+func (h *Handler) writePromMetadata(db, rp string, mds []prompb.MetricMetadata) error {
+	var changed []prompb.MetricMetadata
+	for i := range mds {
+		if mds[i].MetricFamilyName != "" && h.promMetadata.update(db, rp, &mds[i]) {
+			changed = append(changed, mds[i])
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	// all the metadata belongs to one series, so every row gets its own timestamp.
+	now := time.Now().UnixNano()
+	rows := make([]influx.Row, 0, len(changed))
+	for i := range changed {
+		rows = append(rows, influx.Row{
+			Name:      PromMetadataMeasurement,
+			Timestamp: now + int64(i),
+			Fields: []influx.Field{
+				{Key: promMetadataHelp, Type: influx.Field_Type_String, StrValue: changed[i].Help},
+				{Key: promMetadataMetric, Type: influx.Field_Type_String, StrValue: changed[i].MetricFamilyName},
+				{Key: promMetadataType, Type: influx.Field_Type_String, StrValue: promMetadataTypeName(changed[i].Type)},
+				{Key: promMetadataUnit, Type: influx.Field_Type_String, StrValue: changed[i].Unit},
+			},
+		})
+	}
+	if err := h.PointsWriter.RetryWritePointRows(db, rp, rows); err != nil {
+		h.promMetadata.forget(db, rp, changed)
+		return err
+	}
+	return nil
+}
+
+// promMetadata is the metadata of one metric returned by the /api/v1/metadata.
+type promMetadata struct {
+	Type string `json:"type"`
+	Help string `json:"help"`
+	Unit string `json:"unit"`
+}
+
+// servePromQueryMetaData Executes a metadata query of the PromQL and returns the query result.
+func (h *Handler) servePromQueryMetaData(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	h.servePromQueryMetaDataBase(w, r, user, &promQueryParam{getMetaQuery: getMetadataQuery})
+}
+
+// servePromQueryMetaDataWithMetricStore Executes a metadata query of the PromQL and returns the query result.
+func (h *Handler) servePromQueryMetaDataWithMetricStore(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	mst, ok := getMstByProm(h, w, r)
+	if !ok {
+		return
+	}
+	h.servePromQueryMetaDataBase(w, r, user, &promQueryParam{mst: mst, getMetaQuery: getMetadataQuery})
+}
+
+// servePromQueryMetaDataBase returns the latest metadata of each metric stored by the remote write.
+func (h *Handler) servePromQueryMetaDataBase(w http.ResponseWriter, r *http.Request, user meta2.User, p *promQueryParam) {
+	limit := -1
+	if s := r.FormValue("limit"); s != "" {
+		var err error
+		if limit, err = strconv.Atoi(s); err != nil {
+			respondError(w, &apiError{errorBadData, fmt.Errorf("limit must be a number")}, nil)
+			return
+		}
+	}
+
+	rw, ok := w.(ResponseWriter)
+	if !ok {
+		rw = NewResponseWriter(w, r)
+	}
+	stmtID2Result, ok := h.servePromBaseMetaQuery(w, r, user, p)
+	if !ok {
+		return
+	}
+	data, err := influxResultToPromMetadata(stmtID2Result[0], limit)
+	if err != nil {
+		respondError(w, &apiError{errorExec, err}, nil)
+		return
+	}
+
+	n, _ := rw.WritePromResponse(PromResponse{Status: "success", Data: data})
+	atomic.AddInt64(&statistics.HandlerStat.QueryRequestBytesTransmitted, int64(n))
+}
+
+func getMetadataQuery(r *http.Request, _ http.ResponseWriter, _ string) (*influxql.Query, bool) {
+	db, rp := getDbRpByProm(r)
+	stmt := &influxql.SelectStatement{
+		Sources: influxql.Sources{&influxql.Measurement{
+			Database:        db,
+			RetentionPolicy: rp,
+			Name:            PromMetadataMeasurement,
+		}},
+		OmitTime: true,
+	}
+	for _, name := range []string{promMetadataMetric, promMetadataType, promMetadataHelp, promMetadataUnit} {
+		stmt.Fields = append(stmt.Fields, &influxql.Field{Expr: &influxql.VarRef{Val: name, Type: influxql.String}})
+	}
+	if metric := r.FormValue("metric"); metric != "" {
+		stmt.Condition = &influxql.BinaryExpr{
+			Op:  influxql.EQ,
+			LHS: &influxql.VarRef{Val: promMetadataMetric, Type: influxql.String},
+			RHS: &influxql.StringLiteral{Val: metric},
+		}
+	}
+	return &influxql.Query{Statements: influxql.Statements{stmt}}, true
+}
+
+// influxResultToPromMetadata converts the rows of PromMetadataMeasurement to the response of /api/v1/metadata,
+// the rows are in time order, so the last metadata of each metric is the latest one.
+func influxResultToPromMetadata(result *query.Result, limit int) (map[string][]promMetadata, error) {
+	data := make(map[string][]promMetadata)
+	if result == nil {
+		return data, nil
+	}
+	if result.Err != nil {
+		return nil, result.Err
+	}
+	for _, row := range result.Series {
+		index := make(map[string]int, len(row.Columns))
+		for i, col := range row.Columns {
+			index[col] = i
+		}
+		get := func(values []interface{}, name string) string {
+			i, ok := index[name]
+			if !ok || i >= len(values) {
+				return ""
+			}
+			s, _ := values[i].(string)
+			return s
+		}
+		for _, values := range row.Values {
+			metric := get(values, promMetadataMetric)
+			if metric == "" {
+				continue
+			}
+			data[metric] = []promMetadata{{
+				Type: get(values, promMetadataType),
+				Help: get(values, promMetadataHelp),
+				Unit: get(values, promMetadataUnit),
+			}}
+		}
+	}
+	if limit >= 0 && len(data) > limit {
+		metrics := make([]string, 0, len(data))
+		for metric := range data {
+			metrics = append(metrics, metric)
+		}
+		sort.Strings(metrics)
+		for _, metric := range metrics[limit:] {
+			delete(data, metric)
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"fmt"
+	"net/http"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/services/rule"
+)
+
+// servePromRules returns the rule groups and the state of their rules.
+func (h *Handler) servePromRules(w http.ResponseWriter, r *http.Request, _ meta2.User) {
+	typ := r.FormValue("type")
+	if typ != "" && typ != "alert" && typ != "record" {
+		respondError(w, &apiError{errorBadData, fmt.Errorf("not supported value %q", typ)}, nil)
+		return
+	}
+	if h.RuleManager == nil {
+		h.writePromData(w, r, &rule.RuleDiscovery{RuleGroups: []*rule.RuleGroup{}})
+		return
+	}
+	h.writePromData(w, r, h.RuleManager.Rules(typ))
+}
+
+// servePromAlerts returns the active alerts.
+func (h *Handler) servePromAlerts(w http.ResponseWriter, r *http.Request, _ meta2.User) {
+	if h.RuleManager == nil {
+		h.writePromData(w, r, &rule.AlertDiscovery{Alerts: []*rule.Alert{}})
+		return
+	}
+	h.writePromData(w, r, h.RuleManager.Alerts())
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/services/rule"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRuleManager struct {
+	typ string
+}
+
+func (m *mockRuleManager) Rules(typ string) *rule.RuleDiscovery {
+	m.typ = typ
+	return &rule.RuleDiscovery{RuleGroups: []*rule.RuleGroup{{
+		Name: "up",
+		File: "rules.yml",
+		Rules: []interface{}{&rule.RecordingRule{
+			Name:   "job:up:sum",
+			Query:  "sum by(job) (up)",
+			Health: "ok",
+			Type:   rule.RuleTypeRecording,
+		}},
+	}}}
+}
+
+func (m *mockRuleManager) Alerts() *rule.AlertDiscovery {
+	return &rule.AlertDiscovery{Alerts: []*rule.Alert{{
+		Labels: labels.FromStrings("alertname", "InstanceDown"),
+		State:  "firing",
+		Value:  "0e+00",
+	}}}
+}
+
+func TestHandler_Prom_Rules(t *testing.T) {
+	h := newOTLPHandler(&mockOTLPPointsWriter{})
+	var user meta.User
+
+	w := httptest.NewRecorder()
+	h.servePromRules(w, httptest.NewRequest(http.MethodGet, "/api/v1/rules", nil), user)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"data":{"groups":[]}`)
+
+	w = httptest.NewRecorder()
+	h.servePromAlerts(w, httptest.NewRequest(http.MethodGet, "/api/v1/alerts", nil), user)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"data":{"alerts":[]}`)
+
+	m := &mockRuleManager{}
+	h.RuleManager = m
+	w = httptest.NewRecorder()
+	h.servePromRules(w, httptest.NewRequest(http.MethodGet, "/api/v1/rules?type=record", nil), user)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "record", m.typ)
+	assert.Contains(t, w.Body.String(), `"name":"job:up:sum"`)
+	assert.Contains(t, w.Body.String(), `"type":"recording"`)
+
+	w = httptest.NewRecorder()
+	h.servePromRules(w, httptest.NewRequest(http.MethodGet, "/api/v1/rules?type=foo", nil), user)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	h.servePromAlerts(w, httptest.NewRequest(http.MethodGet, "/api/v1/alerts", nil), user)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"state":"firing"`)
+	assert.Contains(t, w.Body.String(), `"alertname":"InstanceDown"`)
+}
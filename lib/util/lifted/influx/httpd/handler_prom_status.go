@@ -0,0 +1,202 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// defaultTSDBStatusLimit is the number of metrics reported by /api/v1/status/tsdb by default.
+const defaultTSDBStatusLimit = 10
+
+// promBuildInfo is the response of /api/v1/status/buildinfo.
+type promBuildInfo struct {
+	Version   string `json:"version"`
+	Revision  string `json:"revision"`
+	Branch    string `json:"branch"`
+	BuildUser string `json:"buildUser"`
+	BuildDate string `json:"buildDate"`
+	GoVersion string `json:"goVersion"`
+}
+
+// promRuntimeInfo is the response of /api/v1/status/runtimeinfo.
+type promRuntimeInfo struct {
+	StartTime           time.Time `json:"startTime"`
+	CWD                 string    `json:"CWD"`
+	ReloadConfigSuccess bool      `json:"reloadConfigSuccess"`
+	LastConfigTime      time.Time `json:"lastConfigTime"`
+	CorruptionCount     int64     `json:"corruptionCount"`
+	GoroutineCount      int       `json:"goroutineCount"`
+	GOMAXPROCS          int       `json:"GOMAXPROCS"`
+	GOGC                string    `json:"GOGC"`
+	GODEBUG             string    `json:"GODEBUG"`
+	StorageRetention    string    `json:"storageRetention"`
+}
+
+type promTSDBStat struct {
+	Name  string `json:"name"`
+	Value uint64 `json:"value"`
+}
+
+type promHeadStats struct {
+	NumSeries  uint64 `json:"numSeries"`
+	ChunkCount int64  `json:"chunkCount"`
+	MinTime    int64  `json:"minTime"`
+	MaxTime    int64  `json:"maxTime"`
+}
+
+// promTSDBStatus is the response of /api/v1/status/tsdb.
+type promTSDBStatus struct {
+	HeadStats                   promHeadStats  `json:"headStats"`
+	SeriesCountByMetricName     []promTSDBStat `json:"seriesCountByMetricName"`
+	LabelValueCountByLabelName  []promTSDBStat `json:"labelValueCountByLabelName"`
+	MemoryInBytesByLabelName    []promTSDBStat `json:"memoryInBytesByLabelName"`
+	SeriesCountByLabelValuePair []promTSDBStat `json:"seriesCountByLabelValuePair"`
+}
+
+// servePromBuildInfo returns the build information of ts-sql.
+func (h *Handler) servePromBuildInfo(w http.ResponseWriter, r *http.Request, _ meta2.User) {
+	h.writePromData(w, r, promBuildInfo{
+		Version:   h.Version,
+		Revision:  h.Commit,
+		Branch:    h.Branch,
+		BuildDate: h.BuildTime,
+		GoVersion: runtime.Version(),
+	})
+}
+
+// servePromRuntimeInfo returns the runtime information of ts-sql.
+func (h *Handler) servePromRuntimeInfo(w http.ResponseWriter, r *http.Request, _ meta2.User) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		cwd = err.Error()
+	}
+	h.writePromData(w, r, promRuntimeInfo{
+		StartTime:           h.startTime,
+		CWD:                 cwd,
+		ReloadConfigSuccess: true,
+		LastConfigTime:      h.startTime,
+		GoroutineCount:      runtime.NumGoroutine(),
+		GOMAXPROCS:          runtime.GOMAXPROCS(0),
+		GOGC:                os.Getenv("GOGC"),
+		GODEBUG:             os.Getenv("GODEBUG"),
+	})
+}
+
+// servePromFormatQuery returns the PromQL expression in the canonical format.
+func (h *Handler) servePromFormatQuery(w http.ResponseWriter, r *http.Request, _ meta2.User) {
+	expr, err := parser.ParseExpr(r.FormValue("query"))
+	if err != nil {
+		respondError(w, &apiError{errorBadData, err}, nil)
+		return
+	}
+	h.writePromData(w, r, expr.String())
+}
+
+// servePromTSDBStatus returns the series count of the top metrics, which comes from the series index of the database.
+func (h *Handler) servePromTSDBStatus(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	limit := defaultTSDBStatusLimit
+	if s := r.FormValue("limit"); s != "" {
+		var err error
+		if limit, err = strconv.Atoi(s); err != nil || limit <= 0 {
+			respondError(w, &apiError{errorBadData, fmt.Errorf("limit must be a positive number")}, nil)
+			return
+		}
+	}
+
+	stmtID2Result, ok := h.servePromBaseMetaQuery(w, r, user, &promQueryParam{getMetaQuery: getTSDBStatusQuery})
+	if !ok {
+		return
+	}
+	status, err := influxResultToTSDBStatus(stmtID2Result[0], limit)
+	if err != nil {
+		respondError(w, &apiError{errorExec, err}, nil)
+		return
+	}
+	h.writePromData(w, r, status)
+}
+
+func getTSDBStatusQuery(r *http.Request, _ http.ResponseWriter, _ string) (*influxql.Query, bool) {
+	db, _ := getDbRpByProm(r)
+	stmt := &influxql.ShowSeriesCardinalityStatement{Database: db, Exact: true}
+	return &influxql.Query{Statements: influxql.Statements{stmt}}, true
+}
+
+// influxResultToTSDBStatus converts the result of SHOW SERIES EXACT CARDINALITY to the tsdb status.
+func influxResultToTSDBStatus(result *query.Result, limit int) (*promTSDBStatus, error) {
+	status := &promTSDBStatus{
+		SeriesCountByMetricName:     []promTSDBStat{},
+		LabelValueCountByLabelName:  []promTSDBStat{},
+		MemoryInBytesByLabelName:    []promTSDBStat{},
+		SeriesCountByLabelValuePair: []promTSDBStat{},
+	}
+	if result == nil {
+		return status, nil
+	}
+	if result.Err != nil {
+		return nil, result.Err
+	}
+	for _, row := range result.Series {
+		if row.Name == PromMetadataMeasurement || len(row.Values) == 0 || len(row.Values[0]) == 0 {
+			continue
+		}
+		var count uint64
+		switch v := row.Values[0][0].(type) {
+		case uint64:
+			count = v
+		case int64:
+			count = uint64(v)
+		default:
+			continue
+		}
+		status.HeadStats.NumSeries += count
+		status.SeriesCountByMetricName = append(status.SeriesCountByMetricName, promTSDBStat{Name: row.Name, Value: count})
+	}
+	sort.Slice(status.SeriesCountByMetricName, func(i, j int) bool {
+		a, b := status.SeriesCountByMetricName[i], status.SeriesCountByMetricName[j]
+		if a.Value != b.Value {
+			return a.Value > b.Value
+		}
+		return a.Name < b.Name
+	})
+	if len(status.SeriesCountByMetricName) > limit {
+		status.SeriesCountByMetricName = status.SeriesCountByMetricName[:limit]
+	}
+	return status, nil
+}
+
+func (h *Handler) writePromData(w http.ResponseWriter, r *http.Request, data interface{}) {
+	rw, ok := w.(ResponseWriter)
+	if !ok {
+		rw = NewResponseWriter(w, r)
+	}
+	n, _ := rw.WritePromResponse(PromResponse{Status: "success", Data: data})
+	atomic.AddInt64(&statistics.HandlerStat.QueryRequestBytesTransmitted, int64(n))
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPromWriteBody(t *testing.T, wr *prompb.WriteRequest) *bytes.Reader {
+	buf, err := wr.Marshal()
+	require.NoError(t, err)
+	return bytes.NewReader(snappy.Encode(nil, buf))
+}
+
+func TestParsePromWriteRequest(t *testing.T) {
+	wr := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{{
+			Labels:  []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "job", Value: "a"}},
+			Samples: []prompb.Sample{{Value: 1, Timestamp: 1000}},
+		}},
+		Metadata: []prompb.MetricMetadata{
+			{Type: prompb.MetricMetadata_COUNTER, MetricFamilyName: "http_requests_total", Help: "The total requests.", Unit: "requests"},
+			{Type: prompb.MetricMetadata_GAUGE, MetricFamilyName: "up"},
+		},
+	}
+
+	var series, samples int
+	var mds []prompb.MetricMetadata
+	err := parsePromWriteRequest(newPromWriteBody(t, wr), promRemoteWriteV1, func(req *promWriteRequest) error {
+		series = len(req.Timeseries)
+		for i := range req.Timeseries {
+			samples += len(req.Timeseries[i].Samples)
+		}
+		mds = req.Metadata
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, series)
+	assert.Equal(t, 1, samples)
+	require.Equal(t, 2, len(mds))
+	assert.Equal(t, "http_requests_total", mds[0].MetricFamilyName)
+	assert.Equal(t, prompb.MetricMetadata_COUNTER, mds[0].Type)
+	assert.Equal(t, "The total requests.", mds[0].Help)
+	assert.Equal(t, "requests", mds[0].Unit)
+	assert.Equal(t, "gauge", promMetadataTypeName(mds[1].Type))
+
+	err = parsePromWriteRequest(bytes.NewReader([]byte("invalid")), promRemoteWriteV1, func(*promWriteRequest) error {
+		return nil
+	})
+	assert.Error(t, err)
+}
+
+func TestHandler_WritePromMetadata(t *testing.T) {
+	w := &mockOTLPPointsWriter{}
+	h := newOTLPHandler(w)
+	h.promMetadata = newPromMetadataCache()
+
+	mds := []prompb.MetricMetadata{
+		{Type: prompb.MetricMetadata_COUNTER, MetricFamilyName: "http_requests_total", Help: "The total requests."},
+		{Type: prompb.MetricMetadata_GAUGE},
+	}
+	require.NoError(t, h.writePromMetadata("prometheus", "autogen", mds))
+	require.Equal(t, 1, len(w.rows))
+	assert.Equal(t, PromMetadataMeasurement, w.rows[0].Name)
+	assert.Equal(t, 0, len(w.rows[0].Tags))
+	assert.Equal(t, "http_requests_total", w.rows[0].Fields[1].StrValue)
+	assert.Equal(t, "counter", w.rows[0].Fields[2].StrValue)
+
+	// the unchanged metadata is not written again
+	require.NoError(t, h.writePromMetadata("prometheus", "autogen", mds))
+	assert.Equal(t, 1, len(w.rows))
+
+	mds[0].Help = "The total HTTP requests."
+	require.NoError(t, h.writePromMetadata("prometheus", "autogen", mds))
+	require.Equal(t, 2, len(w.rows))
+	assert.Equal(t, "The total HTTP requests.", w.rows[1].Fields[0].StrValue)
+
+	// the same metric in another database is written
+	require.NoError(t, h.writePromMetadata("db1", "autogen", mds))
+	assert.Equal(t, 3, len(w.rows))
+}
+
+func TestInfluxResultToPromMetadata(t *testing.T) {
+	result := &query.Result{Series: models.Rows{{
+		Name:    PromMetadataMeasurement,
+		Columns: []string{promMetadataMetric, promMetadataType, promMetadataHelp, promMetadataUnit},
+		Values: [][]interface{}{
+			{"up", "gauge", "old help", ""},
+			{"http_requests_total", "counter", "The total requests.", "requests"},
+			{"up", "gauge", "The scrape status.", ""},
+			{nil, "gauge", "", ""},
+		},
+	}}}
+
+	data, err := influxResultToPromMetadata(result, -1)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]promMetadata{
+		"up":                  {{Type: "gauge", Help: "The scrape status."}},
+		"http_requests_total": {{Type: "counter", Help: "The total requests.", Unit: "requests"}},
+	}, data)
+
+	data, err = influxResultToPromMetadata(result, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(data))
+	assert.Contains(t, data, "http_requests_total")
+
+	data, err = influxResultToPromMetadata(nil, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(data))
+}
+
+func TestInfluxResultToTSDBStatus(t *testing.T) {
+	result := &query.Result{Series: models.Rows{
+		{Name: "cpu", Columns: []string{"count"}, Values: [][]interface{}{{uint64(3)}}},
+		{Name: "up", Columns: []string{"count"}, Values: [][]interface{}{{uint64(10)}}},
+		{Name: PromMetadataMeasurement, Columns: []string{"count"}, Values: [][]interface{}{{uint64(1)}}},
+		{Name: "mem", Columns: []string{"count"}, Values: [][]interface{}{{int64(3)}}},
+	}}
+
+	status, err := influxResultToTSDBStatus(result, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(16), status.HeadStats.NumSeries)
+	assert.Equal(t, []promTSDBStat{{Name: "up", Value: 10}, {Name: "cpu", Value: 3}}, status.SeriesCountByMetricName)
+	assert.NotNil(t, status.LabelValueCountByLabelName)
+}
+
+func TestHandler_Prom_Status(t *testing.T) {
+	h := newOTLPHandler(&mockOTLPPointsWriter{})
+	h.Version, h.Commit, h.Branch = "1.2.0", "abc", "main"
+	var user meta.User
+
+	t.Run("buildinfo", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/status/buildinfo", nil)
+		h.servePromBuildInfo(w, req, user)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resp struct {
+			Status string        `json:"status"`
+			Data   promBuildInfo `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, "success", resp.Status)
+		assert.Equal(t, "1.2.0", resp.Data.Version)
+		assert.Equal(t, "abc", resp.Data.Revision)
+		assert.Equal(t, "main", resp.Data.Branch)
+	})
+
+	t.Run("runtimeinfo", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/status/runtimeinfo", nil)
+		h.servePromRuntimeInfo(w, req, user)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"goroutineCount"`)
+	})
+
+	t.Run("format_query", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/format_query?query=sum(rate(foo[5m]))by(job)", nil)
+		h.servePromFormatQuery(w, req, user)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"data":"sum by(job) (rate(foo[5m]))"`)
+
+		w = httptest.NewRecorder()
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/format_query?query=sum(", nil)
+		h.servePromFormatQuery(w, req, user)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("tsdb invalid limit", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/status/tsdb?db=prometheus&limit=-1", nil)
+		h.servePromTSDBStatus(w, req, user)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("metadata invalid limit", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/metadata?db=prometheus&limit=a", nil)
+		h.servePromQueryMetaData(w, req, user)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
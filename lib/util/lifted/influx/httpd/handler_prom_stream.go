@@ -0,0 +1,285 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"sync/atomic"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/quota"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
+	"go.uber.org/zap"
+)
+
+// promStreamFlushPoints is the number of the buffered points written to the client at a time.
+const promStreamFlushPoints = 64 * 1024
+
+// PromStatusTrailer is the HTTP trailer holding the status of a streamed PromQL result, which is
+// known only after the result is written.
+const PromStatusTrailer = "X-Geminidb-Prom-Status"
+
+// isPromEmptyResult returns true if the error of the query means that no series is selected,
+// which is an empty result in PromQL.
+func isPromEmptyResult(err error) bool {
+	return errno.Equal(err, errno.ErrMeasurementNotFound)
+}
+
+// canStreamPromResult returns true if the result of the query is a matrix written in JSON, which
+// is streamed series by series. The functions over the whole result, such as sort, need the
+// result to be materialised.
+func canStreamPromResult(w ResponseWriter, expr parser.Expr, cmd promql2influxql.PromCommand) bool {
+	rw, ok := w.(*responseWriter)
+	if !ok {
+		return false
+	}
+	if f, ok := rw.formatter.(*jsonFormatter); !ok || f.Pretty {
+		return false
+	}
+	if _, ok = promql2influxql.ResultFunctionCall(expr); ok {
+		return false
+	}
+	switch expr.Type() {
+	case parser.ValueTypeMatrix:
+		return true
+	case parser.ValueTypeVector:
+		return cmd.DataType == promql2influxql.GRAPH_DATA
+	default:
+		return false
+	}
+}
+
+// promMatrixStream writes the matrix result of a PromQL query series by series as the chunks
+// arrive from the executor, so the whole result is never held in memory. The series are written
+// in the order returned by the executor.
+//
+// The series are buffered up to promStreamFlushPoints, so the small results are sorted and the
+// errors found before the first flush are responded with the error status as usual. The status
+// code can not be changed after the first flush, so the "status" is written after the result and
+// also set in the PromStatusTrailer. An error found later sets both to "error", and the trailing
+// "errorType" and "error" fields tell the cause, the written series are incomplete then.
+//
+// A row without tags holds the points of several series in the time order, its series are
+// complete only at the end of the result, so they are merged by the labels and written last.
+type promMatrixStream struct {
+	h        *Handler
+	w        ResponseWriter
+	receiver *promql2influxql.Receiver
+
+	pending   *models.Row // the last series, its points may continue in the next chunk
+	matrix    []*promql.Series
+	tagless   map[uint64]*promql.Series
+	points    int // the number of the buffered points
+	count     int // the number of the series written
+	committed bool
+	n         int
+	err       error
+}
+
+func newPromMatrixStream(h *Handler, w ResponseWriter, receiver *promql2influxql.Receiver) *promMatrixStream {
+	return &promMatrixStream{h: h, w: w, receiver: receiver}
+}
+
+func (s *promMatrixStream) add(rows models.Rows) error {
+	for _, row := range rows {
+		if s.pending != nil && s.pending.SameSeries(row) {
+			s.pending.Values = append(s.pending.Values, row.Values...)
+			continue
+		}
+		if err := s.addSeries(); err != nil {
+			return err
+		}
+		s.pending = row
+	}
+	return nil
+}
+
+// addSeries converts the pending row and buffers its series.
+func (s *promMatrixStream) addSeries() error {
+	if s.pending == nil {
+		return nil
+	}
+	if len(s.pending.Tags) == 0 {
+		return s.addTagless()
+	}
+	n := len(s.matrix)
+	var err error
+	s.matrix, err = s.receiver.RowToPromSeries(s.matrix, s.pending)
+	s.pending = nil
+	if err != nil {
+		return err
+	}
+	for _, series := range s.matrix[n:] {
+		s.points += len(series.Points)
+	}
+	if s.points >= promStreamFlushPoints {
+		return s.flush()
+	}
+	return nil
+}
+
+// addTagless merges the series of the pending row without tags into the ones found before.
+func (s *promMatrixStream) addTagless() error {
+	matrix, err := s.receiver.RowToPromSeries(nil, s.pending)
+	s.pending = nil
+	if err != nil {
+		return err
+	}
+	if s.tagless == nil {
+		s.tagless = make(map[uint64]*promql.Series, len(matrix))
+	}
+	for _, series := range matrix {
+		hash := series.Metric.Hash()
+		if merged, ok := s.tagless[hash]; ok {
+			merged.Points = append(merged.Points, series.Points...)
+			continue
+		}
+		s.tagless[hash] = series
+	}
+	return nil
+}
+
+func (s *promMatrixStream) write(b []byte) {
+	if s.err != nil {
+		return
+	}
+	n, err := s.w.Write(b)
+	s.n += n
+	s.err = err
+}
+
+// flush commits the status code and writes the buffered series to the client.
+func (s *promMatrixStream) flush() error {
+	if !s.committed {
+		s.committed = true
+		s.w.Header().Set("Trailer", PromStatusTrailer)
+		s.h.writeHeader(s.w, http.StatusOK)
+		s.write([]byte(`{"data":{"resultType":"matrix","result":[`))
+	}
+	var buf bytes.Buffer
+	for _, series := range s.matrix {
+		b, err := json.Marshal(series)
+		if err != nil {
+			return err
+		}
+		if s.count > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(b)
+		s.count++
+	}
+	s.write(buf.Bytes())
+	s.matrix, s.points = s.matrix[:0], 0
+	if w, ok := s.w.(http.Flusher); ok {
+		w.Flush()
+	}
+	return nil
+}
+
+func (s *promMatrixStream) finish() error {
+	if err := s.addSeries(); err != nil {
+		return err
+	}
+	if len(s.tagless) > 0 {
+		tagless := make([]*promql.Series, 0, len(s.tagless))
+		for _, series := range s.tagless {
+			tagless = append(tagless, series)
+		}
+		sort.Slice(tagless, func(i, j int) bool {
+			return labels.Compare(tagless[i].Metric, tagless[j].Metric) < 0
+		})
+		s.matrix = append(s.matrix, tagless...)
+	}
+	if s.committed {
+		if err := s.flush(); err != nil {
+			return err
+		}
+		s.write([]byte("]},\"status\":\"success\"}\n"))
+		s.w.Header().Set(PromStatusTrailer, string(statusSuccess))
+		return nil
+	}
+
+	// the whole result is buffered, respond it as the materialised result
+	matrix := make(promql.Matrix, 0, len(s.matrix))
+	for _, series := range s.matrix {
+		matrix = append(matrix, *series)
+	}
+	sort.Sort(matrix)
+	s.committed = true
+	s.h.writeHeader(s.w, http.StatusOK)
+	n, _ := s.w.WritePromResponse(PromResponse{Data: promql2influxql.NewPromResult(matrix, string(parser.ValueTypeMatrix)), Status: "success"})
+	s.n += n
+	return nil
+}
+
+func (s *promMatrixStream) abort(apiErr *apiError) {
+	if !s.committed {
+		s.committed = true
+		respondError(s.w, apiErr, nil)
+		return
+	}
+	s.w.Header().Set(PromStatusTrailer, string(statusError))
+	msg, _ := json.Marshal(apiErr.err.Error())
+	s.write([]byte(`]},"status":"error","errorType":"` + string(apiErr.typ) + `","error":`))
+	s.write(msg)
+	s.write([]byte("}\n"))
+}
+
+// streamPromResult writes the matrix result of the query as the results arrive, see promMatrixStream.
+// The client does not ask for the chunks, so the whole result counts for the max_query_result_bytes quota.
+func (h *Handler) streamPromResult(rw ResponseWriter, resultCh <-chan *query.Result, cmd promql2influxql.PromCommand, receiver *promql2influxql.Receiver, qt *quota.Query) {
+	s := newPromMatrixStream(h, rw, receiver)
+	defer func() {
+		atomic.AddInt64(&statistics.HandlerStat.QueryRequestBytesTransmitted, int64(s.n))
+	}()
+
+	for result := range resultCh {
+		if result == nil {
+			continue
+		}
+		if result.Err != nil {
+			if !s.committed && isPromEmptyResult(result.Err) {
+				h.writeHeader(rw, http.StatusOK)
+				n, _ := rw.WritePromResponse(PromResponse{Data: getEmptyResponse(cmd), Status: "success"})
+				s.n += n
+				return
+			}
+			h.Logger.Error("prom query failed", zap.Error(result.Err))
+			s.abort(&apiError{errorExec, result.Err})
+			return
+		}
+		if err := qt.Grow(estimateResultSize(result)); err != nil {
+			s.abort(&apiError{errorQuotaExceeded, err})
+			return
+		}
+		if err := s.add(result.Series); err != nil {
+			s.abort(&apiError{errorBadData, err})
+			return
+		}
+	}
+	if err := s.finish(); err != nil {
+		s.abort(&apiError{errorBadData, err})
+	}
+}
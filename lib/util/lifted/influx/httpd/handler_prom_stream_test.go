@@ -0,0 +1,238 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/quota"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type promStreamResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Values [][]interface{}   `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+func newPromStreamRow(host string, start, n int) *models.Row {
+	row := &models.Row{
+		Name:    "up",
+		Tags:    map[string]string{"host": host},
+		Columns: []string{"time", "value"},
+	}
+	for i := start; i < start+n; i++ {
+		row.Values = append(row.Values, []interface{}{time.Unix(int64(i), 0), float64(i)})
+	}
+	return row
+}
+
+func servePromStream(h *Handler, results ...*query.Result) (*httptest.ResponseRecorder, *promStreamResponse, error) {
+	ch := make(chan *query.Result, len(results))
+	for _, r := range results {
+		ch <- r
+	}
+	close(ch)
+
+	w := httptest.NewRecorder()
+	rw := NewResponseWriter(w, httptest.NewRequest(http.MethodGet, "/api/v1/query_range", nil))
+	cmd := promql2influxql.PromCommand{DataType: promql2influxql.GRAPH_DATA}
+	h.streamPromResult(rw, ch, cmd, &promql2influxql.Receiver{}, nil)
+
+	resp := &promStreamResponse{}
+	err := json.Unmarshal(w.Body.Bytes(), resp)
+	return w, resp, err
+}
+
+func TestCanStreamPromResult(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/query_range", nil)
+	rw := NewResponseWriter(httptest.NewRecorder(), req)
+	graph := promql2influxql.PromCommand{DataType: promql2influxql.GRAPH_DATA}
+	table := promql2influxql.PromCommand{DataType: promql2influxql.TABLE_DATA}
+
+	for query, expected := range map[string]bool{
+		"up":            true,
+		"up[5m]":        true,
+		"sum(up)":       true,
+		"sort(up)":      false,
+		"absent(up)":    false,
+		"scalar(up)":    false,
+		"vector(1) + 1": true,
+	} {
+		expr, err := parser.ParseExpr(query)
+		require.NoError(t, err)
+		assert.Equal(t, expected, canStreamPromResult(rw, expr, graph), query)
+	}
+
+	expr, _ := parser.ParseExpr("up")
+	assert.False(t, canStreamPromResult(rw, expr, table))
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/query_range?pretty=true", nil)
+	assert.False(t, canStreamPromResult(NewResponseWriter(httptest.NewRecorder(), req), expr, graph))
+	req.Header.Set("Accept", "application/csv")
+	assert.False(t, canStreamPromResult(NewResponseWriter(httptest.NewRecorder(), req), expr, graph))
+}
+
+func TestHandler_StreamPromResult(t *testing.T) {
+	h := newOTLPHandler(&mockOTLPPointsWriter{})
+
+	t.Run("buffered", func(t *testing.T) {
+		// the series b continues in the second chunk
+		w, resp, err := servePromStream(h,
+			&query.Result{Series: models.Rows{newPromStreamRow("c", 0, 2), newPromStreamRow("b", 0, 2)}},
+			&query.Result{Series: models.Rows{newPromStreamRow("b", 2, 2), newPromStreamRow("a", 0, 1)}},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, strings.HasPrefix(w.Body.String(), `{"status":"success"`))
+		assert.Equal(t, "matrix", resp.Data.ResultType)
+		require.Equal(t, 3, len(resp.Data.Result))
+		assert.Equal(t, map[string]string{"__name__": "up", "host": "a"}, resp.Data.Result[0].Metric)
+		assert.Equal(t, "b", resp.Data.Result[1].Metric["host"])
+		assert.Equal(t, 4, len(resp.Data.Result[1].Values))
+		assert.Equal(t, []interface{}{float64(3), "3"}, resp.Data.Result[1].Values[3])
+	})
+
+	t.Run("streamed", func(t *testing.T) {
+		var results []*query.Result
+		series := 2*promStreamFlushPoints/100 + 1
+		for i := 0; i < series; i++ {
+			results = append(results, &query.Result{Series: models.Rows{newPromStreamRow(fmt.Sprintf("h%05d", i), 0, 100)}})
+		}
+		w, resp, err := servePromStream(h, results...)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, strings.HasPrefix(w.Body.String(), `{"data":{"resultType":"matrix","result":[`))
+		assert.Equal(t, "success", resp.Status)
+		assert.Equal(t, "success", w.Result().Trailer.Get(PromStatusTrailer))
+		require.Equal(t, series, len(resp.Data.Result))
+		assert.Equal(t, "h00000", resp.Data.Result[0].Metric["host"])
+		assert.Equal(t, 100, len(resp.Data.Result[series-1].Values))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		w, resp, err := servePromStream(h)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "success", resp.Status)
+		assert.Equal(t, "matrix", resp.Data.ResultType)
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		w, resp, err := servePromStream(h, &query.Result{Err: errors.New("shard error")})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, "error", resp.Status)
+		assert.Equal(t, string(errorExec), resp.ErrorType)
+		assert.Equal(t, "shard error", resp.Error)
+	})
+
+	t.Run("measurement not found", func(t *testing.T) {
+		w, resp, err := servePromStream(h, &query.Result{Err: meta2.ErrMeasurementNotFound})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "success", resp.Status)
+		assert.Equal(t, 0, len(resp.Data.Result))
+	})
+}
+
+func TestHandler_StreamPromResult_Error(t *testing.T) {
+	h := newOTLPHandler(&mockOTLPPointsWriter{})
+
+	// the samples limit is exceeded after the first series are written
+	w, resp, err := servePromStream(h,
+		&query.Result{Series: models.Rows{newPromStreamRow("a", 0, promStreamFlushPoints), newPromStreamRow("b", 0, 1)}},
+		&query.Result{Series: models.Rows{newPromStreamRow("c", 0, 2)}},
+		&query.Result{Err: query.ErrPromTooManySamples},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "error", resp.Status)
+	assert.Equal(t, string(errorExec), resp.ErrorType)
+	assert.Equal(t, query.ErrPromTooManySamples.Error(), resp.Error)
+	assert.Equal(t, 1, len(resp.Data.Result))
+	assert.Equal(t, "error", w.Result().Trailer.Get(PromStatusTrailer))
+}
+
+func TestHandler_StreamPromResult_Tagless(t *testing.T) {
+	h := newOTLPHandler(&mockOTLPPointsWriter{})
+	tagless := func(start, n int) *models.Row {
+		row := &models.Row{Name: "up", Columns: []string{"time", "host", "value"}}
+		for i := start; i < start+n; i++ {
+			for _, host := range []string{"b", "a"} {
+				row.Values = append(row.Values, []interface{}{time.Unix(int64(i), 0), host, float64(i)})
+			}
+		}
+		return row
+	}
+
+	// the series of the rows without tags continue after the flush
+	w, resp, err := servePromStream(h,
+		&query.Result{Series: models.Rows{tagless(0, 2), newPromStreamRow("c", 0, promStreamFlushPoints)}},
+		&query.Result{Series: models.Rows{tagless(2, 3)}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "success", resp.Status)
+	require.Equal(t, 3, len(resp.Data.Result))
+	assert.Equal(t, "c", resp.Data.Result[0].Metric["host"])
+	assert.Equal(t, map[string]string{"__name__": "up", "host": "a"}, resp.Data.Result[1].Metric)
+	assert.Equal(t, "b", resp.Data.Result[2].Metric["host"])
+	for _, series := range resp.Data.Result[1:] {
+		require.Equal(t, 5, len(series.Values))
+		assert.Equal(t, []interface{}{float64(4), "4"}, series.Values[4])
+	}
+}
+
+func TestHandler_StreamPromResult_Quota(t *testing.T) {
+	h := newOTLPHandler(&mockOTLPPointsWriter{})
+	m := quota.NewManager(&mockQuotaSource{dbs: map[string]*meta2.QuotaInfo{"db0": {MaxQueryResultBytes: 100}}})
+	qt, err := m.BeginQuery("", "db0")
+	require.NoError(t, err)
+	defer qt.Done()
+
+	ch := make(chan *query.Result, 1)
+	ch <- &query.Result{Series: models.Rows{newPromStreamRow("h1", 0, 10)}}
+	close(ch)
+	w := httptest.NewRecorder()
+	rw := NewResponseWriter(w, httptest.NewRequest(http.MethodGet, "/api/v1/query_range", nil))
+	h.streamPromResult(rw, ch, promql2influxql.PromCommand{DataType: promql2influxql.GRAPH_DATA}, &promql2influxql.Receiver{}, qt)
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	resp := &promStreamResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.Equal(t, "quota_exceeded", resp.ErrorType)
+}
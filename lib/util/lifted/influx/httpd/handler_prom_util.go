@@ -57,6 +57,7 @@ const (
 	errorNotFound      errorType = "not_found"
 	errorNotAcceptable errorType = "not_acceptable"
 	errorForbidden     errorType = "forbidden"
+	errorQuotaExceeded errorType = "quota_exceeded"
 )
 
 type status string
@@ -146,8 +147,11 @@ func (h *Handler) getPromResult(w http.ResponseWriter, stmtID2Result map[int]*qu
 	r := &promql2influxql.Receiver{DropMetric: dropMetric, RemoveTableName: removeTableName}
 	resp := PromResponse{Data: &promql2influxql.PromResult{}, Status: "success"}
 	if len(stmtID2Result) > 0 {
-		if stmtID2Result[0].Err != nil {
+		if err := stmtID2Result[0].Err; isPromEmptyResult(err) {
 			resp.Data = getEmptyResponse(cmd)
+		} else if err != nil {
+			respondError(w, &apiError{errorExec, err}, nil)
+			return resp, false
 		} else {
 			data, err := r.InfluxResultToPromQLValue(stmtID2Result[0], expr, cmd)
 			if err != nil {
@@ -254,6 +258,8 @@ func respondError(w http.ResponseWriter, apiErr *apiError, data interface{}) {
 		code = http.StatusNotAcceptable
 	case errorForbidden:
 		code = http.StatusForbidden
+	case errorQuotaExceeded:
+		code = http.StatusTooManyRequests
 	default:
 		code = http.StatusInternalServerError
 	}
@@ -0,0 +1,892 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	prompb2 "github.com/VictoriaMetrics/VictoriaMetrics/lib/prompb"
+	"github.com/golang/snappy"
+	"github.com/openGemini/openGemini/lib/bufferpool"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/prometheus/prometheus/prompb"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	// PromExemplarMeasurement stores the exemplars sent by the Prometheus remote write, the tags are the
+	// labels of the series and the labels of the exemplar are stored as a string field. Like the metadata, it
+	// is hidden from the measurement lists.
+	PromExemplarMeasurement = meta2.PromExemplarMeasurement
+
+	promExemplarLabels = "labels"
+
+	// the protobuf messages of the remote write, which are negotiated by the proto parameter of the content type.
+	promRemoteWriteV1 = "prometheus.WriteRequest"
+	promRemoteWriteV2 = "io.prometheus.write.v2.Request"
+
+	promWrittenSamplesHeader    = "X-Prometheus-Remote-Write-Samples-Written"
+	promWrittenHistogramsHeader = "X-Prometheus-Remote-Write-Histograms-Written"
+	promWrittenExemplarsHeader  = "X-Prometheus-Remote-Write-Exemplars-Written"
+
+	promBucketLabel     = "le"
+	promBucketSuffix    = "_bucket"
+	promCountSuffix     = "_count"
+	promSumSuffix       = "_sum"
+	customBucketsSchema = -53
+
+	// maxPromHistogramBuckets limits the number of the bucket series of a native histogram. The exponential
+	// histograms with more buckets are downscaled by merging the adjacent buckets, and the histograms
+	// with the custom buckets are rejected.
+	maxPromHistogramBuckets = 160
+
+	// maxPromHistogramSpanBuckets limits the number of the buckets described by the spans of a native histogram
+	// before the downscaling, it covers the histograms downscaled by up to 6 schemas.
+	maxPromHistogramSpanBuckets = maxPromHistogramBuckets << 6
+)
+
+// field numbers of the remote write messages, the messages of the protocol 1.0 and 2.0 share the
+// same Histogram, BucketSpan and Sample.
+const (
+	// prometheus.WriteRequest
+	promV1Timeseries = 1
+	promV1Metadata   = 3
+
+	// prometheus.TimeSeries
+	promV1Labels     = 1
+	promV1Samples    = 2
+	promV1Exemplars  = 3
+	promV1Histograms = 4
+
+	// prometheus.Exemplar
+	promV1ExemplarLabels    = 1
+	promV1ExemplarValue     = 2
+	promV1ExemplarTimestamp = 3
+
+	// io.prometheus.write.v2.Request
+	promV2Symbols    = 4
+	promV2Timeseries = 5
+
+	// io.prometheus.write.v2.TimeSeries
+	promV2LabelsRefs = 1
+	promV2Samples    = 2
+	promV2Histograms = 3
+	promV2Exemplars  = 4
+	promV2Metadata   = 5
+
+	// io.prometheus.write.v2.Exemplar
+	promV2ExemplarLabelsRefs = 1
+	promV2ExemplarValue      = 2
+	promV2ExemplarTimestamp  = 3
+
+	// io.prometheus.write.v2.Metadata
+	promV2MetadataType    = 1
+	promV2MetadataHelpRef = 3
+	promV2MetadataUnitRef = 4
+
+	// Sample
+	promSampleValue     = 1
+	promSampleTimestamp = 2
+
+	// Histogram
+	promHistogramCountInt       = 1
+	promHistogramCountFloat     = 2
+	promHistogramSum            = 3
+	promHistogramSchema         = 4
+	promHistogramZeroThreshold  = 5
+	promHistogramZeroCountInt   = 6
+	promHistogramZeroCountFloat = 7
+	promHistogramNegativeSpans  = 8
+	promHistogramNegativeDeltas = 9
+	promHistogramNegativeCounts = 10
+	promHistogramPositiveSpans  = 11
+	promHistogramPositiveDeltas = 12
+	promHistogramPositiveCounts = 13
+	promHistogramTimestamp      = 15
+	promHistogramCustomValues   = 16
+
+	// BucketSpan
+	promBucketSpanOffset = 1
+	promBucketSpanLength = 2
+
+	// Label
+	promLabelName  = 1
+	promLabelValue = 2
+)
+
+var promWriteRequestPool = sync.Pool{
+	New: func() interface{} {
+		return &prompb2.WriteRequest{}
+	},
+}
+
+// promWriteRequest is the decoded remote write request of the protocol 1.0 or 2.0. The native histograms
+// are converted into the classic histogram series in Timeseries, so that histogram_quantile can query them.
+type promWriteRequest struct {
+	Timeseries []prompb2.TimeSeries
+	Metadata   []prompb.MetricMetadata
+	Exemplars  []promExemplars
+
+	samples    int
+	histograms int
+	exemplars  int
+	// size is the length of the decompressed request
+	size int
+}
+
+// promExemplars are the exemplars of one series.
+type promExemplars struct {
+	Labels    []prompb2.Label
+	Exemplars []promExemplar
+}
+
+type promExemplar struct {
+	Labels    []prompb2.Label
+	Value     float64
+	Timestamp int64
+}
+
+type promBucketSpan struct {
+	offset int32
+	length uint32
+}
+
+// promHistogram is the native histogram, the integer counts are converted into float.
+type promHistogram struct {
+	count          float64
+	sum            float64
+	schema         int32
+	zeroThreshold  float64
+	zeroCount      float64
+	negativeSpans  []promBucketSpan
+	negativeDeltas []int64
+	negativeCounts []float64
+	positiveSpans  []promBucketSpan
+	positiveDeltas []int64
+	positiveCounts []float64
+	customValues   []float64
+	timestamp      int64
+}
+
+// promWriteProtoMsg returns the protobuf message of the remote write request. The request without
+// the proto parameter in the content type is the protocol 1.0.
+func promWriteProtoMsg(contentType string) (string, error) {
+	if contentType == "" {
+		return promRemoteWriteV1, nil
+	}
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", fmt.Errorf("invalid content type %q: %w", contentType, err)
+	}
+	proto, ok := params["proto"]
+	if !ok {
+		return promRemoteWriteV1, nil
+	}
+	switch proto {
+	case promRemoteWriteV1, promRemoteWriteV2:
+		return proto, nil
+	default:
+		return "", fmt.Errorf("unsupported remote write protobuf message %q", proto)
+	}
+}
+
+// parsePromWriteRequest decodes the Prometheus remote write request of protoMsg and calls callback with it.
+// callback shouldn't hold the request after returning.
+func parsePromWriteRequest(body io.Reader, protoMsg string, callback func(wr *promWriteRequest) error) error {
+	compressed, err := io.ReadAll(body)
+	if err != nil {
+		return fmt.Errorf("cannot read compressed request: %w", err)
+	}
+
+	buf := bufferpool.Get()
+	defer func() {
+		bufferpool.Put(buf)
+	}()
+	buf, err = snappy.Decode(buf[:cap(buf)], compressed)
+	if err != nil {
+		return fmt.Errorf("cannot decompress request with length %d: %w", len(compressed), err)
+	}
+
+	if protoMsg == promRemoteWriteV2 {
+		req := &promWriteRequest{size: len(buf)}
+		if err = req.unmarshalV2(buf); err != nil {
+			return fmt.Errorf("cannot unmarshal %s with size %d bytes: %w", promRemoteWriteV2, len(buf), err)
+		}
+		return callback(req)
+	}
+
+	wr := promWriteRequestPool.Get().(*prompb2.WriteRequest)
+	defer func() {
+		wr.Reset()
+		promWriteRequestPool.Put(wr)
+	}()
+	if err = wr.Unmarshal(buf); err != nil {
+		return fmt.Errorf("cannot unmarshal prompb.WriteRequest with size %d bytes: %w", len(buf), err)
+	}
+	// the histogram series are appended to a copy, the pooled Timeseries is left untouched.
+	req := &promWriteRequest{Timeseries: wr.Timeseries[:len(wr.Timeseries):len(wr.Timeseries)], size: len(buf)}
+	for i := range wr.Timeseries {
+		req.samples += len(wr.Timeseries[i].Samples)
+	}
+	if err = req.unmarshalV1(buf); err != nil {
+		return fmt.Errorf("cannot unmarshal prompb.WriteRequest with size %d bytes: %w", len(buf), err)
+	}
+	return callback(req)
+}
+
+// unmarshalV1 decodes the metadata, the exemplars and the native histograms of the protocol 1.0,
+// which are not supported by prompb2.WriteRequest.
+func (req *promWriteRequest) unmarshalV1(buf []byte) error {
+	return walkProto(buf, func(f *protoField) error {
+		if f.typ != protowire.BytesType {
+			return nil
+		}
+		switch f.num {
+		case promV1Timeseries:
+			return req.unmarshalV1Series(f.b)
+		case promV1Metadata:
+			var md prompb.MetricMetadata
+			if err := md.Unmarshal(f.b); err != nil {
+				return fmt.Errorf("cannot unmarshal prompb.MetricMetadata: %w", err)
+			}
+			req.Metadata = append(req.Metadata, md)
+		}
+		return nil
+	})
+}
+
+func (req *promWriteRequest) unmarshalV1Series(buf []byte) error {
+	if !hasPromV1Extensions(buf) {
+		return nil
+	}
+	var labels []prompb2.Label
+	var exemplars []promExemplar
+	var histograms []promHistogram
+	err := walkProto(buf, func(f *protoField) error {
+		if f.typ != protowire.BytesType {
+			return nil
+		}
+		switch f.num {
+		case promV1Labels:
+			l, err := unmarshalPromLabel(f.b)
+			if err != nil {
+				return err
+			}
+			labels = append(labels, l)
+		case promV1Exemplars:
+			e, err := unmarshalPromV1Exemplar(f.b)
+			if err != nil {
+				return err
+			}
+			exemplars = append(exemplars, e)
+		case promV1Histograms:
+			h, err := unmarshalPromHistogram(f.b)
+			if err != nil {
+				return err
+			}
+			histograms = append(histograms, h)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return req.addSeries(labels, nil, exemplars, histograms)
+}
+
+// hasPromV1Extensions checks whether the series has the exemplars or the native histograms,
+// the labels of most series needn't be decoded again.
+func hasPromV1Extensions(buf []byte) bool {
+	for len(buf) > 0 {
+		num, typ, n := protowire.ConsumeTag(buf)
+		if n < 0 {
+			return false
+		}
+		if num == promV1Exemplars || num == promV1Histograms {
+			return true
+		}
+		buf = buf[n:]
+		n = protowire.ConsumeFieldValue(num, typ, buf)
+		if n < 0 {
+			return false
+		}
+		buf = buf[n:]
+	}
+	return false
+}
+
+// unmarshalV2 decodes the request of the protocol 2.0, the labels refer to the symbol table of the request.
+func (req *promWriteRequest) unmarshalV2(buf []byte) error {
+	var symbols [][]byte
+	err := walkProto(buf, func(f *protoField) error {
+		if f.num == promV2Symbols && f.typ == protowire.BytesType {
+			symbols = append(symbols, f.b)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return walkProto(buf, func(f *protoField) error {
+		if f.num == promV2Timeseries && f.typ == protowire.BytesType {
+			return req.unmarshalV2Series(f.b, symbols)
+		}
+		return nil
+	})
+}
+
+func (req *promWriteRequest) unmarshalV2Series(buf []byte, symbols [][]byte) error {
+	var refs []uint64
+	var samples []prompb2.Sample
+	var exemplars []promExemplar
+	var histograms []promHistogram
+	var md *prompb.MetricMetadata
+	err := walkProto(buf, func(f *protoField) error {
+		var err error
+		switch f.num {
+		case promV2LabelsRefs:
+			refs, err = appendPackedValues(refs, f, false)
+		case promV2Samples:
+			var s prompb2.Sample
+			s, err = unmarshalPromSample(f.b)
+			samples = append(samples, s)
+		case promV2Histograms:
+			var h promHistogram
+			h, err = unmarshalPromHistogram(f.b)
+			histograms = append(histograms, h)
+		case promV2Exemplars:
+			var e promExemplar
+			e, err = unmarshalPromV2Exemplar(f.b, symbols)
+			exemplars = append(exemplars, e)
+		case promV2Metadata:
+			md, err = unmarshalPromV2Metadata(f.b, symbols)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	labels, err := resolvePromLabels(refs, symbols)
+	if err != nil {
+		return err
+	}
+	if md != nil && (md.Type != prompb.MetricMetadata_UNKNOWN || md.Help != "" || md.Unit != "") {
+		for i := range labels {
+			if string(labels[i].Name) == promql2influxql.DefaultMetricKeyLabel {
+				md.MetricFamilyName = string(labels[i].Value)
+				req.Metadata = append(req.Metadata, *md)
+				break
+			}
+		}
+	}
+	return req.addSeries(labels, samples, exemplars, histograms)
+}
+
+func (req *promWriteRequest) addSeries(labels []prompb2.Label, samples []prompb2.Sample, exemplars []promExemplar, histograms []promHistogram) error {
+	if len(samples) > 0 {
+		req.Timeseries = append(req.Timeseries, prompb2.TimeSeries{Labels: labels, Samples: samples})
+		req.samples += len(samples)
+	}
+	if len(exemplars) > 0 {
+		req.Exemplars = append(req.Exemplars, promExemplars{Labels: labels, Exemplars: exemplars})
+		req.exemplars += len(exemplars)
+	}
+	for i := range histograms {
+		var err error
+		req.Timeseries, err = histograms[i].appendClassicSeries(req.Timeseries, labels)
+		if err != nil {
+			return err
+		}
+	}
+	req.histograms += len(histograms)
+	return nil
+}
+
+// appendClassicSeries converts the native histogram into the classic <name>_bucket, <name>_count
+// and <name>_sum series, the buckets are cumulative and labeled by their upper bounds.
+func (h *promHistogram) appendClassicSeries(dst []prompb2.TimeSeries, labels []prompb2.Label) ([]prompb2.TimeSeries, error) {
+	bounds, counts, err := h.cumulativeBuckets()
+	if err != nil {
+		return dst, err
+	}
+	for i := range bounds {
+		le := prompb2.Label{Name: []byte(promBucketLabel), Value: []byte(formatPromBound(bounds[i]))}
+		dst = append(dst, prompb2.TimeSeries{
+			Labels:  append(renamePromSeries(labels, promBucketSuffix), le),
+			Samples: []prompb2.Sample{{Value: counts[i], Timestamp: h.timestamp}},
+		})
+	}
+	dst = append(dst, prompb2.TimeSeries{
+		Labels:  renamePromSeries(labels, promCountSuffix),
+		Samples: []prompb2.Sample{{Value: h.count, Timestamp: h.timestamp}},
+	}, prompb2.TimeSeries{
+		Labels:  renamePromSeries(labels, promSumSuffix),
+		Samples: []prompb2.Sample{{Value: h.sum, Timestamp: h.timestamp}},
+	})
+	return dst, nil
+}
+
+// cumulativeBuckets returns the upper bounds in ascending order and the cumulative counts of the buckets,
+// the last bucket is always +Inf with the total count.
+func (h *promHistogram) cumulativeBuckets() ([]float64, []float64, error) {
+	if h.schema != customBucketsSchema && (h.schema < -4 || h.schema > 8) {
+		return nil, nil, fmt.Errorf("unsupported native histogram schema %d", h.schema)
+	}
+	negIndexes, negCounts, err := expandPromBuckets(h.negativeSpans, h.negativeDeltas, h.negativeCounts)
+	if err != nil {
+		return nil, nil, err
+	}
+	posIndexes, posCounts, err := expandPromBuckets(h.positiveSpans, h.positiveDeltas, h.positiveCounts)
+	if err != nil {
+		return nil, nil, err
+	}
+	schema := h.schema
+	for schema != customBucketsSchema && schema > -4 && len(negIndexes)+len(posIndexes) > maxPromHistogramBuckets {
+		negIndexes, negCounts = downscalePromBuckets(negIndexes, negCounts)
+		posIndexes, posCounts = downscalePromBuckets(posIndexes, posCounts)
+		schema--
+	}
+	if n := len(negIndexes) + len(posIndexes); n > maxPromHistogramBuckets {
+		return nil, nil, fmt.Errorf("native histogram with %d buckets exceeds the limit of %d buckets", n, maxPromHistogramBuckets)
+	}
+
+	var bounds, counts []float64
+	var cumulative float64
+	add := func(bound, count float64) {
+		cumulative += count
+		bounds = append(bounds, bound)
+		counts = append(counts, cumulative)
+	}
+
+	if h.schema == customBucketsSchema {
+		for i, idx := range posIndexes {
+			if idx < 0 || int(idx) > len(h.customValues) {
+				return nil, nil, fmt.Errorf("invalid custom bucket index %d", idx)
+			}
+			bound := math.Inf(1)
+			if int(idx) < len(h.customValues) {
+				bound = h.customValues[idx]
+			}
+			add(bound, posCounts[i])
+		}
+	} else {
+		// the negative bucket idx is [-bound(idx), -bound(idx-1)), so the upper bounds ascend with the descending index.
+		for i := len(negIndexes) - 1; i >= 0; i-- {
+			add(-exponentialBound(negIndexes[i]-1, schema), negCounts[i])
+		}
+		add(h.zeroThreshold, h.zeroCount)
+		for i, idx := range posIndexes {
+			add(exponentialBound(idx, schema), posCounts[i])
+		}
+	}
+
+	if len(bounds) > 0 && math.IsInf(bounds[len(bounds)-1], 1) {
+		counts[len(counts)-1] = h.count
+	} else {
+		bounds = append(bounds, math.Inf(1))
+		counts = append(counts, h.count)
+	}
+	return bounds, counts, nil
+}
+
+// exponentialBound returns the upper bound of the bucket idx, which is (2^(2^-schema))^idx.
+func exponentialBound(idx, schema int32) float64 {
+	if schema < 0 {
+		return math.Ldexp(1, int(idx)<<uint(-schema))
+	}
+	return math.Exp2(float64(idx) / float64(int(1)<<uint(schema)))
+}
+
+// downscalePromBuckets merges every two adjacent buckets into the bucket of the schema smaller by one,
+// the bucket idx is (bound(2*idx-2), bound(2*idx)] of the original schema.
+func downscalePromBuckets(indexes []int32, counts []float64) ([]int32, []float64) {
+	dstIndexes := make([]int32, 0, len(indexes))
+	dstCounts := make([]float64, 0, len(counts))
+	for i, idx := range indexes {
+		idx = ((idx - 1) >> 1) + 1
+		if n := len(dstIndexes); n > 0 && dstIndexes[n-1] == idx {
+			dstCounts[n-1] += counts[i]
+			continue
+		}
+		dstIndexes = append(dstIndexes, idx)
+		dstCounts = append(dstCounts, counts[i])
+	}
+	return dstIndexes, dstCounts
+}
+
+// expandPromBuckets returns the indexes and the counts of the buckets described by the spans,
+// the integer histogram encodes the counts as the deltas to the previous bucket.
+func expandPromBuckets(spans []promBucketSpan, deltas []int64, counts []float64) ([]int32, []float64, error) {
+	// the span lengths come from the request, check them before allocating the buckets
+	var n uint64
+	for _, span := range spans {
+		n += uint64(span.length)
+	}
+	if n > maxPromHistogramSpanBuckets {
+		return nil, nil, fmt.Errorf("native histogram with %d buckets exceeds the limit of %d buckets", n, maxPromHistogramSpanBuckets)
+	}
+	if len(deltas) > 0 && uint64(len(deltas)) != n {
+		return nil, nil, fmt.Errorf("the spans of %d buckets mismatch %d deltas", n, len(deltas))
+	}
+	if len(deltas) == 0 && uint64(len(counts)) != n {
+		return nil, nil, fmt.Errorf("the spans of %d buckets mismatch %d counts", n, len(counts))
+	}
+
+	indexes := make([]int32, 0, n)
+	var idx int32
+	for i, span := range spans {
+		if i == 0 {
+			idx = span.offset
+		} else {
+			idx += span.offset
+		}
+		for j := uint32(0); j < span.length; j++ {
+			indexes = append(indexes, idx)
+			idx++
+		}
+	}
+
+	if len(deltas) > 0 {
+		counts = make([]float64, len(deltas))
+		var count int64
+		for i, d := range deltas {
+			count += d
+			counts[i] = float64(count)
+		}
+	}
+	return indexes, counts, nil
+}
+
+func formatPromBound(bound float64) string {
+	if math.IsInf(bound, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(bound, 'g', -1, 64)
+}
+
+// renamePromSeries copies the labels and appends suffix to the metric name.
+func renamePromSeries(labels []prompb2.Label, suffix string) []prompb2.Label {
+	dst := make([]prompb2.Label, len(labels), len(labels)+1)
+	for i := range labels {
+		dst[i] = labels[i]
+		if string(labels[i].Name) == promql2influxql.DefaultMetricKeyLabel {
+			dst[i].Value = append(append(make([]byte, 0, len(labels[i].Value)+len(suffix)), labels[i].Value...), suffix...)
+		}
+	}
+	return dst
+}
+
+// appendPromExemplarRows converts the exemplars into the rows of PromExemplarMeasurement.
+func appendPromExemplarRows(dst []influx.Row, exemplars []promExemplars) []influx.Row {
+	for i := range exemplars {
+		tags := make(influx.PointTags, len(exemplars[i].Labels))
+		tags, _ = unmarshalPromTags(tags, prompb2.TimeSeries{Labels: exemplars[i].Labels})
+		for _, e := range exemplars[i].Exemplars {
+			dst = append(dst, influx.Row{
+				Name:      PromExemplarMeasurement,
+				Tags:      tags,
+				Timestamp: e.Timestamp * int64(time.Millisecond),
+				Fields: []influx.Field{
+					{Key: promExemplarLabels, Type: influx.Field_Type_String, StrValue: formatPromLabels(e.Labels)},
+					{Key: promql2influxql.DefaultFieldKey, Type: influx.Field_Type_Float, NumValue: e.Value},
+				},
+			})
+		}
+	}
+	return dst
+}
+
+// formatPromLabels formats the labels as {name="value", ...} in the order of the names.
+func formatPromLabels(labels []prompb2.Label) string {
+	sorted := make([]prompb2.Label, len(labels))
+	copy(sorted, labels)
+	sort.Slice(sorted, func(i, j int) bool {
+		return string(sorted[i].Name) < string(sorted[j].Name)
+	})
+	var b strings.Builder
+	b.WriteByte('{')
+	for i := range sorted {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.Write(sorted[i].Name)
+		b.WriteByte('=')
+		b.WriteString(strconv.Quote(string(sorted[i].Value)))
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// protoField is a field decoded by walkProto, val is set for the varint and fixed types and b for the bytes type.
+type protoField struct {
+	num protowire.Number
+	typ protowire.Type
+	val uint64
+	b   []byte
+}
+
+// walkProto calls fn with every field of the protobuf message in buf.
+func walkProto(buf []byte, fn func(f *protoField) error) error {
+	for len(buf) > 0 {
+		num, typ, n := protowire.ConsumeTag(buf)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		buf = buf[n:]
+		f := protoField{num: num, typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			f.val, n = protowire.ConsumeVarint(buf)
+		case protowire.Fixed64Type:
+			f.val, n = protowire.ConsumeFixed64(buf)
+		case protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(buf)
+			f.val = uint64(v)
+		case protowire.BytesType:
+			f.b, n = protowire.ConsumeBytes(buf)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, buf)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		buf = buf[n:]
+		if err := fn(&f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendPackedValues appends the values of the repeated scalar field, which may be packed or not.
+func appendPackedValues(dst []uint64, f *protoField, fixed64 bool) ([]uint64, error) {
+	if f.typ != protowire.BytesType {
+		return append(dst, f.val), nil
+	}
+	b := f.b
+	for len(b) > 0 {
+		var v uint64
+		var n int
+		if fixed64 {
+			v, n = protowire.ConsumeFixed64(b)
+		} else {
+			v, n = protowire.ConsumeVarint(b)
+		}
+		if n < 0 {
+			return dst, protowire.ParseError(n)
+		}
+		dst = append(dst, v)
+		b = b[n:]
+	}
+	return dst, nil
+}
+
+func unmarshalPromLabel(buf []byte) (prompb2.Label, error) {
+	var l prompb2.Label
+	err := walkProto(buf, func(f *protoField) error {
+		switch f.num {
+		case promLabelName:
+			l.Name = f.b
+		case promLabelValue:
+			l.Value = f.b
+		}
+		return nil
+	})
+	return l, err
+}
+
+func unmarshalPromSample(buf []byte) (prompb2.Sample, error) {
+	var s prompb2.Sample
+	err := walkProto(buf, func(f *protoField) error {
+		switch f.num {
+		case promSampleValue:
+			s.Value = math.Float64frombits(f.val)
+		case promSampleTimestamp:
+			s.Timestamp = int64(f.val)
+		}
+		return nil
+	})
+	return s, err
+}
+
+func unmarshalPromV1Exemplar(buf []byte) (promExemplar, error) {
+	var e promExemplar
+	err := walkProto(buf, func(f *protoField) error {
+		switch f.num {
+		case promV1ExemplarLabels:
+			l, err := unmarshalPromLabel(f.b)
+			if err != nil {
+				return err
+			}
+			e.Labels = append(e.Labels, l)
+		case promV1ExemplarValue:
+			e.Value = math.Float64frombits(f.val)
+		case promV1ExemplarTimestamp:
+			e.Timestamp = int64(f.val)
+		}
+		return nil
+	})
+	return e, err
+}
+
+func unmarshalPromV2Exemplar(buf []byte, symbols [][]byte) (promExemplar, error) {
+	var e promExemplar
+	var refs []uint64
+	err := walkProto(buf, func(f *protoField) error {
+		var err error
+		switch f.num {
+		case promV2ExemplarLabelsRefs:
+			refs, err = appendPackedValues(refs, f, false)
+		case promV2ExemplarValue:
+			e.Value = math.Float64frombits(f.val)
+		case promV2ExemplarTimestamp:
+			e.Timestamp = int64(f.val)
+		}
+		return err
+	})
+	if err != nil {
+		return e, err
+	}
+	e.Labels, err = resolvePromLabels(refs, symbols)
+	return e, err
+}
+
+func unmarshalPromV2Metadata(buf []byte, symbols [][]byte) (*prompb.MetricMetadata, error) {
+	md := &prompb.MetricMetadata{}
+	err := walkProto(buf, func(f *protoField) error {
+		switch f.num {
+		case promV2MetadataType:
+			// the metric types of the protocol 2.0 are numbered the same as the protocol 1.0
+			md.Type = prompb.MetricMetadata_MetricType(f.val)
+		case promV2MetadataHelpRef, promV2MetadataUnitRef:
+			if f.val >= uint64(len(symbols)) {
+				return fmt.Errorf("symbol reference %d is out of %d symbols", f.val, len(symbols))
+			}
+			if f.num == promV2MetadataHelpRef {
+				md.Help = string(symbols[f.val])
+			} else {
+				md.Unit = string(symbols[f.val])
+			}
+		}
+		return nil
+	})
+	return md, err
+}
+
+// resolvePromLabels converts the pairs of the name and value references into the labels.
+func resolvePromLabels(refs []uint64, symbols [][]byte) ([]prompb2.Label, error) {
+	if len(refs)%2 != 0 {
+		return nil, fmt.Errorf("odd number of label references %d", len(refs))
+	}
+	labels := make([]prompb2.Label, 0, len(refs)/2)
+	for i := 0; i < len(refs); i += 2 {
+		if refs[i] >= uint64(len(symbols)) || refs[i+1] >= uint64(len(symbols)) {
+			return nil, fmt.Errorf("symbol reference %d or %d is out of %d symbols", refs[i], refs[i+1], len(symbols))
+		}
+		labels = append(labels, prompb2.Label{Name: symbols[refs[i]], Value: symbols[refs[i+1]]})
+	}
+	return labels, nil
+}
+
+func unmarshalPromHistogram(buf []byte) (promHistogram, error) {
+	var h promHistogram
+	var values []uint64
+	err := walkProto(buf, func(f *protoField) error {
+		var err error
+		switch f.num {
+		case promHistogramCountInt:
+			h.count = float64(f.val)
+		case promHistogramCountFloat:
+			h.count = math.Float64frombits(f.val)
+		case promHistogramSum:
+			h.sum = math.Float64frombits(f.val)
+		case promHistogramSchema:
+			h.schema = int32(protowire.DecodeZigZag(f.val))
+		case promHistogramZeroThreshold:
+			h.zeroThreshold = math.Float64frombits(f.val)
+		case promHistogramZeroCountInt:
+			h.zeroCount = float64(f.val)
+		case promHistogramZeroCountFloat:
+			h.zeroCount = math.Float64frombits(f.val)
+		case promHistogramNegativeSpans, promHistogramPositiveSpans:
+			var span promBucketSpan
+			if span, err = unmarshalPromBucketSpan(f.b); err != nil {
+				return err
+			}
+			if f.num == promHistogramNegativeSpans {
+				h.negativeSpans = append(h.negativeSpans, span)
+			} else {
+				h.positiveSpans = append(h.positiveSpans, span)
+			}
+		case promHistogramNegativeDeltas, promHistogramPositiveDeltas:
+			if values, err = appendPackedValues(values[:0], f, false); err != nil {
+				return err
+			}
+			for _, v := range values {
+				if f.num == promHistogramNegativeDeltas {
+					h.negativeDeltas = append(h.negativeDeltas, protowire.DecodeZigZag(v))
+				} else {
+					h.positiveDeltas = append(h.positiveDeltas, protowire.DecodeZigZag(v))
+				}
+			}
+		case promHistogramNegativeCounts, promHistogramPositiveCounts, promHistogramCustomValues:
+			if values, err = appendPackedValues(values[:0], f, true); err != nil {
+				return err
+			}
+			for _, v := range values {
+				switch f.num {
+				case promHistogramNegativeCounts:
+					h.negativeCounts = append(h.negativeCounts, math.Float64frombits(v))
+				case promHistogramPositiveCounts:
+					h.positiveCounts = append(h.positiveCounts, math.Float64frombits(v))
+				default:
+					h.customValues = append(h.customValues, math.Float64frombits(v))
+				}
+			}
+		case promHistogramTimestamp:
+			h.timestamp = int64(f.val)
+		}
+		return nil
+	})
+	return h, err
+}
+
+func unmarshalPromBucketSpan(buf []byte) (promBucketSpan, error) {
+	var span promBucketSpan
+	err := walkProto(buf, func(f *protoField) error {
+		switch f.num {
+		case promBucketSpanOffset:
+			span.offset = int32(protowire.DecodeZigZag(f.val))
+		case promBucketSpanLength:
+			span.length = uint32(f.val)
+		}
+		return nil
+	})
+	return span, err
+}
@@ -0,0 +1,381 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"bytes"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// appendTestHistogram encodes the integer native histogram with schema 0, a zero bucket,
+// one negative bucket and two positive buckets.
+func appendTestHistogram(b []byte, ts int64) []byte {
+	span := protowire.AppendTag(nil, promBucketSpanOffset, protowire.VarintType)
+	span = protowire.AppendVarint(span, protowire.EncodeZigZag(0))
+	span = protowire.AppendTag(span, promBucketSpanLength, protowire.VarintType)
+	span = protowire.AppendVarint(span, 1)
+
+	posSpan := protowire.AppendTag(nil, promBucketSpanOffset, protowire.VarintType)
+	posSpan = protowire.AppendVarint(posSpan, protowire.EncodeZigZag(0))
+	posSpan = protowire.AppendTag(posSpan, promBucketSpanLength, protowire.VarintType)
+	posSpan = protowire.AppendVarint(posSpan, 2)
+
+	var deltas []byte
+	deltas = protowire.AppendVarint(deltas, protowire.EncodeZigZag(2))
+	deltas = protowire.AppendVarint(deltas, protowire.EncodeZigZag(-1))
+
+	var h []byte
+	h = protowire.AppendTag(h, promHistogramCountInt, protowire.VarintType)
+	h = protowire.AppendVarint(h, 5)
+	h = protowire.AppendTag(h, promHistogramSum, protowire.Fixed64Type)
+	h = protowire.AppendFixed64(h, math.Float64bits(3.5))
+	h = protowire.AppendTag(h, promHistogramSchema, protowire.VarintType)
+	h = protowire.AppendVarint(h, protowire.EncodeZigZag(0))
+	h = protowire.AppendTag(h, promHistogramZeroThreshold, protowire.Fixed64Type)
+	h = protowire.AppendFixed64(h, math.Float64bits(0.001))
+	h = protowire.AppendTag(h, promHistogramZeroCountInt, protowire.VarintType)
+	h = protowire.AppendVarint(h, 1)
+	h = protowire.AppendTag(h, promHistogramNegativeSpans, protowire.BytesType)
+	h = protowire.AppendBytes(h, span)
+	h = protowire.AppendTag(h, promHistogramNegativeDeltas, protowire.VarintType)
+	h = protowire.AppendVarint(h, protowire.EncodeZigZag(1))
+	h = protowire.AppendTag(h, promHistogramPositiveSpans, protowire.BytesType)
+	h = protowire.AppendBytes(h, posSpan)
+	h = protowire.AppendTag(h, promHistogramPositiveDeltas, protowire.BytesType)
+	h = protowire.AppendBytes(h, deltas)
+	h = protowire.AppendTag(h, promHistogramTimestamp, protowire.VarintType)
+	h = protowire.AppendVarint(h, uint64(ts))
+
+	b = protowire.AppendTag(b, promV1Histograms, protowire.BytesType)
+	return protowire.AppendBytes(b, h)
+}
+
+func newPromWriteV1Body(t *testing.T) *bytes.Reader {
+	ts := prompb.TimeSeries{
+		Labels:  []prompb.Label{{Name: "__name__", Value: "http_requests_total"}, {Name: "job", Value: "a"}},
+		Samples: []prompb.Sample{{Value: 10, Timestamp: 1000}},
+		Exemplars: []prompb.Exemplar{{
+			Labels:    []prompb.Label{{Name: "trace_id", Value: "abc"}},
+			Value:     1,
+			Timestamp: 900,
+		}},
+	}
+	series, err := ts.Marshal()
+	require.NoError(t, err)
+
+	hs := prompb.TimeSeries{Labels: []prompb.Label{{Name: "__name__", Value: "latency"}, {Name: "job", Value: "a"}}}
+	hSeries, err := hs.Marshal()
+	require.NoError(t, err)
+	hSeries = appendTestHistogram(hSeries, 2000)
+
+	var buf []byte
+	buf = protowire.AppendTag(buf, promV1Timeseries, protowire.BytesType)
+	buf = protowire.AppendBytes(buf, series)
+	buf = protowire.AppendTag(buf, promV1Timeseries, protowire.BytesType)
+	buf = protowire.AppendBytes(buf, hSeries)
+	return bytes.NewReader(snappy.Encode(nil, buf))
+}
+
+func newPromWriteV2Body() *bytes.Reader {
+	symbols := []string{"", "__name__", "up", "job", "a", "trace_id", "abc", "The up.", "latency"}
+	var buf []byte
+	for _, s := range symbols {
+		buf = protowire.AppendTag(buf, promV2Symbols, protowire.BytesType)
+		buf = protowire.AppendString(buf, s)
+	}
+
+	var refs []byte
+	for _, ref := range []uint64{1, 2, 3, 4} {
+		refs = protowire.AppendVarint(refs, ref)
+	}
+	var sample []byte
+	sample = protowire.AppendTag(sample, promSampleValue, protowire.Fixed64Type)
+	sample = protowire.AppendFixed64(sample, math.Float64bits(1))
+	sample = protowire.AppendTag(sample, promSampleTimestamp, protowire.VarintType)
+	sample = protowire.AppendVarint(sample, 1000)
+	var exemplar []byte
+	exemplar = protowire.AppendTag(exemplar, promV2ExemplarLabelsRefs, protowire.BytesType)
+	exemplar = protowire.AppendBytes(exemplar, protowire.AppendVarint(protowire.AppendVarint(nil, 5), 6))
+	exemplar = protowire.AppendTag(exemplar, promV2ExemplarValue, protowire.Fixed64Type)
+	exemplar = protowire.AppendFixed64(exemplar, math.Float64bits(1))
+	exemplar = protowire.AppendTag(exemplar, promV2ExemplarTimestamp, protowire.VarintType)
+	exemplar = protowire.AppendVarint(exemplar, 1000)
+	var md []byte
+	md = protowire.AppendTag(md, promV2MetadataType, protowire.VarintType)
+	md = protowire.AppendVarint(md, uint64(prompb.MetricMetadata_GAUGE))
+	md = protowire.AppendTag(md, promV2MetadataHelpRef, protowire.VarintType)
+	md = protowire.AppendVarint(md, 7)
+
+	var series []byte
+	series = protowire.AppendTag(series, promV2LabelsRefs, protowire.BytesType)
+	series = protowire.AppendBytes(series, refs)
+	series = protowire.AppendTag(series, promV2Samples, protowire.BytesType)
+	series = protowire.AppendBytes(series, sample)
+	series = protowire.AppendTag(series, promV2Exemplars, protowire.BytesType)
+	series = protowire.AppendBytes(series, exemplar)
+	series = protowire.AppendTag(series, promV2Metadata, protowire.BytesType)
+	series = protowire.AppendBytes(series, md)
+	buf = protowire.AppendTag(buf, promV2Timeseries, protowire.BytesType)
+	buf = protowire.AppendBytes(buf, series)
+
+	var hRefs []byte
+	for _, ref := range []uint64{1, 8, 3, 4} {
+		hRefs = protowire.AppendVarint(hRefs, ref)
+	}
+	var hSeries []byte
+	hSeries = protowire.AppendTag(hSeries, promV2LabelsRefs, protowire.BytesType)
+	hSeries = protowire.AppendBytes(hSeries, hRefs)
+	// the histograms of both protocols have the same encoding, but the field number of the series differ.
+	h := appendTestHistogram(nil, 2000)
+	_, _, n := protowire.ConsumeTag(h)
+	hSeries = protowire.AppendTag(hSeries, promV2Histograms, protowire.BytesType)
+	hSeries = append(hSeries, h[n:]...)
+	buf = protowire.AppendTag(buf, promV2Timeseries, protowire.BytesType)
+	buf = protowire.AppendBytes(buf, hSeries)
+	return bytes.NewReader(snappy.Encode(nil, buf))
+}
+
+func rowTag(r *influx.Row, key string) string {
+	for _, tag := range r.Tags {
+		if tag.Key == key {
+			return tag.Value
+		}
+	}
+	return ""
+}
+
+// checkHistogramRows checks the classic histogram converted from appendTestHistogram.
+func checkHistogramRows(t *testing.T, rows influx.Rows, name func(r *influx.Row) string) {
+	buckets := make(map[string]float64)
+	var count, sum float64
+	for i := range rows {
+		switch name(&rows[i]) {
+		case "latency_bucket":
+			buckets[rowTag(&rows[i], "le")] = rows[i].Fields[0].NumValue
+			assert.Equal(t, int64(2000*1e6), rows[i].Timestamp)
+			assert.Equal(t, "a", rowTag(&rows[i], "job"))
+		case "latency_count":
+			count = rows[i].Fields[0].NumValue
+		case "latency_sum":
+			sum = rows[i].Fields[0].NumValue
+		}
+	}
+	assert.Equal(t, map[string]float64{"-0.5": 1, "0.001": 2, "1": 4, "2": 5, "+Inf": 5}, buckets)
+	assert.Equal(t, float64(5), count)
+	assert.Equal(t, 3.5, sum)
+}
+
+func TestPromWriteProtoMsg(t *testing.T) {
+	for contentType, expected := range map[string]string{
+		"":                       promRemoteWriteV1,
+		"application/x-protobuf": promRemoteWriteV1,
+		"application/x-protobuf;proto=prometheus.WriteRequest":        promRemoteWriteV1,
+		"application/x-protobuf;proto=io.prometheus.write.v2.Request": promRemoteWriteV2,
+	} {
+		msg, err := promWriteProtoMsg(contentType)
+		require.NoError(t, err)
+		assert.Equal(t, expected, msg)
+	}
+	_, err := promWriteProtoMsg("application/x-protobuf;proto=io.prometheus.write.v3.Request")
+	assert.Error(t, err)
+	_, err = promWriteProtoMsg(";;")
+	assert.Error(t, err)
+}
+
+func TestPromHistogram_CumulativeBuckets(t *testing.T) {
+	h := &promHistogram{
+		count:          6,
+		schema:         customBucketsSchema,
+		positiveSpans:  []promBucketSpan{{offset: 0, length: 1}, {offset: 1, length: 1}},
+		positiveCounts: []float64{1, 5},
+		customValues:   []float64{0.1, 1},
+	}
+	bounds, counts, err := h.cumulativeBuckets()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0.1, math.Inf(1)}, bounds)
+	assert.Equal(t, []float64{1, 6}, counts)
+
+	// schema -1 doubles the bucket twice, and schema 1 by the square root of 2
+	assert.Equal(t, float64(16), exponentialBound(2, -1))
+	assert.Equal(t, float64(2), exponentialBound(2, 1))
+	assert.InDelta(t, math.Sqrt2, exponentialBound(1, 1), 1e-12)
+
+	h = &promHistogram{schema: 9}
+	_, _, err = h.cumulativeBuckets()
+	assert.Error(t, err)
+
+	h = &promHistogram{positiveSpans: []promBucketSpan{{length: 2}}, positiveDeltas: []int64{1}}
+	_, _, err = h.cumulativeBuckets()
+	assert.Error(t, err)
+
+	h = &promHistogram{schema: customBucketsSchema, positiveSpans: []promBucketSpan{{offset: 3, length: 1}}, positiveCounts: []float64{1}}
+	_, _, err = h.cumulativeBuckets()
+	assert.Error(t, err)
+}
+
+func TestPromHistogram_BucketLimit(t *testing.T) {
+	// 400 buckets of schema 8 from the bound 1 are downscaled to schema 6 with 100 buckets
+	counts := make([]float64, 400)
+	for i := range counts {
+		counts[i] = 1
+	}
+	h := &promHistogram{
+		count:          400,
+		schema:         8,
+		positiveSpans:  []promBucketSpan{{offset: 1, length: 400}},
+		positiveCounts: counts,
+	}
+	bounds, cumulative, err := h.cumulativeBuckets()
+	require.NoError(t, err)
+	// the zero bucket, 100 buckets and +Inf
+	require.Equal(t, 102, len(bounds))
+	assert.Equal(t, exponentialBound(1, 6), bounds[1])
+	assert.Equal(t, float64(4), cumulative[1])
+	assert.Equal(t, exponentialBound(100, 6), bounds[100])
+	assert.Equal(t, float64(400), cumulative[100])
+	assert.Equal(t, math.Inf(1), bounds[101])
+	// the counts of the histogram are untouched
+	assert.Equal(t, float64(1), counts[1])
+
+	// the merged bucket idx of schema-1 covers the buckets 2*idx-1 and 2*idx
+	indexes, merged := downscalePromBuckets([]int32{-2, -1, 0, 1, 2, 3}, []float64{1, 2, 3, 4, 5, 6})
+	assert.Equal(t, []int32{-1, 0, 1, 2}, indexes)
+	assert.Equal(t, []float64{1, 5, 9, 6}, merged)
+
+	// the custom buckets can not be merged
+	h = &promHistogram{
+		schema:         customBucketsSchema,
+		positiveSpans:  []promBucketSpan{{length: maxPromHistogramBuckets + 1}},
+		positiveCounts: make([]float64, maxPromHistogramBuckets+1),
+		customValues:   make([]float64, maxPromHistogramBuckets+1),
+	}
+	_, _, err = h.cumulativeBuckets()
+	assert.EqualError(t, err, "native histogram with 161 buckets exceeds the limit of 160 buckets")
+
+	// the span lengths are checked before the buckets are expanded
+	h = &promHistogram{
+		schema:         0,
+		positiveSpans:  []promBucketSpan{{length: math.MaxUint32}, {length: math.MaxUint32}},
+		positiveDeltas: []int64{1},
+	}
+	_, _, err = h.cumulativeBuckets()
+	assert.EqualError(t, err, "native histogram with 8589934590 buckets exceeds the limit of 10240 buckets")
+
+	h = &promHistogram{
+		schema:         0,
+		positiveSpans:  []promBucketSpan{{length: 2}, {offset: 1, length: 1}},
+		positiveCounts: []float64{1, 2},
+	}
+	_, _, err = h.cumulativeBuckets()
+	assert.EqualError(t, err, "the spans of 3 buckets mismatch 2 counts")
+}
+
+func TestHandler_PromWrite_V1Extensions(t *testing.T) {
+	w := &mockOTLPPointsWriter{}
+	h := newOTLPHandler(w)
+	var user meta.User
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/prom/write?db=db0", newPromWriteV1Body(t))
+	h.servePromWrite(rec, req, user)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "", rec.Header().Get(promWrittenSamplesHeader))
+
+	var samples, exemplars int
+	for i := range w.rows {
+		switch w.rows[i].Name {
+		case "http_requests_total":
+			samples++
+		case PromExemplarMeasurement:
+			exemplars++
+			assert.Equal(t, "http_requests_total", rowTag(&w.rows[i], "__name__"))
+			assert.Equal(t, `{trace_id="abc"}`, w.rows[i].Fields[0].StrValue)
+			assert.Equal(t, float64(1), w.rows[i].Fields[1].NumValue)
+			assert.Equal(t, int64(900*1e6), w.rows[i].Timestamp)
+		}
+	}
+	assert.Equal(t, 1, samples)
+	assert.Equal(t, 1, exemplars)
+	checkHistogramRows(t, w.rows, func(r *influx.Row) string { return r.Name })
+}
+
+func TestHandler_PromWrite_V2(t *testing.T) {
+	w := &mockOTLPPointsWriter{}
+	h := newOTLPHandler(w)
+	h.promMetadata = newPromMetadataCache()
+	var user meta.User
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/prometheus/metric_store/api/v1/prom/write?db=db0", newPromWriteV2Body())
+	req.Header.Set("Content-Type", "application/x-protobuf;proto=io.prometheus.write.v2.Request")
+	h.servePromWriteBase(rec, req, user, "metrics", timeSeries2RowsV2)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get(promWrittenSamplesHeader))
+	assert.Equal(t, "1", rec.Header().Get(promWrittenHistogramsHeader))
+	assert.Equal(t, "1", rec.Header().Get(promWrittenExemplarsHeader))
+
+	var up, exemplars, metadata int
+	for i := range w.rows {
+		switch w.rows[i].Name {
+		case PromMetadataMeasurement:
+			metadata++
+			assert.Equal(t, "The up.", w.rows[i].Fields[0].StrValue)
+			assert.Equal(t, "up", w.rows[i].Fields[1].StrValue)
+			assert.Equal(t, "gauge", w.rows[i].Fields[2].StrValue)
+		case PromExemplarMeasurement:
+			exemplars++
+			assert.Equal(t, `{trace_id="abc"}`, w.rows[i].Fields[0].StrValue)
+		case "metrics":
+			if rowTag(&w.rows[i], "__name__") == "up" {
+				up++
+				assert.Equal(t, "a", rowTag(&w.rows[i], "job"))
+				assert.Equal(t, float64(1), w.rows[i].Fields[0].NumValue)
+			}
+		}
+	}
+	assert.Equal(t, 1, up)
+	assert.Equal(t, 1, exemplars)
+	assert.Equal(t, 1, metadata)
+	checkHistogramRows(t, w.rows, func(r *influx.Row) string { return rowTag(r, "__name__") })
+
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/prom/write?db=db0", newPromWriteV2Body())
+	req.Header.Set("Content-Type", "application/x-protobuf;proto=io.prometheus.write.v3.Request")
+	h.servePromWrite(rec, req, user)
+	assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+
+	// the label reference is out of the symbols
+	var buf []byte
+	buf = protowire.AppendTag(buf, promV2Timeseries, protowire.BytesType)
+	buf = protowire.AppendBytes(buf, protowire.AppendBytes(protowire.AppendTag(nil, promV2LabelsRefs, protowire.BytesType), []byte{1, 2}))
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/prom/write?db=db0", bytes.NewReader(snappy.Encode(nil, buf)))
+	req.Header.Set("Content-Type", "application/x-protobuf;proto=io.prometheus.write.v2.Request")
+	h.servePromWrite(rec, req, user)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -2,10 +2,15 @@ package httpd
 
 import (
 	"bufio"
+	"bytes"
+	"errors"
 	"fmt"
 	"math"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -13,16 +18,23 @@ import (
 
 	prompb2 "github.com/VictoriaMetrics/VictoriaMetrics/lib/prompb"
 	"github.com/gorilla/mux"
+	"github.com/influxdata/influxdb"
+	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/services/httpd"
+	originql "github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/lib/audit"
+	config2 "github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
 	"github.com/openGemini/openGemini/lib/pool"
+	"github.com/openGemini/openGemini/lib/quota"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/syscontrol"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"github.com/stretchr/testify/assert"
@@ -221,6 +233,32 @@ func TestHandler_Disabled_Prom_Query(t *testing.T) {
 	})
 }
 
+func TestHandler_PromQueryQuota(t *testing.T) {
+	h := Handler{
+		requestTracker: httpd.NewRequestTracker(),
+		Logger:         logger.NewLogger(errno.ModuleHTTP),
+		Config:         &config.Config{},
+		QuotaManager:   quota.NewManager(&mockQuotaSource{dbs: map[string]*meta.QuotaInfo{"db1": {MaxConcurrentQueries: 1}}}),
+	}
+	var user meta.User
+
+	qt, err := h.QuotaManager.BeginQuery("", "db1")
+	assert.NoError(t, err)
+	w := httptest.NewRecorder()
+	h.servePromQuery(w, httptest.NewRequest(http.MethodGet, "/api/v1/query?db=db1&query=1%2B1", nil), user)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Contains(t, w.Body.String(), "quota_exceeded")
+	w = httptest.NewRecorder()
+	h.servePromQueryRange(w, httptest.NewRequest(http.MethodGet, "/api/v1/query_range?db=db1&query=1%2B1&start=1708572257&end=1708585337&step=15s", nil), user)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	qt.Done()
+
+	w = httptest.NewRecorder()
+	h.servePromQuery(w, httptest.NewRequest(http.MethodGet, "/api/v1/query?db=db1&query=1%2B1", nil), user)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, int64(0), h.QuotaManager.Usage(quota.Database, "db1").ConcurrentQueries)
+}
+
 func TestHandler_Prom_Metadata_Query(t *testing.T) {
 	h := Handler{
 		requestTracker: httpd.NewRequestTracker(),
@@ -739,3 +777,129 @@ func TestMergeFragments(t *testing.T) {
 	fragments = mergeFragments(fragments)
 	assert.Equal(t, 2, len(fragments))
 }
+
+type mockQuotaSource struct {
+	users map[string]*meta.QuotaInfo
+	dbs   map[string]*meta.QuotaInfo
+}
+
+func (s *mockQuotaSource) UserQuota(name string) *meta.QuotaInfo {
+	return s.users[name]
+}
+
+func (s *mockQuotaSource) DatabaseQuota(name string) *meta.QuotaInfo {
+	return s.dbs[name]
+}
+
+func TestHandler_WriteQuota(t *testing.T) {
+	h := &Handler{}
+	h.QuotaManager = quota.NewManager(&mockQuotaSource{users: map[string]*meta.QuotaInfo{"user1": {PointsPerSecond: 2}}})
+
+	user1 := &meta.UserInfo{Name: "user1"}
+	assert.NoError(t, h.allowWrite(user1, "db0", 2, 100))
+	err := h.allowWrite(user1, "db0", 2, 100)
+	assert.True(t, errno.Equal(err, errno.WriteQuotaExceeded))
+	assert.NoError(t, h.allowWrite(&meta.UserInfo{Name: "user2"}, "db0", 2, 100))
+	assert.NoError(t, h.allowWrite(nil, "db0", 2, 100))
+
+	h.QuotaManager = quota.NewManager(&mockQuotaSource{dbs: map[string]*meta.QuotaInfo{"db1": {PointsPerSecond: 2}}})
+	assert.NoError(t, h.allowWrite(nil, "db1", 2, 100))
+	err = h.allowWrite(nil, "db1", 2, 100)
+	assert.True(t, errno.Equal(err, errno.WriteQuotaExceeded))
+
+	// the write rejected by the database quota takes no points from the user quota
+	h.QuotaManager = quota.NewManager(&mockQuotaSource{
+		users: map[string]*meta.QuotaInfo{"user1": {PointsPerSecond: 2}},
+		dbs:   map[string]*meta.QuotaInfo{"db1": {PointsPerSecond: 2}},
+	})
+	assert.NoError(t, h.allowWrite(nil, "db1", 2, 100))
+	err = h.allowWrite(user1, "db1", 2, 100)
+	assert.True(t, errno.Equal(err, errno.WriteQuotaExceeded))
+	assert.NoError(t, h.allowWrite(user1, "db0", 2, 100))
+}
+
+func TestAuthorizeSeriesWrite(t *testing.T) {
+	mp, err := meta.NewMeasurementPrivilege("db0", "cpu", originql.WritePrivilege, "tenant = 'acme'")
+	assert.NoError(t, err)
+	user := &meta.UserInfo{Name: "user1", MeasurementPrivileges: []*meta.MeasurementPrivilege{mp}}
+
+	rows := []influx.Row{{Name: "cpu", Tags: influx.PointTags{{Key: "host", Value: "h1"}, {Key: "tenant", Value: "acme"}}}}
+	assert.NoError(t, authorizeSeriesWrite(nil, "db0", rows))
+	assert.NoError(t, authorizeSeriesWrite(user, "db0", rows))
+
+	rows = append(rows, influx.Row{Name: "cpu", Tags: influx.PointTags{{Key: "tenant", Value: "other"}}})
+	err = authorizeSeriesWrite(user, "db0", rows)
+	assert.True(t, influxdb.IsAuthorizationError(err))
+	assert.Error(t, authorizeSeriesWrite(user, "db0", []influx.Row{{Name: "mem"}}))
+}
+
+func TestHandler_AuditSysCtrl(t *testing.T) {
+	conf := config2.NewAuditConfig()
+	conf.Enabled = true
+	conf.Path = filepath.Join(t.TempDir(), "audit.log")
+	h := &Handler{
+		Config:         &config.Config{AuthEnabled: true},
+		requestTracker: httpd.NewRequestTracker(),
+		Logger:         logger.NewLogger(errno.ModuleHTTP),
+		AuditLogger:    audit.NewLogger(conf),
+	}
+	syscontrol.SysCtrl.MetaClient = &mockMetaClient{}
+	syscontrol.SysCtrl.NetStore = &mockStorage{}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/debug/ctrl?mod=print_logical_plan&enabled=0&u=user1&p=secret", nil)
+	h.serveSysCtrl(w, req, &meta.UserInfo{Name: "user1"})
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/debug/ctrl?mod=print_logical_plan&enabled=0", nil)
+	h.serveSysCtrl(w, req, &meta.UserInfo{Name: "admin", Admin: true})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NoError(t, h.AuditLogger.Close())
+
+	buf, err := os.ReadFile(conf.Path)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(buf)), "\n")
+	assert.Equal(t, 2, len(lines))
+	var e audit.Event
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &e))
+	assert.Equal(t, "user1", e.User)
+	assert.Equal(t, audit.Failure, e.Result)
+	assert.Equal(t, "POST /debug/ctrl?enabled=0&mod=print_logical_plan", e.Statement)
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &e))
+	assert.Equal(t, "admin", e.User)
+	assert.Equal(t, audit.Success, e.Result)
+	assert.Equal(t, "192.0.2.1", e.ClientIP)
+}
+
+func TestHandler_AuditAuthentication(t *testing.T) {
+	conf := config2.NewAuditConfig()
+	conf.Enabled = true
+	conf.Path = filepath.Join(t.TempDir(), "audit.log")
+	h := &Handler{AuditLogger: audit.NewLogger(conf)}
+
+	req := httptest.NewRequest(http.MethodPost, "/query?db=db0&u=user1&p=secret1&q="+
+		url.QueryEscape("CREATE USER user2 WITH PASSWORD 'secret2'"), nil)
+	h.auditRequest(audit.Authentication, "user1", req, errors.New("authorization failed"))
+	assert.NoError(t, h.AuditLogger.Close())
+
+	buf, err := os.ReadFile(conf.Path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(buf), "secret")
+	var e audit.Event
+	assert.NoError(t, json.Unmarshal(bytes.TrimSpace(buf), &e))
+	assert.Equal(t, audit.Authentication, e.Operation)
+	assert.Equal(t, "POST /query", e.Statement)
+	assert.Equal(t, "db0", e.Database)
+	assert.Equal(t, audit.Failure, e.Result)
+}
+
+func TestEstimateResultSize(t *testing.T) {
+	r := &query.Result{Series: models.Rows{{
+		Name:    "cpu",
+		Tags:    map[string]string{"host": "h1"},
+		Columns: []string{"time", "value"},
+		Values:  [][]interface{}{{int64(1), "abc"}, {int64(2), 1.5}},
+	}}}
+	assert.Equal(t, int64(3+6+9+8+3+8+8), estimateResultSize(r))
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// error codes of the InfluxDB 2.x API
+const (
+	v2ErrInternal         = "internal error"
+	v2ErrNotFound         = "not found"
+	v2ErrInvalid          = "invalid"
+	v2ErrUnprocessable    = "unprocessable entity"
+	v2ErrUnavailable      = "unavailable"
+	v2ErrForbidden        = "forbidden"
+	v2ErrTooManyRequests  = "too many requests"
+	v2ErrUnauthorized     = "unauthorized"
+	v2ErrRequestTooLarge  = "request too large"
+	v2ErrUnsupportedMedia = "unsupported media type"
+)
+
+// v2Error is the error body of the InfluxDB 2.x API
+type v2Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func v2ErrorCode(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return v2ErrInvalid
+	case http.StatusUnauthorized:
+		return v2ErrUnauthorized
+	case http.StatusForbidden:
+		return v2ErrForbidden
+	case http.StatusNotFound:
+		return v2ErrNotFound
+	case http.StatusRequestEntityTooLarge:
+		return v2ErrRequestTooLarge
+	case http.StatusUnsupportedMediaType:
+		return v2ErrUnsupportedMedia
+	case http.StatusUnprocessableEntity:
+		return v2ErrUnprocessable
+	case http.StatusTooManyRequests:
+		return v2ErrTooManyRequests
+	case http.StatusServiceUnavailable:
+		return v2ErrUnavailable
+	default:
+		return v2ErrInternal
+	}
+}
+
+// v2ResponseWriter writes the errors reported by httpError in the InfluxDB 2.x format
+type v2ResponseWriter struct {
+	ResponseWriter
+	status int
+}
+
+func (w *v2ResponseWriter) WriteHeader(status int) {
+	w.status = status
+	if status/100 != 2 {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *v2ResponseWriter) WriteResponse(resp Response) (int, error) {
+	if resp.Err == nil {
+		return w.ResponseWriter.WriteResponse(resp)
+	}
+	b, err := json.Marshal(&v2Error{Code: v2ErrorCode(w.status), Message: resp.Err.Error()})
+	if err != nil {
+		return 0, err
+	}
+	return w.Write(b)
+}
+
+// v2ResponseWriterFilter must be wrapped by responseWriter
+func v2ResponseWriterFilter(inner http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rw, ok := w.(ResponseWriter); ok {
+			w = &v2ResponseWriter{ResponseWriter: rw}
+		}
+		inner.ServeHTTP(w, r)
+	})
+}
+
+// serveWriteV2 is the InfluxDB 2.x compatible write API, the bucket is mapped to the database
+// and retention policy, and the org is ignored. Token auth is handled by authenticate, the token
+// is in the form of "username:password".
+func (h *Handler) serveWriteV2(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	precision := r.URL.Query().Get("precision")
+	switch precision {
+	case "", "ns", "us", "ms", "s":
+	default:
+		h.httpError(w, fmt.Sprintf("invalid precision %q (use ns, us, ms or s)", precision), http.StatusBadRequest)
+		return
+	}
+
+	db, rp, err := bucket2dbrp(r.URL.Query().Get("bucket"))
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	h.writeLineProtocol(w, r, user, db, rp, precision)
+}
+
+// bucket2dbrp extracts the database and retention policy from the bucket, which
+// is in the form of "database/retention-policy" or "database".
+func bucket2dbrp(bucket string) (string, string, error) {
+	db, rp, _ := strings.Cut(bucket, "/")
+	if db == "" {
+		return "", "", fmt.Errorf(`bucket %q is not in "database/retention-policy" format`, bucket)
+	}
+	return db, rp, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucket2dbrp(t *testing.T) {
+	db, rp, err := bucket2dbrp("db0/rp0")
+	require.NoError(t, err)
+	assert.Equal(t, "db0", db)
+	assert.Equal(t, "rp0", rp)
+
+	db, rp, err = bucket2dbrp("db0")
+	require.NoError(t, err)
+	assert.Equal(t, "db0", db)
+	assert.Equal(t, "", rp)
+
+	_, _, err = bucket2dbrp("")
+	assert.Error(t, err)
+	_, _, err = bucket2dbrp("/rp0")
+	assert.Error(t, err)
+}
+
+func TestHandler_ServeWriteV2(t *testing.T) {
+	influx.StartUnmarshalWorkers()
+	defer influx.StopUnmarshalWorkers()
+
+	pw := &mockOTLPPointsWriter{}
+	h := newOTLPHandler(pw)
+	c := config.NewConfig()
+	h.Config = &c
+	handler := h.responseWriter(v2ResponseWriterFilter(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.serveWriteV2(w, r, nil)
+	})))
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/api/v2/write?org=my-org&bucket=db0/rp1&precision=s", strings.NewReader("cpu,host=h1 value=1 1678898440\n"))
+	r.Header.Set("Authorization", "Token user:pwd")
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "rp1", pw.rp)
+	require.Equal(t, 1, len(pw.rows))
+	assert.Equal(t, int64(1678898440000000000), pw.rows[0].Timestamp)
+
+	// the database of the bucket does not exist
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodPost, "/api/v2/write?bucket=db1", strings.NewReader("cpu value=1\n"))
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"code":"not found","message":"database not found: \"db1\""}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodPost, "/api/v2/write?bucket=", nil)
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodPost, "/api/v2/write?bucket=db0&precision=h", nil)
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{"code":"invalid","message":"invalid precision \"h\" (use ns, us, ms or s)"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodPost, "/api/v2/write?bucket=db0", strings.NewReader("cpu value=\n"))
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"invalid"`)
+}
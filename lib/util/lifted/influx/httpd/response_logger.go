@@ -107,6 +107,20 @@ func hideUrlPassword(url string) string {
 	return buf.String()
 }
 
+// clientAddr returns the host of the client, preceded by the proxies of the X-Forwarded-For header.
+func clientAddr(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if xff := r.Header["X-Forwarded-For"]; xff != nil {
+		addrs := append(xff, host)
+		host = strings.Join(addrs, ",")
+	}
+	return host
+}
+
 // Common Log Format: http://en.wikipedia.org/wiki/Common_Log_Format
 
 // buildLogLine creates a common log format
@@ -121,15 +135,7 @@ func buildLogLine(l *responseLogger, r *http.Request, start time.Time) string {
 
 	username := parseUsername(r)
 
-	host, _, err := net.SplitHostPort(r.RemoteAddr)
-	if err != nil {
-		host = r.RemoteAddr
-	}
-
-	if xff := r.Header["X-Forwarded-For"]; xff != nil {
-		addrs := append(xff, host)
-		host = strings.Join(addrs, ",")
-	}
+	host := clientAddr(r)
 
 	uri := hideUrlPassword(r.URL.RequestURI())
 
@@ -1101,6 +1101,12 @@ type GrantStatement struct {
 	// Database to grant the privilege to.
 	On string
 
+	// Measurement to grant the privilege to, empty for all the measurements of the database.
+	Measurement string
+
+	// Condition on the tags of the series granted, nil for all the series.
+	Condition Expr
+
 	// Who to grant the privilege to.
 	User string
 }
@@ -1112,6 +1118,14 @@ func (s *GrantStatement) String() string {
 	_, _ = buf.WriteString(s.Privilege.String())
 	_, _ = buf.WriteString(" ON ")
 	_, _ = buf.WriteString(QuoteIdent(s.On))
+	if s.Measurement != "" {
+		_, _ = buf.WriteString(" MEASUREMENT ")
+		_, _ = buf.WriteString(QuoteIdent(s.Measurement))
+	}
+	if s.Condition != nil {
+		_, _ = buf.WriteString(" WHERE ")
+		_, _ = buf.WriteString(s.Condition.String())
+	}
 	_, _ = buf.WriteString(" TO ")
 	_, _ = buf.WriteString(QuoteIdent(s.User))
 	return buf.String()
@@ -1204,6 +1218,9 @@ type RevokeStatement struct {
 	// Database to revoke the privilege from.
 	On string
 
+	// Measurement to revoke the privilege from, empty for the database.
+	Measurement string
+
 	// Who to revoke privilege from.
 	User string
 }
@@ -1215,6 +1232,10 @@ func (s *RevokeStatement) String() string {
 	_, _ = buf.WriteString(s.Privilege.String())
 	_, _ = buf.WriteString(" ON ")
 	_, _ = buf.WriteString(QuoteIdent(s.On))
+	if s.Measurement != "" {
+		_, _ = buf.WriteString(" MEASUREMENT ")
+		_, _ = buf.WriteString(QuoteIdent(s.Measurement))
+	}
 	_, _ = buf.WriteString(" FROM ")
 	_, _ = buf.WriteString(QuoteIdent(s.User))
 	return buf.String()
@@ -1535,6 +1556,10 @@ type SelectStatement struct {
 	BinOpSource []*BinOp
 
 	IsPromQuery bool
+
+	// PromSubCall is set when the statement evaluates a PromQL range function over the
+	// result of its subquery source, e.g. max_over_time(rate(x[5m])[1h:1m]).
+	PromSubCall *PromSubCall
 }
 
 func (s *SelectStatement) SetStmtId(id int) {
@@ -1651,6 +1676,7 @@ func cloneSource(s Source) Source {
 		c.LSrc = cloneSource(s.LSrc)
 		c.RSrc = cloneSource(s.RSrc)
 		c.Condition = CloneExpr(s.Condition)
+		c.JoinType = s.JoinType
 		return c
 	case *Unnest:
 		return s.Clone()
@@ -2290,7 +2316,8 @@ func (s *SelectStatement) RewriteFields(m FieldMapper, batchEn bool, hasJoin boo
 					supportedTypes[Boolean] = struct{}{}
 				case "holt_winters", "holt_winters_with_fit":
 					delete(supportedTypes, Unsigned)
-				case "str", "strlen", "substr":
+				case "str", "strlen", "substr", "lower", "upper", "trim", "replace", "regexp_extract", "regexp_replace",
+					"split_part", "concat", "starts_with", "ends_with", "position", "lpad", "rpad", "md5", "sha256", "json_extract":
 					supportedTypes[String] = struct{}{}
 					delete(supportedTypes, Integer)
 					delete(supportedTypes, Float)
@@ -3003,6 +3030,20 @@ func (s *SelectStatement) GroupByInterval() (time.Duration, error) {
 	return 0, nil
 }
 
+// GroupByMonths returns the calendar months of the time interval, or 0 if the interval is not
+// given in months.
+func (s *SelectStatement) GroupByMonths() int64 {
+	for _, d := range s.Dimensions {
+		if call, ok := d.Expr.(*Call); ok && call.Name == "time" && len(call.Args) > 0 {
+			if lit, ok := call.Args[0].(*DurationLiteral); ok {
+				return lit.Months
+			}
+			return 0
+		}
+	}
+	return 0
+}
+
 // GroupByOffset extracts the time interval offset, if specified.
 func (s *SelectStatement) GroupByOffset() (time.Duration, error) {
 	interval, err := s.GroupByInterval()
@@ -3020,6 +3061,9 @@ func (s *SelectStatement) GroupByOffset() (time.Duration, error) {
 			if len(call.Args) == 2 {
 				switch expr := call.Args[1].(type) {
 				case *DurationLiteral:
+					if expr.Months > 0 {
+						return 0, fmt.Errorf("invalid time dimension offset: %s", expr)
+					}
 					return expr.Val % interval, nil
 				case *TimeLiteral:
 					return expr.Val.Sub(expr.Val.Truncate(interval)), nil
@@ -4814,14 +4858,53 @@ func (s *SubQuery) GetName() string {
 	return s.Alias
 }
 
+// JoinType is the type of the join between two subqueries.
+type JoinType int
+
+const (
+	FullJoin JoinType = iota
+	InnerJoin
+	LeftOuterJoin
+	RightOuterJoin
+)
+
+func (t JoinType) String() string {
+	switch t {
+	case InnerJoin:
+		return "inner join"
+	case LeftOuterJoin:
+		return "left join"
+	case RightOuterJoin:
+		return "right join"
+	default:
+		return "full join"
+	}
+}
+
+// joinTypeOf returns the join type of the identifier before JOIN. INNER, LEFT and RIGHT are not
+// keywords so that they can still be used as the names of fields, tags and measurements.
+func joinTypeOf(ident string) (JoinType, bool) {
+	switch strings.ToLower(ident) {
+	case "inner":
+		return InnerJoin, true
+	case "left":
+		return LeftOuterJoin, true
+	case "right":
+		return RightOuterJoin, true
+	default:
+		return InnerJoin, false
+	}
+}
+
 type Join struct {
 	LSrc      Source
 	RSrc      Source
 	Condition Expr
+	JoinType  JoinType
 }
 
 func (j *Join) String() string {
-	return fmt.Sprintf("%s full join %s on %s", "1", "2", j.Condition.String())
+	return fmt.Sprintf("%s %s %s on %s", "1", j.JoinType, "2", j.Condition.String())
 }
 
 func (j *Join) GetName() string {
@@ -4834,8 +4917,20 @@ const (
 	OneToOne MatchCardinality = iota
 	ManyToOne
 	OneToMany
+	ManyToMany // set operations
 )
 
+// PromSubCall holds the evaluation parameters of a PromQL range function applied to a subquery.
+// The function is evaluated at each step from StartTime to EndTime over the points of the
+// subquery within [t-Offset-Range, t-Offset]. All times are in nanoseconds.
+type PromSubCall struct {
+	StartTime int64
+	EndTime   int64
+	Interval  int64 // zero for an instant query
+	Range     time.Duration
+	Offset    time.Duration
+}
+
 type BinOp struct {
 	LSrc        Source
 	RSrc        Source
@@ -5170,12 +5265,21 @@ func (l *TimeLiteral) String() string {
 // DurationLiteral represents a duration literal.
 type DurationLiteral struct {
 	Val time.Duration
+
+	// Months is the number of calendar months of a literal such as 3mo, which is only accepted
+	// as the interval of GROUP BY time(). Val is then Months times AverageMonth.
+	Months int64
 }
 
 func (l *DurationLiteral) RewriteNameSpace(alias, mst string) {}
 
 // String returns a string representation of the literal.
-func (l *DurationLiteral) String() string { return FormatDuration(l.Val) }
+func (l *DurationLiteral) String() string {
+	if l.Months > 0 {
+		return fmt.Sprintf("%dmo", l.Months)
+	}
+	return FormatDuration(l.Val)
+}
 
 // NilLiteral represents a nil literal.
 // This is not available to the query language itself. It's only used internally.
@@ -5340,7 +5444,7 @@ func CloneExpr(expr Expr) Expr {
 	case *Distinct:
 		return &Distinct{Val: expr.Val}
 	case *DurationLiteral:
-		return &DurationLiteral{Val: expr.Val}
+		return &DurationLiteral{Val: expr.Val, Months: expr.Months}
 	case *IntegerLiteral:
 		return &IntegerLiteral{Val: expr.Val}
 	case *UnsignedLiteral:
@@ -7821,6 +7925,9 @@ func (c *CreateStreamStatement) Check(stmt *SelectStatement, supportTable map[st
 	if err := stmt.StreamCheck(supportTable); err != nil {
 		return err
 	}
+	if stmt.GroupByMonths() > 0 {
+		return errors.New("GROUP BY time of calendar months is not supported by streams")
+	}
 	if stmt.groupByInterval*10 < c.Delay {
 		return errors.New("delay time must be smaller than 10 times of group by interval time")
 	}
@@ -7961,6 +8068,84 @@ func (s *SetConfigStatement) String() string {
 	return buf.String()
 }
 
+// QuotaOption is a single limit of a SET QUOTA statement, e.g. points_per_second = 1000.
+type QuotaOption struct {
+	Name  string
+	Value int64
+}
+
+// SetQuotaStatement represents a command for setting the quota of a user or a database.
+type SetQuotaStatement struct {
+	// Whether Name is a user or a database.
+	OnUser bool
+	Name   string
+
+	Options []QuotaOption
+}
+
+func (s *SetQuotaStatement) stmt() {}
+
+func (s *SetQuotaStatement) node() {}
+
+func (s *SetQuotaStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+func (s *SetQuotaStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("SET QUOTA ON ")
+	_, _ = buf.WriteString(quotaTarget(s.OnUser, s.Name))
+	_, _ = buf.WriteString(" WITH ")
+	for i, opt := range s.Options {
+		if i > 0 {
+			_, _ = buf.WriteString(", ")
+		}
+		_, _ = buf.WriteString(fmt.Sprintf("%s = %d", opt.Name, opt.Value))
+	}
+	return buf.String()
+}
+
+// DropQuotaStatement represents a command for removing the quota of a user or a database.
+type DropQuotaStatement struct {
+	// Whether Name is a user or a database.
+	OnUser bool
+	Name   string
+}
+
+func (s *DropQuotaStatement) stmt() {}
+
+func (s *DropQuotaStatement) node() {}
+
+func (s *DropQuotaStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+func (s *DropQuotaStatement) String() string {
+	return "DROP QUOTA ON " + quotaTarget(s.OnUser, s.Name)
+}
+
+func quotaTarget(onUser bool, name string) string {
+	if onUser {
+		return "USER " + QuoteIdent(name)
+	}
+	return "DATABASE " + QuoteIdent(name)
+}
+
+// ShowQuotasStatement represents a command for listing the quotas and their current usage.
+type ShowQuotasStatement struct{}
+
+func (s *ShowQuotasStatement) stmt() {}
+
+func (s *ShowQuotasStatement) node() {}
+
+func (s *ShowQuotasStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+func (s *ShowQuotasStatement) String() string {
+	return "SHOW QUOTAS"
+}
+
 type ShowClusterStatement struct {
 	NodeType string
 	NodeID   int64
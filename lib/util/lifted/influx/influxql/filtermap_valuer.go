@@ -21,12 +21,13 @@ import (
 )
 
 type FilterMapValue struct {
-	DataType     int
-	FloatValue   float64
-	IntegerValue int64
-	BooleanValue bool
-	StringValue  string
-	IsNil        bool
+	DataType      int
+	FloatValue    float64
+	IntegerValue  int64
+	UnsignedValue uint64
+	BooleanValue  bool
+	StringValue   string
+	IsNil         bool
 }
 
 // FilterMapValuer is a valuer that substitutes values for the mapped interface.
@@ -51,6 +52,10 @@ func (m FilterMapValuer) SetFilterMapValue(name string, value interface{}) {
 		res.IsNil = true
 		res.DataType = influx.Field_Type_Int
 		return
+	case *uint64:
+		res.IsNil = true
+		res.DataType = influx.Field_Type_UInt
+		return
 	case *float64:
 		res.IsNil = true
 		res.DataType = influx.Field_Type_Float
@@ -69,6 +74,11 @@ func (m FilterMapValuer) SetFilterMapValue(name string, value interface{}) {
 		res.DataType = influx.Field_Type_Int
 		res.IntegerValue = v
 		return
+	case uint64:
+		res.IsNil = false
+		res.DataType = influx.Field_Type_UInt
+		res.UnsignedValue = v
+		return
 	case float64:
 		res.IsNil = false
 		res.DataType = influx.Field_Type_Float
@@ -94,6 +104,11 @@ func (m FilterMapValuer) Value(key string) (interface{}, bool) {
 			return (*int64)(nil), ok
 		}
 		return v.IntegerValue, ok
+	case influx.Field_Type_UInt:
+		if v.IsNil {
+			return (*uint64)(nil), ok
+		}
+		return v.UnsignedValue, ok
 	case influx.Field_Type_Float:
 		if v.IsNil {
 			return (*float64)(nil), ok
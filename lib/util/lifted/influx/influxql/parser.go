@@ -52,6 +52,10 @@ var SupportHit = map[string]bool{
 type Parser struct {
 	s      *bufScanner
 	params map[string]interface{}
+
+	// inDimension is set while a GROUP BY dimension is parsed, the only place calendar
+	// months are accepted.
+	inDimension bool
 }
 
 // NewParser returns a new instance of Parser.
@@ -81,6 +85,7 @@ func (p *Parser) GetScanner() *Scanner {
 func (p *Parser) reset(r io.Reader) {
 	p.s.reset(r)
 	p.params = nil
+	p.inDimension = false
 }
 
 func (p *Parser) Release() {
@@ -2527,7 +2532,9 @@ func (p *Parser) parseDimension() (*Dimension, error) {
 	}
 
 	// Parse the expression first.
+	p.inDimension = true
 	expr, err := p.ParseExpr()
+	p.inDimension = false
 	if err != nil {
 		return nil, err
 	}
@@ -2897,6 +2904,12 @@ func (p *Parser) parseUnaryExpr() (Expr, error) {
 	case TRUE, FALSE:
 		return &BooleanLiteral{Val: (tok == TRUE)}, nil
 	case DURATIONVAL:
+		if months, ok := parseMonths(lit); ok {
+			if !p.inDimension {
+				return nil, &ParseError{Message: "calendar months are only supported by GROUP BY time()", Pos: pos}
+			}
+			return &DurationLiteral{Val: time.Duration(months) * AverageMonth, Months: months}, nil
+		}
 		v, err := ParseDuration(lit)
 		if err != nil {
 			return nil, err
@@ -3253,6 +3266,23 @@ func ParseDuration(s string) (time.Duration, error) {
 	return d, nil
 }
 
+// AverageMonth is the average length of a month in the Gregorian calendar. It is the Val of a
+// DurationLiteral of calendar months, for the code that needs a fixed length.
+const AverageMonth = 2629746 * time.Second
+
+// parseMonths parses a number of calendar months such as "3mo". It returns false if s is not
+// a positive number of months or the months overflow a duration.
+func parseMonths(s string) (int64, bool) {
+	if !strings.HasSuffix(s, "mo") {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(s[:len(s)-2], 10, 64)
+	if err != nil || n <= 0 || n > int64(math.MaxInt64/AverageMonth) {
+		return 0, false
+	}
+	return n, true
+}
+
 // FormatDuration formats a duration to a string.
 func FormatDuration(d time.Duration) string {
 	if d == 0 {
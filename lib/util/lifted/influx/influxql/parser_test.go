@@ -2,11 +2,14 @@ package influxql_test
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParseExpr(t *testing.T) {
@@ -51,3 +54,51 @@ func BenchmarkParseExpr(b *testing.B) {
 		_, _ = influxql.ParseExpr(cond)
 	}
 }
+
+func TestParseGroupByCalendarMonths(t *testing.T) {
+	for _, sql := range []string{
+		"SELECT sum(v) FROM m GROUP BY time(3mo) TZ('Asia/Shanghai')",
+		"SELECT sum(v) FROM m GROUP BY time(3mo, 1d)",
+	} {
+		// the handwritten parser
+		stmt, err := influxql.ParseStatement(sql)
+		require.NoError(t, err)
+		sel := stmt.(*influxql.SelectStatement)
+		interval, err := sel.GroupByInterval()
+		require.NoError(t, err)
+		assert.Equal(t, 3*influxql.AverageMonth, interval)
+		assert.Equal(t, int64(3), sel.GroupByMonths())
+		assert.Equal(t, sql, stmt.String())
+		assert.Equal(t, int64(3), influxql.CloneExpr(sel.Dimensions[0].Expr).(*influxql.Call).Args[0].(*influxql.DurationLiteral).Months)
+
+		// the yacc parser
+		p := influxql.NewParser(strings.NewReader(sql))
+		yy := influxql.NewYyParser(p.GetScanner(), p.GetPara())
+		yy.ParseTokens()
+		q, err := yy.GetQuery()
+		p.Release()
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), q.Statements[0].(*influxql.SelectStatement).GroupByMonths())
+	}
+
+	// a duration as long as a month is not a month
+	stmt, err := influxql.ParseStatement("SELECT sum(v) FROM m GROUP BY time(2629746s)")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), stmt.(*influxql.SelectStatement).GroupByMonths())
+	assert.Equal(t, "SELECT sum(v) FROM m GROUP BY time(2629746s)", stmt.String())
+	d, err := influxql.ParseDuration("1mo")
+	assert.Error(t, err)
+	assert.Equal(t, time.Duration(0), d)
+
+	// the months are only accepted as the interval of GROUP BY time()
+	for _, sql := range []string{
+		"SELECT v FROM m WHERE time > now() - 1mo",
+		"SELECT sum(v) FROM m GROUP BY time(1d, 1mo)",
+	} {
+		stmt, err = influxql.ParseStatement(sql)
+		if err == nil {
+			_, err = stmt.(*influxql.SelectStatement).GroupByOffset()
+		}
+		assert.Error(t, err, sql)
+	}
+}
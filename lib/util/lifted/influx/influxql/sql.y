@@ -101,6 +101,8 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
     indexOption         *IndexOption
     databasePolicy      DatabasePolicy
     cmOption            *CreateMeasurementStatementOption
+    quotaOptions        []QuotaOption
+    privScope           *privilegeScope
 }
 
 %token <str>    FROM MEASUREMENT INTO ON SELECT WHERE AS GROUP BY ORDER LIMIT OFFSET SLIMIT SOFFSET SHOW CREATE FULL PRIVILEGES OUTER JOIN
@@ -115,12 +117,14 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
                 TOKEN TOKENIZERS MATCH LIKE MATCHPHRASE CONFIG CONFIGS CLUSTER
                 REPLICAS DETAIL DESTINATIONS
                 SCHEMA INDEXES AUTO EXCEPT
+                QUOTA QUOTAS
 %token <bool>   DESC ASC
 %token <str>    COMMA SEMICOLON LPAREN RPAREN REGEX
 %token <int>    EQ NEQ LT LTE GT GTE DOT DOUBLECOLON NEQREGEX EQREGEX
-%token <str>    IDENT
+%token <str>    IDENT JOINKIND
 %token <int64>  INTEGER
 %token <tdur>   DURATIONVAL
+%token <int64>  MONTHVAL
 %token <str>    STRING
 %token <float64> NUMBER
 %token <hints>  HINT
@@ -147,6 +151,7 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
                                     CREATE_STREAM_STATEMENT SHOW_STREAM_STATEMENT DROP_STREAM_STATEMENT COLUMN_LISTS SHOW_MEASUREMENT_KEYS_STATEMENT
                                     SHOW_QUERIES_STATEMENT KILL_QUERY_STATEMENT SHOW_CONFIGS_STATEMENT SET_CONFIG_STATEMENT SHOW_CLUSTER_STATEMENT
                                     CREATE_SUBSCRIPTION_STATEMENT SHOW_SUBSCRIPTION_STATEMENT DROP_SUBSCRIPTION_STATEMENT
+                                    SET_QUOTA_STATEMENT DROP_QUOTA_STATEMENT SHOW_QUOTAS_STATEMENT
 %type <fields>                      COLUMN_CLAUSES IDENTS
 %type <field>                       COLUMN_CLAUSE
 %type <stmts>                       ALL_QUERIES ALL_QUERY
@@ -155,7 +160,8 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 %type <ment>                        TABLE_OPTION  TABLE_NAME_WITH_OPTION TABLE_CASE MEASUREMENT_WITH
 %type <expr>                        WHERE_CLAUSE OR_CONDITION AND_CONDITION CONDITION OPERATION_EQUAL COLUMN_VAREF COLUMN CONDITION_COLUMN TAG_KEYS
                                     CASE_WHEN_CASE CASE_WHEN_CASES
-%type <int>                         CONDITION_OPERATOR
+%type <int>                         CONDITION_OPERATOR JOIN_TYPE PRIVILEGE_TYPE
+%type <privScope>                   PRIVILEGE_SCOPE
 %type <dataType>                    COLUMN_VAREF_TYPE
 %type <sortfs>                      SORTFIELDS ORDER_CLAUSES
 %type <sortf>                       SORTFIELD
@@ -164,7 +170,7 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 %type <intSlice>                    OPTION_CLAUSES LIMIT_OFFSET_OPTION SLIMIT_SOFFSET_OPTION
 %type <inter>                       FILL_CLAUSE FILLCONTENT
 %type <durations>                   SHARD_HOT_WARM_INDEX_DURATIONS SHARD_HOT_WARM_INDEX_DURATION CREAT_DATABASE_POLICY  CREAT_DATABASE_POLICYS
-%type <str>                         REGULAR_EXPRESSION TAG_KEY ON_DATABASE TYPE_CLAUSE SHARD_KEY STRING_TYPE MEASUREMENT_INFO SUBSCRIPTION_TYPE COMPACTION_TYPE_CLAUSE
+%type <str>                         PRIVILEGE_MEASUREMENT REGULAR_EXPRESSION TAG_KEY ON_DATABASE TYPE_CLAUSE SHARD_KEY STRING_TYPE MEASUREMENT_INFO SUBSCRIPTION_TYPE COMPACTION_TYPE_CLAUSE
 %type <strSlice>                    SHARDKEYLIST CMOPTION_SHARDKEY INDEX_LIST PRIMARYKEY_LIST SORTKEY_LIST ALL_DESTINATION CMOPTION_PRIMARYKEY CMOPTION_SORTKEY
 %type <strSlices>                   MEASUREMENT_PROPERTYS MEASUREMENT_PROPERTY MEASUREMENT_PROPERTYS_LIST CMOPTION_PROPERTIES
 %type <location>                    TIME_ZONE
@@ -172,6 +178,8 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 %type <cqsp>                        SAMPLE_POLICY
 %type <tdurs>                       DURATIONVALS
 %type <cqsp>                        SAMPLE_POLICY
+%type <bool>                        QUOTA_ON_USER
+%type <quotaOptions>                QUOTA_OPTIONS
 %type <int64>                       INTEGERPARA CMOPTION_SHARDNUM
 %type <bool>                        ALLOW_TAG_ARRAY
 %type <fieldOption>                 FIELD_OPTION FIELD_COLUMN
@@ -326,6 +334,18 @@ STATEMENT:
     {
         $$ = $1
     }
+    |SET_QUOTA_STATEMENT
+    {
+        $$ = $1
+    }
+    |DROP_QUOTA_STATEMENT
+    {
+        $$ = $1
+    }
+    |SHOW_QUOTAS_STATEMENT
+    {
+        $$ = $1
+    }
     |SHOW_GRANTS_FOR_USER_STATEMENT
     {
         $$ = $1
@@ -765,7 +785,7 @@ TABLE_NAMES:
     }
 
 JOIN_CLAUSE:
-    SUBQUERY_CLAUSE FULL JOIN TABLE_NAMES ON CONDITION
+    SUBQUERY_CLAUSE JOIN_TYPE JOIN TABLE_NAMES ON CONDITION
     {
         join := &Join{}
         if len($1) != 1 || len($4) != 1{
@@ -774,9 +794,37 @@ JOIN_CLAUSE:
         join.LSrc = $1[0]
         join.RSrc = $4[0]
         join.Condition = $6
+        join.JoinType = JoinType($2)
         $$ = join
     }
 
+JOIN_TYPE:
+    FULL
+    {
+        $$ = int(FullJoin)
+    }
+    |FULL OUTER
+    {
+        $$ = int(FullJoin)
+    }
+    |
+    {
+        $$ = int(InnerJoin)
+    }
+    |JOINKIND
+    {
+        joinType, _ := joinTypeOf($1)
+        $$ = int(joinType)
+    }
+    |JOINKIND OUTER
+    {
+        joinType, _ := joinTypeOf($1)
+        if joinType == InnerJoin {
+            yylex.Error("unsupported join type: " + $1 + " outer")
+        }
+        $$ = int(joinType)
+    }
+
 SUBQUERY_CLAUSE:
     LPAREN ALL_QUERY RPAREN
     {
@@ -942,6 +990,30 @@ DIMENSION_NAME:
 
         $$ = &Dimension{Expr:&Call{Name:"time", Args:[]Expr{&DurationLiteral{Val: $3},&DurationLiteral{Val: time.Duration(-$6)}}}}
     }
+    |IDENT LPAREN MONTHVAL RPAREN
+    {
+        if strings.ToLower($1) != "time"{
+                    yylex.Error("Invalid group by combination for no-time tag and time duration")
+                }
+
+        $$ = &Dimension{Expr:&Call{Name:"time", Args:[]Expr{&DurationLiteral{Val: time.Duration($3) * AverageMonth, Months: $3}}}}
+    }
+    |IDENT LPAREN MONTHVAL COMMA DURATIONVAL RPAREN
+    {
+        if strings.ToLower($1) != "time"{
+                    yylex.Error("Invalid group by combination for no-time tag and time duration")
+                }
+
+        $$ = &Dimension{Expr:&Call{Name:"time", Args:[]Expr{&DurationLiteral{Val: time.Duration($3) * AverageMonth, Months: $3},&DurationLiteral{Val: $5}}}}
+    }
+    |IDENT LPAREN MONTHVAL COMMA SUB DURATIONVAL RPAREN
+    {
+        if strings.ToLower($1) != "time"{
+                    yylex.Error("Invalid group by combination for no-time tag and time duration")
+                }
+
+        $$ = &Dimension{Expr:&Call{Name:"time", Args:[]Expr{&DurationLiteral{Val: time.Duration($3) * AverageMonth, Months: $3},&DurationLiteral{Val: time.Duration(-$6)}}}}
+    }
     |MUL
     {
         $$ = &Dimension{Expr:&Wildcard{Type:Token($1)}}
@@ -1889,36 +1961,56 @@ DROP_RETENTION_POLICY_STATEMENT:
     }
 
 GRANT_STATEMENT:
-    GRANT ALL ON IDENT TO IDENT
+    GRANT PRIVILEGE_TYPE ON IDENT PRIVILEGE_SCOPE TO IDENT
     {
     	stmt := &GrantStatement{}
-    	stmt.Privilege = AllPrivileges
+    	stmt.Privilege = Privilege($2)
     	stmt.On = $4
-    	stmt.User = $6
+    	stmt.Measurement = $5.measurement
+    	stmt.Condition = $5.condition
+    	stmt.User = $7
     	$$ = stmt
     }
-    |GRANT ALL PRIVILEGES ON IDENT TO IDENT
+
+PRIVILEGE_TYPE:
+    ALL
     {
-    	stmt := &GrantStatement{}
-    	stmt.Privilege = AllPrivileges
-    	stmt.On = $5
-    	stmt.User = $7
-    	$$ = stmt
+    	$$ = int(AllPrivileges)
     }
-    |GRANT IDENT ON IDENT TO IDENT
+    |ALL PRIVILEGES
     {
-    	stmt := &GrantStatement{}
-    	switch strings.ToLower($2){
+    	$$ = int(AllPrivileges)
+    }
+    |IDENT
+    {
+    	switch strings.ToLower($1){
     	case "read":
-    	    stmt.Privilege = ReadPrivilege
+    	    $$ = int(ReadPrivilege)
     	case "write":
-    	    stmt.Privilege = WritePrivilege
+    	    $$ = int(WritePrivilege)
     	default:
     	    yylex.Error("wrong Privilege")
     	}
-    	stmt.On = $4
-    	stmt.User = $6
-    	$$ = stmt
+    }
+
+PRIVILEGE_SCOPE:
+    MEASUREMENT IDENT WHERE_CLAUSE
+    {
+    	$$ = &privilegeScope{measurement: $2, condition: $3}
+    }
+    |WHERE_CLAUSE
+    {
+    	$$ = &privilegeScope{condition: $1}
+    }
+
+PRIVILEGE_MEASUREMENT:
+    MEASUREMENT IDENT
+    {
+    	$$ = $2
+    }
+    |
+    {
+    	$$ = ""
     }
 
 GRANT_ADMIN_STATEMENT:
@@ -1932,37 +2024,15 @@ GRANT_ADMIN_STATEMENT:
     }
 
 REVOKE_STATEMENT:
-    REVOKE ALL ON IDENT FROM IDENT
+    REVOKE PRIVILEGE_TYPE ON IDENT PRIVILEGE_MEASUREMENT FROM IDENT
     {
     	stmt := &RevokeStatement{}
-    	stmt.Privilege = AllPrivileges
+    	stmt.Privilege = Privilege($2)
     	stmt.On = $4
-    	stmt.User = $6
-    	$$ = stmt
-    }
-    |REVOKE ALL PRIVILEGES ON IDENT FROM IDENT
-    {
-    	stmt := &RevokeStatement{}
-    	stmt.Privilege = AllPrivileges
-    	stmt.On = $5
+    	stmt.Measurement = $5
     	stmt.User = $7
     	$$ = stmt
     }
-    |REVOKE IDENT ON IDENT FROM IDENT
-    {
-    	stmt := &RevokeStatement{}
-    	switch strings.ToLower($2){
-    	case "read":
-    	    stmt.Privilege = ReadPrivilege
-    	case "write":
-    	    stmt.Privilege = WritePrivilege
-    	default:
-    	    yylex.Error("wrong Privilege")
-    	}
-    	stmt.On = $4
-    	stmt.User = $6
-    	$$ = stmt
-    }
 
 REVOKE_ADMIN_STATEMENT:
     REVOKE ALL PRIVILEGES FROM IDENT
@@ -2940,6 +3010,50 @@ SET_PASSWORD_USER_STATEMENT:
         $$ = stmt
     }
 
+SET_QUOTA_STATEMENT:
+    SET QUOTA ON QUOTA_ON_USER IDENT WITH QUOTA_OPTIONS
+    {
+        stmt := &SetQuotaStatement{}
+        stmt.OnUser = $4
+        stmt.Name = $5
+        stmt.Options = $7
+        $$ = stmt
+    }
+
+DROP_QUOTA_STATEMENT:
+    DROP QUOTA ON QUOTA_ON_USER IDENT
+    {
+        stmt := &DropQuotaStatement{}
+        stmt.OnUser = $4
+        stmt.Name = $5
+        $$ = stmt
+    }
+
+SHOW_QUOTAS_STATEMENT:
+    SHOW QUOTAS
+    {
+        $$ = &ShowQuotasStatement{}
+    }
+
+QUOTA_ON_USER:
+    USER
+    {
+        $$ = true
+    }
+    |DATABASE
+    {
+        $$ = false
+    }
+
+QUOTA_OPTIONS:
+    IDENT EQ INTEGER
+    {
+        $$ = []QuotaOption{{Name: $1, Value: $3}}
+    }
+    |QUOTA_OPTIONS COMMA IDENT EQ INTEGER
+    {
+        $$ = append($1, QuotaOption{Name: $3, Value: $5})
+    }
 
 
 SHOW_GRANTS_FOR_USER_STATEMENT:
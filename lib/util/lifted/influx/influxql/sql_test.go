@@ -17,6 +17,7 @@ limitations under the License.
 package influxql_test
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
@@ -182,6 +183,13 @@ func init() {
 		"SHOW CLUSTER WHERE nodeType = data",
 		"SHOW CLUSTER WHERE nodeType = data AND nodeID = 2",
 		"SHOW CLUSTER WHERE nodeID = 2 AND nodeType = \"data\"",
+
+		// quota
+		"SET QUOTA ON USER user1 WITH points_per_second = 1000",
+		"SET QUOTA ON DATABASE db0 WITH max_series = 10000, max_concurrent_queries = 2",
+		"DROP QUOTA ON USER user1",
+		"DROP QUOTA ON DATABASE db0",
+		"SHOW QUOTAS",
 	}
 
 	benchCases = []string{
@@ -456,6 +464,140 @@ func TestSingleParserError(t *testing.T) {
 	}
 }
 
+func TestParserJoinType(t *testing.T) {
+	cases := map[string]influxql.JoinType{
+		"full join":        influxql.FullJoin,
+		"full outer join":  influxql.FullJoin,
+		"join":             influxql.InnerJoin,
+		"inner join":       influxql.InnerJoin,
+		"left join":        influxql.LeftOuterJoin,
+		"left outer join":  influxql.LeftOuterJoin,
+		"right join":       influxql.RightOuterJoin,
+		"right outer join": influxql.RightOuterJoin,
+	}
+	for join, expected := range cases {
+		c := fmt.Sprintf("select m1.f1, m2.f2 from (select f1 from mst1) as m1 %s (select f2 from mst2) as m2 on (m1.tk1 = m2.tk1) group by tk1", join)
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err.Error(), c)
+		}
+		stmt := q.Statements[0].(*influxql.SelectStatement)
+		j, ok := stmt.Sources[0].(*influxql.Join)
+		if !ok {
+			t.Fatalf("expect join source with sql: %s", c)
+		}
+		if j.JoinType != expected {
+			t.Fatalf("expect %s, got %s with sql: %s", expected, j.JoinType, c)
+		}
+		if !strings.Contains(stmt.String(), expected.String()) {
+			t.Fatalf("expect %s in %s", expected, stmt.String())
+		}
+	}
+}
+
+func TestParserJoinTypeIdent(t *testing.T) {
+	cases := map[string]string{
+		`select left from m`:                                     `SELECT left FROM m`,
+		`select inner from m`:                                    `SELECT inner FROM m`,
+		`select right, LEFT from m`:                              `SELECT right, LEFT FROM m`,
+		`select v from m where right = 'a'`:                      `SELECT v FROM m WHERE right = 'a'`,
+		`select count(v) from m group by left`:                   `SELECT count(v) FROM m GROUP BY left`,
+		`select v from left, right`:                              `SELECT v FROM left, right`,
+		`select v from (select v from m) as left`:                `SELECT v FROM (SELECT v FROM m)`,
+		`select v from (select v from m) tz('Asia/Shanghai')`:    `SELECT v FROM (SELECT v FROM m) TZ('Asia/Shanghai')`,
+		`select v from (select v from m) as m1 where left = 'b'`: `SELECT v FROM (SELECT v FROM m) WHERE left = 'b'`,
+	}
+	for c, expected := range cases {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err.Error(), c)
+		}
+		if got := q.Statements[0].String(); got != expected {
+			t.Fatalf("expect %s, got %s", expected, got)
+		}
+	}
+
+	c := `select m1.f1 from (select f1 from mst1) as m1 inner outer join (select f2 from mst2) as m2 on (m1.tk1 = m2.tk1)`
+	YyParser := &influxql.YyParser{
+		Query: influxql.Query{},
+	}
+	YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+	YyParser.ParseTokens()
+	if _, err := YyParser.GetQuery(); err == nil {
+		t.Fatalf("expect error with sql: %s", c)
+	}
+}
+
+func TestParserQuota(t *testing.T) {
+	cases := []struct {
+		sql  string
+		stmt influxql.Statement
+	}{
+		{
+			sql: `SET QUOTA ON USER "user1" WITH points_per_second = 1000, max_query_result_bytes = 1048576`,
+			stmt: &influxql.SetQuotaStatement{OnUser: true, Name: "user1", Options: []influxql.QuotaOption{
+				{Name: "points_per_second", Value: 1000},
+				{Name: "max_query_result_bytes", Value: 1048576},
+			}},
+		},
+		{
+			sql: `SET QUOTA ON DATABASE db0 WITH max_series = 10000`,
+			stmt: &influxql.SetQuotaStatement{Name: "db0", Options: []influxql.QuotaOption{
+				{Name: "max_series", Value: 10000},
+			}},
+		},
+		{
+			sql:  `DROP QUOTA ON DATABASE db0`,
+			stmt: &influxql.DropQuotaStatement{Name: "db0"},
+		},
+		{
+			sql:  `SHOW QUOTAS`,
+			stmt: &influxql.ShowQuotasStatement{},
+		},
+	}
+	for _, c := range cases {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c.sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err.Error(), c.sql)
+		}
+		if !reflect.DeepEqual(q.Statements[0], c.stmt) {
+			t.Fatalf("expect %s, got %s", c.stmt, q.Statements[0])
+		}
+	}
+
+	stmt := &influxql.SetQuotaStatement{OnUser: true, Name: "user1", Options: []influxql.QuotaOption{
+		{Name: "points_per_second", Value: 1000},
+		{Name: "max_series", Value: 10},
+	}}
+	if got, exp := stmt.String(), `SET QUOTA ON USER user1 WITH points_per_second = 1000, max_series = 10`; got != exp {
+		t.Fatalf("expect %s, got %s", exp, got)
+	}
+
+	YyParser := &influxql.YyParser{
+		Query: influxql.Query{},
+	}
+	YyParser.Scanner = influxql.NewScanner(strings.NewReader("SET QUOTA ON USER user1 WITH points_per_second = -1"))
+	YyParser.ParseTokens()
+	if _, err := YyParser.GetQuery(); err == nil {
+		t.Fatal("expect error for a negative quota")
+	}
+}
+
 func BenchmarkNewParser(b *testing.B) {
 	YyParser := &influxql.YyParser{
 		Query: influxql.Query{},
@@ -479,3 +621,61 @@ func BenchmarkPreviousParser(b *testing.B) {
 		}
 	}
 }
+
+func TestParserMeasurementPrivilege(t *testing.T) {
+	cases := []struct {
+		sql  string
+		stmt influxql.Statement
+	}{
+		{
+			sql:  `GRANT READ ON db0 TO user1`,
+			stmt: &influxql.GrantStatement{Privilege: influxql.ReadPrivilege, On: "db0", User: "user1"},
+		},
+		{
+			sql:  `GRANT ALL PRIVILEGES ON db0 MEASUREMENT cpu TO user1`,
+			stmt: &influxql.GrantStatement{Privilege: influxql.AllPrivileges, On: "db0", Measurement: "cpu", User: "user1"},
+		},
+		{
+			sql: `GRANT READ ON db0 MEASUREMENT "cpu" WHERE tenant = 'acme' TO user1`,
+			stmt: &influxql.GrantStatement{Privilege: influxql.ReadPrivilege, On: "db0", Measurement: "cpu", User: "user1",
+				Condition: &influxql.BinaryExpr{Op: influxql.EQ, LHS: &influxql.VarRef{Val: "tenant"}, RHS: &influxql.StringLiteral{Val: "acme"}}},
+		},
+		{
+			sql: `GRANT WRITE ON db0 WHERE tenant = 'acme' TO user1`,
+			stmt: &influxql.GrantStatement{Privilege: influxql.WritePrivilege, On: "db0", User: "user1",
+				Condition: &influxql.BinaryExpr{Op: influxql.EQ, LHS: &influxql.VarRef{Val: "tenant"}, RHS: &influxql.StringLiteral{Val: "acme"}}},
+		},
+		{
+			sql:  `REVOKE ALL ON db0 MEASUREMENT cpu FROM user1`,
+			stmt: &influxql.RevokeStatement{Privilege: influxql.AllPrivileges, On: "db0", Measurement: "cpu", User: "user1"},
+		},
+		{
+			sql:  `REVOKE WRITE ON db0 FROM user1`,
+			stmt: &influxql.RevokeStatement{Privilege: influxql.WritePrivilege, On: "db0", User: "user1"},
+		},
+	}
+	for _, c := range cases {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c.sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err.Error(), c.sql)
+		}
+		if !reflect.DeepEqual(q.Statements[0], c.stmt) {
+			t.Fatalf("expect %s, got %s", c.stmt, q.Statements[0])
+		}
+	}
+
+	stmt := &influxql.GrantStatement{Privilege: influxql.ReadPrivilege, On: "db0", Measurement: "cpu", User: "user1",
+		Condition: &influxql.BinaryExpr{Op: influxql.EQ, LHS: &influxql.VarRef{Val: "tenant"}, RHS: &influxql.StringLiteral{Val: "acme"}}}
+	if got, exp := stmt.String(), `GRANT READ ON db0 MEASUREMENT cpu WHERE tenant = 'acme' TO user1`; got != exp {
+		t.Fatalf("expect %s, got %s", exp, got)
+	}
+	revoke := &influxql.RevokeStatement{Privilege: influxql.ReadPrivilege, On: "db0", Measurement: "cpu", User: "user1"}
+	if got, exp := revoke.String(), `REVOKE READ ON db0 MEASUREMENT cpu FROM user1`; got != exp {
+		t.Fatalf("expect %s, got %s", exp, got)
+	}
+}
@@ -339,6 +339,8 @@ var tokens = [...]string{
 	COMPACT:        "COMPACT",
 	AUTO:           "AUTO",
 	EXCEPT:         "EXCEPT",
+	QUOTA:          "QUOTA",
+	QUOTAS:         "QUOTAS",
 }
 
 var keywords map[string]int
@@ -106,6 +106,8 @@ type yySymType struct {
 	indexOption      *IndexOption
 	databasePolicy   DatabasePolicy
 	cmOption         *CreateMeasurementStatementOption
+	quotaOptions     []QuotaOption
+	privScope        *privilegeScope
 }
 
 const FROM = 57346
@@ -229,41 +231,45 @@ const SCHEMA = 57463
 const INDEXES = 57464
 const AUTO = 57465
 const EXCEPT = 57466
-const DESC = 57467
-const ASC = 57468
-const COMMA = 57469
-const SEMICOLON = 57470
-const LPAREN = 57471
-const RPAREN = 57472
-const REGEX = 57473
-const EQ = 57474
-const NEQ = 57475
-const LT = 57476
-const LTE = 57477
-const GT = 57478
-const GTE = 57479
-const DOT = 57480
-const DOUBLECOLON = 57481
-const NEQREGEX = 57482
-const EQREGEX = 57483
-const IDENT = 57484
-const INTEGER = 57485
-const DURATIONVAL = 57486
-const STRING = 57487
-const NUMBER = 57488
-const HINT = 57489
-const BOUNDPARAM = 57490
-const AND = 57491
-const OR = 57492
-const ADD = 57493
-const SUB = 57494
-const BITWISE_OR = 57495
-const BITWISE_XOR = 57496
-const MUL = 57497
-const DIV = 57498
-const MOD = 57499
-const BITWISE_AND = 57500
-const UMINUS = 57501
+const QUOTA = 57467
+const QUOTAS = 57468
+const DESC = 57469
+const ASC = 57470
+const COMMA = 57471
+const SEMICOLON = 57472
+const LPAREN = 57473
+const RPAREN = 57474
+const REGEX = 57475
+const EQ = 57476
+const NEQ = 57477
+const LT = 57478
+const LTE = 57479
+const GT = 57480
+const GTE = 57481
+const DOT = 57482
+const DOUBLECOLON = 57483
+const NEQREGEX = 57484
+const EQREGEX = 57485
+const IDENT = 57486
+const JOINKIND = 57487
+const INTEGER = 57488
+const DURATIONVAL = 57489
+const MONTHVAL = 57490
+const STRING = 57491
+const NUMBER = 57492
+const HINT = 57493
+const BOUNDPARAM = 57494
+const AND = 57495
+const OR = 57496
+const ADD = 57497
+const SUB = 57498
+const BITWISE_OR = 57499
+const BITWISE_XOR = 57500
+const MUL = 57501
+const DIV = 57502
+const MOD = 57503
+const BITWISE_AND = 57504
+const UMINUS = 57505
 
 var yyToknames = [...]string{
 	"$end",
@@ -390,6 +396,8 @@ var yyToknames = [...]string{
 	"INDEXES",
 	"AUTO",
 	"EXCEPT",
+	"QUOTA",
+	"QUOTAS",
 	"DESC",
 	"ASC",
 	"COMMA",
@@ -408,8 +416,10 @@ var yyToknames = [...]string{
 	"NEQREGEX",
 	"EQREGEX",
 	"IDENT",
+	"JOINKIND",
 	"INTEGER",
 	"DURATIONVAL",
+	"MONTHVAL",
 	"STRING",
 	"NUMBER",
 	"HINT",
@@ -433,308 +443,326 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line sql.y:3484
+//line sql.y:3598
 
 //line yacctab:1
 var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 70,
-	4, 92,
-	-2, 138,
-	-1, 469,
-	113, 155,
-	132, 155,
-	133, 155,
-	134, 155,
-	135, 155,
-	136, 155,
-	137, 155,
-	140, 155,
-	141, 155,
-	-2, 144,
+	-1, 73,
+	4, 95,
+	-2, 149,
+	-1, 239,
+	23, 107,
+	-2, 99,
+	-1, 481,
+	113, 166,
+	134, 166,
+	135, 166,
+	136, 166,
+	137, 166,
+	138, 166,
+	139, 166,
+	142, 166,
+	143, 166,
+	-2, 155,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 1129
+const yyLast = 1220
 
 var yyAct = [...]int16{
-	495, 898, 924, 510, 868, 771, 690, 422, 798, 889,
-	711, 262, 392, 509, 788, 739, 643, 694, 704, 829,
-	4, 550, 491, 628, 74, 70, 632, 235, 769, 551,
-	420, 493, 138, 383, 205, 441, 321, 245, 231, 2,
-	153, 318, 173, 229, 279, 348, 349, 80, 137, 670,
-	233, 669, 880, 84, 85, 162, 163, 167, 164, 160,
-	161, 165, 166, 605, 390, 162, 163, 167, 164, 160,
-	161, 165, 166, 562, 899, 80, 160, 161, 165, 166,
-	88, 84, 85, 896, 848, 58, 709, 148, 469, 882,
-	629, 496, 849, 212, 213, 630, 213, 156, 569, 872,
-	168, 839, 172, 154, 497, 348, 349, 348, 349, 212,
-	934, 864, 213, 75, 281, 88, 718, 719, 269, 838,
-	720, 270, 786, 785, 211, 214, 76, 82, 79, 83,
-	81, 88, 87, 866, 766, 225, 77, 227, 446, 73,
-	80, 75, 445, 88, 159, 206, 84, 85, 348, 349,
-	723, 675, 88, 867, 76, 82, 79, 83, 81, 217,
-	87, 609, 610, 573, 77, 246, 206, 73, 257, 212,
-	228, 202, 213, 674, 80, 216, 673, 672, 546, 862,
-	84, 85, 774, 266, 851, 271, 272, 273, 274, 275,
-	276, 277, 278, 248, 501, 280, 88, 265, 315, 264,
-	728, 246, 261, 204, 290, 727, 75, 203, 88, 646,
-	206, 292, 543, 544, 296, 560, 58, 288, 289, 76,
-	82, 79, 83, 81, 774, 87, 558, 549, 547, 77,
-	295, 284, 73, 285, 212, 607, 331, 213, 608, 531,
-	75, 433, 88, 530, 313, 260, 410, 220, 367, 334,
-	409, 773, 332, 76, 82, 79, 83, 81, 71, 87,
-	381, 176, 350, 77, 86, 351, 73, 359, 360, 361,
-	362, 363, 364, 347, 346, 366, 365, 162, 163, 167,
-	164, 160, 161, 165, 166, 162, 163, 167, 164, 160,
-	161, 165, 166, 777, 382, 234, 928, 88, 145, 352,
-	353, 306, 283, 143, 204, 305, 869, 559, 203, 396,
-	799, 206, 388, 863, 386, 741, 644, 645, 705, 552,
-	412, 505, 506, 634, 648, 647, 617, 444, 395, 508,
-	507, 399, 401, 796, 454, 763, 762, 754, 714, 174,
-	713, 459, 460, 700, 659, 417, 658, 398, 400, 402,
-	705, 622, 621, 604, 602, 601, 411, 474, 475, 419,
-	599, 416, 447, 597, 584, 583, 582, 577, 575, 561,
-	548, 533, 502, 246, 246, 472, 467, 468, 486, 485,
-	482, 481, 462, 246, 456, 207, 461, 394, 463, 380,
-	379, 378, 375, 374, 490, 373, 476, 370, 368, 339,
-	515, 338, 337, 169, 207, 146, 335, 207, 514, 330,
-	144, 519, 171, 170, 521, 503, 535, 499, 329, 500,
-	207, 328, 323, 316, 534, 314, 310, 293, 286, 542,
-	517, 518, 259, 520, 221, 219, 215, 201, 199, 615,
-	529, 169, 581, 444, 450, 570, 516, 538, 540, 541,
-	171, 170, 545, 451, 525, 158, 528, 657, 207, 585,
-	580, 571, 532, 537, 539, 458, 448, 408, 336, 327,
-	557, 930, 825, 824, 579, 683, 576, 489, 566, 488,
-	418, 572, 802, 574, 88, 801, 567, 935, 913, 568,
-	606, 590, 901, 69, 593, 465, 589, 900, 895, 881,
-	855, 598, 350, 841, 612, 596, 833, 800, 587, 795,
-	794, 792, 618, 791, 706, 702, 701, 688, 635, 592,
-	466, 452, 387, 639, 611, 209, 927, 876, 847, 637,
-	638, 743, 689, 640, 616, 641, 631, 620, 660, 836,
-	613, 656, 591, 473, 470, 357, 668, 356, 354, 636,
-	664, 326, 666, 667, 712, 345, 343, 69, 929, 914,
-	654, 655, 891, 671, 844, 811, 793, 731, 732, 662,
-	663, 730, 665, 649, 614, 595, 653, 594, 586, 157,
-	384, 693, 787, 177, 434, 661, 697, 149, 322, 222,
-	767, 319, 207, 208, 151, 707, 708, 692, 920, 842,
-	687, 834, 833, 682, 680, 194, 685, 207, 226, 207,
-	782, 830, 703, 195, 923, 918, 910, 80, 894, 179,
-	770, 479, 413, 84, 85, 671, 716, 406, 710, 404,
-	698, 322, 813, 726, 715, 320, 179, 80, 308, 309,
-	748, 734, 735, 84, 85, 311, 721, 210, 733, 498,
-	498, 781, 725, 297, 736, 303, 304, 191, 192, 737,
-	753, 742, 344, 58, 768, 150, 751, 752, 758, 749,
-	760, 761, 747, 342, 756, 757, 738, 759, 320, 184,
-	185, 186, 178, 75, 652, 88, 750, 188, 776, 189,
-	642, 301, 302, 523, 755, 789, 76, 82, 79, 83,
-	81, 764, 87, 477, 684, 88, 77, 435, 182, 183,
-	775, 207, 267, 207, 268, 784, 76, 82, 79, 83,
-	81, 724, 87, 722, 246, 322, 77, 790, 797, 207,
-	3, 873, 619, 147, 780, 389, 287, 808, 130, 176,
-	804, 826, 429, 432, 874, 430, 431, 258, 803, 712,
-	190, 810, 765, 806, 691, 818, 819, 807, 677, 812,
-	821, 822, 817, 823, 814, 815, 556, 820, 135, 555,
-	809, 554, 623, 624, 128, 181, 553, 125, 832, 127,
-	247, 180, 816, 218, 129, 200, 695, 696, 58, 437,
-	840, 142, 831, 565, 126, 835, 779, 778, 59, 60,
-	152, 837, 139, 140, 139, 843, 875, 783, 65, 746,
-	62, 846, 845, 678, 853, 651, 850, 492, 578, 131,
-	63, 860, 852, 139, 861, 291, 136, 854, 859, 650,
-	856, 526, 141, 64, 132, 133, 207, 67, 134, 870,
-	865, 522, 61, 440, 789, 789, 871, 857, 858, 369,
-	403, 207, 324, 355, 471, 879, 884, 66, 877, 878,
-	249, 600, 483, 888, 883, 480, 298, 299, 300, 886,
-	887, 307, 890, 464, 250, 312, 371, 251, 68, 498,
-	828, 827, 897, 626, 627, 255, 729, 98, 253, 885,
-	904, 905, 902, 372, 805, 513, 907, 903, 890, 911,
-	906, 912, 254, 511, 512, 393, 385, 915, 139, 234,
-	263, 588, 744, 745, 112, 919, 921, 393, 139, 926,
-	140, 120, 198, 140, 93, 89, 58, 90, 91, 931,
-	926, 933, 932, 100, 240, 239, 155, 377, 699, 140,
-	376, 97, 179, 92, 478, 457, 455, 453, 449, 436,
-	341, 340, 333, 94, 294, 96, 256, 119, 252, 224,
-	117, 223, 118, 111, 108, 109, 110, 115, 101, 197,
-	104, 80, 99, 196, 105, 155, 391, 84, 85, 603,
-	397, 487, 564, 484, 102, 405, 58, 407, 139, 103,
-	193, 187, 414, 563, 415, 439, 59, 60, 106, 107,
-	438, 443, 121, 113, 114, 442, 65, 686, 62, 124,
-	681, 679, 772, 916, 917, 925, 908, 122, 63, 892,
-	241, 123, 242, 909, 116, 893, 922, 95, 740, 421,
-	717, 64, 625, 494, 633, 67, 282, 237, 358, 88,
-	61, 175, 78, 244, 243, 236, 504, 230, 232, 1,
-	238, 82, 79, 83, 81, 66, 87, 425, 426, 72,
-	77, 54, 53, 52, 57, 56, 55, 51, 423, 427,
-	429, 432, 50, 430, 431, 49, 68, 325, 48, 424,
-	47, 46, 45, 44, 43, 42, 41, 524, 40, 527,
-	39, 38, 37, 36, 35, 34, 536, 33, 32, 31,
-	428, 30, 29, 28, 27, 26, 25, 24, 23, 20,
-	19, 21, 18, 22, 17, 16, 15, 13, 14, 12,
-	11, 676, 7, 10, 9, 8, 317, 6, 5,
+	507, 924, 952, 522, 891, 786, 521, 431, 815, 915,
+	701, 269, 722, 705, 803, 754, 654, 715, 562, 848,
+	639, 4, 643, 503, 784, 243, 73, 563, 429, 450,
+	392, 505, 212, 328, 253, 325, 237, 343, 239, 2,
+	180, 61, 160, 399, 360, 361, 241, 681, 680, 185,
+	286, 169, 170, 174, 171, 167, 168, 172, 173, 904,
+	871, 640, 77, 737, 738, 83, 615, 641, 401, 872,
+	143, 87, 88, 169, 170, 174, 171, 167, 168, 172,
+	173, 167, 168, 172, 173, 869, 720, 581, 91, 481,
+	574, 154, 620, 621, 870, 219, 83, 962, 926, 508,
+	220, 163, 87, 88, 220, 91, 91, 360, 361, 925,
+	360, 361, 455, 732, 509, 733, 454, 585, 219, 734,
+	276, 213, 213, 220, 922, 277, 908, 513, 218, 221,
+	906, 360, 361, 78, 288, 91, 895, 858, 857, 801,
+	233, 175, 235, 179, 161, 188, 79, 889, 85, 82,
+	800, 86, 84, 781, 90, 686, 685, 684, 80, 683,
+	219, 76, 558, 887, 78, 220, 91, 219, 885, 618,
+	890, 209, 220, 619, 166, 263, 555, 79, 556, 85,
+	82, 874, 86, 84, 242, 90, 91, 866, 743, 80,
+	742, 273, 76, 211, 256, 657, 570, 210, 561, 271,
+	559, 224, 213, 287, 83, 322, 272, 442, 254, 297,
+	87, 88, 267, 236, 169, 170, 174, 171, 167, 168,
+	172, 173, 295, 296, 356, 291, 227, 292, 789, 61,
+	278, 279, 280, 281, 282, 283, 284, 285, 789, 543,
+	305, 306, 307, 542, 338, 314, 254, 320, 572, 319,
+	419, 313, 956, 91, 418, 312, 299, 517, 518, 303,
+	211, 339, 152, 183, 210, 520, 519, 390, 892, 213,
+	816, 363, 78, 149, 91, 886, 756, 865, 716, 564,
+	645, 359, 358, 811, 778, 79, 777, 85, 82, 769,
+	86, 84, 74, 90, 341, 364, 365, 80, 290, 788,
+	76, 728, 726, 389, 655, 656, 379, 724, 362, 792,
+	711, 83, 659, 658, 628, 670, 669, 87, 88, 169,
+	170, 174, 171, 167, 168, 172, 173, 371, 372, 373,
+	374, 375, 376, 354, 453, 378, 377, 668, 633, 391,
+	632, 463, 571, 181, 614, 612, 609, 596, 626, 357,
+	595, 469, 470, 594, 406, 589, 587, 397, 573, 414,
+	428, 416, 560, 545, 514, 716, 423, 456, 424, 486,
+	487, 150, 405, 404, 500, 498, 408, 410, 495, 78,
+	472, 91, 150, 421, 466, 465, 479, 480, 484, 471,
+	426, 473, 79, 403, 85, 82, 388, 86, 84, 387,
+	90, 176, 385, 502, 80, 488, 384, 76, 382, 527,
+	178, 177, 380, 349, 958, 348, 347, 342, 337, 336,
+	531, 335, 330, 254, 254, 547, 511, 323, 321, 317,
+	300, 293, 266, 254, 229, 226, 222, 208, 554, 206,
+	176, 593, 459, 165, 597, 583, 544, 468, 457, 178,
+	177, 460, 453, 494, 582, 417, 346, 334, 902, 557,
+	844, 592, 515, 536, 843, 539, 512, 813, 694, 501,
+	499, 427, 548, 526, 91, 963, 569, 529, 530, 533,
+	532, 578, 821, 941, 591, 820, 588, 541, 584, 546,
+	586, 929, 928, 83, 550, 552, 553, 927, 819, 87,
+	88, 818, 617, 921, 602, 579, 61, 605, 580, 601,
+	610, 599, 72, 907, 477, 608, 62, 63, 905, 878,
+	860, 852, 817, 810, 629, 809, 68, 807, 65, 806,
+	646, 717, 713, 622, 712, 650, 699, 604, 66, 478,
+	652, 648, 649, 461, 396, 642, 955, 899, 868, 216,
+	671, 67, 362, 667, 758, 70, 855, 700, 679, 89,
+	64, 78, 675, 91, 677, 678, 627, 624, 603, 485,
+	482, 369, 623, 368, 79, 69, 85, 82, 366, 86,
+	84, 333, 90, 723, 353, 72, 80, 631, 957, 942,
+	917, 682, 863, 704, 830, 142, 71, 812, 708, 647,
+	808, 651, 746, 747, 326, 745, 625, 718, 719, 607,
+	665, 666, 606, 598, 164, 393, 696, 155, 329, 673,
+	674, 802, 676, 184, 443, 714, 230, 782, 124, 242,
+	730, 215, 158, 948, 703, 709, 861, 698, 721, 853,
+	852, 693, 234, 691, 329, 201, 729, 849, 797, 202,
+	951, 946, 749, 750, 938, 422, 682, 735, 751, 748,
+	740, 920, 785, 491, 123, 327, 186, 121, 186, 122,
+	752, 768, 757, 217, 315, 316, 415, 766, 767, 773,
+	764, 775, 776, 725, 214, 771, 772, 413, 774, 796,
+	318, 327, 310, 311, 304, 157, 198, 199, 832, 791,
+	741, 783, 61, 352, 214, 156, 804, 214, 763, 125,
+	779, 762, 195, 83, 196, 663, 128, 790, 653, 87,
+	88, 535, 214, 444, 126, 753, 223, 799, 127, 191,
+	192, 193, 3, 695, 739, 765, 805, 736, 308, 309,
+	189, 190, 274, 770, 275, 329, 148, 896, 823, 630,
+	795, 398, 827, 294, 183, 845, 268, 897, 438, 441,
+	214, 439, 440, 264, 822, 825, 197, 723, 826, 780,
+	837, 838, 153, 254, 831, 840, 841, 836, 842, 833,
+	834, 489, 839, 91, 302, 702, 688, 568, 567, 566,
+	565, 255, 225, 851, 79, 207, 85, 82, 814, 86,
+	84, 187, 90, 446, 850, 159, 80, 147, 344, 859,
+	854, 345, 706, 707, 144, 151, 862, 856, 794, 793,
+	577, 867, 829, 828, 144, 144, 864, 898, 145, 798,
+	761, 689, 662, 876, 616, 835, 590, 534, 449, 381,
+	883, 661, 331, 884, 248, 247, 877, 882, 146, 879,
+	298, 538, 412, 504, 367, 483, 258, 611, 893, 259,
+	383, 888, 474, 804, 804, 476, 475, 894, 395, 847,
+	846, 824, 262, 637, 638, 744, 903, 900, 901, 910,
+	909, 83, 525, 523, 524, 402, 914, 87, 88, 261,
+	144, 873, 912, 913, 214, 916, 394, 875, 270, 402,
+	600, 407, 409, 411, 880, 881, 144, 145, 205, 923,
+	420, 145, 214, 61, 214, 425, 932, 933, 930, 710,
+	186, 490, 935, 931, 916, 939, 934, 940, 162, 493,
+	249, 145, 250, 145, 467, 943, 464, 462, 458, 445,
+	351, 350, 340, 947, 949, 102, 301, 954, 135, 245,
+	911, 91, 265, 510, 510, 260, 257, 959, 954, 961,
+	960, 232, 246, 231, 85, 82, 228, 86, 84, 204,
+	90, 203, 116, 162, 80, 497, 400, 613, 140, 144,
+	386, 200, 96, 92, 132, 93, 94, 129, 194, 131,
+	576, 104, 575, 448, 133, 447, 452, 451, 727, 101,
+	697, 95, 528, 692, 130, 690, 787, 944, 945, 953,
+	537, 97, 540, 99, 936, 214, 918, 214, 937, 549,
+	551, 115, 112, 113, 114, 119, 105, 919, 108, 136,
+	103, 950, 109, 98, 214, 755, 141, 496, 430, 731,
+	636, 506, 106, 61, 137, 138, 644, 107, 139, 289,
+	492, 355, 370, 62, 63, 182, 110, 111, 81, 252,
+	251, 117, 118, 68, 244, 65, 100, 516, 134, 238,
+	240, 1, 75, 35, 34, 66, 33, 57, 56, 634,
+	635, 55, 434, 435, 120, 60, 59, 58, 67, 54,
+	53, 52, 70, 432, 436, 438, 441, 64, 439, 440,
+	332, 51, 50, 49, 433, 48, 47, 46, 45, 44,
+	43, 42, 69, 41, 40, 39, 38, 37, 36, 32,
+	31, 30, 29, 28, 27, 437, 26, 25, 24, 23,
+	20, 19, 660, 71, 21, 664, 18, 22, 17, 16,
+	15, 13, 14, 214, 672, 12, 11, 687, 7, 10,
+	9, 8, 324, 6, 5, 0, 0, 0, 214, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 510, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 759, 760,
 }
 
 var yyPact = [...]int16{
-	978, -1000, 429, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1035, -1000, 455, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 111, 882,
-	916, 733, 914, 786, 268, 263, 655, 550, 486, 978,
-	930, 12, 452, 316, 134, 554, 312, 554, -1000, -1000,
-	197, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 464,
-	935, 734, 629, -1000, 605, 987, 613, 692, 578, 986,
-	511, 525, 966, 962, -1000, -1000, -1000, 913, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 296, 737, 295,
-	65, 485, 518, -49, -49, 294, 914, 735, 293, 104,
-	292, 481, 954, 952, -49, 516, -49, 911, -1000, 166,
-	908, 732, 65, 853, 951, 881, 949, 918, -1000, 689,
-	290, 102, -1000, 984, 899, 166, 969, 12, 641, -24,
-	554, 554, 554, 554, 554, 554, 554, 554, -86, -16,
-	160, 286, -1000, 670, 675, 675, 908, -1000, 794, 285,
-	947, 914, 573, 935, 935, 612, 576, 163, 935, 559,
-	284, 565, 935, 65, -1000, -1000, 283, -49, 281, 560,
-	280, 821, 422, 331, 279, -1000, -1000, -1000, 276, 267,
-	12, 969, -1000, -1000, 945, -1000, 911, -1000, 264, -1000,
-	-1000, 330, 260, 259, 257, -1000, 944, 943, -1000, -1000,
-	546, 535, -1000, -1000, 780, -104, -1000, 908, 274, 419,
-	826, 418, 416, -1000, -1000, 135, -96, 256, 818, 255,
-	869, 253, 251, 250, 933, 249, 248, -1000, 247, -49,
-	-1000, 911, 456, 894, -1000, 984, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -79, -79, -79, -1000, -1000, -79, -1000,
-	392, -1000, -1000, -1000, -1000, -1000, -1000, 554, 669, -1000,
-	-1, 971, 892, -1000, 245, 911, 892, 935, 914, 914,
-	819, 549, 935, 547, 935, 329, 108, 904, 542, 935,
-	-1000, 935, 914, -1000, -1000, -1000, 348, 517, -1000, 1019,
-	98, 466, 635, 942, 752, 812, -49, 0, 328, 941,
-	315, 391, 940, -49, -1000, 939, 242, 938, 327, -1000,
-	-49, -49, 166, 240, 166, 850, 365, 390, 908, 908,
-	-86, -42, 415, 829, 918, 414, -49, -49, 574, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 937, 540,
-	841, 239, 238, -1000, 838, 979, 237, 236, -1000, 977,
-	347, 345, 899, 788, -51, -51, 911, -1000, 126, 230,
-	554, 189, 889, 883, -1000, 892, 889, 914, 911, 899,
-	911, 892, 810, 617, 935, 800, 935, 914, 101, 324,
-	229, 892, 889, 935, 914, 914, 911, 899, 70, -1000,
-	-1000, 1019, -1000, 34, 85, 228, 84, -1000, 177, 727,
-	722, 720, 717, 654, 83, 165, 227, -72, -1000, -1000,
-	761, -1000, -49, 359, 27, 323, 21, -1000, 21, 226,
-	12, 225, 787, 918, 322, 224, -1000, 223, 222, -1000,
-	321, -1000, 451, -1000, 166, 901, -1000, -1000, -1000, -1000,
-	77, 413, 389, 918, 450, 448, -1000, 908, 221, 177,
-	218, 837, -1000, 213, 212, 975, -1000, 211, -82, 92,
-	456, 892, 411, -1000, 447, 300, 405, 187, -1000, -1000,
-	899, -1000, 664, -96, 911, 210, 209, 353, 353, -1000,
-	867, -53, -53, 181, 889, -1000, 911, 899, 899, 889,
-	892, 889, 614, 184, 798, 784, 608, 914, 911, 899,
-	319, 204, 202, -1000, 889, -1000, 914, 911, 899, 911,
-	899, 899, 889, -98, -100, -1000, -1000, -1000, -1000, -1000,
-	436, -1000, -1000, 33, 32, 29, 7, -1000, -1000, -1000,
-	-1000, 709, 782, 509, 508, 343, -1000, -1000, -1000, -1000,
-	631, 21, -1000, -1000, -1000, 500, 387, 403, 705, 491,
-	-49, 751, -1000, -1000, -1000, -49, 166, 931, 201, 386,
-	385, 208, -1000, 384, -49, -49, -44, 1019, 498, -1000,
-	198, -1000, -1000, 196, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 788, 889, -26, -51, 652, 6, 650, 456, -1000,
-	892, -1000, -1000, -1000, -1000, -1000, 62, 57, 871, -1000,
-	-1000, -1000, -1000, 444, 442, -1000, 899, 889, 889, -1000,
-	889, -1000, 184, 911, 173, 173, 402, 353, 353, 778,
-	596, 564, 184, 911, 899, 899, 889, 195, -1000, -1000,
-	-1000, 911, 899, 899, 889, 899, 889, 889, -1000, 194,
-	193, 177, -1000, -1000, -1000, -1000, 702, -10, 555, 539,
-	109, 539, 151, 763, -1000, -1000, 667, 552, 776, 12,
-	-1000, -21, -22, 462, -49, -1000, -1000, -1000, -1000, 908,
-	-1000, -1000, -1000, 383, 381, 439, -1000, 380, 379, -1000,
-	-1000, -1000, 191, -1000, -1000, 892, 168, 377, -1000, -1000,
-	-1000, -1000, -1000, 355, -1000, 788, 889, 877, -1000, -53,
-	181, -1000, -1000, 889, -1000, -1000, -1000, 911, 892, -1000,
-	438, -1000, -1000, 173, -1000, -1000, 556, 184, 184, 911,
-	899, 889, 889, -1000, -1000, 899, 889, 889, -1000, 889,
-	-1000, -1000, 341, 340, -1000, -1000, 681, 860, 859, 521,
-	177, -1000, 109, 506, 505, 521, -1000, 410, -1000, -1000,
-	918, -25, -43, 705, 373, 496, -1000, 751, -1000, 437,
-	-104, -1000, -1000, 176, -1000, -1000, -1000, 889, -1000, 399,
-	-1000, -1000, -60, 892, -1000, 41, -1000, -1000, -1000, 892,
-	889, 173, 370, 184, 911, 911, 899, 889, -1000, -1000,
-	889, -1000, -1000, -1000, 36, 171, -32, -1000, -1000, 693,
-	10, 436, -1000, 164, 164, 693, -45, 663, 686, -1000,
-	-1000, 775, 398, -49, -49, -1000, 168, -93, 369, -55,
-	889, -1000, 889, -1000, -1000, -1000, 911, 899, 899, 889,
-	-1000, -1000, -1000, -1000, 691, -1000, -1000, -1000, -1000, 435,
-	-1000, 536, 368, -1000, -61, 705, -70, -1000, -1000, -1000,
-	367, -1000, 362, 168, -1000, 899, 889, 889, -1000, -1000,
-	691, 164, 533, -1000, 164, 109, -1000, -1000, 358, 432,
-	-1000, -1000, -1000, 889, -1000, -1000, -1000, -1000, 531, -1000,
-	164, -1000, -1000, 494, -70, -1000, 529, -1000, -49, -1000,
-	397, -1000, -1000, 154, -1000, 431, 339, -70, -1000, -49,
-	-33, 357, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 141, 940, 623, 943, 902, 802, 238, 227, 694,
+	580, 524, 1035, 922, 248, 485, 302, 164, 430, 309,
+	430, -1000, -1000, 199, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 504, 913, 754, 661, -1000, 655, 984, 638,
+	-1000, 708, 617, 977, 551, 561, 964, 962, -1000, -1000,
+	-1000, 899, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 295, 747, 293, 120, 523, 542, -26, -26, 292,
+	902, 744, 291, 80, 959, 290, 518, 956, 954, -26,
+	550, -26, 898, -1000, 53, 818, 743, 120, 949, 835,
+	-1000, 948, 868, 905, -1000, 705, 945, 288, 66, -1000,
+	975, 887, 53, 967, 248, 671, -24, 430, 430, 430,
+	430, 430, 430, 430, 430, -82, 2, 154, 287, -1000,
+	687, 690, 690, 818, -1000, 819, 286, 939, 902, 614,
+	913, 913, 659, 613, 111, 913, 595, 285, 610, 913,
+	120, -1000, -1000, 284, -26, 283, 573, 278, 811, 450,
+	317, 277, -1000, -1000, -1000, 275, 274, 248, 967, -1000,
+	-1000, 935, -1000, 898, -1000, 273, -1000, -1000, 767, 316,
+	272, 271, 269, -1000, 934, 933, -1000, -1000, 574, 204,
+	-1000, -1000, 498, -109, -1000, 818, 270, 447, 827, 442,
+	440, -1000, -1000, 193, -104, 268, 808, 264, 836, 262,
+	258, 976, 255, -1000, 252, 767, -26, -1000, 898, 491,
+	884, -1000, 975, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-78, -78, -78, -1000, -1000, -78, -1000, 412, -1000, -1000,
+	-1000, -1000, -1000, -1000, 430, 685, -1000, -22, 971, 872,
+	-1000, 249, 898, 872, 913, 902, 902, 821, 607, 913,
+	596, 913, 315, 110, 886, 575, 913, -1000, 913, 902,
+	-1000, -1000, -1000, 337, 547, -1000, 1044, 61, 506, 651,
+	932, 766, 807, -26, -28, 308, 931, 311, 411, 930,
+	-26, -1000, 929, 241, -1000, -1000, 240, 927, 307, -1000,
+	-26, -26, 53, 236, 53, 839, 844, 843, 382, 407,
+	818, 818, -82, -43, 439, 830, 905, 438, -26, -26,
+	650, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	914, 582, 924, 234, -1000, 970, 231, -1000, 336, 230,
+	335, 887, 824, -45, -45, 898, -1000, 59, 220, 430,
+	123, 869, 870, -1000, 872, 869, 902, 898, 887, 898,
+	872, 806, 645, 913, 820, 913, 902, 99, 306, 219,
+	872, 869, 913, 902, 902, 898, 887, 32, -1000, -1000,
+	1044, -1000, 15, 54, 218, 52, -1000, 135, 741, 740,
+	739, 738, 674, 50, 198, 214, -59, -1000, -1000, 788,
+	-1000, -26, 376, 16, 305, -27, -1000, -27, 212, 248,
+	211, 805, 905, 321, 209, -1000, -1000, 206, 203, -1000,
+	304, -1000, 484, -1000, 53, -1000, -1000, 890, -1000, -1000,
+	-1000, -1000, 33, 437, 405, 905, 483, 480, -1000, 818,
+	202, 135, 833, 201, -1000, -1000, 973, 200, -1000, -83,
+	803, 23, 491, 872, 436, -1000, 477, 207, 435, 173,
+	-1000, -1000, 887, -1000, 681, -104, 898, 196, 194, 341,
+	341, -1000, 857, -85, -85, 136, 869, -1000, 898, 887,
+	887, 869, 872, 869, 642, 170, 810, 801, 639, 902,
+	898, 887, 197, 172, 171, -1000, 869, -1000, 902, 898,
+	887, 898, 887, 887, 869, -105, -106, -1000, -1000, -1000,
+	-1000, -1000, 462, -1000, -1000, 12, 10, 9, 8, -1000,
+	-1000, -1000, -1000, 737, 800, 548, 546, 334, -1000, -1000,
+	-1000, -1000, 660, -27, -1000, -1000, -1000, 537, 404, 426,
+	736, 528, -26, 777, -1000, -1000, -1000, -26, 53, 912,
+	166, 402, 400, 221, -1000, 399, -26, -26, -46, 1044,
+	527, 163, 898, 158, -1000, -1000, 157, -1000, -1000, -1000,
+	-1000, -1000, 824, 869, -31, -45, 666, -84, 663, 491,
+	-1000, 872, -1000, -1000, -1000, -1000, -1000, 44, 42, 860,
+	-1000, -1000, -1000, -1000, 476, 475, -1000, 887, 869, 869,
+	-1000, 869, -1000, 170, 898, 132, 132, 423, 341, 341,
+	799, 635, 632, 170, 898, 887, 887, 869, 145, -1000,
+	-1000, -1000, 898, 887, 887, 869, 887, 869, 869, -1000,
+	142, 140, 135, -1000, -1000, -1000, -1000, 719, 6, 592,
+	581, 155, 581, 165, 785, -1000, -1000, 683, 590, 798,
+	248, -1000, 3, -8, 501, -26, -1000, -1000, -1000, -1000,
+	818, -1000, -1000, -1000, 397, 395, 471, -1000, 393, 391,
+	-1000, -1000, -1000, 139, -1000, -1000, -1000, 468, 333, 872,
+	126, 390, -1000, -1000, -1000, -1000, -1000, 369, 353, -1000,
+	824, 869, 854, -1000, -85, 136, -1000, -1000, 869, -1000,
+	-1000, -1000, 898, 872, -1000, 465, -1000, -1000, 132, -1000,
+	-1000, 622, 170, 170, 898, 887, 869, 869, -1000, -1000,
+	887, 869, 869, -1000, 869, -1000, -1000, 330, 326, -1000,
+	-1000, 695, 849, 848, 557, 135, -1000, 155, 544, 543,
+	557, -1000, 425, -1000, -1000, 905, -9, -10, 736, 388,
+	533, -1000, 777, -1000, 463, -109, -1000, -1000, 134, -1000,
+	-1000, -1000, 133, 41, 869, -1000, 417, -1000, -1000, -62,
+	-1000, -87, 872, -1000, 35, -1000, -1000, -1000, 872, 869,
+	132, 387, 170, 898, 898, 887, 869, -1000, -1000, 869,
+	-1000, -1000, -1000, 22, 131, 17, -1000, -1000, 711, 24,
+	462, -1000, 124, 124, 711, -11, 679, 699, -1000, -1000,
+	796, 416, -26, -26, -1000, 324, -1000, 126, -90, 386,
+	-17, 381, -21, 869, -1000, 869, -1000, -1000, -1000, 898,
+	887, 887, 869, -1000, -1000, -1000, -1000, 707, -1000, -1000,
+	-1000, -1000, 461, -1000, 579, 371, -1000, -23, 736, -38,
+	-1000, -1000, -48, -1000, 365, -1000, 360, -1000, 359, 126,
+	-1000, 887, 869, 869, -1000, -1000, 707, 124, 571, -1000,
+	124, 155, -1000, -1000, 351, 460, -1000, -1000, -1000, -1000,
+	-1000, 869, -1000, -1000, -1000, -1000, 567, -1000, 124, -1000,
+	-1000, 529, -38, -1000, 565, -1000, -26, -1000, 415, -1000,
+	-1000, 108, -1000, 459, 280, -38, -1000, -26, -49, 343,
+	-1000, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int16{
-	0, 730, 1128, 1127, 1126, 1125, 20, 1124, 1123, 1122,
-	1121, 1120, 1119, 1118, 1117, 1116, 1115, 1114, 1113, 1112,
-	1111, 1110, 1109, 1108, 1107, 1106, 16, 1105, 1104, 1103,
-	1102, 1101, 1099, 1098, 1097, 1095, 1094, 1093, 1092, 1091,
-	1090, 1088, 1086, 1085, 1084, 6, 1083, 1082, 1081, 1080,
-	1078, 1077, 1075, 1072, 1067, 1066, 1065, 1064, 1063, 1062,
-	1061, 25, 18, 1059, 1049, 39, 48, 43, 38, 40,
-	1048, 34, 1047, 50, 1046, 32, 1045, 1044, 27, 1043,
-	1042, 24, 37, 15, 1041, 42, 1038, 1036, 26, 12,
-	1034, 11, 33, 31, 1033, 13, 3, 1032, 22, 1030,
-	9, 7, 1029, 30, 264, 1028, 682, 10, 29, 0,
-	1027, 17, 1026, 21, 28, 4, 1025, 1023, 14, 1019,
-	1016, 2, 1015, 1014, 1013, 8, 1012, 5, 1011, 1010,
-	1007, 1, 23, 19, 36, 1005, 1001, 35, 41, 1000,
-	995, 993, 982,
+	0, 732, 1154, 1153, 1152, 1151, 21, 1150, 1149, 1148,
+	1147, 1146, 1145, 1142, 1141, 1140, 1139, 1138, 1137, 1136,
+	1134, 1131, 1130, 1129, 1128, 1127, 16, 1126, 1124, 1123,
+	1122, 1121, 1120, 1119, 1118, 1117, 1116, 1115, 1114, 1113,
+	1111, 1110, 1109, 1108, 1107, 10, 1106, 1105, 1103, 1102,
+	1101, 1100, 1091, 1090, 1089, 1087, 1086, 1085, 1081, 1078,
+	1077, 1076, 1074, 1073, 26, 17, 1072, 1071, 39, 595,
+	36, 38, 42, 1070, 32, 1069, 46, 1067, 70, 1064,
+	1060, 25, 1059, 1058, 62, 34, 15, 1055, 40, 1052,
+	1051, 746, 1050, 1049, 22, 68, 1046, 11, 30, 31,
+	1041, 6, 3, 1040, 23, 1039, 9, 7, 1038, 28,
+	1037, 559, 1035, 49, 12, 27, 0, 1033, 13, 1031,
+	18, 24, 4, 1027, 1018, 14, 1016, 1014, 2, 1009,
+	1008, 1007, 8, 1006, 5, 1005, 1003, 1000, 1, 37,
+	998, 20, 19, 33, 997, 996, 29, 35, 995, 993,
+	992, 990,
 }
 
 var yyR1 = [...]uint8{
-	0, 64, 65, 65, 65, 1, 1, 1, 1, 1,
+	0, 67, 68, 68, 68, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 6,
-	6, 6, 61, 61, 63, 63, 63, 63, 63, 63,
-	85, 85, 84, 62, 62, 81, 81, 81, 81, 81,
-	81, 81, 81, 81, 81, 81, 81, 81, 81, 81,
-	81, 69, 69, 66, 67, 67, 67, 67, 67, 67,
-	67, 70, 68, 68, 68, 72, 73, 73, 73, 73,
-	73, 71, 71, 71, 91, 91, 92, 92, 93, 93,
-	109, 109, 94, 94, 94, 94, 94, 94, 94, 94,
-	125, 125, 98, 98, 99, 99, 99, 75, 75, 77,
-	77, 76, 76, 78, 78, 78, 78, 78, 78, 78,
-	78, 78, 78, 79, 82, 82, 86, 86, 86, 86,
-	86, 86, 86, 86, 86, 104, 80, 80, 80, 80,
-	80, 80, 80, 80, 80, 80, 87, 87, 87, 89,
-	89, 88, 88, 90, 90, 90, 95, 132, 132, 96,
-	96, 96, 96, 97, 97, 97, 97, 2, 2, 3,
-	3, 138, 138, 138, 138, 138, 134, 134, 4, 103,
-	103, 102, 102, 102, 102, 102, 102, 102, 7, 7,
-	74, 74, 74, 74, 8, 8, 9, 9, 5, 5,
-	5, 10, 10, 100, 100, 101, 101, 101, 101, 11,
-	11, 12, 14, 13, 13, 15, 15, 16, 17, 19,
-	19, 19, 21, 21, 20, 20, 20, 22, 22, 18,
-	23, 23, 110, 110, 110, 110, 110, 110, 110, 110,
-	110, 52, 52, 52, 52, 52, 106, 106, 24, 24,
-	25, 25, 26, 26, 26, 26, 26, 83, 83, 105,
-	27, 27, 28, 28, 28, 28, 29, 29, 29, 29,
-	30, 30, 30, 30, 31, 31, 139, 139, 140, 128,
-	128, 129, 129, 129, 114, 114, 133, 133, 133, 141,
-	141, 142, 119, 119, 120, 120, 124, 124, 112, 112,
-	51, 51, 137, 137, 135, 135, 136, 136, 136, 126,
-	126, 127, 127, 115, 115, 107, 107, 116, 117, 121,
-	121, 123, 122, 122, 122, 113, 113, 108, 32, 33,
-	34, 35, 35, 35, 35, 36, 36, 36, 36, 37,
-	37, 38, 38, 39, 40, 40, 41, 130, 130, 130,
-	130, 42, 43, 44, 44, 44, 46, 46, 46, 46,
-	47, 47, 45, 131, 131, 48, 48, 49, 49, 50,
-	53, 54, 118, 118, 111, 111, 58, 58, 59, 60,
-	60, 60, 60, 55, 56, 56, 56, 56, 56, 57,
-	57, 57, 57, 57,
+	1, 1, 6, 6, 6, 64, 64, 66, 66, 66,
+	66, 66, 66, 88, 88, 87, 65, 65, 84, 84,
+	84, 84, 84, 84, 84, 84, 84, 84, 84, 84,
+	84, 84, 84, 84, 72, 72, 69, 70, 70, 70,
+	70, 70, 70, 70, 73, 90, 90, 90, 90, 90,
+	71, 71, 71, 75, 76, 76, 76, 76, 76, 74,
+	74, 74, 97, 97, 98, 98, 99, 99, 116, 116,
+	100, 100, 100, 100, 100, 100, 100, 100, 100, 100,
+	100, 132, 132, 104, 104, 105, 105, 105, 78, 78,
+	80, 80, 79, 79, 81, 81, 81, 81, 81, 81,
+	81, 81, 81, 81, 82, 85, 85, 89, 89, 89,
+	89, 89, 89, 89, 89, 89, 111, 83, 83, 83,
+	83, 83, 83, 83, 83, 83, 83, 93, 93, 93,
+	95, 95, 94, 94, 96, 96, 96, 101, 141, 141,
+	102, 102, 102, 102, 103, 103, 103, 103, 2, 2,
+	3, 3, 147, 147, 147, 147, 147, 143, 143, 4,
+	109, 109, 108, 108, 108, 108, 108, 108, 108, 7,
+	7, 77, 77, 77, 77, 8, 8, 9, 9, 5,
+	5, 5, 10, 10, 106, 106, 107, 107, 107, 107,
+	11, 11, 12, 14, 13, 13, 15, 15, 16, 17,
+	19, 91, 91, 91, 92, 92, 110, 110, 21, 21,
+	20, 22, 22, 18, 23, 23, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 52, 52, 52, 52, 52,
+	113, 113, 24, 24, 25, 25, 26, 26, 26, 26,
+	26, 86, 86, 112, 27, 27, 28, 28, 28, 28,
+	29, 29, 29, 29, 30, 30, 30, 30, 31, 31,
+	148, 148, 149, 135, 135, 136, 136, 136, 121, 121,
+	142, 142, 142, 150, 150, 151, 126, 126, 127, 127,
+	131, 131, 119, 119, 51, 51, 146, 146, 144, 144,
+	145, 145, 145, 133, 133, 134, 134, 122, 122, 114,
+	114, 123, 124, 128, 128, 130, 129, 129, 129, 120,
+	120, 115, 32, 33, 61, 62, 63, 139, 139, 140,
+	140, 34, 35, 35, 35, 35, 36, 36, 36, 36,
+	37, 37, 38, 38, 39, 40, 40, 41, 137, 137,
+	137, 137, 42, 43, 44, 44, 44, 46, 46, 46,
+	46, 47, 47, 45, 138, 138, 48, 48, 49, 49,
+	50, 53, 54, 125, 125, 118, 118, 58, 58, 59,
+	60, 60, 60, 60, 55, 56, 56, 56, 56, 56,
+	57, 57, 57, 57, 57,
 }
 
 var yyR2 = [...]int8{
@@ -743,141 +771,146 @@ var yyR2 = [...]int8{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 11,
-	12, 9, 1, 3, 1, 3, 3, 1, 3, 3,
-	1, 2, 4, 1, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 4, 3, 2, 1, 1, 5,
-	6, 2, 0, 2, 1, 3, 1, 3, 3, 5,
-	1, 6, 3, 5, 3, 1, 5, 4, 4, 3,
-	1, 1, 1, 1, 3, 0, 2, 0, 1, 3,
-	1, 1, 1, 3, 4, 6, 7, 1, 3, 1,
-	4, 0, 4, 0, 1, 1, 1, 2, 0, 1,
-	3, 1, 3, 1, 3, 5, 5, 4, 6, 6,
-	5, 6, 6, 3, 1, 3, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 1, 1,
-	1, 1, 1, 1, 3, 1, 1, 1, 1, 3,
-	0, 1, 3, 1, 2, 2, 2, 1, 1, 4,
-	2, 2, 0, 4, 2, 2, 0, 2, 3, 5,
-	4, 2, 1, 3, 3, 0, 3, 3, 2, 1,
-	2, 1, 2, 2, 2, 2, 1, 2, 9, 6,
-	2, 2, 2, 2, 5, 3, 7, 8, 6, 9,
-	9, 5, 4, 1, 2, 3, 3, 3, 3, 7,
-	6, 2, 3, 4, 3, 3, 2, 7, 6, 6,
-	7, 6, 5, 4, 6, 7, 6, 5, 4, 3,
-	8, 7, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 4, 8, 7, 7, 6, 2, 0, 7, 6,
-	11, 10, 2, 2, 4, 2, 2, 1, 3, 1,
-	3, 2, 10, 9, 9, 8, 13, 12, 12, 11,
-	10, 9, 9, 8, 5, 5, 0, 6, 10, 0,
-	2, 0, 2, 6, 0, 2, 0, 2, 2, 0,
-	3, 3, 0, 1, 0, 1, 0, 1, 0, 2,
-	2, 0, 2, 1, 2, 2, 2, 3, 2, 3,
-	3, 2, 0, 1, 3, 2, 0, 2, 2, 3,
-	1, 2, 3, 3, 0, 1, 3, 1, 3, 6,
-	4, 9, 8, 8, 7, 9, 8, 8, 7, 2,
-	4, 7, 3, 3, 3, 5, 10, 3, 3, 5,
-	0, 3, 6, 9, 11, 7, 4, 6, 2, 4,
-	2, 4, 10, 1, 3, 8, 6, 2, 4, 3,
-	2, 3, 1, 3, 1, 1, 10, 8, 2, 3,
-	5, 7, 5, 2, 6, 6, 6, 6, 6, 2,
-	6, 6, 10, 10,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 11, 12, 9, 1, 3, 1, 3, 3,
+	1, 3, 3, 1, 2, 4, 1, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 4, 3, 2,
+	1, 1, 5, 6, 2, 0, 2, 1, 3, 1,
+	3, 3, 5, 1, 6, 1, 2, 0, 1, 2,
+	3, 5, 3, 1, 5, 4, 4, 3, 1, 1,
+	1, 1, 3, 0, 2, 0, 1, 3, 1, 1,
+	1, 3, 4, 6, 7, 4, 6, 7, 1, 3,
+	1, 4, 0, 4, 0, 1, 1, 1, 2, 0,
+	1, 3, 1, 3, 1, 3, 5, 5, 4, 6,
+	6, 5, 6, 6, 3, 1, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
+	1, 1, 1, 1, 1, 3, 1, 1, 1, 1,
+	3, 0, 1, 3, 1, 2, 2, 2, 1, 1,
+	4, 2, 2, 0, 4, 2, 2, 0, 2, 3,
+	5, 4, 2, 1, 3, 3, 0, 3, 3, 2,
+	1, 2, 1, 2, 2, 2, 2, 1, 2, 9,
+	6, 2, 2, 2, 2, 5, 3, 7, 8, 6,
+	9, 9, 5, 4, 1, 2, 3, 3, 3, 3,
+	7, 6, 2, 3, 4, 3, 3, 2, 7, 6,
+	7, 1, 2, 1, 3, 1, 2, 0, 5, 4,
+	7, 5, 4, 3, 8, 7, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 4, 8, 7, 7, 6,
+	2, 0, 7, 6, 11, 10, 2, 2, 4, 2,
+	2, 1, 3, 1, 3, 2, 10, 9, 9, 8,
+	13, 12, 12, 11, 10, 9, 9, 8, 5, 5,
+	0, 6, 10, 0, 2, 0, 2, 6, 0, 2,
+	0, 2, 2, 0, 3, 3, 0, 1, 0, 1,
+	0, 1, 0, 2, 2, 0, 2, 1, 2, 2,
+	2, 3, 2, 3, 3, 2, 0, 1, 3, 2,
+	0, 2, 2, 3, 1, 2, 3, 3, 0, 1,
+	3, 1, 3, 6, 7, 5, 2, 1, 1, 3,
+	5, 4, 9, 8, 8, 7, 9, 8, 8, 7,
+	2, 4, 7, 3, 3, 3, 5, 10, 3, 3,
+	5, 0, 3, 6, 9, 11, 7, 4, 6, 2,
+	4, 2, 4, 10, 1, 3, 8, 6, 2, 4,
+	3, 2, 3, 1, 3, 1, 1, 10, 8, 2,
+	3, 5, 7, 5, 2, 6, 6, 6, 6, 6,
+	2, 6, 6, 10, 10,
 }
 
 var yyChk = [...]int16{
-	-1000, -64, -65, -1, -6, -2, -3, -9, -5, -7,
+	-1000, -67, -68, -1, -6, -2, -3, -9, -5, -7,
 	-8, -11, -12, -14, -13, -15, -16, -17, -19, -21,
 	-22, -20, -18, -23, -24, -25, -27, -28, -29, -30,
-	-31, -32, -33, -34, -35, -36, -37, -38, -39, -40,
-	-41, -42, -43, -44, -46, -47, -48, -49, -50, -52,
-	-53, -54, -58, -59, -60, -55, -56, -57, 8, 18,
-	19, 62, 30, 40, 53, 28, 77, 57, 98, 128,
-	-61, 147, -63, 155, -81, 129, 142, 152, -80, 144,
-	63, 146, 143, 145, 69, 70, -104, 148, 131, 43,
-	45, 46, 61, 42, 71, -110, 73, 59, 5, 90,
-	51, 86, 102, 107, 88, 92, 116, 117, 82, 83,
-	84, 81, 32, 121, 122, 85, 142, 44, 46, 41,
-	5, 86, 101, 105, 93, 44, 61, 46, 41, 51,
-	5, 86, 101, 102, 105, 35, 93, -66, -75, 4,
-	9, 46, 5, 35, 142, 35, 142, 78, -6, 37,
-	115, 108, -1, -69, -75, 6, -61, 127, 139, 10,
-	155, 156, 151, 152, 154, 157, 158, 153, -81, 129,
-	139, 138, -81, -85, 142, -84, 64, 119, -106, 7,
-	47, -106, 79, 80, 74, 75, 76, 4, 74, 76,
-	58, 79, 80, 4, 94, 88, 7, 7, 9, 142,
-	48, 142, -73, 142, 138, -71, 145, -104, 108, 7,
-	129, -109, 142, 145, -109, 142, -66, -75, 48, 142,
-	143, 142, 108, 7, 7, -109, 92, -109, -75, -67,
-	-72, -68, -70, -73, 129, -78, -76, 129, 142, 27,
-	26, 112, 114, -77, -79, -82, -81, 48, -73, 7,
-	21, 24, 7, 7, 21, 4, 7, -6, 58, 142,
-	143, -66, -91, 11, -67, -69, -61, 71, 73, 142,
-	145, -81, -81, -81, -81, -81, -81, -81, -81, 130,
-	-61, 130, -87, 142, 71, 73, 142, 66, -85, -85,
-	-78, 31, -75, 142, 7, -66, -75, 80, -106, -106,
-	-106, 79, 80, 79, 80, 142, 138, -106, 79, 80,
-	142, 80, -106, -73, 142, -109, 142, -4, -138, 31,
-	118, -134, 71, 142, 31, -51, 129, 138, 142, 142,
-	142, -61, -69, 7, -75, 142, 138, 142, 142, 142,
-	7, 7, 127, 10, 127, 20, -65, -68, 149, 150,
-	-81, -78, 25, 26, 129, 27, 129, 129, -86, 132,
-	133, 134, 135, 136, 137, 141, 140, 113, 142, 31,
-	142, 7, 24, 142, 142, 142, 7, 4, 142, 142,
-	142, -109, -75, -92, 124, 12, -66, 130, -81, 66,
-	65, 5, -89, 13, 142, -75, -89, -106, -66, -75,
-	-66, -75, -66, 31, 80, -106, 80, -106, 138, 142,
-	138, -66, -89, 80, -106, -106, -66, -75, 132, -138,
-	-103, -102, -101, 49, 60, 38, 39, 50, 81, 51,
-	54, 55, 52, 143, 118, 72, 7, 37, -139, -140,
-	31, -137, -135, -136, -109, 142, 138, -71, 138, 7,
-	129, 138, 130, 7, -109, 7, 142, 7, 138, -109,
-	-109, -67, 142, -67, 23, 130, 130, -78, -78, 130,
-	129, 25, -6, 129, -109, -109, -82, 129, 7, 81,
-	24, 142, 142, 24, 4, 142, 142, 4, 132, 132,
-	-91, -98, 29, -93, -94, -109, 142, 155, -104, -93,
-	-75, 68, 142, -81, -74, 132, 133, 141, 140, -95,
-	-96, 14, 15, 12, -89, -96, -66, -75, -75, -91,
-	-75, -89, 31, 76, -106, -66, 31, -106, -66, -75,
-	142, 138, 138, 142, -89, -96, -106, -66, -75, -66,
-	-75, -75, -91, 142, 143, -103, 144, 143, 142, 143,
-	-113, -108, 142, 49, 49, 49, 49, -134, 143, 142,
-	50, 142, 145, -141, -142, 32, -137, 127, 130, 71,
-	-109, 138, -71, 142, -71, 142, -61, 142, 31, -6,
-	138, 120, 142, 142, 142, 138, 127, -67, 10, -61,
-	-6, 129, 130, -6, 127, 127, -78, 142, -113, 142,
-	24, 142, 142, 4, 142, 145, -109, 143, 146, 69,
-	70, -92, -89, 129, 127, 139, 129, 139, -91, 68,
-	-75, 142, 142, -104, -104, -97, 16, 17, -132, 143,
-	148, -132, -88, -90, 142, -96, -75, -91, -91, -96,
-	-89, -95, 76, -26, 132, 133, 25, 141, 140, -66,
-	31, 31, 76, -66, -75, -75, -91, 138, 142, 142,
-	-96, -66, -75, -75, -91, -75, -91, -91, -96, 149,
-	149, 127, 144, 144, 144, 144, -10, 49, 31, -128,
-	95, -129, 95, 132, 73, -71, -130, 100, 130, 129,
-	-45, 49, 106, -109, -111, 35, 36, -109, -67, 7,
-	142, 130, 130, -6, -62, 142, 130, -109, -109, 130,
-	-103, -107, 56, 142, 142, -98, -95, -99, 142, 143,
-	146, -93, 71, 144, 71, -92, -89, 143, 143, 15,
-	127, 125, 126, -91, -96, -96, -95, -26, -75, -83,
-	-105, 142, -83, 129, -104, -104, 31, 76, 76, -26,
-	-75, -91, -91, -96, 142, -75, -91, -91, -96, -91,
-	-96, -96, 142, 142, -108, 50, 144, 35, 109, -114,
-	81, -127, -126, 142, 73, -114, -127, 142, 34, 33,
-	67, 99, 58, 31, -61, 144, 144, 120, -118, -109,
-	-78, 130, 130, 127, 130, 130, 142, -89, -125, 142,
-	130, 130, 127, -98, -95, 17, -132, -88, -96, -75,
-	-89, 127, -83, 76, -26, -26, -75, -91, -96, -96,
-	-91, -96, -96, -96, 132, 132, 60, 21, 21, -133,
-	90, -113, -127, 96, 96, -133, 129, -6, 144, 144,
-	-45, 130, 103, -111, 127, -62, -95, 129, 144, 152,
-	-89, 143, -89, -96, -83, 130, -26, -75, -75, -91,
-	-96, -96, 143, 142, 143, -107, 123, 143, -115, 142,
-	-115, -107, 144, 68, 58, 31, 129, -118, -118, -125,
-	145, 130, 144, -95, -96, -75, -91, -91, -96, -100,
-	-101, 127, -119, -116, 82, 130, 144, -45, -131, 144,
-	130, 130, -125, -91, -96, -96, -100, -115, -120, -117,
-	83, -115, -127, 130, 127, -96, -124, -123, 84, -115,
-	104, -131, -112, 85, -121, -122, -109, 129, 142, 127,
-	132, -131, -121, -109, 143, 130,
+	-31, -32, -33, -61, -62, -63, -34, -35, -36, -37,
+	-38, -39, -40, -41, -42, -43, -44, -46, -47, -48,
+	-49, -50, -52, -53, -54, -58, -59, -60, -55, -56,
+	-57, 8, 18, 19, 62, 30, 40, 53, 28, 77,
+	57, 98, 130, -64, 151, -66, 159, -84, 131, 144,
+	156, -83, 147, 63, 150, 146, 149, 69, 70, -111,
+	152, 133, 43, 45, 46, 61, 42, 71, -117, 73,
+	126, 59, 5, 90, 51, 86, 102, 107, 88, 92,
+	116, 117, 82, 83, 84, 81, 32, 121, 122, 85,
+	144, 44, 46, 41, 5, 86, 101, 105, 93, 44,
+	61, 46, 41, 51, 125, 5, 86, 101, 102, 105,
+	35, 93, -69, -78, 4, 9, 46, 5, -91, 35,
+	144, -91, 35, 78, -6, 37, 125, 115, 108, -1,
+	-72, -78, 6, -64, 129, 141, 10, 159, 160, 155,
+	156, 158, 161, 162, 157, -84, 131, 141, 140, -84,
+	-88, 144, -87, 64, 119, -113, 7, 47, -113, 79,
+	80, 74, 75, 76, 4, 74, 76, 58, 79, 80,
+	4, 94, 88, 7, 7, 9, 144, 48, 144, -76,
+	144, 140, -74, 149, -111, 108, 7, 131, -116, 144,
+	149, -116, 144, -69, -78, 48, 144, 146, 7, 144,
+	108, 7, 7, -116, 92, -116, -78, -70, -75, -71,
+	-73, -76, 131, -81, -79, 131, 144, 27, 26, 112,
+	114, -80, -82, -85, -84, 48, -76, 7, 21, 24,
+	7, 21, 4, -6, 58, 7, 144, 146, -69, -97,
+	11, -70, -72, -64, 71, 73, 144, 149, -84, -84,
+	-84, -84, -84, -84, -84, -84, 132, -64, 132, -93,
+	144, 71, 73, 144, 66, -88, -88, -81, 31, -78,
+	144, 7, -69, -78, 80, -113, -113, -113, 79, 80,
+	79, 80, 144, 140, -113, 79, 80, 144, 80, -113,
+	-76, 144, -116, 144, -4, -147, 31, 118, -143, 71,
+	144, 31, -51, 131, 140, 144, 144, 144, -64, -72,
+	7, -78, 144, -139, 41, 44, 140, 144, 144, 144,
+	7, 7, 129, 10, 129, -90, 20, 145, -68, -71,
+	153, 154, -84, -81, 25, 26, 131, 27, 131, 131,
+	-89, 134, 135, 136, 137, 138, 139, 143, 142, 113,
+	144, 31, 144, 24, 144, 144, 4, 144, 144, -139,
+	-116, -78, -98, 124, 12, -69, 132, -84, 66, 65,
+	5, -95, 13, 144, -78, -95, -113, -69, -78, -69,
+	-78, -69, 31, 80, -113, 80, -113, 140, 144, 140,
+	-69, -95, 80, -113, -113, -69, -78, 134, -147, -109,
+	-108, -107, 49, 60, 38, 39, 50, 81, 51, 54,
+	55, 52, 146, 118, 72, 7, 37, -148, -149, 31,
+	-146, -144, -145, -116, 144, 140, -74, 140, 7, 131,
+	140, 132, 7, -116, 7, 144, 144, 7, 140, -116,
+	-116, -70, 144, -70, 23, 22, 22, 132, 132, -81,
+	-81, 132, 131, 25, -6, 131, -116, -116, -85, 131,
+	7, 81, -92, 5, -78, 144, -110, 5, 144, 134,
+	144, 134, -97, -104, 29, -99, -100, -116, 144, 159,
+	-111, -99, -78, 68, 144, -84, -77, 134, 135, 143,
+	142, -101, -102, 14, 15, 12, -95, -102, -69, -78,
+	-78, -97, -78, -95, 31, 76, -113, -69, 31, -113,
+	-69, -78, 144, 140, 140, 144, -95, -102, -113, -69,
+	-78, -69, -78, -78, -97, 144, 146, -109, 147, 146,
+	144, 146, -120, -115, 144, 49, 49, 49, 49, -143,
+	146, 144, 50, 144, 149, -150, -151, 32, -146, 129,
+	132, 71, -116, 140, -74, 144, -74, 144, -64, 144,
+	31, -6, 140, 120, 144, 144, 144, 140, 129, -70,
+	10, -64, -6, 131, 132, -6, 129, 129, -81, 144,
+	-120, 24, 144, 4, 144, 149, 31, -116, 146, 150,
+	69, 70, -98, -95, 131, 129, 141, 131, 141, -97,
+	68, -78, 144, 144, -111, -111, -103, 16, 17, -141,
+	146, 152, -141, -94, -96, 144, -102, -78, -97, -97,
+	-102, -95, -101, 76, -26, 134, 135, 25, 143, 142,
+	-69, 31, 31, 76, -69, -78, -78, -97, 140, 144,
+	144, -102, -69, -78, -78, -97, -78, -97, -97, -102,
+	153, 153, 129, 147, 147, 147, 147, -10, 49, 31,
+	-135, 95, -136, 95, 134, 73, -74, -137, 100, 132,
+	131, -45, 49, 106, -116, -118, 35, 36, -116, -70,
+	7, 144, 132, 132, -6, -65, 144, 132, -116, -116,
+	132, -109, -114, 56, 144, -78, 144, -140, 144, -104,
+	-101, -105, 144, 146, 150, -99, 71, 147, 148, 71,
+	-98, -95, 146, 146, 15, 129, 127, 128, -97, -102,
+	-102, -101, -26, -78, -86, -112, 144, -86, 131, -111,
+	-111, 31, 76, 76, -26, -78, -97, -97, -102, 144,
+	-78, -97, -97, -102, -97, -102, -102, 144, 144, -115,
+	50, 147, 35, 109, -121, 81, -134, -133, 144, 73,
+	-121, -134, 144, 34, 33, 67, 99, 58, 31, -64,
+	147, 147, 120, -125, -116, -81, 132, 132, 129, 132,
+	132, 144, 129, 134, -95, -132, 144, 132, 132, 129,
+	132, 129, -104, -101, 17, -141, -94, -102, -78, -95,
+	129, -86, 76, -26, -26, -78, -97, -102, -102, -97,
+	-102, -102, -102, 134, 134, 60, 21, 21, -142, 90,
+	-120, -134, 96, 96, -142, 131, -6, 147, 147, -45,
+	132, 103, -118, 129, -65, 144, 146, -101, 131, 147,
+	156, 147, 156, -95, 146, -95, -102, -86, 132, -26,
+	-78, -78, -97, -102, -102, 146, 144, 146, -114, 123,
+	146, -122, 144, -122, -114, 147, 68, 58, 31, 131,
+	-125, -125, 134, -132, 149, 132, 147, 132, 147, -101,
+	-102, -78, -97, -97, -102, -106, -107, 129, -126, -123,
+	82, 132, 147, -45, -138, 147, 146, 132, 132, 132,
+	-132, -97, -102, -102, -106, -122, -127, -124, 83, -122,
+	-134, 132, 129, -102, -131, -130, 84, -122, 104, -138,
+	-119, 85, -128, -129, -116, 131, 144, 129, 134, -138,
+	-128, -116, 146, 132,
 }
 
 var yyDef = [...]int16{
@@ -886,95 +919,98 @@ var yyDef = [...]int16{
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
 	31, 32, 33, 34, 35, 36, 37, 38, 39, 40,
 	41, 42, 43, 44, 45, 46, 47, 48, 49, 50,
-	51, 52, 53, 54, 55, 56, 57, 58, 0, 0,
-	0, 0, 138, 0, 0, 0, 0, 0, 0, 3,
-	-2, 0, 62, 64, 67, 0, 166, 0, 87, 88,
-	0, 168, 169, 170, 171, 172, 173, 175, 165, 197,
-	277, 0, 277, 241, 0, 0, 0, 0, 0, 369,
-	0, 0, 390, 397, 400, 408, 413, 419, 262, 263,
-	264, 265, 266, 267, 268, 269, 270, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 138, 0, 0, 0,
-	0, 0, 0, 388, 0, 0, 0, 138, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
-	0, 0, 4, 0, 115, 0, 92, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 86, 0, 0, 70, 0, 198, 138, 0,
-	225, 138, 0, 277, 277, 277, 0, 0, 277, 0,
-	0, 0, 277, 0, 373, 381, 0, 0, 0, 205,
-	0, 0, 331, 111, 0, 110, 112, 113, 0, 0,
-	0, 92, 120, 121, 0, 242, 138, 244, 0, 259,
-	358, 374, 0, 0, 0, 399, 409, 0, 245, 93,
-	94, 96, 100, 105, 0, 137, 143, 0, 166, 0,
-	0, 0, 0, 141, 139, 0, 154, 0, 372, 0,
-	0, 0, 0, 0, 0, 0, 0, 290, 0, 0,
-	401, 138, 117, 0, 91, 0, 63, 65, 66, 68,
-	69, 75, 76, 77, 78, 79, 80, 81, 82, 83,
-	0, 85, 167, 176, 177, 178, 174, 0, 0, 71,
-	0, 0, 180, 276, 0, 138, 180, 277, 138, 138,
-	0, 0, 277, 0, 277, 271, 0, 180, 0, 277,
-	360, 277, 138, 370, 391, 398, 0, 205, 200, 0,
-	0, 202, 0, 0, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 243, 0, 0, 0, 386, 389,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	154, 0, 0, 0, 0, 0, 0, 0, 0, 156,
-	157, 158, 159, 160, 161, 162, 163, 164, 0, 0,
-	0, 0, 0, 253, 0, 0, 0, 0, 258, 0,
-	0, 0, 115, 133, 0, 0, 138, 84, 0, 0,
-	0, 0, 192, 0, 224, 180, 192, 138, 138, 115,
-	138, 180, 0, 0, 277, 0, 277, 138, 0, 0,
-	0, 180, 192, 277, 138, 138, 138, 115, 0, 199,
-	208, 209, 211, 0, 0, 0, 0, 216, 0, 0,
-	0, 0, 0, 201, 0, 0, 0, 0, 304, 305,
-	319, 330, 333, 0, 0, 111, 0, 109, 0, 0,
-	0, 0, 0, 0, 0, 0, 375, 0, 0, 410,
-	412, 95, 98, 97, 0, 102, 104, 140, 142, -2,
-	0, 0, 0, 0, 0, 0, 153, 0, 0, 0,
-	0, 0, 252, 0, 0, 0, 257, 0, 0, 0,
-	117, 180, 0, 116, 118, 122, 120, 127, 129, 114,
-	115, 89, 0, 72, 138, 0, 0, 0, 0, 219,
-	196, 0, 0, 0, 192, 240, 138, 115, 115, 192,
-	180, 192, 0, 0, 0, 0, 0, 138, 138, 115,
-	0, 0, 0, 275, 192, 279, 138, 138, 115, 138,
-	115, 115, 192, 420, 421, 210, 212, 213, 214, 215,
-	217, 355, 357, 0, 0, 0, 0, 203, 204, 206,
-	207, 0, 228, 309, 311, 0, 332, 334, 335, 336,
-	338, 0, 108, 111, 107, 380, 0, 0, 0, 396,
-	0, 0, 248, 382, 387, 0, 0, 0, 0, 0,
-	0, 0, 147, 0, 0, 0, 0, 0, 346, 249,
-	0, 251, 254, 0, 256, 359, 414, 415, 416, 417,
-	418, 133, 192, 0, 0, 0, 0, 0, 117, 90,
-	180, 220, 221, 222, 223, 186, 0, 0, 190, 187,
-	188, 191, 179, 181, 183, 239, 115, 192, 192, 368,
-	192, 261, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 138, 115, 115, 192, 0, 273, 274,
-	278, 138, 115, 115, 192, 115, 192, 192, 364, 0,
-	0, 0, 235, 236, 237, 238, 226, 0, 0, 314,
-	342, 314, 342, 0, 337, 106, 0, 0, 0, 0,
-	385, 0, 0, 0, 0, 404, 405, 411, 99, 0,
-	103, 145, 146, 0, 0, 73, 150, 0, 0, 155,
-	247, 371, 0, 250, 255, 180, 131, 0, 134, 135,
-	136, 119, 123, 0, 128, 133, 192, 194, 195, 0,
-	0, 184, 185, 192, 366, 367, 260, 138, 180, 282,
-	287, 289, 283, 0, 285, 286, 0, 0, 0, 138,
-	115, 192, 192, 295, 272, 115, 192, 192, 303, 192,
-	362, 363, 0, 0, 356, 227, 0, 0, 0, 316,
-	0, 310, 342, 0, 0, 316, 312, 0, 320, 321,
-	0, 0, 0, 0, 0, 0, 395, 0, 407, 402,
-	101, 148, 149, 0, 151, 152, 345, 192, 61, 0,
-	132, 124, 0, 180, 218, 0, 189, 182, 365, 180,
-	192, 0, 0, 0, 138, 138, 115, 192, 293, 294,
-	192, 301, 302, 361, 0, 0, 0, 229, 230, 346,
-	0, 315, 341, 0, 0, 346, 0, 0, 377, 378,
-	383, 0, 0, 0, 0, 74, 131, 0, 0, 0,
-	192, 193, 192, 281, 288, 284, 138, 115, 115, 192,
-	292, 300, 423, 422, 232, 307, 317, 318, 339, 343,
-	340, 322, 0, 376, 0, 0, 0, 406, 403, 59,
-	0, 125, 0, 131, 280, 115, 192, 192, 299, 231,
-	233, 0, 324, 323, 0, 342, 379, 384, 0, 393,
-	130, 126, 60, 192, 297, 298, 234, 344, 326, 325,
-	0, 347, 313, 0, 0, 296, 328, 327, 354, 348,
-	0, 394, 308, 0, 351, 350, 0, 0, 329, 354,
-	0, 0, 349, 352, 353, 392,
+	51, 52, 53, 54, 55, 56, 57, 58, 59, 60,
+	61, 0, 0, 0, 0, 149, 0, 0, 0, 0,
+	0, 0, 3, -2, 0, 65, 67, 70, 0, 177,
+	0, 90, 91, 0, 179, 180, 181, 182, 183, 184,
+	186, 176, 208, 291, 0, 291, 252, 0, 0, 0,
+	376, 0, 0, 390, 0, 0, 411, 418, 421, 429,
+	434, 440, 276, 277, 278, 279, 280, 281, 282, 283,
+	284, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	149, 0, 0, 0, 0, 0, 0, 0, 409, 0,
+	0, 0, 149, 257, 0, 0, 0, 0, 0, 261,
+	263, 0, 261, 0, 305, 0, 0, 0, 0, 4,
+	0, 123, 0, 95, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 89,
+	0, 0, 73, 0, 209, 149, 0, 236, 149, 0,
+	291, 291, 291, 0, 0, 291, 0, 0, 0, 291,
+	0, 394, 402, 0, 0, 0, 216, 0, 0, 345,
+	119, 0, 118, 120, 121, 0, 0, 0, 95, 128,
+	129, 0, 253, 149, 255, 0, 273, 372, 0, 395,
+	0, 0, 0, 420, 430, 0, 256, 96, 97, -2,
+	103, 113, 0, 148, 154, 0, 177, 0, 0, 0,
+	0, 152, 150, 0, 165, 0, 393, 0, 262, 0,
+	0, 262, 0, 304, 0, 0, 0, 422, 149, 125,
+	0, 94, 0, 66, 68, 69, 71, 72, 78, 79,
+	80, 81, 82, 83, 84, 85, 86, 0, 88, 178,
+	187, 188, 189, 185, 0, 0, 74, 0, 0, 191,
+	290, 0, 149, 191, 291, 149, 149, 0, 0, 291,
+	0, 291, 285, 0, 191, 0, 291, 381, 291, 149,
+	391, 412, 419, 0, 216, 211, 0, 0, 213, 0,
+	0, 0, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 254, 0, 0, 377, 378, 0, 0, 407, 410,
+	0, 0, 0, 0, 0, 0, 105, 108, 0, 0,
+	0, 0, 165, 0, 0, 0, 0, 0, 0, 0,
+	0, 167, 168, 169, 170, 171, 172, 173, 174, 175,
+	0, 0, 149, 0, 269, 267, 0, 272, 0, 0,
+	0, 123, 144, 0, 0, 149, 87, 0, 0, 0,
+	0, 203, 0, 235, 191, 203, 149, 149, 123, 149,
+	191, 0, 0, 291, 0, 291, 149, 0, 0, 0,
+	191, 203, 291, 149, 149, 149, 123, 0, 210, 219,
+	220, 222, 0, 0, 0, 0, 227, 0, 0, 0,
+	0, 0, 212, 0, 0, 0, 0, 318, 319, 333,
+	344, 347, 0, 0, 119, 0, 117, 0, 0, 0,
+	0, 0, 0, 0, 0, 375, 396, 0, 0, 431,
+	433, 98, 101, 100, 0, 106, 109, 110, 112, 151,
+	153, -2, 0, 0, 0, 0, 0, 0, 164, 0,
+	0, 0, 0, 0, 265, 268, 0, 0, 271, 0,
+	0, 0, 125, 191, 0, 124, 126, 130, 128, 138,
+	140, 122, 123, 92, 0, 75, 149, 0, 0, 0,
+	0, 230, 207, 0, 0, 0, 203, 251, 149, 123,
+	123, 203, 191, 203, 0, 0, 0, 0, 0, 149,
+	149, 123, 0, 0, 0, 289, 203, 293, 149, 149,
+	123, 149, 123, 123, 203, 441, 442, 221, 223, 224,
+	225, 226, 228, 369, 371, 0, 0, 0, 0, 214,
+	215, 217, 218, 0, 239, 323, 325, 0, 346, 348,
+	349, 350, 352, 0, 116, 119, 115, 401, 0, 0,
+	0, 417, 0, 0, 259, 403, 408, 0, 0, 0,
+	0, 0, 0, 0, 158, 0, 0, 0, 0, 0,
+	360, 0, 149, 0, 266, 373, 0, 435, 436, 437,
+	438, 439, 144, 203, 0, 0, 0, 0, 0, 125,
+	93, 191, 231, 232, 233, 234, 197, 0, 0, 201,
+	198, 199, 202, 190, 192, 194, 250, 123, 203, 203,
+	389, 203, 275, 0, 149, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 149, 123, 123, 203, 0, 287,
+	288, 292, 149, 123, 123, 203, 123, 203, 203, 385,
+	0, 0, 0, 246, 247, 248, 249, 237, 0, 0,
+	328, 356, 328, 356, 0, 351, 114, 0, 0, 0,
+	0, 406, 0, 0, 0, 0, 425, 426, 432, 102,
+	0, 111, 156, 157, 0, 0, 76, 161, 0, 0,
+	166, 258, 392, 0, 260, 264, 270, 374, 0, 191,
+	142, 0, 145, 146, 147, 127, 131, 0, 0, 139,
+	144, 203, 205, 206, 0, 0, 195, 196, 203, 387,
+	388, 274, 149, 191, 296, 301, 303, 297, 0, 299,
+	300, 0, 0, 0, 149, 123, 203, 203, 309, 286,
+	123, 203, 203, 317, 203, 383, 384, 0, 0, 370,
+	238, 0, 0, 0, 330, 0, 324, 356, 0, 0,
+	330, 326, 0, 334, 335, 0, 0, 0, 0, 0,
+	0, 416, 0, 428, 423, 104, 159, 160, 0, 162,
+	163, 359, 0, 0, 203, 64, 0, 143, 132, 0,
+	135, 0, 191, 229, 0, 200, 193, 386, 191, 203,
+	0, 0, 0, 149, 149, 123, 203, 307, 308, 203,
+	315, 316, 382, 0, 0, 0, 240, 241, 360, 0,
+	329, 355, 0, 0, 360, 0, 0, 398, 399, 404,
+	0, 0, 0, 0, 77, 0, 379, 142, 0, 0,
+	0, 0, 0, 203, 204, 203, 295, 302, 298, 149,
+	123, 123, 203, 306, 314, 444, 443, 243, 321, 331,
+	332, 353, 357, 354, 336, 0, 397, 0, 0, 0,
+	427, 424, 0, 62, 0, 133, 0, 136, 0, 142,
+	294, 123, 203, 203, 313, 242, 244, 0, 338, 337,
+	0, 356, 400, 405, 0, 414, 380, 141, 134, 137,
+	63, 203, 311, 312, 245, 358, 340, 339, 0, 361,
+	327, 0, 0, 310, 342, 341, 368, 362, 0, 415,
+	322, 0, 365, 364, 0, 0, 343, 368, 0, 0,
+	363, 366, 367, 413,
 }
 
 var yyTok1 = [...]int8{
@@ -997,7 +1033,8 @@ var yyTok2 = [...]uint8{
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
 	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
-	152, 153, 154, 155, 156, 157, 158, 159,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163,
 }
 
 var yyTok3 = [...]int8{
@@ -1343,19 +1380,19 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:188
+//line sql.y:196
 		{
 			setParseTree(yylex, yyDollar[1].stmts)
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:194
+//line sql.y:202
 		{
 			yyVAL.stmts = []Statement{yyDollar[1].stmt}
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:198
+//line sql.y:206
 		{
 			if len(yyDollar[1].stmts) >= 1 {
 				yyVAL.stmts = yyDollar[1].stmts
@@ -1365,337 +1402,355 @@ yydefault:
 		}
 	case 4:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:206
+//line sql.y:214
 		{
 			yyVAL.stmts = append(yyDollar[1].stmts, yyDollar[3].stmt)
 		}
 	case 5:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:214
+//line sql.y:222
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 6:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:218
+//line sql.y:226
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:222
+//line sql.y:230
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:226
+//line sql.y:234
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 9:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:230
+//line sql.y:238
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:234
+//line sql.y:242
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:238
+//line sql.y:246
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:242
+//line sql.y:250
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:246
+//line sql.y:254
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:250
+//line sql.y:258
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:254
+//line sql.y:262
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:258
+//line sql.y:266
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:262
+//line sql.y:270
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:266
+//line sql.y:274
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:270
+//line sql.y:278
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:274
+//line sql.y:282
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:278
+//line sql.y:286
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:282
+//line sql.y:290
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:286
+//line sql.y:294
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:290
+//line sql.y:298
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:294
+//line sql.y:302
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:298
+//line sql.y:306
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:302
+//line sql.y:310
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:306
+//line sql.y:314
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:310
+//line sql.y:318
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:314
+//line sql.y:322
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:318
+//line sql.y:326
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:322
+//line sql.y:330
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:326
+//line sql.y:334
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:330
+//line sql.y:338
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:334
+//line sql.y:342
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:338
+//line sql.y:346
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:342
+//line sql.y:350
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:346
+//line sql.y:354
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:350
+//line sql.y:358
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:354
+//line sql.y:362
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:358
+//line sql.y:366
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:362
+//line sql.y:370
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:366
+//line sql.y:374
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:370
+//line sql.y:378
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:374
+//line sql.y:382
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:378
+//line sql.y:386
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:382
+//line sql.y:390
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:386
+//line sql.y:394
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:390
+//line sql.y:398
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:394
+//line sql.y:402
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:398
+//line sql.y:406
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:402
+//line sql.y:410
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:406
+//line sql.y:414
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:410
+//line sql.y:418
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:414
+//line sql.y:422
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:418
+//line sql.y:426
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:422
+//line sql.y:430
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:426
+//line sql.y:434
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 59:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:438
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 60:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:442
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 61:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:446
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 62:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:432
+//line sql.y:452
 		{
 			stmt := &SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
@@ -1736,9 +1791,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 60:
+	case 63:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:473
+//line sql.y:493
 		{
 			stmt := &SelectStatement{}
 			stmt.Hints = yyDollar[2].hints
@@ -1780,9 +1835,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 61:
+	case 64:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:515
+//line sql.y:535
 		{
 			stmt := &SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
@@ -1810,147 +1865,147 @@ yydefault:
 			stmt.Location = yyDollar[9].location
 			yyVAL.stmt = stmt
 		}
-	case 62:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:546
+//line sql.y:566
 		{
 			yyVAL.fields = []*Field{yyDollar[1].field}
 		}
-	case 63:
+	case 66:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:550
+//line sql.y:570
 		{
 			yyVAL.fields = append([]*Field{yyDollar[1].field}, yyDollar[3].fields...)
 		}
-	case 64:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:556
+//line sql.y:576
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 65:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:560
+//line sql.y:580
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: TAG}}
 		}
-	case 66:
+	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:564
+//line sql.y:584
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: FIELD}}
 		}
-	case 67:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:568
+//line sql.y:588
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr}
 		}
-	case 68:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:572
+//line sql.y:592
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
-	case 69:
+	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:576
+//line sql.y:596
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
-	case 70:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:582
+//line sql.y:602
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 71:
+	case 74:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:586
+//line sql.y:606
 		{
 			c := yyDollar[1].expr.(*CaseWhenExpr)
 			c.Conditions = append(c.Conditions, yyDollar[2].expr.(*CaseWhenExpr).Conditions...)
 			c.Assigners = append(c.Assigners, yyDollar[2].expr.(*CaseWhenExpr).Assigners...)
 			yyVAL.expr = c
 		}
-	case 72:
+	case 75:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:595
+//line sql.y:615
 		{
 			c := &CaseWhenExpr{}
 			c.Conditions = []Expr{yyDollar[2].expr}
 			c.Assigners = []Expr{yyDollar[4].expr}
 			yyVAL.expr = c
 		}
-	case 73:
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:604
+//line sql.y:624
 		{
 			yyVAL.fields = []*Field{&Field{Expr: &VarRef{Val: yyDollar[1].str}}}
 		}
-	case 74:
+	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:608
+//line sql.y:628
 		{
 			yyVAL.fields = append([]*Field{&Field{Expr: &VarRef{Val: yyDollar[1].str}}}, yyDollar[3].fields...)
 		}
-	case 75:
+	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:614
+//line sql.y:634
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(MUL), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 76:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:618
+//line sql.y:638
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(DIV), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 77:
+	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:622
+//line sql.y:642
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(ADD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 78:
+	case 81:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:626
+//line sql.y:646
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(SUB), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 79:
+	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:630
+//line sql.y:650
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_XOR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 80:
+	case 83:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:634
+//line sql.y:654
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(MOD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 81:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:638
+//line sql.y:658
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_AND), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 82:
+	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:642
+//line sql.y:662
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_OR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 83:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:646
+//line sql.y:666
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 84:
+	case 87:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:650
+//line sql.y:670
 		{
 			if strings.ToLower(yyDollar[1].str) == "cast" {
 				if len(yyDollar[3].fields) != 1 {
@@ -1981,16 +2036,16 @@ yydefault:
 				yyVAL.expr = cols
 			}
 		}
-	case 85:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:681
+//line sql.y:701
 		{
 			cols := &Call{Name: strings.ToLower(yyDollar[1].str)}
 			yyVAL.expr = cols
 		}
-	case 86:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:686
+//line sql.y:706
 		{
 			switch s := yyDollar[2].expr.(type) {
 			case *NumberLiteral:
@@ -2004,98 +2059,98 @@ yydefault:
 			}
 
 		}
-	case 87:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:700
+//line sql.y:720
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 88:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:704
+//line sql.y:724
 		{
 			yyVAL.expr = &DurationLiteral{Val: yyDollar[1].tdur}
 		}
-	case 89:
+	case 92:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:708
+//line sql.y:728
 		{
 			c := yyDollar[2].expr.(*CaseWhenExpr)
 			c.Assigners = append(c.Assigners, yyDollar[4].expr)
 			yyVAL.expr = c
 		}
-	case 90:
+	case 93:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:714
+//line sql.y:734
 		{
 			yyVAL.expr = &VarRef{}
 		}
-	case 91:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:720
+//line sql.y:740
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 92:
+	case 95:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:724
+//line sql.y:744
 		{
 			yyVAL.sources = nil
 		}
-	case 93:
+	case 96:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:730
+//line sql.y:750
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 94:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:736
+//line sql.y:756
 		{
 			yyVAL.sources = []Source{yyDollar[1].ment}
 		}
-	case 95:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:740
+//line sql.y:760
 		{
 			yyVAL.sources = append([]Source{yyDollar[1].ment}, yyDollar[3].sources...)
 		}
-	case 96:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:744
+//line sql.y:764
 		{
 			yyVAL.sources = yyDollar[1].sources
 
 		}
-	case 97:
+	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:749
+//line sql.y:769
 		{
 			yyVAL.sources = append(yyDollar[1].sources, yyDollar[3].sources...)
 		}
-	case 98:
+	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:753
+//line sql.y:773
 		{
 			yyDollar[1].ment.Alias = yyDollar[3].str
 			yyVAL.sources = []Source{yyDollar[1].ment}
 		}
-	case 99:
+	case 102:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:758
+//line sql.y:778
 		{
 			yyDollar[1].ment.Alias = yyDollar[3].str
 			yyVAL.sources = append([]Source{yyDollar[1].ment}, yyDollar[5].sources...)
 		}
-	case 100:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:763
+//line sql.y:783
 		{
 			yyVAL.sources = []Source{yyDollar[1].source}
 		}
-	case 101:
+	case 104:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:769
+//line sql.y:789
 		{
 			join := &Join{}
 			if len(yyDollar[1].sources) != 1 || len(yyDollar[4].sources) != 1 {
@@ -2104,26 +2159,62 @@ yydefault:
 			join.LSrc = yyDollar[1].sources[0]
 			join.RSrc = yyDollar[4].sources[0]
 			join.Condition = yyDollar[6].expr
+			join.JoinType = JoinType(yyDollar[2].int)
 			yyVAL.source = join
 		}
-	case 102:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:782
+	case 105:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:803
 		{
-			all_subquerys := []Source{}
-			for _, temp_stmt := range yyDollar[2].stmts {
-				stmt, ok := temp_stmt.(*SelectStatement)
-				if !ok {
-					yylex.Error("expexted SelectStatement")
-				}
-				build_SubQuery := &SubQuery{Statement: stmt}
-				all_subquerys = append(all_subquerys, build_SubQuery)
-			}
-			yyVAL.sources = all_subquerys
+			yyVAL.int = int(FullJoin)
 		}
-	case 103:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:795
+	case 106:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:807
+		{
+			yyVAL.int = int(FullJoin)
+		}
+	case 107:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:811
+		{
+			yyVAL.int = int(InnerJoin)
+		}
+	case 108:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:815
+		{
+			joinType, _ := joinTypeOf(yyDollar[1].str)
+			yyVAL.int = int(joinType)
+		}
+	case 109:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:820
+		{
+			joinType, _ := joinTypeOf(yyDollar[1].str)
+			if joinType == InnerJoin {
+				yylex.Error("unsupported join type: " + yyDollar[1].str + " outer")
+			}
+			yyVAL.int = int(joinType)
+		}
+	case 110:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:830
+		{
+			all_subquerys := []Source{}
+			for _, temp_stmt := range yyDollar[2].stmts {
+				stmt, ok := temp_stmt.(*SelectStatement)
+				if !ok {
+					yylex.Error("expexted SelectStatement")
+				}
+				build_SubQuery := &SubQuery{Statement: stmt}
+				all_subquerys = append(all_subquerys, build_SubQuery)
+			}
+			yyVAL.sources = all_subquerys
+		}
+	case 111:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:843
 		{
 			if len(yyDollar[2].stmts) != 1 {
 				yylex.Error("expexted SelectStatement length")
@@ -2140,72 +2231,72 @@ yydefault:
 			all_subquerys = append(all_subquerys, build_SubQuery)
 			yyVAL.sources = all_subquerys
 		}
-	case 104:
+	case 112:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:812
+//line sql.y:860
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 105:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:818
+//line sql.y:866
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 106:
+	case 114:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:824
+//line sql.y:872
 		{
 			mst := yyDollar[5].ment
 			mst.Database = yyDollar[1].str
 			mst.RetentionPolicy = yyDollar[3].str
 			yyVAL.ment = mst
 		}
-	case 107:
+	case 115:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:831
+//line sql.y:879
 		{
 			mst := yyDollar[4].ment
 			mst.RetentionPolicy = yyDollar[2].str
 			yyVAL.ment = mst
 		}
-	case 108:
+	case 116:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:837
+//line sql.y:885
 		{
 			mst := yyDollar[4].ment
 			mst.Database = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 109:
+	case 117:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:843
+//line sql.y:891
 		{
 			mst := yyDollar[3].ment
 			mst.RetentionPolicy = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 110:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:849
+//line sql.y:897
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 111:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:855
+//line sql.y:903
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[1].str}
 		}
-	case 112:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:859
+//line sql.y:907
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[1].str}
 		}
-	case 113:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:863
+//line sql.y:911
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2214,69 +2305,69 @@ yydefault:
 
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 114:
+	case 122:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:874
+//line sql.y:922
 		{
 			yyVAL.dimens = yyDollar[3].dimens
 		}
-	case 115:
+	case 123:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:878
+//line sql.y:926
 		{
 			yyVAL.dimens = nil
 		}
-	case 116:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:884
+//line sql.y:932
 		{
 			yyVAL.dimens = yyDollar[2].dimens
 		}
-	case 117:
+	case 125:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:888
+//line sql.y:936
 		{
 			yyVAL.dimens = nil
 		}
-	case 118:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:894
+//line sql.y:942
 		{
 			yyVAL.dimens = []*Dimension{yyDollar[1].dimen}
 		}
-	case 119:
+	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:898
+//line sql.y:946
 		{
 			yyVAL.dimens = append([]*Dimension{yyDollar[1].dimen}, yyDollar[3].dimens...)
 		}
-	case 120:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:904
+//line sql.y:952
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 121:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:908
+//line sql.y:956
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 122:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:914
+//line sql.y:962
 		{
 			yyVAL.dimen = &Dimension{Expr: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 123:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:918
+//line sql.y:966
 		{
 			yyVAL.dimen = &Dimension{Expr: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 124:
+	case 132:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:922
+//line sql.y:970
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2284,9 +2375,9 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}}}}
 		}
-	case 125:
+	case 133:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:930
+//line sql.y:978
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2294,9 +2385,9 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}, &DurationLiteral{Val: yyDollar[5].tdur}}}}
 		}
-	case 126:
+	case 134:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:938
+//line sql.y:986
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2304,21 +2395,51 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}, &DurationLiteral{Val: time.Duration(-yyDollar[6].tdur)}}}}
 		}
-	case 127:
+	case 135:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:994
+		{
+			if strings.ToLower(yyDollar[1].str) != "time" {
+				yylex.Error("Invalid group by combination for no-time tag and time duration")
+			}
+
+			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: time.Duration(yyDollar[3].int64) * AverageMonth, Months: yyDollar[3].int64}}}}
+		}
+	case 136:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1002
+		{
+			if strings.ToLower(yyDollar[1].str) != "time" {
+				yylex.Error("Invalid group by combination for no-time tag and time duration")
+			}
+
+			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: time.Duration(yyDollar[3].int64) * AverageMonth, Months: yyDollar[3].int64}, &DurationLiteral{Val: yyDollar[5].tdur}}}}
+		}
+	case 137:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1010
+		{
+			if strings.ToLower(yyDollar[1].str) != "time" {
+				yylex.Error("Invalid group by combination for no-time tag and time duration")
+			}
+
+			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: time.Duration(yyDollar[3].int64) * AverageMonth, Months: yyDollar[3].int64}, &DurationLiteral{Val: time.Duration(-yyDollar[6].tdur)}}}}
+		}
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:946
+//line sql.y:1018
 		{
 			yyVAL.dimen = &Dimension{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 128:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:950
+//line sql.y:1022
 		{
 			yyVAL.dimen = &Dimension{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 129:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:954
+//line sql.y:1026
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2326,9 +2447,9 @@ yydefault:
 			}
 			yyVAL.dimen = &Dimension{Expr: &RegexLiteral{Val: re}}
 		}
-	case 130:
+	case 141:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:965
+//line sql.y:1037
 		{
 			if strings.ToLower(yyDollar[1].str) != "tz" {
 				yylex.Error("Expect tz")
@@ -2339,93 +2460,93 @@ yydefault:
 			}
 			yyVAL.location = loc
 		}
-	case 131:
+	case 142:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:976
+//line sql.y:1048
 		{
 			yyVAL.location = nil
 		}
-	case 132:
+	case 143:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:982
+//line sql.y:1054
 		{
 			yyVAL.inter = yyDollar[3].inter
 		}
-	case 133:
+	case 144:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:986
+//line sql.y:1058
 		{
 			yyVAL.inter = "null"
 		}
-	case 134:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:992
+//line sql.y:1064
 		{
 			yyVAL.inter = yyDollar[1].str
 		}
-	case 135:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:996
+//line sql.y:1068
 		{
 			yyVAL.inter = yyDollar[1].int64
 		}
-	case 136:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1000
+//line sql.y:1072
 		{
 			yyVAL.inter = yyDollar[1].float64
 		}
-	case 137:
+	case 148:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1006
+//line sql.y:1078
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 138:
+	case 149:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1010
+//line sql.y:1082
 		{
 			yyVAL.expr = nil
 		}
-	case 139:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1016
+//line sql.y:1088
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 140:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1020
+//line sql.y:1092
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 141:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1026
+//line sql.y:1098
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 142:
+	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1030
+//line sql.y:1102
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 143:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1036
+//line sql.y:1108
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 144:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1040
+//line sql.y:1112
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 145:
+	case 156:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1044
+//line sql.y:1116
 		{
 			ident := &VarRef{Val: yyDollar[1].str}
 			var expr, e Expr
@@ -2439,39 +2560,39 @@ yydefault:
 			}
 			yyVAL.expr = e
 		}
-	case 146:
+	case 157:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1058
+//line sql.y:1130
 		{
 			yyVAL.expr = &InCondition{Stmt: yyDollar[4].stmt.(*SelectStatement), Column: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 147:
+	case 158:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1062
+//line sql.y:1134
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 148:
+	case 159:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1066
+//line sql.y:1138
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 149:
+	case 160:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1070
+//line sql.y:1142
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 150:
+	case 161:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1074
+//line sql.y:1146
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 151:
+	case 162:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1078
+//line sql.y:1150
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2479,9 +2600,9 @@ yydefault:
 				Op:  MATCH,
 			}
 		}
-	case 152:
+	case 163:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1086
+//line sql.y:1158
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2489,9 +2610,9 @@ yydefault:
 				Op:  MATCHPHRASE,
 			}
 		}
-	case 153:
+	case 164:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1096
+//line sql.y:1168
 		{
 			if yyDollar[2].int == NEQREGEX {
 				switch yyDollar[3].expr.(type) {
@@ -2502,123 +2623,123 @@ yydefault:
 			}
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 154:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1109
+//line sql.y:1181
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 155:
+	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1113
+//line sql.y:1185
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 156:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1119
+//line sql.y:1191
 		{
 			yyVAL.int = EQ
 		}
-	case 157:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1123
+//line sql.y:1195
 		{
 			yyVAL.int = NEQ
 		}
-	case 158:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1127
+//line sql.y:1199
 		{
 			yyVAL.int = LT
 		}
-	case 159:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1131
+//line sql.y:1203
 		{
 			yyVAL.int = LTE
 		}
-	case 160:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1135
+//line sql.y:1207
 		{
 			yyVAL.int = GT
 		}
-	case 161:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1139
+//line sql.y:1211
 		{
 			yyVAL.int = GTE
 		}
-	case 162:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1143
+//line sql.y:1215
 		{
 			yyVAL.int = EQREGEX
 		}
-	case 163:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1147
+//line sql.y:1219
 		{
 			yyVAL.int = NEQREGEX
 		}
-	case 164:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1151
+//line sql.y:1223
 		{
 			yyVAL.int = LIKE
 		}
-	case 165:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1157
+//line sql.y:1229
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 166:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1163
+//line sql.y:1235
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str}
 		}
-	case 167:
+	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1167
+//line sql.y:1239
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str, Type: yyDollar[3].dataType}
 		}
-	case 168:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1171
+//line sql.y:1243
 		{
 			yyVAL.expr = &NumberLiteral{Val: yyDollar[1].float64}
 		}
-	case 169:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1175
+//line sql.y:1247
 		{
 			yyVAL.expr = &IntegerLiteral{Val: yyDollar[1].int64}
 		}
-	case 170:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1179
+//line sql.y:1251
 		{
 			yyVAL.expr = &StringLiteral{Val: yyDollar[1].str}
 		}
-	case 171:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1183
+//line sql.y:1255
 		{
 			yyVAL.expr = &BooleanLiteral{Val: true}
 		}
-	case 172:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1187
+//line sql.y:1259
 		{
 			yyVAL.expr = &BooleanLiteral{Val: false}
 		}
-	case 173:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1191
+//line sql.y:1263
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2626,21 +2747,21 @@ yydefault:
 			}
 			yyVAL.expr = &RegexLiteral{Val: re}
 		}
-	case 174:
+	case 185:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1199
+//line sql.y:1271
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str + "." + yyDollar[3].str, Type: Tag}
 		}
-	case 175:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1203
+//line sql.y:1275
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 176:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1209
+//line sql.y:1281
 		{
 			switch strings.ToLower(yyDollar[1].str) {
 			case "float":
@@ -2661,75 +2782,75 @@ yydefault:
 				yylex.Error("wrong field dataType")
 			}
 		}
-	case 177:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1230
+//line sql.y:1302
 		{
 			yyVAL.dataType = Tag
 		}
-	case 178:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1234
+//line sql.y:1306
 		{
 			yyVAL.dataType = AnyField
 		}
-	case 179:
+	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1240
+//line sql.y:1312
 		{
 			yyVAL.sortfs = yyDollar[3].sortfs
 		}
-	case 180:
+	case 191:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1244
+//line sql.y:1316
 		{
 			yyVAL.sortfs = nil
 		}
-	case 181:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1250
+//line sql.y:1322
 		{
 			yyVAL.sortfs = []*SortField{yyDollar[1].sortf}
 		}
-	case 182:
+	case 193:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1254
+//line sql.y:1326
 		{
 			yyVAL.sortfs = append([]*SortField{yyDollar[1].sortf}, yyDollar[3].sortfs...)
 		}
-	case 183:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1260
+//line sql.y:1332
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 184:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1264
+//line sql.y:1336
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: false}
 		}
-	case 185:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1268
+//line sql.y:1340
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 186:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1274
+//line sql.y:1346
 		{
 			yyVAL.intSlice = append(yyDollar[1].intSlice, yyDollar[2].intSlice...)
 		}
-	case 187:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1280
+//line sql.y:1352
 		{
 			yyVAL.int64 = yyDollar[1].int64
 		}
-	case 188:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1285
+//line sql.y:1357
 		{
 			if n, ok := yyDollar[1].expr.(*IntegerLiteral); ok {
 				yyVAL.int64 = n.Val
@@ -2737,69 +2858,69 @@ yydefault:
 				yylex.Error("unsupported type, expect integer type")
 			}
 		}
-	case 189:
+	case 200:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1295
+//line sql.y:1367
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 190:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1299
+//line sql.y:1371
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 191:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1303
+//line sql.y:1375
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 192:
+	case 203:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1307
+//line sql.y:1379
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 193:
+	case 204:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1313
+//line sql.y:1385
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 194:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1317
+//line sql.y:1389
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 195:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1321
+//line sql.y:1393
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 196:
+	case 207:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1325
+//line sql.y:1397
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 197:
+	case 208:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1331
+//line sql.y:1403
 		{
 			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: false}
 		}
-	case 198:
+	case 209:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1335
+//line sql.y:1407
 		{
 			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: true}
 		}
-	case 199:
+	case 210:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1341
+//line sql.y:1413
 		{
 			sms := yyDollar[4].stmt
 
@@ -2807,9 +2928,9 @@ yydefault:
 			sms.(*CreateDatabaseStatement).DatabaseAttr = yyDollar[5].databasePolicy
 			yyVAL.stmt = sms
 		}
-	case 200:
+	case 211:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1349
+//line sql.y:1421
 		{
 			stmt := &CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = false
@@ -2817,54 +2938,54 @@ yydefault:
 			stmt.DatabaseAttr = yyDollar[4].databasePolicy
 			yyVAL.stmt = stmt
 		}
-	case 201:
+	case 212:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1359
+//line sql.y:1431
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[2].int64), EnableTagArray: false}
 		}
-	case 202:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1364
+//line sql.y:1436
 		{
 			yyVAL.databasePolicy = DatabasePolicy{EnableTagArray: yyDollar[1].bool}
 		}
-	case 203:
+	case 214:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1369
+//line sql.y:1441
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[2].int64), EnableTagArray: yyDollar[3].bool}
 		}
-	case 204:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1374
+//line sql.y:1446
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[3].int64), EnableTagArray: yyDollar[1].bool}
 		}
-	case 205:
+	case 216:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1378
+//line sql.y:1450
 		{
 			yyVAL.databasePolicy = DatabasePolicy{EnableTagArray: false}
 		}
-	case 206:
+	case 217:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1384
+//line sql.y:1456
 		{
 			if strings.ToLower(yyDollar[3].str) != "array" {
 				yylex.Error("unsupport type")
 			}
 			yyVAL.bool = true
 		}
-	case 207:
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1391
+//line sql.y:1463
 		{
 			yyVAL.bool = false
 		}
-	case 208:
+	case 219:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1398
+//line sql.y:1470
 		{
 			stmt := &CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = true
@@ -2903,15 +3024,15 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 209:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1441
+//line sql.y:1513
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 210:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1445
+//line sql.y:1517
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -2984,22 +3105,22 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 211:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1520
+//line sql.y:1592
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 212:
+	case 223:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1524
+//line sql.y:1596
 		{
 			duration := yyDollar[2].tdur
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyDuration: &duration}
 		}
-	case 213:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1529
+//line sql.y:1601
 		{
 			if yyDollar[2].int64 < 1 || yyDollar[2].int64%2 == 0 {
 				yylex.Error("REPLICATION must be an odd number")
@@ -3007,36 +3128,36 @@ yydefault:
 			replicaN := int(yyDollar[2].int64)
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, Replication: &replicaN}
 		}
-	case 214:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1537
+//line sql.y:1609
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyName: yyDollar[2].str}
 		}
-	case 215:
+	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1541
+//line sql.y:1613
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, ReplicaNum: uint32(yyDollar[2].int64)}
 		}
-	case 216:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1545
+//line sql.y:1617
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: true}
 		}
-	case 217:
+	case 228:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1549
+//line sql.y:1621
 		{
 			if len(yyDollar[2].strSlice) == 0 {
 				yylex.Error("ShardKey should not be nil")
 			}
 			yyVAL.durations = &Durations{ShardKey: yyDollar[2].strSlice, ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: false}
 		}
-	case 218:
+	case 229:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1560
+//line sql.y:1632
 		{
 			sms := &ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -3047,9 +3168,9 @@ yydefault:
 			sms.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 219:
+	case 230:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1571
+//line sql.y:1643
 		{
 			sms := &ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -3059,21 +3180,21 @@ yydefault:
 			sms.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 220:
+	case 231:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1584
+//line sql.y:1656
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[2].str}
 		}
-	case 221:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1588
+//line sql.y:1660
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[2].str}
 		}
-	case 222:
+	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1592
+//line sql.y:1664
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -3081,9 +3202,9 @@ yydefault:
 			}
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 223:
+	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1600
+//line sql.y:1672
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -3091,32 +3212,32 @@ yydefault:
 			}
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 224:
+	case 235:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1612
+//line sql.y:1684
 		{
 			yyVAL.stmt = &ShowRetentionPoliciesStatement{
 				Database: yyDollar[5].str,
 			}
 		}
-	case 225:
+	case 236:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1618
+//line sql.y:1690
 		{
 			yyVAL.stmt = &ShowRetentionPoliciesStatement{}
 		}
-	case 226:
+	case 237:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1625
+//line sql.y:1697
 		{
 			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 227:
+	case 238:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1632
+//line sql.y:1704
 		{
 			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
@@ -3124,18 +3245,18 @@ yydefault:
 			stmt.Default = true
 			yyVAL.stmt = stmt
 		}
-	case 228:
+	case 239:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1642
+//line sql.y:1714
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 229:
+	case 240:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1649
+//line sql.y:1721
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -3143,9 +3264,9 @@ yydefault:
 			stmt.Admin = true
 			yyVAL.stmt = stmt
 		}
-	case 230:
+	case 241:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1657
+//line sql.y:1729
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -3153,9 +3274,9 @@ yydefault:
 			stmt.Rwuser = true
 			yyVAL.stmt = stmt
 		}
-	case 231:
+	case 242:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1668
+//line sql.y:1740
 		{
 			stmt := &CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
@@ -3190,9 +3311,9 @@ yydefault:
 
 			yyVAL.stmt = stmt
 		}
-	case 232:
+	case 243:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1703
+//line sql.y:1775
 		{
 			stmt := &CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
@@ -3202,15 +3323,15 @@ yydefault:
 			stmt.Replication = int(yyDollar[4].int64)
 			yyVAL.stmt = stmt
 		}
-	case 233:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1716
+//line sql.y:1788
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 234:
+	case 245:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1720
+//line sql.y:1792
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -3245,33 +3366,33 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 235:
+	case 246:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1758
+//line sql.y:1830
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: yyDollar[3].tdur, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 236:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1762
+//line sql.y:1834
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: yyDollar[3].tdur, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 237:
+	case 248:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1766
+//line sql.y:1838
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: yyDollar[3].tdur, IndexGroupDuration: -1}
 		}
-	case 238:
+	case 249:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1770
+//line sql.y:1842
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: yyDollar[3].tdur}
 		}
-	case 239:
+	case 250:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1778
+//line sql.y:1850
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -3282,9 +3403,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 240:
+	case 251:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1789
+//line sql.y:1861
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -3294,57 +3415,57 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 241:
+	case 252:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1801
+//line sql.y:1873
 		{
 			yyVAL.stmt = &ShowUsersStatement{}
 		}
-	case 242:
+	case 253:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1807
+//line sql.y:1879
 		{
 			stmt := &DropDatabaseStatement{}
 			stmt.Name = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 243:
+	case 254:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1815
+//line sql.y:1887
 		{
 			stmt := &DropSeriesStatement{}
 			stmt.Sources = yyDollar[3].sources
 			stmt.Condition = yyDollar[4].expr
 			yyVAL.stmt = stmt
 		}
-	case 244:
+	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1822
+//line sql.y:1894
 		{
 			stmt := &DropSeriesStatement{}
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 245:
+	case 256:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1830
+//line sql.y:1902
 		{
 			stmt := &DeleteSeriesStatement{}
 			stmt.Sources = yyDollar[2].sources
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 246:
+	case 257:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1837
+//line sql.y:1909
 		{
 			stmt := &DeleteSeriesStatement{}
 			stmt.Condition = yyDollar[2].expr
 			yyVAL.stmt = stmt
 		}
-	case 247:
+	case 258:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1846
+//line sql.y:1918
 		{
 			stmt := &AlterRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
@@ -3378,122 +3499,120 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 248:
+	case 259:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1884
+//line sql.y:1956
 		{
 			stmt := &DropRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 249:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1893
+	case 260:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1965
 		{
 			stmt := &GrantStatement{}
-			stmt.Privilege = AllPrivileges
+			stmt.Privilege = Privilege(yyDollar[2].int)
 			stmt.On = yyDollar[4].str
-			stmt.User = yyDollar[6].str
+			stmt.Measurement = yyDollar[5].privScope.measurement
+			stmt.Condition = yyDollar[5].privScope.condition
+			stmt.User = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 250:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1901
+	case 261:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1977
 		{
-			stmt := &GrantStatement{}
-			stmt.Privilege = AllPrivileges
-			stmt.On = yyDollar[5].str
-			stmt.User = yyDollar[7].str
-			yyVAL.stmt = stmt
+			yyVAL.int = int(AllPrivileges)
 		}
-	case 251:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1909
+	case 262:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1981
 		{
-			stmt := &GrantStatement{}
-			switch strings.ToLower(yyDollar[2].str) {
+			yyVAL.int = int(AllPrivileges)
+		}
+	case 263:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1985
+		{
+			switch strings.ToLower(yyDollar[1].str) {
 			case "read":
-				stmt.Privilege = ReadPrivilege
+				yyVAL.int = int(ReadPrivilege)
 			case "write":
-				stmt.Privilege = WritePrivilege
+				yyVAL.int = int(WritePrivilege)
 			default:
 				yylex.Error("wrong Privilege")
 			}
-			stmt.On = yyDollar[4].str
-			stmt.User = yyDollar[6].str
-			yyVAL.stmt = stmt
 		}
-	case 252:
+	case 264:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1998
+		{
+			yyVAL.privScope = &privilegeScope{measurement: yyDollar[2].str, condition: yyDollar[3].expr}
+		}
+	case 265:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2002
+		{
+			yyVAL.privScope = &privilegeScope{condition: yyDollar[1].expr}
+		}
+	case 266:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2008
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 267:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2012
+		{
+			yyVAL.str = ""
+		}
+	case 268:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1926
+//line sql.y:2018
 		{
 			yyVAL.stmt = &GrantAdminStatement{User: yyDollar[5].str}
 		}
-	case 253:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1930
+//line sql.y:2022
 		{
 			yyVAL.stmt = &GrantAdminStatement{User: yyDollar[4].str}
 		}
-	case 254:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1936
-		{
-			stmt := &RevokeStatement{}
-			stmt.Privilege = AllPrivileges
-			stmt.On = yyDollar[4].str
-			stmt.User = yyDollar[6].str
-			yyVAL.stmt = stmt
-		}
-	case 255:
+	case 270:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1944
-		{
-			stmt := &RevokeStatement{}
-			stmt.Privilege = AllPrivileges
-			stmt.On = yyDollar[5].str
-			stmt.User = yyDollar[7].str
-			yyVAL.stmt = stmt
-		}
-	case 256:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1952
+//line sql.y:2028
 		{
 			stmt := &RevokeStatement{}
-			switch strings.ToLower(yyDollar[2].str) {
-			case "read":
-				stmt.Privilege = ReadPrivilege
-			case "write":
-				stmt.Privilege = WritePrivilege
-			default:
-				yylex.Error("wrong Privilege")
-			}
+			stmt.Privilege = Privilege(yyDollar[2].int)
 			stmt.On = yyDollar[4].str
-			stmt.User = yyDollar[6].str
+			stmt.Measurement = yyDollar[5].str
+			stmt.User = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 257:
+	case 271:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1969
+//line sql.y:2039
 		{
 			yyVAL.stmt = &RevokeAdminStatement{User: yyDollar[5].str}
 		}
-	case 258:
+	case 272:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1973
+//line sql.y:2043
 		{
 			yyVAL.stmt = &RevokeAdminStatement{User: yyDollar[4].str}
 		}
-	case 259:
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1979
+//line sql.y:2049
 		{
 			yyVAL.stmt = &DropUserStatement{Name: yyDollar[3].str}
 		}
-	case 260:
+	case 274:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1985
+//line sql.y:2055
 		{
 			stmt := &ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3507,9 +3626,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 261:
+	case 275:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1999
+//line sql.y:2069
 		{
 			stmt := &ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3521,72 +3640,72 @@ yydefault:
 			stmt.SOffset = yyDollar[7].intSlice[3]
 			yyVAL.stmt = stmt
 		}
-	case 262:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2013
+//line sql.y:2083
 		{
 			yyVAL.str = "PRIMARYKEY"
 		}
-	case 263:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2017
+//line sql.y:2087
 		{
 			yyVAL.str = "SORTKEY"
 		}
-	case 264:
+	case 278:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2021
+//line sql.y:2091
 		{
 			yyVAL.str = "PROPERTY"
 		}
-	case 265:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2025
+//line sql.y:2095
 		{
 			yyVAL.str = "SHARDKEY"
 		}
-	case 266:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2029
+//line sql.y:2099
 		{
 			yyVAL.str = "ENGINETYPE"
 		}
-	case 267:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2033
+//line sql.y:2103
 		{
 			yyVAL.str = "SCHEMA"
 		}
-	case 268:
+	case 282:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2037
+//line sql.y:2107
 		{
 			yyVAL.str = "INDEXES"
 		}
-	case 269:
+	case 283:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2041
+//line sql.y:2111
 		{
 			yyVAL.str = "COMPACT"
 		}
-	case 270:
+	case 284:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2045
+//line sql.y:2115
 		{
 			yylex.Error("SHOW command error, only support PRIMARYKEY, SORTKEY, SHARDKEY, ENGINETYPE, INDEXES, SCHEMA, COMPACT")
 		}
-	case 271:
+	case 285:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2051
+//line sql.y:2121
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
 			stmt.Measurement = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 272:
+	case 286:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2058
+//line sql.y:2128
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3595,9 +3714,9 @@ yydefault:
 			stmt.Measurement = yyDollar[8].str
 			yyVAL.stmt = stmt
 		}
-	case 273:
+	case 287:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2067
+//line sql.y:2137
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3605,9 +3724,9 @@ yydefault:
 			stmt.Measurement = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 274:
+	case 288:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2075
+//line sql.y:2145
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3615,30 +3734,30 @@ yydefault:
 			stmt.Measurement = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 275:
+	case 289:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2083
+//line sql.y:2153
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
 			stmt.Measurement = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 276:
+	case 290:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2092
+//line sql.y:2162
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 277:
+	case 291:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2096
+//line sql.y:2166
 		{
 			yyVAL.str = ""
 		}
-	case 278:
+	case 292:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2102
+//line sql.y:2172
 		{
 			stmt := &ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3648,9 +3767,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 279:
+	case 293:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2112
+//line sql.y:2182
 		{
 			stmt := &ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3659,9 +3778,9 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 280:
+	case 294:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2124
+//line sql.y:2194
 		{
 			stmt := yyDollar[8].stmt.(*ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -3674,9 +3793,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 281:
+	case 295:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2137
+//line sql.y:2207
 		{
 			stmt := yyDollar[7].stmt.(*ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -3687,36 +3806,36 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 282:
+	case 296:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2150
+//line sql.y:2220
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = EQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 283:
+	case 297:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2157
+//line sql.y:2227
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = NEQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 284:
+	case 298:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2164
+//line sql.y:2234
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = IN
 			stmt.TagKeyExpr = yyDollar[3].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 285:
+	case 299:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2171
+//line sql.y:2241
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = EQREGEX
@@ -3727,9 +3846,9 @@ yydefault:
 			stmt.TagKeyExpr = &RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 286:
+	case 300:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2182
+//line sql.y:2252
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = NEQREGEX
@@ -3740,47 +3859,47 @@ yydefault:
 			stmt.TagKeyExpr = &RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 287:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2196
+//line sql.y:2266
 		{
 			temp := []string{yyDollar[1].str}
 			yyVAL.expr = &ListLiteral{Vals: temp}
 		}
-	case 288:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2201
+//line sql.y:2271
 		{
 			yyDollar[3].expr.(*ListLiteral).Vals = append(yyDollar[3].expr.(*ListLiteral).Vals, yyDollar[1].str)
 			yyVAL.expr = yyDollar[3].expr
 		}
-	case 289:
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2208
+//line sql.y:2278
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 290:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2216
+//line sql.y:2286
 		{
 			stmt := &ExplainStatement{}
 			stmt.Statement = yyDollar[3].stmt.(*SelectStatement)
 			stmt.Analyze = true
 			yyVAL.stmt = stmt
 		}
-	case 291:
+	case 305:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2223
+//line sql.y:2293
 		{
 			stmt := &ExplainStatement{}
 			stmt.Statement = yyDollar[2].stmt.(*SelectStatement)
 			stmt.Analyze = false
 			yyVAL.stmt = stmt
 		}
-	case 292:
+	case 306:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2233
+//line sql.y:2303
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3792,9 +3911,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 293:
+	case 307:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2245
+//line sql.y:2315
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3805,9 +3924,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 294:
+	case 308:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2256
+//line sql.y:2326
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3819,9 +3938,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 295:
+	case 309:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2268
+//line sql.y:2338
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3832,9 +3951,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 296:
+	case 310:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line sql.y:2284
+//line sql.y:2354
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3851,9 +3970,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 297:
+	case 311:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2301
+//line sql.y:2371
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3868,9 +3987,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 298:
+	case 312:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2316
+//line sql.y:2386
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3887,9 +4006,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 299:
+	case 313:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2333
+//line sql.y:2403
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3904,9 +4023,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 300:
+	case 314:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2351
+//line sql.y:2421
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3918,9 +4037,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 301:
+	case 315:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2363
+//line sql.y:2433
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3931,9 +4050,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 302:
+	case 316:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2374
+//line sql.y:2444
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3945,9 +4064,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 303:
+	case 317:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2386
+//line sql.y:2456
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3958,9 +4077,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 304:
+	case 318:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2400
+//line sql.y:2470
 		{
 			stmt := &CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -3983,9 +4102,9 @@ yydefault:
 
 			yyVAL.stmt = stmt
 		}
-	case 305:
+	case 319:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2423
+//line sql.y:2493
 		{
 			stmt := &CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4074,18 +4193,18 @@ yydefault:
 			stmt.CompactType = yyDollar[5].cmOption.CompactType
 			yyVAL.stmt = stmt
 		}
-	case 306:
+	case 320:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2513
+//line sql.y:2583
 		{
 			option := &CreateMeasurementStatementOption{}
 			option.Type = "hash"
 			option.EngineType = "tsstore"
 			yyVAL.cmOption = option
 		}
-	case 307:
+	case 321:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2520
+//line sql.y:2590
 		{
 			option := &CreateMeasurementStatementOption{}
 			if yyDollar[3].indexType != nil {
@@ -4100,9 +4219,9 @@ yydefault:
 			option.EngineType = yyDollar[2].str
 			yyVAL.cmOption = option
 		}
-	case 308:
+	case 322:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2537
+//line sql.y:2607
 		{
 			option := &CreateMeasurementStatementOption{}
 			if yyDollar[3].indexType != nil {
@@ -4133,15 +4252,15 @@ yydefault:
 			option.CompactType = yyDollar[10].str
 			yyVAL.cmOption = option
 		}
-	case 309:
+	case 323:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2569
+//line sql.y:2639
 		{
 			yyVAL.indexType = nil
 		}
-	case 310:
+	case 324:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2573
+//line sql.y:2643
 		{
 			validIndexType := map[string]struct{}{}
 			validIndexType["text"] = struct{}{}
@@ -4157,15 +4276,15 @@ yydefault:
 				yyVAL.indexType = yyDollar[2].indexType
 			}
 		}
-	case 311:
+	case 325:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2590
+//line sql.y:2660
 		{
 			yyVAL.indexType = nil
 		}
-	case 312:
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2594
+//line sql.y:2664
 		{
 			validIndexType := map[string]struct{}{}
 			validIndexType["bloomfilter"] = struct{}{}
@@ -4182,9 +4301,9 @@ yydefault:
 				yyVAL.indexType = yyDollar[2].indexType
 			}
 		}
-	case 313:
+	case 327:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2611
+//line sql.y:2681
 		{
 			indexType := strings.ToLower(yyDollar[2].str)
 			if indexType != "timecluster" {
@@ -4212,104 +4331,104 @@ yydefault:
 				yyVAL.indexType = indextype
 			}
 		}
-	case 314:
+	case 328:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2640
+//line sql.y:2710
 		{
 			yyVAL.strSlice = nil
 		}
-	case 315:
+	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2644
+//line sql.y:2714
 		{
 			shardKey := yyDollar[2].strSlice
 			sort.Strings(shardKey)
 			yyVAL.strSlice = shardKey
 		}
-	case 316:
+	case 330:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2651
+//line sql.y:2721
 		{
 			yyVAL.int64 = 0
 		}
-	case 317:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2655
+//line sql.y:2725
 		{
 			yyVAL.int64 = -1
 		}
-	case 318:
+	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2659
+//line sql.y:2729
 		{
 			if yyDollar[2].int64 == 0 {
 				yylex.Error("syntax error: NUM OF SHARDS SHOULD LARGER THAN 0")
 			}
 			yyVAL.int64 = yyDollar[2].int64
 		}
-	case 319:
+	case 333:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2667
+//line sql.y:2737
 		{
 			yyVAL.str = "tsstore" // default engine type
 		}
-	case 320:
+	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2671
+//line sql.y:2741
 		{
 			yyVAL.str = "tsstore"
 		}
-	case 321:
+	case 335:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2677
+//line sql.y:2747
 		{
 			yyVAL.str = "columnstore"
 		}
-	case 322:
+	case 336:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2682
+//line sql.y:2752
 		{
 			yyVAL.strSlice = nil
 		}
-	case 323:
+	case 337:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2685
+//line sql.y:2755
 		{
 			yyVAL.strSlice = yyDollar[1].strSlice
 		}
-	case 324:
+	case 338:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2690
+//line sql.y:2760
 		{
 			yyVAL.strSlice = nil
 		}
-	case 325:
+	case 339:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2693
+//line sql.y:2763
 		{
 			yyVAL.strSlice = yyDollar[1].strSlice
 		}
-	case 326:
+	case 340:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2698
+//line sql.y:2768
 		{
 			yyVAL.strSlices = nil
 		}
-	case 327:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2701
+//line sql.y:2771
 		{
 			yyVAL.strSlices = yyDollar[1].strSlices
 		}
-	case 328:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2706
+//line sql.y:2776
 		{
 			yyVAL.str = "row"
 		}
-	case 329:
+	case 343:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2710
+//line sql.y:2780
 		{
 			compactionType := strings.ToLower(yyDollar[2].str)
 			if compactionType != "row" && compactionType != "block" {
@@ -4318,9 +4437,9 @@ yydefault:
 			}
 			yyVAL.str = compactionType
 		}
-	case 330:
+	case 344:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2721
+//line sql.y:2791
 		{
 			stmt := &CreateMeasurementStatement{
 				Tags:   make(map[string]int32),
@@ -4349,40 +4468,40 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 331:
+	case 345:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2750
+//line sql.y:2820
 		{
 			yyVAL.stmt = nil
 		}
-	case 332:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2756
+//line sql.y:2826
 		{
 			fields := []*fieldList{yyDollar[1].fieldOption}
 			yyVAL.fieldOptions = append(fields, yyDollar[2].fieldOptions...)
 		}
-	case 333:
+	case 347:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2762
+//line sql.y:2832
 		{
 			yyVAL.fieldOptions = []*fieldList{yyDollar[1].fieldOption}
 		}
-	case 334:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2768
+//line sql.y:2838
 		{
 			yyVAL.fieldOption = yyDollar[1].fieldOption
 		}
-	case 335:
+	case 349:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2773
+//line sql.y:2843
 		{
 			yyVAL.fieldOption = yyDollar[1].fieldOption
 		}
-	case 336:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2779
+//line sql.y:2849
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4390,9 +4509,9 @@ yydefault:
 				tagOrField: "tag",
 			}
 		}
-	case 337:
+	case 351:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2788
+//line sql.y:2858
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4400,9 +4519,9 @@ yydefault:
 				tagOrField: "field",
 			}
 		}
-	case 338:
+	case 352:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2797
+//line sql.y:2867
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4410,27 +4529,27 @@ yydefault:
 				tagOrField: "field",
 			}
 		}
-	case 339:
+	case 353:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2807
+//line sql.y:2877
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{yyDollar[1].str},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 340:
+	case 354:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2815
+//line sql.y:2885
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{"field"},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 341:
+	case 355:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2824
+//line sql.y:2894
 		{
 			indextype := yyDollar[1].indexType
 			if yyDollar[2].indexType != nil {
@@ -4439,28 +4558,28 @@ yydefault:
 			}
 			yyVAL.indexType = indextype
 		}
-	case 342:
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2833
+//line sql.y:2903
 		{
 			yyVAL.indexType = nil
 		}
-	case 343:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2839
+//line sql.y:2909
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 344:
+	case 358:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2843
+//line sql.y:2913
 		{
 
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 345:
+	case 359:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2850
+//line sql.y:2920
 		{
 			shardType := strings.ToLower(yyDollar[2].str)
 			if shardType != "hash" && shardType != "range" {
@@ -4469,27 +4588,27 @@ yydefault:
 			}
 			yyVAL.str = shardType
 		}
-	case 346:
+	case 360:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2859
+//line sql.y:2929
 		{
 			yyVAL.str = "hash"
 		}
-	case 347:
+	case 361:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2865
+//line sql.y:2935
 		{
 			yyVAL.strSlice = yyDollar[2].strSlice
 		}
-	case 348:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2871
+//line sql.y:2941
 		{
 			yyVAL.strSlice = yyDollar[2].strSlice
 		}
-	case 349:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2877
+//line sql.y:2947
 		{
 			m := yyDollar[1].strSlices
 			if yyDollar[3].strSlices != nil {
@@ -4498,82 +4617,131 @@ yydefault:
 			}
 			yyVAL.strSlices = m
 		}
-	case 350:
+	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2887
+//line sql.y:2957
 		{
 			yyVAL.strSlices = yyDollar[1].strSlices
 		}
-	case 351:
+	case 365:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2893
+//line sql.y:2963
 		{
 			yyVAL.strSlices = yyDollar[2].strSlices
 		}
-	case 352:
+	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2899
+//line sql.y:2969
 		{
 			yyVAL.strSlices = [][]string{{yyDollar[1].str}, {yyDollar[3].str}}
 		}
-	case 353:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2903
+//line sql.y:2973
 		{
 			yyVAL.strSlices = [][]string{{yyDollar[1].str}, {fmt.Sprintf("%d", yyDollar[3].int64)}}
 		}
-	case 354:
+	case 368:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2907
+//line sql.y:2977
 		{
 			yyVAL.strSlices = nil
 		}
-	case 355:
+	case 369:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2913
+//line sql.y:2983
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 356:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2917
+//line sql.y:2987
 		{
 			yyVAL.strSlice = append(yyDollar[1].strSlice, yyDollar[3].str)
 		}
-	case 357:
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2922
+//line sql.y:2992
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 358:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2928
+//line sql.y:2998
 		{
 			stmt := &DropShardStatement{}
 			stmt.ID = uint64(yyDollar[3].int64)
 			yyVAL.stmt = stmt
 		}
-	case 359:
+	case 373:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2936
+//line sql.y:3006
 		{
 			stmt := &SetPasswordUserStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 360:
+	case 374:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3015
+		{
+			stmt := &SetQuotaStatement{}
+			stmt.OnUser = yyDollar[4].bool
+			stmt.Name = yyDollar[5].str
+			stmt.Options = yyDollar[7].quotaOptions
+			yyVAL.stmt = stmt
+		}
+	case 375:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3025
+		{
+			stmt := &DropQuotaStatement{}
+			stmt.OnUser = yyDollar[4].bool
+			stmt.Name = yyDollar[5].str
+			yyVAL.stmt = stmt
+		}
+	case 376:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3034
+		{
+			yyVAL.stmt = &ShowQuotasStatement{}
+		}
+	case 377:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3040
+		{
+			yyVAL.bool = true
+		}
+	case 378:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3044
+		{
+			yyVAL.bool = false
+		}
+	case 379:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3050
+		{
+			yyVAL.quotaOptions = []QuotaOption{{Name: yyDollar[1].str, Value: yyDollar[3].int64}}
+		}
+	case 380:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3054
+		{
+			yyVAL.quotaOptions = append(yyDollar[1].quotaOptions, QuotaOption{Name: yyDollar[3].str, Value: yyDollar[5].int64})
+		}
+	case 381:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2947
+//line sql.y:3061
 		{
 			stmt := &ShowGrantsForUserStatement{}
 			stmt.Name = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 361:
+	case 382:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2955
+//line sql.y:3069
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4585,9 +4753,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 362:
+	case 383:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2967
+//line sql.y:3081
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4598,9 +4766,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 363:
+	case 384:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2978
+//line sql.y:3092
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4612,9 +4780,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 364:
+	case 385:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2990
+//line sql.y:3104
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4625,9 +4793,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 365:
+	case 386:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3004
+//line sql.y:3118
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4639,9 +4807,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 366:
+	case 387:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3016
+//line sql.y:3130
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4652,9 +4820,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 367:
+	case 388:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3027
+//line sql.y:3141
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4666,9 +4834,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 368:
+	case 389:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3039
+//line sql.y:3153
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4679,23 +4847,23 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 369:
+	case 390:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3053
+//line sql.y:3167
 		{
 			stmt := &ShowShardsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 370:
+	case 391:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3058
+//line sql.y:3172
 		{
 			stmt := &ShowShardsStatement{mstInfo: yyDollar[4].ment}
 			yyVAL.stmt = stmt
 		}
-	case 371:
+	case 392:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3066
+//line sql.y:3180
 		{
 			stmt := &AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4706,9 +4874,9 @@ yydefault:
 			stmt.Type = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 372:
+	case 393:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3077
+//line sql.y:3191
 		{
 			stmt := &AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4717,34 +4885,34 @@ yydefault:
 			stmt.Type = "hash"
 			yyVAL.stmt = stmt
 		}
-	case 373:
+	case 394:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3091
+//line sql.y:3205
 		{
 			stmt := &ShowShardGroupsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 374:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3098
+//line sql.y:3212
 		{
 			stmt := &DropMeasurementStatement{}
 			stmt.Name = yyDollar[3].str
 			stmt.RpName = ""
 			yyVAL.stmt = stmt
 		}
-	case 375:
+	case 396:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3105
+//line sql.y:3219
 		{
 			stmt := &DropMeasurementStatement{}
 			stmt.Name = yyDollar[5].str
 			stmt.RpName = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 376:
+	case 397:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3115
+//line sql.y:3229
 		{
 			stmt := &CreateContinuousQueryStatement{
 				Name:     yyDollar[4].str,
@@ -4757,64 +4925,64 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 377:
+	case 398:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3130
+//line sql.y:3244
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleEvery: yyDollar[3].tdur,
 			}
 		}
-	case 378:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3136
+//line sql.y:3250
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleFor: yyDollar[3].tdur,
 			}
 		}
-	case 379:
+	case 400:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3142
+//line sql.y:3256
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleEvery: yyDollar[3].tdur,
 				ResampleFor:   yyDollar[5].tdur,
 			}
 		}
-	case 380:
+	case 401:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3149
+//line sql.y:3263
 		{
 			yyVAL.cqsp = nil
 		}
-	case 381:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3155
+//line sql.y:3269
 		{
 			yyVAL.stmt = &ShowContinuousQueriesStatement{}
 		}
-	case 382:
+	case 403:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3161
+//line sql.y:3275
 		{
 			yyVAL.stmt = &DropContinuousQueryStatement{
 				Name:     yyDollar[4].str,
 				Database: yyDollar[6].str,
 			}
 		}
-	case 383:
+	case 404:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3169
+//line sql.y:3283
 		{
 			stmt := yyDollar[9].stmt.(*CreateDownSampleStatement)
 			stmt.RpName = yyDollar[4].str
 			stmt.Ops = yyDollar[6].fields
 			yyVAL.stmt = stmt
 		}
-	case 384:
+	case 405:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:3176
+//line sql.y:3290
 		{
 			stmt := yyDollar[11].stmt.(*CreateDownSampleStatement)
 			stmt.RpName = yyDollar[6].str
@@ -4822,65 +4990,65 @@ yydefault:
 			stmt.Ops = yyDollar[8].fields
 			yyVAL.stmt = stmt
 		}
-	case 385:
+	case 406:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3184
+//line sql.y:3298
 		{
 			stmt := yyDollar[7].stmt.(*CreateDownSampleStatement)
 			stmt.Ops = yyDollar[4].fields
 			yyVAL.stmt = stmt
 		}
-	case 386:
+	case 407:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3192
+//line sql.y:3306
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				RpName: yyDollar[4].str,
 			}
 		}
-	case 387:
+	case 408:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3198
+//line sql.y:3312
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DbName: yyDollar[4].str,
 				RpName: yyDollar[6].str,
 			}
 		}
-	case 388:
+	case 409:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3205
+//line sql.y:3319
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DropAll: true,
 			}
 		}
-	case 389:
+	case 410:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3211
+//line sql.y:3325
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DbName:  yyDollar[4].str,
 				DropAll: true,
 			}
 		}
-	case 390:
+	case 411:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3220
+//line sql.y:3334
 		{
 			yyVAL.stmt = &ShowDownSampleStatement{}
 		}
-	case 391:
+	case 412:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3224
+//line sql.y:3338
 		{
 			yyVAL.stmt = &ShowDownSampleStatement{
 				DbName: yyDollar[4].str,
 			}
 		}
-	case 392:
+	case 413:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3232
+//line sql.y:3346
 		{
 			yyVAL.stmt = &CreateDownSampleStatement{
 				Duration:       yyDollar[2].tdur,
@@ -4888,21 +5056,21 @@ yydefault:
 				TimeInterval:   yyDollar[9].tdurs,
 			}
 		}
-	case 393:
+	case 414:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3242
+//line sql.y:3356
 		{
 			yyVAL.tdurs = []time.Duration{yyDollar[1].tdur}
 		}
-	case 394:
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3246
+//line sql.y:3360
 		{
 			yyVAL.tdurs = append([]time.Duration{yyDollar[1].tdur}, yyDollar[3].tdurs...)
 		}
-	case 395:
+	case 416:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3253
+//line sql.y:3367
 		{
 			stmt := &CreateStreamStatement{
 				Name:  yyDollar[3].str,
@@ -4924,9 +5092,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 396:
+	case 417:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3275
+//line sql.y:3389
 		{
 			stmt := &CreateStreamStatement{
 				Name:  yyDollar[3].str,
@@ -4947,112 +5115,112 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 397:
+	case 418:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3298
+//line sql.y:3412
 		{
 			yyVAL.stmt = &ShowStreamsStatement{}
 		}
-	case 398:
+	case 419:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3302
+//line sql.y:3416
 		{
 			yyVAL.stmt = &ShowStreamsStatement{Database: yyDollar[4].str}
 		}
-	case 399:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3308
+//line sql.y:3422
 		{
 			yyVAL.stmt = &DropStreamsStatement{Name: yyDollar[3].str}
 		}
-	case 400:
+	case 421:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3313
+//line sql.y:3427
 		{
 			yyVAL.stmt = &ShowQueriesStatement{}
 		}
-	case 401:
+	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3318
+//line sql.y:3432
 		{
 			yyVAL.stmt = &KillQueryStatement{QueryID: uint64(yyDollar[3].int64)}
 		}
-	case 402:
+	case 423:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3324
+//line sql.y:3438
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 403:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3328
+//line sql.y:3442
 		{
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 404:
+	case 425:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3334
+//line sql.y:3448
 		{
 			yyVAL.str = "ALL"
 		}
-	case 405:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3338
+//line sql.y:3452
 		{
 			yyVAL.str = "ANY"
 		}
-	case 406:
+	case 427:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3344
+//line sql.y:3458
 		{
 			yyVAL.stmt = &CreateSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str, Destinations: yyDollar[10].strSlice, Mode: yyDollar[9].str}
 		}
-	case 407:
+	case 428:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3348
+//line sql.y:3462
 		{
 			yyVAL.stmt = &CreateSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: "", Destinations: yyDollar[8].strSlice, Mode: yyDollar[7].str}
 		}
-	case 408:
+	case 429:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3354
+//line sql.y:3468
 		{
 			yyVAL.stmt = &ShowSubscriptionsStatement{}
 		}
-	case 409:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3360
+//line sql.y:3474
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: "", Database: "", RetentionPolicy: ""}
 		}
-	case 410:
+	case 431:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3364
+//line sql.y:3478
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: "", Database: yyDollar[5].str, RetentionPolicy: ""}
 		}
-	case 411:
+	case 432:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3368
+//line sql.y:3482
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str}
 		}
-	case 412:
+	case 433:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3372
+//line sql.y:3486
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: ""}
 		}
-	case 413:
+	case 434:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3378
+//line sql.y:3492
 		{
 			stmt := &ShowConfigsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 414:
+	case 435:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3385
+//line sql.y:3499
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5060,9 +5228,9 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 415:
+	case 436:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3393
+//line sql.y:3507
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5070,9 +5238,9 @@ yydefault:
 			stmt.Value = yyDollar[6].int64
 			yyVAL.stmt = stmt
 		}
-	case 416:
+	case 437:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3401
+//line sql.y:3515
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5080,9 +5248,9 @@ yydefault:
 			stmt.Value = yyDollar[6].float64
 			yyVAL.stmt = stmt
 		}
-	case 417:
+	case 438:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3409
+//line sql.y:3523
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5090,9 +5258,9 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 418:
+	case 439:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3417
+//line sql.y:3531
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5100,17 +5268,17 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 419:
+	case 440:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3427
+//line sql.y:3541
 		{
 			stmt := &ShowClusterStatement{}
 			stmt.NodeID = 0
 			yyVAL.stmt = stmt
 		}
-	case 420:
+	case 441:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3433
+//line sql.y:3547
 		{
 			stmt := &ShowClusterStatement{}
 			stmt.NodeID = 0
@@ -5121,9 +5289,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 421:
+	case 442:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3444
+//line sql.y:3558
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodeid" {
@@ -5133,9 +5301,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 422:
+	case 443:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3454
+//line sql.y:3568
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodeid" {
@@ -5150,9 +5318,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 423:
+	case 444:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3469
+//line sql.y:3583
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodetype" {
@@ -28,6 +28,14 @@ type YyParser struct {
 	Scanner *Scanner
 	error   YyParserError
 	Params  map[string]interface{}
+
+	// peeked is the token scanned ahead to tell a join type from an identifier
+	peeked *scannedToken
+}
+
+type scannedToken struct {
+	typ Token
+	val string
 }
 
 type YyParserError string
@@ -45,6 +53,7 @@ func (p *YyParser) ParseTokens() {
 }
 
 func (p *YyParser) SetScanner(s *Scanner) {
+	p.peeked = nil
 	p.Scanner = s
 }
 func (p *YyParser) GetQuery() (*Query, error) {
@@ -59,8 +68,16 @@ func (p *YyParser) Lex(lval *yySymType) int {
 	var val string
 
 	for {
-		typ, _, val = p.Scanner.Scan()
+		typ, val = p.scan()
 		switch typ {
+		case IDENT:
+			// INNER, LEFT and RIGHT are only join types if followed by JOIN or OUTER,
+			// otherwise they are identifiers
+			if _, ok := joinTypeOf(val); ok {
+				if next := p.peek(); next.typ == JOIN || next.typ == OUTER {
+					typ = JOINKIND
+				}
+			}
 		case ILLEGAL:
 			p.Error("unexpected " + string(val) + ", it's ILLEGAL")
 		case EOF:
@@ -78,6 +95,13 @@ func (p *YyParser) Lex(lval *yySymType) int {
 				lval.int64, _ = strconv.ParseInt(val, 10, 64)
 			}
 		case DURATIONVAL:
+			// Calendar months have their own token, the grammar accepts them only as the
+			// interval of GROUP BY time().
+			if months, ok := parseMonths(val); ok {
+				typ = MONTHVAL
+				lval.int64 = months
+				break
+			}
 			{
 				time, err := ParseDuration(val)
 				if err == nil {
@@ -155,6 +179,28 @@ func (p *YyParser) Lex(lval *yySymType) int {
 	lval.str = val
 	return int(typ)
 }
+func (p *YyParser) scan() (Token, string) {
+	if p.peeked != nil {
+		t := p.peeked
+		p.peeked = nil
+		return t.typ, t.val
+	}
+	typ, _, val := p.Scanner.Scan()
+	return typ, val
+}
+
+// peek returns the next token which is not a whitespace without consuming it.
+func (p *YyParser) peek() *scannedToken {
+	if p.peeked == nil {
+		typ, _, val := p.Scanner.Scan()
+		for typ == WS {
+			typ, _, val = p.Scanner.Scan()
+		}
+		p.peeked = &scannedToken{typ: typ, val: val}
+	}
+	return p.peeked
+}
+
 func (p *YyParser) Error(err string) {
 	p.error = YyParserError(err)
 }
@@ -189,6 +235,11 @@ type cqSamplePolicyInfo struct {
 	ResampleFor   time.Duration
 }
 
+type privilegeScope struct {
+	measurement string
+	condition   Expr
+}
+
 type fieldList struct {
 	fieldName  string
 	fieldType  string
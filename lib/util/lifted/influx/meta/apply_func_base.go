@@ -108,6 +108,16 @@ func ApplyDeleteShardGroup(data *Data, cmd *proto2.Command) error {
 	return data.DeleteShardGroup(v.GetDatabase(), v.GetPolicy(), v.GetShardGroupID(), v.GetDeletedAt(), v.GetDeleteType())
 }
 
+func ApplyDropShard(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_DropShardCommand_Command)
+	v, ok := ext.(*proto2.DropShardCommand)
+	if !ok {
+		DataLogger.Error("applyDropShard err")
+	}
+	data.DropShard(v.GetID())
+	return nil
+}
+
 func ApplyCreateSubscription(data *Data, cmd *proto2.Command) error {
 	ext, _ := proto.GetExtension(cmd, proto2.E_CreateSubscriptionCommand_Command)
 	v, ok := ext.(*proto2.CreateSubscriptionCommand)
@@ -157,6 +167,18 @@ func ApplyUpdateUser(data *Data, cmd *proto2.Command) error {
 	return data.UpdateUser(v.GetName(), v.GetHash())
 }
 
+func ApplySetQuota(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_SetQuotaCommand_Command)
+	v, ok := ext.(*proto2.SetQuotaCommand)
+	if !ok {
+		DataLogger.Error("applySetQuota err")
+	}
+	err := data.SetQuota(v.GetUser(), v.GetDatabase(), unmarshalQuota(v.GetQuota()))
+	DataLogger.Info("apply set quota command", zap.String("userID", v.GetUser()),
+		zap.String("db", v.GetDatabase()), zap.Error(err))
+	return err
+}
+
 func ApplySetPrivilege(data *Data, cmd *proto2.Command) error {
 	ext, _ := proto.GetExtension(cmd, proto2.E_SetPrivilegeCommand_Command)
 	v, ok := ext.(*proto2.SetPrivilegeCommand)
@@ -169,6 +191,20 @@ func ApplySetPrivilege(data *Data, cmd *proto2.Command) error {
 	return err
 }
 
+func ApplySetMeasurementPrivilege(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_SetMeasurementPrivilegeCommand_Command)
+	v, ok := ext.(*proto2.SetMeasurementPrivilegeCommand)
+	if !ok {
+		DataLogger.Error("applySetMeasurementPrivilege err")
+	}
+	err := data.SetMeasurementPrivilege(v.GetUsername(), v.GetDatabase(), v.GetMeasurement(),
+		originql.Privilege(v.GetPrivilege()), v.GetCondition())
+	DataLogger.Info("apply set measurement privilege command", zap.String("userID", v.GetUsername()),
+		zap.String("db", v.GetDatabase()), zap.String("mst", v.GetMeasurement()),
+		zap.Int32("privilege", v.GetPrivilege()), zap.Error(err))
+	return err
+}
+
 func ApplySetAdminPrivilege(data *Data, cmd *proto2.Command) error {
 	ext, _ := proto.GetExtension(cmd, proto2.E_SetAdminPrivilegeCommand_Command)
 	v, ok := ext.(*proto2.SetAdminPrivilegeCommand)
@@ -128,6 +128,11 @@ func (u *UserInfo) AuthorizeQuery(database string, query *influxql.Query) error
 				db = database
 			}
 			if !u.AuthorizeDatabase(originql.Privilege(p.Privilege), db) {
+				if _, ok := stmt.(*influxql.SelectStatement); ok && p.Privilege == influxql.ReadPrivilege &&
+					u.HasMeasurementPrivilege(originql.ReadPrivilege, db) {
+					// the measurements are checked by RestrictSelect once the statement is normalized
+					continue
+				}
 				return &ErrAuthorize{
 					Query:    query,
 					User:     u.Name,
@@ -148,6 +153,11 @@ type ErrAuthorize struct {
 	Message  string
 }
 
+// AuthorizationFailed marks the error as an authorization failure, see influxdb.IsAuthorizationError.
+func (e ErrAuthorize) AuthorizationFailed() bool {
+	return true
+}
+
 // Error returns the text of the error.
 func (e ErrAuthorize) Error() string {
 	if e.User == "" {
@@ -0,0 +1,172 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var ErrRestoreNotEmpty = errors.New("restore requires a cluster without databases")
+
+// KeepDatabases removes the databases which are not in dbs, as well as their pt views,
+// replica groups and the streams of them. It is used to back up a part of the metadata.
+func (data *Data) KeepDatabases(dbs map[string]struct{}) {
+	for name := range data.Databases {
+		if _, ok := dbs[name]; !ok {
+			delete(data.Databases, name)
+			delete(data.PtView, name)
+			delete(data.ReplicaGroups, name)
+		}
+	}
+	for name, si := range data.Streams {
+		if _, ok := dbs[si.SrcMst.Database]; !ok {
+			delete(data.Streams, name)
+			continue
+		}
+		if _, ok := dbs[si.DesMst.Database]; !ok {
+			delete(data.Streams, name)
+		}
+	}
+}
+
+// Restore restores the databases, users and streams of the backup into the metadata of a
+// cluster without databases. The shard, index and pt ids are kept, so that the backup files of
+// the shards can be restored to the same paths. The pt owners are mapped from the data nodes of
+// the backup to the data nodes of the cluster in the order of ids, and the pts are offline until
+// they are assigned to the owners.
+func (data *Data) Restore(backup *Data) error {
+	// the databases marked as deleted are not dropped by the stores yet
+	if len(data.Databases) > 0 {
+		return ErrRestoreNotEmpty
+	}
+
+	nodeMap, err := data.restoreNodeMap(backup)
+	if err != nil {
+		return err
+	}
+
+	data.Databases = make(map[string]*DatabaseInfo, len(backup.Databases))
+	if data.PtView == nil {
+		data.PtView = make(map[string]DBPtInfos, len(backup.PtView))
+	}
+	for name, dbi := range backup.Databases {
+		if dbi.MarkDeleted {
+			continue
+		}
+		data.Databases[name] = dbi.clone()
+
+		view := make(DBPtInfos, len(backup.PtView[name]))
+		for i, pi := range backup.PtView[name] {
+			view[i] = pi
+			view[i].Owner.NodeID = nodeMap[pi.Owner.NodeID]
+			view[i].Status = Offline
+		}
+		data.PtView[name] = view
+
+		if rgs, ok := backup.ReplicaGroups[name]; ok {
+			if data.ReplicaGroups == nil {
+				data.ReplicaGroups = make(map[string][]ReplicaGroup)
+			}
+			data.ReplicaGroups[name] = append([]ReplicaGroup(nil), rgs...)
+		}
+	}
+
+	data.Streams = backup.CloneStreams()
+	data.restoreUsers(backup.Users)
+
+	data.MaxShardGroupID = maxUint64(data.MaxShardGroupID, backup.MaxShardGroupID)
+	data.MaxShardID = maxUint64(data.MaxShardID, backup.MaxShardID)
+	data.MaxMstID = maxUint64(data.MaxMstID, backup.MaxMstID)
+	data.MaxIndexGroupID = maxUint64(data.MaxIndexGroupID, backup.MaxIndexGroupID)
+	data.MaxIndexID = maxUint64(data.MaxIndexID, backup.MaxIndexID)
+	data.MaxDownSampleID = maxUint64(data.MaxDownSampleID, backup.MaxDownSampleID)
+	data.MaxStreamID = maxUint64(data.MaxStreamID, backup.MaxStreamID)
+	// the restored databases carry their subscriptions and continuous queries, the ids are the change
+	// counters watched by the subscriber managers and the continuous query services, which only reload
+	// the subscriptions and continuous queries when the counters change.
+	data.MaxSubscriptionID++
+	data.MaxCQChangeID++
+	return nil
+}
+
+// restoreNodeMap maps the ids of the data nodes which own the pts of the backup to the ids of the data nodes
+func (data *Data) restoreNodeMap(backup *Data) (map[uint64]uint64, error) {
+	owners := make(map[uint64]struct{})
+	for name, view := range backup.PtView {
+		if dbi, ok := backup.Databases[name]; !ok || dbi.MarkDeleted {
+			continue
+		}
+		for _, pi := range view {
+			owners[pi.Owner.NodeID] = struct{}{}
+		}
+	}
+	backupNodes := make([]uint64, 0, len(backup.DataNodes))
+	for _, n := range backup.DataNodes {
+		backupNodes = append(backupNodes, n.ID)
+	}
+	for id := range owners {
+		if backup.DataNode(id) == nil {
+			backupNodes = append(backupNodes, id)
+		}
+	}
+	nodes := make([]uint64, 0, len(data.DataNodes))
+	for _, n := range data.DataNodes {
+		nodes = append(nodes, n.ID)
+	}
+	sort.Slice(backupNodes, func(i, j int) bool { return backupNodes[i] < backupNodes[j] })
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+
+	nodeMap := make(map[uint64]uint64, len(backupNodes))
+	for i, id := range backupNodes {
+		if i >= len(nodes) {
+			if _, ok := owners[id]; ok {
+				return nil, fmt.Errorf("restore requires %d data nodes, but the cluster has %d", len(backupNodes), len(nodes))
+			}
+			continue
+		}
+		nodeMap[id] = nodes[i]
+	}
+	return nodeMap, nil
+}
+
+// restoreUsers replaces the users with the same names by the users of the backup
+func (data *Data) restoreUsers(users []UserInfo) {
+	for i := range users {
+		restored := users[i].clone()
+		found := false
+		for j := range data.Users {
+			if data.Users[j].Name == restored.Name {
+				data.Users[j] = restored
+				found = true
+				break
+			}
+		}
+		if !found {
+			data.Users = append(data.Users, restored)
+		}
+	}
+	data.AdminUserExists = data.HasAdminUser()
+}
+
+func maxUint64(a, b uint64) uint64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/util"
+
+	assert2 "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func initBackupData(t *testing.T) *Data {
+	data := initData()
+	data.SetClusterPtNum(2)
+	require.NoError(t, generateMeasurement(data, "db0", "rp0", "cpu"))
+	require.NoError(t, data.CreateShardGroup("db0", "rp0", time.Unix(0, 0), util.Hot, config.TSSTORE, 0))
+	require.NoError(t, data.CreateDatabase("db1", nil, nil, false, 1, nil))
+	require.NoError(t, data.CreateDBPtView("db0"))
+	require.NoError(t, data.CreateDBPtView("db1"))
+	require.NoError(t, data.CreateUser("admin", "hash", true, false))
+	data.Streams = map[string]*StreamInfo{
+		"s0": {Name: "s0", ID: 1, SrcMst: &StreamMeasurementInfo{Name: "cpu", Database: "db0", RetentionPolicy: "rp0"},
+			DesMst: &StreamMeasurementInfo{Name: "cpu1", Database: "db0", RetentionPolicy: "rp0"}},
+		"s1": {Name: "s1", ID: 2, SrcMst: &StreamMeasurementInfo{Name: "cpu", Database: "db0", RetentionPolicy: "rp0"},
+			DesMst: &StreamMeasurementInfo{Name: "cpu1", Database: "db1", RetentionPolicy: "rp0"}},
+	}
+	data.MaxStreamID = 2
+	return data
+}
+
+func TestData_KeepDatabases(t *testing.T) {
+	data := initBackupData(t)
+	data.KeepDatabases(map[string]struct{}{"db0": {}})
+	assert2.NotNil(t, data.Database("db0"))
+	assert2.Nil(t, data.Database("db1"))
+	assert2.Nil(t, data.PtView["db1"])
+	assert2.Equal(t, 1, len(data.Streams))
+	assert2.NotNil(t, data.Streams["s0"])
+}
+
+func TestData_Restore(t *testing.T) {
+	backup := initBackupData(t)
+	buf, err := backup.MarshalBinary()
+	require.NoError(t, err)
+	backup = &Data{}
+	require.NoError(t, backup.UnmarshalBinary(buf))
+
+	data := &Data{PtNumPerNode: 1}
+	_, err = data.CreateDataNode("127.0.0.3:8086", "127.0.0.3:8188", "")
+	require.NoError(t, err)
+	_, err = data.CreateDataNode("127.0.0.4:8086", "127.0.0.4:8188", "")
+	require.NoError(t, err)
+	// the ids of the data nodes are different from the backup
+	data.DataNodes[0].ID, data.DataNodes[1].ID = 10, 11
+	require.NoError(t, data.CreateUser("admin", "new", false, false))
+	require.NoError(t, data.CreateUser("u1", "hash", false, false))
+
+	require.NoError(t, data.Restore(backup))
+	require.NotNil(t, data.Database("db0"))
+	require.NotNil(t, data.Database("db1"))
+	rp, err := data.RetentionPolicy("db0", "rp0")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rp.ShardGroups))
+	assert2.Equal(t, backup.Databases["db0"].RetentionPolicies["rp0"].ShardGroups[0].Shards[0].ID, rp.ShardGroups[0].Shards[0].ID)
+	assert2.Equal(t, backup.MaxShardID, data.MaxShardID)
+	assert2.Equal(t, backup.MaxIndexID, data.MaxIndexID)
+	assert2.Equal(t, 2, len(data.Streams))
+
+	require.Equal(t, 2, len(data.PtView["db0"]))
+	for i, pi := range data.PtView["db0"] {
+		assert2.Equal(t, backup.PtView["db0"][i].PtId, pi.PtId)
+		assert2.Equal(t, Offline, pi.Status)
+		if backup.PtView["db0"][i].Owner.NodeID == backup.DataNodes[0].ID {
+			assert2.Equal(t, uint64(10), pi.Owner.NodeID)
+		} else {
+			assert2.Equal(t, uint64(11), pi.Owner.NodeID)
+		}
+	}
+
+	require.Equal(t, 2, len(data.Users))
+	assert2.Equal(t, "hash", data.GetUser("admin").Hash)
+	assert2.True(t, data.AdminUserExists)
+	assert2.NotNil(t, data.GetUser("u1"))
+
+	// restore to a cluster with databases
+	assert2.Equal(t, ErrRestoreNotEmpty, data.Restore(backup))
+
+	// restore to a cluster with less data nodes
+	data = &Data{PtNumPerNode: 1}
+	_, err = data.CreateDataNode("127.0.0.3:8086", "127.0.0.3:8188", "")
+	require.NoError(t, err)
+	assert2.EqualError(t, data.Restore(backup), "restore requires 2 data nodes, but the cluster has 1")
+}
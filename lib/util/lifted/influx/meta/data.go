@@ -342,6 +342,7 @@ func init() {
 		proto2.Command_UpdateRetentionPolicyCommand:     {},
 		proto2.Command_CreateShardGroupCommand:          {},
 		proto2.Command_DeleteShardGroupCommand:          {},
+		proto2.Command_DropShardCommand:                 {},
 		proto2.Command_CreateSubscriptionCommand:        {},
 		proto2.Command_DropSubscriptionCommand:          {},
 		proto2.Command_CreateUserCommand:                {},
@@ -386,6 +387,8 @@ func init() {
 		proto2.Command_RemoveNodeCommand:                {},
 		proto2.Command_UpdateReplicationCommand:         {},
 		proto2.Command_UpdateMeasurementCommand:         {},
+		proto2.Command_SetQuotaCommand:                  {},
+		proto2.Command_SetMeasurementPrivilegeCommand:   {},
 	}
 }
 
@@ -1640,6 +1643,7 @@ func (data *Data) DropDatabase(name string) {
 
 	for i := range data.Users {
 		delete(data.Users[i].Privileges, name)
+		data.Users[i].dropMeasurementPrivileges(name)
 	}
 
 	if data.PtView != nil {
@@ -2063,33 +2067,24 @@ func (data *Data) UpdateRetentionPolicy(database, name string, rpu *RetentionPol
 	return nil
 }
 
-// DropShard removes a shard by ID.
+// DropShard marks a shard as deleted by ID to stop writing and querying it. The shard is kept
+// in the shard group until the data nodes have dropped it, the shard group is then marked as
+// deleted and pruned once all its shards are marked as deleted.
 //
 // DropShard won't return an error if the shard can't be found, which
 // allows the command to be re-run in the case that the meta store
 // succeeds but a data node fails.
 func (data *Data) DropShard(id uint64) {
-	found := -1
-	for dbidx, dbi := range data.Databases {
-		for rpidx, rpi := range dbi.RetentionPolicies {
-			for sgidx, sg := range rpi.ShardGroups {
-				for sidx, s := range sg.Shards {
-					if s.ID == id {
-						found = sidx
-						break
+	for _, dbi := range data.Databases {
+		for _, rpi := range dbi.RetentionPolicies {
+			for sgidx := range rpi.ShardGroups {
+				sg := &rpi.ShardGroups[sgidx]
+				for sidx := range sg.Shards {
+					if sg.Shards[sidx].ID == id {
+						sg.Shards[sidx].MarkDelete = true
+						return
 					}
 				}
-
-				if found > -1 {
-					shards := sg.Shards
-					data.Databases[dbidx].RetentionPolicies[rpidx].ShardGroups[sgidx].Shards = append(shards[:found], shards[found+1:]...)
-
-					if len(shards) == 1 {
-						// We just deleted the last shard in the shard group.
-						data.Databases[dbidx].RetentionPolicies[rpidx].ShardGroups[sgidx].DeletedAt = time.Now()
-					}
-					return
-				}
 			}
 		}
 	}
@@ -314,6 +314,44 @@ func TestData_DeleteShardGroup(t *testing.T) {
 	}
 }
 
+func TestData_DropShard(t *testing.T) {
+	db := "foo"
+	rp := "bar"
+
+	data := NewMockData(db, rp)
+	data.DropShard(6)
+	// shard not found
+	data.DropShard(100)
+
+	rpInfo, err := data.RetentionPolicy(db, rp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert(len(rpInfo.ShardGroups) == 3, "expect len(ShardGroups) == 3, but got %d", len(rpInfo.ShardGroups))
+	shards := rpInfo.ShardGroups[1].Shards
+	assert(len(shards) == 4, "expect the dropped shard to be kept in the shard group, but got %d shards", len(shards))
+	assert(shards[1].ID == 6 && shards[1].MarkDelete, "expect shard 6 to be marked as deleted")
+	assert(!shards[0].MarkDelete && !shards[2].MarkDelete, "expect other shards not to be marked as deleted")
+
+	for _, id := range []uint64{2, 3, 4} {
+		data.DropShard(id)
+	}
+	// the shard group is kept until the data nodes have dropped the shards
+	assert(len(rpInfo.ShardGroups) == 3, "expect len(ShardGroups) == 3, but got %d", len(rpInfo.ShardGroups))
+	assert(rpInfo.ShardGroups[0].canDelete() && !rpInfo.ShardGroups[0].Deleted(), "expect the shards of shard group 1 to be marked as deleted")
+
+	if err = data.DeleteShardGroup(db, rp, 1, 0, MarkDelete); err != nil {
+		t.Fatal(err)
+	}
+	if err = data.PruneGroups(true, 4); err != nil {
+		t.Fatal(err)
+	}
+	assert(len(rpInfo.ShardGroups) == 2, "expect len(ShardGroups) == 2, but got %d", len(rpInfo.ShardGroups))
+	assert(rpInfo.ShardGroups[0].ID == 2, "expect left ShardGroup ID == 2, but got %d", rpInfo.ShardGroups[0].ID)
+	_, ok := rpInfo.Measurements["mst2"].ShardIdexes[1]
+	assert(!ok, "expect the shard indexes of shard group 1 to be removed")
+}
+
 func TestData_PruneGroups(t *testing.T) {
 	db := "foo"
 	rp := "bar"
@@ -461,6 +499,36 @@ func TestData_UserCmd(t *testing.T) {
 	require.EqualError(t, data.DropUser(""), ErrUserNotFound.Error())
 }
 
+func TestData_SetQuota(t *testing.T) {
+	data := initData()
+	require.NoError(t, data.CreateUser("user1", "xxxxhashxxxx", false, false))
+	data.CreateDBPtView("db0")
+	require.NoError(t, data.CreateDatabase("db0", nil, nil, false, 1, nil))
+
+	userQuota := &QuotaInfo{PointsPerSecond: 1000, MaxConcurrentQueries: 2}
+	dbQuota := &QuotaInfo{MaxSeries: 10000}
+	require.EqualError(t, data.SetQuota("", "", userQuota), ErrQuotaTargetRequired.Error())
+	require.EqualError(t, data.SetQuota("user1", "db0", userQuota), ErrQuotaTargetRequired.Error())
+	require.EqualError(t, data.SetQuota("user_notfound", "", userQuota), ErrUserNotFound.Error())
+	require.Error(t, data.SetQuota("", "db_notfound", dbQuota))
+	require.NoError(t, data.SetQuota("user1", "", userQuota))
+	require.NoError(t, data.SetQuota("", "db0", dbQuota))
+
+	buf, err := data.MarshalBinary()
+	require.NoError(t, err)
+	other := &Data{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Equal(t, userQuota, other.GetUser("user1").Quota)
+	require.Equal(t, dbQuota, other.Database("db0").Quota)
+	require.Equal(t, userQuota, other.CloneUsers()[0].Quota)
+
+	// an empty quota drops it
+	require.NoError(t, data.SetQuota("user1", "", &QuotaInfo{}))
+	require.Nil(t, data.GetUser("user1").Quota)
+	require.NoError(t, data.SetQuota("", "db0", nil))
+	require.Nil(t, data.Database("db0").Quota)
+}
+
 func TestData_UpdateRetentionPolicy(t *testing.T) {
 	data := initData()
 	database := "alterDb"
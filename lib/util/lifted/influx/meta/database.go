@@ -28,6 +28,7 @@ type DatabaseInfo struct {
 	ReplicaN               int
 	ContinuousQueries      map[string]*ContinuousQueryInfo // {"cqName": *ContinuousQueryInfo}
 	Options                *obs.ObsOptions
+	Quota                  *QuotaInfo
 }
 
 func NewDatabase(name string) *DatabaseInfo {
@@ -117,6 +118,7 @@ func (di DatabaseInfo) clone() *DatabaseInfo {
 		options := *di.Options
 		other.Options = &options
 	}
+	other.Quota = di.Quota.Clone()
 
 	return &other
 }
@@ -150,6 +152,9 @@ func (di DatabaseInfo) marshal() *proto2.DatabaseInfo {
 	if di.Options != nil {
 		pb.Options = MarshalObsOptions(di.Options)
 	}
+	if di.Quota != nil {
+		pb.Quota = di.Quota.Marshal()
+	}
 
 	return pb
 }
@@ -189,6 +194,7 @@ func (di *DatabaseInfo) unmarshal(pb *proto2.DatabaseInfo) {
 	if pb.GetOptions() != nil {
 		di.Options = UnmarshalObsOptions(pb.GetOptions())
 	}
+	di.Quota = unmarshalQuota(pb.GetQuota())
 }
 
 type PtOwner struct {
@@ -198,6 +198,9 @@ var (
 	// ErrPwdUsed is returned when use an old password
 	ErrPwdUsed = errors.New("the password is the same as the old one, please enter a new password")
 
+	// ErrQuotaTargetRequired is returned when a quota is neither bound to a user nor to a database.
+	ErrQuotaTargetRequired = errors.New("quota must be set on either a user or a database")
+
 	// ErrHashedLength is returned when hashed length err.
 	ErrHashedLength         = errors.New("hashedSecret too short to be a hashed password")
 	ErrMismatchedHashAndPwd = errors.New("hashedPassword is not the hash of the given password")
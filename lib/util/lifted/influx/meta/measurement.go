@@ -33,6 +33,26 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 )
 
+const (
+	// PromMetadataMeasurement stores the metric metadata sent by the Prometheus remote write
+	PromMetadataMeasurement = "__prom_metadata__"
+	// PromExemplarMeasurement stores the exemplars sent by the Prometheus remote write
+	PromExemplarMeasurement = "__prom_exemplars__"
+)
+
+// hiddenMeasurements are the internal measurements stored in the databases of the users. They are
+// matched only by their exact names, so they are not listed by SHOW MEASUREMENTS, not selected by
+// the regex sources and do not show up in the metric names of PromQL.
+var hiddenMeasurements = map[string]struct{}{
+	PromMetadataMeasurement: {},
+	PromExemplarMeasurement: {},
+}
+
+func IsHiddenMeasurement(name string) bool {
+	_, ok := hiddenMeasurements[name]
+	return ok
+}
+
 var escapeTable [256]byte
 
 func init() {
@@ -618,6 +638,13 @@ func (msti *MeasurementInfo) FindMstInfos(dataTypes []int64) []*MeasurementTypeF
 					info.Fields = append(info.Fields, name)
 				}
 			}
+		case influxql.Unsigned:
+			info.Type = int64(influxql.Unsigned)
+			for name, ty := range msti.Schema {
+				if ty == influx.Field_Type_UInt {
+					info.Fields = append(info.Fields, name)
+				}
+			}
 		case influxql.String:
 			info.Type = int64(influxql.String)
 			for name, ty := range msti.Schema {
@@ -0,0 +1,346 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/influxdata/influxdb/models"
+	originql "github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+)
+
+// MeasurementPrivilege is a privilege granted on one measurement, or on all the measurements
+// of the database if Measurement is empty, and limited to the series whose tags match Condition.
+type MeasurementPrivilege struct {
+	Database    string
+	Measurement string
+	Privilege   originql.Privilege
+
+	// Condition on the tags of the series, empty if all the series are granted.
+	Condition string
+
+	cond influxql.Expr
+}
+
+func NewMeasurementPrivilege(database, measurement string, p originql.Privilege, condition string) (*MeasurementPrivilege, error) {
+	mp := &MeasurementPrivilege{
+		Database:    database,
+		Measurement: measurement,
+		Privilege:   p,
+	}
+	if condition == "" {
+		return mp, nil
+	}
+
+	cond, err := ParsePrivilegeCondition(condition)
+	if err != nil {
+		return nil, err
+	}
+	mp.Condition = cond.String()
+	mp.cond = cond
+	return mp, nil
+}
+
+// ParsePrivilegeCondition parses the condition of a measurement privilege. Only tags compared
+// with strings or regular expressions, combined with AND and OR, are supported.
+func ParsePrivilegeCondition(s string) (influxql.Expr, error) {
+	expr, err := influxql.ParseExpr(s)
+	if err != nil {
+		return nil, err
+	}
+	if err = ValidatePrivilegeCondition(expr); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
+
+func ValidatePrivilegeCondition(expr influxql.Expr) error {
+	switch expr := expr.(type) {
+	case *influxql.ParenExpr:
+		return ValidatePrivilegeCondition(expr.Expr)
+	case *influxql.BinaryExpr:
+		_, isTag := expr.LHS.(*influxql.VarRef)
+		switch expr.Op {
+		case influxql.AND, influxql.OR:
+			if err := ValidatePrivilegeCondition(expr.LHS); err != nil {
+				return err
+			}
+			return ValidatePrivilegeCondition(expr.RHS)
+		case influxql.EQ, influxql.NEQ:
+			if _, ok := expr.RHS.(*influxql.StringLiteral); ok && isTag {
+				return nil
+			}
+		case influxql.EQREGEX, influxql.NEQREGEX:
+			if _, ok := expr.RHS.(*influxql.RegexLiteral); ok && isTag {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("invalid privilege condition: %s, expect tag comparisons with strings or regular expressions", expr)
+}
+
+// Match returns true if the series with the tags is granted by the privilege.
+func (mp *MeasurementPrivilege) Match(tags models.Tags) bool {
+	if mp.cond == nil {
+		return true
+	}
+	m := make(map[string]interface{})
+	influxql.WalkFunc(mp.cond, func(n influxql.Node) {
+		if ref, ok := n.(*influxql.VarRef); ok {
+			m[ref.Val] = string(tags.Get([]byte(ref.Val)))
+		}
+	})
+	return influxql.EvalBool(mp.cond, m)
+}
+
+func (mp *MeasurementPrivilege) marshal() *proto2.MeasurementPrivilege {
+	return &proto2.MeasurementPrivilege{
+		Database:    proto.String(mp.Database),
+		Measurement: proto.String(mp.Measurement),
+		Privilege:   proto.Int32(int32(mp.Privilege)),
+		Condition:   proto.String(mp.Condition),
+	}
+}
+
+func unmarshalMeasurementPrivilege(pb *proto2.MeasurementPrivilege) *MeasurementPrivilege {
+	mp, err := NewMeasurementPrivilege(pb.GetDatabase(), pb.GetMeasurement(), originql.Privilege(pb.GetPrivilege()), pb.GetCondition())
+	if err != nil {
+		// the condition was validated when it was granted, deny all the series rather than none
+		mp = &MeasurementPrivilege{
+			Database:    pb.GetDatabase(),
+			Measurement: pb.GetMeasurement(),
+			Privilege:   originql.Privilege(pb.GetPrivilege()),
+			Condition:   pb.GetCondition(),
+			cond:        &influxql.BooleanLiteral{Val: false},
+		}
+	}
+	return mp
+}
+
+// measurementPrivilege returns the measurement privilege that grants p on the measurement,
+// a privilege on the measurement takes precedence over a privilege on the whole database.
+func (u *UserInfo) measurementPrivilege(p originql.Privilege, database, measurement string) *MeasurementPrivilege {
+	var all *MeasurementPrivilege
+	for _, mp := range u.MeasurementPrivileges {
+		if mp.Database != database || mp.Privilege&p != p {
+			continue
+		}
+		if mp.Measurement == measurement {
+			return mp
+		}
+		if mp.Measurement == "" {
+			all = mp
+		}
+	}
+	return all
+}
+
+// HasMeasurementPrivilege returns true if p is granted on some measurements or series of the database.
+func (u *UserInfo) HasMeasurementPrivilege(p originql.Privilege, database string) bool {
+	for _, mp := range u.MeasurementPrivileges {
+		if mp.Database == database && mp.Privilege&p == p {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *UserInfo) authorizeSeries(p originql.Privilege, database string, measurement []byte, tags models.Tags) bool {
+	if u.AuthorizeDatabase(p, database) {
+		return true
+	}
+	mp := u.measurementPrivilege(p, database, string(measurement))
+	return mp != nil && mp.Match(tags)
+}
+
+// RestrictSelect checks that the user can read all the measurements of the statement and its subqueries,
+// and adds the conditions of the measurement privileges to the conditions of the statements.
+// The measurements of the statement must have been normalized with their database.
+func (u *UserInfo) RestrictSelect(stmt *influxql.SelectStatement) error {
+	if u.Admin || u.Rwuser || len(u.MeasurementPrivileges) == 0 {
+		return nil
+	}
+	return u.restrictSelect(stmt)
+}
+
+func (u *UserInfo) restrictSelect(stmt *influxql.SelectStatement) error {
+	var granted []*MeasurementPrivilege
+	var walk func(sources influxql.Sources) error
+	walk = func(sources influxql.Sources) error {
+		for _, source := range sources {
+			switch source := source.(type) {
+			case *influxql.Measurement:
+				mp, err := u.readPrivilege(source)
+				if err != nil {
+					return err
+				}
+				granted = append(granted, mp)
+			case *influxql.SubQuery:
+				if err := u.restrictSelect(source.Statement); err != nil {
+					return err
+				}
+			case *influxql.Join:
+				if err := walk(influxql.Sources{source.LSrc, source.RSrc}); err != nil {
+					return err
+				}
+			case *influxql.BinOp:
+				if err := walk(influxql.Sources{source.LSrc, source.RSrc}); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := walk(stmt.Sources); err != nil {
+		return err
+	}
+
+	var err error
+	influxql.WalkFunc(stmt.Condition, func(n influxql.Node) {
+		if in, ok := n.(*influxql.InCondition); ok && err == nil {
+			err = u.restrictSelect(in.Stmt)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	var cond influxql.Expr
+	for i, mp := range granted {
+		var c influxql.Expr
+		if mp != nil {
+			c = mp.cond
+		}
+		if i == 0 {
+			cond = c
+			continue
+		}
+		if !sameCondition(cond, c) {
+			return &ErrAuthorize{
+				User:    u.Name,
+				Message: fmt.Sprintf("statement '%s', measurements granted with different conditions cannot be queried together", stmt),
+			}
+		}
+	}
+	if cond == nil {
+		return nil
+	}
+
+	cond = influxql.CloneExpr(cond)
+	if stmt.Condition == nil {
+		stmt.Condition = cond
+	} else {
+		stmt.Condition = &influxql.BinaryExpr{
+			Op:  influxql.AND,
+			LHS: &influxql.ParenExpr{Expr: stmt.Condition},
+			RHS: &influxql.ParenExpr{Expr: cond},
+		}
+	}
+	return nil
+}
+
+// readPrivilege returns the measurement privilege which grants the read of the measurement,
+// nil if the whole database is granted.
+func (u *UserInfo) readPrivilege(m *influxql.Measurement) (*MeasurementPrivilege, error) {
+	if u.AuthorizeDatabase(originql.ReadPrivilege, m.Database) {
+		return nil, nil
+	}
+
+	name := m.Name
+	if m.Regex != nil {
+		// only a privilege on all the measurements covers a regular expression
+		name = ""
+	}
+	mp := u.measurementPrivilege(originql.ReadPrivilege, m.Database, name)
+	if mp == nil {
+		return nil, &ErrAuthorize{
+			User:     u.Name,
+			Database: m.Database,
+			Message:  fmt.Sprintf("measurement %s, requires %s on %s", m, originql.ReadPrivilege, m.Database),
+		}
+	}
+	return mp, nil
+}
+
+func sameCondition(a, b influxql.Expr) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.String() == b.String()
+}
+
+func (u *UserInfo) dropMeasurementPrivileges(database string) {
+	var privileges []*MeasurementPrivilege
+	for _, mp := range u.MeasurementPrivileges {
+		if mp.Database != database {
+			privileges = append(privileges, mp)
+		}
+	}
+	u.MeasurementPrivileges = privileges
+}
+
+// UserMeasurementPrivileges returns the measurement privileges of a user.
+func (data *Data) UserMeasurementPrivileges(name string) ([]*MeasurementPrivilege, error) {
+	ui := data.GetUser(name)
+	if ui == nil {
+		return nil, ErrUserNotFound
+	}
+	return append([]*MeasurementPrivilege(nil), ui.MeasurementPrivileges...), nil
+}
+
+// SetMeasurementPrivilege sets a privilege for a user on a measurement of a database,
+// NoPrivileges drops the measurement privilege.
+func (data *Data) SetMeasurementPrivilege(name, database, measurement string, p originql.Privilege, condition string) error {
+	ui := data.GetUser(name)
+	if ui == nil {
+		return ErrUserNotFound
+	}
+
+	if _, err := data.GetDatabase(database); err != nil {
+		return err
+	}
+
+	privileges := make([]*MeasurementPrivilege, 0, len(ui.MeasurementPrivileges)+1)
+	for _, mp := range ui.MeasurementPrivileges {
+		if mp.Database != database || mp.Measurement != measurement {
+			privileges = append(privileges, mp)
+		}
+	}
+	if p != originql.NoPrivileges {
+		mp, err := NewMeasurementPrivilege(database, measurement, p, condition)
+		if err != nil {
+			return err
+		}
+		privileges = append(privileges, mp)
+		sort.Slice(privileges, func(i, j int) bool {
+			if privileges[i].Database != privileges[j].Database {
+				return privileges[i].Database < privileges[j].Database
+			}
+			return privileges[i].Measurement < privileges[j].Measurement
+		})
+	}
+
+	if len(privileges) == 0 {
+		privileges = nil
+	}
+	ui.MeasurementPrivileges = privileges
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/models"
+	originql "github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestData_SetMeasurementPrivilege(t *testing.T) {
+	data := initData()
+	require.NoError(t, data.CreateUser("user1", "xxxxhashxxxx", false, false))
+	data.CreateDBPtView("db0")
+	require.NoError(t, data.CreateDatabase("db0", nil, nil, false, 1, nil))
+
+	require.EqualError(t, data.SetMeasurementPrivilege("user_notfound", "db0", "cpu", originql.ReadPrivilege, ""), ErrUserNotFound.Error())
+	require.Error(t, data.SetMeasurementPrivilege("user1", "db_notfound", "cpu", originql.ReadPrivilege, ""))
+	require.Error(t, data.SetMeasurementPrivilege("user1", "db0", "cpu", originql.ReadPrivilege, "value > 1"))
+	require.NoError(t, data.SetMeasurementPrivilege("user1", "db0", "mem", originql.AllPrivileges, ""))
+	require.NoError(t, data.SetMeasurementPrivilege("user1", "db0", "cpu", originql.ReadPrivilege, "tenant='acme'"))
+
+	buf, err := data.MarshalBinary()
+	require.NoError(t, err)
+	other := &Data{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	mps, err := other.UserMeasurementPrivileges("user1")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(mps))
+	require.Equal(t, "cpu", mps[0].Measurement)
+	require.Equal(t, "tenant = 'acme'", mps[0].Condition)
+	require.Equal(t, originql.AllPrivileges, mps[1].Privilege)
+
+	// replace the privilege on the measurement, then drop it
+	require.NoError(t, data.SetMeasurementPrivilege("user1", "db0", "cpu", originql.WritePrivilege, ""))
+	mps, _ = data.UserMeasurementPrivileges("user1")
+	require.Equal(t, 2, len(mps))
+	require.Equal(t, originql.WritePrivilege, mps[0].Privilege)
+	require.NoError(t, data.SetMeasurementPrivilege("user1", "db0", "cpu", originql.NoPrivileges, ""))
+	mps, _ = data.UserMeasurementPrivileges("user1")
+	require.Equal(t, 1, len(mps))
+
+	data.DropDatabase("db0")
+	require.Nil(t, data.GetUser("user1").MeasurementPrivileges)
+}
+
+func newRestrictedUser(t *testing.T, privileges ...*MeasurementPrivilege) *UserInfo {
+	for _, mp := range privileges {
+		if mp.Condition != "" {
+			other, err := NewMeasurementPrivilege(mp.Database, mp.Measurement, mp.Privilege, mp.Condition)
+			require.NoError(t, err)
+			*mp = *other
+		}
+	}
+	return &UserInfo{
+		Name:                  "user1",
+		Privileges:            map[string]originql.Privilege{"db1": originql.ReadPrivilege},
+		MeasurementPrivileges: privileges,
+	}
+}
+
+func parseSelect(t *testing.T, s string) *influxql.SelectStatement {
+	stmt, err := influxql.ParseStatement(s)
+	require.NoError(t, err)
+	influxql.WalkFunc(stmt, func(n influxql.Node) {
+		if m, ok := n.(*influxql.Measurement); ok && m.Database == "" {
+			m.Database = "db0"
+		}
+	})
+	return stmt.(*influxql.SelectStatement)
+}
+
+func TestUserInfo_RestrictSelect(t *testing.T) {
+	u := newRestrictedUser(t,
+		&MeasurementPrivilege{Database: "db0", Measurement: "cpu", Privilege: originql.ReadPrivilege, Condition: "tenant = 'acme'"},
+		&MeasurementPrivilege{Database: "db0", Measurement: "disk", Privilege: originql.ReadPrivilege, Condition: "tenant = 'acme'"},
+		&MeasurementPrivilege{Database: "db0", Measurement: "mem", Privilege: originql.AllPrivileges},
+		&MeasurementPrivilege{Database: "db0", Measurement: "net", Privilege: originql.WritePrivilege},
+	)
+
+	cases := []struct {
+		sql  string
+		exp  string
+		fail bool
+	}{
+		{sql: `SELECT value FROM cpu`, exp: `SELECT value FROM db0..cpu WHERE tenant = 'acme'`},
+		{sql: `SELECT value FROM cpu WHERE host = 'a' OR host = 'b'`, exp: `SELECT value FROM db0..cpu WHERE (host = 'a' OR host = 'b') AND (tenant = 'acme')`},
+		{sql: `SELECT value FROM cpu, disk`, exp: `SELECT value FROM db0..cpu, db0..disk WHERE tenant = 'acme'`},
+		{sql: `SELECT max(value) FROM (SELECT value FROM cpu)`, exp: `SELECT max(value) FROM (SELECT value FROM db0..cpu WHERE tenant = 'acme')`},
+		{sql: `SELECT value FROM mem`, exp: `SELECT value FROM db0..mem`},
+		{sql: `SELECT value FROM db1..io`, exp: `SELECT value FROM db1..io`},
+		{sql: `SELECT value FROM cpu, mem`, fail: true},
+		{sql: `SELECT value FROM net`, fail: true},
+		{sql: `SELECT value FROM swap`, fail: true},
+		{sql: `SELECT value FROM /cpu/`, fail: true},
+		{sql: `SELECT max(value) FROM (SELECT value FROM swap)`, fail: true},
+	}
+	for _, c := range cases {
+		stmt := parseSelect(t, c.sql)
+		err := u.RestrictSelect(stmt)
+		if c.fail {
+			require.Error(t, err, c.sql)
+			continue
+		}
+		require.NoError(t, err, c.sql)
+		require.Equal(t, c.exp, stmt.String())
+	}
+
+	// a privilege on all the measurements covers the regular expressions
+	u = newRestrictedUser(t, &MeasurementPrivilege{Database: "db0", Privilege: originql.ReadPrivilege, Condition: "tenant =~ /^acme/"})
+	stmt := parseSelect(t, `SELECT value FROM /cpu/`)
+	require.NoError(t, u.RestrictSelect(stmt))
+	require.Equal(t, `SELECT value FROM db0../cpu/ WHERE tenant =~ /^acme/`, stmt.String())
+
+	// admin and users without measurement privileges are not restricted
+	admin := &UserInfo{Name: "admin", Admin: true, MeasurementPrivileges: u.MeasurementPrivileges}
+	stmt = parseSelect(t, `SELECT value FROM swap`)
+	require.NoError(t, admin.RestrictSelect(stmt))
+	require.NoError(t, (&UserInfo{Name: "user2"}).RestrictSelect(stmt))
+	require.Equal(t, `SELECT value FROM db0..swap`, stmt.String())
+}
+
+func TestUserInfo_AuthorizeSeriesWrite(t *testing.T) {
+	u := newRestrictedUser(t,
+		&MeasurementPrivilege{Database: "db0", Measurement: "cpu", Privilege: originql.WritePrivilege, Condition: "tenant = 'acme' AND host != ''"},
+		&MeasurementPrivilege{Database: "db0", Privilege: originql.AllPrivileges, Condition: "tenant = 'other'"},
+	)
+	acme := models.NewTags(map[string]string{"tenant": "acme", "host": "h1"})
+	other := models.NewTags(map[string]string{"tenant": "other"})
+
+	require.True(t, u.AuthorizeSeriesWrite("db0", []byte("cpu"), acme))
+	require.False(t, u.AuthorizeSeriesWrite("db0", []byte("cpu"), models.NewTags(map[string]string{"tenant": "acme"})))
+	require.False(t, u.AuthorizeSeriesWrite("db0", []byte("cpu"), other))
+	require.True(t, u.AuthorizeSeriesWrite("db0", []byte("mem"), other))
+	require.False(t, u.AuthorizeSeriesWrite("db0", []byte("mem"), acme))
+	require.False(t, u.AuthorizeSeriesWrite("db1", []byte("cpu"), acme))
+	require.True(t, u.AuthorizeSeriesRead("db1", []byte("cpu"), acme))
+	require.True(t, u.HasMeasurementPrivilege(originql.WritePrivilege, "db0"))
+	require.False(t, u.HasMeasurementPrivilege(originql.WritePrivilege, "db1"))
+}
+
+func TestUserInfo_AuthorizeQueryWithMeasurementPrivilege(t *testing.T) {
+	u := newRestrictedUser(t, &MeasurementPrivilege{Database: "db0", Measurement: "cpu", Privilege: originql.ReadPrivilege})
+
+	q, err := influxql.ParseQuery(`SELECT value FROM cpu; SELECT value FROM db1..cpu`)
+	require.NoError(t, err)
+	require.NoError(t, u.AuthorizeQuery("db0", q))
+
+	// other statements still require the privilege on the database
+	for _, s := range []string{`SHOW MEASUREMENTS`, `SELECT value INTO cpu_copy FROM cpu`, `SELECT value FROM db2..cpu`} {
+		q, err = influxql.ParseQuery(s)
+		require.NoError(t, err)
+		require.Error(t, u.AuthorizeQuery("db0", q), s)
+	}
+}
+
+func TestValidatePrivilegeCondition(t *testing.T) {
+	for _, s := range []string{`tenant = 'a'`, `tenant != 'a' AND (host =~ /h/ OR host !~ /x/)`} {
+		_, err := ParsePrivilegeCondition(s)
+		require.NoError(t, err, s)
+	}
+	for _, s := range []string{`value > 1`, `tenant = 1`, `'a' = tenant`, `tenant = 'a' AND time > 0`, `tenant =`} {
+		_, err := ParsePrivilegeCondition(s)
+		require.Error(t, err, s)
+	}
+}
@@ -97,6 +97,8 @@ const (
 	Command_UpdateSqlNodeStatusCommand            Command_Type = 97
 	Command_InsertFilesCommand                    Command_Type = 98
 	Command_UpdateMeasurementCommand              Command_Type = 101
+	Command_SetQuotaCommand                       Command_Type = 102
+	Command_SetMeasurementPrivilegeCommand        Command_Type = 103
 )
 
 var Command_Type_name = map[int32]string{
@@ -174,6 +176,8 @@ var Command_Type_name = map[int32]string{
 	97:  "UpdateSqlNodeStatusCommand",
 	98:  "InsertFilesCommand",
 	101: "UpdateMeasurementCommand",
+	102: "SetQuotaCommand",
+	103: "SetMeasurementPrivilegeCommand",
 }
 
 var Command_Type_value = map[string]int32{
@@ -251,6 +255,8 @@ var Command_Type_value = map[string]int32{
 	"UpdateSqlNodeStatusCommand":            97,
 	"InsertFilesCommand":                    98,
 	"UpdateMeasurementCommand":              101,
+	"SetQuotaCommand":                       102,
+	"SetMeasurementPrivilegeCommand":        103,
 }
 
 func (x Command_Type) Enum() *Command_Type {
@@ -273,7 +279,7 @@ func (x *Command_Type) UnmarshalJSON(data []byte) error {
 }
 
 func (Command_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{34, 0}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{36, 0}
 }
 
 type Data struct {
@@ -1028,6 +1034,7 @@ type DatabaseInfo struct {
 	EnableTagArray         *bool                  `protobuf:"varint,7,opt,name=EnableTagArray" json:"EnableTagArray,omitempty"`
 	ReplicaN               *int64                 `protobuf:"varint,8,opt,name=ReplicaN" json:"ReplicaN,omitempty"`
 	Options                *ObsOptions            `protobuf:"bytes,21,opt,name=Options" json:"Options,omitempty"`
+	Quota                  *QuotaInfo             `protobuf:"bytes,22,opt,name=Quota" json:"Quota,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}               `json:"-"`
 	XXX_unrecognized       []byte                 `json:"-"`
 	XXX_sizecache          int32                  `json:"-"`
@@ -1120,6 +1127,13 @@ func (m *DatabaseInfo) GetOptions() *ObsOptions {
 	return nil
 }
 
+func (m *DatabaseInfo) GetQuota() *QuotaInfo {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
 type RetentionPolicySpec struct {
 	Name                 *string  `protobuf:"bytes,1,opt,name=Name" json:"Name,omitempty"`
 	Duration             *int64   `protobuf:"varint,2,opt,name=Duration" json:"Duration,omitempty"`
@@ -1902,14 +1916,16 @@ func (m *ShardOwner) GetNodeID() uint64 {
 }
 
 type UserInfo struct {
-	Name                 *string          `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
-	Hash                 *string          `protobuf:"bytes,2,req,name=Hash" json:"Hash,omitempty"`
-	Admin                *bool            `protobuf:"varint,3,req,name=Admin" json:"Admin,omitempty"`
-	RwUser               *bool            `protobuf:"varint,4,opt,name=RwUser" json:"RwUser,omitempty"`
-	Privileges           []*UserPrivilege `protobuf:"bytes,5,rep,name=Privileges" json:"Privileges,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Name                  *string                 `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Hash                  *string                 `protobuf:"bytes,2,req,name=Hash" json:"Hash,omitempty"`
+	Admin                 *bool                   `protobuf:"varint,3,req,name=Admin" json:"Admin,omitempty"`
+	RwUser                *bool                   `protobuf:"varint,4,opt,name=RwUser" json:"RwUser,omitempty"`
+	Privileges            []*UserPrivilege        `protobuf:"bytes,5,rep,name=Privileges" json:"Privileges,omitempty"`
+	Quota                 *QuotaInfo              `protobuf:"bytes,6,opt,name=Quota" json:"Quota,omitempty"`
+	MeasurementPrivileges []*MeasurementPrivilege `protobuf:"bytes,7,rep,name=MeasurementPrivileges" json:"MeasurementPrivileges,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                `json:"-"`
+	XXX_unrecognized      []byte                  `json:"-"`
+	XXX_sizecache         int32                   `json:"-"`
 }
 
 func (m *UserInfo) Reset()         { *m = UserInfo{} }
@@ -1971,6 +1987,90 @@ func (m *UserInfo) GetPrivileges() []*UserPrivilege {
 	return nil
 }
 
+func (m *UserInfo) GetQuota() *QuotaInfo {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *UserInfo) GetMeasurementPrivileges() []*MeasurementPrivilege {
+	if m != nil {
+		return m.MeasurementPrivileges
+	}
+	return nil
+}
+
+type QuotaInfo struct {
+	PointsPerSecond      *int64   `protobuf:"varint,1,opt,name=PointsPerSecond" json:"PointsPerSecond,omitempty"`
+	BytesPerSecond       *int64   `protobuf:"varint,2,opt,name=BytesPerSecond" json:"BytesPerSecond,omitempty"`
+	MaxConcurrentQueries *int64   `protobuf:"varint,3,opt,name=MaxConcurrentQueries" json:"MaxConcurrentQueries,omitempty"`
+	MaxSeries            *int64   `protobuf:"varint,4,opt,name=MaxSeries" json:"MaxSeries,omitempty"`
+	MaxQueryResultBytes  *int64   `protobuf:"varint,5,opt,name=MaxQueryResultBytes" json:"MaxQueryResultBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuotaInfo) Reset()         { *m = QuotaInfo{} }
+func (m *QuotaInfo) String() string { return proto.CompactTextString(m) }
+func (*QuotaInfo) ProtoMessage()    {}
+func (*QuotaInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{21}
+}
+func (m *QuotaInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotaInfo.Unmarshal(m, b)
+}
+func (m *QuotaInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotaInfo.Marshal(b, m, deterministic)
+}
+func (m *QuotaInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaInfo.Merge(m, src)
+}
+func (m *QuotaInfo) XXX_Size() int {
+	return xxx_messageInfo_QuotaInfo.Size(m)
+}
+func (m *QuotaInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaInfo proto.InternalMessageInfo
+
+func (m *QuotaInfo) GetPointsPerSecond() int64 {
+	if m != nil && m.PointsPerSecond != nil {
+		return *m.PointsPerSecond
+	}
+	return 0
+}
+
+func (m *QuotaInfo) GetBytesPerSecond() int64 {
+	if m != nil && m.BytesPerSecond != nil {
+		return *m.BytesPerSecond
+	}
+	return 0
+}
+
+func (m *QuotaInfo) GetMaxConcurrentQueries() int64 {
+	if m != nil && m.MaxConcurrentQueries != nil {
+		return *m.MaxConcurrentQueries
+	}
+	return 0
+}
+
+func (m *QuotaInfo) GetMaxSeries() int64 {
+	if m != nil && m.MaxSeries != nil {
+		return *m.MaxSeries
+	}
+	return 0
+}
+
+func (m *QuotaInfo) GetMaxQueryResultBytes() int64 {
+	if m != nil && m.MaxQueryResultBytes != nil {
+		return *m.MaxQueryResultBytes
+	}
+	return 0
+}
+
 type UserPrivilege struct {
 	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
 	Privilege            *int32   `protobuf:"varint,2,req,name=Privilege" json:"Privilege,omitempty"`
@@ -1983,7 +2083,7 @@ func (m *UserPrivilege) Reset()         { *m = UserPrivilege{} }
 func (m *UserPrivilege) String() string { return proto.CompactTextString(m) }
 func (*UserPrivilege) ProtoMessage()    {}
 func (*UserPrivilege) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{21}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{22}
 }
 func (m *UserPrivilege) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserPrivilege.Unmarshal(m, b)
@@ -2017,6 +2117,68 @@ func (m *UserPrivilege) GetPrivilege() int32 {
 	return 0
 }
 
+type MeasurementPrivilege struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Measurement          *string  `protobuf:"bytes,2,opt,name=Measurement" json:"Measurement,omitempty"`
+	Privilege            *int32   `protobuf:"varint,3,req,name=Privilege" json:"Privilege,omitempty"`
+	Condition            *string  `protobuf:"bytes,4,opt,name=Condition" json:"Condition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MeasurementPrivilege) Reset()         { *m = MeasurementPrivilege{} }
+func (m *MeasurementPrivilege) String() string { return proto.CompactTextString(m) }
+func (*MeasurementPrivilege) ProtoMessage()    {}
+func (*MeasurementPrivilege) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{23}
+}
+func (m *MeasurementPrivilege) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeasurementPrivilege.Unmarshal(m, b)
+}
+func (m *MeasurementPrivilege) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeasurementPrivilege.Marshal(b, m, deterministic)
+}
+func (m *MeasurementPrivilege) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeasurementPrivilege.Merge(m, src)
+}
+func (m *MeasurementPrivilege) XXX_Size() int {
+	return xxx_messageInfo_MeasurementPrivilege.Size(m)
+}
+func (m *MeasurementPrivilege) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeasurementPrivilege.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeasurementPrivilege proto.InternalMessageInfo
+
+func (m *MeasurementPrivilege) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *MeasurementPrivilege) GetMeasurement() string {
+	if m != nil && m.Measurement != nil {
+		return *m.Measurement
+	}
+	return ""
+}
+
+func (m *MeasurementPrivilege) GetPrivilege() int32 {
+	if m != nil && m.Privilege != nil {
+		return *m.Privilege
+	}
+	return 0
+}
+
+func (m *MeasurementPrivilege) GetCondition() string {
+	if m != nil && m.Condition != nil {
+		return *m.Condition
+	}
+	return ""
+}
+
 type IndexRelation struct {
 	Rid                  *uint32         `protobuf:"varint,1,req,name=Rid" json:"Rid,omitempty"`
 	Oid                  []uint32        `protobuf:"varint,2,rep,name=Oid" json:"Oid,omitempty"`
@@ -2032,7 +2194,7 @@ func (m *IndexRelation) Reset()         { *m = IndexRelation{} }
 func (m *IndexRelation) String() string { return proto.CompactTextString(m) }
 func (*IndexRelation) ProtoMessage()    {}
 func (*IndexRelation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{22}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{24}
 }
 func (m *IndexRelation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexRelation.Unmarshal(m, b)
@@ -2098,7 +2260,7 @@ func (m *IndexList) Reset()         { *m = IndexList{} }
 func (m *IndexList) String() string { return proto.CompactTextString(m) }
 func (*IndexList) ProtoMessage()    {}
 func (*IndexList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{23}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{25}
 }
 func (m *IndexList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexList.Unmarshal(m, b)
@@ -2136,7 +2298,7 @@ func (m *RpMeasurementsFieldsInfo) Reset()         { *m = RpMeasurementsFieldsIn
 func (m *RpMeasurementsFieldsInfo) String() string { return proto.CompactTextString(m) }
 func (*RpMeasurementsFieldsInfo) ProtoMessage()    {}
 func (*RpMeasurementsFieldsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{24}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{26}
 }
 func (m *RpMeasurementsFieldsInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RpMeasurementsFieldsInfo.Unmarshal(m, b)
@@ -2175,7 +2337,7 @@ func (m *MeasurementFieldsInfo) Reset()         { *m = MeasurementFieldsInfo{} }
 func (m *MeasurementFieldsInfo) String() string { return proto.CompactTextString(m) }
 func (*MeasurementFieldsInfo) ProtoMessage()    {}
 func (*MeasurementFieldsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{25}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{27}
 }
 func (m *MeasurementFieldsInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementFieldsInfo.Unmarshal(m, b)
@@ -2221,7 +2383,7 @@ func (m *MeasurementTypeFields) Reset()         { *m = MeasurementTypeFields{} }
 func (m *MeasurementTypeFields) String() string { return proto.CompactTextString(m) }
 func (*MeasurementTypeFields) ProtoMessage()    {}
 func (*MeasurementTypeFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{26}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{28}
 }
 func (m *MeasurementTypeFields) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementTypeFields.Unmarshal(m, b)
@@ -2273,7 +2435,7 @@ func (m *StreamInfo) Reset()         { *m = StreamInfo{} }
 func (m *StreamInfo) String() string { return proto.CompactTextString(m) }
 func (*StreamInfo) ProtoMessage()    {}
 func (*StreamInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{27}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{29}
 }
 func (m *StreamInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamInfo.Unmarshal(m, b)
@@ -2360,7 +2522,7 @@ func (m *StreamInfos) Reset()         { *m = StreamInfos{} }
 func (m *StreamInfos) String() string { return proto.CompactTextString(m) }
 func (*StreamInfos) ProtoMessage()    {}
 func (*StreamInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{28}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{30}
 }
 func (m *StreamInfos) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamInfos.Unmarshal(m, b)
@@ -2400,7 +2562,7 @@ func (m *StreamMeasurementInfo) Reset()         { *m = StreamMeasurementInfo{} }
 func (m *StreamMeasurementInfo) String() string { return proto.CompactTextString(m) }
 func (*StreamMeasurementInfo) ProtoMessage()    {}
 func (*StreamMeasurementInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{29}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{31}
 }
 func (m *StreamMeasurementInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamMeasurementInfo.Unmarshal(m, b)
@@ -2454,7 +2616,7 @@ func (m *StreamCall) Reset()         { *m = StreamCall{} }
 func (m *StreamCall) String() string { return proto.CompactTextString(m) }
 func (*StreamCall) ProtoMessage()    {}
 func (*StreamCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{30}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{32}
 }
 func (m *StreamCall) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamCall.Unmarshal(m, b)
@@ -2511,7 +2673,7 @@ func (m *ColStoreInfo) Reset()         { *m = ColStoreInfo{} }
 func (m *ColStoreInfo) String() string { return proto.CompactTextString(m) }
 func (*ColStoreInfo) ProtoMessage()    {}
 func (*ColStoreInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{31}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{33}
 }
 func (m *ColStoreInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ColStoreInfo.Unmarshal(m, b)
@@ -2586,7 +2748,7 @@ func (m *IndexOption) Reset()         { *m = IndexOption{} }
 func (m *IndexOption) String() string { return proto.CompactTextString(m) }
 func (*IndexOption) ProtoMessage()    {}
 func (*IndexOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{32}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{34}
 }
 func (m *IndexOption) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexOption.Unmarshal(m, b)
@@ -2638,7 +2800,7 @@ func (m *IndexOptions) Reset()         { *m = IndexOptions{} }
 func (m *IndexOptions) String() string { return proto.CompactTextString(m) }
 func (*IndexOptions) ProtoMessage()    {}
 func (*IndexOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{33}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{35}
 }
 func (m *IndexOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexOptions.Unmarshal(m, b)
@@ -2677,7 +2839,7 @@ func (m *Command) Reset()         { *m = Command{} }
 func (m *Command) String() string { return proto.CompactTextString(m) }
 func (*Command) ProtoMessage()    {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{34}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{36}
 }
 
 var extRange_Command = []proto.ExtensionRange{
@@ -2729,7 +2891,7 @@ func (m *CreateDatabaseCommand) Reset()         { *m = CreateDatabaseCommand{} }
 func (m *CreateDatabaseCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabaseCommand) ProtoMessage()    {}
 func (*CreateDatabaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{35}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{37}
 }
 func (m *CreateDatabaseCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDatabaseCommand.Unmarshal(m, b)
@@ -2811,7 +2973,7 @@ func (m *DropDatabaseCommand) Reset()         { *m = DropDatabaseCommand{} }
 func (m *DropDatabaseCommand) String() string { return proto.CompactTextString(m) }
 func (*DropDatabaseCommand) ProtoMessage()    {}
 func (*DropDatabaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{36}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{38}
 }
 func (m *DropDatabaseCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropDatabaseCommand.Unmarshal(m, b)
@@ -2860,7 +3022,7 @@ func (m *CreateRetentionPolicyCommand) Reset()         { *m = CreateRetentionPol
 func (m *CreateRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateRetentionPolicyCommand) ProtoMessage()    {}
 func (*CreateRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{37}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{39}
 }
 func (m *CreateRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRetentionPolicyCommand.Unmarshal(m, b)
@@ -2922,7 +3084,7 @@ func (m *DropRetentionPolicyCommand) Reset()         { *m = DropRetentionPolicyC
 func (m *DropRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*DropRetentionPolicyCommand) ProtoMessage()    {}
 func (*DropRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{38}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{40}
 }
 func (m *DropRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropRetentionPolicyCommand.Unmarshal(m, b)
@@ -2977,7 +3139,7 @@ func (m *SetDefaultRetentionPolicyCommand) Reset()         { *m = SetDefaultRete
 func (m *SetDefaultRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*SetDefaultRetentionPolicyCommand) ProtoMessage()    {}
 func (*SetDefaultRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{39}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{41}
 }
 func (m *SetDefaultRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetDefaultRetentionPolicyCommand.Unmarshal(m, b)
@@ -3040,7 +3202,7 @@ func (m *UpdateRetentionPolicyCommand) Reset()         { *m = UpdateRetentionPol
 func (m *UpdateRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateRetentionPolicyCommand) ProtoMessage()    {}
 func (*UpdateRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{40}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{42}
 }
 func (m *UpdateRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateRetentionPolicyCommand.Unmarshal(m, b)
@@ -3155,7 +3317,7 @@ func (m *CreateShardGroupCommand) Reset()         { *m = CreateShardGroupCommand
 func (m *CreateShardGroupCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateShardGroupCommand) ProtoMessage()    {}
 func (*CreateShardGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{41}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{43}
 }
 func (m *CreateShardGroupCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateShardGroupCommand.Unmarshal(m, b)
@@ -3241,7 +3403,7 @@ func (m *DeleteShardGroupCommand) Reset()         { *m = DeleteShardGroupCommand
 func (m *DeleteShardGroupCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteShardGroupCommand) ProtoMessage()    {}
 func (*DeleteShardGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{42}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{44}
 }
 func (m *DeleteShardGroupCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteShardGroupCommand.Unmarshal(m, b)
@@ -3319,7 +3481,7 @@ func (m *CreateUserCommand) Reset()         { *m = CreateUserCommand{} }
 func (m *CreateUserCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateUserCommand) ProtoMessage()    {}
 func (*CreateUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{43}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{45}
 }
 func (m *CreateUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateUserCommand.Unmarshal(m, b)
@@ -3387,7 +3549,7 @@ func (m *DropUserCommand) Reset()         { *m = DropUserCommand{} }
 func (m *DropUserCommand) String() string { return proto.CompactTextString(m) }
 func (*DropUserCommand) ProtoMessage()    {}
 func (*DropUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{44}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{46}
 }
 func (m *DropUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropUserCommand.Unmarshal(m, b)
@@ -3435,7 +3597,7 @@ func (m *UpdateUserCommand) Reset()         { *m = UpdateUserCommand{} }
 func (m *UpdateUserCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateUserCommand) ProtoMessage()    {}
 func (*UpdateUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{45}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{47}
 }
 func (m *UpdateUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateUserCommand.Unmarshal(m, b)
@@ -3491,7 +3653,7 @@ func (m *SetPrivilegeCommand) Reset()         { *m = SetPrivilegeCommand{} }
 func (m *SetPrivilegeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetPrivilegeCommand) ProtoMessage()    {}
 func (*SetPrivilegeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{46}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{48}
 }
 func (m *SetPrivilegeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetPrivilegeCommand.Unmarshal(m, b)
@@ -3552,7 +3714,7 @@ func (m *SetDataCommand) Reset()         { *m = SetDataCommand{} }
 func (m *SetDataCommand) String() string { return proto.CompactTextString(m) }
 func (*SetDataCommand) ProtoMessage()    {}
 func (*SetDataCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{47}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{49}
 }
 func (m *SetDataCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetDataCommand.Unmarshal(m, b)
@@ -3600,7 +3762,7 @@ func (m *SetAdminPrivilegeCommand) Reset()         { *m = SetAdminPrivilegeComma
 func (m *SetAdminPrivilegeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetAdminPrivilegeCommand) ProtoMessage()    {}
 func (*SetAdminPrivilegeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{48}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{50}
 }
 func (m *SetAdminPrivilegeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAdminPrivilegeCommand.Unmarshal(m, b)
@@ -3658,7 +3820,7 @@ func (m *CreateSubscriptionCommand) Reset()         { *m = CreateSubscriptionCom
 func (m *CreateSubscriptionCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateSubscriptionCommand) ProtoMessage()    {}
 func (*CreateSubscriptionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{49}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{51}
 }
 func (m *CreateSubscriptionCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSubscriptionCommand.Unmarshal(m, b)
@@ -3735,7 +3897,7 @@ func (m *DropSubscriptionCommand) Reset()         { *m = DropSubscriptionCommand
 func (m *DropSubscriptionCommand) String() string { return proto.CompactTextString(m) }
 func (*DropSubscriptionCommand) ProtoMessage()    {}
 func (*DropSubscriptionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{50}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{52}
 }
 func (m *DropSubscriptionCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropSubscriptionCommand.Unmarshal(m, b)
@@ -3799,7 +3961,7 @@ func (m *CreateMetaNodeCommand) Reset()         { *m = CreateMetaNodeCommand{} }
 func (m *CreateMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateMetaNodeCommand) ProtoMessage()    {}
 func (*CreateMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{51}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{53}
 }
 func (m *CreateMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateMetaNodeCommand.Unmarshal(m, b)
@@ -3869,7 +4031,7 @@ func (m *CreateDataNodeCommand) Reset()         { *m = CreateDataNodeCommand{} }
 func (m *CreateDataNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDataNodeCommand) ProtoMessage()    {}
 func (*CreateDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{52}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{54}
 }
 func (m *CreateDataNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDataNodeCommand.Unmarshal(m, b)
@@ -3932,7 +4094,7 @@ func (m *DataNodeEvent) Reset()         { *m = DataNodeEvent{} }
 func (m *DataNodeEvent) String() string { return proto.CompactTextString(m) }
 func (*DataNodeEvent) ProtoMessage()    {}
 func (*DataNodeEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{53}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{55}
 }
 func (m *DataNodeEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataNodeEvent.Unmarshal(m, b)
@@ -3984,7 +4146,7 @@ func (m *DeleteMetaNodeCommand) Reset()         { *m = DeleteMetaNodeCommand{} }
 func (m *DeleteMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteMetaNodeCommand) ProtoMessage()    {}
 func (*DeleteMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{54}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{56}
 }
 func (m *DeleteMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteMetaNodeCommand.Unmarshal(m, b)
@@ -4031,7 +4193,7 @@ func (m *DeleteDataNodeCommand) Reset()         { *m = DeleteDataNodeCommand{} }
 func (m *DeleteDataNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteDataNodeCommand) ProtoMessage()    {}
 func (*DeleteDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{55}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{57}
 }
 func (m *DeleteDataNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteDataNodeCommand.Unmarshal(m, b)
@@ -4080,7 +4242,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{56}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{58}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response.Unmarshal(m, b)
@@ -4137,7 +4299,7 @@ func (m *SetMetaNodeCommand) Reset()         { *m = SetMetaNodeCommand{} }
 func (m *SetMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetMetaNodeCommand) ProtoMessage()    {}
 func (*SetMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{57}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{59}
 }
 func (m *SetMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMetaNodeCommand.Unmarshal(m, b)
@@ -4205,7 +4367,7 @@ func (m *DropShardCommand) Reset()         { *m = DropShardCommand{} }
 func (m *DropShardCommand) String() string { return proto.CompactTextString(m) }
 func (*DropShardCommand) ProtoMessage()    {}
 func (*DropShardCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{58}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{60}
 }
 func (m *DropShardCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropShardCommand.Unmarshal(m, b)
@@ -4252,7 +4414,7 @@ func (m *MarkDatabaseDeleteCommand) Reset()         { *m = MarkDatabaseDeleteCom
 func (m *MarkDatabaseDeleteCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkDatabaseDeleteCommand) ProtoMessage()    {}
 func (*MarkDatabaseDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{59}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{61}
 }
 func (m *MarkDatabaseDeleteCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkDatabaseDeleteCommand.Unmarshal(m, b)
@@ -4302,7 +4464,7 @@ func (m *UpdateShardOwnerCommand) Reset()         { *m = UpdateShardOwnerCommand
 func (m *UpdateShardOwnerCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateShardOwnerCommand) ProtoMessage()    {}
 func (*UpdateShardOwnerCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{60}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{62}
 }
 func (m *UpdateShardOwnerCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateShardOwnerCommand.Unmarshal(m, b)
@@ -4371,7 +4533,7 @@ func (m *MarkRetentionPolicyDeleteCommand) Reset()         { *m = MarkRetentionP
 func (m *MarkRetentionPolicyDeleteCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkRetentionPolicyDeleteCommand) ProtoMessage()    {}
 func (*MarkRetentionPolicyDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{61}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{63}
 }
 func (m *MarkRetentionPolicyDeleteCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkRetentionPolicyDeleteCommand.Unmarshal(m, b)
@@ -4434,7 +4596,7 @@ func (m *CreateMeasurementCommand) Reset()         { *m = CreateMeasurementComma
 func (m *CreateMeasurementCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateMeasurementCommand) ProtoMessage()    {}
 func (*CreateMeasurementCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{62}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{64}
 }
 func (m *CreateMeasurementCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateMeasurementCommand.Unmarshal(m, b)
@@ -4547,7 +4709,7 @@ func (m *AlterShardKeyCmd) Reset()         { *m = AlterShardKeyCmd{} }
 func (m *AlterShardKeyCmd) String() string { return proto.CompactTextString(m) }
 func (*AlterShardKeyCmd) ProtoMessage()    {}
 func (*AlterShardKeyCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{63}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{65}
 }
 func (m *AlterShardKeyCmd) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AlterShardKeyCmd.Unmarshal(m, b)
@@ -4619,7 +4781,7 @@ func (m *UpdateDbPtStatusCommand) Reset()         { *m = UpdateDbPtStatusCommand
 func (m *UpdateDbPtStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateDbPtStatusCommand) ProtoMessage()    {}
 func (*UpdateDbPtStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{64}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{66}
 }
 func (m *UpdateDbPtStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateDbPtStatusCommand.Unmarshal(m, b)
@@ -4698,7 +4860,7 @@ func (m *ReShardingCommand) Reset()         { *m = ReShardingCommand{} }
 func (m *ReShardingCommand) String() string { return proto.CompactTextString(m) }
 func (*ReShardingCommand) ProtoMessage()    {}
 func (*ReShardingCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{65}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{67}
 }
 func (m *ReShardingCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReShardingCommand.Unmarshal(m, b)
@@ -4776,7 +4938,7 @@ func (m *UpdateSchemaCommand) Reset()         { *m = UpdateSchemaCommand{} }
 func (m *UpdateSchemaCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateSchemaCommand) ProtoMessage()    {}
 func (*UpdateSchemaCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{66}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{68}
 }
 func (m *UpdateSchemaCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateSchemaCommand.Unmarshal(m, b)
@@ -4845,7 +5007,7 @@ func (m *FieldSchema) Reset()         { *m = FieldSchema{} }
 func (m *FieldSchema) String() string { return proto.CompactTextString(m) }
 func (*FieldSchema) ProtoMessage()    {}
 func (*FieldSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{67}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{69}
 }
 func (m *FieldSchema) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FieldSchema.Unmarshal(m, b)
@@ -4892,7 +5054,7 @@ func (m *IndexInfo) Reset()         { *m = IndexInfo{} }
 func (m *IndexInfo) String() string { return proto.CompactTextString(m) }
 func (*IndexInfo) ProtoMessage()    {}
 func (*IndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{68}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{70}
 }
 func (m *IndexInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexInfo.Unmarshal(m, b)
@@ -4949,7 +5111,7 @@ func (m *IndexGroupInfo) Reset()         { *m = IndexGroupInfo{} }
 func (m *IndexGroupInfo) String() string { return proto.CompactTextString(m) }
 func (*IndexGroupInfo) ProtoMessage()    {}
 func (*IndexGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{69}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{71}
 }
 func (m *IndexGroupInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexGroupInfo.Unmarshal(m, b)
@@ -5025,7 +5187,7 @@ func (m *ShardStatus) Reset()         { *m = ShardStatus{} }
 func (m *ShardStatus) String() string { return proto.CompactTextString(m) }
 func (*ShardStatus) ProtoMessage()    {}
 func (*ShardStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{70}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{72}
 }
 func (m *ShardStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardStatus.Unmarshal(m, b)
@@ -5085,7 +5247,7 @@ func (m *RpShardStatus) Reset()         { *m = RpShardStatus{} }
 func (m *RpShardStatus) String() string { return proto.CompactTextString(m) }
 func (*RpShardStatus) ProtoMessage()    {}
 func (*RpShardStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{71}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{73}
 }
 func (m *RpShardStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RpShardStatus.Unmarshal(m, b)
@@ -5132,7 +5294,7 @@ func (m *DBPtStatus) Reset()         { *m = DBPtStatus{} }
 func (m *DBPtStatus) String() string { return proto.CompactTextString(m) }
 func (*DBPtStatus) ProtoMessage()    {}
 func (*DBPtStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{72}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{74}
 }
 func (m *DBPtStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DBPtStatus.Unmarshal(m, b)
@@ -5184,7 +5346,7 @@ func (m *ReportShardsLoadCommand) Reset()         { *m = ReportShardsLoadCommand
 func (m *ReportShardsLoadCommand) String() string { return proto.CompactTextString(m) }
 func (*ReportShardsLoadCommand) ProtoMessage()    {}
 func (*ReportShardsLoadCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{73}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{75}
 }
 func (m *ReportShardsLoadCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReportShardsLoadCommand.Unmarshal(m, b)
@@ -5234,7 +5396,7 @@ func (m *DownSamplePolicyInfo) Reset()         { *m = DownSamplePolicyInfo{} }
 func (m *DownSamplePolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*DownSamplePolicyInfo) ProtoMessage()    {}
 func (*DownSamplePolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{74}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{76}
 }
 func (m *DownSamplePolicyInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSamplePolicyInfo.Unmarshal(m, b)
@@ -5295,7 +5457,7 @@ func (m *DownSamplePolicy) Reset()         { *m = DownSamplePolicy{} }
 func (m *DownSamplePolicy) String() string { return proto.CompactTextString(m) }
 func (*DownSamplePolicy) ProtoMessage()    {}
 func (*DownSamplePolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{75}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{77}
 }
 func (m *DownSamplePolicy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSamplePolicy.Unmarshal(m, b)
@@ -5348,7 +5510,7 @@ func (m *DownSampleOperators) Reset()         { *m = DownSampleOperators{} }
 func (m *DownSampleOperators) String() string { return proto.CompactTextString(m) }
 func (*DownSampleOperators) ProtoMessage()    {}
 func (*DownSampleOperators) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{76}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{78}
 }
 func (m *DownSampleOperators) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSampleOperators.Unmarshal(m, b)
@@ -5395,7 +5557,7 @@ func (m *DownSamplePolicyInfoWithDbRp) Reset()         { *m = DownSamplePolicyIn
 func (m *DownSamplePolicyInfoWithDbRp) String() string { return proto.CompactTextString(m) }
 func (*DownSamplePolicyInfoWithDbRp) ProtoMessage()    {}
 func (*DownSamplePolicyInfoWithDbRp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{77}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{79}
 }
 func (m *DownSamplePolicyInfoWithDbRp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSamplePolicyInfoWithDbRp.Unmarshal(m, b)
@@ -5447,7 +5609,7 @@ func (m *DownSamplePoliciesInfoWithDbRp) Reset()         { *m = DownSamplePolici
 func (m *DownSamplePoliciesInfoWithDbRp) String() string { return proto.CompactTextString(m) }
 func (*DownSamplePoliciesInfoWithDbRp) ProtoMessage()    {}
 func (*DownSamplePoliciesInfoWithDbRp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{78}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{80}
 }
 func (m *DownSamplePoliciesInfoWithDbRp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSamplePoliciesInfoWithDbRp.Unmarshal(m, b)
@@ -5485,7 +5647,7 @@ func (m *ShardDownSampleUpdateInfos) Reset()         { *m = ShardDownSampleUpdat
 func (m *ShardDownSampleUpdateInfos) String() string { return proto.CompactTextString(m) }
 func (*ShardDownSampleUpdateInfos) ProtoMessage()    {}
 func (*ShardDownSampleUpdateInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{79}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{81}
 }
 func (m *ShardDownSampleUpdateInfos) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDownSampleUpdateInfos.Unmarshal(m, b)
@@ -5524,7 +5686,7 @@ func (m *ShardDownSampleUpdateInfo) Reset()         { *m = ShardDownSampleUpdate
 func (m *ShardDownSampleUpdateInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardDownSampleUpdateInfo) ProtoMessage()    {}
 func (*ShardDownSampleUpdateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{80}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{82}
 }
 func (m *ShardDownSampleUpdateInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDownSampleUpdateInfo.Unmarshal(m, b)
@@ -5570,7 +5732,7 @@ func (m *PruneGroupsCommand) Reset()         { *m = PruneGroupsCommand{} }
 func (m *PruneGroupsCommand) String() string { return proto.CompactTextString(m) }
 func (*PruneGroupsCommand) ProtoMessage()    {}
 func (*PruneGroupsCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{81}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{83}
 }
 func (m *PruneGroupsCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PruneGroupsCommand.Unmarshal(m, b)
@@ -5626,7 +5788,7 @@ func (m *MarkMeasurementDeleteCommand) Reset()         { *m = MarkMeasurementDel
 func (m *MarkMeasurementDeleteCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkMeasurementDeleteCommand) ProtoMessage()    {}
 func (*MarkMeasurementDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{82}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{84}
 }
 func (m *MarkMeasurementDeleteCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkMeasurementDeleteCommand.Unmarshal(m, b)
@@ -5689,7 +5851,7 @@ func (m *DropMeasurementCommand) Reset()         { *m = DropMeasurementCommand{}
 func (m *DropMeasurementCommand) String() string { return proto.CompactTextString(m) }
 func (*DropMeasurementCommand) ProtoMessage()    {}
 func (*DropMeasurementCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{83}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{85}
 }
 func (m *DropMeasurementCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropMeasurementCommand.Unmarshal(m, b)
@@ -5756,7 +5918,7 @@ func (m *NodeStartInfo) Reset()         { *m = NodeStartInfo{} }
 func (m *NodeStartInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeStartInfo) ProtoMessage()    {}
 func (*NodeStartInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{84}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{86}
 }
 func (m *NodeStartInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeStartInfo.Unmarshal(m, b)
@@ -5838,7 +6000,7 @@ func (m *TimeRangeCommand) Reset()         { *m = TimeRangeCommand{} }
 func (m *TimeRangeCommand) String() string { return proto.CompactTextString(m) }
 func (*TimeRangeCommand) ProtoMessage()    {}
 func (*TimeRangeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{85}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{87}
 }
 func (m *TimeRangeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TimeRangeCommand.Unmarshal(m, b)
@@ -5901,7 +6063,7 @@ func (m *ShardDurationCommand) Reset()         { *m = ShardDurationCommand{} }
 func (m *ShardDurationCommand) String() string { return proto.CompactTextString(m) }
 func (*ShardDurationCommand) ProtoMessage()    {}
 func (*ShardDurationCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{86}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{88}
 }
 func (m *ShardDurationCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDurationCommand.Unmarshal(m, b)
@@ -5964,7 +6126,7 @@ func (m *DurationDescriptor) Reset()         { *m = DurationDescriptor{} }
 func (m *DurationDescriptor) String() string { return proto.CompactTextString(m) }
 func (*DurationDescriptor) ProtoMessage()    {}
 func (*DurationDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{87}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{89}
 }
 func (m *DurationDescriptor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DurationDescriptor.Unmarshal(m, b)
@@ -6025,7 +6187,7 @@ func (m *ShardIdentifier) Reset()         { *m = ShardIdentifier{} }
 func (m *ShardIdentifier) String() string { return proto.CompactTextString(m) }
 func (*ShardIdentifier) ProtoMessage()    {}
 func (*ShardIdentifier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{88}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{90}
 }
 func (m *ShardIdentifier) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardIdentifier.Unmarshal(m, b)
@@ -6127,7 +6289,7 @@ func (m *TimeRangeInfo) Reset()         { *m = TimeRangeInfo{} }
 func (m *TimeRangeInfo) String() string { return proto.CompactTextString(m) }
 func (*TimeRangeInfo) ProtoMessage()    {}
 func (*TimeRangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{89}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{91}
 }
 func (m *TimeRangeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TimeRangeInfo.Unmarshal(m, b)
@@ -6174,7 +6336,7 @@ func (m *IndexDescriptor) Reset()         { *m = IndexDescriptor{} }
 func (m *IndexDescriptor) String() string { return proto.CompactTextString(m) }
 func (*IndexDescriptor) ProtoMessage()    {}
 func (*IndexDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{90}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{92}
 }
 func (m *IndexDescriptor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexDescriptor.Unmarshal(m, b)
@@ -6228,7 +6390,7 @@ func (m *ShardDurationInfo) Reset()         { *m = ShardDurationInfo{} }
 func (m *ShardDurationInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardDurationInfo) ProtoMessage()    {}
 func (*ShardDurationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{91}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{93}
 }
 func (m *ShardDurationInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDurationInfo.Unmarshal(m, b)
@@ -6283,7 +6445,7 @@ func (m *ShardTimeRangeInfo) Reset()         { *m = ShardTimeRangeInfo{} }
 func (m *ShardTimeRangeInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardTimeRangeInfo) ProtoMessage()    {}
 func (*ShardTimeRangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{92}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{94}
 }
 func (m *ShardTimeRangeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardTimeRangeInfo.Unmarshal(m, b)
@@ -6343,7 +6505,7 @@ func (m *ShardDurationResponse) Reset()         { *m = ShardDurationResponse{} }
 func (m *ShardDurationResponse) String() string { return proto.CompactTextString(m) }
 func (*ShardDurationResponse) ProtoMessage()    {}
 func (*ShardDurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{93}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{95}
 }
 func (m *ShardDurationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDurationResponse.Unmarshal(m, b)
@@ -6390,7 +6552,7 @@ func (m *DeleteIndexGroupCommand) Reset()         { *m = DeleteIndexGroupCommand
 func (m *DeleteIndexGroupCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteIndexGroupCommand) ProtoMessage()    {}
 func (*DeleteIndexGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{94}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{96}
 }
 func (m *DeleteIndexGroupCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteIndexGroupCommand.Unmarshal(m, b)
@@ -6454,7 +6616,7 @@ func (m *UpdateShardInfoTierCommand) Reset()         { *m = UpdateShardInfoTierC
 func (m *UpdateShardInfoTierCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateShardInfoTierCommand) ProtoMessage()    {}
 func (*UpdateShardInfoTierCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{95}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{97}
 }
 func (m *UpdateShardInfoTierCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateShardInfoTierCommand.Unmarshal(m, b)
@@ -6523,7 +6685,7 @@ func (m *CardinalityInfo) Reset()         { *m = CardinalityInfo{} }
 func (m *CardinalityInfo) String() string { return proto.CompactTextString(m) }
 func (*CardinalityInfo) ProtoMessage()    {}
 func (*CardinalityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{96}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{98}
 }
 func (m *CardinalityInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardinalityInfo.Unmarshal(m, b)
@@ -6569,7 +6731,7 @@ func (m *MeasurementCardinalityInfo) Reset()         { *m = MeasurementCardinali
 func (m *MeasurementCardinalityInfo) String() string { return proto.CompactTextString(m) }
 func (*MeasurementCardinalityInfo) ProtoMessage()    {}
 func (*MeasurementCardinalityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{97}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{99}
 }
 func (m *MeasurementCardinalityInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementCardinalityInfo.Unmarshal(m, b)
@@ -6615,7 +6777,7 @@ func (m *CardinalityResponse) Reset()         { *m = CardinalityResponse{} }
 func (m *CardinalityResponse) String() string { return proto.CompactTextString(m) }
 func (*CardinalityResponse) ProtoMessage()    {}
 func (*CardinalityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{98}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{100}
 }
 func (m *CardinalityResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardinalityResponse.Unmarshal(m, b)
@@ -6663,7 +6825,7 @@ func (m *UpdateNodeStatusCommand) Reset()         { *m = UpdateNodeStatusCommand
 func (m *UpdateNodeStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateNodeStatusCommand) ProtoMessage()    {}
 func (*UpdateNodeStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{99}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{101}
 }
 func (m *UpdateNodeStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNodeStatusCommand.Unmarshal(m, b)
@@ -6734,7 +6896,7 @@ func (m *DbPt) Reset()         { *m = DbPt{} }
 func (m *DbPt) String() string { return proto.CompactTextString(m) }
 func (*DbPt) ProtoMessage()    {}
 func (*DbPt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{100}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{102}
 }
 func (m *DbPt) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DbPt.Unmarshal(m, b)
@@ -6802,7 +6964,7 @@ func (m *MigrateEventInfo) Reset()         { *m = MigrateEventInfo{} }
 func (m *MigrateEventInfo) String() string { return proto.CompactTextString(m) }
 func (*MigrateEventInfo) ProtoMessage()    {}
 func (*MigrateEventInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{101}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{103}
 }
 func (m *MigrateEventInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MigrateEventInfo.Unmarshal(m, b)
@@ -6903,7 +7065,7 @@ func (m *CreateEventCommand) Reset()         { *m = CreateEventCommand{} }
 func (m *CreateEventCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateEventCommand) ProtoMessage()    {}
 func (*CreateEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{102}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{104}
 }
 func (m *CreateEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateEventCommand.Unmarshal(m, b)
@@ -6950,7 +7112,7 @@ func (m *UpdateEventCommand) Reset()         { *m = UpdateEventCommand{} }
 func (m *UpdateEventCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateEventCommand) ProtoMessage()    {}
 func (*UpdateEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{103}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{105}
 }
 func (m *UpdateEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateEventCommand.Unmarshal(m, b)
@@ -7000,7 +7162,7 @@ func (m *UpdatePtInfoCommand) Reset()         { *m = UpdatePtInfoCommand{} }
 func (m *UpdatePtInfoCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdatePtInfoCommand) ProtoMessage()    {}
 func (*UpdatePtInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{104}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{106}
 }
 func (m *UpdatePtInfoCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdatePtInfoCommand.Unmarshal(m, b)
@@ -7068,7 +7230,7 @@ func (m *RemoveEventCommand) Reset()         { *m = RemoveEventCommand{} }
 func (m *RemoveEventCommand) String() string { return proto.CompactTextString(m) }
 func (*RemoveEventCommand) ProtoMessage()    {}
 func (*RemoveEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{105}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{107}
 }
 func (m *RemoveEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveEventCommand.Unmarshal(m, b)
@@ -7117,7 +7279,7 @@ func (m *CreateDownSamplePolicyCommand) Reset()         { *m = CreateDownSampleP
 func (m *CreateDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDownSamplePolicyCommand) ProtoMessage()    {}
 func (*CreateDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{106}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{108}
 }
 func (m *CreateDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDownSamplePolicyCommand.Unmarshal(m, b)
@@ -7180,7 +7342,7 @@ func (m *DropDownSamplePolicyCommand) Reset()         { *m = DropDownSamplePolic
 func (m *DropDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*DropDownSamplePolicyCommand) ProtoMessage()    {}
 func (*DropDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{107}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{109}
 }
 func (m *DropDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropDownSamplePolicyCommand.Unmarshal(m, b)
@@ -7240,7 +7402,7 @@ func (m *GetDownSamplePolicyCommand) Reset()         { *m = GetDownSamplePolicyC
 func (m *GetDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*GetDownSamplePolicyCommand) ProtoMessage()    {}
 func (*GetDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{108}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{110}
 }
 func (m *GetDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDownSamplePolicyCommand.Unmarshal(m, b)
@@ -7281,7 +7443,7 @@ func (m *CreateDbPtViewCommand) Reset()         { *m = CreateDbPtViewCommand{} }
 func (m *CreateDbPtViewCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDbPtViewCommand) ProtoMessage()    {}
 func (*CreateDbPtViewCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{109}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{111}
 }
 func (m *CreateDbPtViewCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDbPtViewCommand.Unmarshal(m, b)
@@ -7337,7 +7499,7 @@ func (m *GetMeasurementInfoWithinSameRpCommand) Reset()         { *m = GetMeasur
 func (m *GetMeasurementInfoWithinSameRpCommand) String() string { return proto.CompactTextString(m) }
 func (*GetMeasurementInfoWithinSameRpCommand) ProtoMessage()    {}
 func (*GetMeasurementInfoWithinSameRpCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{110}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{112}
 }
 func (m *GetMeasurementInfoWithinSameRpCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand.Unmarshal(m, b)
@@ -7398,7 +7560,7 @@ func (m *UpdateShardDownSampleInfoCommand) Reset()         { *m = UpdateShardDow
 func (m *UpdateShardDownSampleInfoCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateShardDownSampleInfoCommand) ProtoMessage()    {}
 func (*UpdateShardDownSampleInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{111}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{113}
 }
 func (m *UpdateShardDownSampleInfoCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateShardDownSampleInfoCommand.Unmarshal(m, b)
@@ -7445,7 +7607,7 @@ func (m *MarkTakeoverCommand) Reset()         { *m = MarkTakeoverCommand{} }
 func (m *MarkTakeoverCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkTakeoverCommand) ProtoMessage()    {}
 func (*MarkTakeoverCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{112}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{114}
 }
 func (m *MarkTakeoverCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkTakeoverCommand.Unmarshal(m, b)
@@ -7492,7 +7654,7 @@ func (m *MarkBalancerCommand) Reset()         { *m = MarkBalancerCommand{} }
 func (m *MarkBalancerCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkBalancerCommand) ProtoMessage()    {}
 func (*MarkBalancerCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{113}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{115}
 }
 func (m *MarkBalancerCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkBalancerCommand.Unmarshal(m, b)
@@ -7539,7 +7701,7 @@ func (m *CreateStreamCommand) Reset()         { *m = CreateStreamCommand{} }
 func (m *CreateStreamCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateStreamCommand) ProtoMessage()    {}
 func (*CreateStreamCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{114}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{116}
 }
 func (m *CreateStreamCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateStreamCommand.Unmarshal(m, b)
@@ -7586,7 +7748,7 @@ func (m *DropStreamCommand) Reset()         { *m = DropStreamCommand{} }
 func (m *DropStreamCommand) String() string { return proto.CompactTextString(m) }
 func (*DropStreamCommand) ProtoMessage()    {}
 func (*DropStreamCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{115}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{117}
 }
 func (m *DropStreamCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropStreamCommand.Unmarshal(m, b)
@@ -7635,7 +7797,7 @@ func (m *GetMeasurementInfoStoreCommand) Reset()         { *m = GetMeasurementIn
 func (m *GetMeasurementInfoStoreCommand) String() string { return proto.CompactTextString(m) }
 func (*GetMeasurementInfoStoreCommand) ProtoMessage()    {}
 func (*GetMeasurementInfoStoreCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{116}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{118}
 }
 func (m *GetMeasurementInfoStoreCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMeasurementInfoStoreCommand.Unmarshal(m, b)
@@ -7696,7 +7858,7 @@ func (m *VerifyDataNodeCommand) Reset()         { *m = VerifyDataNodeCommand{} }
 func (m *VerifyDataNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*VerifyDataNodeCommand) ProtoMessage()    {}
 func (*VerifyDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{117}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{119}
 }
 func (m *VerifyDataNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyDataNodeCommand.Unmarshal(m, b)
@@ -7742,7 +7904,7 @@ func (m *ExpandGroupsCommand) Reset()         { *m = ExpandGroupsCommand{} }
 func (m *ExpandGroupsCommand) String() string { return proto.CompactTextString(m) }
 func (*ExpandGroupsCommand) ProtoMessage()    {}
 func (*ExpandGroupsCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{118}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{120}
 }
 func (m *ExpandGroupsCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExpandGroupsCommand.Unmarshal(m, b)
@@ -7783,7 +7945,7 @@ func (m *UpdatePtVersionCommand) Reset()         { *m = UpdatePtVersionCommand{}
 func (m *UpdatePtVersionCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdatePtVersionCommand) ProtoMessage()    {}
 func (*UpdatePtVersionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{119}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{121}
 }
 func (m *UpdatePtVersionCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdatePtVersionCommand.Unmarshal(m, b)
@@ -7838,7 +8000,7 @@ func (m *GetMeasurementsInfoCommand) Reset()         { *m = GetMeasurementsInfoC
 func (m *GetMeasurementsInfoCommand) String() string { return proto.CompactTextString(m) }
 func (*GetMeasurementsInfoCommand) ProtoMessage()    {}
 func (*GetMeasurementsInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{120}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{122}
 }
 func (m *GetMeasurementsInfoCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMeasurementsInfoCommand.Unmarshal(m, b)
@@ -7893,7 +8055,7 @@ func (m *DatabaseBriefInfo) Reset()         { *m = DatabaseBriefInfo{} }
 func (m *DatabaseBriefInfo) String() string { return proto.CompactTextString(m) }
 func (*DatabaseBriefInfo) ProtoMessage()    {}
 func (*DatabaseBriefInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{121}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{123}
 }
 func (m *DatabaseBriefInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DatabaseBriefInfo.Unmarshal(m, b)
@@ -7938,7 +8100,7 @@ func (m *MeasurementsInfo) Reset()         { *m = MeasurementsInfo{} }
 func (m *MeasurementsInfo) String() string { return proto.CompactTextString(m) }
 func (*MeasurementsInfo) ProtoMessage()    {}
 func (*MeasurementsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{122}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{124}
 }
 func (m *MeasurementsInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementsInfo.Unmarshal(m, b)
@@ -7976,7 +8138,7 @@ func (m *RegisterQueryIDOffsetCommand) Reset()         { *m = RegisterQueryIDOff
 func (m *RegisterQueryIDOffsetCommand) String() string { return proto.CompactTextString(m) }
 func (*RegisterQueryIDOffsetCommand) ProtoMessage()    {}
 func (*RegisterQueryIDOffsetCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{123}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{125}
 }
 func (m *RegisterQueryIDOffsetCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegisterQueryIDOffsetCommand.Unmarshal(m, b)
@@ -8025,7 +8187,7 @@ func (m *CreateContinuousQueryCommand) Reset()         { *m = CreateContinuousQu
 func (m *CreateContinuousQueryCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateContinuousQueryCommand) ProtoMessage()    {}
 func (*CreateContinuousQueryCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{124}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{126}
 }
 func (m *CreateContinuousQueryCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateContinuousQueryCommand.Unmarshal(m, b)
@@ -8086,7 +8248,7 @@ func (m *Sql2MetaHeartbeatCommand) Reset()         { *m = Sql2MetaHeartbeatComma
 func (m *Sql2MetaHeartbeatCommand) String() string { return proto.CompactTextString(m) }
 func (*Sql2MetaHeartbeatCommand) ProtoMessage()    {}
 func (*Sql2MetaHeartbeatCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{125}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{127}
 }
 func (m *Sql2MetaHeartbeatCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Sql2MetaHeartbeatCommand.Unmarshal(m, b)
@@ -8133,7 +8295,7 @@ func (m *ContinuousQueryReportCommand) Reset()         { *m = ContinuousQueryRep
 func (m *ContinuousQueryReportCommand) String() string { return proto.CompactTextString(m) }
 func (*ContinuousQueryReportCommand) ProtoMessage()    {}
 func (*ContinuousQueryReportCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{126}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{128}
 }
 func (m *ContinuousQueryReportCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContinuousQueryReportCommand.Unmarshal(m, b)
@@ -8181,7 +8343,7 @@ func (m *CQState) Reset()         { *m = CQState{} }
 func (m *CQState) String() string { return proto.CompactTextString(m) }
 func (*CQState) ProtoMessage()    {}
 func (*CQState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{127}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{129}
 }
 func (m *CQState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CQState.Unmarshal(m, b)
@@ -8226,7 +8388,7 @@ func (m *GetContinuousQueryLeaseCommand) Reset()         { *m = GetContinuousQue
 func (m *GetContinuousQueryLeaseCommand) String() string { return proto.CompactTextString(m) }
 func (*GetContinuousQueryLeaseCommand) ProtoMessage()    {}
 func (*GetContinuousQueryLeaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{128}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{130}
 }
 func (m *GetContinuousQueryLeaseCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetContinuousQueryLeaseCommand.Unmarshal(m, b)
@@ -8274,7 +8436,7 @@ func (m *DropContinuousQueryCommand) Reset()         { *m = DropContinuousQueryC
 func (m *DropContinuousQueryCommand) String() string { return proto.CompactTextString(m) }
 func (*DropContinuousQueryCommand) ProtoMessage()    {}
 func (*DropContinuousQueryCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{129}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{131}
 }
 func (m *DropContinuousQueryCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropContinuousQueryCommand.Unmarshal(m, b)
@@ -8327,7 +8489,7 @@ func (m *NotifyCQLeaseChangedCommand) Reset()         { *m = NotifyCQLeaseChange
 func (m *NotifyCQLeaseChangedCommand) String() string { return proto.CompactTextString(m) }
 func (*NotifyCQLeaseChangedCommand) ProtoMessage()    {}
 func (*NotifyCQLeaseChangedCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{130}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{132}
 }
 func (m *NotifyCQLeaseChangedCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotifyCQLeaseChangedCommand.Unmarshal(m, b)
@@ -8368,7 +8530,7 @@ func (m *SetNodeSegregateStatusCommand) Reset()         { *m = SetNodeSegregateS
 func (m *SetNodeSegregateStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*SetNodeSegregateStatusCommand) ProtoMessage()    {}
 func (*SetNodeSegregateStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{131}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{133}
 }
 func (m *SetNodeSegregateStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetNodeSegregateStatusCommand.Unmarshal(m, b)
@@ -8422,7 +8584,7 @@ func (m *RemoveNodeCommand) Reset()         { *m = RemoveNodeCommand{} }
 func (m *RemoveNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*RemoveNodeCommand) ProtoMessage()    {}
 func (*RemoveNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{132}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{134}
 }
 func (m *RemoveNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveNodeCommand.Unmarshal(m, b)
@@ -8473,7 +8635,7 @@ func (m *UpdateReplicationCommand) Reset()         { *m = UpdateReplicationComma
 func (m *UpdateReplicationCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateReplicationCommand) ProtoMessage()    {}
 func (*UpdateReplicationCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{133}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{135}
 }
 func (m *UpdateReplicationCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateReplicationCommand.Unmarshal(m, b)
@@ -8553,7 +8715,7 @@ func (m *ObsOptions) Reset()         { *m = ObsOptions{} }
 func (m *ObsOptions) String() string { return proto.CompactTextString(m) }
 func (*ObsOptions) ProtoMessage()    {}
 func (*ObsOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{134}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{136}
 }
 func (m *ObsOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObsOptions.Unmarshal(m, b)
@@ -8633,7 +8795,7 @@ func (m *Options) Reset()         { *m = Options{} }
 func (m *Options) String() string { return proto.CompactTextString(m) }
 func (*Options) ProtoMessage()    {}
 func (*Options) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{135}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{137}
 }
 func (m *Options) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Options.Unmarshal(m, b)
@@ -8723,7 +8885,7 @@ func (m *UpdateMeasurementCommand) Reset()         { *m = UpdateMeasurementComma
 func (m *UpdateMeasurementCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateMeasurementCommand) ProtoMessage()    {}
 func (*UpdateMeasurementCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{136}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{138}
 }
 func (m *UpdateMeasurementCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateMeasurementCommand.Unmarshal(m, b)
@@ -8795,7 +8957,7 @@ func (m *DataOps) Reset()         { *m = DataOps{} }
 func (m *DataOps) String() string { return proto.CompactTextString(m) }
 func (*DataOps) ProtoMessage()    {}
 func (*DataOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{137}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{139}
 }
 func (m *DataOps) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataOps.Unmarshal(m, b)
@@ -8862,7 +9024,7 @@ func (m *CreateSqlNodeCommand) Reset()         { *m = CreateSqlNodeCommand{} }
 func (m *CreateSqlNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateSqlNodeCommand) ProtoMessage()    {}
 func (*CreateSqlNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{138}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{140}
 }
 func (m *CreateSqlNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSqlNodeCommand.Unmarshal(m, b)
@@ -8919,7 +9081,7 @@ func (m *UpdateSqlNodeStatusCommand) Reset()         { *m = UpdateSqlNodeStatusC
 func (m *UpdateSqlNodeStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateSqlNodeStatusCommand) ProtoMessage()    {}
 func (*UpdateSqlNodeStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{139}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{141}
 }
 func (m *UpdateSqlNodeStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateSqlNodeStatusCommand.Unmarshal(m, b)
@@ -8989,7 +9151,7 @@ func (m *UpdateNodeTmpIndexCommand) Reset()         { *m = UpdateNodeTmpIndexCom
 func (m *UpdateNodeTmpIndexCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateNodeTmpIndexCommand) ProtoMessage()    {}
 func (*UpdateNodeTmpIndexCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{140}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{142}
 }
 func (m *UpdateNodeTmpIndexCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNodeTmpIndexCommand.Unmarshal(m, b)
@@ -9061,7 +9223,7 @@ func (m *FileInfo) Reset()         { *m = FileInfo{} }
 func (m *FileInfo) String() string { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()    {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{141}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{143}
 }
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileInfo.Unmarshal(m, b)
@@ -9176,7 +9338,7 @@ func (m *InsertFilesCommand) Reset()         { *m = InsertFilesCommand{} }
 func (m *InsertFilesCommand) String() string { return proto.CompactTextString(m) }
 func (*InsertFilesCommand) ProtoMessage()    {}
 func (*InsertFilesCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{142}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{144}
 }
 func (m *InsertFilesCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InsertFilesCommand.Unmarshal(m, b)
@@ -9212,6 +9374,148 @@ var E_InsertFilesCommand_Command = &proto.ExtensionDesc{
 	Filename:      "meta.proto",
 }
 
+type SetQuotaCommand struct {
+	User                 *string    `protobuf:"bytes,1,opt,name=User" json:"User,omitempty"`
+	Database             *string    `protobuf:"bytes,2,opt,name=Database" json:"Database,omitempty"`
+	Quota                *QuotaInfo `protobuf:"bytes,3,opt,name=Quota" json:"Quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SetQuotaCommand) Reset()         { *m = SetQuotaCommand{} }
+func (m *SetQuotaCommand) String() string { return proto.CompactTextString(m) }
+func (*SetQuotaCommand) ProtoMessage()    {}
+func (*SetQuotaCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{145}
+}
+func (m *SetQuotaCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetQuotaCommand.Unmarshal(m, b)
+}
+func (m *SetQuotaCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetQuotaCommand.Marshal(b, m, deterministic)
+}
+func (m *SetQuotaCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetQuotaCommand.Merge(m, src)
+}
+func (m *SetQuotaCommand) XXX_Size() int {
+	return xxx_messageInfo_SetQuotaCommand.Size(m)
+}
+func (m *SetQuotaCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetQuotaCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetQuotaCommand proto.InternalMessageInfo
+
+func (m *SetQuotaCommand) GetUser() string {
+	if m != nil && m.User != nil {
+		return *m.User
+	}
+	return ""
+}
+
+func (m *SetQuotaCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *SetQuotaCommand) GetQuota() *QuotaInfo {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+var E_SetQuotaCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*SetQuotaCommand)(nil),
+	Field:         199,
+	Name:          "proto.SetQuotaCommand.command",
+	Tag:           "bytes,199,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type SetMeasurementPrivilegeCommand struct {
+	Username             *string  `protobuf:"bytes,1,req,name=Username" json:"Username,omitempty"`
+	Database             *string  `protobuf:"bytes,2,req,name=Database" json:"Database,omitempty"`
+	Measurement          *string  `protobuf:"bytes,3,opt,name=Measurement" json:"Measurement,omitempty"`
+	Privilege            *int32   `protobuf:"varint,4,req,name=Privilege" json:"Privilege,omitempty"`
+	Condition            *string  `protobuf:"bytes,5,opt,name=Condition" json:"Condition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetMeasurementPrivilegeCommand) Reset()         { *m = SetMeasurementPrivilegeCommand{} }
+func (m *SetMeasurementPrivilegeCommand) String() string { return proto.CompactTextString(m) }
+func (*SetMeasurementPrivilegeCommand) ProtoMessage()    {}
+func (*SetMeasurementPrivilegeCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{146}
+}
+func (m *SetMeasurementPrivilegeCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetMeasurementPrivilegeCommand.Unmarshal(m, b)
+}
+func (m *SetMeasurementPrivilegeCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetMeasurementPrivilegeCommand.Marshal(b, m, deterministic)
+}
+func (m *SetMeasurementPrivilegeCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMeasurementPrivilegeCommand.Merge(m, src)
+}
+func (m *SetMeasurementPrivilegeCommand) XXX_Size() int {
+	return xxx_messageInfo_SetMeasurementPrivilegeCommand.Size(m)
+}
+func (m *SetMeasurementPrivilegeCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMeasurementPrivilegeCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMeasurementPrivilegeCommand proto.InternalMessageInfo
+
+func (m *SetMeasurementPrivilegeCommand) GetUsername() string {
+	if m != nil && m.Username != nil {
+		return *m.Username
+	}
+	return ""
+}
+
+func (m *SetMeasurementPrivilegeCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *SetMeasurementPrivilegeCommand) GetMeasurement() string {
+	if m != nil && m.Measurement != nil {
+		return *m.Measurement
+	}
+	return ""
+}
+
+func (m *SetMeasurementPrivilegeCommand) GetPrivilege() int32 {
+	if m != nil && m.Privilege != nil {
+		return *m.Privilege
+	}
+	return 0
+}
+
+func (m *SetMeasurementPrivilegeCommand) GetCondition() string {
+	if m != nil && m.Condition != nil {
+		return *m.Condition
+	}
+	return ""
+}
+
+var E_SetMeasurementPrivilegeCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*SetMeasurementPrivilegeCommand)(nil),
+	Field:         200,
+	Name:          "proto.SetMeasurementPrivilegeCommand.command",
+	Tag:           "bytes,200,opt,name=command",
+	Filename:      "meta.proto",
+}
+
 func init() {
 	proto.RegisterEnum("proto.Command_Type", Command_Type_name, Command_Type_value)
 	proto.RegisterType((*Data)(nil), "proto.Data")
@@ -9241,7 +9545,9 @@ func init() {
 	proto.RegisterType((*SubscriptionInfo)(nil), "proto.SubscriptionInfo")
 	proto.RegisterType((*ShardOwner)(nil), "proto.ShardOwner")
 	proto.RegisterType((*UserInfo)(nil), "proto.UserInfo")
+	proto.RegisterType((*QuotaInfo)(nil), "proto.QuotaInfo")
 	proto.RegisterType((*UserPrivilege)(nil), "proto.UserPrivilege")
+	proto.RegisterType((*MeasurementPrivilege)(nil), "proto.MeasurementPrivilege")
 	proto.RegisterType((*IndexRelation)(nil), "proto.IndexRelation")
 	proto.RegisterType((*IndexList)(nil), "proto.IndexList")
 	proto.RegisterType((*RpMeasurementsFieldsInfo)(nil), "proto.RpMeasurementsFieldsInfo")
@@ -9437,450 +9743,467 @@ func init() {
 	proto.RegisterType((*FileInfo)(nil), "proto.FileInfo")
 	proto.RegisterExtension(E_InsertFilesCommand_Command)
 	proto.RegisterType((*InsertFilesCommand)(nil), "proto.InsertFilesCommand")
+	proto.RegisterExtension(E_SetQuotaCommand_Command)
+	proto.RegisterType((*SetQuotaCommand)(nil), "proto.SetQuotaCommand")
+	proto.RegisterExtension(E_SetMeasurementPrivilegeCommand_Command)
+	proto.RegisterType((*SetMeasurementPrivilegeCommand)(nil), "proto.SetMeasurementPrivilegeCommand")
 }
 
 func init() { proto.RegisterFile("meta.proto", fileDescriptor_3b5ea8fe65782bcc) }
 
 var fileDescriptor_3b5ea8fe65782bcc = []byte{
-	// 7035 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0xeb, 0x8f, 0x1d, 0x49,
-	0x75, 0xb8, 0xfa, 0x3e, 0x66, 0xee, 0xd4, 0xf8, 0xda, 0xe3, 0xf2, 0xeb, 0x7a, 0xd6, 0xf6, 0x8e,
-	0x1b, 0x2f, 0x6b, 0x76, 0xc1, 0xcb, 0x8e, 0x60, 0x59, 0x16, 0x58, 0xf0, 0xcc, 0xf5, 0xe3, 0xb2,
-	0x1e, 0xcf, 0x75, 0xdd, 0x59, 0xfb, 0xf7, 0x03, 0x7e, 0xfc, 0xe8, 0x99, 0x5b, 0x1e, 0x37, 0x73,
-	0x5f, 0xdb, 0xdd, 0x33, 0x9e, 0x59, 0x11, 0xb1, 0x80, 0x94, 0x88, 0x20, 0x14, 0x45, 0x51, 0x78,
-	0x29, 0x21, 0x09, 0x01, 0x12, 0x48, 0x48, 0x02, 0x81, 0x40, 0xc8, 0x42, 0xc2, 0x2b, 0x8a, 0xa2,
-	0x88, 0x6f, 0x91, 0xf2, 0x29, 0x7f, 0x40, 0x94, 0x44, 0xc9, 0x97, 0x3c, 0xa4, 0x44, 0x8a, 0xce,
-	0xa9, 0xaa, 0xae, 0xaa, 0xee, 0xea, 0x1e, 0xdb, 0x8a, 0xf9, 0x34, 0xb7, 0xce, 0x39, 0x5d, 0x75,
-	0xea, 0xd4, 0xa9, 0x53, 0xa7, 0x4e, 0x9d, 0xaa, 0x21, 0x64, 0xc8, 0x93, 0xe0, 0xc2, 0x24, 0x1a,
-	0x27, 0x63, 0x5a, 0xc7, 0x3f, 0xfe, 0x7f, 0xcc, 0x92, 0x5a, 0x3b, 0x48, 0x02, 0x4a, 0x49, 0x6d,
-	0x8d, 0x47, 0xc3, 0x96, 0xb7, 0x50, 0x39, 0x5f, 0x63, 0xf8, 0x9b, 0x1e, 0x25, 0xf5, 0xce, 0xa8,
-	0xcf, 0x77, 0x5b, 0x15, 0x04, 0x8a, 0x02, 0x3d, 0x45, 0x66, 0x96, 0x07, 0xdb, 0x71, 0xc2, 0xa3,
-	0x4e, 0xbb, 0x55, 0x45, 0x8c, 0x06, 0xd0, 0xc7, 0x48, 0xfd, 0xfa, 0xb8, 0xcf, 0xe3, 0x56, 0x6d,
-	0xa1, 0x7a, 0x7e, 0x76, 0xf1, 0x90, 0x68, 0xee, 0x02, 0xc0, 0x3a, 0xa3, 0xdb, 0x63, 0x26, 0xb0,
-	0xf4, 0x69, 0x32, 0x03, 0xcd, 0xae, 0x07, 0x31, 0x8f, 0x5b, 0x75, 0x24, 0x3d, 0x22, 0x49, 0x15,
-	0x1c, 0xc9, 0x35, 0x15, 0xd4, 0xfc, 0x62, 0xcc, 0xa3, 0xb8, 0x35, 0x65, 0xd5, 0x0c, 0x30, 0x51,
-	0x33, 0x62, 0x81, 0xbd, 0x95, 0x60, 0x17, 0xdb, 0x6b, 0xb7, 0xa6, 0x05, 0x7b, 0x29, 0x80, 0x9e,
-	0x27, 0x87, 0x56, 0x82, 0xdd, 0xde, 0x9d, 0x20, 0xea, 0x5f, 0x89, 0xc6, 0xdb, 0x93, 0x4e, 0xbb,
-	0xd5, 0x40, 0x9a, 0x2c, 0x98, 0x9e, 0x21, 0x44, 0x81, 0x3a, 0xed, 0xd6, 0x0c, 0x12, 0x19, 0x10,
-	0xfa, 0x06, 0xd1, 0x03, 0xd1, 0x59, 0x62, 0xb1, 0xa4, 0xe0, 0x4c, 0x53, 0x00, 0xf9, 0x0a, 0x57,
-	0xe4, 0xb3, 0x6e, 0xd9, 0x68, 0x0a, 0xea, 0x93, 0x03, 0x52, 0xa6, 0xdd, 0xe4, 0xfa, 0xf6, 0xb0,
-	0x75, 0x70, 0xa1, 0x72, 0xbe, 0xc9, 0x2c, 0x18, 0x7d, 0x8a, 0x4c, 0x75, 0x93, 0x9b, 0x21, 0xbf,
-	0xdb, 0x3a, 0x84, 0xf5, 0x9d, 0x30, 0x9a, 0xbf, 0x20, 0x30, 0x97, 0x46, 0x49, 0xb4, 0xc7, 0x24,
-	0x19, 0x54, 0x8a, 0x5f, 0x76, 0x79, 0x04, 0xad, 0xb4, 0xe6, 0x16, 0x3c, 0xa8, 0xd4, 0x84, 0x49,
-	0x01, 0xe1, 0x48, 0x2b, 0x01, 0x1d, 0x4e, 0x05, 0x64, 0x82, 0xa5, 0x80, 0x10, 0xd4, 0x69, 0xb7,
-	0x68, 0x2a, 0x20, 0x09, 0x81, 0xd6, 0x56, 0x82, 0xdd, 0x4b, 0x3b, 0x7c, 0x94, 0xac, 0x4e, 0x3a,
-	0xfd, 0xd6, 0x91, 0x05, 0xef, 0x7c, 0x8d, 0x59, 0x30, 0x68, 0x6d, 0x2d, 0xd8, 0xe2, 0xab, 0x3b,
-	0x3c, 0xba, 0x34, 0x0a, 0xd6, 0x07, 0xbc, 0xdf, 0x3a, 0xba, 0xe0, 0x9d, 0x6f, 0xb0, 0x2c, 0x98,
-	0xbe, 0x83, 0x34, 0x57, 0xc2, 0xcd, 0x28, 0x48, 0x38, 0x7e, 0x1d, 0xb7, 0x8e, 0x59, 0x7d, 0x36,
-	0x71, 0x28, 0x4b, 0x9b, 0x1a, 0x1a, 0x5a, 0x0a, 0x06, 0xc1, 0x68, 0x43, 0x37, 0x74, 0x5c, 0x34,
-	0x94, 0x01, 0x4b, 0x01, 0xb4, 0xc7, 0x77, 0x47, 0xbd, 0x60, 0x38, 0x19, 0x80, 0x16, 0x9d, 0x40,
-	0xce, 0xb3, 0x60, 0xfa, 0x24, 0x99, 0xee, 0x25, 0x11, 0x0f, 0x86, 0x71, 0xab, 0x85, 0xcc, 0x1c,
-	0x96, 0xcc, 0x08, 0x28, 0xb2, 0xa1, 0x28, 0xe8, 0x02, 0x99, 0x05, 0xe5, 0x11, 0x98, 0x76, 0xeb,
-	0x24, 0x56, 0x69, 0x82, 0xa4, 0xe2, 0x2e, 0x8f, 0x47, 0xa3, 0x4e, 0xbf, 0x35, 0x8f, 0x78, 0x0d,
-	0xa0, 0xcf, 0x93, 0xd9, 0x1b, 0xdb, 0x3c, 0xda, 0xeb, 0xb4, 0x3b, 0xa3, 0x30, 0x69, 0x3d, 0x82,
-	0x0d, 0x9e, 0x32, 0x47, 0xdc, 0x40, 0x8b, 0x61, 0x37, 0x3f, 0xa0, 0x6d, 0xd2, 0x64, 0x7c, 0x32,
-	0x08, 0x37, 0x02, 0x1c, 0xbf, 0xb8, 0x75, 0x0a, 0x6b, 0x38, 0x63, 0xd6, 0x60, 0x11, 0x88, 0x3a,
-	0xec, 0x8f, 0xe8, 0xeb, 0xc9, 0x61, 0x60, 0x79, 0x7b, 0x3d, 0xde, 0x88, 0xc2, 0x49, 0x12, 0x8e,
-	0x47, 0x9d, 0x76, 0xeb, 0x34, 0xf2, 0x9a, 0x47, 0xd0, 0x73, 0xa4, 0x09, 0x1d, 0xb8, 0xb1, 0x7c,
-	0x27, 0x18, 0x6d, 0x82, 0x20, 0xcf, 0x20, 0xa5, 0x0d, 0x04, 0xc9, 0x5c, 0xdf, 0x1e, 0xae, 0xde,
-	0xc6, 0x89, 0x15, 0xb7, 0x1e, 0x5d, 0xf0, 0xce, 0xd7, 0x99, 0x09, 0x82, 0x21, 0xe9, 0xc4, 0xbd,
-	0x1b, 0xd7, 0xc2, 0x84, 0xab, 0xc1, 0x5b, 0x10, 0x83, 0x97, 0x01, 0xd3, 0x27, 0x49, 0xa3, 0xf7,
-	0xd2, 0x40, 0x4c, 0xb2, 0xb3, 0xee, 0x39, 0x99, 0x12, 0xd0, 0x79, 0xd2, 0x58, 0x09, 0x76, 0x57,
-	0xe2, 0xa4, 0xd3, 0x6e, 0xf9, 0xc8, 0x59, 0x5a, 0x9e, 0x7f, 0x37, 0x99, 0x35, 0x66, 0x10, 0x9d,
-	0x23, 0xd5, 0x2d, 0xbe, 0xd7, 0xf2, 0x16, 0xbc, 0xf3, 0x33, 0x0c, 0x7e, 0x82, 0x35, 0xda, 0x09,
-	0x06, 0xdb, 0xbc, 0x55, 0x59, 0xf0, 0xcc, 0x66, 0x96, 0xba, 0x42, 0xff, 0x04, 0xf6, 0xb9, 0xca,
-	0xb3, 0xde, 0xfc, 0xf3, 0x64, 0x2e, 0x3b, 0x36, 0x8e, 0x0a, 0x8f, 0x9a, 0x15, 0xd6, 0xcc, 0xef,
-	0x5f, 0x24, 0x34, 0x3f, 0x32, 0x8e, 0x1a, 0x5e, 0x67, 0xb3, 0xa4, 0xec, 0xa9, 0xfc, 0x16, 0xc6,
-	0x24, 0x36, 0xaa, 0xf5, 0xdf, 0x46, 0x0e, 0x98, 0x28, 0xfa, 0x24, 0x99, 0x92, 0xaa, 0xe1, 0x59,
-	0xf6, 0xd8, 0x6c, 0x9b, 0x49, 0x12, 0xff, 0xe3, 0x5e, 0xfa, 0x35, 0x42, 0xe8, 0x41, 0x52, 0xe9,
-	0xb4, 0x71, 0xf5, 0x68, 0xb2, 0x4a, 0xa7, 0x2d, 0x84, 0x2b, 0x17, 0x89, 0x0a, 0x42, 0xd3, 0x32,
-	0x3d, 0x4b, 0xea, 0x5d, 0x0e, 0x96, 0xbc, 0x8a, 0x0d, 0xcd, 0xca, 0x86, 0x00, 0xc6, 0x04, 0x86,
-	0x1e, 0x27, 0x53, 0xbd, 0x24, 0x48, 0xb6, 0x61, 0x1d, 0x81, 0x8f, 0x65, 0x29, 0x5d, 0xa6, 0xea,
-	0x7a, 0x99, 0xf2, 0x9f, 0x20, 0x35, 0xf8, 0x28, 0xc7, 0x02, 0x25, 0x35, 0x36, 0x1e, 0x70, 0xd9,
-	0x3c, 0xfe, 0xf6, 0xcf, 0x92, 0xe9, 0x6e, 0xb2, 0x7a, 0x77, 0xc4, 0x23, 0x68, 0x42, 0xae, 0x12,
-	0x62, 0xcd, 0x93, 0x25, 0xff, 0x15, 0x0f, 0xec, 0x2a, 0x0c, 0x22, 0x3d, 0x47, 0xea, 0x48, 0x8b,
-	0x14, 0xb3, 0x8b, 0x07, 0x15, 0xa3, 0xa2, 0x06, 0x56, 0x4f, 0x2b, 0x92, 0xbc, 0x56, 0xb2, 0xbc,
-	0x76, 0x93, 0x4e, 0x1f, 0xd7, 0xc8, 0x26, 0xc3, 0xdf, 0x30, 0x6a, 0x37, 0x79, 0xd4, 0xaa, 0xe1,
-	0x18, 0xc3, 0x4f, 0xe4, 0xf2, 0x4a, 0xa7, 0xdd, 0xaa, 0xa3, 0x31, 0xc6, 0xdf, 0xfe, 0x1b, 0x48,
-	0x43, 0x29, 0x12, 0x3d, 0x4b, 0x6a, 0xed, 0xf5, 0x6e, 0x22, 0x07, 0xa5, 0x99, 0xb2, 0x80, 0x5a,
-	0x86, 0x28, 0xff, 0x9f, 0x3d, 0xd2, 0x50, 0x8b, 0x88, 0x21, 0x85, 0x9a, 0x92, 0xc2, 0xd5, 0x71,
-	0x9c, 0x20, 0x6f, 0x33, 0x0c, 0x7f, 0xd3, 0x16, 0x99, 0x66, 0xdd, 0xe5, 0x8b, 0xfd, 0x7e, 0x84,
-	0xcd, 0xce, 0x30, 0x55, 0x04, 0xcc, 0xda, 0x72, 0x17, 0x3f, 0xa8, 0x0a, 0x8c, 0x2c, 0x66, 0x46,
-	0xa4, 0x9a, 0xf6, 0xf2, 0x28, 0xa9, 0x5f, 0x5b, 0x0b, 0x87, 0xbc, 0x35, 0x25, 0x9c, 0x04, 0x2c,
-	0xc0, 0xe2, 0x70, 0x65, 0x1c, 0xc7, 0xe1, 0x04, 0x1b, 0x99, 0xc6, 0xb6, 0x0d, 0x08, 0x4c, 0xe9,
-	0x1e, 0xdf, 0x8c, 0xf8, 0x66, 0x90, 0x70, 0x59, 0x6d, 0x43, 0x58, 0xd9, 0x0c, 0x38, 0x1d, 0x45,
-	0x82, 0xec, 0x88, 0x51, 0xe4, 0xa4, 0xa1, 0xe6, 0x33, 0x7d, 0x94, 0x54, 0xae, 0x87, 0x72, 0x80,
-	0x72, 0x2b, 0x6a, 0xe5, 0x7a, 0x08, 0x8c, 0xa3, 0x0d, 0x6d, 0xcb, 0x99, 0x25, 0x4b, 0x60, 0x77,
-	0x2e, 0x0e, 0xc2, 0x1d, 0x2e, 0x91, 0x55, 0x61, 0x91, 0x0d, 0x90, 0xff, 0x8d, 0x2a, 0x39, 0x60,
-	0x7a, 0x23, 0xc0, 0xcb, 0xf5, 0x60, 0xc8, 0xb1, 0xb5, 0x19, 0x86, 0xbf, 0xe9, 0x33, 0xe4, 0x78,
-	0x9b, 0xdf, 0x0e, 0xb6, 0x07, 0x09, 0xe3, 0x09, 0x1f, 0xc1, 0x5c, 0xea, 0x8e, 0x07, 0xe1, 0xc6,
-	0x9e, 0x94, 0x78, 0x01, 0x96, 0x5e, 0x25, 0x87, 0x6d, 0x50, 0xc8, 0xd5, 0x84, 0x98, 0x4f, 0x67,
-	0x9e, 0xf5, 0x09, 0xf6, 0x28, 0xff, 0x11, 0xd4, 0xb4, 0x3c, 0x1e, 0x25, 0xe1, 0x68, 0x7b, 0xbc,
-	0x1d, 0x83, 0xa5, 0x09, 0x53, 0xf7, 0x4b, 0xd5, 0x64, 0xe3, 0x65, 0x4d, 0xb9, 0x8f, 0xc4, 0x22,
-	0x15, 0x6d, 0xb5, 0xf9, 0x80, 0x27, 0xbc, 0x8f, 0xba, 0xd1, 0x60, 0x26, 0x88, 0x3e, 0x45, 0x1a,
-	0x68, 0x94, 0x5f, 0xe0, 0x7b, 0xad, 0x29, 0xcb, 0xcc, 0x28, 0x30, 0xd6, 0x9d, 0x12, 0xd1, 0xd7,
-	0x92, 0x83, 0xc2, 0x38, 0xaf, 0x05, 0x9b, 0x17, 0xa3, 0x28, 0xd8, 0x6b, 0x4d, 0x63, 0xad, 0x19,
-	0x28, 0xd8, 0x0b, 0x69, 0x4f, 0xae, 0xa3, 0x26, 0x54, 0x59, 0x5a, 0x86, 0x85, 0x76, 0x15, 0xd7,
-	0x14, 0x58, 0xf5, 0x3d, 0x63, 0xa1, 0x5d, 0x5d, 0x8f, 0x25, 0x82, 0x29, 0x0a, 0xff, 0x5b, 0x1e,
-	0x39, 0x92, 0x11, 0x5c, 0x6f, 0xc2, 0x37, 0x8c, 0xb1, 0xf3, 0xd2, 0xb1, 0x9b, 0x27, 0x8d, 0xf6,
-	0x76, 0x84, 0xf6, 0x0f, 0x95, 0xa3, 0xca, 0xd2, 0x32, 0xbd, 0x40, 0xa8, 0xf6, 0x07, 0x53, 0xaa,
-	0x2a, 0x52, 0x39, 0x30, 0x56, 0x07, 0x6a, 0x38, 0x97, 0x75, 0x07, 0x7c, 0x72, 0xe0, 0x56, 0x10,
-	0x0d, 0xd3, 0x5a, 0xea, 0x58, 0x8b, 0x05, 0xf3, 0xbf, 0x51, 0x27, 0x87, 0x56, 0x78, 0x10, 0x6f,
-	0x47, 0x7c, 0x28, 0x9d, 0x18, 0xa7, 0xbe, 0x3d, 0x4d, 0x66, 0x94, 0x70, 0xc1, 0xe0, 0x54, 0x8b,
-	0x86, 0x40, 0x53, 0xd1, 0xe7, 0xc8, 0x54, 0x6f, 0xe3, 0x0e, 0x1f, 0x06, 0x52, 0xbf, 0x7c, 0xe5,
-	0x34, 0xd9, 0xcd, 0x5d, 0x10, 0x44, 0xd2, 0x67, 0x14, 0x85, 0xac, 0x4a, 0xd4, 0xf2, 0x2a, 0xf1,
-	0x1c, 0x69, 0x86, 0xe0, 0xf2, 0x31, 0x3e, 0xd0, 0xbd, 0x9b, 0x5d, 0x3c, 0x2a, 0x1b, 0xe9, 0x98,
-	0x38, 0x66, 0x93, 0x82, 0x99, 0xb8, 0x34, 0xda, 0x0c, 0x47, 0x7c, 0x6d, 0x6f, 0xc2, 0x51, 0xa1,
-	0x9a, 0xcc, 0x80, 0xd0, 0xb7, 0x90, 0x03, 0xcb, 0xe3, 0x41, 0x2f, 0x19, 0x47, 0x38, 0x01, 0x51,
-	0x77, 0x74, 0x7f, 0x4d, 0x14, 0xb3, 0x08, 0xe9, 0xd3, 0x84, 0x68, 0xe5, 0x40, 0x85, 0x72, 0x6a,
-	0x8d, 0x41, 0x44, 0x2f, 0x13, 0x22, 0x7c, 0xfb, 0xfe, 0x2e, 0x8f, 0x5b, 0x33, 0x28, 0xa9, 0xd7,
-	0x16, 0x49, 0x2a, 0x25, 0x14, 0xd2, 0x32, 0xbe, 0x44, 0x6f, 0x65, 0x14, 0x26, 0xa6, 0x4f, 0x43,
-	0xd0, 0xa7, 0xc9, 0x82, 0xa5, 0xa9, 0x9e, 0x45, 0xc3, 0x53, 0xc1, 0xcd, 0x49, 0x46, 0xcf, 0xd5,
-	0x82, 0x93, 0x55, 0xf2, 0xf9, 0xb7, 0x92, 0x59, 0x63, 0xb0, 0xf6, 0xf3, 0x26, 0xea, 0xa6, 0x37,
-	0xf1, 0x02, 0x39, 0x94, 0xe1, 0xde, 0xfc, 0xbc, 0x26, 0x3e, 0xf7, 0x6d, 0x57, 0xe2, 0x80, 0x1a,
-	0x4b, 0xf8, 0xc6, 0xf4, 0x21, 0xfe, 0xad, 0x9e, 0x9b, 0x6c, 0x85, 0x8a, 0x6b, 0x4f, 0xb6, 0xca,
-	0x3d, 0x4d, 0xb6, 0xca, 0x3d, 0x4d, 0xb6, 0x8a, 0x35, 0xd9, 0x9e, 0x23, 0x07, 0x8c, 0xe1, 0x52,
-	0xbb, 0xcb, 0xe3, 0xee, 0x91, 0x64, 0x16, 0x2d, 0x5d, 0x21, 0xb3, 0x2b, 0x71, 0x72, 0x93, 0x47,
-	0x31, 0x8e, 0xc2, 0x41, 0xfc, 0xf4, 0xc9, 0x62, 0x73, 0x7c, 0xc1, 0xa0, 0x96, 0x4e, 0xb7, 0x01,
-	0xa1, 0x6f, 0x21, 0xb3, 0x9a, 0x79, 0xb5, 0x71, 0x3d, 0x66, 0xce, 0x56, 0xb1, 0x99, 0x02, 0x46,
-	0x4c, 0x4a, 0xd8, 0xed, 0x98, 0xbe, 0x74, 0xdc, 0x9a, 0xb6, 0x76, 0x3b, 0x96, 0x9f, 0x8d, 0xbb,
-	0x1d, 0x8b, 0x3a, 0x3b, 0x69, 0x1b, 0xf9, 0x49, 0xbb, 0x40, 0x66, 0xaf, 0x8e, 0x93, 0x54, 0xd2,
-	0x33, 0x28, 0x69, 0x13, 0x94, 0xb3, 0x59, 0x04, 0x49, 0x2c, 0x18, 0x0c, 0x9b, 0xde, 0x12, 0xa6,
-	0x94, 0xb3, 0x62, 0xd8, 0xf2, 0x18, 0x90, 0x87, 0x86, 0xc6, 0xad, 0x03, 0x96, 0x3c, 0x8c, 0xcd,
-	0x25, 0xca, 0xc3, 0xa0, 0xa4, 0xab, 0xe4, 0xa8, 0xde, 0x7a, 0x69, 0xf1, 0xb7, 0x9a, 0xa8, 0x9e,
-	0x8f, 0x28, 0xe7, 0xdb, 0x41, 0xc2, 0x9c, 0x1f, 0x82, 0x4f, 0x9e, 0x1d, 0xba, 0xfd, 0x66, 0x51,
-	0xd3, 0x54, 0xfc, 0x80, 0x1c, 0x71, 0xac, 0xa9, 0x4e, 0xbd, 0x3f, 0x4a, 0xea, 0x48, 0x20, 0xfd,
-	0x01, 0x51, 0x80, 0x01, 0xb8, 0x16, 0xc4, 0x09, 0xdb, 0x1e, 0xa1, 0xf3, 0x24, 0xd6, 0x15, 0x13,
-	0xe4, 0xff, 0x97, 0x47, 0x0e, 0xda, 0x3a, 0x92, 0xf3, 0xed, 0x4e, 0x91, 0x99, 0x5e, 0x12, 0x44,
-	0x09, 0x56, 0x21, 0xe6, 0x94, 0x06, 0x80, 0x2f, 0x77, 0x69, 0xd4, 0x97, 0xd5, 0x03, 0x4e, 0x15,
-	0xe1, 0x3b, 0xa9, 0x08, 0x17, 0x13, 0xe9, 0xce, 0x69, 0x00, 0x3d, 0x4f, 0xa6, 0xa4, 0xdd, 0x12,
-	0x53, 0x67, 0xce, 0x54, 0x58, 0x94, 0xa9, 0xc4, 0x43, 0x27, 0xd6, 0xa2, 0xed, 0xd1, 0x46, 0x20,
-	0x6a, 0x9a, 0x12, 0x9d, 0x30, 0x40, 0x19, 0x03, 0x3f, 0x9d, 0x33, 0xf0, 0x2d, 0x32, 0xbd, 0x23,
-	0x06, 0xa1, 0x75, 0x00, 0x91, 0xaa, 0xe8, 0x7f, 0xba, 0x22, 0x17, 0x3a, 0x67, 0xcf, 0xcf, 0x90,
-	0x06, 0x3a, 0xdf, 0x9d, 0xb6, 0x58, 0x04, 0x9b, 0x4b, 0x95, 0x96, 0xc7, 0x52, 0x18, 0x8c, 0xe5,
-	0x4a, 0x28, 0x2c, 0xc8, 0x0c, 0x83, 0x9f, 0x08, 0x09, 0x76, 0xb1, 0xb7, 0x00, 0x09, 0x76, 0x71,
-	0x2f, 0x11, 0xf2, 0x28, 0xdd, 0x4b, 0x84, 0x1c, 0xfd, 0x5f, 0x15, 0xd1, 0x10, 0xfe, 0xac, 0x2a,
-	0x82, 0x59, 0xd7, 0x9a, 0x74, 0x8d, 0xef, 0xf0, 0x01, 0xba, 0xb5, 0x55, 0x96, 0x05, 0xc3, 0xcc,
-	0xb1, 0xc2, 0x07, 0xc2, 0xb1, 0xb5, 0x60, 0xc2, 0x80, 0x05, 0xfd, 0xd5, 0xd1, 0x60, 0xaf, 0x35,
-	0x83, 0xd3, 0x33, 0x2d, 0x8b, 0xc0, 0x8a, 0x9a, 0xaa, 0xb8, 0x76, 0x34, 0x98, 0x01, 0xf1, 0x19,
-	0x39, 0x60, 0xae, 0xf4, 0x50, 0x57, 0xea, 0x93, 0xc1, 0x2e, 0x61, 0xc6, 0x70, 0xbf, 0xa0, 0x8f,
-	0x20, 0xf9, 0x8a, 0xf0, 0x7a, 0x50, 0xe6, 0x94, 0xd4, 0x7a, 0x9b, 0xa9, 0xc7, 0x8b, 0xbf, 0xfd,
-	0x93, 0xa4, 0x2e, 0x56, 0xaf, 0x39, 0x52, 0xed, 0xf4, 0x77, 0xb1, 0x9e, 0x3a, 0x83, 0x9f, 0xfe,
-	0xfb, 0xc9, 0x5c, 0xd6, 0xde, 0x38, 0xf5, 0x9c, 0x92, 0xda, 0xca, 0xb8, 0xcf, 0xd5, 0x46, 0x03,
-	0x7e, 0xa3, 0x28, 0x78, 0x9c, 0x84, 0x23, 0xb1, 0xc7, 0x44, 0xff, 0x63, 0x86, 0x59, 0x30, 0xff,
-	0x9c, 0x5c, 0x77, 0xcb, 0x77, 0x65, 0x9f, 0xf2, 0x48, 0x43, 0x85, 0xfa, 0x8a, 0x9a, 0xbf, 0x1a,
-	0xc4, 0x77, 0xd2, 0x7d, 0x4e, 0x10, 0xdf, 0x81, 0xa9, 0x77, 0xb1, 0x3f, 0x94, 0x7a, 0xd0, 0x60,
-	0xa2, 0x00, 0x4d, 0xb0, 0xbb, 0x50, 0x97, 0xf4, 0x66, 0x64, 0x89, 0xbe, 0x89, 0x90, 0x6e, 0x14,
-	0xee, 0x84, 0x03, 0xbe, 0x99, 0x06, 0x25, 0x8f, 0x1a, 0x51, 0xc6, 0x14, 0xc9, 0x0c, 0x3a, 0xbf,
-	0x43, 0x9a, 0x16, 0x12, 0xd7, 0x39, 0xb9, 0x69, 0x90, 0x0c, 0xa6, 0x65, 0x98, 0x78, 0x29, 0x21,
-	0x72, 0x5a, 0x67, 0x1a, 0xe0, 0xbf, 0xea, 0x91, 0xa6, 0xe5, 0x2e, 0xc1, 0x68, 0xb0, 0xb0, 0x2f,
-	0xf7, 0xb4, 0xf0, 0x13, 0x20, 0xab, 0x61, 0x5f, 0xe8, 0x3c, 0x83, 0x9f, 0x50, 0x27, 0x7e, 0x84,
-	0x12, 0x11, 0x02, 0xd6, 0x00, 0xfa, 0x46, 0x42, 0xb0, 0x70, 0x2d, 0x8c, 0x13, 0xb5, 0x2b, 0x98,
-	0x33, 0x2d, 0x2e, 0x20, 0x98, 0x41, 0x03, 0x3e, 0x17, 0x96, 0x94, 0x2b, 0x62, 0x47, 0x67, 0x4d,
-	0x14, 0xb3, 0x08, 0xfd, 0xb3, 0x92, 0x11, 0xa8, 0x06, 0x63, 0xc7, 0xf0, 0x43, 0x6a, 0xa4, 0x28,
-	0xf8, 0x7d, 0xd2, 0x62, 0x13, 0x73, 0xc5, 0xbd, 0x1c, 0xf2, 0x41, 0x3f, 0xc6, 0x41, 0xbd, 0x4a,
-	0xe6, 0x32, 0x8b, 0xb3, 0x8a, 0x44, 0x9c, 0xca, 0xaf, 0xdd, 0xfa, 0x3b, 0x96, 0xfb, 0xca, 0x1f,
-	0x93, 0x63, 0x4e, 0x52, 0x98, 0xdd, 0x2b, 0x71, 0x62, 0xa8, 0x8e, 0x2a, 0xd2, 0xb7, 0x13, 0x02,
-	0x73, 0x43, 0xd0, 0x4a, 0xb7, 0xda, 0xd1, 0xac, 0xa6, 0x61, 0x06, 0xbd, 0xbf, 0x6c, 0x35, 0xa8,
-	0x11, 0xa0, 0x6a, 0xb2, 0x4a, 0x21, 0x06, 0x59, 0x32, 0xa6, 0x25, 0x58, 0x10, 0xfc, 0xed, 0x7f,
-	0xa2, 0x42, 0x88, 0x8e, 0x1c, 0x3a, 0x75, 0x5c, 0x58, 0xc1, 0x4a, 0x6a, 0x05, 0xdf, 0x44, 0xa6,
-	0x7a, 0xd1, 0xc6, 0x0a, 0x6e, 0xd6, 0x2b, 0x06, 0xc7, 0xa2, 0x9a, 0xac, 0xab, 0x23, 0x69, 0xe1,
-	0xab, 0x36, 0x8f, 0xe1, 0xab, 0xda, 0xbd, 0x7c, 0x25, 0x68, 0x41, 0xad, 0x3b, 0xa3, 0x84, 0x47,
-	0x3b, 0xc1, 0x00, 0x2d, 0x66, 0x95, 0xa5, 0x65, 0x18, 0xec, 0x36, 0x1f, 0x04, 0x7b, 0x68, 0x33,
-	0xab, 0x4c, 0x14, 0xa0, 0x07, 0xed, 0x70, 0x28, 0x7c, 0x97, 0x19, 0x86, 0xbf, 0xe9, 0xe3, 0xa4,
-	0xbe, 0x1c, 0x0c, 0x06, 0xe0, 0x92, 0xe7, 0x23, 0xa6, 0x80, 0x61, 0x02, 0xef, 0x3f, 0x43, 0x66,
-	0xb5, 0x30, 0xf0, 0x3b, 0x53, 0x23, 0x1c, 0x91, 0x56, 0x81, 0xf7, 0x5f, 0x22, 0xc7, 0x9c, 0xfd,
-	0x28, 0x74, 0x49, 0xd5, 0x54, 0xad, 0x64, 0xa6, 0xea, 0x79, 0x72, 0x28, 0xbb, 0xa1, 0x17, 0xab,
-	0x49, 0x16, 0xec, 0x5f, 0x53, 0xe3, 0x06, 0x9c, 0x43, 0x3b, 0xf0, 0x57, 0xb5, 0x83, 0xb0, 0xa3,
-	0xa4, 0x8e, 0x03, 0xaf, 0x5c, 0x00, 0x2c, 0xa0, 0x75, 0x1a, 0x84, 0x41, 0x2c, 0xeb, 0x15, 0x05,
-	0xff, 0x1f, 0x3c, 0x7b, 0xcf, 0x03, 0xcb, 0x41, 0x37, 0x0a, 0x87, 0x41, 0xb4, 0xa7, 0x0d, 0xbc,
-	0x01, 0x01, 0xa5, 0xee, 0x8d, 0xa3, 0x04, 0x90, 0x15, 0x44, 0xaa, 0x22, 0x2c, 0xcf, 0xdd, 0x68,
-	0x3c, 0xe1, 0x51, 0x82, 0x9f, 0x0a, 0xdb, 0x60, 0x82, 0xe8, 0x39, 0xd2, 0x54, 0xc5, 0x9b, 0xe8,
-	0xe8, 0xd4, 0x90, 0xc6, 0x06, 0xd2, 0x37, 0x92, 0x23, 0xe0, 0x36, 0xc8, 0xc3, 0x87, 0xcc, 0x2e,
-	0xd6, 0x85, 0x82, 0x5d, 0xff, 0xf2, 0x78, 0x38, 0x09, 0x36, 0xa0, 0x94, 0xee, 0xed, 0xea, 0x2c,
-	0x03, 0xf5, 0xef, 0x4a, 0x87, 0x50, 0x98, 0x10, 0x98, 0x2e, 0x6b, 0xe3, 0x2d, 0x3e, 0x8a, 0xa5,
-	0x13, 0x26, 0x4b, 0x20, 0x02, 0xfc, 0x15, 0xbe, 0xcc, 0xa3, 0x58, 0xae, 0x65, 0x06, 0xa4, 0x88,
-	0xc1, 0x6a, 0x21, 0x83, 0xfe, 0xb3, 0xb6, 0x91, 0xa3, 0xe7, 0x6d, 0xfd, 0xa2, 0x79, 0x6b, 0xa7,
-	0x14, 0xec, 0x93, 0x87, 0xc9, 0xf4, 0xf2, 0x78, 0x38, 0x0c, 0x46, 0x7d, 0xfa, 0x38, 0xa9, 0x25,
-	0xd0, 0x39, 0x18, 0xeb, 0x83, 0xc6, 0xb6, 0x14, 0xb1, 0x17, 0xa0, 0x87, 0x0c, 0x09, 0xfc, 0x9f,
-	0xce, 0x89, 0x09, 0x4f, 0x4f, 0x92, 0x63, 0xcb, 0x11, 0x0f, 0x12, 0xae, 0xf4, 0x4c, 0x12, 0xcf,
-	0x55, 0xe9, 0x09, 0x72, 0xa4, 0x1d, 0x8d, 0x27, 0x59, 0x44, 0x8d, 0x2e, 0x90, 0x53, 0xe2, 0x9b,
-	0x8c, 0xe2, 0x29, 0x8a, 0x3a, 0x3d, 0x43, 0xe6, 0xe1, 0xd3, 0x02, 0xfc, 0x14, 0x3d, 0x47, 0x16,
-	0x7a, 0x3c, 0x71, 0x07, 0xa2, 0x14, 0xd5, 0x34, 0xb4, 0xf3, 0xe2, 0xa4, 0x5f, 0xdc, 0x4e, 0x83,
-	0x3e, 0x42, 0x4e, 0x08, 0x4e, 0xb4, 0x5f, 0xaa, 0x90, 0x33, 0x80, 0x14, 0x0e, 0x4a, 0x1e, 0x49,
-	0xe8, 0x31, 0x72, 0x58, 0x7c, 0x09, 0x6b, 0xa5, 0x02, 0x37, 0xe9, 0x11, 0x72, 0x08, 0x18, 0x37,
-	0x81, 0x07, 0x81, 0x56, 0xf0, 0x61, 0x82, 0x0f, 0x81, 0x7c, 0x7a, 0x3c, 0x49, 0x57, 0x4b, 0x85,
-	0x98, 0xa3, 0x94, 0x1c, 0x84, 0xde, 0x05, 0x49, 0xa0, 0x60, 0x87, 0xe9, 0x29, 0xd2, 0xea, 0xf1,
-	0x04, 0xd7, 0xfb, 0xdc, 0x17, 0x94, 0x9e, 0x26, 0x27, 0x65, 0x3f, 0x0c, 0xc7, 0x46, 0xa1, 0x8f,
-	0x61, 0x4f, 0xa2, 0xf1, 0xc4, 0x85, 0x3c, 0xae, 0x47, 0x50, 0x1d, 0xd6, 0x29, 0x54, 0xcb, 0x1e,
-	0x5c, 0x13, 0x75, 0x12, 0x50, 0xa2, 0x4f, 0x59, 0xd4, 0x3c, 0xa0, 0x84, 0xdc, 0xb2, 0x15, 0x3e,
-	0xa2, 0x51, 0xd9, 0xaf, 0x4e, 0xd1, 0xe3, 0x84, 0xf6, 0x78, 0x92, 0xfd, 0xe4, 0x34, 0x3d, 0x4a,
-	0xe6, 0x90, 0x77, 0x18, 0x03, 0x05, 0x3d, 0x03, 0x1d, 0x46, 0x07, 0x52, 0xea, 0x96, 0xa8, 0x54,
-	0xa1, 0x1f, 0x85, 0x0e, 0x0b, 0xee, 0xb4, 0x23, 0xa6, 0x90, 0xaf, 0x01, 0xe5, 0x81, 0x6f, 0x33,
-	0x4a, 0x61, 0x57, 0xf1, 0x38, 0x08, 0x5c, 0x89, 0x25, 0xb5, 0xbb, 0x0a, 0xfb, 0x34, 0x70, 0x75,
-	0x71, 0x90, 0xf0, 0x48, 0xf9, 0xa5, 0xcb, 0xc3, 0xfe, 0xdc, 0x22, 0x0c, 0x34, 0x13, 0x4d, 0x86,
-	0xa3, 0x4d, 0x45, 0xfc, 0x26, 0x18, 0x68, 0xc9, 0x0d, 0x86, 0x38, 0x14, 0xe2, 0xcd, 0x80, 0x60,
-	0x7c, 0x32, 0x8e, 0x12, 0xb1, 0xfd, 0x50, 0x88, 0x67, 0x40, 0x18, 0xdd, 0x68, 0x7b, 0xc4, 0xc5,
-	0x6e, 0x51, 0xc1, 0xdf, 0x0a, 0x1a, 0x0d, 0xac, 0x1b, 0x2c, 0xd9, 0x6c, 0x3f, 0x47, 0xe7, 0xc9,
-	0x71, 0x10, 0x97, 0x83, 0xe9, 0xb7, 0x01, 0xd3, 0x60, 0x3a, 0x58, 0x30, 0xd2, 0xba, 0xf3, 0x76,
-	0xda, 0x22, 0x47, 0xb1, 0x79, 0x65, 0x4a, 0x14, 0xe6, 0x1d, 0x7a, 0x02, 0xe8, 0x9d, 0xab, 0x42,
-	0x3e, 0x0f, 0x53, 0xd4, 0x10, 0x31, 0x98, 0x12, 0xd8, 0x6f, 0x28, 0xfc, 0x3b, 0xf5, 0x10, 0xc0,
-	0x70, 0x8a, 0xd0, 0xb7, 0x42, 0xbe, 0x0b, 0xfa, 0x27, 0x84, 0x8b, 0xa7, 0x99, 0x0a, 0x7e, 0x11,
-	0xe0, 0xe2, 0x23, 0x0b, 0xbe, 0xa4, 0x25, 0x28, 0x8e, 0x09, 0x14, 0x62, 0x19, 0x3e, 0x60, 0x7c,
-	0x38, 0xde, 0xb1, 0x3f, 0x68, 0xd3, 0xb3, 0xe4, 0xb4, 0xd4, 0xdc, 0xcc, 0x66, 0x59, 0x91, 0x5c,
-	0xa2, 0x8f, 0x92, 0x47, 0xd0, 0x3c, 0x15, 0x10, 0x5c, 0x86, 0x1e, 0x5e, 0xe1, 0x49, 0x11, 0xfe,
-	0x8a, 0x31, 0x3b, 0xd6, 0xc5, 0xd1, 0x9a, 0x42, 0x5d, 0xa5, 0xaf, 0x23, 0x8f, 0x5d, 0x01, 0x65,
-	0xb6, 0x56, 0xec, 0x5b, 0x61, 0x72, 0x27, 0x84, 0xba, 0x38, 0x4b, 0xe5, 0xd8, 0x01, 0x6d, 0x34,
-	0xe4, 0x68, 0xec, 0xa9, 0x8c, 0x7e, 0xbe, 0x1b, 0x04, 0x00, 0x03, 0xbf, 0x16, 0x6c, 0xf1, 0xf1,
-	0x8e, 0x16, 0xf3, 0x0b, 0x0a, 0xa1, 0x0e, 0x7d, 0x15, 0xe2, 0x1a, 0x20, 0xa4, 0x49, 0x10, 0x4b,
-	0xb9, 0x44, 0xac, 0x80, 0x92, 0xe2, 0x84, 0xb2, 0xc0, 0xd7, 0xa9, 0x4f, 0xce, 0xe4, 0x59, 0xc6,
-	0x45, 0x5b, 0xd1, 0xac, 0x42, 0x8f, 0x6f, 0xf2, 0x28, 0xbc, 0xbd, 0x97, 0x9d, 0xbe, 0x5d, 0x68,
-	0xee, 0xd2, 0xee, 0x24, 0x18, 0xf5, 0x6d, 0x95, 0xbd, 0x01, 0x0a, 0xa9, 0x86, 0x4e, 0x46, 0x27,
-	0x14, 0x8e, 0x41, 0x7d, 0x20, 0xe1, 0xa5, 0xa5, 0x28, 0xe4, 0xb7, 0xcd, 0x0e, 0xf7, 0xa4, 0xf0,
-	0x4d, 0xcf, 0xda, 0xc4, 0xaf, 0xc1, 0x4c, 0x60, 0x7c, 0x33, 0x84, 0x35, 0x50, 0x9e, 0x45, 0xae,
-	0xde, 0xbe, 0x1d, 0xf3, 0x54, 0x05, 0x5e, 0xd4, 0xab, 0x4c, 0x26, 0xae, 0xa1, 0x28, 0x6e, 0xa2,
-	0x4d, 0x7d, 0x69, 0xb0, 0x08, 0x36, 0xe7, 0x2a, 0x0f, 0xa2, 0x64, 0x9d, 0x07, 0xe9, 0xf7, 0xb7,
-	0xf0, 0x7b, 0xfb, 0x4b, 0x31, 0x57, 0x15, 0xc5, 0xff, 0x91, 0x22, 0xcb, 0x10, 0x5d, 0xe3, 0xc6,
-	0x5a, 0xf7, 0x7f, 0xd5, 0x4a, 0x56, 0xc0, 0xc3, 0x7b, 0x40, 0x0b, 0xaf, 0x8f, 0x93, 0xf0, 0xf6,
-	0xde, 0xf2, 0x0d, 0xf1, 0x25, 0x9e, 0x22, 0xa7, 0x96, 0xee, 0xbd, 0xa0, 0xc9, 0x3d, 0x9e, 0xe0,
-	0x24, 0xb2, 0x0f, 0x92, 0x14, 0xc9, 0xfb, 0x84, 0xd9, 0x81, 0x49, 0x60, 0x0e, 0xc9, 0xff, 0x83,
-	0xee, 0xa9, 0xe5, 0x2f, 0x3d, 0x15, 0x55, 0xd8, 0xf7, 0x83, 0x05, 0xd5, 0xf3, 0x73, 0x6d, 0x38,
-	0xc1, 0x39, 0xae, 0xd0, 0xff, 0x1f, 0xac, 0x82, 0x54, 0x1f, 0x71, 0xba, 0xac, 0x30, 0x1f, 0x30,
-	0x26, 0xbe, 0xc0, 0xd8, 0xdc, 0x04, 0x30, 0x25, 0x3b, 0xa3, 0x98, 0x47, 0xc9, 0xe5, 0x70, 0xc0,
-	0x53, 0xf8, 0xba, 0x66, 0xc7, 0x61, 0x9b, 0xf8, 0x13, 0x8d, 0x46, 0x7f, 0xee, 0x95, 0x57, 0x5e,
-	0x79, 0xa5, 0xe2, 0xff, 0x6d, 0xa5, 0xc0, 0xa5, 0x70, 0x7a, 0xbc, 0xed, 0xbc, 0x57, 0x2b, 0x42,
-	0xbc, 0x65, 0x67, 0x4e, 0xd9, 0x4f, 0xc0, 0x1f, 0x53, 0xe1, 0xd6, 0xed, 0x21, 0xba, 0x59, 0x4d,
-	0x66, 0x40, 0xe8, 0x63, 0xa4, 0xda, 0xdb, 0x0a, 0x71, 0x7b, 0x5d, 0x70, 0x3a, 0x01, 0x78, 0xc7,
-	0xd9, 0x50, 0xdd, 0x79, 0x36, 0x74, 0x3f, 0xe7, 0x3f, 0x8b, 0x97, 0xc9, 0xf4, 0x86, 0x14, 0xc0,
-	0x41, 0xdb, 0x21, 0x6b, 0x6d, 0xe2, 0xc7, 0x6a, 0xbb, 0xe3, 0x14, 0x1a, 0x53, 0x1f, 0xfb, 0x63,
-	0xa7, 0x3b, 0xe6, 0x12, 0xea, 0x62, 0xbb, 0xb8, 0xc9, 0x3b, 0x96, 0x70, 0x1d, 0x15, 0xea, 0x06,
-	0xff, 0xc9, 0x2b, 0xf7, 0xf3, 0x4a, 0x03, 0x0b, 0xce, 0x71, 0xad, 0xdc, 0xef, 0xb8, 0x62, 0x5c,
-	0x50, 0x38, 0x89, 0x5d, 0x19, 0x33, 0xd1, 0x80, 0xc5, 0x95, 0xe2, 0x6e, 0x86, 0xd8, 0xcd, 0xd7,
-	0x58, 0x92, 0x75, 0xf7, 0x42, 0xf7, 0xf7, 0xb3, 0x5e, 0x99, 0xd7, 0x5a, 0xda, 0x5b, 0x35, 0x08,
-	0x15, 0x63, 0x10, 0x5e, 0x28, 0xe6, 0xee, 0x83, 0xc8, 0xdd, 0x59, 0x63, 0x10, 0xf6, 0xe3, 0xed,
-	0x4b, 0xde, 0xfe, 0x1e, 0xf3, 0x7d, 0x73, 0x78, 0xa3, 0x98, 0xc3, 0x2d, 0xe4, 0xf0, 0x71, 0x35,
-	0x53, 0xf6, 0x69, 0x59, 0xf3, 0xf9, 0xed, 0x6a, 0xb9, 0xcf, 0x7e, 0xbf, 0x3c, 0xc2, 0x66, 0xf2,
-	0x3a, 0xbf, 0x2b, 0x43, 0x49, 0x78, 0xfe, 0x2f, 0x8b, 0xd6, 0xf1, 0x4d, 0x2d, 0x73, 0x56, 0x6a,
-	0x1e, 0xc7, 0xd4, 0x33, 0x67, 0x9f, 0xee, 0xa3, 0x9d, 0xa9, 0xc2, 0x73, 0x54, 0x3c, 0xbb, 0xd8,
-	0xe2, 0x52, 0x00, 0x18, 0x63, 0xc5, 0xb3, 0x8b, 0x14, 0x94, 0x3f, 0xbb, 0xf0, 0xf6, 0x3f, 0xbb,
-	0xf0, 0xee, 0xf9, 0xec, 0xc2, 0x73, 0x9f, 0x5d, 0x94, 0x69, 0xff, 0xc0, 0xd2, 0xfe, 0xb2, 0xf1,
-	0xd0, 0x23, 0xf7, 0xc9, 0x4a, 0xe1, 0x5e, 0xaa, 0x74, 0xd0, 0x8e, 0x93, 0x29, 0x2b, 0xbd, 0x60,
-	0x4a, 0x4f, 0x5d, 0x70, 0x56, 0xe3, 0x24, 0x18, 0x4e, 0x64, 0xb8, 0x5f, 0x03, 0xf0, 0xa0, 0x00,
-	0x9a, 0xc1, 0x78, 0x77, 0x4d, 0x24, 0x45, 0xa6, 0x80, 0x4c, 0x90, 0xbe, 0xee, 0x0a, 0xd2, 0x4b,
-	0x5f, 0x04, 0xe5, 0xd3, 0x64, 0xaa, 0xb8, 0x78, 0xb5, 0x58, 0x28, 0x43, 0x14, 0xca, 0x19, 0xcb,
-	0x24, 0xe4, 0xba, 0xaa, 0xe5, 0xf1, 0x9f, 0x5e, 0xe1, 0xf6, 0xf1, 0x81, 0xe4, 0xe1, 0xcb, 0x20,
-	0xb9, 0x4a, 0x62, 0x14, 0x89, 0xaa, 0x16, 0xcc, 0x3e, 0x06, 0x11, 0x1a, 0x69, 0x1c, 0x83, 0x9c,
-	0x21, 0x44, 0x14, 0xd2, 0xa3, 0x8b, 0x3a, 0x33, 0x20, 0x65, 0x7d, 0x1f, 0x59, 0x7d, 0x2f, 0xe8,
-	0x96, 0xee, 0xfb, 0xd7, 0x3c, 0xc7, 0xee, 0xf8, 0xe1, 0x04, 0xb9, 0x17, 0x97, 0x8a, 0xb9, 0x7e,
-	0x09, 0xb9, 0x6e, 0x59, 0x23, 0x66, 0x30, 0xa4, 0xf9, 0xdd, 0xcc, 0xed, 0xda, 0x9d, 0xcb, 0xe2,
-	0xbb, 0x8a, 0x9b, 0x8a, 0xb0, 0xa9, 0xe3, 0x86, 0x45, 0x76, 0x36, 0xf4, 0x61, 0x47, 0x24, 0xe0,
-	0x5e, 0xe5, 0x52, 0xd6, 0xd3, 0xd8, 0xea, 0x69, 0xae, 0x09, 0xcd, 0xc0, 0xd7, 0x3d, 0x67, 0xd0,
-	0x01, 0x34, 0x12, 0xe8, 0x47, 0x9a, 0x8f, 0xb4, 0x5c, 0x1a, 0x54, 0xb4, 0xe2, 0xff, 0xd5, 0x4c,
-	0xfc, 0xbf, 0xcc, 0x8f, 0x48, 0x2c, 0x3f, 0xc2, 0xc1, 0x92, 0xe6, 0x39, 0xca, 0x86, 0x43, 0xe8,
-	0xa3, 0x22, 0xc7, 0x5b, 0x26, 0x49, 0xcd, 0x1a, 0x19, 0x91, 0x0c, 0x11, 0x8b, 0xef, 0x2c, 0x6e,
-	0x78, 0x1b, 0x1b, 0x3e, 0x66, 0xac, 0x4c, 0xba, 0x62, 0xdd, 0xe6, 0xa7, 0xbd, 0xe2, 0x78, 0x4b,
-	0xa9, 0xb0, 0x52, 0xe5, 0xad, 0x18, 0xca, 0xbb, 0xd8, 0x29, 0xe6, 0x67, 0x07, 0xf9, 0x79, 0x54,
-	0xf3, 0xe3, 0x6c, 0xd3, 0xb2, 0x2b, 0xc5, 0xb1, 0x9e, 0x87, 0x17, 0x14, 0x4e, 0x4f, 0xc3, 0x6a,
-	0x25, 0xa7, 0x61, 0xf5, 0xfc, 0x69, 0xd8, 0xe2, 0xbb, 0x8b, 0xbb, 0xbe, 0x87, 0x5d, 0x5f, 0xb0,
-	0x2d, 0x6a, 0xbe, 0x53, 0xba, 0xef, 0xdf, 0xf3, 0x0a, 0x03, 0x59, 0x0f, 0xaf, 0xe7, 0x65, 0x76,
-	0xf1, 0x65, 0xdb, 0x2e, 0xba, 0x59, 0xd3, 0xfc, 0xff, 0xc8, 0x2b, 0x88, 0xb5, 0x01, 0xa7, 0x57,
-	0xd7, 0xd6, 0xba, 0x98, 0x5c, 0x28, 0x55, 0x4a, 0x95, 0xcd, 0xe4, 0x46, 0x21, 0xfc, 0x4c, 0x72,
-	0x23, 0x62, 0x44, 0xf7, 0x54, 0x11, 0x93, 0x0c, 0x81, 0x41, 0xb1, 0x4a, 0xe0, 0xef, 0xb2, 0x8d,
-	0xc4, 0x87, 0x1c, 0x1b, 0x89, 0x0c, 0x8b, 0xba, 0x17, 0x5f, 0xf1, 0x0a, 0xc2, 0x82, 0xfb, 0xf5,
-	0xa2, 0x84, 0xd7, 0x4c, 0x42, 0x64, 0x19, 0xaf, 0x3f, 0x57, 0xb0, 0xe9, 0x71, 0xf2, 0x7a, 0x8b,
-	0x34, 0x15, 0x0e, 0x23, 0x44, 0x69, 0xf6, 0x28, 0xb0, 0x77, 0x40, 0x66, 0x8f, 0x9e, 0x22, 0x33,
-	0x88, 0x34, 0x4e, 0xb0, 0x34, 0x40, 0xe7, 0x83, 0x56, 0x8d, 0x7c, 0x50, 0x7f, 0x5c, 0x10, 0xe4,
-	0xcc, 0x1e, 0xec, 0x97, 0xf5, 0xe4, 0xc3, 0x56, 0x4f, 0x9c, 0xd5, 0xe9, 0x9e, 0x4c, 0x0a, 0x42,
-	0xa7, 0xb9, 0x06, 0xaf, 0x14, 0x37, 0xf8, 0x8a, 0xe7, 0x68, 0xb1, 0x50, 0x76, 0x97, 0xc1, 0x09,
-	0x8e, 0x27, 0xe3, 0x51, 0x8c, 0x07, 0x75, 0xab, 0x2f, 0x60, 0x23, 0x0d, 0x56, 0x59, 0x7d, 0x01,
-	0x84, 0x72, 0x29, 0x8a, 0xc6, 0x91, 0x3c, 0xbb, 0x10, 0x05, 0x7d, 0xbf, 0x46, 0x9c, 0xc4, 0x8b,
-	0x82, 0xff, 0x7d, 0xcf, 0x15, 0xda, 0xfd, 0x99, 0xa8, 0x7c, 0xc9, 0x02, 0xf4, 0x11, 0x21, 0x8b,
-	0x93, 0xda, 0xf0, 0x16, 0x8a, 0xfe, 0x76, 0x3e, 0x04, 0x9d, 0x93, 0x7a, 0xc9, 0xe2, 0xfc, 0x51,
-	0xd1, 0xd2, 0x09, 0xd3, 0x4a, 0x18, 0x55, 0xe9, 0x76, 0x3e, 0x54, 0x12, 0xd4, 0x76, 0x3a, 0x24,
-	0x25, 0x5b, 0xc4, 0x8f, 0x79, 0x96, 0x71, 0x2d, 0xac, 0x57, 0xb7, 0xfe, 0x57, 0x5e, 0x61, 0xd0,
-	0x1c, 0x8f, 0xe4, 0x44, 0x8e, 0x1d, 0xb6, 0x5f, 0x65, 0xaa, 0x08, 0x18, 0x91, 0xa3, 0xd2, 0x97,
-	0x33, 0x47, 0x15, 0xc1, 0x61, 0x6b, 0xaf, 0xcb, 0x8d, 0x17, 0x3a, 0xb2, 0xa2, 0x84, 0x8e, 0xdc,
-	0x04, 0xe1, 0x62, 0x68, 0x65, 0xa9, 0x6c, 0x8d, 0xfc, 0x05, 0xcf, 0xb2, 0xb3, 0x05, 0x5c, 0xea,
-	0xae, 0x7c, 0xd9, 0xdb, 0x3f, 0xc4, 0x7f, 0xdf, 0xbb, 0x5d, 0x56, 0xcc, 0xdf, 0x27, 0x3c, 0x6b,
-	0xbb, 0xbb, 0x5f, 0xd3, 0x9a, 0xd1, 0xbf, 0xab, 0x16, 0x9f, 0x32, 0xa0, 0x00, 0x97, 0x8c, 0x31,
-	0x97, 0x25, 0x43, 0x80, 0x15, 0x53, 0x80, 0x29, 0xd3, 0x55, 0x63, 0x05, 0xbc, 0xc7, 0xc0, 0xd5,
-	0x39, 0x52, 0xe9, 0xb0, 0xd2, 0x3c, 0xd7, 0x4a, 0x87, 0x3d, 0xbc, 0xe4, 0xd6, 0x45, 0x42, 0xc4,
-	0xd1, 0x08, 0x7e, 0xd6, 0xb0, 0x4e, 0x2c, 0xf1, 0x68, 0x59, 0x60, 0x99, 0x41, 0x65, 0xe6, 0x96,
-	0xce, 0x94, 0xe6, 0x96, 0xde, 0x7b, 0xfe, 0x6a, 0x99, 0xaf, 0xf2, 0xab, 0x9e, 0xe5, 0xa7, 0x15,
-	0x0d, 0x9a, 0x1e, 0xda, 0x1f, 0x78, 0xf9, 0x23, 0xa2, 0x9f, 0xe1, 0x90, 0x96, 0x19, 0xa4, 0x4f,
-	0xd9, 0x06, 0x29, 0xcb, 0xa5, 0xee, 0xc3, 0x5f, 0xa7, 0x26, 0xa1, 0xbd, 0xde, 0x4d, 0xac, 0x40,
-	0x2f, 0x1e, 0x6d, 0x07, 0xf1, 0x96, 0xce, 0x6b, 0x12, 0xa5, 0x34, 0xdf, 0xa9, 0x2f, 0xd3, 0x3a,
-	0x64, 0x09, 0x0c, 0x66, 0x7b, 0x49, 0x76, 0xa4, 0xd2, 0x5e, 0x82, 0x72, 0x77, 0x4d, 0xe6, 0xba,
-	0x56, 0xba, 0x6b, 0x7a, 0x45, 0xa9, 0x1b, 0x2b, 0x4a, 0x99, 0x51, 0xf8, 0xb4, 0xcb, 0x28, 0xe4,
-	0xf8, 0xd4, 0x9d, 0xf9, 0x17, 0xcf, 0x71, 0x3a, 0xb7, 0xdf, 0x56, 0xdc, 0x39, 0x2a, 0xf7, 0xb8,
-	0x15, 0xef, 0x4d, 0x06, 0xa1, 0xc8, 0x64, 0x94, 0x19, 0x89, 0x29, 0x80, 0x2e, 0xc8, 0x3c, 0xda,
-	0xa5, 0xf1, 0xf6, 0xa8, 0xaf, 0xfc, 0x66, 0x13, 0xb4, 0xb8, 0x5c, 0xdc, 0xf1, 0xcf, 0x78, 0xd6,
-	0x6e, 0x2f, 0xd7, 0x27, 0xdd, 0xe5, 0x7f, 0xf4, 0x9c, 0x27, 0x8f, 0x0f, 0xd4, 0xe9, 0x05, 0x32,
-	0x6b, 0xa8, 0xbb, 0x1c, 0x48, 0x13, 0x44, 0x9f, 0x25, 0x4d, 0x9c, 0xac, 0x6b, 0x63, 0x31, 0x3b,
-	0x64, 0x72, 0x96, 0x6b, 0x22, 0xdb, 0x84, 0x8b, 0x97, 0x8a, 0x3b, 0xfb, 0x59, 0xcf, 0xda, 0x28,
-	0x3a, 0x7a, 0xa3, 0xbb, 0xdb, 0x21, 0xb3, 0x46, 0x23, 0x30, 0x04, 0x58, 0x34, 0xe6, 0x9b, 0x06,
-	0xa4, 0xd8, 0xd4, 0xe9, 0xab, 0x33, 0x0d, 0xf0, 0x6f, 0xc9, 0xd4, 0x2f, 0x67, 0xae, 0xe6, 0x7c,
-	0x36, 0x57, 0xd3, 0xc8, 0xd3, 0xb4, 0x73, 0x1d, 0xab, 0xb9, 0x5c, 0xc7, 0x1f, 0x7a, 0xe4, 0xa0,
-	0x9d, 0x18, 0xfc, 0x33, 0x4a, 0x82, 0x7d, 0x42, 0x26, 0x82, 0xf2, 0x6c, 0x16, 0x6c, 0xda, 0x4f,
-	0xa6, 0x08, 0xf6, 0x33, 0xf4, 0xfe, 0x47, 0x3c, 0xa9, 0xbf, 0xf2, 0x4a, 0x53, 0xea, 0x1e, 0xa8,
-	0x6e, 0xa8, 0x62, 0x1a, 0xa7, 0xeb, 0x85, 0x2f, 0x73, 0x69, 0x10, 0x34, 0x00, 0xa7, 0x01, 0x5e,
-	0xd4, 0x59, 0x1e, 0x6f, 0x4b, 0x9d, 0xaa, 0x33, 0x13, 0x84, 0x09, 0x6e, 0xc1, 0xae, 0x31, 0x89,
-	0x54, 0xd1, 0x7f, 0x2f, 0x69, 0xb2, 0x89, 0xc9, 0x84, 0x56, 0x5c, 0xcf, 0x52, 0xdc, 0x45, 0x99,
-	0x8e, 0x09, 0x64, 0xb1, 0x3c, 0x44, 0xa0, 0xa6, 0xd9, 0x14, 0xdf, 0x33, 0x83, 0xca, 0xff, 0x00,
-	0x21, 0xed, 0x25, 0x65, 0x49, 0xa4, 0xe9, 0xf2, 0x52, 0xd3, 0x25, 0xee, 0xc1, 0xa9, 0x6b, 0x80,
-	0xf8, 0x9b, 0x5e, 0x20, 0xd3, 0x6c, 0x22, 0x9a, 0xa8, 0x5a, 0x89, 0x96, 0x16, 0x93, 0x4c, 0x11,
-	0xf9, 0xbf, 0xe2, 0x91, 0x13, 0xe6, 0xd9, 0xff, 0xb5, 0x71, 0x90, 0xfa, 0x96, 0xe2, 0xb6, 0xdc,
-	0x1a, 0x10, 0x66, 0xd2, 0xc3, 0x34, 0x53, 0x2c, 0x25, 0x29, 0xb3, 0x91, 0x9f, 0xb3, 0x6d, 0x64,
-	0x41, 0x83, 0x7a, 0x06, 0xfd, 0xc4, 0x73, 0xe7, 0xa5, 0xd3, 0x37, 0xaa, 0x34, 0x37, 0xcf, 0xba,
-	0x86, 0xa5, 0x69, 0x57, 0x27, 0x3c, 0x0a, 0x92, 0x71, 0x14, 0xcb, 0x7c, 0x37, 0x7a, 0x85, 0xd0,
-	0x4c, 0x4d, 0x21, 0x57, 0x89, 0x88, 0x27, 0x0a, 0xf2, 0xdb, 0x99, 0xe3, 0x13, 0x2b, 0x4e, 0x5f,
-	0xcd, 0x5c, 0xb3, 0xd0, 0x8b, 0x90, 0xb8, 0x80, 0x28, 0x4b, 0xfe, 0x87, 0xc8, 0x5c, 0xb6, 0x6e,
-	0xfa, 0x5a, 0x72, 0x50, 0x9d, 0xac, 0xcb, 0xac, 0x3f, 0xe1, 0xca, 0x66, 0xa0, 0x60, 0xdd, 0x41,
-	0xc1, 0x52, 0x2a, 0x31, 0x03, 0x2d, 0x18, 0xa8, 0xf5, 0xad, 0x20, 0xe1, 0x11, 0x4c, 0x6c, 0x15,
-	0x9c, 0x4e, 0x01, 0x7e, 0x87, 0x1c, 0x71, 0x08, 0x06, 0x98, 0xbd, 0xb8, 0xb9, 0xb9, 0x3a, 0x49,
-	0x73, 0x27, 0x45, 0x49, 0x59, 0x63, 0x63, 0xf7, 0x99, 0x96, 0xfd, 0x0f, 0x93, 0x53, 0xae, 0xf1,
-	0xb8, 0x15, 0x26, 0x77, 0xda, 0xeb, 0x6c, 0x42, 0x9f, 0x22, 0x35, 0xf4, 0x99, 0x44, 0x24, 0xac,
-	0xf4, 0xde, 0x00, 0x12, 0x1a, 0x5e, 0x79, 0xa5, 0xc0, 0x2b, 0xaf, 0x9a, 0xb3, 0xc7, 0x7f, 0x2f,
-	0x39, 0x93, 0x1f, 0x13, 0x8b, 0x85, 0xb7, 0xda, 0x99, 0x66, 0xaf, 0x29, 0xe1, 0x41, 0x7d, 0xa3,
-	0x52, 0xcf, 0xd6, 0xc8, 0x7c, 0x26, 0xeb, 0x41, 0xd8, 0x77, 0x91, 0x22, 0xf9, 0x8c, 0x5d, 0xf1,
-	0x82, 0x39, 0x67, 0x5d, 0x5f, 0xa8, 0x5a, 0xc7, 0xe4, 0x64, 0x21, 0x0d, 0x7d, 0x3d, 0xa9, 0x77,
-	0xfa, 0xb0, 0x80, 0x09, 0x89, 0x1d, 0xb7, 0xae, 0x02, 0x00, 0x22, 0xbc, 0x1d, 0xf2, 0x88, 0x09,
-	0x22, 0x7a, 0x8e, 0x34, 0x8d, 0x64, 0xf8, 0x1d, 0xa5, 0x0c, 0x36, 0xd0, 0xff, 0x45, 0xcf, 0x95,
-	0xae, 0x03, 0x56, 0x54, 0xbb, 0x04, 0x72, 0xef, 0x6c, 0x40, 0xd2, 0xe4, 0x57, 0x79, 0x5b, 0xaa,
-	0x6c, 0xb3, 0xfa, 0xeb, 0xf6, 0x66, 0x35, 0xdf, 0x98, 0x9e, 0xc2, 0x3f, 0xf6, 0xca, 0x73, 0x84,
-	0x1e, 0xe8, 0xf0, 0x61, 0xdf, 0xc5, 0x7f, 0xf1, 0x7a, 0x31, 0xf3, 0x9f, 0xf7, 0xac, 0xe3, 0xa4,
-	0x32, 0xe6, 0x74, 0x37, 0xbe, 0xe3, 0x15, 0x25, 0x32, 0x3d, 0xa4, 0x0e, 0x94, 0x44, 0xf9, 0x7e,
-	0x43, 0x74, 0xe0, 0xb4, 0xb1, 0x81, 0x2f, 0xf3, 0xfc, 0xff, 0xdb, 0x23, 0x4d, 0x99, 0x18, 0x11,
-	0x89, 0x54, 0xdd, 0x53, 0xe2, 0x69, 0x0d, 0x11, 0x1b, 0x11, 0x2b, 0xa4, 0x06, 0x18, 0x37, 0x04,
-	0x4c, 0x8f, 0xb9, 0x0d, 0x1e, 0x71, 0x37, 0xe9, 0xf4, 0xc5, 0x82, 0xd2, 0x64, 0xa2, 0x40, 0x9f,
-	0x21, 0x33, 0xca, 0xfc, 0xa9, 0xf4, 0xf7, 0x96, 0x35, 0x33, 0x24, 0x52, 0xbe, 0x36, 0xa2, 0x48,
-	0x75, 0x18, 0xab, 0x6e, 0x5e, 0x6b, 0x7e, 0x8e, 0xcc, 0x1a, 0xe9, 0x37, 0xf2, 0x42, 0x57, 0x2b,
-	0xf3, 0x70, 0x49, 0x8a, 0x67, 0x26, 0x31, 0xf0, 0xbd, 0x21, 0x1e, 0x77, 0x98, 0x16, 0xc6, 0x57,
-	0x94, 0xfc, 0x2f, 0x7a, 0xf9, 0x3c, 0xb3, 0x07, 0x1a, 0x34, 0xc3, 0xad, 0xa8, 0x5a, 0x6e, 0x45,
-	0xd9, 0xe6, 0xe6, 0x37, 0xed, 0xcd, 0x4d, 0x96, 0x11, 0x3d, 0x4c, 0x9f, 0xf7, 0xdc, 0x89, 0x6f,
-	0x3a, 0x8a, 0xe5, 0x99, 0xaf, 0xc4, 0xcc, 0x91, 0x6a, 0x37, 0x51, 0xfe, 0x1e, 0xfc, 0x04, 0xb6,
-	0x47, 0x62, 0xa7, 0x23, 0xc2, 0x5d, 0xb2, 0x54, 0x16, 0xf1, 0xfb, 0x2d, 0xcf, 0xba, 0xdf, 0xe5,
-	0x6a, 0xde, 0x8c, 0xf8, 0x51, 0x85, 0x6b, 0x73, 0x11, 0x54, 0x1e, 0x47, 0x20, 0xc8, 0xb5, 0x90,
-	0x47, 0x6b, 0x2a, 0x4d, 0xb7, 0xc6, 0xd2, 0xb2, 0x58, 0xba, 0x8c, 0x7c, 0xe1, 0x74, 0xe9, 0x32,
-	0x32, 0x99, 0x4b, 0x96, 0x53, 0xff, 0x47, 0x95, 0xf4, 0x2e, 0xa5, 0xb2, 0x84, 0x25, 0xbe, 0x5d,
-	0x76, 0x1b, 0x54, 0x71, 0x6c, 0x83, 0x54, 0x78, 0xa8, 0xbd, 0x2e, 0xe7, 0x9c, 0x2a, 0xa6, 0x98,
-	0x6e, 0x22, 0x37, 0x81, 0xaa, 0x68, 0xa8, 0x43, 0x3d, 0x7b, 0x22, 0x2c, 0x8e, 0x78, 0x85, 0x53,
-	0x8a, 0xfe, 0x7c, 0x0a, 0x70, 0x5f, 0x67, 0xf2, 0x1e, 0xd2, 0x75, 0x26, 0xc3, 0x3b, 0x26, 0x39,
-	0xef, 0xf8, 0x0a, 0x69, 0xa6, 0x5a, 0xa7, 0xa6, 0xbf, 0x76, 0xe8, 0xbd, 0x12, 0x87, 0xbe, 0x62,
-	0x39, 0xf4, 0xfe, 0xc7, 0x3c, 0x72, 0x08, 0x95, 0xcf, 0x18, 0x7e, 0xe3, 0x3e, 0x97, 0x67, 0xdf,
-	0xe7, 0xf2, 0x65, 0x06, 0x78, 0x66, 0x38, 0xac, 0x27, 0x6e, 0x16, 0xc5, 0xa1, 0x3a, 0xb2, 0x26,
-	0xaf, 0x58, 0x1c, 0xcd, 0x4e, 0x14, 0x61, 0x38, 0xd2, 0x22, 0xec, 0x58, 0x0e, 0xe7, 0x2c, 0x8b,
-	0xb9, 0x8e, 0x7a, 0xfb, 0xaf, 0xa3, 0xef, 0x20, 0x07, 0xcc, 0xaf, 0xa5, 0x17, 0xae, 0x96, 0xb3,
-	0xbc, 0x96, 0x33, 0x8b, 0x9c, 0xbe, 0x2b, 0x77, 0x93, 0x5c, 0x3a, 0xd9, 0x45, 0x97, 0x60, 0xb3,
-	0xe4, 0xfe, 0xdf, 0x7b, 0x32, 0x6b, 0xc3, 0x1e, 0x19, 0x4b, 0x1e, 0xde, 0x3d, 0xc9, 0x83, 0x3e,
-	0x43, 0x88, 0xd8, 0xed, 0xa5, 0x2f, 0x49, 0x69, 0x3e, 0x32, 0xa3, 0xc5, 0x0c, 0x4a, 0xfa, 0x3c,
-	0x69, 0x5a, 0x62, 0x94, 0xf2, 0x2f, 0x36, 0xde, 0x36, 0xb9, 0xad, 0xfe, 0x35, 0x0c, 0x92, 0x68,
-	0x80, 0x3f, 0x24, 0xc7, 0x2c, 0xf2, 0x34, 0x72, 0x5f, 0xbe, 0xf6, 0x58, 0xab, 0x49, 0xe5, 0x9e,
-	0x57, 0x13, 0xff, 0x55, 0xaf, 0x30, 0x37, 0xf8, 0x41, 0xb3, 0x1b, 0x2c, 0xe5, 0xad, 0xe6, 0x95,
-	0xb7, 0x6c, 0x9f, 0xf3, 0x05, 0xcf, 0x91, 0xa0, 0x90, 0xe3, 0xcc, 0x8a, 0x75, 0x97, 0x64, 0x2f,
-	0x97, 0xd8, 0x3c, 0x75, 0xc5, 0xb2, 0x62, 0x5c, 0xb1, 0xbc, 0xdf, 0x40, 0xf7, 0xb5, 0xe2, 0x7e,
-	0xfc, 0xb6, 0x67, 0x65, 0x76, 0x15, 0xb3, 0x68, 0xe5, 0x2e, 0x2c, 0x63, 0xf8, 0x27, 0x18, 0x84,
-	0xc9, 0xde, 0x03, 0x6b, 0xf5, 0x02, 0x99, 0x35, 0xaa, 0x91, 0xfd, 0x33, 0x41, 0xfe, 0x07, 0xc9,
-	0xbc, 0xe9, 0xf5, 0x64, 0xda, 0x74, 0x1d, 0xbf, 0x3e, 0x9b, 0xad, 0xd3, 0x9c, 0xb2, 0x99, 0x0a,
-	0xec, 0xb6, 0x3e, 0x40, 0x8e, 0x18, 0xc5, 0x54, 0x97, 0xdf, 0x62, 0xef, 0x08, 0xce, 0xe6, 0x67,
-	0x7f, 0xb6, 0x56, 0x41, 0x0f, 0x8b, 0xf7, 0xa5, 0x48, 0x1d, 0x56, 0xc1, 0x4f, 0xb0, 0x6a, 0x45,
-	0xf9, 0xe9, 0xb9, 0x80, 0x8c, 0xfd, 0x1e, 0x4e, 0xdd, 0x7a, 0x29, 0x26, 0x31, 0x4f, 0x06, 0x93,
-	0xfc, 0x4b, 0x31, 0xb5, 0xec, 0x4b, 0x31, 0x65, 0x6a, 0xfc, 0x45, 0x57, 0x48, 0x33, 0xc7, 0x9f,
-	0x1e, 0xfb, 0x7f, 0xf7, 0xc4, 0x5b, 0x3a, 0x18, 0xa1, 0x58, 0x4f, 0x23, 0x14, 0xeb, 0xf4, 0x34,
-	0xa9, 0x74, 0x13, 0x69, 0x9b, 0x32, 0x2f, 0xec, 0x54, 0xba, 0x09, 0x7d, 0x2a, 0xbd, 0x10, 0x5d,
-	0xb5, 0xf7, 0xe3, 0xeb, 0xdd, 0x44, 0xcc, 0xfb, 0x58, 0x3d, 0x9a, 0x21, 0xee, 0x45, 0x67, 0xdc,
-	0xc4, 0x9a, 0x15, 0x80, 0x2c, 0x77, 0x13, 0xe7, 0x7b, 0x32, 0x56, 0x54, 0xf8, 0x36, 0xc3, 0x05,
-	0xfb, 0x6d, 0x86, 0x62, 0xfb, 0x63, 0x5c, 0x57, 0xff, 0x52, 0x85, 0xcc, 0x65, 0x9f, 0x48, 0x83,
-	0x69, 0xcb, 0xb1, 0xd0, 0x97, 0xd7, 0xad, 0x54, 0x11, 0x8c, 0x20, 0x37, 0x4e, 0x78, 0xbd, 0xf3,
-	0x75, 0xa6, 0x01, 0xa0, 0xbb, 0xe3, 0x49, 0xea, 0xc6, 0xe1, 0x6f, 0x7a, 0x9a, 0x54, 0x27, 0x89,
-	0x8a, 0xb2, 0xcf, 0x1a, 0xf2, 0x61, 0x00, 0x87, 0x0a, 0x37, 0xb6, 0xa3, 0x08, 0xc6, 0x45, 0x24,
-	0x98, 0xd5, 0x99, 0x06, 0x80, 0x05, 0x9c, 0x44, 0x5c, 0x20, 0xc5, 0x3d, 0xb1, 0xb4, 0x0c, 0xfd,
-	0x8f, 0xa3, 0x0d, 0xe9, 0x32, 0xc3, 0x4f, 0x68, 0xbe, 0xcf, 0xe3, 0x44, 0xfa, 0x21, 0xf8, 0x1b,
-	0x36, 0x9e, 0x1b, 0x77, 0xf8, 0xc6, 0xd6, 0xf2, 0x78, 0x74, 0x7b, 0x10, 0x6e, 0x24, 0xd2, 0x09,
-	0xb1, 0x81, 0x30, 0x69, 0x83, 0xf4, 0x79, 0x9f, 0x3e, 0xba, 0x22, 0x35, 0x66, 0x82, 0xfc, 0x5f,
-	0xf6, 0x5c, 0x37, 0x2d, 0xe8, 0x9b, 0xa5, 0x3c, 0x8c, 0xd8, 0x41, 0xe1, 0xc3, 0x73, 0x9a, 0xb2,
-	0x6c, 0x87, 0xfa, 0x25, 0x7b, 0x87, 0x9a, 0x6f, 0x53, 0x6b, 0x2d, 0xf0, 0x94, 0xbf, 0xe5, 0xf1,
-	0x10, 0x78, 0xfa, 0xb2, 0xcd, 0x53, 0xbe, 0x4d, 0xeb, 0xb4, 0xc6, 0x75, 0xc3, 0xe4, 0x7e, 0x27,
-	0xd6, 0x29, 0x32, 0x83, 0x2b, 0x3e, 0xbe, 0x46, 0x28, 0xd4, 0x49, 0x03, 0xac, 0x17, 0xa7, 0x3c,
-	0xfd, 0xae, 0x56, 0x59, 0xf8, 0xfb, 0x77, 0x5c, 0xe1, 0x6f, 0x8b, 0x45, 0xdd, 0x87, 0xc4, 0x75,
-	0x17, 0xc6, 0x9e, 0x14, 0x15, 0x63, 0x52, 0x94, 0x49, 0xee, 0x77, 0x6d, 0xc9, 0xe5, 0xab, 0xd5,
-	0xad, 0xfe, 0xab, 0xb7, 0xcf, 0x55, 0x9b, 0xc2, 0xb7, 0x2e, 0xee, 0x21, 0x66, 0xe5, 0x0e, 0x46,
-	0x96, 0xa5, 0xf5, 0x50, 0x52, 0x1b, 0x19, 0x27, 0x66, 0xf0, 0x7b, 0x71, 0xb5, 0xb8, 0xa3, 0x5f,
-	0x11, 0x1d, 0x3d, 0x67, 0x67, 0x93, 0xb8, 0x3b, 0xa2, 0xfb, 0xfc, 0x5d, 0xaf, 0xf4, 0xee, 0xd0,
-	0x7e, 0x1e, 0x50, 0x64, 0x9d, 0xaf, 0x88, 0x12, 0x8c, 0x53, 0x3f, 0x1a, 0x4f, 0x2e, 0x0e, 0x06,
-	0xf2, 0xd4, 0x40, 0x15, 0xcb, 0x12, 0x75, 0xbf, 0x2a, 0xd8, 0xf7, 0xcd, 0x74, 0xfc, 0xfd, 0x98,
-	0xff, 0x60, 0xd9, 0xb5, 0xa6, 0x32, 0xe7, 0xe4, 0xf7, 0x6c, 0xe7, 0xa4, 0xb8, 0x12, 0xdd, 0xd6,
-	0x67, 0xbc, 0x82, 0x3b, 0x52, 0x86, 0xd3, 0xe4, 0x59, 0x4e, 0xd3, 0x19, 0x42, 0x22, 0x7d, 0x13,
-	0x43, 0x3c, 0x53, 0x62, 0x40, 0xca, 0xb2, 0x5b, 0x7e, 0xdf, 0x73, 0x65, 0x06, 0xd9, 0xed, 0x6a,
-	0xd6, 0x7e, 0xea, 0xdd, 0xe3, 0x1d, 0xad, 0x42, 0x56, 0x8b, 0x4e, 0xca, 0xa4, 0xc7, 0x0d, 0x4b,
-	0x8b, 0x58, 0x60, 0xab, 0x4c, 0x03, 0x16, 0x6f, 0x15, 0x77, 0xe0, 0x6b, 0xa2, 0x03, 0xaf, 0xd7,
-	0x02, 0xde, 0x9f, 0x3b, 0xdd, 0xa1, 0x2f, 0x7a, 0xfb, 0xdf, 0x24, 0xbb, 0xbf, 0xf0, 0x67, 0x59,
-	0xca, 0xc3, 0x1f, 0xd8, 0x29, 0x0f, 0xfb, 0x35, 0x6c, 0x5a, 0x29, 0xd7, 0x4d, 0x36, 0x10, 0x26,
-	0xc7, 0x4b, 0x32, 0x32, 0x50, 0x2a, 0x4b, 0x65, 0xb6, 0xf1, 0x0f, 0x6d, 0xdb, 0xe8, 0xa8, 0x35,
-	0xd7, 0x6a, 0xe6, 0x9a, 0xdc, 0x83, 0xb4, 0xfa, 0x47, 0xf9, 0x56, 0x33, 0xb5, 0xea, 0x56, 0x7f,
-	0xc9, 0x73, 0x5e, 0xc2, 0xa3, 0x4f, 0x9b, 0x0f, 0x23, 0xc8, 0xa1, 0x70, 0xbc, 0x00, 0x60, 0x10,
-	0x95, 0x71, 0xf4, 0x75, 0x9b, 0x23, 0x47, 0x83, 0x9a, 0xa3, 0x81, 0xe3, 0xf2, 0x9f, 0x33, 0xb5,
-	0xa8, 0xe4, 0xfc, 0xf9, 0x1b, 0xf6, 0xf9, 0x73, 0xae, 0x3e, 0xdd, 0xda, 0xab, 0xde, 0x7e, 0x97,
-	0x0a, 0xef, 0x7b, 0x72, 0x19, 0x2f, 0x5e, 0x54, 0xad, 0x17, 0x2f, 0x16, 0xbb, 0xc5, 0x1c, 0xff,
-	0xb1, 0xe0, 0xf8, 0xb1, 0xc2, 0x89, 0x65, 0xb2, 0xa4, 0xd9, 0xdf, 0x2d, 0xb8, 0xee, 0x58, 0xf4,
-	0xa6, 0x4b, 0x99, 0x71, 0xfa, 0xa6, 0x6d, 0x9c, 0x9c, 0xf5, 0xea, 0x96, 0xdf, 0xe7, 0xbc, 0x4d,
-	0x59, 0xa6, 0x04, 0xdf, 0xb2, 0x95, 0xc0, 0xf1, 0xb5, 0xae, 0xfd, 0xa3, 0x5e, 0xd1, 0x9d, 0xcc,
-	0x9c, 0xbf, 0x73, 0x30, 0xf5, 0x77, 0x9a, 0xe0, 0xe0, 0x94, 0x45, 0xc9, 0xff, 0xc4, 0x8e, 0x92,
-	0xbb, 0x1b, 0xd0, 0x4c, 0x7c, 0xce, 0x2b, 0xbb, 0xe1, 0x79, 0xbf, 0x7a, 0x51, 0xb6, 0x6e, 0x7d,
-	0x3b, 0xb7, 0x6e, 0x15, 0x34, 0xaa, 0x99, 0x5b, 0x25, 0x87, 0x73, 0xbb, 0x1a, 0xe7, 0x16, 0x37,
-	0x7f, 0xe3, 0x4f, 0xe4, 0x7d, 0x67, 0xa0, 0xfe, 0x4d, 0xeb, 0x2d, 0x18, 0xf1, 0x78, 0xcb, 0x52,
-	0x1e, 0x26, 0x37, 0xb6, 0x45, 0x61, 0xad, 0x1c, 0x3d, 0x0c, 0x65, 0xe9, 0x3d, 0x58, 0x2b, 0xdf,
-	0x55, 0xbe, 0x96, 0x5a, 0x76, 0x56, 0xf3, 0x1d, 0xfb, 0xac, 0xa6, 0xac, 0x6a, 0x2d, 0xad, 0x6f,
-	0x7a, 0xe5, 0x57, 0x6d, 0xef, 0xfb, 0xd2, 0x56, 0xfa, 0xc2, 0x58, 0xd5, 0x78, 0x61, 0xac, 0x8c,
-	0xed, 0x3f, 0xf5, 0x1c, 0xf7, 0xf5, 0xdc, 0xcc, 0x68, 0xb6, 0x5f, 0x2e, 0xbe, 0xfe, 0xeb, 0x14,
-	0x5b, 0x49, 0x76, 0xd8, 0x77, 0xed, 0xec, 0xb0, 0xa2, 0x6a, 0x2d, 0xed, 0x2f, 0xbd, 0x5d, 0x4c,
-	0x9f, 0x20, 0x8d, 0xe5, 0x1b, 0xb8, 0x63, 0x54, 0xd1, 0x8e, 0xb4, 0x4d, 0x01, 0x66, 0x29, 0xbe,
-	0x4c, 0x30, 0x7f, 0x96, 0x11, 0x4c, 0x49, 0x93, 0x9a, 0xb9, 0x77, 0x92, 0x69, 0x59, 0xb7, 0x53,
-	0xe7, 0x33, 0x2f, 0xbd, 0x89, 0xa0, 0xb5, 0xf5, 0xd2, 0xdb, 0xcf, 0x7b, 0xfb, 0xdd, 0x8c, 0x76,
-	0x0a, 0xb8, 0xc4, 0x82, 0xbf, 0x9a, 0xb3, 0xe0, 0x25, 0x95, 0xdb, 0x46, 0xa6, 0xf8, 0xfa, 0xf5,
-	0xfd, 0xde, 0x19, 0x28, 0x33, 0x32, 0xdf, 0xf3, 0x72, 0x77, 0x32, 0xf7, 0xd3, 0xbf, 0x41, 0xe9,
-	0xd5, 0xef, 0x32, 0xb7, 0xff, 0xfb, 0xb6, 0xdb, 0x5f, 0x52, 0x8b, 0x6e, 0xed, 0x0b, 0xde, 0x3e,
-	0x17, 0xc9, 0xc1, 0xb4, 0xc6, 0x62, 0x7b, 0x0a, 0x0a, 0x57, 0x63, 0xb2, 0x04, 0x4b, 0xae, 0x38,
-	0xd9, 0x12, 0x11, 0xe2, 0x1a, 0x53, 0xc5, 0xb2, 0x8d, 0xd5, 0x9f, 0xdb, 0x1b, 0xab, 0xd2, 0x96,
-	0xcd, 0xab, 0x3e, 0xf9, 0x9b, 0xec, 0x66, 0xfb, 0x9e, 0xdd, 0x7e, 0x89, 0x93, 0xf2, 0x17, 0xd9,
-	0x24, 0xb9, 0x4c, 0xad, 0xd6, 0x71, 0x6d, 0xe1, 0x3d, 0x79, 0xd0, 0x86, 0x7e, 0xc6, 0x72, 0xa9,
-	0xb2, 0xdc, 0xaa, 0x88, 0xe8, 0x74, 0x5f, 0xae, 0x91, 0x06, 0x04, 0xbe, 0x1d, 0x8a, 0x17, 0xc2,
-	0xfb, 0xf2, 0x4a, 0x79, 0x5a, 0xd6, 0x2f, 0x86, 0xd7, 0x0a, 0x5f, 0x0c, 0x9f, 0x27, 0x8d, 0x68,
-	0x53, 0xc6, 0x0b, 0xe4, 0x1d, 0x54, 0x55, 0x2e, 0x33, 0x45, 0x3f, 0xb0, 0x4d, 0x51, 0x51, 0xcf,
-	0xac, 0x73, 0x50, 0xf3, 0xd5, 0x58, 0x3c, 0x8e, 0x12, 0x6f, 0xd2, 0x7b, 0x62, 0x1f, 0xaa, 0xde,
-	0xa2, 0x3f, 0x43, 0xc8, 0xd2, 0xf6, 0xc6, 0x16, 0x4f, 0xa4, 0xbd, 0xc6, 0x47, 0x8b, 0x34, 0x04,
-	0x7c, 0x85, 0x8b, 0x5b, 0xf2, 0x96, 0x6d, 0xe5, 0xe2, 0x16, 0x94, 0x7b, 0x5b, 0xf2, 0xa4, 0xa2,
-	0xd2, 0xdb, 0x82, 0x0e, 0x5d, 0x1a, 0xf5, 0x27, 0xe3, 0x70, 0x94, 0xc8, 0x24, 0xcf, 0xb4, 0x0c,
-	0xb8, 0xa5, 0x20, 0xe6, 0xdd, 0x20, 0xb9, 0x83, 0x11, 0xb3, 0x19, 0x96, 0x96, 0xfd, 0xcf, 0x56,
-	0x88, 0x99, 0xcb, 0xbb, 0x8c, 0x8f, 0x57, 0xf7, 0xf8, 0x28, 0x0e, 0x93, 0x70, 0x87, 0x4b, 0x2e,
-	0xb3, 0x60, 0xe0, 0xf6, 0xe2, 0x64, 0xc2, 0x47, 0x7d, 0x30, 0xc4, 0xc8, 0x6d, 0x83, 0x19, 0x10,
-	0x58, 0xb9, 0x6f, 0x45, 0x61, 0xc2, 0xd7, 0xee, 0x44, 0x3c, 0xbe, 0x33, 0x1e, 0x88, 0x31, 0xaa,
-	0xb3, 0x0c, 0x94, 0x9e, 0x23, 0x4d, 0xc6, 0x83, 0xbe, 0x26, 0xab, 0x21, 0x99, 0x0d, 0xc4, 0xe7,
-	0xbf, 0x93, 0x71, 0x14, 0x6c, 0xf2, 0xe5, 0x60, 0x12, 0x6c, 0x84, 0xc9, 0x9e, 0x8c, 0x0a, 0x66,
-	0xc1, 0x69, 0x62, 0xe8, 0xf2, 0x9d, 0x20, 0x92, 0x5d, 0xd5, 0x00, 0x3a, 0x47, 0xaa, 0x6b, 0x89,
-	0x3a, 0xb9, 0x84, 0x9f, 0x78, 0x0f, 0x36, 0xd8, 0x8c, 0x91, 0x44, 0x5e, 0x91, 0xd1, 0x00, 0xff,
-	0x87, 0x5e, 0xf1, 0xab, 0x0a, 0x2e, 0x67, 0x8e, 0x4d, 0xa4, 0x51, 0xab, 0xb0, 0x09, 0xbe, 0x20,
-	0x19, 0x27, 0xe9, 0x9b, 0x92, 0x71, 0x62, 0x26, 0x55, 0xd7, 0xac, 0x17, 0xe2, 0x73, 0xaf, 0x12,
-	0x94, 0x68, 0xe0, 0x0f, 0x5d, 0x1a, 0x58, 0x96, 0x30, 0xf1, 0x6b, 0x1e, 0x99, 0x06, 0x1b, 0xbb,
-	0x3a, 0xc1, 0x5c, 0xbb, 0xd5, 0x89, 0x4c, 0x90, 0xaa, 0xac, 0x4e, 0x40, 0x31, 0x46, 0xfc, 0xae,
-	0x3a, 0x6b, 0xc3, 0x5b, 0xda, 0xaa, 0x9c, 0xff, 0x77, 0x0c, 0xe2, 0x7d, 0xac, 0xcc, 0xbf, 0x63,
-	0x38, 0x43, 0xc8, 0x15, 0x9e, 0xac, 0x4e, 0x44, 0x38, 0x56, 0x8c, 0x9e, 0x01, 0x49, 0x2f, 0x13,
-	0xd6, 0xed, 0x50, 0x6f, 0x7a, 0x99, 0x10, 0x16, 0x11, 0xe7, 0x5b, 0x18, 0xa5, 0x37, 0x58, 0xec,
-	0x53, 0x00, 0x39, 0x59, 0x8c, 0x53, 0x80, 0x92, 0x24, 0x81, 0x1f, 0xd9, 0x49, 0x02, 0xae, 0xa6,
-	0x9d, 0x27, 0x59, 0x8e, 0xe7, 0x38, 0xfe, 0x97, 0x8f, 0x32, 0xb2, 0x9d, 0x28, 0x59, 0x0f, 0x7f,
-	0xec, 0x3c, 0xc9, 0x72, 0xb0, 0xa8, 0xbb, 0xf2, 0x55, 0xaf, 0xe4, 0x49, 0x92, 0xf4, 0x96, 0x98,
-	0x87, 0x7c, 0xe3, 0xef, 0x82, 0xff, 0xe7, 0xa3, 0x33, 0xd0, 0xab, 0x66, 0x06, 0x7a, 0xd9, 0x6d,
-	0x99, 0x9f, 0xd8, 0xb7, 0x65, 0x0a, 0xb9, 0xd0, 0xcc, 0xfe, 0x4d, 0x85, 0x34, 0x2e, 0x87, 0x22,
-	0xc6, 0x01, 0x8a, 0x10, 0xf3, 0x97, 0xb6, 0xf9, 0x68, 0x83, 0xcb, 0x83, 0x8d, 0xb4, 0x0c, 0x3c,
-	0x0e, 0x30, 0x1b, 0x41, 0x3e, 0xb9, 0x8b, 0x05, 0x80, 0x0e, 0x79, 0xb4, 0xc9, 0xe5, 0xc2, 0x20,
-	0x0a, 0x18, 0x8e, 0xd8, 0x4d, 0xf8, 0x28, 0x51, 0x01, 0x62, 0x51, 0x42, 0x6a, 0xfc, 0xaf, 0x1e,
-	0x75, 0x71, 0xaf, 0x0a, 0x0b, 0x60, 0xa9, 0x63, 0x79, 0x4a, 0x39, 0x85, 0x70, 0x55, 0x04, 0x9b,
-	0xd1, 0x4f, 0x33, 0x81, 0x85, 0x2d, 0xd1, 0x00, 0x3c, 0xbb, 0x40, 0x9d, 0x02, 0xac, 0x78, 0x9a,
-	0x5e, 0x03, 0xa0, 0xd6, 0x61, 0x28, 0x3c, 0x3b, 0xf1, 0x10, 0x81, 0x2a, 0x22, 0x46, 0xe6, 0xe2,
-	0x12, 0x89, 0x11, 0x45, 0x5c, 0xaa, 0xc6, 0x77, 0x45, 0x12, 0xaf, 0x78, 0x70, 0x20, 0x2d, 0xc3,
-	0x24, 0xbd, 0x1d, 0x0e, 0x78, 0x2f, 0x7c, 0x99, 0x2f, 0xed, 0x81, 0x37, 0x7b, 0x40, 0x4c, 0x52,
-	0x0b, 0xe8, 0x7f, 0xdc, 0x73, 0xbd, 0x1a, 0x43, 0xdf, 0x40, 0x66, 0x94, 0x90, 0x95, 0x1b, 0x7c,
-	0x28, 0x4d, 0x27, 0x1f, 0xc8, 0x43, 0xcc, 0x94, 0xa2, 0x2c, 0xa2, 0xfd, 0x97, 0x76, 0x44, 0x3b,
-	0xdf, 0x56, 0x3a, 0xb4, 0x4b, 0xe4, 0x3d, 0x8d, 0x0b, 0x17, 0x9e, 0x42, 0xba, 0xff, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0xbc, 0xac, 0x61, 0x99, 0x62, 0x6a, 0x00, 0x00,
+	// 7243 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3d, 0x6d, 0x6c, 0x5d, 0xc9,
+	0x55, 0xba, 0xef, 0xc3, 0x7e, 0x6f, 0x6c, 0x27, 0xce, 0x8d, 0x93, 0xbd, 0xf1, 0x26, 0x59, 0xe7,
+	0x76, 0xb7, 0x9b, 0xee, 0xb6, 0xd9, 0xae, 0xd5, 0x6e, 0xb7, 0xdb, 0x76, 0x5b, 0xdb, 0x2f, 0x9b,
+	0xbc, 0x6e, 0x1c, 0xbf, 0xcc, 0xf3, 0x26, 0xd0, 0x96, 0xd2, 0x6b, 0xbf, 0xb1, 0x73, 0xeb, 0xf7,
+	0xb5, 0xf7, 0x5e, 0x3b, 0xf6, 0xaa, 0xa8, 0xdb, 0x56, 0xa2, 0x40, 0x85, 0xa0, 0x42, 0xd0, 0x0f,
+	0x41, 0x81, 0xd2, 0x16, 0x5a, 0x5a, 0xa0, 0xa5, 0xa5, 0xa5, 0x6c, 0x0b, 0xdd, 0x7e, 0x50, 0x21,
+	0xc4, 0x3f, 0x24, 0xc4, 0x0f, 0x24, 0xfe, 0x22, 0x40, 0xf0, 0x87, 0x0f, 0x09, 0x24, 0x74, 0xce,
+	0xcc, 0xdc, 0x99, 0xb9, 0x77, 0xee, 0x75, 0x12, 0x91, 0xfd, 0xf5, 0xee, 0x9c, 0x73, 0x66, 0xce,
+	0x99, 0xaf, 0x33, 0x67, 0xce, 0x9c, 0x99, 0x47, 0xc8, 0x80, 0x25, 0xc1, 0x85, 0x71, 0x34, 0x4a,
+	0x46, 0x6e, 0x1d, 0x7f, 0xfc, 0xff, 0x9a, 0x22, 0xb5, 0x56, 0x90, 0x04, 0xae, 0x4b, 0x6a, 0xeb,
+	0x2c, 0x1a, 0x78, 0xce, 0x42, 0xe5, 0x7c, 0x8d, 0xe2, 0xb7, 0x3b, 0x47, 0xea, 0xed, 0x61, 0x8f,
+	0xed, 0x7b, 0x15, 0x04, 0xf2, 0x84, 0x7b, 0x9a, 0x34, 0x57, 0xfa, 0xbb, 0x71, 0xc2, 0xa2, 0x76,
+	0xcb, 0xab, 0x22, 0x46, 0x01, 0xdc, 0x87, 0x48, 0xfd, 0xea, 0xa8, 0xc7, 0x62, 0xaf, 0xb6, 0x50,
+	0x3d, 0x3f, 0xb5, 0x78, 0x94, 0xb3, 0xbb, 0x00, 0xb0, 0xf6, 0x70, 0x6b, 0x44, 0x39, 0xd6, 0x7d,
+	0x9c, 0x34, 0x81, 0xed, 0x46, 0x10, 0xb3, 0xd8, 0xab, 0x23, 0xe9, 0x71, 0x41, 0x2a, 0xe1, 0x48,
+	0xae, 0xa8, 0xa0, 0xe4, 0xe7, 0x62, 0x16, 0xc5, 0xde, 0x84, 0x51, 0x32, 0xc0, 0x78, 0xc9, 0x88,
+	0x05, 0xf1, 0x56, 0x83, 0x7d, 0xe4, 0xd7, 0xf2, 0x26, 0xb9, 0x78, 0x29, 0xc0, 0x3d, 0x4f, 0x8e,
+	0xae, 0x06, 0xfb, 0xdd, 0x9b, 0x41, 0xd4, 0xbb, 0x14, 0x8d, 0x76, 0xc7, 0xed, 0x96, 0xd7, 0x40,
+	0x9a, 0x2c, 0xd8, 0x3d, 0x4b, 0x88, 0x04, 0xb5, 0x5b, 0x5e, 0x13, 0x89, 0x34, 0x88, 0xfb, 0x3a,
+	0x5e, 0x03, 0x5e, 0x59, 0x62, 0x88, 0x24, 0xe1, 0x54, 0x51, 0x00, 0xf9, 0x2a, 0x93, 0xe4, 0x53,
+	0xf6, 0xb6, 0x51, 0x14, 0xae, 0x4f, 0xa6, 0x45, 0x9b, 0x76, 0x92, 0xab, 0xbb, 0x03, 0xef, 0xc8,
+	0x42, 0xe5, 0xfc, 0x0c, 0x35, 0x60, 0xee, 0x63, 0x64, 0xa2, 0x93, 0x5c, 0x0f, 0xd9, 0x2d, 0xef,
+	0x28, 0x96, 0x77, 0x9f, 0xc6, 0xfe, 0x02, 0xc7, 0x5c, 0x1c, 0x26, 0xd1, 0x01, 0x15, 0x64, 0x50,
+	0x28, 0xe6, 0xec, 0xb0, 0x08, 0xb8, 0x78, 0xb3, 0x0b, 0x0e, 0x14, 0xaa, 0xc3, 0x44, 0x03, 0x61,
+	0x4f, 0xcb, 0x06, 0x3a, 0x96, 0x36, 0x90, 0x0e, 0x16, 0x0d, 0x84, 0xa0, 0x76, 0xcb, 0x73, 0xd3,
+	0x06, 0x12, 0x10, 0xe0, 0xb6, 0x1a, 0xec, 0x5f, 0xdc, 0x63, 0xc3, 0x64, 0x6d, 0xdc, 0xee, 0x79,
+	0xc7, 0x17, 0x9c, 0xf3, 0x35, 0x6a, 0xc0, 0x80, 0xdb, 0x7a, 0xb0, 0xc3, 0xd6, 0xf6, 0x58, 0x74,
+	0x71, 0x18, 0x6c, 0xf4, 0x59, 0xcf, 0x9b, 0x5b, 0x70, 0xce, 0x37, 0x68, 0x16, 0xec, 0xbe, 0x8d,
+	0xcc, 0xac, 0x86, 0xdb, 0x51, 0x90, 0x30, 0xcc, 0x1d, 0x7b, 0x27, 0x8c, 0x3a, 0xeb, 0x38, 0x6c,
+	0x4b, 0x93, 0x1a, 0x18, 0x2d, 0x07, 0xfd, 0x60, 0xb8, 0xa9, 0x18, 0x9d, 0xe4, 0x8c, 0x32, 0x60,
+	0xd1, 0x00, 0xad, 0xd1, 0xad, 0x61, 0x37, 0x18, 0x8c, 0xfb, 0x30, 0x8a, 0xee, 0x43, 0xc9, 0xb3,
+	0x60, 0xf7, 0x51, 0x32, 0xd9, 0x4d, 0x22, 0x16, 0x0c, 0x62, 0xcf, 0x43, 0x61, 0x8e, 0x09, 0x61,
+	0x38, 0x14, 0xc5, 0x90, 0x14, 0xee, 0x02, 0x99, 0x82, 0xc1, 0xc3, 0x31, 0x2d, 0xef, 0x14, 0x16,
+	0xa9, 0x83, 0xc4, 0xc0, 0x5d, 0x19, 0x0d, 0x87, 0xed, 0x9e, 0x37, 0x8f, 0x78, 0x05, 0x70, 0x9f,
+	0x26, 0x53, 0xd7, 0x76, 0x59, 0x74, 0xd0, 0x6e, 0xb5, 0x87, 0x61, 0xe2, 0xdd, 0x8f, 0x0c, 0x4f,
+	0xeb, 0x3d, 0xae, 0xa1, 0x79, 0xb7, 0xeb, 0x19, 0xdc, 0x16, 0x99, 0xa1, 0x6c, 0xdc, 0x0f, 0x37,
+	0x03, 0xec, 0xbf, 0xd8, 0x3b, 0x8d, 0x25, 0x9c, 0xd5, 0x4b, 0x30, 0x08, 0x78, 0x19, 0x66, 0x26,
+	0xf7, 0xb5, 0xe4, 0x18, 0x88, 0xbc, 0xbb, 0x11, 0x6f, 0x46, 0xe1, 0x38, 0x09, 0x47, 0xc3, 0x76,
+	0xcb, 0x3b, 0x83, 0xb2, 0xe6, 0x11, 0xee, 0x83, 0x64, 0x06, 0x2a, 0x70, 0x6d, 0xe5, 0x66, 0x30,
+	0xdc, 0x86, 0x86, 0x3c, 0x8b, 0x94, 0x26, 0x10, 0x5a, 0xe6, 0xea, 0xee, 0x60, 0x6d, 0x0b, 0x27,
+	0x56, 0xec, 0x3d, 0xb0, 0xe0, 0x9c, 0xaf, 0x53, 0x1d, 0x04, 0x5d, 0xd2, 0x8e, 0xbb, 0xd7, 0xae,
+	0x84, 0x09, 0x93, 0x9d, 0xb7, 0xc0, 0x3b, 0x2f, 0x03, 0x76, 0x1f, 0x25, 0x8d, 0xee, 0xf3, 0x7d,
+	0x3e, 0xc9, 0xce, 0xd9, 0xe7, 0x64, 0x4a, 0xe0, 0xce, 0x93, 0xc6, 0x6a, 0xb0, 0xbf, 0x1a, 0x27,
+	0xed, 0x96, 0xe7, 0xa3, 0x64, 0x69, 0x7a, 0xfe, 0x9d, 0x64, 0x4a, 0x9b, 0x41, 0xee, 0x2c, 0xa9,
+	0xee, 0xb0, 0x03, 0xcf, 0x59, 0x70, 0xce, 0x37, 0x29, 0x7c, 0x82, 0x36, 0xda, 0x0b, 0xfa, 0xbb,
+	0xcc, 0xab, 0x2c, 0x38, 0x3a, 0x9b, 0xe5, 0x0e, 0x1f, 0x7f, 0x1c, 0xfb, 0x54, 0xe5, 0x49, 0x67,
+	0xfe, 0x69, 0x32, 0x9b, 0xed, 0x1b, 0x4b, 0x81, 0x73, 0x7a, 0x81, 0x35, 0x3d, 0xff, 0x73, 0xc4,
+	0xcd, 0xf7, 0x8c, 0xa5, 0x84, 0xd7, 0x98, 0x22, 0x49, 0x7d, 0x2a, 0xf2, 0x42, 0x9f, 0xc4, 0x5a,
+	0xb1, 0xfe, 0x5b, 0xc8, 0xb4, 0x8e, 0x72, 0x1f, 0x25, 0x13, 0x62, 0x68, 0x38, 0x86, 0x3e, 0xd6,
+	0x79, 0x53, 0x41, 0xe2, 0xff, 0xbc, 0x93, 0xe6, 0x46, 0x88, 0x7b, 0x84, 0x54, 0xda, 0x2d, 0x5c,
+	0x3d, 0x66, 0x68, 0xa5, 0xdd, 0xe2, 0x8d, 0x2b, 0x16, 0x89, 0x0a, 0x42, 0xd3, 0xb4, 0x7b, 0x8e,
+	0xd4, 0x3b, 0x0c, 0x34, 0x79, 0x15, 0x19, 0x4d, 0x09, 0x46, 0x00, 0xa3, 0x1c, 0xe3, 0x9e, 0x24,
+	0x13, 0xdd, 0x24, 0x48, 0x76, 0x61, 0x1d, 0x81, 0xcc, 0x22, 0x95, 0x2e, 0x53, 0x75, 0xb5, 0x4c,
+	0xf9, 0x8f, 0x90, 0x1a, 0x64, 0xca, 0x89, 0xe0, 0x92, 0x1a, 0x1d, 0xf5, 0x99, 0x60, 0x8f, 0xdf,
+	0xfe, 0x39, 0x32, 0xd9, 0x49, 0xd6, 0x6e, 0x0d, 0x59, 0x04, 0x2c, 0xc4, 0x2a, 0xc1, 0xd7, 0x3c,
+	0x91, 0xf2, 0x5f, 0x74, 0xc8, 0x04, 0xef, 0x44, 0xf7, 0x41, 0x52, 0x47, 0x5a, 0xa4, 0x98, 0x5a,
+	0x3c, 0x22, 0x05, 0xe5, 0x25, 0xd0, 0x7a, 0x5a, 0x90, 0x90, 0xb5, 0x92, 0x95, 0xb5, 0x93, 0xb4,
+	0x7b, 0xb8, 0x46, 0xce, 0x50, 0xfc, 0x86, 0x5e, 0xbb, 0xce, 0x22, 0xaf, 0x86, 0x7d, 0x0c, 0x9f,
+	0x28, 0xe5, 0xa5, 0x76, 0xcb, 0xab, 0xa3, 0x32, 0xc6, 0x6f, 0xff, 0x75, 0xa4, 0x21, 0x07, 0x92,
+	0x7b, 0x8e, 0xd4, 0x5a, 0x1b, 0x9d, 0x44, 0x74, 0xca, 0x4c, 0x2a, 0x02, 0x20, 0x29, 0xa2, 0xfc,
+	0x7f, 0x75, 0x48, 0x43, 0x2e, 0x22, 0x5a, 0x2b, 0xd4, 0x64, 0x2b, 0x5c, 0x1e, 0xc5, 0x09, 0xca,
+	0xd6, 0xa4, 0xf8, 0xed, 0x7a, 0x64, 0x92, 0x76, 0x56, 0x96, 0x7a, 0xbd, 0x08, 0xd9, 0x36, 0xa9,
+	0x4c, 0x02, 0x66, 0x7d, 0xa5, 0x83, 0x19, 0xaa, 0x1c, 0x23, 0x92, 0x99, 0x1e, 0xa9, 0xa6, 0xb5,
+	0x9c, 0x23, 0xf5, 0x2b, 0xeb, 0xe1, 0x80, 0x79, 0x13, 0xdc, 0x48, 0xc0, 0x04, 0x2c, 0x0e, 0x97,
+	0x46, 0x71, 0x1c, 0x8e, 0x91, 0xc9, 0x24, 0xf2, 0xd6, 0x20, 0x30, 0xa5, 0xbb, 0x6c, 0x3b, 0x62,
+	0xdb, 0x41, 0xc2, 0x44, 0xb1, 0x0d, 0xae, 0x65, 0x33, 0xe0, 0xb4, 0x17, 0x09, 0x8a, 0xc3, 0x7b,
+	0x91, 0x91, 0x86, 0x9c, 0xcf, 0xee, 0x03, 0xa4, 0x72, 0x35, 0x14, 0x1d, 0x94, 0x5b, 0x51, 0x2b,
+	0x57, 0x43, 0x10, 0x1c, 0x75, 0x68, 0x4b, 0xcc, 0x2c, 0x91, 0x02, 0xbd, 0xb3, 0xd4, 0x0f, 0xf7,
+	0x98, 0x40, 0x56, 0xb9, 0x46, 0xd6, 0x40, 0xfe, 0xdf, 0x57, 0xc9, 0xb4, 0x6e, 0x8d, 0x80, 0x2c,
+	0x57, 0x83, 0x01, 0x43, 0x6e, 0x4d, 0x8a, 0xdf, 0xee, 0x13, 0xe4, 0x64, 0x8b, 0x6d, 0x05, 0xbb,
+	0xfd, 0x84, 0xb2, 0x84, 0x0d, 0x61, 0x2e, 0x75, 0x46, 0xfd, 0x70, 0xf3, 0x40, 0xb4, 0x78, 0x01,
+	0xd6, 0xbd, 0x4c, 0x8e, 0x99, 0xa0, 0x90, 0xc9, 0x09, 0x31, 0x9f, 0xce, 0x3c, 0x23, 0x0b, 0xd6,
+	0x28, 0x9f, 0x09, 0x4a, 0x5a, 0x19, 0x0d, 0x93, 0x70, 0xb8, 0x3b, 0xda, 0x8d, 0x41, 0xd3, 0x84,
+	0xa9, 0xf9, 0x25, 0x4b, 0x32, 0xf1, 0xa2, 0xa4, 0x5c, 0x26, 0xbe, 0x48, 0x45, 0x3b, 0x2d, 0xd6,
+	0x67, 0x09, 0xeb, 0xe1, 0xd8, 0x68, 0x50, 0x1d, 0xe4, 0x3e, 0x46, 0x1a, 0xa8, 0x94, 0x9f, 0x65,
+	0x07, 0xde, 0x84, 0xa1, 0x66, 0x24, 0x18, 0xcb, 0x4e, 0x89, 0xdc, 0x57, 0x93, 0x23, 0x5c, 0x39,
+	0xaf, 0x07, 0xdb, 0x4b, 0x51, 0x14, 0x1c, 0x78, 0x93, 0x58, 0x6a, 0x06, 0x0a, 0xfa, 0x42, 0xe8,
+	0x93, 0xab, 0x38, 0x12, 0xaa, 0x34, 0x4d, 0xc3, 0x42, 0xbb, 0x86, 0x6b, 0x0a, 0xac, 0xfa, 0x8e,
+	0xb6, 0xd0, 0xae, 0x6d, 0xc4, 0x02, 0x41, 0x25, 0x85, 0xfb, 0x6a, 0x52, 0xbf, 0xb6, 0x3b, 0x4a,
+	0x02, 0x5c, 0xdf, 0xa7, 0x16, 0x67, 0x05, 0x29, 0xc2, 0xb8, 0x66, 0xc6, 0x4f, 0xff, 0x1b, 0x0e,
+	0x39, 0x9e, 0x69, 0xe0, 0xee, 0x98, 0x6d, 0x6a, 0x7d, 0xec, 0xa4, 0x7d, 0x3c, 0x4f, 0x1a, 0xad,
+	0xdd, 0x08, 0xf5, 0x24, 0x0e, 0xa2, 0x2a, 0x4d, 0xd3, 0xee, 0x05, 0xe2, 0x2a, 0xbb, 0x31, 0xa5,
+	0xaa, 0x22, 0x95, 0x05, 0x63, 0x54, 0xb4, 0x86, 0x73, 0x5e, 0x55, 0xd4, 0x27, 0xd3, 0x37, 0x82,
+	0x68, 0x90, 0x96, 0x52, 0xc7, 0x52, 0x0c, 0x98, 0xff, 0xb5, 0x3a, 0x39, 0xba, 0xca, 0x82, 0x78,
+	0x37, 0x62, 0x03, 0x61, 0xec, 0x58, 0xc7, 0xe5, 0xe3, 0xa4, 0x29, 0x3b, 0x01, 0x14, 0x53, 0xb5,
+	0xa8, 0xab, 0x14, 0x95, 0xfb, 0x14, 0x99, 0xe8, 0x6e, 0xde, 0x64, 0x83, 0x40, 0x8c, 0x43, 0x5f,
+	0x1a, 0x57, 0x26, 0xbb, 0x0b, 0x9c, 0x48, 0xd8, 0x96, 0x3c, 0x91, 0x1d, 0x3a, 0xb5, 0xfc, 0xd0,
+	0x79, 0x8a, 0xcc, 0x84, 0x60, 0x1a, 0x52, 0xd6, 0x57, 0xb5, 0x9b, 0x5a, 0x9c, 0x13, 0x4c, 0xda,
+	0x3a, 0x8e, 0x9a, 0xa4, 0xa0, 0x4e, 0x2e, 0x0e, 0xb7, 0xc3, 0x21, 0x5b, 0x3f, 0x18, 0x33, 0x1c,
+	0x78, 0x33, 0x54, 0x83, 0xb8, 0x6f, 0x22, 0xd3, 0x2b, 0xa3, 0x7e, 0x37, 0x19, 0x45, 0x38, 0x51,
+	0x71, 0x8c, 0xa9, 0xfa, 0xea, 0x28, 0x6a, 0x10, 0xba, 0x8f, 0x13, 0xa2, 0x06, 0x91, 0xd7, 0x28,
+	0x1a, 0x5d, 0x1a, 0x91, 0xfb, 0x0c, 0x21, 0x7c, 0x0f, 0xd0, 0xdb, 0x67, 0xb1, 0xd7, 0xc4, 0x96,
+	0x7a, 0x75, 0x51, 0x4b, 0xa5, 0x84, 0xbc, 0xb5, 0xb4, 0x9c, 0x68, 0xd5, 0x0c, 0xc3, 0x44, 0xb7,
+	0x7d, 0x08, 0xda, 0x3e, 0x59, 0xb0, 0x50, 0xe9, 0x53, 0x0b, 0x8e, 0x50, 0xe9, 0xe7, 0xb3, 0xf3,
+	0x41, 0x2e, 0x4c, 0xd9, 0xc9, 0x30, 0xff, 0x66, 0x32, 0xa5, 0x75, 0xd6, 0x61, 0x56, 0x47, 0x5d,
+	0xb7, 0x3a, 0x9e, 0x25, 0x47, 0x33, 0xd2, 0xeb, 0xd9, 0x6b, 0x3c, 0xbb, 0x6f, 0x9a, 0x1c, 0xd3,
+	0xb2, 0x2f, 0x21, 0x8f, 0x6e, 0x6b, 0xfc, 0x47, 0x3d, 0x37, 0xd9, 0x0a, 0x07, 0xae, 0x39, 0xd9,
+	0x2a, 0xb7, 0x35, 0xd9, 0x2a, 0xb7, 0x35, 0xd9, 0x2a, 0xc6, 0x64, 0x7b, 0x8a, 0x4c, 0x6b, 0xdd,
+	0x25, 0x77, 0xa1, 0x27, 0xed, 0x3d, 0x49, 0x0d, 0x5a, 0x77, 0x95, 0x4c, 0xad, 0xc6, 0xc9, 0x75,
+	0x16, 0xc5, 0xd8, 0x0b, 0x47, 0x30, 0xeb, 0xa3, 0xc5, 0x6a, 0xfb, 0x82, 0x46, 0x2d, 0x8c, 0x73,
+	0x0d, 0xe2, 0xbe, 0x89, 0x4c, 0x29, 0xe1, 0xe5, 0x06, 0xf7, 0x84, 0x3e, 0x5b, 0x11, 0x83, 0x82,
+	0xe8, 0x94, 0xb0, 0x2b, 0xd2, 0x6d, 0xee, 0xd8, 0x9b, 0x34, 0x76, 0x45, 0x3a, 0x8e, 0xef, 0x8a,
+	0x0c, 0xea, 0xec, 0xa4, 0x6d, 0xe4, 0x27, 0xed, 0x02, 0x99, 0xba, 0x3c, 0x4a, 0xd2, 0x96, 0x6e,
+	0x62, 0x4b, 0xeb, 0xa0, 0x9c, 0xce, 0x22, 0x48, 0x62, 0xc0, 0xa0, 0xdb, 0xd4, 0xd6, 0x31, 0xa5,
+	0x9c, 0xe2, 0xdd, 0x96, 0xc7, 0x40, 0x7b, 0x28, 0x68, 0xec, 0x4d, 0x1b, 0xed, 0xa1, 0x30, 0xbc,
+	0x3d, 0x34, 0x4a, 0x77, 0x8d, 0xcc, 0xa9, 0x2d, 0x9a, 0x6a, 0x7e, 0x6f, 0x06, 0x87, 0xe7, 0xfd,
+	0xd2, 0x48, 0xb7, 0x90, 0x50, 0x6b, 0x46, 0xb0, 0xdd, 0xb3, 0x5d, 0x77, 0xd8, 0x2c, 0x9a, 0xd1,
+	0x07, 0x7e, 0x40, 0x8e, 0x5b, 0xd6, 0x5e, 0xeb, 0xb8, 0x9f, 0x83, 0x85, 0x8b, 0x45, 0xd2, 0x6e,
+	0xe0, 0x09, 0xe8, 0x80, 0x2b, 0x41, 0x9c, 0xd0, 0xdd, 0x21, 0x1a, 0x59, 0x7c, 0x5d, 0xd1, 0x41,
+	0xfe, 0xff, 0x38, 0xe4, 0x88, 0x39, 0x46, 0x72, 0x36, 0xe0, 0x69, 0xd2, 0xec, 0x26, 0x41, 0x94,
+	0x60, 0x11, 0x7c, 0x4e, 0x29, 0x00, 0xd8, 0x7c, 0x17, 0x87, 0x3d, 0x51, 0x3c, 0xe0, 0x64, 0x12,
+	0xf2, 0x89, 0x81, 0xb0, 0x94, 0x08, 0xb3, 0x4f, 0x01, 0xdc, 0xf3, 0x64, 0x42, 0xe8, 0x2d, 0x3e,
+	0x75, 0x66, 0xf5, 0x01, 0x8b, 0x6d, 0x2a, 0xf0, 0x50, 0x89, 0xf5, 0x68, 0x77, 0xb8, 0x19, 0xf0,
+	0x92, 0x26, 0x78, 0x25, 0x34, 0x50, 0x46, 0xc1, 0x4f, 0xe6, 0x14, 0xbc, 0x47, 0x26, 0xf7, 0x78,
+	0x27, 0x78, 0xd3, 0x88, 0x94, 0x49, 0xff, 0x13, 0x15, 0xd2, 0x4c, 0x39, 0xe6, 0x6a, 0x7e, 0x96,
+	0x34, 0xd0, 0x48, 0x6f, 0xb7, 0xf8, 0x22, 0x38, 0xb3, 0x5c, 0xf1, 0x1c, 0x9a, 0xc2, 0xa0, 0x2f,
+	0x57, 0x43, 0xae, 0x41, 0x9a, 0x14, 0x3e, 0x11, 0x12, 0xec, 0x7b, 0x35, 0x01, 0x09, 0xf6, 0x71,
+	0xcf, 0x11, 0xb2, 0x28, 0xdd, 0x73, 0x84, 0x0c, 0xed, 0x64, 0xe9, 0xf9, 0xe0, 0x76, 0xaf, 0x4c,
+	0x82, 0x5a, 0x57, 0x23, 0xe9, 0x0a, 0xdb, 0x63, 0x7d, 0x34, 0x7f, 0xab, 0x34, 0x0b, 0x86, 0x99,
+	0x63, 0xb8, 0x19, 0xb8, 0x01, 0x6c, 0xc0, 0xb8, 0x02, 0x0b, 0x7a, 0x6b, 0xc3, 0xfe, 0x81, 0xd7,
+	0xc4, 0xe9, 0x99, 0xa6, 0xb9, 0x03, 0x46, 0x4e, 0x55, 0x5c, 0x3b, 0x1a, 0x54, 0x83, 0xf8, 0x94,
+	0x4c, 0xeb, 0x2b, 0x3d, 0x94, 0x25, 0xd3, 0xb8, 0x9b, 0x68, 0x6a, 0x66, 0x1a, 0xd4, 0xf1, 0x60,
+	0xcc, 0x07, 0x70, 0x93, 0xe2, 0x37, 0xc0, 0xba, 0xdb, 0xa9, 0x65, 0x8c, 0xdf, 0xfe, 0x29, 0x52,
+	0xe7, 0xab, 0xd7, 0x2c, 0xa9, 0xb6, 0x7b, 0xfb, 0x58, 0x4e, 0x9d, 0xc2, 0xa7, 0xff, 0x5e, 0x32,
+	0x9b, 0xd5, 0x37, 0xd6, 0x71, 0xee, 0x92, 0xda, 0xea, 0xa8, 0xc7, 0xe4, 0x86, 0x04, 0xbe, 0xb1,
+	0x29, 0x58, 0x9c, 0x84, 0x43, 0xbe, 0x17, 0x45, 0xfb, 0xa3, 0x49, 0x0d, 0x98, 0xff, 0xa0, 0x58,
+	0x77, 0xcb, 0x77, 0x6f, 0x1f, 0xaf, 0x90, 0x86, 0x74, 0x09, 0x16, 0xb1, 0xbf, 0x1c, 0xc4, 0x37,
+	0xd3, 0xfd, 0x50, 0x10, 0xdf, 0x84, 0xa9, 0xb7, 0xd4, 0x1b, 0x88, 0x71, 0xd0, 0xa0, 0x3c, 0x01,
+	0x2c, 0xe8, 0x2d, 0x28, 0x4b, 0x58, 0x33, 0x22, 0xe5, 0xbe, 0x81, 0x90, 0x4e, 0x14, 0xee, 0x85,
+	0x7d, 0xb6, 0x9d, 0x3a, 0x2f, 0xe7, 0x34, 0x6f, 0x64, 0x8a, 0xa4, 0x1a, 0x9d, 0xb2, 0x4b, 0x27,
+	0x4a, 0xed, 0x52, 0xf7, 0x1a, 0x39, 0xa1, 0x2d, 0x35, 0x1a, 0x23, 0xae, 0xda, 0xef, 0xcf, 0xaf,
+	0x4f, 0x8a, 0x9f, 0x3d, 0xa7, 0xff, 0x8f, 0x0e, 0x69, 0xa6, 0x7c, 0x60, 0x80, 0x76, 0x46, 0xe1,
+	0x30, 0x89, 0x3b, 0x2c, 0xea, 0xb2, 0xcd, 0xd1, 0xb0, 0x87, 0xaa, 0xac, 0x4a, 0xb3, 0x60, 0xb0,
+	0xdd, 0x97, 0x0f, 0x12, 0xa6, 0x11, 0x72, 0xe3, 0x37, 0x03, 0x75, 0x17, 0xc9, 0x1c, 0x77, 0x54,
+	0x6d, 0xee, 0x46, 0x11, 0x1b, 0x26, 0x72, 0x0f, 0xc2, 0x95, 0x95, 0x15, 0x27, 0xbc, 0x5d, 0x5d,
+	0xb9, 0x59, 0x01, 0x42, 0x05, 0x70, 0x5f, 0x4f, 0x8e, 0xaf, 0x06, 0xfb, 0xa8, 0x01, 0x29, 0x8b,
+	0x77, 0xfb, 0x09, 0x72, 0x14, 0xf6, 0xb0, 0x0d, 0xe5, 0xb7, 0xc9, 0x8c, 0xd1, 0xf6, 0x68, 0x46,
+	0x88, 0xbd, 0x9b, 0xe8, 0xff, 0x34, 0x0d, 0xcc, 0x53, 0x42, 0x1c, 0x08, 0x75, 0xaa, 0x00, 0xfe,
+	0x2f, 0x3b, 0x64, 0xce, 0xd6, 0x90, 0xa5, 0x45, 0xc2, 0x52, 0xaa, 0xf2, 0x88, 0x79, 0xa4, 0x83,
+	0x4c, 0xa6, 0xd5, 0x0c, 0x53, 0xc0, 0xae, 0x8c, 0x86, 0xbd, 0x10, 0x57, 0xc6, 0x1a, 0xe6, 0x56,
+	0x00, 0xff, 0x25, 0x87, 0xcc, 0x18, 0x06, 0x32, 0xcc, 0x3f, 0x1a, 0xf6, 0x84, 0xb7, 0x03, 0x3e,
+	0x01, 0xb2, 0x16, 0xf6, 0xb8, 0x96, 0xa3, 0xf0, 0x09, 0x65, 0x62, 0x26, 0x9c, 0x03, 0x7c, 0x4a,
+	0x29, 0x80, 0xfb, 0x7a, 0x42, 0x30, 0x71, 0x25, 0x8c, 0x13, 0xb9, 0x5f, 0x9c, 0xd5, 0xd7, 0x58,
+	0x40, 0x50, 0x8d, 0x06, 0xac, 0x6c, 0x4c, 0x49, 0xe3, 0xd3, 0xf4, 0xdb, 0xeb, 0x28, 0x6a, 0x10,
+	0xfa, 0xe7, 0x48, 0x33, 0x2d, 0x06, 0x4f, 0x15, 0xe0, 0x43, 0xe8, 0x20, 0x9e, 0xf0, 0x7b, 0xc4,
+	0xa3, 0x63, 0xdd, 0xc6, 0x7a, 0x26, 0x64, 0xfd, 0x5e, 0x8c, 0x23, 0xf6, 0x32, 0x99, 0xcd, 0x98,
+	0x63, 0xd2, 0x47, 0x75, 0x3a, 0x3f, 0x1b, 0x54, 0x3e, 0x9a, 0xcb, 0xe5, 0x8f, 0xc8, 0x09, 0x2b,
+	0x29, 0xe8, 0xf3, 0xd5, 0x38, 0xd1, 0x94, 0x85, 0x4c, 0xba, 0x6f, 0x25, 0x04, 0xb4, 0x21, 0xa7,
+	0xf5, 0x2a, 0x45, 0x6c, 0x15, 0x0d, 0xd5, 0xe8, 0xfd, 0x15, 0x83, 0xa1, 0x42, 0x80, 0x72, 0x11,
+	0x45, 0xf2, 0x66, 0x10, 0x29, 0x4d, 0x11, 0xc3, 0x9a, 0x81, 0xdf, 0xfe, 0xc7, 0x2a, 0x84, 0x28,
+	0x9f, 0xb2, 0x55, 0xab, 0xf1, 0x75, 0xaf, 0x92, 0xae, 0x7b, 0x6f, 0x20, 0x13, 0xdd, 0x68, 0x73,
+	0x15, 0xdd, 0x38, 0x15, 0x4d, 0x62, 0x5e, 0x4c, 0xd6, 0xb8, 0x15, 0xb4, 0x90, 0xab, 0xc5, 0x62,
+	0xc8, 0x55, 0xbb, 0x9d, 0x5c, 0x9c, 0x16, 0xa6, 0x45, 0x7b, 0x98, 0xb0, 0x68, 0x2f, 0xe8, 0xe3,
+	0x1a, 0x59, 0xa5, 0x69, 0x1a, 0x3a, 0xbb, 0xc5, 0xfa, 0xc1, 0x01, 0xae, 0x92, 0x55, 0xca, 0x13,
+	0x50, 0x83, 0x56, 0x38, 0xe0, 0x2a, 0xad, 0x49, 0xf1, 0xdb, 0x7d, 0x98, 0xd4, 0x57, 0x82, 0x7e,
+	0x1f, 0x36, 0x61, 0x79, 0x5f, 0x3a, 0x60, 0x28, 0xc7, 0xfb, 0x4f, 0x90, 0x29, 0xd5, 0x18, 0x98,
+	0x4f, 0x1f, 0x11, 0x16, 0x1f, 0x3c, 0xc7, 0xfb, 0xcf, 0x93, 0x13, 0xd6, 0x7a, 0x14, 0x6e, 0x42,
+	0xe4, 0x54, 0xaf, 0x64, 0xa6, 0xfa, 0x79, 0x72, 0x34, 0xeb, 0xea, 0xe1, 0xf6, 0x43, 0x16, 0xec,
+	0x5f, 0x91, 0xfd, 0x06, 0x92, 0x03, 0x1f, 0xf8, 0x95, 0x7c, 0x10, 0x36, 0x47, 0xea, 0xd8, 0xf1,
+	0xd2, 0xe8, 0xc3, 0x04, 0xae, 0x47, 0xfd, 0x30, 0x88, 0x45, 0xb9, 0x3c, 0xe1, 0xff, 0x93, 0x63,
+	0xee, 0x72, 0xc1, 0x00, 0xe8, 0x44, 0xe1, 0x20, 0x88, 0x0e, 0xd4, 0x92, 0xae, 0x41, 0x60, 0x50,
+	0x77, 0x47, 0x51, 0x02, 0xc8, 0x0a, 0x22, 0x65, 0x12, 0xb4, 0x55, 0x27, 0x1a, 0x8d, 0x59, 0x94,
+	0x60, 0x56, 0xae, 0x1b, 0x74, 0x10, 0xf8, 0xee, 0x65, 0xf2, 0x3a, 0x9a, 0xb6, 0x35, 0xa4, 0x31,
+	0x81, 0xa0, 0xa7, 0xc1, 0x50, 0x14, 0xc7, 0x52, 0x19, 0xbf, 0x85, 0x0d, 0x05, 0x6b, 0xca, 0xca,
+	0x68, 0x30, 0x0e, 0x36, 0x21, 0x95, 0xee, 0xe6, 0xeb, 0x34, 0x03, 0xf5, 0x6f, 0x91, 0x29, 0x4d,
+	0x85, 0xc0, 0x74, 0x59, 0x1f, 0xed, 0xb0, 0x61, 0x2c, 0xcc, 0x6e, 0x91, 0x82, 0x26, 0xc0, 0xaf,
+	0xf0, 0x05, 0xf0, 0x27, 0x73, 0xad, 0xab, 0x41, 0x8a, 0x04, 0xac, 0x16, 0x0a, 0xe8, 0x3f, 0x69,
+	0x2a, 0x39, 0xf7, 0xbc, 0x39, 0xbe, 0xdc, 0xbc, 0xb6, 0x93, 0x03, 0xec, 0x47, 0xc7, 0xc8, 0xe4,
+	0xca, 0x68, 0x30, 0x08, 0x86, 0x3d, 0xf7, 0x61, 0x52, 0x4b, 0xa0, 0x72, 0xd0, 0xd7, 0x47, 0x34,
+	0x47, 0x04, 0x62, 0x2f, 0x40, 0x0d, 0x29, 0x12, 0xf8, 0x1f, 0x3d, 0xc6, 0x27, 0xbc, 0x7b, 0x8a,
+	0x9c, 0x58, 0x89, 0x58, 0x90, 0x30, 0x39, 0xce, 0x04, 0xf1, 0x6c, 0xd5, 0xbd, 0x8f, 0x1c, 0x6f,
+	0x45, 0xa3, 0x71, 0x16, 0x51, 0x73, 0x17, 0xc8, 0x69, 0x9e, 0x27, 0x33, 0xf0, 0x24, 0x45, 0xdd,
+	0x3d, 0x4b, 0xe6, 0x21, 0x6b, 0x01, 0x7e, 0xc2, 0x7d, 0x90, 0x2c, 0x74, 0x59, 0x62, 0x77, 0x51,
+	0x4a, 0xaa, 0x49, 0xe0, 0xf3, 0xdc, 0xb8, 0x57, 0xcc, 0xa7, 0xe1, 0xde, 0x4f, 0xee, 0xe3, 0x92,
+	0xa8, 0x9d, 0x88, 0x44, 0x36, 0x01, 0xc9, 0x4d, 0xd2, 0x3c, 0x92, 0xb8, 0x27, 0xc8, 0x31, 0x9e,
+	0x13, 0x96, 0x6f, 0x09, 0x9e, 0x71, 0x8f, 0x93, 0xa3, 0x20, 0xb8, 0x0e, 0x3c, 0x02, 0xb4, 0x5c,
+	0x0e, 0x1d, 0x7c, 0x14, 0xda, 0xa7, 0xcb, 0xd4, 0x3a, 0x2d, 0x11, 0xb3, 0xae, 0x4b, 0x8e, 0x40,
+	0xed, 0x82, 0x24, 0x90, 0xb0, 0x63, 0xee, 0x69, 0xe2, 0x75, 0x59, 0x82, 0x16, 0x5e, 0x2e, 0x87,
+	0xeb, 0x9e, 0x21, 0xa7, 0x44, 0x3d, 0x34, 0x53, 0x56, 0xa2, 0x4f, 0x60, 0x4d, 0xa2, 0xd1, 0xd8,
+	0x86, 0x3c, 0xa9, 0x7a, 0x50, 0x1e, 0xe3, 0x4a, 0x94, 0x67, 0x76, 0xae, 0x8e, 0x3a, 0x05, 0x28,
+	0x5e, 0xa7, 0x2c, 0x6a, 0x1e, 0x50, 0xbc, 0xdd, 0xb2, 0x05, 0xde, 0xaf, 0x50, 0xd9, 0x5c, 0xa7,
+	0xdd, 0x93, 0xc4, 0xed, 0xb2, 0x24, 0x9b, 0xe5, 0x8c, 0x3b, 0x47, 0x66, 0x51, 0x76, 0xe8, 0x03,
+	0x09, 0x3d, 0x0b, 0x15, 0xc6, 0x2d, 0x83, 0x18, 0x5b, 0xbc, 0x50, 0x89, 0x7e, 0x00, 0x2a, 0xcc,
+	0xa5, 0x53, 0xa6, 0xb7, 0x44, 0xbe, 0x0a, 0x06, 0x0f, 0xe4, 0xcd, 0x0c, 0x0a, 0xb3, 0x88, 0x87,
+	0xa1, 0xc1, 0x65, 0xb3, 0xa4, 0x7a, 0x57, 0x62, 0x1f, 0x07, 0xa9, 0x96, 0xfa, 0x09, 0x8b, 0xe4,
+	0x4e, 0x64, 0x65, 0xd0, 0x9b, 0x5d, 0x84, 0x8e, 0xa6, 0x9c, 0x65, 0x38, 0xdc, 0x96, 0xc4, 0x6f,
+	0x80, 0x8e, 0x16, 0xd2, 0xa0, 0x53, 0x4b, 0x22, 0xde, 0x08, 0x08, 0xca, 0xc6, 0xa3, 0x28, 0xc1,
+	0x3c, 0xb1, 0x44, 0x3c, 0x01, 0x8d, 0xd1, 0x89, 0x76, 0x87, 0x8c, 0xfb, 0x07, 0x24, 0xfc, 0xcd,
+	0x30, 0xa2, 0x41, 0x74, 0x4d, 0x24, 0x53, 0xec, 0xa7, 0xdc, 0x79, 0x72, 0x12, 0x9a, 0xcb, 0x22,
+	0xf4, 0x5b, 0x40, 0x68, 0x50, 0x1d, 0x14, 0x4e, 0x30, 0x25, 0xf4, 0xad, 0xae, 0x47, 0xe6, 0x90,
+	0xbd, 0x54, 0x25, 0x12, 0xf3, 0x36, 0x35, 0x01, 0x94, 0xaf, 0x42, 0x22, 0x9f, 0x86, 0x29, 0xaa,
+	0x35, 0x31, 0xa8, 0x12, 0xd8, 0x61, 0x4a, 0xfc, 0xdb, 0x55, 0x17, 0x40, 0x77, 0xf2, 0x43, 0x11,
+	0x89, 0x7c, 0x07, 0xd4, 0x8f, 0x37, 0x2e, 0x9e, 0x73, 0x4b, 0xf8, 0x12, 0xc0, 0x79, 0x26, 0x03,
+	0xbe, 0xac, 0x5a, 0x90, 0x1f, 0x20, 0x49, 0xc4, 0x0a, 0x64, 0xa0, 0x6c, 0x30, 0xda, 0x33, 0x33,
+	0xc0, 0x59, 0xdd, 0x19, 0x31, 0x72, 0x33, 0xee, 0x11, 0x49, 0x72, 0xd1, 0x7d, 0x80, 0xdc, 0x8f,
+	0xea, 0xa9, 0x80, 0xe0, 0x19, 0xa8, 0xe1, 0x25, 0x96, 0x14, 0xe1, 0x2f, 0x69, 0xb3, 0x63, 0x83,
+	0x1f, 0xba, 0x4a, 0xd4, 0x65, 0xf7, 0x35, 0xe4, 0xa1, 0x4b, 0x2c, 0xd1, 0x3a, 0x01, 0xa4, 0xbe,
+	0x11, 0x26, 0x37, 0x43, 0x28, 0x8b, 0xd1, 0xb4, 0x1d, 0xdb, 0x30, 0x1a, 0xb5, 0x76, 0x54, 0xdc,
+	0xf4, 0x7a, 0xbe, 0x13, 0x1a, 0x00, 0x3a, 0x1e, 0xc2, 0x0b, 0x46, 0x7b, 0xaa, 0x99, 0x9f, 0x95,
+	0x08, 0x19, 0x0e, 0x20, 0x11, 0x57, 0x00, 0x21, 0x54, 0x02, 0x5f, 0xca, 0x05, 0x62, 0x15, 0x06,
+	0x29, 0x4e, 0x28, 0x03, 0x0c, 0x4e, 0xfc, 0xb3, 0x79, 0x91, 0x71, 0xd1, 0x96, 0x34, 0x6b, 0x50,
+	0xe3, 0xeb, 0x2c, 0x0a, 0xb7, 0x0e, 0xb2, 0xd3, 0xb7, 0x03, 0xec, 0x2e, 0xee, 0x8f, 0x83, 0x61,
+	0xcf, 0x1c, 0xb2, 0xd7, 0x60, 0x40, 0xca, 0xae, 0x13, 0xfe, 0x28, 0x89, 0xa3, 0x50, 0x1e, 0xb4,
+	0xf0, 0xf2, 0x72, 0x14, 0xb2, 0x2d, 0xbd, 0xc2, 0x5d, 0xd1, 0xf8, 0xba, 0x65, 0xad, 0xe3, 0xd7,
+	0x61, 0x26, 0x50, 0xb6, 0x1d, 0xc2, 0x1a, 0x28, 0x4e, 0xa9, 0xd7, 0xb6, 0xb6, 0x62, 0x96, 0x0e,
+	0x81, 0xe7, 0xd4, 0x2a, 0x93, 0xf1, 0x64, 0x49, 0x8a, 0xeb, 0xa8, 0x53, 0x9f, 0xef, 0x2f, 0x82,
+	0xce, 0xb9, 0xcc, 0x82, 0x28, 0xd9, 0x60, 0x41, 0x9a, 0xff, 0x06, 0xe6, 0x37, 0x73, 0xf2, 0xb9,
+	0x2a, 0x29, 0x7e, 0x42, 0x34, 0x59, 0x86, 0xe8, 0x0a, 0xd3, 0xd6, 0xba, 0x9f, 0x94, 0x2b, 0x59,
+	0x81, 0x0c, 0xef, 0x82, 0x51, 0x78, 0x75, 0x94, 0x84, 0x5b, 0x07, 0x2b, 0xd7, 0x78, 0x4e, 0x8c,
+	0x2f, 0x48, 0x35, 0xdd, 0xbb, 0x61, 0x24, 0x77, 0x59, 0x82, 0x93, 0xc8, 0x3c, 0x62, 0x94, 0x24,
+	0xef, 0xe1, 0x6a, 0x07, 0x26, 0x81, 0xde, 0x25, 0x3f, 0x05, 0xd5, 0x93, 0xcb, 0x5f, 0x7a, 0x5e,
+	0x2e, 0xb1, 0xef, 0x05, 0x0d, 0xaa, 0xe6, 0xe7, 0xfa, 0x60, 0x8c, 0x73, 0x5c, 0xa2, 0x7f, 0x1a,
+	0xb4, 0x82, 0x18, 0x3e, 0x3c, 0xee, 0x40, 0x62, 0xde, 0xa7, 0x4d, 0x7c, 0x8e, 0x31, 0xa5, 0x09,
+	0x60, 0x4a, 0xb6, 0x87, 0x31, 0x8b, 0x92, 0x67, 0xc2, 0x3e, 0x4b, 0xe1, 0x1b, 0x4a, 0x1c, 0x8b,
+	0x6e, 0x62, 0xb0, 0x70, 0x76, 0x59, 0x82, 0xdb, 0x7d, 0x09, 0xdc, 0x82, 0x06, 0xee, 0x1a, 0x83,
+	0x20, 0xb7, 0xf4, 0x6d, 0x3f, 0xd2, 0x68, 0xf4, 0x66, 0x5f, 0x7c, 0xf1, 0xc5, 0x17, 0x2b, 0xfe,
+	0xdf, 0x56, 0x0a, 0x6c, 0x11, 0xab, 0xa9, 0xdc, 0xca, 0x9b, 0xc3, 0xfc, 0x34, 0xa0, 0xec, 0x18,
+	0x33, 0x9b, 0x05, 0x0c, 0x39, 0xe9, 0x99, 0xdf, 0x1d, 0xa0, 0x7d, 0x36, 0x43, 0x35, 0x88, 0xfb,
+	0x10, 0xa9, 0x76, 0x77, 0x42, 0xdc, 0x19, 0x17, 0x1c, 0x64, 0x01, 0xde, 0x72, 0xdc, 0x58, 0xb7,
+	0x1e, 0x37, 0xde, 0xc9, 0x91, 0xe2, 0xe2, 0x33, 0x64, 0x72, 0x53, 0x34, 0xc0, 0x11, 0xd3, 0x92,
+	0xf3, 0xb6, 0x17, 0x1c, 0x6d, 0x9f, 0x64, 0x6d, 0x34, 0x2a, 0x33, 0xfb, 0x23, 0xab, 0x1d, 0x67,
+	0x6b, 0xd4, 0xc5, 0x56, 0x31, 0xcb, 0x9b, 0x46, 0xe3, 0x5a, 0x0a, 0x54, 0x0c, 0xff, 0xc5, 0x29,
+	0x37, 0x10, 0x4b, 0x3d, 0x1a, 0xd6, 0x7e, 0xad, 0xdc, 0x69, 0xbf, 0xa2, 0x0b, 0x99, 0x5b, 0x97,
+	0x1d, 0xe1, 0x5e, 0x53, 0x80, 0xc5, 0xd5, 0xe2, 0x6a, 0x86, 0x58, 0xcd, 0x57, 0x19, 0x2d, 0x6b,
+	0xaf, 0x85, 0xaa, 0xef, 0xa7, 0x9c, 0x32, 0x73, 0xb7, 0xb4, 0xb6, 0xb2, 0x13, 0x2a, 0x5a, 0x27,
+	0x3c, 0x5b, 0x2c, 0xdd, 0xfb, 0x51, 0xba, 0x73, 0x5a, 0x27, 0x1c, 0x26, 0xdb, 0xe7, 0x9d, 0xc3,
+	0x4d, 0xed, 0x3b, 0x96, 0xf0, 0x5a, 0xb1, 0x84, 0x3b, 0x28, 0xe1, 0xc3, 0x72, 0xa6, 0x1c, 0xc2,
+	0x59, 0xc9, 0xf9, 0xcd, 0x6a, 0xb9, 0xb1, 0x7f, 0xa7, 0x32, 0xc2, 0x2e, 0xf4, 0x2a, 0xbb, 0x25,
+	0x7c, 0x50, 0x18, 0x52, 0x22, 0x92, 0xc6, 0x49, 0x5f, 0x2d, 0x73, 0xac, 0xae, 0x9f, 0xdc, 0xd5,
+	0x33, 0xc7, 0xe4, 0xf6, 0x53, 0xc0, 0x89, 0xc2, 0x23, 0x77, 0x3c, 0xe6, 0xda, 0x61, 0xa2, 0x01,
+	0xd0, 0x1d, 0xdf, 0xa0, 0x3a, 0x28, 0x7f, 0xcc, 0xe5, 0x1c, 0x7e, 0xcc, 0xe5, 0xdc, 0xf6, 0x31,
+	0x97, 0x63, 0x3f, 0xe6, 0x2a, 0x1b, 0xfd, 0x7d, 0x63, 0xf4, 0x97, 0xf5, 0x87, 0xea, 0xb9, 0x5f,
+	0xac, 0x14, 0x6e, 0xc2, 0x4a, 0x3b, 0xed, 0x24, 0x99, 0x30, 0x22, 0x56, 0x26, 0xd4, 0xd4, 0x05,
+	0x2b, 0x37, 0x4e, 0x82, 0xc1, 0x58, 0x9c, 0x0c, 0x29, 0x00, 0x60, 0x91, 0x0d, 0x1e, 0x8d, 0xd4,
+	0x78, 0x9c, 0x6d, 0x0a, 0xc8, 0x9c, 0xe7, 0xd4, 0x6d, 0xe7, 0x39, 0xc2, 0x88, 0xc1, 0xf6, 0x99,
+	0xa1, 0x32, 0xb9, 0x78, 0xb9, 0xb8, 0x51, 0x06, 0x0b, 0x8e, 0x16, 0xb2, 0x58, 0x50, 0x55, 0xd5,
+	0x1e, 0xff, 0xed, 0x14, 0xee, 0x3b, 0xef, 0xaa, 0x3d, 0x7c, 0x32, 0xad, 0x0a, 0x4a, 0x63, 0x9f,
+	0x0d, 0x98, 0x79, 0x62, 0xc6, 0x47, 0xa4, 0x02, 0x40, 0xab, 0xf0, 0x44, 0x7a, 0xca, 0x55, 0xa7,
+	0x1a, 0xa4, 0xac, 0xee, 0x43, 0xa3, 0xee, 0x05, 0xd5, 0x52, 0x75, 0xff, 0x8a, 0x63, 0xd9, 0x56,
+	0xdf, 0x9b, 0xf3, 0x90, 0xc5, 0xe5, 0x62, 0xa9, 0x9f, 0x47, 0xa9, 0x3d, 0xa3, 0xc7, 0x34, 0x81,
+	0x94, 0xbc, 0xdb, 0xb9, 0xed, 0xbe, 0x75, 0x59, 0x7c, 0x47, 0x31, 0xab, 0x08, 0x59, 0x9d, 0xd4,
+	0x34, 0xb2, 0x95, 0xd1, 0x07, 0x2d, 0x2e, 0x84, 0xdb, 0x6d, 0x97, 0xb2, 0x9a, 0xc6, 0x46, 0x4d,
+	0x73, 0x2c, 0x94, 0x00, 0x5f, 0x75, 0xac, 0xde, 0x0a, 0x18, 0x91, 0x40, 0x3f, 0x54, 0x72, 0xa4,
+	0xe9, 0x52, 0x6f, 0x64, 0xe9, 0xb1, 0x42, 0x99, 0x1d, 0x91, 0x18, 0x76, 0x84, 0x45, 0x24, 0x25,
+	0x73, 0x94, 0xf5, 0xa3, 0xb8, 0x0f, 0xf0, 0x6b, 0x03, 0x22, 0xee, 0x6e, 0x4a, 0x0b, 0xb2, 0xa5,
+	0x88, 0x58, 0x7c, 0x7b, 0x31, 0xe3, 0xdd, 0x05, 0x47, 0x3b, 0xce, 0x37, 0x0b, 0x56, 0x3c, 0x3f,
+	0xe1, 0x14, 0x3b, 0x6a, 0x4a, 0x1b, 0x2b, 0x1d, 0xbc, 0x15, 0x6d, 0xf0, 0x2e, 0xb6, 0x8b, 0xe5,
+	0xd9, 0x43, 0x79, 0x1e, 0x50, 0xf2, 0x58, 0x79, 0x1a, 0x7a, 0xa5, 0xd8, 0x49, 0x74, 0xef, 0xbc,
+	0xc9, 0xe9, 0xc1, 0x69, 0xad, 0xe4, 0xe0, 0xb4, 0x9e, 0x3f, 0x38, 0x5d, 0x7c, 0x67, 0x71, 0xd5,
+	0x0f, 0xb0, 0xea, 0x0b, 0xa6, 0x46, 0xcd, 0x57, 0x4a, 0xd5, 0xfd, 0x3b, 0x4e, 0xa1, 0x07, 0xec,
+	0xde, 0xd5, 0xbc, 0x4c, 0x2f, 0xbe, 0x60, 0xea, 0x45, 0xbb, 0x68, 0x4a, 0xfe, 0xef, 0x3b, 0x05,
+	0x4e, 0x3a, 0x90, 0xf4, 0xf2, 0xfa, 0x7a, 0x07, 0xe3, 0x55, 0xc5, 0x90, 0x92, 0x69, 0x3d, 0x5e,
+	0x96, 0x37, 0x7e, 0x26, 0x5e, 0x16, 0x31, 0xbc, 0x7a, 0x32, 0x09, 0xad, 0x41, 0x41, 0x40, 0xbe,
+	0x4a, 0xe0, 0x77, 0xd9, 0x46, 0xe2, 0x03, 0x96, 0x8d, 0x44, 0x46, 0x44, 0x55, 0x8b, 0x2f, 0x3a,
+	0x05, 0xfe, 0xc4, 0xc3, 0x6a, 0x51, 0x22, 0x6b, 0x26, 0xc6, 0xb6, 0x4c, 0xd6, 0x9f, 0x29, 0xd8,
+	0xf4, 0x58, 0x65, 0xbd, 0x41, 0x66, 0x24, 0x0e, 0x5d, 0x4b, 0x69, 0x40, 0x32, 0x88, 0x37, 0x2d,
+	0x02, 0x92, 0x4f, 0x93, 0x26, 0x22, 0xb5, 0xa3, 0x2f, 0x05, 0x50, 0x21, 0xc6, 0x55, 0x2d, 0xc4,
+	0x18, 0xce, 0xf2, 0xac, 0xde, 0xd1, 0x6c, 0x0c, 0x48, 0x59, 0x4d, 0x3e, 0x68, 0xd4, 0xc4, 0x5a,
+	0x9c, 0xaa, 0xc9, 0xb8, 0xc0, 0xe7, 0x9a, 0x63, 0x78, 0xa9, 0x98, 0xe1, 0x8b, 0x8e, 0x85, 0x63,
+	0x61, 0xdb, 0x3d, 0x03, 0x46, 0x70, 0x3c, 0x1e, 0x0d, 0x63, 0x3c, 0xe1, 0x5b, 0x7b, 0x16, 0x99,
+	0x34, 0x68, 0x65, 0xed, 0x59, 0x68, 0x94, 0x8b, 0x51, 0x34, 0x8a, 0xc4, 0xa1, 0x07, 0x4f, 0xa8,
+	0x2b, 0x5b, 0x3c, 0x68, 0x83, 0x27, 0xfc, 0xef, 0x3a, 0x36, 0x9f, 0xf0, 0x2b, 0x32, 0xe4, 0x4b,
+	0x16, 0xa0, 0x0f, 0xf1, 0xb6, 0x38, 0xa5, 0x14, 0x6f, 0x61, 0xd3, 0x6f, 0xe5, 0x7d, 0xd7, 0xb9,
+	0x56, 0x2f, 0x59, 0x9c, 0x3f, 0xcc, 0x39, 0xdd, 0xa7, 0x6b, 0x09, 0xad, 0x28, 0xc5, 0xe7, 0x03,
+	0x25, 0xde, 0x70, 0xab, 0x41, 0x52, 0xb2, 0x45, 0xfc, 0x88, 0x63, 0x28, 0xd7, 0xc2, 0x72, 0x15,
+	0xf7, 0x1f, 0x3b, 0x85, 0xde, 0x76, 0x3c, 0xcb, 0xe3, 0xe1, 0x98, 0xc8, 0xbf, 0x4a, 0x65, 0x12,
+	0x30, 0x48, 0xd9, 0xee, 0x89, 0x99, 0x23, 0x93, 0x60, 0xb0, 0xb5, 0x36, 0xc4, 0xc6, 0x0b, 0x0d,
+	0x59, 0x9e, 0x02, 0x38, 0x1d, 0x23, 0x9c, 0x77, 0xad, 0x48, 0x95, 0xad, 0x91, 0x1f, 0x75, 0x0c,
+	0x3d, 0x5b, 0x20, 0xa5, 0xaa, 0xca, 0x17, 0x9c, 0xc3, 0xcf, 0x06, 0xee, 0x78, 0xb7, 0x4b, 0x8b,
+	0xe5, 0xfb, 0x98, 0x63, 0x6c, 0x77, 0x0f, 0x63, 0xad, 0x04, 0xfd, 0xbb, 0x6a, 0xf1, 0xf1, 0x04,
+	0x36, 0xe0, 0xb2, 0xd6, 0xe7, 0x22, 0xa5, 0x35, 0x60, 0x45, 0x6f, 0xc0, 0x54, 0xe8, 0xaa, 0xb6,
+	0x02, 0xde, 0xa6, 0xe3, 0xea, 0x41, 0x52, 0x69, 0xd3, 0xd2, 0x90, 0xe8, 0x4a, 0x9b, 0xde, 0xbb,
+	0x38, 0xe8, 0x45, 0x42, 0xf8, 0x99, 0x0a, 0x66, 0x6b, 0x18, 0x47, 0x9d, 0x78, 0x26, 0xcd, 0xb1,
+	0x54, 0xa3, 0xd2, 0xc3, 0x90, 0x9b, 0xa5, 0x61, 0xc8, 0xb7, 0x1f, 0xea, 0x5c, 0x66, 0xab, 0xfc,
+	0xaa, 0x63, 0xd8, 0x69, 0x45, 0x9d, 0xa6, 0xba, 0xf6, 0x7b, 0x4e, 0xfe, 0x6c, 0xe9, 0x15, 0xec,
+	0xd2, 0x32, 0x85, 0xf4, 0x6b, 0xa6, 0x42, 0xca, 0x4a, 0xa9, 0xea, 0xf0, 0x57, 0xa9, 0x4a, 0x80,
+	0xb3, 0x11, 0xc3, 0x43, 0x8c, 0x67, 0xe2, 0x41, 0xbc, 0xa3, 0x42, 0xe0, 0x78, 0x2a, 0x0d, 0x8d,
+	0xeb, 0x89, 0x78, 0x10, 0x91, 0x02, 0x85, 0xd9, 0x5a, 0x16, 0x15, 0xa9, 0xb4, 0x96, 0x21, 0xdd,
+	0x59, 0x17, 0x61, 0xd1, 0x95, 0xce, 0xba, 0x5a, 0x51, 0xea, 0xda, 0x8a, 0x52, 0xa6, 0x14, 0x3e,
+	0x61, 0x53, 0x0a, 0x39, 0x39, 0x55, 0x65, 0xfe, 0xcd, 0xb1, 0x1c, 0xeb, 0x1d, 0xb6, 0x15, 0xb7,
+	0xf6, 0xca, 0x6d, 0x6e, 0xc5, 0xbb, 0xe3, 0x7e, 0xc8, 0x83, 0x5e, 0x45, 0xf0, 0x6a, 0x0a, 0x00,
+	0x8f, 0x0f, 0x52, 0x2f, 0x8f, 0x76, 0x87, 0x3d, 0x69, 0x37, 0xeb, 0xa0, 0xc5, 0x95, 0xe2, 0x8a,
+	0x7f, 0xd2, 0x31, 0x76, 0x7b, 0xb9, 0x3a, 0xa9, 0x2a, 0xff, 0xb3, 0x63, 0x3d, 0xb2, 0xbc, 0xab,
+	0x4a, 0x67, 0x42, 0xcc, 0x78, 0x47, 0xea, 0x20, 0xf7, 0x49, 0x32, 0x83, 0x93, 0x75, 0x7d, 0xc4,
+	0x67, 0x87, 0x57, 0x2b, 0x9c, 0xc8, 0x26, 0xe1, 0xe2, 0xc5, 0xe2, 0xca, 0x7e, 0xca, 0x31, 0x36,
+	0x8a, 0x96, 0xda, 0xa8, 0xea, 0xb6, 0xc9, 0x94, 0xc6, 0x04, 0xba, 0x00, 0x93, 0xda, 0x7c, 0x53,
+	0x80, 0x14, 0x9b, 0x1a, 0x7d, 0x75, 0xaa, 0x00, 0xfe, 0x0d, 0x11, 0x33, 0x66, 0x0d, 0xeb, 0x9d,
+	0xcf, 0x86, 0xf5, 0x6a, 0x21, 0xbd, 0x66, 0x58, 0x6c, 0x35, 0x17, 0x16, 0xfb, 0xb2, 0x43, 0x8e,
+	0x98, 0x31, 0xe4, 0xaf, 0x50, 0xbc, 0xf4, 0x23, 0x22, 0x66, 0x98, 0x65, 0x03, 0xa6, 0xd3, 0x7a,
+	0x52, 0x49, 0x70, 0x98, 0xa2, 0xf7, 0x3f, 0xe4, 0x88, 0xf1, 0x2b, 0x6e, 0xc9, 0xa5, 0xe6, 0x81,
+	0xac, 0x86, 0x4c, 0xa6, 0x7e, 0xba, 0x6e, 0xf8, 0x02, 0x13, 0x0a, 0x41, 0x01, 0x70, 0x1a, 0x60,
+	0xc8, 0xe5, 0xca, 0x68, 0x57, 0x8c, 0xa9, 0x3a, 0xd5, 0x41, 0x50, 0xf2, 0x6a, 0xb0, 0xaf, 0x4d,
+	0x22, 0x99, 0xf4, 0xdf, 0x4d, 0x66, 0xe8, 0x58, 0x17, 0x42, 0x0d, 0x5c, 0xc7, 0x18, 0xb8, 0x8b,
+	0x84, 0xa4, 0x64, 0xb1, 0x38, 0x44, 0x70, 0x75, 0xb5, 0xc9, 0xf3, 0x53, 0x8d, 0xca, 0x7f, 0x1f,
+	0x21, 0x70, 0x05, 0x52, 0x94, 0xcc, 0x55, 0x97, 0x93, 0xaa, 0x2e, 0x7e, 0xb5, 0x52, 0xde, 0x2c,
+	0xc5, 0x6f, 0xf7, 0x02, 0x99, 0xa4, 0x63, 0xce, 0xa2, 0x6a, 0xc4, 0xe4, 0x1a, 0x42, 0x52, 0x49,
+	0xe4, 0xff, 0x8a, 0x43, 0xee, 0xd3, 0x83, 0x06, 0xae, 0x8c, 0x82, 0xd4, 0xb6, 0xe4, 0x17, 0x30,
+	0xd7, 0x81, 0x30, 0x13, 0x57, 0xa6, 0x84, 0xa2, 0x29, 0x49, 0x99, 0x8e, 0xfc, 0xb4, 0xa9, 0x23,
+	0x0b, 0x18, 0xaa, 0x19, 0xf4, 0x43, 0xc7, 0x7e, 0x85, 0xc1, 0x7d, 0xbd, 0x8c, 0x8f, 0x73, 0x8c,
+	0x9b, 0x7d, 0x8a, 0x76, 0x6d, 0xcc, 0xa2, 0x20, 0x19, 0x45, 0xb1, 0x08, 0x94, 0x73, 0x2f, 0x11,
+	0x37, 0x53, 0x52, 0xc8, 0xf8, 0x74, 0xd1, 0x4c, 0xe1, 0x0c, 0x2b, 0x6a, 0xc9, 0x62, 0xf8, 0xe9,
+	0xab, 0x99, 0x1b, 0x39, 0x6a, 0x11, 0xe2, 0x77, 0x5a, 0x45, 0xca, 0xff, 0x00, 0x99, 0xcd, 0x96,
+	0x0d, 0x87, 0x73, 0xf2, 0x48, 0x5e, 0x84, 0x0b, 0x72, 0x53, 0x36, 0x03, 0x05, 0xed, 0x0e, 0x03,
+	0x2c, 0xa5, 0xe2, 0x33, 0xd0, 0x80, 0xc1, 0xb0, 0xbe, 0x11, 0x24, 0x2c, 0x82, 0x89, 0x2d, 0x9d,
+	0xd3, 0x29, 0xc0, 0x6f, 0x93, 0xe3, 0x96, 0x86, 0x01, 0x61, 0x97, 0xb6, 0xb7, 0xd7, 0xc6, 0x69,
+	0xd0, 0x25, 0x4f, 0x49, 0x6d, 0xac, 0xed, 0x3e, 0xd3, 0xb4, 0xff, 0x41, 0x72, 0xda, 0xd6, 0x1f,
+	0x10, 0x83, 0xd0, 0xda, 0xa0, 0x63, 0xf7, 0x31, 0x52, 0x83, 0xb4, 0xf0, 0x84, 0x95, 0x5e, 0x31,
+	0x41, 0x42, 0xcd, 0x2a, 0xaf, 0x14, 0x58, 0xe5, 0x55, 0x7d, 0xf6, 0xf8, 0xef, 0x26, 0x67, 0xf3,
+	0x7d, 0x62, 0x88, 0xf0, 0x66, 0x33, 0x44, 0xed, 0x55, 0x25, 0x32, 0xc8, 0x3c, 0x32, 0x66, 0x6d,
+	0x9d, 0xcc, 0x67, 0xc2, 0x25, 0xb8, 0x7e, 0x47, 0xac, 0xfb, 0x84, 0x59, 0xf0, 0x82, 0x3e, 0x67,
+	0x6d, 0x39, 0x64, 0xa9, 0x23, 0x72, 0xaa, 0x90, 0xc6, 0x7d, 0x2d, 0x5c, 0x21, 0x80, 0x05, 0x8c,
+	0xb7, 0xd8, 0x49, 0xbd, 0x50, 0x44, 0x84, 0x5b, 0x21, 0x5c, 0xae, 0xc6, 0x6f, 0x88, 0x43, 0xd4,
+	0xee, 0x4d, 0xec, 0xc9, 0xc1, 0x60, 0x02, 0xfd, 0x5f, 0x70, 0x6c, 0x71, 0x3e, 0xa0, 0x45, 0x95,
+	0x49, 0x20, 0xf6, 0xce, 0x1a, 0x24, 0x8d, 0x9a, 0x15, 0x17, 0xeb, 0xca, 0x36, 0xab, 0xbf, 0x61,
+	0x6e, 0x56, 0xf3, 0xcc, 0xd4, 0x14, 0xfe, 0x81, 0x53, 0x1e, 0x5c, 0x74, 0x57, 0x87, 0x0f, 0x87,
+	0x2e, 0xfe, 0x8b, 0x57, 0x8b, 0x85, 0xff, 0x8c, 0x63, 0x1c, 0x27, 0x95, 0x09, 0xa7, 0xaa, 0xf1,
+	0x2d, 0xa7, 0x28, 0x02, 0xea, 0x1e, 0x55, 0xa0, 0xc4, 0xcb, 0xf7, 0x9b, 0xbc, 0x02, 0x67, 0xb4,
+	0x0d, 0x7c, 0x99, 0xe5, 0xff, 0xbf, 0x0e, 0x99, 0x11, 0x11, 0x15, 0x11, 0x8f, 0xf1, 0x3d, 0xcd,
+	0x5f, 0x6b, 0xe1, 0xbe, 0x11, 0xbe, 0x42, 0x2a, 0x80, 0x76, 0x99, 0x44, 0xb7, 0x98, 0x5b, 0x60,
+	0x11, 0xc3, 0xad, 0x7d, 0xbe, 0xa0, 0xcc, 0x50, 0x9e, 0x70, 0x9f, 0x20, 0x4d, 0xa9, 0xfe, 0x64,
+	0xdc, 0xbc, 0x67, 0xcc, 0x0c, 0x81, 0x14, 0x0f, 0xd8, 0x48, 0x52, 0xe5, 0xc6, 0xaa, 0xeb, 0x37,
+	0xe5, 0x9f, 0x22, 0x53, 0x5a, 0xdc, 0x8e, 0x37, 0x61, 0x94, 0x27, 0x5b, 0x35, 0xc5, 0x53, 0x9d,
+	0x18, 0xe4, 0xde, 0xe4, 0xef, 0x85, 0x4c, 0x72, 0xe5, 0xcb, 0x53, 0xfe, 0xe7, 0x9c, 0x7c, 0x80,
+	0xda, 0x5d, 0x75, 0x9a, 0x66, 0x56, 0x54, 0x0d, 0xb3, 0xa2, 0x6c, 0x73, 0xf3, 0x5b, 0xe6, 0xe6,
+	0x26, 0x2b, 0x88, 0xea, 0xa6, 0xcf, 0x38, 0xf6, 0x88, 0x39, 0xe5, 0xc5, 0x72, 0xf4, 0x87, 0x87,
+	0x66, 0x49, 0xb5, 0x93, 0x48, 0x7b, 0x0f, 0x3e, 0x41, 0xec, 0x21, 0xdf, 0xe9, 0x70, 0x77, 0x97,
+	0x48, 0x95, 0x79, 0xfc, 0x7e, 0xdb, 0x31, 0xae, 0x02, 0xda, 0xd8, 0xeb, 0x1e, 0x3f, 0x57, 0xe2,
+	0x5a, 0x8c, 0x3b, 0x95, 0x47, 0x11, 0x34, 0x24, 0x9c, 0x71, 0xae, 0xcb, 0xf8, 0xde, 0x1a, 0x4d,
+	0xd3, 0x7c, 0xe9, 0xd2, 0x02, 0x8d, 0xd3, 0xa5, 0x4b, 0xc1, 0xca, 0x96, 0x53, 0xff, 0xfb, 0x15,
+	0x72, 0x34, 0xa3, 0x09, 0x4b, 0x6c, 0xbb, 0xec, 0x36, 0xa8, 0x62, 0xd9, 0x06, 0x49, 0xf7, 0x50,
+	0x6b, 0x43, 0xcc, 0x39, 0x99, 0x4c, 0x31, 0x9d, 0x44, 0x6c, 0x02, 0x65, 0x52, 0x1b, 0x0e, 0xf5,
+	0xec, 0x89, 0x30, 0x3f, 0xe2, 0xe5, 0x46, 0x29, 0xa0, 0x14, 0xc0, 0x7e, 0xf3, 0xcd, 0xb9, 0x47,
+	0x37, 0xdf, 0x34, 0xeb, 0x98, 0xe4, 0xac, 0xe3, 0x4b, 0x64, 0x26, 0x1d, 0x75, 0x72, 0xfa, 0x2b,
+	0x83, 0xde, 0x29, 0x31, 0xe8, 0x2b, 0x86, 0x41, 0xef, 0x7f, 0xc4, 0x01, 0xcf, 0x45, 0x8f, 0xed,
+	0x6b, 0xdd, 0xaf, 0x5d, 0xfd, 0x73, 0xcc, 0xab, 0x7f, 0xbe, 0x08, 0x1d, 0xcf, 0x74, 0x87, 0x0e,
+	0x73, 0x17, 0x49, 0x33, 0x15, 0x4d, 0xdc, 0xcd, 0x98, 0xcb, 0x4e, 0x14, 0xae, 0x38, 0xd2, 0x24,
+	0xec, 0x58, 0x8e, 0xe5, 0x34, 0x8b, 0xbe, 0x8e, 0x3a, 0x87, 0xaf, 0xa3, 0x6f, 0x23, 0xd3, 0x7a,
+	0x6e, 0x61, 0x85, 0xcb, 0xe5, 0x2c, 0x3f, 0xca, 0xa9, 0x41, 0xee, 0xbe, 0x23, 0xf7, 0xe8, 0x80,
+	0x30, 0xb2, 0x8b, 0xee, 0x4b, 0x67, 0xc9, 0xfd, 0x7f, 0x70, 0x44, 0xd4, 0x86, 0xd9, 0x33, 0x46,
+	0x7b, 0x38, 0xb7, 0xd5, 0x1e, 0xee, 0x13, 0x84, 0xf0, 0xdd, 0x5e, 0xfa, 0x38, 0x99, 0x92, 0x23,
+	0xd3, 0x5b, 0x54, 0xa3, 0x74, 0x9f, 0x26, 0x33, 0x46, 0x33, 0x8a, 0xf6, 0x2f, 0x56, 0xde, 0x26,
+	0xb9, 0x39, 0xfc, 0xc5, 0x1d, 0xad, 0x14, 0xe0, 0x0f, 0xc8, 0x09, 0x83, 0x3c, 0xf5, 0xdc, 0x97,
+	0xaf, 0x3d, 0xc6, 0x6a, 0x52, 0xb9, 0xed, 0xd5, 0xc4, 0x7f, 0x29, 0x8d, 0x6e, 0xc8, 0x05, 0x15,
+	0xdf, 0x6d, 0x74, 0x83, 0x31, 0x78, 0xab, 0xf9, 0xc1, 0x5b, 0xb6, 0xcf, 0xf9, 0xac, 0x63, 0x09,
+	0x50, 0xc8, 0x49, 0x66, 0xf8, 0xba, 0x4b, 0xc2, 0x9e, 0x4b, 0x74, 0x9e, 0xbc, 0x8d, 0x5b, 0xd1,
+	0x6e, 0xe3, 0xde, 0xa9, 0xa3, 0xfb, 0x4a, 0x71, 0x3d, 0x7e, 0xc7, 0x31, 0x22, 0xbb, 0x8a, 0x45,
+	0x34, 0x62, 0x17, 0x56, 0xd0, 0xfd, 0x13, 0xf4, 0xc3, 0xe4, 0xe0, 0xae, 0x47, 0xf5, 0x02, 0x99,
+	0xd2, 0x8a, 0x11, 0xf5, 0xd3, 0x41, 0xfe, 0xfb, 0xc9, 0xbc, 0x6e, 0xf5, 0x64, 0x78, 0xda, 0x8e,
+	0x5f, 0x9f, 0xcc, 0x96, 0xa9, 0x4f, 0xd9, 0x4c, 0x01, 0x26, 0xaf, 0xf7, 0x91, 0xe3, 0x5a, 0x32,
+	0x1d, 0xcb, 0x6f, 0x32, 0x77, 0x04, 0xe7, 0xf2, 0xb3, 0x3f, 0x5b, 0x2a, 0xa7, 0x87, 0xc5, 0xfb,
+	0x62, 0x24, 0x0f, 0xab, 0xe0, 0xd3, 0x7f, 0x39, 0x75, 0x6d, 0xe6, 0x82, 0x5f, 0x73, 0x0e, 0x19,
+	0xf3, 0x89, 0xa5, 0xba, 0xf1, 0xf8, 0x50, 0xa2, 0x9f, 0x0c, 0x26, 0xf9, 0xc7, 0x87, 0x6a, 0xd9,
+	0xc7, 0x87, 0xca, 0x86, 0xf1, 0xe7, 0x6c, 0x2e, 0xcd, 0x9c, 0x7c, 0xaa, 0xef, 0xff, 0xd3, 0xe1,
+	0xcf, 0x33, 0xa1, 0x87, 0x62, 0x23, 0xf5, 0x50, 0x6c, 0xb8, 0x67, 0x48, 0xa5, 0x93, 0x08, 0xdd,
+	0x94, 0x79, 0xb4, 0xa9, 0xd2, 0x49, 0xe0, 0xed, 0x3e, 0xe1, 0x08, 0xaf, 0x9a, 0xfb, 0xf1, 0x8d,
+	0x4e, 0xc2, 0xe7, 0x7d, 0x2c, 0xdf, 0x57, 0xc1, 0x44, 0xd6, 0x4c, 0xac, 0x19, 0x0e, 0xc8, 0x72,
+	0x33, 0x71, 0xbe, 0x4b, 0xa6, 0xb4, 0x22, 0x2d, 0xcf, 0x78, 0x5c, 0x30, 0x9f, 0xf1, 0x28, 0xd6,
+	0x3f, 0xda, 0xcb, 0x06, 0x9f, 0xaf, 0x90, 0xd9, 0xec, 0xab, 0x7b, 0x30, 0x6d, 0x19, 0x26, 0x7a,
+	0xe2, 0x9e, 0x96, 0x4c, 0x82, 0x12, 0x64, 0xda, 0x09, 0x2f, 0x38, 0xff, 0x15, 0x00, 0xc6, 0xee,
+	0x68, 0x9c, 0x9a, 0x71, 0xf8, 0xed, 0x9e, 0x21, 0xd5, 0x71, 0x22, 0xbd, 0xec, 0x53, 0x5a, 0xfb,
+	0x50, 0x80, 0x43, 0x81, 0x70, 0xa5, 0x18, 0xfa, 0x85, 0x07, 0x98, 0xd5, 0xa9, 0x02, 0x80, 0x06,
+	0x1c, 0x47, 0x8c, 0x23, 0xf9, 0x05, 0xb3, 0x34, 0x0d, 0xf5, 0x8f, 0xa3, 0x4d, 0x61, 0x32, 0xc3,
+	0x27, 0xb0, 0xef, 0xb1, 0x38, 0x11, 0x76, 0x08, 0x7e, 0xc3, 0xc6, 0x73, 0xf3, 0x26, 0xdb, 0xdc,
+	0x59, 0x19, 0x0d, 0xb7, 0xfa, 0xe1, 0x66, 0x22, 0x8c, 0x10, 0x13, 0x08, 0x93, 0x36, 0x48, 0x5f,
+	0x8c, 0xea, 0xa1, 0x29, 0x52, 0xa3, 0x3a, 0xc8, 0xff, 0xb8, 0x63, 0xbb, 0xa2, 0xe1, 0xbe, 0x51,
+	0xb4, 0x87, 0xe6, 0x3b, 0x28, 0x7c, 0xcb, 0x50, 0x51, 0x96, 0xed, 0x50, 0x3f, 0x6f, 0xee, 0x50,
+	0xf3, 0x3c, 0xd5, 0xa8, 0x05, 0x99, 0xf2, 0xd7, 0x43, 0xee, 0x81, 0x4c, 0x5f, 0x30, 0x65, 0xca,
+	0xf3, 0x34, 0x4e, 0x6b, 0x6c, 0x57, 0x53, 0xee, 0x74, 0x62, 0x9d, 0x26, 0x4d, 0x5c, 0xf1, 0x61,
+	0xce, 0x8a, 0xe1, 0xa4, 0x00, 0xc6, 0x23, 0x66, 0x8e, 0x7a, 0xaa, 0xad, 0xcc, 0xfd, 0xfd, 0xbb,
+	0x36, 0xf7, 0xb7, 0x21, 0xa2, 0xaa, 0x43, 0x62, 0xbb, 0x44, 0x63, 0x4e, 0x8a, 0x8a, 0x36, 0x29,
+	0xca, 0x5a, 0xee, 0xf7, 0xcc, 0x96, 0xcb, 0x17, 0xab, 0xb8, 0xfe, 0xbb, 0x73, 0xc8, 0x1d, 0x9d,
+	0xc2, 0x67, 0x51, 0x6e, 0xc3, 0x67, 0x65, 0xcd, 0x58, 0x1a, 0xd6, 0xe3, 0x92, 0xda, 0x50, 0x3b,
+	0x31, 0x83, 0xef, 0xc5, 0xb5, 0xe2, 0x8a, 0x7e, 0x91, 0x57, 0xf4, 0x41, 0x33, 0x9a, 0xc4, 0x5e,
+	0x11, 0x55, 0xe7, 0x6f, 0x3b, 0xa5, 0x97, 0x8e, 0x0e, 0xb3, 0x80, 0x22, 0xe3, 0x7c, 0x85, 0xa7,
+	0xa0, 0x9f, 0x7a, 0xd1, 0x68, 0xbc, 0xd4, 0xef, 0x8b, 0x53, 0x03, 0x99, 0x2c, 0x0b, 0xd4, 0xfd,
+	0x12, 0x17, 0xdf, 0xd7, 0xc3, 0xf1, 0x0f, 0x13, 0xfe, 0xfd, 0x65, 0xf7, 0xa1, 0xca, 0x8c, 0x93,
+	0xdf, 0x37, 0x8d, 0x93, 0xe2, 0x42, 0x14, 0xaf, 0x4f, 0x3a, 0x05, 0x97, 0xab, 0x34, 0xa3, 0xc9,
+	0x31, 0x8c, 0xa6, 0xb3, 0x84, 0x44, 0xea, 0x26, 0x06, 0x7f, 0xd1, 0x46, 0x83, 0x94, 0x45, 0xb7,
+	0x7c, 0xd9, 0xb1, 0x45, 0x06, 0x99, 0x7c, 0x95, 0x68, 0x7f, 0xe3, 0xdc, 0xe6, 0xe5, 0xae, 0x42,
+	0x51, 0x8b, 0x4e, 0xca, 0x84, 0xc5, 0x0d, 0x4b, 0x0b, 0x5f, 0x60, 0xab, 0x54, 0x01, 0x16, 0x6f,
+	0x14, 0x57, 0xe0, 0x2b, 0xbc, 0x02, 0xaf, 0x55, 0x0d, 0x7c, 0xb8, 0x74, 0xaa, 0x42, 0x9f, 0x73,
+	0x0e, 0xbf, 0x82, 0x76, 0x67, 0xee, 0xcf, 0xb2, 0x90, 0x87, 0x3f, 0x30, 0x43, 0x1e, 0x0e, 0x63,
+	0xac, 0x6b, 0x29, 0xdb, 0x15, 0x38, 0x68, 0x4c, 0x86, 0x97, 0x64, 0x84, 0xa3, 0x54, 0xa4, 0xca,
+	0x74, 0xe3, 0x1f, 0x9a, 0xba, 0xd1, 0x52, 0x6a, 0x8e, 0x6b, 0xe6, 0x7e, 0xdd, 0xdd, 0x70, 0xfd,
+	0xa3, 0x3c, 0xd7, 0x4c, 0xa9, 0x8a, 0xeb, 0x2f, 0x39, 0xd6, 0xdb, 0x7b, 0xf0, 0xee, 0x9b, 0x7a,
+	0x21, 0x40, 0x74, 0x85, 0xe5, 0xe9, 0x00, 0x8d, 0xa8, 0x4c, 0xa2, 0xaf, 0x9a, 0x12, 0x59, 0x18,
+	0x2a, 0x89, 0xfa, 0x96, 0x5b, 0x83, 0xd6, 0xd0, 0xa2, 0x92, 0xf3, 0xe7, 0xaf, 0x99, 0xe7, 0xcf,
+	0xb9, 0xf2, 0x14, 0xb7, 0x97, 0x9c, 0xc3, 0x6e, 0x23, 0xde, 0xf1, 0xe4, 0xd2, 0x9e, 0xca, 0xa8,
+	0x1a, 0x4f, 0x65, 0x2c, 0x76, 0x8a, 0x25, 0xfe, 0x63, 0x2e, 0xf1, 0x43, 0x85, 0x13, 0x4b, 0x17,
+	0x49, 0x89, 0xbf, 0x5f, 0x70, 0x4f, 0xb2, 0xe8, 0xf9, 0x9f, 0x32, 0xe5, 0xf4, 0x75, 0x53, 0x39,
+	0x59, 0xcb, 0x55, 0x9c, 0xdf, 0x63, 0xbd, 0x86, 0x59, 0x36, 0x08, 0xbe, 0x61, 0x0e, 0x02, 0x4b,
+	0x6e, 0x55, 0xfa, 0x87, 0x9d, 0xa2, 0xcb, 0x9c, 0x39, 0x7b, 0xe7, 0x48, 0x6a, 0xef, 0x40, 0x94,
+	0x46, 0xa9, 0x97, 0xfc, 0x4f, 0x4c, 0x2f, 0xb9, 0x9d, 0x81, 0x12, 0xe2, 0xd3, 0x4e, 0xd9, 0xd5,
+	0xd0, 0x3b, 0x1d, 0x17, 0x65, 0xeb, 0xd6, 0x37, 0x73, 0xeb, 0x56, 0x01, 0x53, 0x25, 0xdc, 0x1a,
+	0x39, 0x96, 0xdb, 0xd5, 0x58, 0xb7, 0xb8, 0xf9, 0x1b, 0x7f, 0x3c, 0xee, 0x3b, 0x03, 0xf5, 0xaf,
+	0x93, 0xd9, 0x2c, 0x53, 0x77, 0x39, 0x0f, 0x13, 0x1b, 0xdb, 0x22, 0xb7, 0x56, 0x8e, 0x1e, 0xba,
+	0xb2, 0xf4, 0x02, 0xad, 0x11, 0xef, 0x2a, 0x1e, 0xe0, 0x2d, 0x3b, 0xab, 0xf9, 0x96, 0x79, 0x56,
+	0x53, 0x56, 0xb4, 0x6a, 0xad, 0xaf, 0x3b, 0xe5, 0x77, 0x74, 0xef, 0xf8, 0xd2, 0x56, 0xfa, 0x18,
+	0x5d, 0x55, 0x7b, 0x8c, 0xae, 0x4c, 0xec, 0x3f, 0x75, 0x2c, 0xf7, 0xf5, 0xec, 0xc2, 0x28, 0xb1,
+	0x5f, 0x28, 0xbe, 0x37, 0x6c, 0x6d, 0xb6, 0x92, 0xe8, 0xb0, 0x6f, 0x9b, 0xd1, 0x61, 0x45, 0xc5,
+	0x1a, 0xa3, 0xbf, 0xf4, 0x5a, 0xb2, 0xfb, 0x08, 0x69, 0xac, 0x5c, 0xc3, 0x1d, 0xa3, 0xf4, 0x76,
+	0xa4, 0x3c, 0x39, 0x98, 0xa6, 0xf8, 0xb2, 0x86, 0xf9, 0xb3, 0x4c, 0xc3, 0x94, 0xb0, 0x54, 0xc2,
+	0xbd, 0x9d, 0x4c, 0x8a, 0xb2, 0xad, 0x63, 0x3e, 0xf3, 0x28, 0x20, 0x77, 0x5a, 0xeb, 0x20, 0xff,
+	0x67, 0x9d, 0xc3, 0xae, 0x54, 0x5b, 0x1b, 0xb8, 0x44, 0x83, 0xbf, 0x94, 0xd3, 0xe0, 0x25, 0x85,
+	0x9b, 0x4a, 0xa6, 0xf8, 0xde, 0xf6, 0x9d, 0xde, 0x19, 0x28, 0x53, 0x32, 0xdf, 0x71, 0x72, 0x77,
+	0x32, 0x0f, 0x1b, 0x7f, 0xfd, 0xd2, 0x3b, 0xe3, 0x65, 0x66, 0xff, 0x77, 0x4d, 0xb3, 0xbf, 0xa4,
+	0x14, 0xc5, 0xed, 0xb3, 0xce, 0x21, 0x37, 0xd0, 0x41, 0xb5, 0xc6, 0x08, 0xc0, 0x01, 0x57, 0xa3,
+	0x22, 0x05, 0x4b, 0x2e, 0x3f, 0xd9, 0xe2, 0x1e, 0xe2, 0x1a, 0x95, 0xc9, 0xb2, 0x8d, 0xd5, 0x9f,
+	0x9b, 0x1b, 0xab, 0x52, 0xce, 0xfa, 0x55, 0x9f, 0xfc, 0x15, 0x78, 0x9d, 0xbf, 0x63, 0xf2, 0x2f,
+	0x31, 0x52, 0xfe, 0x22, 0x1b, 0x24, 0x97, 0x29, 0xd5, 0x38, 0xae, 0x2d, 0xbc, 0x60, 0x0f, 0xa3,
+	0xa1, 0x97, 0xd1, 0x5c, 0x32, 0x2d, 0xb6, 0x2a, 0xdc, 0x3b, 0xdd, 0x13, 0x6b, 0xa4, 0x06, 0x81,
+	0xbc, 0x03, 0xfe, 0xe8, 0x7c, 0x4f, 0x5c, 0x29, 0x4f, 0xd3, 0xea, 0x11, 0xfa, 0x5a, 0xe1, 0x23,
+	0xf4, 0xf3, 0xa4, 0x11, 0x6d, 0x0b, 0x7f, 0x81, 0xb8, 0x83, 0x2a, 0xd3, 0x65, 0xaa, 0xe8, 0x7b,
+	0xa6, 0x2a, 0x2a, 0xaa, 0x99, 0x71, 0x0e, 0xaa, 0x3f, 0x30, 0x8c, 0xc7, 0x51, 0xfc, 0x6f, 0x0e,
+	0x1c, 0xbe, 0x0f, 0x15, 0x49, 0xa8, 0xef, 0xf2, 0xee, 0xe6, 0x0e, 0x4b, 0x84, 0xbe, 0xc6, 0xd7,
+	0x8e, 0x14, 0x04, 0x6c, 0x85, 0xa5, 0x1d, 0x71, 0xcb, 0xb6, 0xb2, 0xb4, 0x03, 0xe9, 0xee, 0x8e,
+	0x38, 0xa9, 0xa8, 0x74, 0x77, 0xa0, 0x42, 0x17, 0x87, 0xbd, 0xf1, 0x28, 0x1c, 0x26, 0x22, 0xc8,
+	0x33, 0x4d, 0x03, 0x6e, 0x39, 0x88, 0x59, 0x27, 0x48, 0x6e, 0xa2, 0xc7, 0xac, 0x49, 0xd3, 0xb4,
+	0xff, 0xa9, 0x0a, 0xd1, 0x63, 0x79, 0x57, 0xf0, 0x3d, 0xf4, 0x2e, 0x1b, 0xc6, 0x61, 0x12, 0xee,
+	0x31, 0x21, 0x65, 0x16, 0x0c, 0xd2, 0x2e, 0x8d, 0xc7, 0x6c, 0xd8, 0x03, 0x45, 0x8c, 0xd2, 0x36,
+	0xa8, 0x06, 0x81, 0x95, 0xfb, 0x46, 0x14, 0x26, 0x6c, 0xfd, 0x66, 0xc4, 0xe2, 0x9b, 0xa3, 0x3e,
+	0xef, 0xa3, 0x3a, 0xcd, 0x40, 0xc1, 0x13, 0x47, 0x59, 0xd0, 0x53, 0x64, 0x35, 0x24, 0x33, 0x81,
+	0x20, 0x17, 0xd8, 0x90, 0xc1, 0x36, 0x5b, 0x09, 0xc6, 0xc1, 0x26, 0xb8, 0xbb, 0xb9, 0x57, 0x30,
+	0x0b, 0x4e, 0x03, 0x43, 0x57, 0x6e, 0x06, 0x91, 0xa8, 0xaa, 0x02, 0x80, 0x77, 0x70, 0x3d, 0x91,
+	0x27, 0x97, 0xf0, 0x09, 0xf4, 0xeb, 0xc1, 0x76, 0x8c, 0x24, 0xe2, 0x8a, 0x8c, 0x02, 0xf8, 0x2f,
+	0xa7, 0x83, 0xd7, 0x12, 0x28, 0x61, 0x31, 0xe6, 0xe8, 0x58, 0x28, 0xb5, 0x0a, 0x1d, 0x03, 0x33,
+	0xf9, 0x46, 0x1b, 0x3c, 0x36, 0x1a, 0x27, 0x7a, 0x50, 0x75, 0xcd, 0xf8, 0xd3, 0x81, 0xdc, 0xab,
+	0x04, 0x25, 0x23, 0xf0, 0x65, 0xdb, 0x08, 0x2c, 0x0b, 0x98, 0xf8, 0x75, 0x87, 0x4c, 0x82, 0x8e,
+	0x85, 0x60, 0x28, 0xb8, 0x68, 0x32, 0x16, 0x01, 0x52, 0x95, 0xb5, 0x31, 0x0c, 0x8c, 0x21, 0xbb,
+	0x25, 0xcf, 0xda, 0xf0, 0x96, 0xb6, 0x4c, 0xe7, 0xff, 0xe1, 0x83, 0x3f, 0xac, 0x65, 0x02, 0xd1,
+	0x1f, 0xcf, 0x92, 0xb5, 0x31, 0x77, 0xc7, 0xf2, 0xde, 0xd3, 0x20, 0xe9, 0x65, 0xc2, 0xfa, 0x82,
+	0x63, 0xbd, 0x4c, 0x08, 0x8b, 0x88, 0xf5, 0x11, 0x8d, 0xd2, 0x1b, 0x2c, 0xe6, 0x29, 0x80, 0x98,
+	0x2c, 0x0a, 0x52, 0x16, 0x24, 0xf0, 0x7d, 0x33, 0x48, 0xc0, 0xc6, 0xda, 0x7a, 0x92, 0x65, 0x79,
+	0xc7, 0xe3, 0xff, 0xf9, 0x28, 0x23, 0x5b, 0x89, 0x92, 0xf5, 0xf0, 0x07, 0xd6, 0x93, 0x2c, 0x8b,
+	0x88, 0xaa, 0x2a, 0x5f, 0x72, 0x4a, 0xde, 0x32, 0x49, 0x6f, 0x89, 0x39, 0x28, 0x37, 0x7e, 0x17,
+	0xfc, 0x45, 0x94, 0x8a, 0x40, 0xaf, 0xea, 0x11, 0xe8, 0x65, 0xb7, 0x65, 0x7e, 0x68, 0xde, 0x96,
+	0x29, 0x94, 0x42, 0x09, 0xfb, 0xd7, 0x15, 0xd2, 0x80, 0x97, 0x51, 0xa4, 0x43, 0x32, 0x66, 0xcf,
+	0xef, 0xb2, 0xe1, 0x26, 0x13, 0x07, 0x1b, 0x69, 0x1a, 0x64, 0xec, 0x63, 0x34, 0x82, 0x78, 0x9d,
+	0x19, 0x13, 0x00, 0x1d, 0xb0, 0x68, 0x9b, 0x89, 0x85, 0x81, 0x27, 0x40, 0x72, 0xb6, 0x9f, 0xb0,
+	0x61, 0x22, 0x1d, 0xc4, 0x3c, 0x85, 0xd4, 0xf8, 0x47, 0x31, 0x75, 0x2c, 0x9c, 0x27, 0x40, 0x53,
+	0xc7, 0xe2, 0x94, 0x72, 0x02, 0xe1, 0x32, 0x09, 0x3a, 0xa3, 0x97, 0x46, 0x02, 0x73, 0x5d, 0xa2,
+	0x00, 0x80, 0xdd, 0xc4, 0x31, 0xd5, 0x5b, 0xe2, 0x87, 0x0e, 0x55, 0xaa, 0x00, 0x50, 0xea, 0x20,
+	0xe4, 0x96, 0x1d, 0x7f, 0x88, 0x40, 0x26, 0x11, 0x23, 0x62, 0x71, 0x89, 0xc0, 0xf0, 0x24, 0x2e,
+	0x55, 0xa3, 0x5b, 0x3c, 0x88, 0x97, 0x3f, 0x38, 0x90, 0xa6, 0x61, 0x92, 0x6e, 0x85, 0x7d, 0x06,
+	0xf1, 0xbe, 0xfc, 0x19, 0xd5, 0x69, 0x3e, 0x49, 0x0d, 0x20, 0xfc, 0xa3, 0x8b, 0xe5, 0xb9, 0x19,
+	0xf8, 0xdf, 0x2a, 0xd9, 0xc8, 0xd2, 0x0c, 0x3e, 0x9a, 0x86, 0x93, 0xf7, 0xc5, 0x21, 0x66, 0x4a,
+	0x51, 0xe6, 0xd1, 0xfe, 0x91, 0xe9, 0xd1, 0xce, 0xf3, 0x52, 0x5d, 0xfb, 0x65, 0x27, 0xf7, 0x88,
+	0x0d, 0x8c, 0x3e, 0xbc, 0x7b, 0x2e, 0xfe, 0x97, 0x01, 0xbe, 0x33, 0x96, 0xa2, 0x63, 0xec, 0x6a,
+	0xd2, 0xf7, 0x76, 0xab, 0xa5, 0xef, 0xed, 0x2e, 0x2e, 0x15, 0x4b, 0xfc, 0x97, 0x99, 0xc8, 0x0a,
+	0x53, 0x22, 0x25, 0xee, 0xcf, 0x55, 0x0e, 0x7b, 0x5e, 0xe7, 0xae, 0x6f, 0x77, 0xe7, 0xa2, 0xe6,
+	0xca, 0x9f, 0x95, 0xad, 0x95, 0x3e, 0x2b, 0x5b, 0xcf, 0x3c, 0x2b, 0x5b, 0x66, 0xee, 0xff, 0xd8,
+	0x34, 0xf7, 0xcb, 0xab, 0x97, 0x36, 0xc5, 0x32, 0x79, 0x57, 0xe3, 0xc2, 0x85, 0xc7, 0x30, 0xcf,
+	0xff, 0x0d, 0x00, 0xf9, 0x2e, 0x08, 0x93, 0x6f, 0x6e, 0x00, 0x00,
 }
@@ -0,0 +1,136 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+)
+
+// QuotaInfo holds the write and query limits of a user or a database.
+// A zero value means the corresponding resource is unlimited.
+type QuotaInfo struct {
+	PointsPerSecond      int64
+	BytesPerSecond       int64
+	MaxConcurrentQueries int64
+	MaxSeries            int64
+	MaxQueryResultBytes  int64
+}
+
+func (qi *QuotaInfo) Clone() *QuotaInfo {
+	if qi == nil {
+		return nil
+	}
+	other := *qi
+	return &other
+}
+
+// IsEmpty reports whether no limit is set.
+func (qi *QuotaInfo) IsEmpty() bool {
+	return qi == nil || *qi == QuotaInfo{}
+}
+
+func (qi *QuotaInfo) GetPointsPerSecond() int64 {
+	if qi == nil {
+		return 0
+	}
+	return qi.PointsPerSecond
+}
+
+func (qi *QuotaInfo) GetBytesPerSecond() int64 {
+	if qi == nil {
+		return 0
+	}
+	return qi.BytesPerSecond
+}
+
+func (qi *QuotaInfo) GetMaxConcurrentQueries() int64 {
+	if qi == nil {
+		return 0
+	}
+	return qi.MaxConcurrentQueries
+}
+
+func (qi *QuotaInfo) GetMaxSeries() int64 {
+	if qi == nil {
+		return 0
+	}
+	return qi.MaxSeries
+}
+
+func (qi *QuotaInfo) GetMaxQueryResultBytes() int64 {
+	if qi == nil {
+		return 0
+	}
+	return qi.MaxQueryResultBytes
+}
+
+func (qi *QuotaInfo) Marshal() *proto2.QuotaInfo {
+	return &proto2.QuotaInfo{
+		PointsPerSecond:      proto.Int64(qi.PointsPerSecond),
+		BytesPerSecond:       proto.Int64(qi.BytesPerSecond),
+		MaxConcurrentQueries: proto.Int64(qi.MaxConcurrentQueries),
+		MaxSeries:            proto.Int64(qi.MaxSeries),
+		MaxQueryResultBytes:  proto.Int64(qi.MaxQueryResultBytes),
+	}
+}
+
+func (qi *QuotaInfo) Unmarshal(pb *proto2.QuotaInfo) {
+	qi.PointsPerSecond = pb.GetPointsPerSecond()
+	qi.BytesPerSecond = pb.GetBytesPerSecond()
+	qi.MaxConcurrentQueries = pb.GetMaxConcurrentQueries()
+	qi.MaxSeries = pb.GetMaxSeries()
+	qi.MaxQueryResultBytes = pb.GetMaxQueryResultBytes()
+}
+
+func unmarshalQuota(pb *proto2.QuotaInfo) *QuotaInfo {
+	if pb == nil {
+		return nil
+	}
+	qi := &QuotaInfo{}
+	qi.Unmarshal(pb)
+	if qi.IsEmpty() {
+		return nil
+	}
+	return qi
+}
+
+// SetQuota replaces the quota of the user or the database, an empty quota drops it.
+func (data *Data) SetQuota(user, database string, quota *QuotaInfo) error {
+	if (user == "") == (database == "") {
+		return ErrQuotaTargetRequired
+	}
+	if quota.IsEmpty() {
+		quota = nil
+	}
+
+	if user != "" {
+		ui := data.GetUser(user)
+		if ui == nil {
+			return ErrUserNotFound
+		}
+		ui.Quota = quota.Clone()
+		return nil
+	}
+
+	dbi, err := data.GetDatabase(database)
+	if err != nil {
+		return err
+	}
+	dbi.Quota = quota.Clone()
+	return nil
+}
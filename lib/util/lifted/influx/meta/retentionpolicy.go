@@ -480,8 +480,12 @@ func (rpi *RetentionPolicyInfo) MatchMeasurements(ms influxql.Measurements, ret
 		}
 
 		key := rpi.Name + "." + mi.Name
+		originName := mi.OriginName()
+		hidden := IsHiddenMeasurement(originName)
 		if len(ms) == 0 {
-			ret[key] = mi
+			if !hidden {
+				ret[key] = mi
+			}
 			return
 		}
 
@@ -490,8 +494,7 @@ func (rpi *RetentionPolicyInfo) MatchMeasurements(ms influxql.Measurements, ret
 				continue
 			}
 
-			originName := mi.OriginName()
-			if m.Regex != nil && m.Regex.Val.MatchString(originName) {
+			if m.Regex != nil && !hidden && m.Regex.Val.MatchString(originName) {
 				ret[key] = mi
 			}
 
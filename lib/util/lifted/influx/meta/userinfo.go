@@ -32,6 +32,12 @@ type UserInfo struct {
 
 	// Map of database name to granted privilege.
 	Privileges map[string]originql.Privilege
+
+	// Privileges granted on measurements or series, sorted by database and measurement.
+	MeasurementPrivileges []*MeasurementPrivilege
+
+	// Write and query limits of the GetUser, nil if unlimited.
+	Quota *QuotaInfo
 }
 
 type User interface {
@@ -41,6 +47,9 @@ type User interface {
 	// AuthorizeQuery returns an error if the query cannot be executed
 	AuthorizeQuery(database string, query *influxql.Query) error
 
+	// HasMeasurementPrivilege indicates whether the given Privilege is authorized on some measurements of the database.
+	HasMeasurementPrivilege(p originql.Privilege, database string) bool
+
 	query.FineAuthorizer
 	ID() string
 	AuthorizeUnrestricted() bool
@@ -63,14 +72,14 @@ func (u *UserInfo) IsOpen() bool {
 	return true
 }
 
-// AuthorizeSeriesRead is used to limit access per-series (enterprise only)
+// AuthorizeSeriesRead returns true if the series can be read with the privileges on the database or the measurement.
 func (u *UserInfo) AuthorizeSeriesRead(database string, measurement []byte, tags models.Tags) bool {
-	return true
+	return u.authorizeSeries(originql.ReadPrivilege, database, measurement, tags)
 }
 
-// AuthorizeSeriesWrite is used to limit access per-series (enterprise only)
+// AuthorizeSeriesWrite returns true if the series can be written with the privileges on the database or the measurement.
 func (u *UserInfo) AuthorizeSeriesWrite(database string, measurement []byte, tags models.Tags) bool {
-	return true
+	return u.authorizeSeries(originql.WritePrivilege, database, measurement, tags)
 }
 
 // AuthorizeUnrestricted allows admins to shortcut access checks.
@@ -88,6 +97,11 @@ func (u UserInfo) clone() UserInfo {
 			other.Privileges[k] = v
 		}
 	}
+	if u.MeasurementPrivileges != nil {
+		other.MeasurementPrivileges = make([]*MeasurementPrivilege, len(u.MeasurementPrivileges))
+		copy(other.MeasurementPrivileges, u.MeasurementPrivileges)
+	}
+	other.Quota = u.Quota.Clone()
 
 	return other
 }
@@ -108,6 +122,14 @@ func (u UserInfo) marshal() *proto2.UserInfo {
 		})
 	}
 
+	for _, mp := range u.MeasurementPrivileges {
+		pb.MeasurementPrivileges = append(pb.MeasurementPrivileges, mp.marshal())
+	}
+
+	if u.Quota != nil {
+		pb.Quota = u.Quota.Marshal()
+	}
+
 	return pb
 }
 
@@ -125,4 +147,10 @@ func (u *UserInfo) unmarshal(pb *proto2.UserInfo) {
 	for _, p := range pb.GetPrivileges() {
 		u.Privileges[p.GetDatabase()] = originql.Privilege(p.GetPrivilege())
 	}
+
+	u.MeasurementPrivileges = nil
+	for _, p := range pb.GetMeasurementPrivileges() {
+		u.MeasurementPrivileges = append(u.MeasurementPrivileges, unmarshalMeasurementPrivilege(p))
+	}
+	u.Quota = unmarshalQuota(pb.GetQuota())
 }
@@ -260,6 +260,42 @@ var (
 			mergeCall: true,
 		},
 	})
+	_ = RegistryAggregateFunction("stdvar_over_time", &StdvarFunc{
+		BaseInfo: BaseInfo{FuncType: AGG_SLICE},
+		BaseAgg: BaseAgg{
+			mergeCall: true,
+		},
+	})
+	_ = RegistryAggregateFunction("last_over_time", &PromRangeFunc{
+		BaseInfo: BaseInfo{FuncType: AGG_SLICE},
+		BaseAgg: BaseAgg{
+			mergeCall: true,
+		},
+	})
+	_ = RegistryAggregateFunction("present_over_time", &PromRangeFunc{
+		BaseInfo: BaseInfo{FuncType: AGG_SLICE},
+		BaseAgg: BaseAgg{
+			mergeCall: true,
+		},
+	})
+	_ = RegistryAggregateFunction("changes", &PromRangeFunc{
+		BaseInfo: BaseInfo{FuncType: AGG_SLICE},
+		BaseAgg: BaseAgg{
+			mergeCall: true,
+		},
+	})
+	_ = RegistryAggregateFunction("resets", &PromRangeFunc{
+		BaseInfo: BaseInfo{FuncType: AGG_SLICE},
+		BaseAgg: BaseAgg{
+			mergeCall: true,
+		},
+	})
+	_ = RegistryAggregateFunction("holt_winters_prom", &PromHoltWintersFunc{
+		BaseInfo: BaseInfo{FuncType: AGG_SLICE},
+		BaseAgg: BaseAgg{
+			mergeCall: true,
+		},
+	})
 	_ = RegistryAggregateFunction("quantile_over_time", &PercentileFunc{
 		BaseInfo: BaseInfo{FuncType: AGG_SLICE},
 		BaseAgg: BaseAgg{
@@ -320,6 +356,12 @@ var (
 			optimizeAgg:       true,
 		},
 	})
+	_ = RegistryAggregateFunction("group_prom", &GroupPromFunc{
+		BaseInfo: BaseInfo{FuncType: AGG_NORMAL},
+		BaseAgg: BaseAgg{
+			mergeCall: true,
+		},
+	})
 	_ = RegistryAggregateFunction("histogram_quantile", &HistogramQuantileFunc{
 		BaseInfo: BaseInfo{FuncType: AGG_SPECIAL},
 		BaseAgg: BaseAgg{
@@ -1390,3 +1432,72 @@ func (f *PromIDeltaFunc) CompileFunc(expr *influxql.Call, c *compiledField) erro
 func (f *PromIDeltaFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
 	return influxql.Float, nil
 }
+
+// PromRangeFunc is used for the prom functions over a range vector which take no parameter and return float,
+// such as changes, resets, last_over_time and present_over_time.
+type PromRangeFunc struct {
+	BaseInfo
+	BaseAgg
+}
+
+func (f *PromRangeFunc) CompileFunc(expr *influxql.Call, c *compiledField) error {
+	args, name := expr.Args, expr.Name
+	if exp, got := 1, len(expr.Args); exp != got {
+		return fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", name, exp, got)
+	}
+	c.global.OnlySelectors = false
+	// Must be a variable reference, wildcard, or regexp.
+	return c.compileSymbol(name, args[0])
+}
+
+func (f *PromRangeFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
+	return influxql.Float, nil
+}
+
+type PromHoltWintersFunc struct {
+	BaseInfo
+	BaseAgg
+}
+
+func (f *PromHoltWintersFunc) CompileFunc(expr *influxql.Call, c *compiledField) error {
+	args := expr.Args
+	if exp, got := 3, len(args); got != exp {
+		return fmt.Errorf("invalid number of arguments for holt_winters, expected %d, got %d", exp, got)
+	}
+	for _, arg := range args[1:] {
+		var factor float64
+		switch lit := arg.(type) {
+		case *influxql.IntegerLiteral:
+			factor = float64(lit.Val)
+		case *influxql.NumberLiteral:
+			factor = lit.Val
+		default:
+			return fmt.Errorf("expected float argument in holt_winters()")
+		}
+		if factor <= 0 || factor >= 1 {
+			return fmt.Errorf("invalid smoothing or trend factor in holt_winters(), expected: 0 < factor < 1, got: %v", factor)
+		}
+	}
+	c.global.OnlySelectors = false
+	return c.compileSymbol(expr.Name, expr.Args[0])
+}
+
+func (f *PromHoltWintersFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
+	return influxql.Float, nil
+}
+
+type GroupPromFunc struct {
+	BaseInfo
+	BaseAgg
+}
+
+func (f *GroupPromFunc) CompileFunc(expr *influxql.Call, c *compiledField) error {
+	if exp, got := 1, len(expr.Args); exp != got {
+		return fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", expr.Name, exp, got)
+	}
+	return c.compileSymbol(expr.Name, expr.Args[0])
+}
+
+func (f *GroupPromFunc) CallTypeFunc(_ string, _ []influxql.DataType) (influxql.DataType, error) {
+	return influxql.Float, nil
+}
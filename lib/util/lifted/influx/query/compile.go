@@ -774,9 +774,13 @@ func (c *compiledStatement) compileDimensions(stmt *influxql.SelectStatement) er
 				return errors.New("multiple time dimensions not allowed")
 			} else {
 				c.Interval.Duration = lit.Val
+				c.Interval.Months = lit.Months
 				if len(expr.Args) == 2 {
 					switch lit := expr.Args[1].(type) {
 					case *influxql.DurationLiteral:
+						if lit.Months > 0 {
+							return fmt.Errorf("invalid time dimension offset: %s", lit)
+						}
 						c.Interval.Offset = lit.Val % c.Interval.Duration
 					case *influxql.TimeLiteral:
 						c.Interval.Offset = lit.Val.Sub(lit.Val.Truncate(c.Interval.Duration))
@@ -882,6 +886,17 @@ func (c *compiledStatement) validateCondition(expr influxql.Expr) error {
 		}
 		return nil
 	case *influxql.Call:
+		if stringFunc, ok := GetStringFunction(expr.Name).(stringArgsValidator); ok {
+			if err := stringFunc.validateArgs(expr); err != nil {
+				return err
+			}
+			for _, arg := range expr.Args {
+				if err := c.validateCondition(arg); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
 		if mathFunc := GetMathFunction(expr.Name); mathFunc == nil {
 			return fmt.Errorf("invalid function call in condition: %s", expr)
 		}
@@ -1038,6 +1053,14 @@ func (c *compiledStatement) RewriteBinOpSourceDFS(binOpSources *[]*influxql.BinO
 
 }
 
+// addIntervals adds n intervals to t, the intervals of calendar months are added as months.
+func addIntervals(t time.Time, interval hybridqp.Interval, n int) time.Time {
+	if interval.Months > 0 {
+		return t.AddDate(0, n*int(interval.Months), 0)
+	}
+	return t.Add(time.Duration(n) * interval.Duration)
+}
+
 func (c *compiledStatement) Prepare(shardMapper ShardMapper, sopt SelectOptions) (PreparedStatement, error) {
 	// If this is a query with a grouping, there is a bucket limit, and the minimum time has not been specified,
 	// we need to limit the possible time range that can be used when mapping shards but not when actually executing
@@ -1060,6 +1083,7 @@ func (c *compiledStatement) Prepare(shardMapper ShardMapper, sopt SelectOptions)
 				Interval: hybridqp.Interval{
 					Duration: interval,
 					Offset:   offset,
+					Months:   c.stmt.GroupByMonths(),
 				},
 			}
 			last, _ := opt.Window(c.TimeRange.MaxTimeNano() - 1)
@@ -1070,7 +1094,7 @@ func (c *compiledStatement) Prepare(shardMapper ShardMapper, sopt SelectOptions)
 			if maxDiff/int64(interval) > int64(sopt.MaxBucketsN) {
 				timeRange.Min = time.Unix(0, models.MinNanoTime)
 			} else {
-				timeRange.Min = time.Unix(0, last-int64(interval)*int64(sopt.MaxBucketsN-1))
+				timeRange.Min = time.Unix(0, opt.WindowStart(last, -int64(sopt.MaxBucketsN-1)))
 			}
 		}
 	}
@@ -1078,14 +1102,14 @@ func (c *compiledStatement) Prepare(shardMapper ShardMapper, sopt SelectOptions)
 	// Modify the time range if there are extra intervals and an interval.
 	if !c.Interval.IsZero() && c.ExtraIntervals > 0 {
 		if c.Ascending {
-			newTime := timeRange.Min.Add(time.Duration(-c.ExtraIntervals) * c.Interval.Duration)
+			newTime := addIntervals(timeRange.Min, c.Interval, -c.ExtraIntervals)
 			if !newTime.Before(time.Unix(0, influxql.MinTime).UTC()) {
 				timeRange.Min = newTime
 			} else {
 				timeRange.Min = time.Unix(0, influxql.MinTime).UTC()
 			}
 		} else {
-			newTime := timeRange.Max.Add(time.Duration(c.ExtraIntervals) * c.Interval.Duration)
+			newTime := addIntervals(timeRange.Max, c.Interval, c.ExtraIntervals)
 			if !newTime.After(time.Unix(0, influxql.MaxTime).UTC()) {
 				timeRange.Max = newTime
 			} else {
@@ -1160,8 +1184,8 @@ func (c *compiledStatement) Prepare(shardMapper ShardMapper, sopt SelectOptions)
 			first, _ := opt.Window(opt.StartTime)
 			last, _ := opt.Window(opt.EndTime - 1)
 
-			// Determine the number of buckets by finding the time span and dividing by the interval.
-			buckets := (last - first + int64(interval)) / int64(interval)
+			// Determine the number of buckets by counting the windows of the time span.
+			buckets := opt.WindowIndex(first, last) + 1
 			if int(buckets) > sopt.MaxBucketsN {
 				shards.Close()
 				return nil, fmt.Errorf("max-select-buckets limit exceeded: (%d/%d)", buckets, sopt.MaxBucketsN)
@@ -0,0 +1,172 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/pkg/testing/assert"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skip(err)
+	}
+	return loc
+}
+
+func TestDateFunctions(t *testing.T) {
+	loc := loadLocation(t, "America/New_York")
+	// 2024-03-10 01:30 in New York, before the daylight saving time starts at 02:00, a Sunday
+	ts := time.Date(2024, 3, 10, 1, 30, 15, 0, loc).UnixNano()
+	valuer := query.DateValuer{Location: loc}
+
+	for _, tt := range []struct {
+		name   string
+		args   []interface{}
+		expect interface{}
+	}{
+		{"date_part", []interface{}{"year", ts}, int64(2024)},
+		{"date_part", []interface{}{"quarter", ts}, int64(1)},
+		{"date_part", []interface{}{"month", ts}, int64(3)},
+		{"date_part", []interface{}{"week", ts}, int64(10)},
+		{"date_part", []interface{}{"day", ts}, int64(10)},
+		{"date_part", []interface{}{"day_of_week", ts}, int64(0)},
+		{"date_part", []interface{}{"day_of_year", ts}, int64(70)},
+		{"date_part", []interface{}{"hour", ts}, int64(1)},
+		{"date_part", []interface{}{"minute", ts}, int64(30)},
+		{"date_part", []interface{}{"second", ts}, int64(15)},
+		{"date_trunc", []interface{}{"year", ts}, time.Date(2024, 1, 1, 0, 0, 0, 0, loc).UnixNano()},
+		{"date_trunc", []interface{}{"quarter", ts}, time.Date(2024, 1, 1, 0, 0, 0, 0, loc).UnixNano()},
+		{"date_trunc", []interface{}{"month", ts}, time.Date(2024, 3, 1, 0, 0, 0, 0, loc).UnixNano()},
+		{"date_trunc", []interface{}{"week", ts}, time.Date(2024, 3, 4, 0, 0, 0, 0, loc).UnixNano()},
+		{"date_trunc", []interface{}{"day", ts}, time.Date(2024, 3, 10, 0, 0, 0, 0, loc).UnixNano()},
+		{"date_trunc", []interface{}{"hour", ts}, time.Date(2024, 3, 10, 1, 0, 0, 0, loc).UnixNano()},
+		{"date_trunc", []interface{}{"minute", ts + 999}, time.Date(2024, 3, 10, 1, 30, 0, 0, loc).UnixNano()},
+		{"format_time", []interface{}{ts, "2006-01-02 15:04:05 MST"}, "2024-03-10 01:30:15 EST"},
+		{"format_time", []interface{}{ts + int64(time.Hour), "15:04 MST"}, "03:30 EDT"},
+		{"date_part", []interface{}{"hour", nil}, nil},
+	} {
+		out, ok := valuer.Call(tt.name, tt.args)
+		assert.Equal(t, ok, true)
+		assert.Equal(t, out, tt.expect)
+	}
+
+	// the date functions are evaluated in UTC without the time zone
+	out, _ := query.StringValuer{}.Call("date_part", []interface{}{"hour", ts})
+	assert.Equal(t, out, int64(6))
+	out, _ = query.DateValuer{}.Call("format_time", []interface{}{ts, time.RFC3339})
+	assert.Equal(t, out, "2024-03-10T06:30:15Z")
+	_, ok := valuer.Call("lower", []interface{}{"A"})
+	assert.Equal(t, ok, false)
+}
+
+func TestDateFunctionCompile(t *testing.T) {
+	for _, sql := range []string{
+		`SELECT value, date_part('day_of_week', time), date_trunc('month', time) FROM m`,
+		`SELECT sum(value), format_time(TIME, '2006-01') FROM m GROUP BY time(1mo) tz('Asia/Shanghai')`,
+	} {
+		stmt := influxql.MustParseStatement(sql).(*influxql.SelectStatement)
+		_, err := query.Compile(stmt, query.CompileOptions{})
+		assert.NoError(t, err)
+	}
+
+	for sql, expect := range map[string]string{
+		`SELECT value, date_part('century', time) FROM m`:       `invalid unit "century" in date_part()`,
+		`SELECT value, date_trunc('day', value) FROM m`:         "expected time argument in date_trunc()",
+		`SELECT value, date_trunc(unit, time) FROM m`:           "expected string argument in date_trunc()",
+		`SELECT value, format_time(time) FROM m`:                "invalid number of arguments for format_time, expected 2, got 1",
+		`SELECT value FROM m WHERE date_part('hour', time) = 3`: "invalid function call in condition: date_part('hour', time)",
+		`SELECT value, format_time(time, '') FROM m`:            `invalid unit "" in format_time()`,
+		`SELECT value, date_part('year', time, 'UTC') FROM m`:   "invalid number of arguments for date_part, expected 2, got 3",
+		`SELECT value, date_part('year', 'time') FROM m`:        "expected time argument in date_part()",
+		`SELECT value, format_time(time, 2006) FROM m`:          "expected string argument in format_time()",
+		`SELECT value, date_trunc('quarter', now()) FROM m`:     "expected time argument in date_trunc()",
+	} {
+		stmt := influxql.MustParseStatement(sql).(*influxql.SelectStatement)
+		_, err := query.Compile(stmt, query.CompileOptions{})
+		if err == nil {
+			t.Fatalf("expected error for %s", sql)
+		}
+		assert.Equal(t, err.Error(), expect)
+	}
+}
+
+func TestProcessorOptions_CalendarWindow(t *testing.T) {
+	loc := loadLocation(t, "America/New_York")
+	date := func(loc *time.Location, y int, m time.Month, d, h int) int64 {
+		return time.Date(y, m, d, h, 0, 0, 0, loc).UnixNano()
+	}
+
+	// months in UTC
+	opt := &query.ProcessorOptions{Interval: hybridqp.Interval{Duration: influxql.AverageMonth, Months: 1}}
+	assert.Equal(t, opt.IsCalendarInterval(), true)
+	start, end := opt.Window(date(time.UTC, 2024, 2, 29, 23))
+	assert.Equal(t, start, date(time.UTC, 2024, 2, 1, 0))
+	assert.Equal(t, end, date(time.UTC, 2024, 3, 1, 0))
+	start, end = opt.Window(date(time.UTC, 1969, 12, 31, 0))
+	assert.Equal(t, start, date(time.UTC, 1969, 12, 1, 0))
+	assert.Equal(t, end, date(time.UTC, 1970, 1, 1, 0))
+
+	// quarters in a time zone, the daylight saving time starts in March
+	opt = &query.ProcessorOptions{Interval: hybridqp.Interval{Duration: 3 * influxql.AverageMonth, Months: 3}, Location: loc}
+	start, end = opt.Window(date(loc, 2024, 5, 10, 12))
+	assert.Equal(t, start, date(loc, 2024, 4, 1, 0))
+	assert.Equal(t, end, date(loc, 2024, 7, 1, 0))
+	assert.Equal(t, opt.WindowIndex(date(loc, 2023, 1, 1, 0), date(loc, 2024, 5, 10, 12)), int64(5))
+	assert.Equal(t, opt.WindowStart(start, -2), date(loc, 2023, 10, 1, 0))
+
+	// months with an offset of one day
+	opt = &query.ProcessorOptions{Interval: hybridqp.Interval{Duration: influxql.AverageMonth, Offset: 24 * time.Hour, Months: 1}}
+	start, end = opt.Window(date(time.UTC, 2024, 3, 1, 12))
+	assert.Equal(t, start, date(time.UTC, 2024, 2, 2, 0))
+	assert.Equal(t, end, date(time.UTC, 2024, 3, 2, 0))
+
+	// a fixed interval as long as a month is not a calendar interval
+	opt = &query.ProcessorOptions{Interval: hybridqp.Interval{Duration: influxql.AverageMonth}}
+	assert.Equal(t, opt.IsCalendarInterval(), false)
+
+	// the day of the daylight saving time change is 23 hours long
+	opt = &query.ProcessorOptions{Interval: hybridqp.Interval{Duration: 24 * time.Hour}, Location: loc}
+	assert.Equal(t, opt.IsCalendarInterval(), true)
+	start, end = opt.Window(date(loc, 2024, 3, 10, 12))
+	assert.Equal(t, start, date(loc, 2024, 3, 10, 0))
+	assert.Equal(t, end-start, int64(23*time.Hour))
+	assert.Equal(t, opt.WindowStart(start, 1), date(loc, 2024, 3, 11, 0))
+
+	// the weeks keep the alignment of the fixed interval
+	opt = &query.ProcessorOptions{Interval: hybridqp.Interval{Duration: 7 * 24 * time.Hour}, Location: loc}
+	start, _ = opt.Window(date(loc, 2024, 3, 12, 12))
+	assert.Equal(t, start, date(loc, 2024, 3, 7, 0))
+	opt.Location = nil
+	assert.Equal(t, opt.IsCalendarInterval(), false)
+	start, _ = opt.Window(date(time.UTC, 2024, 3, 12, 12))
+	assert.Equal(t, start, date(time.UTC, 2024, 3, 7, 0))
+	assert.Equal(t, opt.WindowStart(start, 2), date(time.UTC, 2024, 3, 21, 0))
+
+	// the windows out of the range of the timestamps
+	opt = &query.ProcessorOptions{Interval: hybridqp.Interval{Duration: 12 * influxql.AverageMonth, Months: 12}}
+	start, _ = opt.Window(influxql.MinTime)
+	assert.Equal(t, start, influxql.MinTime)
+	_, end = opt.Window(influxql.MaxTime)
+	assert.Equal(t, end, influxql.MaxTime)
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+// The date functions are evaluated row by row as the string functions, over the time of the rows
+// in the time zone of the query, see DateValuer.
+var (
+	_ = RegistryMaterializeFunction("date_part", &datePartFunc{
+		BaseInfo: BaseInfo{FuncType: STRING},
+	})
+	_ = RegistryMaterializeFunction("date_trunc", &dateTruncFunc{
+		BaseInfo: BaseInfo{FuncType: STRING},
+	})
+	_ = RegistryMaterializeFunction("format_time", &formatTimeFunc{
+		BaseInfo: BaseInfo{FuncType: STRING},
+	})
+)
+
+var datePartUnits = map[string]func(t time.Time) int64{
+	"year":    func(t time.Time) int64 { return int64(t.Year()) },
+	"quarter": func(t time.Time) int64 { return int64(t.Month()-1)/3 + 1 },
+	"month":   func(t time.Time) int64 { return int64(t.Month()) },
+	"week": func(t time.Time) int64 {
+		_, week := t.ISOWeek()
+		return int64(week)
+	},
+	"day":         func(t time.Time) int64 { return int64(t.Day()) },
+	"day_of_week": func(t time.Time) int64 { return int64(t.Weekday()) },
+	"day_of_year": func(t time.Time) int64 { return int64(t.YearDay()) },
+	"hour":        func(t time.Time) int64 { return int64(t.Hour()) },
+	"minute":      func(t time.Time) int64 { return int64(t.Minute()) },
+	"second":      func(t time.Time) int64 { return int64(t.Second()) },
+}
+
+var dateTruncUnits = map[string]func(t time.Time) time.Time{
+	"year": func(t time.Time) time.Time {
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+	},
+	"quarter": func(t time.Time) time.Time {
+		return time.Date(t.Year(), (t.Month()-1)/3*3+1, 1, 0, 0, 0, 0, t.Location())
+	},
+	"month": func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	},
+	// the weeks start on Monday as the ISO weeks
+	"week": func(t time.Time) time.Time {
+		y, m, d := t.Date()
+		return time.Date(y, m, d-(int(t.Weekday())+6)%7, 0, 0, 0, 0, t.Location())
+	},
+	"day": func(t time.Time) time.Time {
+		y, m, d := t.Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	},
+	// subtract the elapsed time instead of building the local time, which is ambiguous
+	// in the hour repeated when the daylight saving time ends
+	"hour": func(t time.Time) time.Time {
+		return t.Add(-time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+	},
+	"minute": func(t time.Time) time.Time {
+		return t.Add(-time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+	},
+	"second": func(t time.Time) time.Time {
+		return t.Add(-time.Duration(t.Nanosecond()))
+	},
+}
+
+// dateFunc is implemented by the date functions evaluated in a time zone.
+type dateFunc interface {
+	callIn(loc *time.Location, args []interface{}) (interface{}, bool)
+}
+
+// IsDateFunction returns true if the function is one of the date functions, the time of the
+// rows is one of the arguments.
+func IsDateFunction(name string) bool {
+	_, ok := GetStringFunction(name).(dateFunc)
+	return ok
+}
+
+// compileTimeArg checks the argument of the time, which is the time of the rows.
+func compileTimeArg(expr *influxql.Call, i int) error {
+	ref, ok := expr.Args[i].(*influxql.VarRef)
+	if !ok || strings.ToLower(ref.Val) != "time" {
+		return fmt.Errorf("expected time argument in %s()", expr.Name)
+	}
+	ref.Val, ref.Type = "time", influxql.Integer
+	return nil
+}
+
+func compileUnitArg(expr *influxql.Call, i int, valid func(unit string) bool) error {
+	lit, ok := expr.Args[i].(*influxql.StringLiteral)
+	if !ok {
+		return fmt.Errorf("expected string argument in %s()", expr.Name)
+	}
+	if !valid(lit.Val) {
+		return fmt.Errorf("invalid unit %q in %s()", lit.Val, expr.Name)
+	}
+	return nil
+}
+
+func checkDateFuncArgs(name string, args []influxql.DataType, timeIndex int) error {
+	if len(args) != 2 {
+		return fmt.Errorf("invalid argument number in %s(): %d", name, len(args))
+	}
+	if args[timeIndex] != influxql.Integer {
+		return fmt.Errorf("invalid argument type for the argument %d in %s(): %s", timeIndex+1, name, args[timeIndex])
+	}
+	if other := args[1-timeIndex]; other != influxql.String {
+		return fmt.Errorf("invalid argument type for the argument %d in %s(): %s", 2-timeIndex, name, other)
+	}
+	return nil
+}
+
+// dateUnitArgs returns the unit and the time of the functions called as f('unit', time).
+func dateUnitArgs(loc *time.Location, args []interface{}) (string, time.Time, bool) {
+	unit, ok := args[0].(string)
+	if !ok {
+		return "", time.Time{}, false
+	}
+	ns, ok := args[1].(int64)
+	if !ok {
+		return "", time.Time{}, false
+	}
+	return unit, time.Unix(0, ns).In(loc), true
+}
+
+// datePartFunc returns the field of the time, such as the year or the hour: date_part('month', time).
+type datePartFunc struct {
+	BaseInfo
+}
+
+func (s *datePartFunc) CompileFunc(expr *influxql.Call, _ *compiledField) error {
+	if got := len(expr.Args); got != 2 {
+		return fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", expr.Name, 2, got)
+	}
+	if err := compileUnitArg(expr, 0, func(unit string) bool {
+		_, ok := datePartUnits[unit]
+		return ok
+	}); err != nil {
+		return err
+	}
+	return compileTimeArg(expr, 1)
+}
+
+func (s *datePartFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
+	if err := checkDateFuncArgs(name, args, 1); err != nil {
+		return influxql.Unknown, err
+	}
+	return influxql.Integer, nil
+}
+
+func (s *datePartFunc) CallFunc(_ string, args []interface{}) (interface{}, bool) {
+	return s.callIn(time.UTC, args)
+}
+
+func (s *datePartFunc) callIn(loc *time.Location, args []interface{}) (interface{}, bool) {
+	if len(args) != 2 {
+		return nil, false
+	}
+	unit, t, ok := dateUnitArgs(loc, args)
+	if !ok {
+		return nil, true
+	}
+	if part, ok := datePartUnits[unit]; ok {
+		return part(t), true
+	}
+	return nil, true
+}
+
+// dateTruncFunc truncates the time to the start of the unit, such as the month: date_trunc('month', time).
+type dateTruncFunc struct {
+	BaseInfo
+}
+
+func (s *dateTruncFunc) CompileFunc(expr *influxql.Call, _ *compiledField) error {
+	if got := len(expr.Args); got != 2 {
+		return fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", expr.Name, 2, got)
+	}
+	if err := compileUnitArg(expr, 0, func(unit string) bool {
+		_, ok := dateTruncUnits[unit]
+		return ok
+	}); err != nil {
+		return err
+	}
+	return compileTimeArg(expr, 1)
+}
+
+func (s *dateTruncFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
+	if err := checkDateFuncArgs(name, args, 1); err != nil {
+		return influxql.Unknown, err
+	}
+	return influxql.Integer, nil
+}
+
+func (s *dateTruncFunc) CallFunc(_ string, args []interface{}) (interface{}, bool) {
+	return s.callIn(time.UTC, args)
+}
+
+func (s *dateTruncFunc) callIn(loc *time.Location, args []interface{}) (interface{}, bool) {
+	if len(args) != 2 {
+		return nil, false
+	}
+	unit, t, ok := dateUnitArgs(loc, args)
+	if !ok {
+		return nil, true
+	}
+	if trunc, ok := dateTruncUnits[unit]; ok {
+		return trunc(t).UnixNano(), true
+	}
+	return nil, true
+}
+
+// formatTimeFunc formats the time by the layout of the Go time package: format_time(time, '2006-01-02').
+type formatTimeFunc struct {
+	BaseInfo
+}
+
+func (s *formatTimeFunc) CompileFunc(expr *influxql.Call, _ *compiledField) error {
+	if got := len(expr.Args); got != 2 {
+		return fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", expr.Name, 2, got)
+	}
+	if err := compileUnitArg(expr, 1, func(layout string) bool {
+		return layout != ""
+	}); err != nil {
+		return err
+	}
+	return compileTimeArg(expr, 0)
+}
+
+func (s *formatTimeFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
+	if err := checkDateFuncArgs(name, args, 0); err != nil {
+		return influxql.Unknown, err
+	}
+	return influxql.String, nil
+}
+
+func (s *formatTimeFunc) CallFunc(_ string, args []interface{}) (interface{}, bool) {
+	return s.callIn(time.UTC, args)
+}
+
+func (s *formatTimeFunc) callIn(loc *time.Location, args []interface{}) (interface{}, bool) {
+	if len(args) != 2 {
+		return nil, false
+	}
+	ns, ok := args[0].(int64)
+	if !ok {
+		return nil, true
+	}
+	layout, ok := args[1].(string)
+	if !ok {
+		return nil, true
+	}
+	return time.Unix(0, ns).In(loc).Format(layout), true
+}
+
+// DateValuer evaluates the date functions in the time zone of the query, the functions
+// are evaluated in UTC by StringValuer.
+type DateValuer struct {
+	Location *time.Location
+}
+
+var _ influxql.CallValuer = DateValuer{}
+
+func (DateValuer) Value(_ string) (interface{}, bool) {
+	return nil, false
+}
+
+func (DateValuer) SetValuer(_ influxql.Valuer, _ int) {
+}
+
+func (v DateValuer) Call(name string, args []interface{}) (interface{}, bool) {
+	f, ok := GetStringFunction(name).(dateFunc)
+	if !ok {
+		return nil, false
+	}
+	loc := v.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	return f.callIn(loc, args)
+}
@@ -85,6 +85,9 @@ func ErrMaxSelectPointsLimitExceeded(n, limit int) error {
 	return fmt.Errorf("max-select-point limit exceeed: (%d/%d)", n, limit)
 }
 
+// ErrPromTooManySamples is the error of the Prometheus query which hits the maximum number of samples.
+var ErrPromTooManySamples = errors.New("query processing would load too many samples into memory in query execution")
+
 // ErrMaxConcurrentQueriesLimitExceeded is an error when a query cannot be run
 // because the maximum number of queries has been reached.
 func ErrMaxConcurrentQueriesLimitExceeded(n, limit int) error {
@@ -157,8 +160,10 @@ func (a openAuthorizer) AuthorizeQuery(_ string, _ *influxql.Query) error { retu
 //}
 
 type RowsChan struct {
-	Rows    models.Rows // models.Rows of data
-	Partial bool        // is partial of rows
+	Rows        models.Rows         // models.Rows of data
+	Partial     bool                // is partial of rows
+	Dimensions  []string            // tag keys the rows are grouped by
+	ColumnTypes []influxql.DataType // data types of the columns of the rows
 }
 
 // ExecutionOptions contains the options for executing a query.
@@ -209,11 +214,18 @@ type ExecutionOptions struct {
 	// IsPromQuery indicates whether the query is a promql query.
 	IsPromQuery bool
 
+	// MaxPointN overrides the max-select-point of the executor when it is greater than 0.
+	MaxPointN int
+
 	// QueryID indicates the representation of the query.
 	QueryID string
 
 	// IterID indicates the number of iteration in incremental query, starting from 0.
 	IterID int32
+
+	// UserName and ClientAddr identify who sent the query, they are recorded by the audit log.
+	UserName   string
+	ClientAddr string
 }
 
 func NewExecutionOptions(db, rp string, nodeID uint64, chunkSize, innerChunkSize int, chunked, readOnly, quiet, parallelQuery bool) *ExecutionOptions {
@@ -44,6 +44,43 @@ func TestQueryExecutor_Parallel(t *testing.T) {
 	discardOutput(results)
 }
 
+func TestProcessorOptions_AddPoints(t *testing.T) {
+	opt := &query.ProcessorOptions{}
+	if err := opt.AddPoints(100); err != nil {
+		t.Fatal(err)
+	}
+
+	// the points are counted across the clones and the decoded options of the store
+	opt.SetMaxPointN(10)
+	buf, err := opt.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &query.ProcessorOptions{}
+	if err = decoded.UnmarshalBinary(buf); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.MaxPointN != 10 {
+		t.Fatalf("unexpected MaxPointN: %d", decoded.MaxPointN)
+	}
+	clone := decoded.Clone()
+	if err = decoded.AddPoints(6); err != nil {
+		t.Fatal(err)
+	}
+	if err = clone.AddPoints(4); err != nil {
+		t.Fatal(err)
+	}
+	err = clone.AddPoints(1)
+	if err == nil || err.Error() != query.ErrMaxSelectPointsLimitExceeded(11, 10).Error() {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	opt.SetPromQuery(true)
+	if err = opt.AddPoints(11); err != query.ErrPromTooManySamples {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func discardOutput(results <-chan *query.Result) {
 	for range results {
 		// Read all results and discard.
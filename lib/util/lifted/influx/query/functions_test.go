@@ -129,3 +129,16 @@ func TestClampMin(t *testing.T) {
 		assert.Equal(t, outputs, expects)
 	})
 }
+
+func TestSgn(t *testing.T) {
+	mathValuer := query.MathValuer{}
+	inputArgs := []interface{}{float64(-2.5), float64(0), float64(3), int64(-4), int64(5)}
+	expects := []interface{}{float64(-1), float64(0), float64(1), float64(-1), float64(1)}
+	outputs := make([]interface{}, 0, len(expects))
+	for _, arg := range inputArgs {
+		if out, ok := mathValuer.Call("sgn_prom", []interface{}{arg}); ok {
+			outputs = append(outputs, out)
+		}
+	}
+	assert.Equal(t, outputs, expects)
+}
@@ -87,6 +87,9 @@ var (
 	_ = RegistryMaterializeFunction("cast_string", &castStringFunc{
 		BaseInfo: BaseInfo{FuncType: MATH},
 	})
+	_ = RegistryMaterializeFunction("sgn_prom", &promSgnFunc{
+		BaseInfo: BaseInfo{FuncType: MATH},
+	})
 	_ = RegistryMaterializeFunction("clamp_prom", &promClampFunc{
 		BaseInfo: BaseInfo{FuncType: MATH},
 	})
@@ -650,6 +653,33 @@ func (f *castStringFunc) CallFunc(name string, args []interface{}) (interface{},
 	}
 }
 
+type promSgnFunc struct {
+	BaseInfo
+}
+
+func (f *promSgnFunc) CompileFunc(expr *influxql.Call, c *compiledField) error {
+	return compileMathFunction(expr, c, 1)
+}
+
+func (f *promSgnFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
+	return commonCallType1(name, args)
+}
+
+func (f *promSgnFunc) CallFunc(name string, args []interface{}) (interface{}, bool) {
+	if arg0, ok := asFloat(args[0]); ok {
+		switch {
+		case arg0 < 0:
+			return float64(-1), true
+		case arg0 > 0:
+			return float64(1), true
+		default:
+			// keep the sign of zero and NaN
+			return arg0, true
+		}
+	}
+	return nil, true
+}
+
 type promClampFunc struct {
 	BaseInfo
 }
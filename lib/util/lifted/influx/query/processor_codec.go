@@ -81,6 +81,7 @@ func encodeProcessorOptions(opt *ProcessorOptions) *internal.ProcessorOptions {
 		IterID:                opt.IterID,
 		PromQuery:             opt.PromQuery,
 		Without:               opt.Without,
+		MaxPointN:             int64(opt.MaxPointN),
 		Step:                  int64(opt.Step),
 		Range:                 int64(opt.Range),
 		LookBackDelta:         int64(opt.LookBackDelta),
@@ -164,6 +165,7 @@ func decodeProcessorOptions(pb *internal.ProcessorOptions) (*ProcessorOptions, e
 		LookBackDelta:         time.Duration(pb.LookBackDelta),
 		QueryOffset:           time.Duration(pb.QueryOffset),
 	}
+	opt.SetMaxPointN(int(pb.GetMaxPointN()))
 
 	// Set expression, if set.
 	if pb.Expr != "" {
@@ -378,6 +380,7 @@ func encodeInterval(i hybridqp.Interval) *internal.Interval {
 	return &internal.Interval{
 		Duration: i.Duration.Nanoseconds(),
 		Offset:   i.Offset.Nanoseconds(),
+		Months:   i.Months,
 	}
 }
 
@@ -385,6 +388,7 @@ func decodeInterval(pb *internal.Interval) hybridqp.Interval {
 	return hybridqp.Interval{
 		Duration: time.Duration(pb.GetDuration()),
 		Offset:   time.Duration(pb.GetOffset()),
+		Months:   pb.GetMonths(),
 	}
 }
 
@@ -30,6 +30,9 @@ func init() {
 	RegistryPromTimeFunction("vector_prom", &vectorPromFunc{
 		BaseInfo: BaseInfo{FuncType: PROMTIME},
 	})
+	RegistryPromTimeFunction("timestamp_prom", &timestampPromFunc{
+		BaseInfo: BaseInfo{FuncType: PROMTIME},
+	})
 	RegistryPromTimeFunction("month_prom", &monthPromFunc{
 		BaseInfo: BaseInfo{FuncType: PROMTIME},
 	})
@@ -94,6 +97,18 @@ func (s *vectorPromFunc) CallTypeFunc(name string, args []influxql.DataType) (in
 	return influxql.Float, nil
 }
 
+type timestampPromFunc struct {
+	BaseInfo
+}
+
+func (s *timestampPromFunc) CompileFunc(expr *influxql.Call, c *compiledField) error {
+	return nil
+}
+
+func (s *timestampPromFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
+	return influxql.Float, nil
+}
+
 type monthPromFunc struct {
 	BaseInfo
 }
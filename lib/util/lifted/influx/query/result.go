@@ -14,8 +14,9 @@ import (
 
 	"github.com/bytedance/sonic"
 	"github.com/influxdata/influxdb/models"
-	"github.com/influxdata/influxql"
+	originql "github.com/influxdata/influxql"
 	json "github.com/json-iterator/go"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 )
 
 const (
@@ -27,13 +28,13 @@ const (
 // composed of multiple individual series that share a set of tag attributes.
 type TagSet struct {
 	Tags       map[string]string
-	Filters    []influxql.Expr
+	Filters    []originql.Expr
 	SeriesKeys []string
 	Key        []byte
 }
 
 // AddFilter adds a series-level filter to the Tagset.
-func (t *TagSet) AddFilter(key string, filter influxql.Expr) {
+func (t *TagSet) AddFilter(key string, filter originql.Expr) {
 	t.SeriesKeys = append(t.SeriesKeys, key)
 	t.Filters = append(t.Filters, filter)
 }
@@ -100,6 +101,11 @@ type Result struct {
 	Messages    []*Message
 	Partial     bool
 	Err         error
+
+	// Dimensions and ColumnTypes are the tag keys and the column types of the
+	// series returned by a SELECT statement, they are not encoded.
+	Dimensions  []string
+	ColumnTypes []influxql.DataType
 }
 
 // MarshalJSON encodes the result into JSON.
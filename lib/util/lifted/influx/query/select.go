@@ -14,6 +14,7 @@ import (
 	"io"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/openGemini/openGemini/engine/hybridqp"
@@ -193,6 +194,11 @@ type ProcessorOptions struct {
 	// Limits on the creation of iterators.
 	MaxSeriesN int
 
+	// MaxPointN limits the number of the points read by the sources of the query, 0 is unlimited.
+	// points counts them and is shared by the clones of the options.
+	MaxPointN int
+	points    *int64
+
 	// If this channel is set and is closed, the iterator should try to exit
 	// and close as soon as possible.
 	InterruptCh <-chan struct{}
@@ -290,6 +296,9 @@ func NewProcessorOptionsStmt(stmt *influxql.SelectStatement, sopt SelectOptions)
 		}
 	}
 	opt.Interval.Duration = interval
+	if interval > 0 {
+		opt.Interval.Months = stmt.GroupByMonths()
+	}
 
 	// Always request an ordered output for the top level iterators.
 	// The emitter will always emit points as ordered.
@@ -330,6 +339,7 @@ func NewProcessorOptionsStmt(stmt *influxql.SelectStatement, sopt SelectOptions)
 	opt.Limit, opt.Offset = stmt.Limit, stmt.Offset
 	opt.SLimit, opt.SOffset = stmt.SLimit, stmt.SOffset
 	opt.MaxSeriesN = sopt.MaxSeriesN
+	opt.SetMaxPointN(sopt.MaxPointN)
 	opt.Authorizer = sopt.Authorizer
 
 	opt.ChunkedSize = sopt.ChunkedSize
@@ -360,6 +370,31 @@ func (opt *ProcessorOptions) UpdateSources(sources influxql.Sources) {
 	opt.Sources = sources
 }
 
+// SetMaxPointN sets the limit of the points read by the sources of the query.
+func (opt *ProcessorOptions) SetMaxPointN(n int) {
+	opt.MaxPointN = n
+	opt.points = nil
+	if n > 0 {
+		opt.points = new(int64)
+	}
+}
+
+// AddPoints counts the points read by a source of the query,
+// it returns an error once the total exceeds MaxPointN.
+func (opt *ProcessorOptions) AddPoints(n int) error {
+	if opt.points == nil {
+		return nil
+	}
+	total := atomic.AddInt64(opt.points, int64(n))
+	if total <= int64(opt.MaxPointN) {
+		return nil
+	}
+	if opt.PromQuery {
+		return ErrPromTooManySamples
+	}
+	return ErrMaxSelectPointsLimitExceeded(int(total), opt.MaxPointN)
+}
+
 func (opt *ProcessorOptions) Clone() *ProcessorOptions {
 	popt := ProcessorOptions{}
 	popt = *opt
@@ -470,6 +505,10 @@ func (opt *ProcessorOptions) Window(t int64) (start, end int64) {
 	if opt.Interval.IsZero() {
 		return opt.StartTime, opt.EndTime + 1
 	}
+	if opt.IsCalendarInterval() {
+		i := opt.calendarIndex(t)
+		return opt.calendarTime(i), opt.calendarTime(i + 1)
+	}
 
 	// Subtract the offset to the time so we calculate the correct base interval.
 	t -= int64(opt.Interval.Offset)
@@ -545,6 +584,81 @@ func (opt *ProcessorOptions) Window(t int64) (start, end int64) {
 	return
 }
 
+// IsCalendarInterval returns true if the windows of the interval follow the calendar instead of
+// a fixed duration, so the windows vary in length. The windows of the months are aligned to the
+// first day of the months. In a time zone, the windows of the days and weeks are aligned to the
+// local midnight, so a window is 23 or 25 hours long when the daylight saving time changes.
+func (opt *ProcessorOptions) IsCalendarInterval() bool {
+	if opt.Interval.Months > 0 {
+		return true
+	}
+	return opt.Location != nil && opt.Interval.Duration > 0 && opt.Interval.Duration%(24*time.Hour) == 0
+}
+
+// WindowIndex returns the number of the windows from the window starting at start to the window of t.
+func (opt *ProcessorOptions) WindowIndex(start, t int64) int64 {
+	if opt.IsCalendarInterval() {
+		return opt.calendarIndex(t) - opt.calendarIndex(start)
+	}
+	return (t - start) / int64(opt.Interval.Duration)
+}
+
+// WindowStart returns the start time of the nth window after the window starting at start,
+// a negative n goes back in time.
+func (opt *ProcessorOptions) WindowStart(start, n int64) int64 {
+	if opt.IsCalendarInterval() {
+		return opt.calendarTime(opt.calendarIndex(start) + n)
+	}
+	return start + n*int64(opt.Interval.Duration)
+}
+
+// calendarIndex returns the index of the calendar window of t, the windows are counted from the
+// one starting on 1970-01-01 in the location of the query.
+func (opt *ProcessorOptions) calendarIndex(t int64) int64 {
+	local := time.Unix(0, t-int64(opt.Interval.Offset)).In(opt.calendarLocation())
+	var units, n int64
+	if opt.Interval.Months > 0 {
+		units = int64(local.Year()-1970)*12 + int64(local.Month()-time.January)
+		n = opt.Interval.Months
+	} else {
+		y, m, d := local.Date()
+		units = time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / int64(24*time.Hour/time.Second)
+		n = int64(opt.Interval.Duration / (24 * time.Hour))
+	}
+	// Round down for the windows before 1970.
+	i := units / n
+	if units%n < 0 {
+		i--
+	}
+	return i
+}
+
+// calendarTime returns the start time of the calendar window with the index i.
+func (opt *ProcessorOptions) calendarTime(i int64) int64 {
+	var t time.Time
+	if n := opt.Interval.Months; n > 0 {
+		t = time.Date(1970, time.Month(i*n+1), 1, 0, 0, 0, 0, opt.calendarLocation())
+	} else {
+		n := int64(opt.Interval.Duration / (24 * time.Hour))
+		t = time.Date(1970, time.January, int(i*n+1), 0, 0, 0, 0, opt.calendarLocation())
+	}
+
+	// Clamp the windows out of the range of the nanosecond timestamps.
+	if sec := t.Unix(); sec <= influxql.MinTime/int64(time.Second) {
+		return influxql.MinTime
+	} else if sec >= influxql.MaxTime/int64(time.Second) {
+		return influxql.MaxTime
+	}
+	return t.UnixNano() + int64(opt.Interval.Offset)
+}
+
+func (opt *ProcessorOptions) calendarLocation() *time.Location {
+	if opt.Location == nil {
+		return time.UTC
+	}
+	return opt.Location
+}
+
 // DerivativeInterval returns the time interval for the derivative function.
 func (opt *ProcessorOptions) DerivativeInterval() hybridqp.Interval {
 	// Use the interval on the derivative() call, if specified.
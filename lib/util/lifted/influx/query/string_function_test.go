@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/influxdata/influxdb/pkg/testing/assert"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 )
 
@@ -83,3 +84,115 @@ func TestStringFunctionSubStrTwoPara(t *testing.T) {
 	assert.Equal(t, outputs, expects)
 
 }
+
+func TestStringFunctions(t *testing.T) {
+	stringValuer := query.StringValuer{}
+	for _, tt := range []struct {
+		name   string
+		args   []interface{}
+		expect interface{}
+	}{
+		{"lower", []interface{}{"AbC-É"}, "abc-é"},
+		{"lower", []interface{}{"AbC"}, "abc"},
+		{"upper", []interface{}{"aBc"}, "ABC"},
+		{"upper", []interface{}{"é-abc"}, "É-ABC"},
+		{"trim", []interface{}{" \tabc \n"}, "abc"},
+		{"replace", []interface{}{"a-b-c", "-", "+"}, "a+b+c"},
+		{"replace", []interface{}{"abc", "", "+"}, "abc"},
+		{"regexp_extract", []interface{}{"code=404 path=/a", `code=(\d+)`}, "code=404"},
+		{"regexp_extract", []interface{}{"code=404 path=/a", `code=(\d+)`, int64(1)}, "404"},
+		{"regexp_extract", []interface{}{"path=/a", `code=(\d+)`, int64(1)}, ""},
+		{"regexp_replace", []interface{}{"user=tom id=1", `user=(\w+)`, "user=<$1>"}, "user=<tom> id=1"},
+		{"split_part", []interface{}{"a,b,,c", ",", int64(2)}, "b"},
+		{"split_part", []interface{}{"a,b,,c", ",", int64(3)}, ""},
+		{"split_part", []interface{}{"a,b,,c", ",", int64(4)}, "c"},
+		{"split_part", []interface{}{"a,b,,c", ",", int64(5)}, ""},
+		{"concat", []interface{}{"a", "b", "c"}, "abc"},
+		{"concat", []interface{}{"a", nil}, nil},
+		{"starts_with", []interface{}{"error: x", "error"}, true},
+		{"starts_with", []interface{}{"warn: x", "error"}, false},
+		{"ends_with", []interface{}{"a.log", ".log"}, true},
+		{"position", []interface{}{"héllo", "llo"}, int64(3)},
+		{"position", []interface{}{"hello", "x"}, int64(0)},
+		{"lpad", []interface{}{"7", int64(3), "0"}, "007"},
+		{"lpad", []interface{}{"12345", int64(3), "0"}, "123"},
+		{"rpad", []interface{}{"ab", int64(5), "xy"}, "abxyx"},
+		{"md5", []interface{}{"abc"}, "900150983cd24fb0d6963f7d28e17f72"},
+		{"sha256", []interface{}{"abc"}, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{"json_extract", []interface{}{`{"a":{"b":[1,"x"]}}`, "$.a.b[1]"}, "x"},
+		{"json_extract", []interface{}{`{"a":{"b":[1,"x"]}}`, "$.a.b"}, `[1,"x"]`},
+		{"json_extract", []interface{}{`{"a":{"b":[1,"x"]}}`, "a.c"}, ""},
+		{"json_extract", []interface{}{`not json`, "a"}, ""},
+		{"lower", []interface{}{nil}, nil},
+	} {
+		out, ok := stringValuer.Call(tt.name, tt.args)
+		assert.Equal(t, ok, true)
+		assert.Equal(t, out, tt.expect)
+	}
+}
+
+func TestStringFunctionTypes(t *testing.T) {
+	s := query.StringFunctionTypeMapper{}
+	for _, tt := range []struct {
+		name   string
+		args   []influxql.DataType
+		expect influxql.DataType
+	}{
+		{"lower", []influxql.DataType{influxql.String}, influxql.String},
+		{"regexp_extract", []influxql.DataType{influxql.String, influxql.String}, influxql.String},
+		{"regexp_extract", []influxql.DataType{influxql.String, influxql.String, influxql.Integer}, influxql.String},
+		{"concat", []influxql.DataType{influxql.String, influxql.String, influxql.String}, influxql.String},
+		{"starts_with", []influxql.DataType{influxql.String, influxql.String}, influxql.Boolean},
+		{"position", []influxql.DataType{influxql.String, influxql.String}, influxql.Integer},
+		{"lpad", []influxql.DataType{influxql.String, influxql.Integer, influxql.String}, influxql.String},
+	} {
+		typ, err := s.CallType(tt.name, tt.args)
+		assert.NoError(t, err)
+		assert.Equal(t, typ, tt.expect)
+	}
+
+	for _, tt := range []struct {
+		name string
+		args []influxql.DataType
+	}{
+		{"lower", []influxql.DataType{influxql.Integer}},
+		{"lower", []influxql.DataType{influxql.String, influxql.String}},
+		{"split_part", []influxql.DataType{influxql.String, influxql.String, influxql.String}},
+		{"concat", []influxql.DataType{influxql.String}},
+		{"concat", []influxql.DataType{influxql.String, influxql.Float}},
+	} {
+		if _, err := s.CallType(tt.name, tt.args); err == nil {
+			t.Fatalf("expected error for %s%v", tt.name, tt.args)
+		}
+	}
+}
+
+func TestStringFunctionCompile(t *testing.T) {
+	for _, sql := range []string{
+		`SELECT lower(msg), regexp_extract(msg, 'code=(\\d+)', 1) FROM m`,
+		`SELECT concat(host, '-', msg) FROM m`,
+		`SELECT msg FROM m WHERE starts_with(lower(msg), 'error') = true`,
+		`SELECT msg FROM m WHERE json_extract(msg, '$.level') = 'warn'`,
+	} {
+		stmt := influxql.MustParseStatement(sql).(*influxql.SelectStatement)
+		_, err := query.Compile(stmt, query.CompileOptions{})
+		assert.NoError(t, err, sql)
+	}
+
+	for sql, expect := range map[string]string{
+		`SELECT regexp_extract(msg, '(', 1) FROM m`:          "regexp_extract() error parsing regexp: missing closing ): `(`",
+		`SELECT regexp_extract(msg, 'a(b)', 2) FROM m`:       "regexp_extract() group 2 is out of range, the pattern has 1 groups",
+		`SELECT split_part(msg, ',', 0) FROM m`:              "split_part() expected positive part number",
+		`SELECT replace(msg, pattern, 'a') FROM m`:           "expected string argument in replace()",
+		`SELECT json_extract(msg, '$.a[x]') FROM m`:          `json_extract() invalid JSON path: invalid index "x"`,
+		`SELECT msg FROM m WHERE lpad(msg, 2) = 'ab'`:        "invalid number of arguments for lpad, expected 3, got 2",
+		`SELECT msg FROM m WHERE regexp_extract(msg) = 'ab'`: "invalid number of arguments for regexp_extract, expected 2 to 3, got 1",
+	} {
+		stmt := influxql.MustParseStatement(sql).(*influxql.SelectStatement)
+		_, err := query.Compile(stmt, query.CompileOptions{})
+		if err == nil {
+			t.Fatalf("expected error for %s", sql)
+		}
+		assert.Equal(t, err.Error(), expect)
+	}
+}
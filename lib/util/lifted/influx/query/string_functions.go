@@ -17,11 +17,19 @@ limitations under the License.
 */
 
 import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"unicode/utf8"
 
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/valyala/fastjson"
 )
 
 var (
@@ -34,6 +42,34 @@ var (
 	_ = RegistryMaterializeFunction("substr", &subStrFunc{
 		BaseInfo: BaseInfo{FuncType: STRING},
 	})
+	_ = RegistryMaterializeFunction("lower", newLiteralArgsStringFunc(influxql.String, nil, 0, bindLower))
+	_ = RegistryMaterializeFunction("upper", newLiteralArgsStringFunc(influxql.String, nil, 0, bindUpper))
+	_ = RegistryMaterializeFunction("trim", newLiteralArgsStringFunc(influxql.String, nil, 0, bindTrim))
+	_ = RegistryMaterializeFunction("replace", newLiteralArgsStringFunc(influxql.String,
+		[]influxql.DataType{influxql.String, influxql.String}, 0, bindReplace))
+	_ = RegistryMaterializeFunction("regexp_extract", newLiteralArgsStringFunc(influxql.String,
+		[]influxql.DataType{influxql.String, influxql.Integer}, 1, bindRegexpExtract))
+	_ = RegistryMaterializeFunction("regexp_replace", newLiteralArgsStringFunc(influxql.String,
+		[]influxql.DataType{influxql.String, influxql.String}, 0, bindRegexpReplace))
+	_ = RegistryMaterializeFunction("split_part", newLiteralArgsStringFunc(influxql.String,
+		[]influxql.DataType{influxql.String, influxql.Integer}, 0, bindSplitPart))
+	_ = RegistryMaterializeFunction("concat", &concatFunc{
+		BaseInfo: BaseInfo{FuncType: STRING},
+	})
+	_ = RegistryMaterializeFunction("starts_with", newLiteralArgsStringFunc(influxql.Boolean,
+		[]influxql.DataType{influxql.String}, 0, bindStartsWith))
+	_ = RegistryMaterializeFunction("ends_with", newLiteralArgsStringFunc(influxql.Boolean,
+		[]influxql.DataType{influxql.String}, 0, bindEndsWith))
+	_ = RegistryMaterializeFunction("position", newLiteralArgsStringFunc(influxql.Integer,
+		[]influxql.DataType{influxql.String}, 0, bindPosition))
+	_ = RegistryMaterializeFunction("lpad", newLiteralArgsStringFunc(influxql.String,
+		[]influxql.DataType{influxql.Integer, influxql.String}, 0, bindPad(true)))
+	_ = RegistryMaterializeFunction("rpad", newLiteralArgsStringFunc(influxql.String,
+		[]influxql.DataType{influxql.Integer, influxql.String}, 0, bindPad(false)))
+	_ = RegistryMaterializeFunction("md5", newLiteralArgsStringFunc(influxql.String, nil, 0, bindMD5))
+	_ = RegistryMaterializeFunction("sha256", newLiteralArgsStringFunc(influxql.String, nil, 0, bindSHA256))
+	_ = RegistryMaterializeFunction("json_extract", newLiteralArgsStringFunc(influxql.String,
+		[]influxql.DataType{influxql.String}, 0, bindJSONExtract))
 )
 
 func GetStringFunction(name string) MaterializeFunc {
@@ -62,13 +98,20 @@ type strFunc struct {
 }
 
 func (s *strFunc) CompileFunc(expr *influxql.Call, c *compiledField) error {
+	if err := s.validateArgs(expr); err != nil {
+		return err
+	}
+	return compileAllStringArgs(expr, c)
+}
+
+func (s *strFunc) validateArgs(expr *influxql.Call) error {
 	if got := len(expr.Args); got != 2 {
 		return fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", expr.Name, 2, got)
 	}
 	if _, ok := expr.Args[1].(*influxql.StringLiteral); !ok {
 		return fmt.Errorf("expected string argument in str()")
 	}
-	return compileAllStringArgs(expr, c)
+	return nil
 }
 
 func (s *strFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
@@ -113,9 +156,19 @@ type strLenFunc struct {
 }
 
 func (s *strLenFunc) CompileFunc(expr *influxql.Call, c *compiledField) error {
+	if err := s.validateArgs(expr); err != nil {
+		return err
+	}
 	return compileAllStringArgs(expr, c)
 }
 
+func (s *strLenFunc) validateArgs(expr *influxql.Call) error {
+	if got := len(expr.Args); got != 1 {
+		return fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", expr.Name, 1, got)
+	}
+	return nil
+}
+
 func (s *strLenFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
 	var arg0 influxql.DataType
 	if len(args) != 1 {
@@ -146,6 +199,13 @@ type subStrFunc struct {
 }
 
 func (s *subStrFunc) CompileFunc(expr *influxql.Call, c *compiledField) error {
+	if err := s.validateArgs(expr); err != nil {
+		return err
+	}
+	return compileAllStringArgs(expr, c)
+}
+
+func (s *subStrFunc) validateArgs(expr *influxql.Call) error {
 	const NARGS = 1
 	// Did we get the expected number of args?
 	if got := len(expr.Args); expr.Name == "substr" && (len(expr.Args) < 2 || len(expr.Args) > 3) {
@@ -164,7 +224,7 @@ func (s *subStrFunc) CompileFunc(expr *influxql.Call, c *compiledField) error {
 			return fmt.Errorf("expected non-gegative integer argument in substr()")
 		}
 	}
-	return compileAllStringArgs(expr, c)
+	return nil
 }
 
 func (s *subStrFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
@@ -253,6 +313,608 @@ func SubStrThreeParaFunc(srcStr string, start, subStrLen int64) string {
 	return util.Bytes2str(newStr)
 }
 
+// StringBatchCall is a string function whose literal arguments are bound, it is evaluated over the
+// values of a string column one by one without the valuer. Only the one of String, Integer and
+// Boolean matching Type is set.
+type StringBatchCall struct {
+	Type    influxql.DataType
+	String  func(dst []byte, s string) []byte
+	Integer func(s string) int64
+	Boolean func(s string) bool
+}
+
+// Call evaluates the function over a single value.
+func (b *StringBatchCall) Call(s string) interface{} {
+	switch b.Type {
+	case influxql.String:
+		return util.Bytes2str(b.String(nil, s))
+	case influxql.Integer:
+		return b.Integer(s)
+	case influxql.Boolean:
+		return b.Boolean(s)
+	default:
+		return nil
+	}
+}
+
+// stringBatchFunc is implemented by the string functions which can be evaluated over a column of
+// strings at a time, the args are the arguments following the string.
+type stringBatchFunc interface {
+	bindArgs(args []interface{}) (*StringBatchCall, error)
+}
+
+// stringArgsValidator validates the arguments of a string function without compiling them, so the
+// function can be used in the condition.
+type stringArgsValidator interface {
+	validateArgs(expr *influxql.Call) error
+}
+
+// GetStringBatchCall returns the batch call of expr if the first argument of the string function
+// is a string column and the others are literals.
+func GetStringBatchCall(expr *influxql.Call) (*StringBatchCall, bool) {
+	f, ok := GetStringFunction(expr.Name).(stringBatchFunc)
+	if !ok || len(expr.Args) == 0 {
+		return nil, false
+	}
+	if ref, ok := expr.Args[0].(*influxql.VarRef); !ok || ref.Type != influxql.String {
+		return nil, false
+	}
+	args := make([]interface{}, 0, len(expr.Args)-1)
+	for _, arg := range expr.Args[1:] {
+		switch arg := arg.(type) {
+		case *influxql.StringLiteral:
+			args = append(args, arg.Val)
+		case *influxql.IntegerLiteral:
+			args = append(args, arg.Val)
+		default:
+			return nil, false
+		}
+	}
+	call, err := f.bindArgs(args)
+	if err != nil {
+		return nil, false
+	}
+	return call, true
+}
+
+// literalArgsStringFunc is a string function whose arguments following the string are literals
+// of argTypes, the last optional ones of them may be omitted.
+type literalArgsStringFunc struct {
+	BaseInfo
+	retType  influxql.DataType
+	argTypes []influxql.DataType
+	optional int
+	bind     func(args []interface{}) (*StringBatchCall, error)
+}
+
+func newLiteralArgsStringFunc(retType influxql.DataType, argTypes []influxql.DataType, optional int,
+	bind func(args []interface{}) (*StringBatchCall, error)) *literalArgsStringFunc {
+	return &literalArgsStringFunc{
+		BaseInfo: BaseInfo{FuncType: STRING},
+		retType:  retType,
+		argTypes: argTypes,
+		optional: optional,
+		bind:     bind,
+	}
+}
+
+func (s *literalArgsStringFunc) checkArgNumber(name string, got int) error {
+	maxArgs := len(s.argTypes) + 1
+	minArgs := maxArgs - s.optional
+	if got < minArgs || got > maxArgs {
+		if minArgs == maxArgs {
+			return fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", name, maxArgs, got)
+		}
+		return fmt.Errorf("invalid number of arguments for %s, expected %d to %d, got %d", name, minArgs, maxArgs, got)
+	}
+	return nil
+}
+
+func (s *literalArgsStringFunc) CompileFunc(expr *influxql.Call, c *compiledField) error {
+	if err := s.validateArgs(expr); err != nil {
+		return err
+	}
+	return compileAllStringArgs(expr, c)
+}
+
+func (s *literalArgsStringFunc) validateArgs(expr *influxql.Call) error {
+	if err := s.checkArgNumber(expr.Name, len(expr.Args)); err != nil {
+		return err
+	}
+	args := make([]interface{}, 0, len(expr.Args)-1)
+	for i, arg := range expr.Args[1:] {
+		switch s.argTypes[i] {
+		case influxql.String:
+			lit, ok := arg.(*influxql.StringLiteral)
+			if !ok {
+				return fmt.Errorf("expected string argument in %s()", expr.Name)
+			}
+			args = append(args, lit.Val)
+		case influxql.Integer:
+			lit, ok := arg.(*influxql.IntegerLiteral)
+			if !ok {
+				return fmt.Errorf("expected integer argument in %s()", expr.Name)
+			}
+			args = append(args, lit.Val)
+		}
+	}
+	if _, err := s.bind(args); err != nil {
+		return fmt.Errorf("%s() %s", expr.Name, err)
+	}
+	return nil
+}
+
+func (s *literalArgsStringFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
+	if err := s.checkArgNumber(name, len(args)); err != nil {
+		return influxql.Unknown, fmt.Errorf("invalid argument number in %s(): %d", name, len(args))
+	}
+	if args[0] != influxql.String {
+		return influxql.Unknown, fmt.Errorf("invalid argument type for the first argument in %s(): %s", name, args[0])
+	}
+	for i, arg := range args[1:] {
+		if arg != s.argTypes[i] {
+			return influxql.Unknown, fmt.Errorf("invalid argument type for the argument %d in %s(): %s", i+2, name, arg)
+		}
+	}
+	return s.retType, nil
+}
+
+func (s *literalArgsStringFunc) CallFunc(name string, args []interface{}) (interface{}, bool) {
+	if s.checkArgNumber(name, len(args)) != nil {
+		return nil, false
+	}
+	arg0, ok := args[0].(string)
+	if !ok {
+		return nil, true
+	}
+	call, err := s.bind(args[1:])
+	if err != nil {
+		return nil, true
+	}
+	return call.Call(arg0), true
+}
+
+func (s *literalArgsStringFunc) bindArgs(args []interface{}) (*StringBatchCall, error) {
+	if s.checkArgNumber("", len(args)+1) != nil {
+		return nil, fmt.Errorf("invalid number of arguments")
+	}
+	return s.bind(args)
+}
+
+func stringArg(args []interface{}, i int) (string, error) {
+	if v, ok := args[i].(string); ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("expected string for the argument %d", i+2)
+}
+
+func integerArg(args []interface{}, i int) (int64, error) {
+	if v, ok := args[i].(int64); ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("expected integer for the argument %d", i+2)
+}
+
+// maxStringFuncRegexps is the size of the cache of the patterns used by regexp_extract and
+// regexp_replace. The patterns are literals of the queries, so the cache is cleared when it is full.
+const maxStringFuncRegexps = 1024
+
+var stringFuncRegexps = struct {
+	sync.RWMutex
+	m map[string]*regexp.Regexp
+}{m: make(map[string]*regexp.Regexp)}
+
+func compileStringFuncRegexp(pattern string) (*regexp.Regexp, error) {
+	stringFuncRegexps.RLock()
+	re, ok := stringFuncRegexps.m[pattern]
+	stringFuncRegexps.RUnlock()
+	if ok {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	stringFuncRegexps.Lock()
+	if len(stringFuncRegexps.m) >= maxStringFuncRegexps {
+		stringFuncRegexps.m = make(map[string]*regexp.Regexp)
+	}
+	stringFuncRegexps.m[pattern] = re
+	stringFuncRegexps.Unlock()
+	return re, nil
+}
+
+func bindLower(_ []interface{}) (*StringBatchCall, error) {
+	return &StringBatchCall{Type: influxql.String, String: AppendLower}, nil
+}
+
+func bindUpper(_ []interface{}) (*StringBatchCall, error) {
+	return &StringBatchCall{Type: influxql.String, String: AppendUpper}, nil
+}
+
+func bindTrim(_ []interface{}) (*StringBatchCall, error) {
+	return &StringBatchCall{Type: influxql.String, String: func(dst []byte, s string) []byte {
+		return append(dst, strings.TrimSpace(s)...)
+	}}, nil
+}
+
+// AppendLower appends the lower case of s to dst, the ASCII strings are converted without allocation.
+func AppendLower(dst []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return append(dst, strings.ToLower(s)...)
+		}
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+	return dst
+}
+
+// AppendUpper appends the upper case of s to dst, the ASCII strings are converted without allocation.
+func AppendUpper(dst []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return append(dst, strings.ToUpper(s)...)
+		}
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+	return dst
+}
+
+func bindReplace(args []interface{}) (*StringBatchCall, error) {
+	old, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	replacement, err := stringArg(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	return &StringBatchCall{Type: influxql.String, String: func(dst []byte, s string) []byte {
+		if old == "" {
+			return append(dst, s...)
+		}
+		for {
+			i := strings.Index(s, old)
+			if i < 0 {
+				return append(dst, s...)
+			}
+			dst = append(dst, s[:i]...)
+			dst = append(dst, replacement...)
+			s = s[i+len(old):]
+		}
+	}}, nil
+}
+
+// bindRegexpExtract binds regexp_extract(s, pattern[, group]), which returns the group of the
+// first match, the whole match by default. An empty string is returned if nothing matches.
+func bindRegexpExtract(args []interface{}) (*StringBatchCall, error) {
+	pattern, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	re, err := compileStringFuncRegexp(pattern)
+	if err != nil {
+		return nil, err
+	}
+	var group int64
+	if len(args) > 1 {
+		if group, err = integerArg(args, 1); err != nil {
+			return nil, err
+		}
+		if group < 0 || group > int64(re.NumSubexp()) {
+			return nil, fmt.Errorf("group %d is out of range, the pattern has %d groups", group, re.NumSubexp())
+		}
+	}
+	return &StringBatchCall{Type: influxql.String, String: func(dst []byte, s string) []byte {
+		loc := re.FindStringSubmatchIndex(s)
+		if loc == nil || loc[2*group] < 0 {
+			return dst
+		}
+		return append(dst, s[loc[2*group]:loc[2*group+1]]...)
+	}}, nil
+}
+
+// bindRegexpReplace binds regexp_replace(s, pattern, replacement), the replacement may refer to
+// the groups by $1 or ${name}.
+func bindRegexpReplace(args []interface{}) (*StringBatchCall, error) {
+	pattern, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	re, err := compileStringFuncRegexp(pattern)
+	if err != nil {
+		return nil, err
+	}
+	replacement, err := stringArg(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	return &StringBatchCall{Type: influxql.String, String: func(dst []byte, s string) []byte {
+		return append(dst, re.ReplaceAllString(s, replacement)...)
+	}}, nil
+}
+
+// bindSplitPart binds split_part(s, delimiter, n), which returns the nth part of s split by the
+// delimiter, counted from 1. An empty string is returned if there are less than n parts.
+func bindSplitPart(args []interface{}) (*StringBatchCall, error) {
+	delimiter, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	if delimiter == "" {
+		return nil, fmt.Errorf("expected non-empty delimiter")
+	}
+	n, err := integerArg(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	if n <= 0 {
+		return nil, fmt.Errorf("expected positive part number")
+	}
+	return &StringBatchCall{Type: influxql.String, String: func(dst []byte, s string) []byte {
+		for i := int64(1); i < n; i++ {
+			j := strings.Index(s, delimiter)
+			if j < 0 {
+				return dst
+			}
+			s = s[j+len(delimiter):]
+		}
+		if j := strings.Index(s, delimiter); j >= 0 {
+			s = s[:j]
+		}
+		return append(dst, s...)
+	}}, nil
+}
+
+func bindStartsWith(args []interface{}) (*StringBatchCall, error) {
+	prefix, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &StringBatchCall{Type: influxql.Boolean, Boolean: func(s string) bool {
+		return strings.HasPrefix(s, prefix)
+	}}, nil
+}
+
+func bindEndsWith(args []interface{}) (*StringBatchCall, error) {
+	suffix, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &StringBatchCall{Type: influxql.Boolean, Boolean: func(s string) bool {
+		return strings.HasSuffix(s, suffix)
+	}}, nil
+}
+
+// bindPosition binds position(s, substr), which returns the character position of the first
+// substr in s counted from 1, or 0 if s does not contain substr.
+func bindPosition(args []interface{}) (*StringBatchCall, error) {
+	substr, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &StringBatchCall{Type: influxql.Integer, Integer: func(s string) int64 {
+		i := strings.Index(s, substr)
+		if i < 0 {
+			return 0
+		}
+		return int64(utf8.RuneCountInString(s[:i])) + 1
+	}}, nil
+}
+
+// bindPad binds lpad(s, length, pad) and rpad(s, length, pad), which pad s with pad to length
+// characters on the left or the right. s is truncated to length if it is longer.
+func bindPad(left bool) func(args []interface{}) (*StringBatchCall, error) {
+	return func(args []interface{}) (*StringBatchCall, error) {
+		length, err := integerArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		if length < 0 || length > maxPadLength {
+			return nil, fmt.Errorf("expected length between 0 and %d", maxPadLength)
+		}
+		pad, err := stringArg(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		padRunes := []rune(pad)
+		return &StringBatchCall{Type: influxql.String, String: func(dst []byte, s string) []byte {
+			n := utf8.RuneCountInString(s)
+			if int64(n) >= length || len(padRunes) == 0 {
+				if int64(n) > length {
+					i := 0
+					for k := int64(0); k < length; k++ {
+						_, size := utf8.DecodeRuneInString(s[i:])
+						i += size
+					}
+					s = s[:i]
+				}
+				return append(dst, s...)
+			}
+			if !left {
+				dst = append(dst, s...)
+			}
+			for i := 0; i < int(length)-n; i++ {
+				dst = utf8.AppendRune(dst, padRunes[i%len(padRunes)])
+			}
+			if left {
+				dst = append(dst, s...)
+			}
+			return dst
+		}}, nil
+	}
+}
+
+// maxPadLength limits the length of lpad and rpad, so a query can not exhaust the memory.
+const maxPadLength = 64 * 1024
+
+func bindMD5(_ []interface{}) (*StringBatchCall, error) {
+	return &StringBatchCall{Type: influxql.String, String: func(dst []byte, s string) []byte {
+		sum := md5.Sum(util.Str2bytes(s))
+		return appendHex(dst, sum[:])
+	}}, nil
+}
+
+func bindSHA256(_ []interface{}) (*StringBatchCall, error) {
+	return &StringBatchCall{Type: influxql.String, String: func(dst []byte, s string) []byte {
+		sum := sha256.Sum256(util.Str2bytes(s))
+		return appendHex(dst, sum[:])
+	}}, nil
+}
+
+func appendHex(dst []byte, src []byte) []byte {
+	n := len(dst)
+	dst = append(dst, make([]byte, hex.EncodedLen(len(src)))...)
+	hex.Encode(dst[n:], src)
+	return dst
+}
+
+// bindJSONExtract binds json_extract(s, path), which returns the value at the path of the JSON
+// document s, such as $.a.b[0]. The strings are returned unquoted and the other values in JSON.
+// An empty string is returned if s is not a JSON document or the path does not exist.
+func bindJSONExtract(args []interface{}) (*StringBatchCall, error) {
+	path, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := parseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return &StringBatchCall{Type: influxql.String, String: func(dst []byte, s string) []byte {
+		p := jsonParserPool.Get()
+		defer jsonParserPool.Put(p)
+		doc, err := p.Parse(s)
+		if err != nil {
+			return dst
+		}
+		v := doc.Get(keys...)
+		if v == nil {
+			return dst
+		}
+		if v.Type() == fastjson.TypeString {
+			return append(dst, v.GetStringBytes()...)
+		}
+		return v.MarshalTo(dst)
+	}}, nil
+}
+
+var jsonParserPool fastjson.ParserPool
+
+// parseJSONPath splits the path like $.a.b[0] into the keys a, b and 0. The leading $ is optional.
+func parseJSONPath(path string) ([]string, error) {
+	path = strings.TrimPrefix(path, "$")
+	var keys []string
+	for len(path) > 0 {
+		switch path[0] {
+		case '.':
+			path = path[1:]
+			i := strings.IndexAny(path, ".[")
+			if i < 0 {
+				i = len(path)
+			}
+			if i == 0 {
+				return nil, fmt.Errorf("invalid JSON path: empty key")
+			}
+			keys = append(keys, path[:i])
+			path = path[i:]
+		case '[':
+			i := strings.IndexByte(path, ']')
+			if i < 0 {
+				return nil, fmt.Errorf("invalid JSON path: missing ]")
+			}
+			index := path[1:i]
+			if _, err := strconv.ParseUint(index, 10, 32); err != nil {
+				return nil, fmt.Errorf("invalid JSON path: invalid index %q", index)
+			}
+			keys = append(keys, index)
+			path = path[i+1:]
+		default:
+			if len(keys) > 0 {
+				return nil, fmt.Errorf("invalid JSON path: unexpected %q", path[0])
+			}
+			path = "." + path
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("invalid JSON path: empty path")
+	}
+	return keys, nil
+}
+
+// concat(s1, s2, ...) concatenates the strings, the arguments may be fields or literals.
+type concatFunc struct {
+	BaseInfo
+}
+
+func (s *concatFunc) CompileFunc(expr *influxql.Call, c *compiledField) error {
+	if err := s.validateArgs(expr); err != nil {
+		return err
+	}
+	return compileAllStringArgs(expr, c)
+}
+
+func (s *concatFunc) validateArgs(expr *influxql.Call) error {
+	if got := len(expr.Args); got < 2 {
+		return fmt.Errorf("invalid number of arguments for %s, expected at least %d, got %d", expr.Name, 2, got)
+	}
+	return nil
+}
+
+func (s *concatFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
+	if len(args) < 2 {
+		return influxql.Unknown, fmt.Errorf("invalid argument number in %s(): %d", name, len(args))
+	}
+	for i, arg := range args {
+		if arg != influxql.String {
+			return influxql.Unknown, fmt.Errorf("invalid argument type for the argument %d in %s(): %s", i+1, name, arg)
+		}
+	}
+	return influxql.String, nil
+}
+
+func (s *concatFunc) CallFunc(name string, args []interface{}) (interface{}, bool) {
+	if len(args) < 2 {
+		return nil, false
+	}
+	var b strings.Builder
+	for _, arg := range args {
+		v, ok := arg.(string)
+		if !ok {
+			return nil, true
+		}
+		b.WriteString(v)
+	}
+	return b.String(), true
+}
+
+func (s *concatFunc) bindArgs(args []interface{}) (*StringBatchCall, error) {
+	var suffix []byte
+	for i := range args {
+		v, err := stringArg(args, i)
+		if err != nil {
+			return nil, err
+		}
+		suffix = append(suffix, v...)
+	}
+	return &StringBatchCall{Type: influxql.String, String: func(dst []byte, s string) []byte {
+		return append(append(dst, s...), suffix...)
+	}}, nil
+}
+
 // type mapper
 type StringFunctionTypeMapper struct{}
 
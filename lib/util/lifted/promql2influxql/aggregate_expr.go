@@ -30,6 +30,7 @@ var aggregateFns = map[parser.ItemType]aggregateFn{
 	parser.QUANTILE:     {name: "percentile", functionType: SELECTOR_FN}, // TODO add unit tests
 	parser.COUNT_VALUES: {name: "count_values_prom", functionType: AGGREGATE_FN},
 	parser.STDVAR:       {name: "stdvar_prom", functionType: AGGREGATE_FN},
+	parser.GROUP:        {name: "group_prom", functionType: AGGREGATE_FN},
 }
 
 // generateDimension is used to generate the dimensions of group by to Dimensions.
@@ -223,7 +224,7 @@ func (t *Transpiler) canPushDownAggWithFunction(agg *parser.AggregateExpr, state
 	if !ok {
 		return false
 	}
-	if statement.Range <= 0 {
+	if statement.Range <= 0 || statement.PromSubCall != nil {
 		return false
 	}
 	t.setAggregateFields(statement, field, parameter, aggFn)
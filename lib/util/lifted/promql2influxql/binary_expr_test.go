@@ -251,3 +251,40 @@ func Test_BothVectorWithoutMstOfBinOp(t *testing.T) {
 		t.Errorf("transpile() got = %v, want %v", got, want)
 	}
 }
+
+func TestTranspiler_transpileSetOperation(t1 *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{
+			expr: `up == 0 unless on(instance) maintenance_mode`,
+			want: `SELECT value FROM (SELECT value AS value FROM up WHERE time >= '2023-01-06T06:55:00Z' AND time <= '2023-01-06T07:00:00Z' AND value = 0 GROUP BY *) binary op (SELECT value AS value FROM maintenance_mode WHERE time >= '2023-01-06T06:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *) false true(instance) 3() WHERE time >= '2023-01-06T06:55:00Z' AND time <= '2023-01-06T07:00:00Z'`,
+		},
+		{
+			expr: `up and ignoring(job) down`,
+			want: `SELECT value FROM (SELECT value AS value FROM up WHERE time >= '2023-01-06T06:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *) binary op (SELECT value AS value FROM down WHERE time >= '2023-01-06T06:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *) false false(job) 3() WHERE time >= '2023-01-06T06:55:00Z' AND time <= '2023-01-06T07:00:00Z'`,
+		},
+		{
+			expr: `up or down`,
+			want: `SELECT value FROM (SELECT value AS value FROM up WHERE time >= '2023-01-06T06:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *) binary op (SELECT value AS value FROM down WHERE time >= '2023-01-06T06:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *) false false() 3() WHERE time >= '2023-01-06T06:55:00Z' AND time <= '2023-01-06T07:00:00Z'`,
+		},
+	}
+	for _, tt := range tests {
+		t := &Transpiler{
+			PromCommand: PromCommand{
+				LookBackDelta: DefaultLookBackDelta,
+				Evaluation:    &endTime2,
+			},
+		}
+		b := BinaryExpr(tt.expr)
+		t.minT, t.maxT = t.findMinMaxTime(t.newEvalStmt(b))
+		got, err := t.transpileBinaryExpr(b)
+		if err != nil {
+			t1.Fatalf("transpileBinaryExpr(%s) error = %v", tt.expr, err)
+		}
+		if got.String() != tt.want {
+			t1.Errorf("transpileBinaryExpr(%s) got = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
@@ -33,11 +33,35 @@ var rangeVectorFunctions = map[string]aggregateFn{
 		name:         "stddev_over_time",
 		functionType: AGGREGATE_FN,
 	},
+	"stdvar_over_time": {
+		name:         "stdvar_over_time",
+		functionType: AGGREGATE_FN,
+	},
+	"last_over_time": {
+		name:         "last_over_time",
+		functionType: SELECTOR_FN,
+	},
+	"present_over_time": {
+		name:         "present_over_time",
+		functionType: AGGREGATE_FN,
+	},
 	"quantile_over_time": {
 		name:           "quantile_over_time",
 		functionType:   SELECTOR_FN,
 		vectorPosition: 1,
 	},
+	"changes": {
+		name:         "changes",
+		functionType: TRANSFORM_FN,
+	},
+	"resets": {
+		name:         "resets",
+		functionType: TRANSFORM_FN,
+	},
+	"holt_winters": {
+		name:         "holt_winters_prom",
+		functionType: TRANSFORM_FN,
+	},
 	"rate": {
 		name:         "rate_prom",
 		functionType: TRANSFORM_FN,
@@ -152,6 +176,11 @@ var vectorMathFunctions = map[string]aggregateFn{
 		functionType: TRANSFORM_FN,
 		KeepFill:     true,
 	},
+	"sgn": {
+		name:         "sgn_prom",
+		functionType: TRANSFORM_FN,
+		KeepFill:     true,
+	},
 	"clamp": {
 		name:         "clamp_prom",
 		functionType: TRANSFORM_FN,
@@ -230,6 +259,38 @@ var vectorTimeFunctions = map[string]aggregateFn{
 	},
 }
 
+var timestampFunction = aggregateFn{
+	name:         "timestamp_prom",
+	functionType: TRANSFORM_FN,
+	KeepFill:     true,
+}
+
+// resultFunctions are evaluated over the final result of the query by the Receiver,
+// so they are only supported as the outermost expression.
+var resultFunctions = map[string]bool{
+	"absent":           true,
+	"absent_over_time": true,
+	"scalar":           true,
+	"sort":             true,
+	"sort_desc":        true,
+}
+
+// ResultFunctionCall returns the call of the outermost expression if it is evaluated by the Receiver.
+func ResultFunctionCall(expr parser.Expr) (*parser.Call, bool) {
+	for {
+		switch e := expr.(type) {
+		case *parser.ParenExpr:
+			expr = e.Expr
+		case *parser.StepInvariantExpr:
+			expr = e.Expr
+		case *parser.Call:
+			return e, resultFunctions[e.Func.Name]
+		default:
+			return nil, false
+		}
+	}
+}
+
 func getOriCallName(call string) string {
 	if strings.HasSuffix(call, PromSuffix) {
 		return call[:len(call)-len(PromSuffix)]
@@ -346,9 +407,20 @@ func (t *Transpiler) transpileCall(a *parser.Call) (influxql.Node, error) {
 		args[i] = tArg
 	}
 
+	if resultFunctions[a.Func.Name] {
+		// only supported as the outermost expression, see transpileResultCall.
+		return nil, errno.NewError(errno.UnsupportedPromExpr)
+	}
+
 	// {count,avg,sum,min,max,...}_over_time()
 	if fn, ok := rangeVectorFunctions[a.Func.Name]; ok {
 		t.dropMetric = true
+		if stmt, ok := args[fn.vectorPosition].(*influxql.SelectStatement); ok && stmt.PromSubCall != nil {
+			// the range function is evaluated over the subquery by the executor at every step.
+			_, parameter := t.transpileParameter(fn.vectorPosition, args)
+			t.setAggregateFields(stmt, stmt.Fields[0], parameter, fn)
+			return stmt, nil
+		}
 		return t.transpilePromFunc(fn, args, t.setAggregateFields)
 	}
 
@@ -368,9 +440,50 @@ func (t *Transpiler) transpileCall(a *parser.Call) (influxql.Node, error) {
 	if fn, ok := vectorTimeFunctions[a.Func.Name]; ok {
 		return t.transpileVectorTimeFunc(fn, args)
 	}
+
+	if a.Func.Name == "timestamp" {
+		return t.transpileTimestampFunc(args)
+	}
 	return nil, errno.NewError(errno.UnsupportedPromExpr)
 }
 
+// transpileTimestampFunc transpiles timestamp(v), the time of each point is passed as the last argument.
+func (t *Transpiler) transpileTimestampFunc(args []influxql.Node) (influxql.Node, error) {
+	if _, ok := args[0].(influxql.Statement); !ok {
+		// the points of a vector without any selector are at the evaluation time.
+		return t.transpileTimeFunc2CallExpr(vectorTimeFunctions["time"])
+	}
+	return t.transpilePromFunc(timestampFunction, append(args, &influxql.VarRef{Val: ArgNameOfTimeFunc}), t.setAggregateFields)
+}
+
+// transpileResultCall transpiles the argument of the functions which are evaluated by the Receiver.
+func (t *Transpiler) transpileResultCall(a *parser.Call) (influxql.Node, error) {
+	arg := a.Args[0]
+	unwrapParenExpr(&arg)
+	arg = unwrapStepInvariantExpr(arg)
+
+	var node influxql.Node
+	var err error
+	switch a.Func.Name {
+	case "absent_over_time":
+		// the Receiver outputs the steps at which no series has any point in the range.
+		node, err = t.transpileCall(&parser.Call{Func: parser.Functions["count_over_time"], Args: parser.Expressions{arg}, PosRange: a.PosRange})
+	default:
+		node, err = t.transpileExpr(arg)
+	}
+	if err != nil {
+		return nil, errno.NewError(errno.TranspileFunctionFail, err.Error())
+	}
+	if a.Func.Name == "sort" || a.Func.Name == "sort_desc" {
+		return node, nil
+	}
+	if _, ok := node.(influxql.Statement); !ok {
+		return nil, errno.NewError(errno.UnsupportedPromExpr)
+	}
+	t.dropMetric = true
+	return node, nil
+}
+
 func (t *Transpiler) transpileTimeFunc2CallExpr(aggFn aggregateFn) (influxql.Expr, error) {
 	callExpr := &influxql.Call{Name: aggFn.name, Args: []influxql.Expr{&influxql.VarRef{Val: ArgNameOfTimeFunc}}}
 	return callExpr, nil
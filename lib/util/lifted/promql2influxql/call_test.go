@@ -77,6 +77,60 @@ func TestTranspiler_transpileCall(t1 *testing.T) {
 			want:    parseInfluxqlByYacc(`SELECT rate_prom(value) AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T03:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *, time(1m) fill(none)`),
 			wantErr: false,
 		},
+		{
+			name: "4",
+			fields: fields{
+				Evaluation: &endTime2,
+			},
+			args: args{
+				a: CallExpr(`changes(go_gc_duration_seconds_count[5m])`),
+			},
+			want:    parseInfluxqlByYacc(`SELECT changes(value) AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T06:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *`),
+			wantErr: false,
+		},
+		{
+			name: "5",
+			fields: fields{
+				Evaluation: &endTime2,
+			},
+			args: args{
+				a: CallExpr(`holt_winters(go_gc_duration_seconds_count[5m], 0.5, 0.1)`),
+			},
+			want:    parseInfluxqlByYacc(`SELECT holt_winters_prom(value, 0.5, 0.1) AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T06:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *`),
+			wantErr: false,
+		},
+		{
+			name: "6",
+			fields: fields{
+				Evaluation: &endTime2,
+			},
+			args: args{
+				a: CallExpr(`sgn(go_gc_duration_seconds_count)`),
+			},
+			want:    parseInfluxqlByYacc(`SELECT sgn_prom(value) AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T07:00:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *`),
+			wantErr: false,
+		},
+		{
+			name: "7",
+			fields: fields{
+				Evaluation: &endTime2,
+			},
+			args: args{
+				a: CallExpr(`timestamp(go_gc_duration_seconds_count)`),
+			},
+			want:    parseInfluxqlByYacc(`SELECT timestamp_prom(value, prom_time) AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T07:00:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *`),
+			wantErr: false,
+		},
+		{
+			name: "8",
+			fields: fields{
+				Evaluation: &endTime2,
+			},
+			args: args{
+				a: CallExpr(`round(sort(go_gc_duration_seconds_count))`),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t1.Run(tt.name, func(t1 *testing.T) {
@@ -99,9 +153,64 @@ func TestTranspiler_transpileCall(t1 *testing.T) {
 				t1.Errorf("transpileCall() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.wantErr {
+				return
+			}
 			if !reflect.DeepEqual(got.String(), tt.want.String()) {
 				t1.Errorf("transpileCall() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func TestTranspiler_transpileResultCall(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		want       string
+		dropMetric bool
+	}{
+		{
+			name:       "absent",
+			input:      `absent(go_gc_duration_seconds_count{job="a"})`,
+			want:       `SELECT value AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T07:00:00Z' AND time <= '2023-01-06T07:00:00Z' AND job = 'a' GROUP BY *`,
+			dropMetric: true,
+		},
+		{
+			name:       "absent_over_time",
+			input:      `absent_over_time(go_gc_duration_seconds_count{job="a"}[5m])`,
+			want:       `SELECT count_over_time(value) AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T06:55:00Z' AND time <= '2023-01-06T07:00:00Z' AND job = 'a' GROUP BY *`,
+			dropMetric: true,
+		},
+		{
+			name:       "scalar",
+			input:      `scalar(go_gc_duration_seconds_count)`,
+			want:       `SELECT value AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T07:00:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *`,
+			dropMetric: true,
+		},
+		{
+			name:  "sort_desc",
+			input: `sort_desc(go_gc_duration_seconds_count)`,
+			want:  `SELECT value AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T07:00:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := parser.ParseExpr(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			trans := &Transpiler{PromCommand: PromCommand{Evaluation: &endTime2}}
+			got, err := trans.Transpile(expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != parseInfluxqlByYacc(tt.want).String() {
+				t.Errorf("Transpile() got = %v, want %v", got, tt.want)
+			}
+			if trans.dropMetric != tt.dropMetric {
+				t.Errorf("Transpile() dropMetric = %v, want %v", trans.dropMetric, tt.dropMetric)
+			}
+		})
+	}
+}
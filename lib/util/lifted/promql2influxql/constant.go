@@ -26,3 +26,6 @@ const (
 )
 
 const DefaultLookBackDelta = 5 * time.Minute
+
+// DefaultEvaluationInterval is the step of a subquery without an explicit resolution.
+const DefaultEvaluationInterval = time.Minute
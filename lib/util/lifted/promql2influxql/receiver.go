@@ -3,6 +3,7 @@ package promql2influxql
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"sort"
 	"time"
 
@@ -25,12 +26,7 @@ type Receiver struct {
 
 // InfluxLiteralToPromQLValue converts influxql.Literal expression to parser.Value of Prometheus
 func (r *Receiver) InfluxLiteralToPromQLValue(result influxql.Literal, cmd PromCommand) (value parser.Value, resultType string) {
-	now := time.Now()
-	if cmd.Evaluation != nil {
-		now = *cmd.Evaluation
-	} else if cmd.End != nil {
-		now = *cmd.End
-	}
+	now := evaluationTime(cmd)
 	switch lit := result.(type) {
 	case *influxql.NumberLiteral:
 		return promql.Scalar{
@@ -120,6 +116,21 @@ func (r *Receiver) populatePromSeriesByHash(promSeries *[]*promql.Series, table
 	return nil
 }
 
+// RowToPromSeries appends the series of the models.Row returned by InfluxDB to promSeries.
+// A row without tags has not been grouped by series and may hold the points of several series.
+func (r *Receiver) RowToPromSeries(promSeries []*promql.Series, row *models.Row) ([]*promql.Series, error) {
+	if len(row.Tags) > 0 {
+		if err := r.populatePromSeriesByTag(&promSeries, row); err != nil {
+			return promSeries, errno.NewError(errno.ErrPopulatePromSeries, err.Error())
+		}
+		return promSeries, nil
+	}
+	if err := r.populatePromSeriesByHash(&promSeries, row); err != nil {
+		return promSeries, errno.NewError(errno.ErrGroupResultBySeries, err.Error())
+	}
+	return promSeries, nil
+}
+
 // InfluxResultToPromQLValue converts query.Result slice to parser.Value of Prometheus
 func (r *Receiver) InfluxResultToPromQLValue(result *query.Result, expr parser.Expr, cmd PromCommand) (*PromResult, error) {
 	if result == nil {
@@ -129,17 +140,15 @@ func (r *Receiver) InfluxResultToPromQLValue(result *query.Result, expr parser.E
 		return NewPromResult(nil, ""), result.Err
 	}
 	var promSeries []*promql.Series
+	var err error
 	for _, item := range result.Series {
-		if len(item.Tags) > 0 {
-			if err := r.populatePromSeriesByTag(&promSeries, item); err != nil {
-				return NewPromResult(nil, ""), errno.NewError(errno.ErrPopulatePromSeries, err.Error())
-			}
-		} else {
-			if err := r.populatePromSeriesByHash(&promSeries, item); err != nil {
-				return NewPromResult(nil, ""), errno.NewError(errno.ErrGroupResultBySeries, err.Error())
-			}
+		if promSeries, err = r.RowToPromSeries(promSeries, item); err != nil {
+			return NewPromResult(nil, ""), err
 		}
 	}
+	if call, ok := ResultFunctionCall(expr); ok {
+		return r.evalResultFunction(call, promSeries, cmd)
+	}
 	switch expr.Type() {
 	case parser.ValueTypeMatrix:
 		return NewPromResult(r.handleValueTypeMatrix(promSeries), string(parser.ValueTypeMatrix)), nil
@@ -237,6 +246,170 @@ func (r *Receiver) handleValueTypeVector(promSeries []*promql.Series) (promql.Ve
 	return vector, nil
 }
 
+// evalResultFunction evaluates the functions over the final result of the query, see resultFunctions.
+func (r *Receiver) evalResultFunction(call *parser.Call, promSeries []*promql.Series, cmd PromCommand) (*PromResult, error) {
+	switch call.Func.Name {
+	case "absent", "absent_over_time":
+		return r.handleAbsent(call, promSeries, cmd), nil
+	case "scalar":
+		return r.handleScalar(promSeries, cmd), nil
+	default:
+		// sort and sort_desc only affect the instant query
+		if cmd.DataType == GRAPH_DATA {
+			return NewPromResult(r.handleValueTypeMatrix(promSeries), string(parser.ValueTypeMatrix)), nil
+		}
+		vector, err := r.handleValueTypeVector(promSeries)
+		if err != nil {
+			return NewPromResult(nil, ""), err
+		}
+		sortVectorByValue(vector, call.Func.Name == "sort_desc")
+		return NewPromResult(vector, string(parser.ValueTypeVector)), nil
+	}
+}
+
+// handleAbsent returns the points with the value 1 at the times when the result is empty.
+func (r *Receiver) handleAbsent(call *parser.Call, promSeries []*promql.Series, cmd PromCommand) *PromResult {
+	metric := createLabelsForAbsentFunction(call.Args[0])
+	if cmd.DataType != GRAPH_DATA {
+		vector := promql.Vector{}
+		for _, ser := range promSeries {
+			if len(ser.Points) > 0 {
+				return NewPromResult(vector, string(parser.ValueTypeVector))
+			}
+		}
+		vector = append(vector, promql.Sample{
+			Metric: metric,
+			Point:  promql.Point{T: timestamp.FromTime(evaluationTime(cmd)), V: 1},
+		})
+		return NewPromResult(vector, string(parser.ValueTypeVector))
+	}
+
+	present := make(map[int64]struct{})
+	for _, ser := range promSeries {
+		for _, p := range ser.Points {
+			present[p.T] = struct{}{}
+		}
+	}
+	var points []promql.Point
+	rangeSteps(cmd, func(t int64) {
+		if _, ok := present[t]; !ok {
+			points = append(points, promql.Point{T: t, V: 1})
+		}
+	})
+	matrix := promql.Matrix{}
+	if len(points) > 0 {
+		matrix = append(matrix, promql.Series{Metric: metric, Points: points})
+	}
+	return NewPromResult(matrix, string(parser.ValueTypeMatrix))
+}
+
+// handleScalar returns the value of the single point at each time, or NaN if the number of points is not one.
+func (r *Receiver) handleScalar(promSeries []*promql.Series, cmd PromCommand) *PromResult {
+	if cmd.DataType != GRAPH_DATA {
+		scalar := promql.Scalar{T: timestamp.FromTime(evaluationTime(cmd)), V: math.NaN()}
+		if len(promSeries) == 1 && len(promSeries[0].Points) == 1 {
+			scalar.V = promSeries[0].Points[0].V
+		}
+		return NewPromResult(scalar, string(parser.ValueTypeScalar))
+	}
+
+	values := make(map[int64]float64)
+	counts := make(map[int64]int)
+	for _, ser := range promSeries {
+		for _, p := range ser.Points {
+			values[p.T] = p.V
+			counts[p.T]++
+		}
+	}
+	var points []promql.Point
+	rangeSteps(cmd, func(t int64) {
+		v := math.NaN()
+		if counts[t] == 1 {
+			v = values[t]
+		}
+		points = append(points, promql.Point{T: t, V: v})
+	})
+	return NewPromResult(promql.Matrix{{Metric: labels.Labels{}, Points: points}}, string(parser.ValueTypeMatrix))
+}
+
+// sortVectorByValue sorts the samples by value, NaN values are always at the end.
+func sortVectorByValue(vector promql.Vector, desc bool) {
+	sort.Slice(vector, func(i, j int) bool {
+		return labels.Compare(vector[i].Metric, vector[j].Metric) < 0
+	})
+	sort.SliceStable(vector, func(i, j int) bool {
+		vi, vj := vector[i].V, vector[j].V
+		if math.IsNaN(vi) {
+			return false
+		}
+		if math.IsNaN(vj) {
+			return true
+		}
+		if desc {
+			return vi > vj
+		}
+		return vi < vj
+	})
+}
+
+/*
+Copyright 2015 The Prometheus Authors
+This code is originally from: https://github.com/prometheus/prometheus/blob/main/promql/functions.go
+*/
+// createLabelsForAbsentFunction returns the labels that are uniquely and exactly matched
+// in a given expression. It is used in the absent functions.
+func createLabelsForAbsentFunction(expr parser.Expr) labels.Labels {
+	m := labels.Labels{}
+
+	var lm []*labels.Matcher
+	switch n := expr.(type) {
+	case *parser.VectorSelector:
+		lm = n.LabelMatchers
+	case *parser.MatrixSelector:
+		lm = n.VectorSelector.(*parser.VectorSelector).LabelMatchers
+	default:
+		return m
+	}
+
+	empty := []string{}
+	for _, ma := range lm {
+		if ma.Name == labels.MetricName {
+			continue
+		}
+		if ma.Type == labels.MatchEqual && !m.Has(ma.Name) {
+			m = labels.NewBuilder(m).Set(ma.Name, ma.Value).Labels()
+		} else {
+			empty = append(empty, ma.Name)
+		}
+	}
+
+	for _, v := range empty {
+		m = labels.NewBuilder(m).Del(v).Labels()
+	}
+	return m
+}
+
+// evaluationTime returns the time of the instant query.
+func evaluationTime(cmd PromCommand) time.Time {
+	if cmd.Evaluation != nil {
+		return *cmd.Evaluation
+	} else if cmd.End != nil {
+		return *cmd.End
+	}
+	return time.Now()
+}
+
+// rangeSteps calls fn with each step of the range query in milliseconds.
+func rangeSteps(cmd PromCommand, fn func(t int64)) {
+	if cmd.Start == nil || cmd.End == nil || cmd.Step <= 0 {
+		return
+	}
+	end, step := timestamp.FromTime(*cmd.End), cmd.Step.Milliseconds()
+	for t := timestamp.FromTime(*cmd.Start); t <= end; t += step {
+		fn(t)
+	}
+}
+
 func Row2Point(row []interface{}) (promql.Point, error) {
 	ts, ok := row[0].(time.Time)
 	if !ok {
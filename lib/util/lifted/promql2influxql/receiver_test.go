@@ -0,0 +1,104 @@
+package promql2influxql
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newReceiverResult(values map[string][][]interface{}) *query.Result {
+	result := &query.Result{}
+	for job, rows := range values {
+		result.Series = append(result.Series, &models.Row{
+			Name:   "up",
+			Tags:   map[string]string{"job": job},
+			Values: rows,
+		})
+	}
+	return result
+}
+
+func TestReceiver_ResultFunctions(t *testing.T) {
+	eval := time.Unix(100, 0)
+	start, end := time.Unix(40, 0), time.Unix(100, 0)
+	instant := PromCommand{Evaluation: &eval}
+	graph := PromCommand{Start: &start, End: &end, Step: 30 * time.Second, DataType: GRAPH_DATA}
+	parse := func(input string) parser.Expr {
+		expr, err := parser.ParseExpr(input)
+		require.NoError(t, err)
+		return expr
+	}
+	r := &Receiver{DropMetric: true}
+
+	t.Run("absent of empty vector", func(t *testing.T) {
+		res, err := r.InfluxResultToPromQLValue(&query.Result{}, parse(`absent(up{job="a",instance=~"b.*"})`), instant)
+		require.NoError(t, err)
+		assert.Equal(t, string(parser.ValueTypeVector), res.ResultType)
+		assert.Equal(t, promql.Vector{{Metric: labels.FromStrings("job", "a"), Point: promql.Point{T: 100000, V: 1}}}, res.Result)
+	})
+
+	t.Run("absent of non-empty vector", func(t *testing.T) {
+		result := newReceiverResult(map[string][][]interface{}{"a": {{eval, 1.0}}})
+		res, err := r.InfluxResultToPromQLValue(result, parse(`absent(up{job="a"})`), instant)
+		require.NoError(t, err)
+		assert.Equal(t, promql.Vector{}, res.Result)
+	})
+
+	t.Run("absent of range query", func(t *testing.T) {
+		result := newReceiverResult(map[string][][]interface{}{"a": {{time.Unix(70, 0), 1.0}}})
+		res, err := r.InfluxResultToPromQLValue(result, parse(`absent(up{job="a"})`), graph)
+		require.NoError(t, err)
+		assert.Equal(t, promql.Matrix{{
+			Metric: labels.FromStrings("job", "a"),
+			Points: []promql.Point{{T: 40000, V: 1}, {T: 100000, V: 1}},
+		}}, res.Result)
+	})
+
+	t.Run("scalar", func(t *testing.T) {
+		result := newReceiverResult(map[string][][]interface{}{"a": {{eval, 3.0}}})
+		res, err := r.InfluxResultToPromQLValue(result, parse(`scalar(up)`), instant)
+		require.NoError(t, err)
+		assert.Equal(t, string(parser.ValueTypeScalar), res.ResultType)
+		assert.Equal(t, promql.Scalar{T: 100000, V: 3}, res.Result)
+
+		result = newReceiverResult(map[string][][]interface{}{"a": {{eval, 3.0}}, "b": {{eval, 4.0}}})
+		res, err = r.InfluxResultToPromQLValue(result, parse(`scalar(up)`), instant)
+		require.NoError(t, err)
+		assert.True(t, math.IsNaN(res.Result.(promql.Scalar).V))
+	})
+
+	t.Run("sort and sort_desc", func(t *testing.T) {
+		result := newReceiverResult(map[string][][]interface{}{
+			"a": {{eval, 2.0}},
+			"b": {{eval, math.NaN()}},
+			"c": {{eval, 1.0}},
+			"d": {{eval, 3.0}},
+		})
+		values := func(v interface{}) []float64 {
+			var res []float64
+			for _, s := range v.(promql.Vector) {
+				res = append(res, s.V)
+			}
+			return res
+		}
+		res, err := r.InfluxResultToPromQLValue(result, parse(`sort(up)`), instant)
+		require.NoError(t, err)
+		got := values(res.Result)
+		assert.Equal(t, []float64{1, 2, 3}, got[:3])
+		assert.True(t, math.IsNaN(got[3]))
+
+		res, err = r.InfluxResultToPromQLValue(result, parse(`sort_desc(up)`), instant)
+		require.NoError(t, err)
+		got = values(res.Result)
+		assert.Equal(t, []float64{3, 2, 1}, got[:3])
+		assert.True(t, math.IsNaN(got[3]))
+	})
+}
@@ -0,0 +1,99 @@
+package promql2influxql
+
+import (
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// evalFrame is the time range and resolution in which an expression is evaluated.
+type evalFrame struct {
+	start, end *time.Time
+	step       time.Duration
+	minT, maxT int64
+}
+
+func (t *Transpiler) saveFrame() evalFrame {
+	return evalFrame{start: t.Start, end: t.End, step: t.Step, minT: t.minT, maxT: t.maxT}
+}
+
+func (t *Transpiler) restoreFrame(f evalFrame) {
+	t.Start, t.End, t.Step, t.minT, t.maxT = f.start, f.end, f.step, f.minT, f.maxT
+}
+
+// evalTimes returns the evaluation start, end and step of the current frame in milliseconds.
+func (t *Transpiler) evalTimes() (int64, int64, int64) {
+	if t.Step == 0 {
+		ts := timestamp.FromTime(*t.Evaluation)
+		return ts, ts, 0
+	}
+	return timestamp.FromTime(*t.Start), timestamp.FromTime(*t.End), durationMilliseconds(t.Step)
+}
+
+// transpileSubqueryExpr transpiles PromQL SubqueryExpr to an InfluxQL SelectStatement over the inner expression.
+// The inner expression is evaluated in its own frame: every subquery step between the outer evaluation
+// start minus the subquery range and offset, and the outer evaluation end minus the subquery offset,
+// aligned to the absolute multiple of the subquery step as Prometheus does.
+// The returned statement only selects the value, the range function is set by transpileCall
+// and evaluated by the executor at every outer step according to the PromSubCall.
+func (t *Transpiler) transpileSubqueryExpr(e *parser.SubqueryExpr) (influxql.Node, error) {
+	start, end, step := t.evalTimes()
+	offset, rng := durationMilliseconds(e.Offset), durationMilliseconds(e.Range)
+
+	subStep := durationMilliseconds(DefaultEvaluationInterval)
+	if e.Step != 0 {
+		subStep = durationMilliseconds(e.Step)
+	}
+	subStart := subStep * ((start - offset - rng) / subStep)
+	if subStart < start-offset-rng {
+		subStart += subStep
+	}
+	subEnd := end - offset
+	if subStart != start {
+		// adjust the offset of selectors based on the new start time
+		setOffsetForAtModifier(subStart, e.Expr)
+	}
+
+	outer := t.saveFrame()
+	subStartTime, subEndTime := timestamp.Time(subStart), timestamp.Time(subEnd)
+	t.Start, t.End, t.Step = &subStartTime, &subEndTime, time.Duration(subStep)*time.Millisecond
+	t.minT, t.maxT = t.findMinMaxTime(&parser.EvalStmt{Expr: e.Expr, Start: subStartTime, End: subEndTime, Interval: t.Step})
+	node, err := t.transpileExpr(e.Expr)
+	t.restoreFrame(outer)
+	if err != nil {
+		return nil, err
+	}
+
+	inner, ok := node.(*influxql.SelectStatement)
+	if !ok {
+		return nil, errno.NewError(errno.UnsupportedNodeType, e.String())
+	}
+	minT, maxT := timestamp.Time(t.minT), timestamp.Time(t.maxT)
+	field := inner.Fields[len(inner.Fields)-1]
+	return &influxql.SelectStatement{
+		Sources: []influxql.Source{&influxql.SubQuery{Statement: inner}},
+		Fields: []*influxql.Field{{
+			Expr:  &influxql.VarRef{Val: field.Name(), Alias: DefaultFieldKey},
+			Alias: DefaultFieldKey,
+		}},
+		Condition:     GetTimeCondition(&minT, &maxT),
+		Dimensions:    []*influxql.Dimension{{Expr: &influxql.Wildcard{}}},
+		Location:      t.Timezone,
+		IsPromQuery:   true,
+		Step:          time.Duration(step) * time.Millisecond,
+		Range:         e.Range,
+		LookBackDelta: t.LookBackDelta,
+		// the instant query result is stamped with the end time plus the query offset
+		QueryOffset: time.Duration(end-t.maxT) * time.Millisecond,
+		PromSubCall: &influxql.PromSubCall{
+			StartTime: start * int64(time.Millisecond),
+			EndTime:   end * int64(time.Millisecond),
+			Interval:  step * int64(time.Millisecond),
+			Range:     e.Range,
+			Offset:    e.Offset,
+		},
+	}, nil
+}
@@ -0,0 +1,89 @@
+package promql2influxql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranspiler_transpileSubqueryExpr(t1 *testing.T) {
+	tests := []struct {
+		name    string
+		fields  fields
+		expr    string
+		want    string
+		wantSub *influxql.PromSubCall
+	}{
+		{
+			name:   "instant query",
+			fields: fields{Evaluation: &endTime2},
+			expr:   `max_over_time(rate(go_gc_duration_seconds_count[5m])[1h:1m])`,
+			want:   `SELECT max_over_time(value) AS value FROM (SELECT rate_prom(value) AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T05:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *, time(1m) fill(none)) WHERE time >= '2023-01-06T05:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *`,
+			wantSub: &influxql.PromSubCall{
+				StartTime: endTime2.UnixNano(),
+				EndTime:   endTime2.UnixNano(),
+				Range:     time.Hour,
+			},
+		},
+		{
+			name:   "range query",
+			fields: fields{Start: &startTime2, End: &endTime2, Step: step},
+			expr:   `max_over_time(rate(go_gc_duration_seconds_count[5m])[1h:1m])`,
+			want:   `SELECT max_over_time(value) AS value FROM (SELECT rate_prom(value) AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T02:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *, time(1m) fill(none)) WHERE time >= '2023-01-06T02:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *`,
+			wantSub: &influxql.PromSubCall{
+				StartTime: startTime2.UnixNano(),
+				EndTime:   endTime2.UnixNano(),
+				Interval:  int64(step),
+				Range:     time.Hour,
+			},
+		},
+		{
+			name:   "aggregate over subquery with offset",
+			fields: fields{Evaluation: &endTime2},
+			expr:   `sum(avg_over_time(go_gc_duration_seconds_count[10m:1m] offset 5m))`,
+			want:   `SELECT sum(value) AS value FROM (SELECT avg_over_time(value) AS value FROM (SELECT value AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T06:45:00Z' AND time <= '2023-01-06T06:55:00Z' GROUP BY *) WHERE time >= '2023-01-06T06:45:00Z' AND time <= '2023-01-06T06:55:00Z' GROUP BY *) WHERE time >= '2023-01-06T06:45:00Z' AND time <= '2023-01-06T06:55:00Z'`,
+			wantSub: &influxql.PromSubCall{
+				StartTime: endTime2.UnixNano(),
+				EndTime:   endTime2.UnixNano(),
+				Range:     10 * time.Minute,
+				Offset:    5 * time.Minute,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			t := &Transpiler{
+				PromCommand: PromCommand{
+					Start:      tt.fields.Start,
+					End:        tt.fields.End,
+					Evaluation: tt.fields.Evaluation,
+					Step:       tt.fields.Step,
+				},
+			}
+			got, err := t.Transpile(ParseExpr(tt.expr))
+			require.NoError(t1, err)
+			assert.Equal(t1, tt.want, got.String())
+
+			stmt := got.(*influxql.SelectStatement)
+			for stmt.PromSubCall == nil {
+				stmt = stmt.Sources[0].(*influxql.SubQuery).Statement
+			}
+			assert.Equal(t1, tt.wantSub, stmt.PromSubCall)
+			assert.Equal(t1, tt.wantSub.Range, stmt.Range)
+		})
+	}
+}
+
+func TestTranspiler_transpileSubqueryExpr_Unsupported(t1 *testing.T) {
+	for _, expr := range []string{
+		`go_gc_duration_seconds_count[1h:1m]`,
+		`max_over_time(vector(1)[1h:1m])`,
+	} {
+		t := &Transpiler{PromCommand: PromCommand{Evaluation: &endTime2}}
+		_, err := t.Transpile(ParseExpr(expr))
+		assert.Error(t1, err, expr)
+	}
+}
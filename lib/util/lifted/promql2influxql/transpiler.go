@@ -50,11 +50,17 @@ func (t *Transpiler) transpile(expr parser.Expr) (influxql.Node, error) {
 	if t.Start != nil && expr.Type() != parser.ValueTypeVector && expr.Type() != parser.ValueTypeScalar {
 		return nil, errno.NewError(errno.InvalidExprType, parser.DocumentedType(expr.Type()))
 	}
+	// a subquery is only supported as the argument of range functions.
+	if _, ok := unwrapStepInvariantExpr(expr).(*parser.SubqueryExpr); ok {
+		return nil, errno.NewError(errno.UnsupportedNodeType, expr.String())
+	}
+	if call, ok := ResultFunctionCall(expr); ok {
+		return t.transpileResultCall(call)
+	}
 	return t.transpileExpr(expr)
 }
 
 // transpileExpr recursively transpile PromQL expression.
-// TODO It doesn't support PromQL SubqueryExpr and StepInvariantExpr yet.
 func (t *Transpiler) transpileExpr(expr parser.Expr) (influxql.Node, error) {
 	switch e := expr.(type) {
 	case *parser.ParenExpr:
@@ -78,7 +84,7 @@ func (t *Transpiler) transpileExpr(expr parser.Expr) (influxql.Node, error) {
 	case *parser.StepInvariantExpr:
 		return t.transpileStepInvariantExpr(e)
 	case *parser.SubqueryExpr:
-		return nil, errno.NewError(errno.UnsupportedNodeType, expr.String())
+		return t.transpileSubqueryExpr(e)
 	default:
 		return nil, errno.NewError(errno.UnsupportedNodeType, expr.String())
 	}
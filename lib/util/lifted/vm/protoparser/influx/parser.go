@@ -1322,6 +1322,8 @@ func FieldType2Val(fieldType int) (interface{}, error) {
 		return (*float64)(nil), nil
 	case Field_Type_Int:
 		return (*int64)(nil), nil
+	case Field_Type_UInt:
+		return (*uint64)(nil), nil
 	case Field_Type_String:
 		return (*string)(nil), nil
 	case Field_Type_Boolean:
@@ -1352,12 +1354,33 @@ func FieldTypeString(fieldType int32) string {
 
 // Field represents influx field.
 type Field struct {
-	Key      string
+	Key string
+	// NumValue is the value of the numeric fields. The value of an unsigned field is larger
+	// than a float64 can hold exactly, NumValue holds the bits of the uint64 instead, which
+	// are read and written by UnsignedValue and SetUnsignedValue.
 	NumValue float64
 	StrValue string
 	Type     int32
 }
 
+// UnsignedValue returns the value of the field of Field_Type_UInt.
+func (f *Field) UnsignedValue() uint64 {
+	return math.Float64bits(f.NumValue)
+}
+
+// SetUnsignedValue sets the value of the field of Field_Type_UInt.
+func (f *Field) SetUnsignedValue(v uint64) {
+	f.NumValue = math.Float64frombits(v)
+}
+
+// FloatValue returns the value of the numeric field as a float64.
+func (f *Field) FloatValue() float64 {
+	if f.Type == Field_Type_UInt {
+		return float64(f.UnsignedValue())
+	}
+	return f.NumValue
+}
+
 type Fields []Field
 
 func (fs *Fields) Less(i, j int) bool {
@@ -1553,8 +1576,13 @@ func parseFieldNumValue(s string) (float64, int32, error) {
 		return float64(n), Field_Type_Int, nil
 	}
 	if ch == 'u' {
-		// Unsigned integer value
-		return 0, Field_Type_Unknown, fmt.Errorf("invalid number")
+		// Unsigned integer value, the bits of the value are kept as they are, see Field.UnsignedValue
+		ss := s[:len(s)-1]
+		n, err := fastfloat.ParseUint64(ss)
+		if err != nil {
+			return 0, Field_Type_Unknown, err
+		}
+		return math.Float64frombits(n), Field_Type_UInt, nil
 	}
 	if ch == 'f' {
 		// Unsigned integer value
@@ -9,6 +9,7 @@ Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
 */
 
 import (
+	"math"
 	"reflect"
 	"strings"
 	"testing"
@@ -53,6 +54,33 @@ func TestUnmarshalRows(t *testing.T) {
 	rows, tagsPool, fieldsPool = f(rows[:0], req, tagsPool[:0], fieldsPool[:0], 0)
 }
 
+func TestUnmarshalRows_Unsigned(t *testing.T) {
+	req := "cpu,host=h1 a=18446744073709551615u,b=3u,c=-1i 1622851200000000000\n"
+	rows, _, _, err := unmarshalRows(nil, req, nil, nil, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 1 || len(rows[0].Fields) != 3 {
+		t.Fatalf("unexpected rows: %+v", rows)
+	}
+
+	fields := rows[0].Fields
+	if fields[0].Type != Field_Type_UInt || fields[0].UnsignedValue() != math.MaxUint64 {
+		t.Fatalf("unexpected unsigned field: %+v", fields[0])
+	}
+	if fields[1].Type != Field_Type_UInt || fields[1].FloatValue() != 3 {
+		t.Fatalf("unexpected unsigned field: %+v", fields[1])
+	}
+	if fields[2].Type != Field_Type_Int || fields[2].FloatValue() != -1 {
+		t.Fatalf("unexpected integer field: %+v", fields[2])
+	}
+
+	_, _, _, err = unmarshalRows(nil, "cpu,host=h1 a=-1u 1622851200000000000\n", nil, nil, false)
+	if err == nil {
+		t.Fatalf("expected error for a negative unsigned value")
+	}
+}
+
 func TestUnmarshalRows_error(t *testing.T) {
 	enableTagArray := false
 	f := func(dst []Row, s string, tagsPool []Tag, fieldsPool []Field, expectedErr string) {
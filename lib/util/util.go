@@ -75,19 +75,19 @@ const MeasurementVersionLength = 5
 const MaxMeasurementLength = MaxMeasurementLengthWithVersion - MeasurementVersionLength
 
 type BasicType interface {
-	int64 | float64 | bool | string
+	int64 | uint64 | float64 | bool | string
 }
 
 type NumberOnly interface {
-	int64 | float64
+	int64 | uint64 | float64
 }
 
 type ExceptString interface {
-	int64 | float64 | bool
+	int64 | uint64 | float64 | bool
 }
 
 type ExceptBool interface {
-	int64 | float64 | string
+	int64 | uint64 | float64 | string
 }
 
 var logger *zap.Logger
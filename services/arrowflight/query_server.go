@@ -0,0 +1,604 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package arrowflight
+
+import (
+	"context"
+	json2 "encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/flight"
+	"github.com/apache/arrow/go/v13/arrow/ipc"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/quota"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/syscontrol"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MeasurementColumn is the first column of every record returned by DoGet,
+// it holds the measurement name of the series.
+const MeasurementColumn = "measurement"
+
+const (
+	// queryChunkSize is the maximum number of rows of a query result, DoGet writes the results
+	// to the stream as they arrive instead of buffering all the rows of the query.
+	queryChunkSize      = 10000
+	queryInnerChunkSize = 1024
+)
+
+type QueryExecutor interface {
+	ExecuteQuery(query *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, qDuration *statistics.SQLSlowQueryStatistics) <-chan *query.Result
+}
+
+type QueryAuthorizer interface {
+	AuthorizeQuery(u meta.User, query *influxql.Query, database string) error
+}
+
+// QueryCommand is the command of the flight descriptor passed to GetFlightInfo,
+// every ticket returned by GetFlightInfo carries the same command and the index
+// of one statement of the query to DoGet.
+type QueryCommand struct {
+	DataBase        string `json:"db"`
+	RetentionPolicy string `json:"rp"`
+	Query           string `json:"q"`
+	Statement       *int   `json:"stmt,omitempty"`
+}
+
+// queryServer serves InfluxQL queries through GetFlightInfo and DoGet. GetFlightInfo returns
+// one endpoint per statement, and DoGet returns the series of the statement in one stream
+// of records which share the same schema: the measurement name, the tags of the GROUP BY
+// ordered by key, and then the columns of the statement. The types of the columns are the
+// types of the statement; they are inferred from the values of the first query result only
+// if the statement does not provide them, e.g. for the SHOW statements.
+type queryServer struct {
+	*writeServer
+	authEnabled bool
+	client      FlightMetaClient
+	executor    QueryExecutor
+	authorizer  QueryAuthorizer
+	logger      *logger.Logger
+}
+
+func NewQueryServer(writer *writeServer, authEnabled bool, logger *logger.Logger) *queryServer {
+	return &queryServer{
+		writeServer: writer,
+		authEnabled: authEnabled,
+		logger:      logger,
+	}
+}
+
+func (s *queryServer) SetMetaClient(client FlightMetaClient) {
+	s.client = client
+}
+
+func (s *queryServer) SetQueryExecutor(executor QueryExecutor, authorizer QueryAuthorizer) {
+	s.executor = executor
+	s.authorizer = authorizer
+}
+
+func (s *queryServer) GetFlightInfo(ctx context.Context, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	if desc.GetType() != flight.DescriptorCMD {
+		return nil, status.Error(codes.InvalidArgument, "flight descriptor must be a command")
+	}
+	cmd, q, _, err := s.prepare(ctx, desc.Cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	// the statements may return different schemas, which do not fit in one stream
+	endpoints := make([]*flight.FlightEndpoint, 0, len(q.Statements))
+	for i := range q.Statements {
+		stmt := i
+		tkt := *cmd
+		tkt.Statement = &stmt
+		buf, err := json2.Marshal(&tkt)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		endpoints = append(endpoints, &flight.FlightEndpoint{Ticket: &flight.Ticket{Ticket: buf}})
+	}
+	return &flight.FlightInfo{
+		FlightDescriptor: desc,
+		Endpoint:         endpoints,
+		TotalRecords:     -1,
+		TotalBytes:       -1,
+	}, nil
+}
+
+func (s *queryServer) DoGet(tkt *flight.Ticket, server flight.FlightService_DoGetServer) error {
+	atomic.AddInt64(&statistics.HandlerStat.QueryRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.ActiveQueryRequests, 1)
+	defer func(start time.Time) {
+		atomic.AddInt64(&statistics.HandlerStat.ActiveQueryRequests, -1)
+		atomic.AddInt64(&statistics.HandlerStat.QueryRequestDuration, time.Since(start).Nanoseconds())
+	}(time.Now())
+
+	cmd, q, user, err := s.prepare(server.Context(), tkt.GetTicket())
+	if err != nil {
+		return err
+	}
+	if err = selectStatement(cmd, q); err != nil {
+		return err
+	}
+
+	var userID string
+	if user != nil {
+		userID = user.ID()
+	}
+	qt, err := s.quota.BeginQuery(userID, cmd.DataBase)
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	defer qt.Done()
+
+	closing := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-done:
+		case <-server.Context().Done():
+		}
+		close(closing)
+	}()
+
+	opts := query.ExecutionOptions{
+		Database:        cmd.DataBase,
+		RetentionPolicy: cmd.RetentionPolicy,
+		ChunkSize:       queryChunkSize,
+		Chunked:         true,
+		ReadOnly:        true,
+		ParallelQuery:   atomic.LoadInt32(&syscontrol.ParallelQueryInBatch) == 1,
+		Quiet:           true,
+		Authorizer:      s.getAuthorizer(user),
+		AbortCh:         closing,
+		InnerChunkSize:  queryInnerChunkSize,
+	}
+
+	wr := &recordStreamWriter{mem: memory.NewGoAllocator(), server: server, qt: qt}
+	for r := range s.executor.ExecuteQuery(q, opts, closing, nil) {
+		if r.Err != nil {
+			s.logger.Error("arrow flight DoGet query error", zap.Error(r.Err), zap.String("db", cmd.DataBase))
+			return status.Error(queryErrorCode(r.Err), r.Err.Error())
+		}
+		if err = wr.write(r); err != nil {
+			return status.Error(queryErrorCode(err), err.Error())
+		}
+	}
+	return wr.close()
+}
+
+// selectStatement keeps only the statement of the ticket in the query. A ticket without
+// statement is accepted for the queries of one statement.
+func selectStatement(cmd *QueryCommand, q *influxql.Query) error {
+	if cmd.Statement == nil {
+		if len(q.Statements) > 1 {
+			return status.Errorf(codes.InvalidArgument, "query has %d statements, use the tickets returned by GetFlightInfo", len(q.Statements))
+		}
+		return nil
+	}
+	i := *cmd.Statement
+	if i < 0 || i >= len(q.Statements) {
+		return status.Errorf(codes.InvalidArgument, "statement %d is out of range of the query with %d statements", i, len(q.Statements))
+	}
+	q.Statements = q.Statements[i : i+1]
+	return nil
+}
+
+// queryErrorCode returns the status code of an error returned while the query is executed,
+// the query is already parsed and authorized so only the resource limits are not internal
+func queryErrorCode(err error) codes.Code {
+	if errno.Equal(err, errno.QueryQuotaExceeded, errno.MemUsageExceeded, errno.HashJoinRowsExceeded) {
+		return codes.ResourceExhausted
+	}
+	return codes.Internal
+}
+
+// recordStreamWriter converts the series of every query result to records and writes them
+// to the stream, the schema of the stream is built from the first result with series
+type recordStreamWriter struct {
+	mem       memory.Allocator
+	server    flight.DataStreamWriter
+	qt        *quota.Query
+	wr        *flight.Writer
+	schema    *arrow.Schema
+	tags      []string
+	columnIdx map[string]int
+}
+
+func (w *recordStreamWriter) write(r *query.Result) error {
+	rows := r.Series
+	if len(rows) == 0 {
+		return nil
+	}
+	if w.wr == nil {
+		schema, tags, columnIdx, err := buildArrowSchema(rows, r.Dimensions, r.ColumnTypes)
+		if err != nil {
+			return err
+		}
+		w.schema, w.tags, w.columnIdx = schema, tags, columnIdx
+		w.wr = flight.NewRecordWriter(w.server, ipc.WithSchema(schema))
+	}
+
+	for _, row := range rows {
+		if err := w.checkRow(row); err != nil {
+			return err
+		}
+		rec, err := rowToArrowRecord(w.mem, w.schema, row, w.tags, w.columnIdx)
+		if err != nil {
+			return err
+		}
+		// the records sent to the client count for the max_query_result_bytes quota
+		if err = w.qt.Grow(recordSize(rec)); err != nil {
+			rec.Release()
+			return err
+		}
+		err = w.wr.Write(rec)
+		rec.Release()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkRow returns an error if the tags or the columns of the series are not in the schema of the stream
+func (w *recordStreamWriter) checkRow(row *models.Row) error {
+	for k := range row.Tags {
+		if idx := sort.SearchStrings(w.tags, k); idx == len(w.tags) || w.tags[idx] != k {
+			return fmt.Errorf("tag %s of measurement %s is not in the schema of the query results", k, row.Name)
+		}
+	}
+	for _, col := range row.Columns {
+		if _, ok := w.columnIdx[col]; !ok {
+			return fmt.Errorf("column %s of measurement %s is not in the schema of the query results", col, row.Name)
+		}
+	}
+	return nil
+}
+
+// close sends the schema with only the measurement column if the query returns no series
+func (w *recordStreamWriter) close() error {
+	if w.wr == nil {
+		schema := arrow.NewSchema([]arrow.Field{{Name: MeasurementColumn, Type: arrow.BinaryTypes.String}}, nil)
+		w.wr = flight.NewRecordWriter(w.server, ipc.WithSchema(schema))
+	}
+	return w.wr.Close()
+}
+
+// prepare parses the query command and checks whether the user of the request
+// is authorized to execute it
+func (s *queryServer) prepare(ctx context.Context, buf []byte) (*QueryCommand, *influxql.Query, meta.User, error) {
+	if syscontrol.DisableReads {
+		return nil, nil, nil, status.Error(codes.PermissionDenied, "disable read!")
+	}
+
+	cmd := &QueryCommand{}
+	if err := json2.Unmarshal(buf, cmd); err != nil {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "invalid query command: %s", err)
+	}
+	if cmd.Query == "" {
+		return nil, nil, nil, status.Error(codes.InvalidArgument, `missing required parameter "q"`)
+	}
+
+	p := influxql.NewParser(strings.NewReader(cmd.Query))
+	defer p.Release()
+	yyParser := influxql.NewYyParser(p.GetScanner(), p.GetPara())
+	yyParser.ParseTokens()
+	q, err := yyParser.GetQuery()
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "error parsing query: %s", err)
+	}
+
+	if !s.authEnabled {
+		return cmd, q, nil, nil
+	}
+	token, ok := flight.AuthFromContext(ctx).(*AuthToken)
+	if !ok {
+		return nil, nil, nil, status.Error(codes.Unauthenticated, "invalid auth token")
+	}
+	user, err := s.client.User(token.Username)
+	if err != nil {
+		return nil, nil, nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err = s.authorizer.AuthorizeQuery(user, q, cmd.DataBase); err != nil {
+		return nil, nil, nil, status.Errorf(codes.PermissionDenied, "error authorizing query: %s", err)
+	}
+	return cmd, q, user, nil
+}
+
+func (s *queryServer) getAuthorizer(user meta.User) query.FineAuthorizer {
+	if !s.authEnabled || user == nil || user.AuthorizeUnrestricted() {
+		return query.OpenAuthorizer
+	}
+	return user
+}
+
+type columnInfo struct {
+	name  string
+	dType arrow.DataType
+}
+
+// RowsToArrowRecords converts every series to a record, all the records share the same schema.
+// The records must be released after use.
+func RowsToArrowRecords(mem memory.Allocator, rows models.Rows) ([]arrow.Record, error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	schema, tags, columnIdx, err := buildArrowSchema(rows, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]arrow.Record, 0, len(rows))
+	for _, row := range rows {
+		rec, err := rowToArrowRecord(mem, schema, row, tags, columnIdx)
+		if err != nil {
+			for _, r := range records {
+				r.Release()
+			}
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// buildArrowSchema returns the schema of the series, the sorted tag keys and the field index of every column.
+// The columns have the types of the statement if they are known, the tags are the dimensions of the
+// statement if the types are known, otherwise both are taken from the series.
+func buildArrowSchema(rows models.Rows, dimensions []string, columnTypes []influxql.DataType) (*arrow.Schema, []string, map[string]int, error) {
+	typed := len(columnTypes) > 0 && len(columnTypes) == len(rows[0].Columns)
+
+	tagKeys := make(map[string]struct{})
+	if typed {
+		for _, k := range dimensions {
+			tagKeys[k] = struct{}{}
+		}
+	}
+	for _, row := range rows {
+		for k := range row.Tags {
+			tagKeys[k] = struct{}{}
+		}
+	}
+	tags := make([]string, 0, len(tagKeys))
+	for k := range tagKeys {
+		tags = append(tags, k)
+	}
+	sort.Strings(tags)
+
+	columns, err := buildColumnInfo(rows)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if typed {
+		for i, c := range columns[:len(columnTypes)] {
+			if dType := arrowColumnType(columnTypes[i]); dType != nil {
+				c.dType = dType
+			}
+		}
+	}
+
+	fields := make([]arrow.Field, 0, 1+len(tags)+len(columns))
+	fields = append(fields, arrow.Field{Name: MeasurementColumn, Type: arrow.BinaryTypes.String})
+	for _, k := range tags {
+		fields = append(fields, arrow.Field{Name: k, Type: arrow.BinaryTypes.String, Nullable: true})
+	}
+	columnIdx := make(map[string]int, len(columns))
+	for _, c := range columns {
+		columnIdx[c.name] = len(fields)
+		fields = append(fields, arrow.Field{Name: c.name, Type: c.dType, Nullable: true})
+	}
+	return arrow.NewSchema(fields, nil), tags, columnIdx, nil
+}
+
+// buildColumnInfo returns the columns of all series in order of appearance, the data type of
+// every column is the type of its first non-null value. Integers are promoted to floats if a
+// column contains both.
+func buildColumnInfo(rows models.Rows) ([]*columnInfo, error) {
+	var columns []*columnInfo
+	columnMap := make(map[string]*columnInfo)
+	for _, row := range rows {
+		for i, name := range row.Columns {
+			c, ok := columnMap[name]
+			if !ok {
+				c = &columnInfo{name: name}
+				columnMap[name] = c
+				columns = append(columns, c)
+			}
+			for _, values := range row.Values {
+				if i >= len(values) || values[i] == nil {
+					continue
+				}
+				dType, err := arrowDataType(values[i])
+				if err != nil {
+					return nil, err
+				}
+				switch {
+				case c.dType == nil:
+					c.dType = dType
+				case arrow.TypeEqual(c.dType, dType):
+				case isNumeric(c.dType) && isNumeric(dType):
+					c.dType = arrow.PrimitiveTypes.Float64
+				default:
+					return nil, fmt.Errorf("column %s has conflicting types %s and %s", name, c.dType, dType)
+				}
+			}
+		}
+	}
+	for _, c := range columns {
+		if c.dType == nil {
+			c.dType = arrow.Null
+		}
+	}
+	return columns, nil
+}
+
+// arrowColumnType returns the arrow type of a column of the statement, or nil if the type is unknown
+func arrowColumnType(t influxql.DataType) arrow.DataType {
+	switch t {
+	case influxql.Float:
+		return arrow.PrimitiveTypes.Float64
+	case influxql.Integer:
+		return arrow.PrimitiveTypes.Int64
+	case influxql.Unsigned:
+		return arrow.PrimitiveTypes.Uint64
+	case influxql.String, influxql.Tag:
+		return arrow.BinaryTypes.String
+	case influxql.Boolean:
+		return arrow.FixedWidthTypes.Boolean
+	case influxql.Time:
+		return arrow.FixedWidthTypes.Timestamp_ns
+	default:
+		return nil
+	}
+}
+
+func isNumeric(dType arrow.DataType) bool {
+	return dType.ID() == arrow.INT64 || dType.ID() == arrow.FLOAT64
+}
+
+func arrowDataType(v interface{}) (arrow.DataType, error) {
+	switch v.(type) {
+	case float64:
+		return arrow.PrimitiveTypes.Float64, nil
+	case int64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case uint64:
+		return arrow.PrimitiveTypes.Uint64, nil
+	case string:
+		return arrow.BinaryTypes.String, nil
+	case bool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case time.Time:
+		return arrow.FixedWidthTypes.Timestamp_ns, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+func rowToArrowRecord(mem memory.Allocator, schema *arrow.Schema, row *models.Row, tags []string, columnIdx map[string]int) (arrow.Record, error) {
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	n := len(row.Values)
+	name := b.Field(0).(*array.StringBuilder)
+	for i := 0; i < n; i++ {
+		name.Append(row.Name)
+	}
+	for i, k := range tags {
+		tb := b.Field(i + 1).(*array.StringBuilder)
+		v, ok := row.Tags[k]
+		for j := 0; j < n; j++ {
+			if ok {
+				tb.Append(v)
+			} else {
+				tb.AppendNull()
+			}
+		}
+	}
+
+	filled := make([]bool, len(schema.Fields()))
+	for i, col := range row.Columns {
+		idx := columnIdx[col]
+		filled[idx] = true
+		for _, values := range row.Values {
+			var v interface{}
+			if i < len(values) {
+				v = values[i]
+			}
+			if err := appendArrowValue(b.Field(idx), v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// the columns which do not belong to this series are filled with null
+	for idx := 1 + len(tags); idx < len(filled); idx++ {
+		if !filled[idx] {
+			b.Field(idx).AppendNulls(n)
+		}
+	}
+	return b.NewRecord(), nil
+}
+
+// appendArrowValue returns an error if the type of the value does not match the column,
+// the values of the later query results may not fit the schema built from the first one
+func appendArrowValue(b array.Builder, v interface{}) error {
+	if v == nil {
+		b.AppendNull()
+		return nil
+	}
+	ok := true
+	switch fb := b.(type) {
+	case *array.Float64Builder:
+		switch val := v.(type) {
+		case float64:
+			fb.Append(val)
+		case int64:
+			fb.Append(float64(val))
+		default:
+			ok = false
+		}
+	case *array.Int64Builder:
+		var val int64
+		if val, ok = v.(int64); ok {
+			fb.Append(val)
+		}
+	case *array.Uint64Builder:
+		var val uint64
+		if val, ok = v.(uint64); ok {
+			fb.Append(val)
+		}
+	case *array.StringBuilder:
+		var val string
+		if val, ok = v.(string); ok {
+			fb.Append(val)
+		}
+	case *array.BooleanBuilder:
+		var val bool
+		if val, ok = v.(bool); ok {
+			fb.Append(val)
+		}
+	case *array.TimestampBuilder:
+		var val time.Time
+		if val, ok = v.(time.Time); ok {
+			fb.Append(arrow.Timestamp(val.UnixNano()))
+		}
+	default:
+		ok = false
+	}
+	if !ok {
+		return fmt.Errorf("unexpected value type %T of %s column", v, b.Type())
+	}
+	return nil
+}
@@ -0,0 +1,428 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package arrowflight_test
+
+import (
+	"context"
+	json2 "encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/flight"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/quota"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/services/arrowflight"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+var queryTime = time.Unix(0, 1629129600000000000).UTC()
+
+func mockQueryRows() models.Rows {
+	return models.Rows{
+		{
+			Name:    "cpu",
+			Tags:    map[string]string{"host": "h1"},
+			Columns: []string{"time", "usage", "count"},
+			Values: [][]interface{}{
+				{queryTime, 1.5, int64(1)},
+				{queryTime.Add(time.Second), nil, int64(2)},
+			},
+		},
+		{
+			Name:    "cpu",
+			Tags:    map[string]string{"region": "r1"},
+			Columns: []string{"time", "usage", "status"},
+			Values: [][]interface{}{
+				{queryTime, int64(3), "ok"},
+			},
+		},
+	}
+}
+
+type MockQueryExecutor struct {
+	query   *influxql.Query
+	opts    query.ExecutionOptions
+	results []*query.Result
+}
+
+func (e *MockQueryExecutor) ExecuteQuery(q *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, _ *statistics.SQLSlowQueryStatistics) <-chan *query.Result {
+	e.query = q
+	e.opts = opt
+	results := make(chan *query.Result, len(e.results))
+	for _, r := range e.results {
+		results <- r
+	}
+	close(results)
+	return results
+}
+
+type MockQueryAuthorizer struct{}
+
+func (a *MockQueryAuthorizer) AuthorizeQuery(u meta.User, q *influxql.Query, database string) error {
+	if database != "db0" {
+		return errors.New("not authorized")
+	}
+	return nil
+}
+
+func TestRowsToArrowRecords(t *testing.T) {
+	records, err := arrowflight.RowsToArrowRecords(memory.NewGoAllocator(), mockQueryRows())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	defer func() {
+		for _, r := range records {
+			r.Release()
+		}
+	}()
+
+	schema := records[0].Schema()
+	var names []string
+	for _, f := range schema.Fields() {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{arrowflight.MeasurementColumn, "host", "region", "time", "usage", "count", "status"}, names)
+	assert.True(t, schema.Equal(records[1].Schema()))
+	assert.Equal(t, arrow.FixedWidthTypes.Timestamp_ns, schema.Field(3).Type)
+	// usage is promoted to float, as the second series contains integers
+	assert.Equal(t, arrow.PrimitiveTypes.Float64, schema.Field(4).Type)
+
+	r0 := records[0]
+	assert.Equal(t, int64(2), r0.NumRows())
+	assert.Equal(t, "cpu", r0.Column(0).(*array.String).Value(0))
+	assert.Equal(t, "h1", r0.Column(1).(*array.String).Value(1))
+	assert.True(t, r0.Column(2).IsNull(0))
+	assert.Equal(t, arrow.Timestamp(queryTime.UnixNano()), r0.Column(3).(*array.Timestamp).Value(0))
+	assert.Equal(t, 1.5, r0.Column(4).(*array.Float64).Value(0))
+	assert.True(t, r0.Column(4).IsNull(1))
+	assert.Equal(t, int64(2), r0.Column(5).(*array.Int64).Value(1))
+	assert.Equal(t, 2, r0.Column(6).NullN())
+
+	r1 := records[1]
+	assert.Equal(t, 3.0, r1.Column(4).(*array.Float64).Value(0))
+	assert.True(t, r1.Column(5).IsNull(0))
+	assert.Equal(t, "ok", r1.Column(6).(*array.String).Value(0))
+
+	_, err = arrowflight.RowsToArrowRecords(memory.NewGoAllocator(), models.Rows{
+		{Name: "m", Columns: []string{"v"}, Values: [][]interface{}{{int64(1)}}},
+		{Name: "m", Columns: []string{"v"}, Values: [][]interface{}{{"a"}}},
+	})
+	assert.Error(t, err)
+}
+
+func TestArrowFlightServiceDoGet(t *testing.T) {
+	c := config.Config{
+		FlightAddress:     "127.0.0.1:0",
+		MaxBodySize:       1024 * 1024 * 1024,
+		FlightAuthEnabled: true,
+	}
+	service, err := arrowflight.NewService(c)
+	require.NoError(t, err)
+	executor := &MockQueryExecutor{results: []*query.Result{{Series: mockQueryRows()}}}
+	service.MetaClient = NewMockFlightMetaClient()
+	service.RecordWriter = &MockRecordWriter{}
+	service.QueryExecutor = executor
+	service.QueryAuthorizer = &MockQueryAuthorizer{}
+	require.NoError(t, service.Open())
+	defer func() {
+		require.NoError(t, service.Close())
+	}()
+
+	authClient := &clientAuth{authEnabled: true}
+	client, err := flight.NewFlightClient(service.GetServer().Addr().String(), authClient, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+
+	ctx := context.Background()
+	cmd, err := json2.Marshal(&arrowflight.QueryCommand{DataBase: "db0", RetentionPolicy: "rp0", Query: "SELECT * FROM cpu"})
+	require.NoError(t, err)
+
+	// no token
+	_, err = client.GetFlightInfo(ctx, &flight.FlightDescriptor{Type: flight.DescriptorCMD, Cmd: cmd})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	require.NoError(t, client.Authenticate(context.WithValue(ctx, Token, []byte(`{"username": "xiaoming", "db": "db0"}`))))
+	info, err := client.GetFlightInfo(ctx, &flight.FlightDescriptor{Type: flight.DescriptorCMD, Cmd: cmd})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(info.Endpoint))
+
+	stream, err := client.DoGet(ctx, info.Endpoint[0].Ticket)
+	require.NoError(t, err)
+	reader, err := flight.NewRecordReader(stream)
+	require.NoError(t, err)
+	defer reader.Release()
+	var rows int64
+	for reader.Next() {
+		rows += reader.Record().NumRows()
+	}
+	assert.Equal(t, int64(3), rows)
+	assert.Equal(t, "db0", executor.opts.Database)
+	assert.Equal(t, "rp0", executor.opts.RetentionPolicy)
+	assert.True(t, executor.opts.ReadOnly)
+	assert.True(t, executor.opts.Chunked)
+
+	// the results are written to the stream one by one
+	series := mockQueryRows()
+	series[1].Tags = map[string]string{"host": "h2"}
+	series[1].Columns = []string{"time", "usage", "count"}
+	series[1].Values = [][]interface{}{{queryTime, 2.5, int64(3)}}
+	executor.results = []*query.Result{{Series: series[:1], Partial: true}, {}, {Series: series[1:]}}
+	records := doGet(t, client, info.Endpoint[0].Ticket)
+	require.Equal(t, 2, len(records))
+	assert.Equal(t, []string{"measurement", "host", "time", "usage", "count"}, schemaNames(records[0].Schema()))
+	assert.Equal(t, "h2", records[1].Column(1).(*array.String).Value(0))
+	assert.Equal(t, 2.5, records[1].Column(3).(*array.Float64).Value(0))
+	releaseRecords(records)
+
+	// the schema is sent even if the query returns no rows
+	executor.results = []*query.Result{{}}
+	stream, err = client.DoGet(ctx, info.Endpoint[0].Ticket)
+	require.NoError(t, err)
+	emptyReader, err := flight.NewRecordReader(stream)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"measurement"}, schemaNames(emptyReader.Schema()))
+	assert.False(t, emptyReader.Next())
+	emptyReader.Release()
+
+	// the series of the later result does not fit the schema
+	executor.results = []*query.Result{{Series: mockQueryRows()[:1]}, {Series: mockQueryRows()[1:]}}
+	stream, err = client.DoGet(ctx, info.Endpoint[0].Ticket)
+	require.NoError(t, err)
+	errReader, err := flight.NewRecordReader(stream)
+	require.NoError(t, err)
+	for errReader.Next() {
+	}
+	assert.Equal(t, codes.Internal, status.Code(errReader.Err()))
+	errReader.Release()
+
+	// the query fails while it is executed
+	executor.results = []*query.Result{{Err: errors.New("shard not found")}}
+	assert.Equal(t, codes.Internal, status.Code(doGetErr(client, info.Endpoint[0].Ticket)))
+	executor.results = []*query.Result{{Err: errno.NewError(errno.MemUsageExceeded, 1)}}
+	assert.Equal(t, codes.ResourceExhausted, status.Code(doGetErr(client, info.Endpoint[0].Ticket)))
+
+	// the user is not authorized to query db1
+	cmd, err = json2.Marshal(&arrowflight.QueryCommand{DataBase: "db1", Query: "SELECT * FROM cpu"})
+	require.NoError(t, err)
+	_, err = client.GetFlightInfo(ctx, &flight.FlightDescriptor{Type: flight.DescriptorCMD, Cmd: cmd})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// invalid query
+	cmd, err = json2.Marshal(&arrowflight.QueryCommand{DataBase: "db0", Query: "SELECT FROM"})
+	require.NoError(t, err)
+	_, err = client.GetFlightInfo(ctx, &flight.FlightDescriptor{Type: flight.DescriptorCMD, Cmd: cmd})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetFlightInfo(ctx, &flight.FlightDescriptor{Type: flight.DescriptorPATH, Path: []string{"cpu"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestArrowFlightServiceDoGetStatements(t *testing.T) {
+	c := config.Config{
+		FlightAddress: "127.0.0.1:0",
+		MaxBodySize:   1024 * 1024 * 1024,
+	}
+	service, err := arrowflight.NewService(c)
+	require.NoError(t, err)
+	executor := &MockQueryExecutor{}
+	service.MetaClient = NewMockFlightMetaClient()
+	service.RecordWriter = &MockRecordWriter{}
+	service.QueryExecutor = executor
+	require.NoError(t, service.Open())
+	defer func() {
+		require.NoError(t, service.Close())
+	}()
+
+	client, err := flight.NewFlightClient(service.GetServer().Addr().String(), nil, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+
+	cmd, err := json2.Marshal(&arrowflight.QueryCommand{DataBase: "db0", Query: "SELECT * FROM cpu GROUP BY *; SELECT count(v) FROM mem"})
+	require.NoError(t, err)
+	info, err := client.GetFlightInfo(context.Background(), &flight.FlightDescriptor{Type: flight.DescriptorCMD, Cmd: cmd})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(info.Endpoint))
+
+	// every ticket executes one statement of the query
+	tkt := &arrowflight.QueryCommand{}
+	require.NoError(t, json2.Unmarshal(info.Endpoint[1].Ticket.Ticket, tkt))
+	require.NotNil(t, tkt.Statement)
+	assert.Equal(t, 1, *tkt.Statement)
+	executor.results = []*query.Result{{}}
+	releaseRecords(doGet(t, client, info.Endpoint[1].Ticket))
+	require.Equal(t, 1, len(executor.query.Statements))
+	assert.Equal(t, "SELECT count(v) FROM mem", executor.query.Statements[0].String())
+
+	// the schema has the types and the dimensions of the statement: usage is null in the
+	// first result and count is a float column which holds integers in the first result
+	columns := []string{"time", "usage", "count"}
+	columnTypes := []influxql.DataType{influxql.Time, influxql.Float, influxql.Float}
+	dimensions := []string{"region", "host"}
+	executor.results = []*query.Result{
+		{
+			Series: models.Rows{{Name: "cpu", Tags: map[string]string{"host": "h1"}, Columns: columns,
+				Values: [][]interface{}{{queryTime, nil, int64(1)}}}},
+			Partial:     true,
+			Dimensions:  dimensions,
+			ColumnTypes: columnTypes,
+		},
+		{
+			Series: models.Rows{{Name: "cpu", Tags: map[string]string{"region": "r1"}, Columns: columns,
+				Values: [][]interface{}{{queryTime, 1.5, 2.5}}}},
+			Dimensions:  dimensions,
+			ColumnTypes: columnTypes,
+		},
+	}
+	records := doGet(t, client, info.Endpoint[0].Ticket)
+	require.Equal(t, 2, len(records))
+	assert.Equal(t, []string{"measurement", "host", "region", "time", "usage", "count"}, schemaNames(records[0].Schema()))
+	assert.Equal(t, arrow.PrimitiveTypes.Float64, records[0].Schema().Field(4).Type)
+	assert.True(t, records[0].Column(4).IsNull(0))
+	assert.Equal(t, 1.0, records[0].Column(5).(*array.Float64).Value(0))
+	assert.Equal(t, "r1", records[1].Column(2).(*array.String).Value(0))
+	assert.Equal(t, 1.5, records[1].Column(4).(*array.Float64).Value(0))
+	assert.Equal(t, 2.5, records[1].Column(5).(*array.Float64).Value(0))
+	releaseRecords(records)
+
+	// the query of several statements needs the ticket of a statement
+	assert.Equal(t, codes.InvalidArgument, status.Code(doGetErr(client, &flight.Ticket{Ticket: cmd})))
+	stmt := 2
+	cmd, err = json2.Marshal(&arrowflight.QueryCommand{DataBase: "db0", Query: "SELECT * FROM cpu", Statement: &stmt})
+	require.NoError(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(doGetErr(client, &flight.Ticket{Ticket: cmd})))
+}
+
+func TestArrowFlightServiceDoGetQuota(t *testing.T) {
+	c := config.Config{
+		FlightAddress:     "127.0.0.1:0",
+		MaxBodySize:       1024 * 1024 * 1024,
+		FlightAuthEnabled: true,
+	}
+	service, err := arrowflight.NewService(c)
+	require.NoError(t, err)
+	service.MetaClient = NewMockFlightMetaClient()
+	service.RecordWriter = &MockRecordWriter{}
+	service.QueryExecutor = &MockQueryExecutor{results: []*query.Result{{Series: mockQueryRows()}}}
+	service.QueryAuthorizer = &MockQueryAuthorizer{}
+	service.QuotaManager = quota.NewManager(&mockQuotaSource{
+		users: map[string]*meta.QuotaInfo{"xiaoming": {MaxConcurrentQueries: 1}},
+		dbs:   map[string]*meta.QuotaInfo{"db0": {MaxQueryResultBytes: 64}},
+	})
+	require.NoError(t, service.Open())
+	defer func() {
+		require.NoError(t, service.Close())
+	}()
+
+	authClient := &clientAuth{authEnabled: true}
+	client, err := flight.NewFlightClient(service.GetServer().Addr().String(), authClient, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+	ctx := context.Background()
+	require.NoError(t, client.Authenticate(context.WithValue(ctx, Token, []byte(`{"username": "xiaoming", "db": "db0"}`))))
+	cmd, err := json2.Marshal(&arrowflight.QueryCommand{DataBase: "db0", Query: "SELECT * FROM cpu"})
+	require.NoError(t, err)
+	ticket := &flight.Ticket{Ticket: cmd}
+
+	readErr := func() error {
+		stream, err := client.DoGet(ctx, ticket)
+		require.NoError(t, err)
+		reader, err := flight.NewRecordReader(stream)
+		if err != nil {
+			return err
+		}
+		defer reader.Release()
+		for reader.Next() {
+		}
+		return reader.Err()
+	}
+
+	// the user runs another query
+	qt, err := service.QuotaManager.BeginQuery("xiaoming", "")
+	require.NoError(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(readErr()))
+	qt.Done()
+
+	// the records exceed the result bytes of the database
+	assert.Equal(t, codes.ResourceExhausted, status.Code(readErr()))
+	assert.Equal(t, quota.Usage{}, service.QuotaManager.Usage(quota.User, "xiaoming"))
+}
+
+func doGet(t *testing.T, client flight.Client, ticket *flight.Ticket) []arrow.Record {
+	stream, err := client.DoGet(context.Background(), ticket)
+	require.NoError(t, err)
+	reader, err := flight.NewRecordReader(stream)
+	require.NoError(t, err)
+	defer reader.Release()
+
+	var records []arrow.Record
+	for reader.Next() {
+		rec := reader.Record()
+		rec.Retain()
+		records = append(records, rec)
+	}
+	require.NoError(t, reader.Err())
+	return records
+}
+
+func doGetErr(client flight.Client, ticket *flight.Ticket) error {
+	stream, err := client.DoGet(context.Background(), ticket)
+	if err != nil {
+		return err
+	}
+	reader, err := flight.NewRecordReader(stream)
+	if err != nil {
+		return err
+	}
+	defer reader.Release()
+	for reader.Next() {
+	}
+	return reader.Err()
+}
+
+func schemaNames(schema *arrow.Schema) []string {
+	names := make([]string, 0, len(schema.Fields()))
+	for _, f := range schema.Fields() {
+		names = append(names, f.Name)
+	}
+	return names
+}
+
+func releaseRecords(records []arrow.Record) {
+	for _, rec := range records {
+		rec.Release()
+	}
+}
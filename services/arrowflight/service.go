@@ -35,6 +35,7 @@ import (
 	"github.com/influxdata/influxql"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/quota"
 	"github.com/openGemini/openGemini/lib/statisticsPusher"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/util"
@@ -72,6 +73,7 @@ type FlightMetaClient interface {
 type Service struct {
 	server           flight.Server
 	writer           *writeServer
+	reader           *queryServer
 	authHandler      *authServer
 	Config           *config.Config
 	Logger           *logger.Logger
@@ -83,6 +85,12 @@ type Service struct {
 	RecordWriter interface {
 		RetryWriteRecord(database, retentionPolicy, measurement string, rec arrow.Record) error
 	}
+
+	QueryExecutor   QueryExecutor
+	QueryAuthorizer QueryAuthorizer
+
+	// QuotaManager enforces the write and query quotas, it is nil if quotas are not enforced.
+	QuotaManager *quota.Manager
 }
 
 func NewService(c config.Config) (*Service, error) {
@@ -98,7 +106,8 @@ func NewService(c config.Config) (*Service, error) {
 
 	server := flight.NewServerWithMiddleware(nil, grpc.MaxRecvMsgSize(maxRecvMsgSize))
 	writer.SetAuthHandler(authHandler)
-	server.RegisterFlightService(writer)
+	reader := NewQueryServer(writer, c.FlightAuthEnabled, sLogger)
+	server.RegisterFlightService(reader)
 	if err := server.Init(c.FlightAddress); err != nil {
 		sLogger.Error("arrow flight service start failed", zap.Error(err))
 		return nil, err
@@ -107,6 +116,7 @@ func NewService(c config.Config) (*Service, error) {
 	return &Service{
 		server:      server,
 		writer:      writer,
+		reader:      reader,
 		authHandler: authHandler,
 		err:         make(chan error),
 		Logger:      sLogger,
@@ -123,6 +133,9 @@ func (s *Service) Open() error {
 	}()
 	s.authHandler.SetMetaClient(s.MetaClient)
 	s.writer.SetWriter(s.RecordWriter)
+	s.writer.SetQuotaManager(s.QuotaManager)
+	s.reader.SetMetaClient(s.MetaClient)
+	s.reader.SetQueryExecutor(s.QueryExecutor, s.QueryAuthorizer)
 	return nil
 }
 
@@ -241,7 +254,8 @@ func (a *authServer) IsValid(authHashID string) (interface{}, error) {
 		a.mu.Unlock()
 		return "", status.Error(codes.PermissionDenied, "auth token time out")
 	}
-	return WriteAuthSuccess, nil
+	// the token is the identity of the request, see flight.AuthFromContext
+	return token, nil
 }
 
 func (a *authServer) Close() {
@@ -259,6 +273,7 @@ type writeServer struct {
 	RecordWriter
 	mem    memory.Allocator
 	logger *logger.Logger
+	quota  *quota.Manager
 	flight.BaseFlightServer
 }
 
@@ -275,6 +290,10 @@ func (w *writeServer) SetWriter(writer RecordWriter) {
 	w.RecordWriter = writer
 }
 
+func (w *writeServer) SetQuotaManager(m *quota.Manager) {
+	w.quota = m
+}
+
 func (w *writeServer) DoPut(server flight.FlightService_DoPutServer) error {
 	metaData := &MetaData{}
 	wr, err := flight.NewRecordReader(server, ipc.WithAllocator(memory.NewGoAllocator()))
@@ -296,9 +315,19 @@ func (w *writeServer) DoPut(server flight.FlightService_DoPutServer) error {
 		return err
 	}
 
+	var username string
+	if token, ok := flight.AuthFromContext(server.Context()).(*AuthToken); ok {
+		username = token.Username
+	}
+
 	w.logger.Info("arrow flight DoPut starting", zap.String("db", metaData.DataBase), zap.String("rp", metaData.RetentionPolicy), zap.String("mst", metaData.Measurement))
 	for wr.Next() {
 		r := wr.Record()
+		if err = w.quota.AllowWrite(username, metaData.DataBase, r.NumRows(), recordSize(r)); err != nil {
+			w.logger.Error("arrow flight DoPut error:quota exceeded", zap.Error(err), zap.String("db", metaData.DataBase))
+			atomic.AddInt64(&statistics.HandlerStat.Write429ErrRequests, 1)
+			return status.Error(codes.ResourceExhausted, err.Error())
+		}
 		r.Retain() // Memory reserved. The value of reference counting is increased by 1.
 
 		err = w.RecordWriter.RetryWriteRecord(metaData.DataBase, metaData.RetentionPolicy, metaData.Measurement, r)
@@ -313,6 +342,19 @@ func (w *writeServer) DoPut(server flight.FlightService_DoPutServer) error {
 	return nil
 }
 
+// recordSize returns the size of the buffers of the record
+func recordSize(r arrow.Record) int64 {
+	var size int64
+	for _, col := range r.Columns() {
+		for _, buf := range col.Data().Buffers() {
+			if buf != nil {
+				size += int64(buf.Len())
+			}
+		}
+	}
+	return size
+}
+
 func (w *writeServer) Close() {
 	w.mem.Free(nil)
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/influxdata/influxql"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/quota"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
@@ -39,6 +40,7 @@ import (
 	"github.com/openGemini/openGemini/services"
 	"github.com/openGemini/openGemini/services/arrowflight"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
@@ -255,6 +257,67 @@ func TestArrowFlightServiceWithAuth(t *testing.T) {
 	testArrowFlightService(t, true)
 }
 
+type mockQuotaSource struct {
+	users map[string]*meta.QuotaInfo
+	dbs   map[string]*meta.QuotaInfo
+}
+
+func (s *mockQuotaSource) UserQuota(name string) *meta.QuotaInfo {
+	return s.users[name]
+}
+
+func (s *mockQuotaSource) DatabaseQuota(name string) *meta.QuotaInfo {
+	return s.dbs[name]
+}
+
+func TestArrowFlightServiceDoPutQuota(t *testing.T) {
+	c := config.Config{
+		FlightAddress:     "127.0.0.1:0",
+		MaxBodySize:       1024 * 1024 * 1024,
+		FlightAuthEnabled: true,
+	}
+	service, err := arrowflight.NewService(c)
+	require.NoError(t, err)
+	service.MetaClient = NewMockFlightMetaClient()
+	service.RecordWriter = &MockRecordWriter{}
+	service.QuotaManager = quota.NewManager(&mockQuotaSource{
+		users: map[string]*meta.QuotaInfo{"xiaoming": {PointsPerSecond: 6}},
+		dbs:   map[string]*meta.QuotaInfo{"db0": {PointsPerSecond: 100}},
+	})
+	require.NoError(t, service.Open())
+	defer func() {
+		require.NoError(t, service.Close())
+	}()
+
+	authClient := &clientAuth{authEnabled: true}
+	client, err := flight.NewFlightClient(service.GetServer().Addr().String(), authClient, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+	ctx := context.WithValue(context.Background(), Token, []byte(`{"username": "xiaoming", "db": "db0"}`))
+	require.NoError(t, client.Authenticate(ctx))
+
+	doPutClient, err := client.DoPut(ctx)
+	require.NoError(t, err)
+	data := MockArrowRecord(1)
+	defer data.Release()
+	wr := flight.NewRecordWriter(doPutClient, ipc.WithSchema(data.Schema()))
+	wr.SetFlightDescriptor(&flight.FlightDescriptor{Path: []string{`{"db": "db0", "rp": "rp0", "mst": "mst0"}`}})
+	// the second record of 4 points exceeds the quota of the user
+	for i := 0; i < 2; i++ {
+		require.NoError(t, wr.Write(data))
+	}
+	require.NoError(t, wr.Close())
+	require.NoError(t, doPutClient.CloseSend())
+	for err == nil {
+		_, err = doPutClient.Recv()
+	}
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	// the rejected record takes no points from the database quota
+	assert.NoError(t, service.QuotaManager.AllowWrite("", "db0", 96, 0))
+}
+
 type MockAuthConn struct {
 	readErr error
 	sendErr error
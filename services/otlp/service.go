@@ -0,0 +1,290 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package otlp
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/opentelemetry"
+	"github.com/openGemini/openGemini/lib/quota"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DatabaseKey and RetentionPolicyKey are the gRPC metadata keys which select
+	// the database and retention policy of the exported data
+	DatabaseKey        = "x-geminidb-database"
+	RetentionPolicyKey = "x-geminidb-retention-policy"
+
+	authorizationKey = "authorization"
+)
+
+type OTLPMetaClient interface {
+	Database(name string) (*meta.DatabaseInfo, error)
+	Authenticate(username, password string) (ui meta.User, err error)
+	AdminUserExists() bool
+}
+
+// exporter converts the decoded OTLP data to rows and writes them
+type exporter interface {
+	ExportTraces(ctx context.Context, td ptrace.Traces) error
+	ExportMetrics(ctx context.Context, md pmetric.Metrics) error
+	ExportLogs(ctx context.Context, ld plog.Logs) error
+}
+
+// Service receives OTLP traces, metrics and logs over gRPC and writes them
+// to the database through the same path as the OTLP HTTP routes.
+type Service struct {
+	server   *grpc.Server
+	listener net.Listener
+	Config   *config.Config
+	Logger   *logger.Logger
+	err      chan error
+
+	MetaClient   OTLPMetaClient
+	PointsWriter opentelemetry.InfluxRowsWriter
+	// QuotaManager enforces the write quotas, it is nil if quotas are not enforced.
+	QuotaManager *quota.Manager
+}
+
+func NewService(c config.Config) (*Service, error) {
+	sLogger := logger.NewLogger(errno.ModuleHTTP)
+	var maxRecvMsgSize int
+	if c.MaxBodySize <= 0 {
+		maxRecvMsgSize = config.DefaultMaxBodySize
+	} else {
+		maxRecvMsgSize = c.MaxBodySize
+	}
+
+	ln, err := net.Listen("tcp", c.OtlpGrpcAddress)
+	if err != nil {
+		sLogger.Error("otlp grpc service start failed", zap.Error(err))
+		return nil, err
+	}
+
+	s := &Service{
+		server:   grpc.NewServer(grpc.MaxRecvMsgSize(maxRecvMsgSize)),
+		listener: ln,
+		Config:   &c,
+		Logger:   sLogger,
+		err:      make(chan error),
+	}
+	ptraceotlp.RegisterServer(s.server, &tracesServer{s: s})
+	pmetricotlp.RegisterServer(s.server, &metricsServer{s: s})
+	plogotlp.RegisterServer(s.server, &logsServer{s: s})
+	sLogger.Info("otlp grpc service start successfully", zap.String("addr", ln.Addr().String()))
+	return s, nil
+}
+
+func (s *Service) Open() error {
+	go func() {
+		if err := s.server.Serve(s.listener); err != nil {
+			s.err <- err
+		}
+	}()
+	return nil
+}
+
+func (s *Service) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+func (s *Service) Close() error {
+	s.server.Stop()
+	return nil
+}
+
+func (s *Service) Err() <-chan error {
+	return s.err
+}
+
+// authorize returns the database, retention policy and user of the request, and checks
+// whether the user has permission to write to the database when auth is enabled.
+func (s *Service) authorize(ctx context.Context) (string, string, meta.User, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	database, rp := metadataValue(md, DatabaseKey), metadataValue(md, RetentionPolicyKey)
+	if database == "" {
+		return "", "", nil, status.Error(codes.InvalidArgument, "database is required")
+	}
+	if di, err := s.MetaClient.Database(database); err != nil || di == nil {
+		return "", "", nil, status.Errorf(codes.NotFound, "database not found: %q", database)
+	}
+
+	if !s.Config.OtlpGrpcAuthEnabled {
+		return database, rp, nil, nil
+	}
+	if !s.MetaClient.AdminUserExists() {
+		return "", "", nil, status.Error(codes.PermissionDenied, "error authorizing query: create admin user first or disable authentication")
+	}
+	username, password, ok := parseAuthorization(metadataValue(md, authorizationKey))
+	if !ok {
+		return "", "", nil, status.Error(codes.Unauthenticated, "unable to parse authentication credentials")
+	}
+	u, err := s.MetaClient.Authenticate(username, password)
+	if err != nil || u == nil {
+		return "", "", nil, status.Error(codes.Unauthenticated, "authorization failed")
+	}
+	if !u.AuthorizeDatabase(influxql.WritePrivilege, database) {
+		return "", "", nil, status.Errorf(codes.PermissionDenied, "%q user is not authorized to write to database %q", username, database)
+	}
+	return database, rp, u, nil
+}
+
+// export converts and writes one export request, signal is only used for logging
+func (s *Service) export(ctx context.Context, signal string, write func(octx exporter) error) error {
+	atomic.AddInt64(&statistics.HandlerStat.WriteRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.ActiveWriteRequests, 1)
+	defer func(start time.Time) {
+		d := time.Since(start).Nanoseconds()
+		atomic.AddInt64(&statistics.HandlerStat.ActiveWriteRequests, -1)
+		atomic.AddInt64(&statistics.HandlerStat.WriteRequestDuration, d)
+	}(time.Now())
+
+	database, rp, user, err := s.authorize(ctx)
+	if err != nil {
+		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		return err
+	}
+
+	qw := &quotaWriter{s: s, user: user}
+	octx := opentelemetry.GetOtelContext(nil)
+	defer opentelemetry.PutOtelContext(octx)
+	octx.Writer = qw
+	octx.Database = database
+	octx.RetentionPolicy = rp
+
+	err = write(octx)
+	if qw.err != nil {
+		s.Logger.Error("write otlp "+signal+" error:quota exceeded", zap.Error(qw.err), zap.String("db", database))
+		atomic.AddInt64(&statistics.HandlerStat.Write429ErrRequests, 1)
+		return status.Error(codes.ResourceExhausted, qw.err.Error())
+	}
+	if err != nil {
+		s.Logger.Error("write otlp "+signal+" error", zap.Error(err), zap.String("db", database))
+		atomic.AddInt64(&statistics.HandlerStat.Write500ErrRequests, 1)
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err = octx.Error(); err != nil {
+		s.Logger.Error("write otlp "+signal+" error", zap.Error(err), zap.String("db", database))
+		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// quotaWriter checks the points quotas of the user and the database before writing the rows.
+// The request size is not known after gRPC decoding, so the bytes quotas are not checked.
+type quotaWriter struct {
+	s    *Service
+	user meta.User
+	err  error
+}
+
+func (qw *quotaWriter) RetryWritePointRows(database, retentionPolicy string, rows []influx.Row) error {
+	var user string
+	if qw.user != nil {
+		user = qw.user.ID()
+	}
+	if err := qw.s.QuotaManager.AllowWrite(user, database, int64(len(rows)), 0); err != nil {
+		qw.err = err
+		return err
+	}
+	return qw.s.PointsWriter.RetryWritePointRows(database, retentionPolicy, rows)
+}
+
+type tracesServer struct {
+	s *Service
+}
+
+func (t *tracesServer) Export(ctx context.Context, req ptraceotlp.Request) (ptraceotlp.Response, error) {
+	err := t.s.export(ctx, "traces", func(octx exporter) error {
+		return octx.ExportTraces(ctx, req.Traces())
+	})
+	return ptraceotlp.NewResponse(), err
+}
+
+type metricsServer struct {
+	s *Service
+}
+
+func (m *metricsServer) Export(ctx context.Context, req pmetricotlp.Request) (pmetricotlp.Response, error) {
+	err := m.s.export(ctx, "metrics", func(octx exporter) error {
+		return octx.ExportMetrics(ctx, req.Metrics())
+	})
+	return pmetricotlp.NewResponse(), err
+}
+
+type logsServer struct {
+	s *Service
+}
+
+func (l *logsServer) Export(ctx context.Context, req plogotlp.Request) (plogotlp.Response, error) {
+	err := l.s.export(ctx, "logs", func(octx exporter) error {
+		return octx.ExportLogs(ctx, req.Logs())
+	})
+	return plogotlp.NewResponse(), err
+}
+
+func metadataValue(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// parseAuthorization parses the credentials of the authorization metadata,
+// both "Basic <base64(username:password)>" and "Token <username:password>" are supported
+func parseAuthorization(auth string) (string, string, bool) {
+	method, value, ok := strings.Cut(auth, " ")
+	if !ok {
+		return "", "", false
+	}
+	switch method {
+	case "Basic":
+		buf, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return "", "", false
+		}
+		value = string(buf)
+	case "Token":
+	default:
+		return "", "", false
+	}
+	return strings.Cut(value, ":")
+}
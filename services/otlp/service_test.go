@@ -0,0 +1,238 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package otlp_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/lib/quota"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services/otlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type MockOTLPMetaClient struct {
+	users map[string]*meta.UserInfo
+}
+
+func NewMockOTLPMetaClient() *MockOTLPMetaClient {
+	return &MockOTLPMetaClient{
+		users: map[string]*meta.UserInfo{
+			"admin":  {Name: "admin", Admin: true},
+			"reader": {Name: "reader", Privileges: map[string]influxql.Privilege{"db0": influxql.ReadPrivilege}},
+		},
+	}
+}
+
+func (c *MockOTLPMetaClient) Database(name string) (*meta.DatabaseInfo, error) {
+	if name != "db0" {
+		return nil, fmt.Errorf("database not found: %s", name)
+	}
+	return &meta.DatabaseInfo{Name: name}, nil
+}
+
+func (c *MockOTLPMetaClient) Authenticate(username, password string) (meta.User, error) {
+	u, ok := c.users[username]
+	if !ok || password != "pwd" {
+		return nil, meta.ErrAuthenticate
+	}
+	return u, nil
+}
+
+func (c *MockOTLPMetaClient) AdminUserExists() bool {
+	return true
+}
+
+type MockPointsWriter struct {
+	mu   sync.Mutex
+	db   string
+	rp   string
+	rows influx.Rows
+}
+
+func (w *MockPointsWriter) RetryWritePointRows(database, retentionPolicy string, points []influx.Row) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.db, w.rp = database, retentionPolicy
+	w.rows = append(w.rows, points...)
+	return nil
+}
+
+func newService(t *testing.T, authEnabled bool) (*otlp.Service, *MockPointsWriter, *grpc.ClientConn) {
+	c := config.NewConfig()
+	c.OtlpGrpcAddress = "127.0.0.1:0"
+	c.OtlpGrpcAuthEnabled = authEnabled
+	service, err := otlp.NewService(c)
+	require.NoError(t, err)
+
+	pw := &MockPointsWriter{}
+	service.MetaClient = NewMockOTLPMetaClient()
+	service.PointsWriter = pw
+	require.NoError(t, service.Open())
+	t.Cleanup(func() {
+		require.NoError(t, service.Close())
+	})
+
+	conn, err := grpc.Dial(service.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+	})
+	return service, pw, conn
+}
+
+func outgoingContext(kv ...string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), kv...)
+}
+
+func TestService_Export(t *testing.T) {
+	_, pw, conn := newService(t, false)
+	ctx := outgoingContext(otlp.DatabaseKey, "db0", otlp.RetentionPolicyKey, "rp0")
+
+	traces := ptraceotlp.NewRequest()
+	span := traces.Traces().ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("test_span")
+	span.SetTraceID(pcommon.NewTraceID([16]byte{1}))
+	span.SetSpanID(pcommon.NewSpanID([8]byte{2}))
+	span.SetStartTimestamp(1678898440000000000)
+	span.SetEndTimestamp(1678898440000001000)
+	_, err := ptraceotlp.NewClient(conn).Export(ctx, traces)
+	require.NoError(t, err)
+	assert.Equal(t, "db0", pw.db)
+	assert.Equal(t, "rp0", pw.rp)
+	assert.NotEqual(t, 0, len(pw.rows))
+
+	pw.rows = pw.rows[:0]
+	metrics := pmetricotlp.NewRequest()
+	m := metrics.Metrics().ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("test_metric")
+	m.SetDataType(pmetric.MetricDataTypeGauge)
+	dp := m.Gauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(1678898440000000000)
+	dp.SetIntVal(1)
+	_, err = pmetricotlp.NewClient(conn).Export(ctx, metrics)
+	require.NoError(t, err)
+	assert.NotEqual(t, 0, len(pw.rows))
+
+	pw.rows = pw.rows[:0]
+	logs := plogotlp.NewRequest()
+	lr := logs.Logs().ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(1678898440000000000)
+	lr.Body().SetStringVal("hello")
+	_, err = plogotlp.NewClient(conn).Export(ctx, logs)
+	require.NoError(t, err)
+	assert.NotEqual(t, 0, len(pw.rows))
+}
+
+func TestService_ExportError(t *testing.T) {
+	_, _, conn := newService(t, false)
+	client := ptraceotlp.NewClient(conn)
+
+	_, err := client.Export(context.Background(), ptraceotlp.NewRequest())
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.Export(outgoingContext(otlp.DatabaseKey, "db1"), ptraceotlp.NewRequest())
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestService_ExportWithAuth(t *testing.T) {
+	_, pw, conn := newService(t, true)
+	client := plogotlp.NewClient(conn)
+	logs := plogotlp.NewRequest()
+	lr := logs.Logs().ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(1678898440000000000)
+	lr.Body().SetStringVal("hello")
+
+	basic := func(user, pwd string) string {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+pwd))
+	}
+
+	_, err := client.Export(outgoingContext(otlp.DatabaseKey, "db0"), logs)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.Export(outgoingContext(otlp.DatabaseKey, "db0", "authorization", basic("admin", "wrong")), logs)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.Export(outgoingContext(otlp.DatabaseKey, "db0", "authorization", "Token reader:pwd"), logs)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, 0, len(pw.rows))
+
+	_, err = client.Export(outgoingContext(otlp.DatabaseKey, "db0", "authorization", basic("admin", "pwd")), logs)
+	require.NoError(t, err)
+	assert.NotEqual(t, 0, len(pw.rows))
+
+	_, err = client.Export(outgoingContext(otlp.DatabaseKey, "db0", "authorization", "Token admin:pwd"), logs)
+	require.NoError(t, err)
+}
+
+type mockQuotaSource struct {
+	dbs map[string]*meta.QuotaInfo
+}
+
+func (s *mockQuotaSource) UserQuota(name string) *meta.QuotaInfo {
+	return nil
+}
+
+func (s *mockQuotaSource) DatabaseQuota(name string) *meta.QuotaInfo {
+	return s.dbs[name]
+}
+
+func TestService_ExportQuota(t *testing.T) {
+	service, pw, conn := newService(t, false)
+	service.QuotaManager = quota.NewManager(&mockQuotaSource{dbs: map[string]*meta.QuotaInfo{"db0": {PointsPerSecond: 1}}})
+	client := plogotlp.NewClient(conn)
+	logs := plogotlp.NewRequest()
+	lr := logs.Logs().ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(1678898440000000000)
+	lr.Body().SetStringVal("hello")
+
+	ctx := outgoingContext(otlp.DatabaseKey, "db0")
+	_, err := client.Export(ctx, logs)
+	require.NoError(t, err)
+	n := len(pw.rows)
+	assert.NotEqual(t, 0, n)
+
+	_, err = client.Export(ctx, logs)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, n, len(pw.rows))
+}
+
+func TestNewServiceErr(t *testing.T) {
+	c := config.NewConfig()
+	c.OtlpGrpcAddress = "1.1.1.1"
+	service, err := otlp.NewService(c)
+	assert.Nil(t, service)
+	assert.Error(t, err)
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rule
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/rules"
+)
+
+const (
+	RuleTypeAlerting  = "alerting"
+	RuleTypeRecording = "recording"
+)
+
+// RuleDiscovery is the response of /api/v1/rules.
+type RuleDiscovery struct {
+	RuleGroups []*RuleGroup `json:"groups"`
+}
+
+// RuleGroup has info for rules which are part of a group.
+type RuleGroup struct {
+	Name           string        `json:"name"`
+	File           string        `json:"file"`
+	Rules          []interface{} `json:"rules"`
+	Interval       float64       `json:"interval"`
+	EvaluationTime float64       `json:"evaluationTime"`
+	LastEvaluation time.Time     `json:"lastEvaluation"`
+}
+
+type AlertingRule struct {
+	// State can be "pending", "firing", "inactive".
+	State          string        `json:"state"`
+	Name           string        `json:"name"`
+	Query          string        `json:"query"`
+	Duration       float64       `json:"duration"`
+	Labels         labels.Labels `json:"labels"`
+	Annotations    labels.Labels `json:"annotations"`
+	Alerts         []*Alert      `json:"alerts"`
+	Health         string        `json:"health"`
+	LastError      string        `json:"lastError,omitempty"`
+	EvaluationTime float64       `json:"evaluationTime"`
+	LastEvaluation time.Time     `json:"lastEvaluation"`
+	Type           string        `json:"type"`
+}
+
+type RecordingRule struct {
+	Name           string        `json:"name"`
+	Query          string        `json:"query"`
+	Labels         labels.Labels `json:"labels,omitempty"`
+	Health         string        `json:"health"`
+	LastError      string        `json:"lastError,omitempty"`
+	EvaluationTime float64       `json:"evaluationTime"`
+	LastEvaluation time.Time     `json:"lastEvaluation"`
+	Type           string        `json:"type"`
+}
+
+// AlertDiscovery is the response of /api/v1/alerts.
+type AlertDiscovery struct {
+	Alerts []*Alert `json:"alerts"`
+}
+
+// Alert has info for an alert.
+type Alert struct {
+	Labels      labels.Labels `json:"labels"`
+	Annotations labels.Labels `json:"annotations"`
+	State       string        `json:"state"`
+	ActiveAt    *time.Time    `json:"activeAt,omitempty"`
+	Value       string        `json:"value"`
+}
+
+func newAlerts(r *rules.AlertingRule) []*Alert {
+	active := r.ActiveAlerts()
+	alerts := make([]*Alert, 0, len(active))
+	for _, a := range active {
+		activeAt := a.ActiveAt
+		alerts = append(alerts, &Alert{
+			Labels:      a.Labels,
+			Annotations: a.Annotations,
+			State:       a.State.String(),
+			ActiveAt:    &activeAt,
+			Value:       strconv.FormatFloat(a.Value, 'e', -1, 64),
+		})
+	}
+	return alerts
+}
+
+// Rules returns the loaded rule groups and the state of their rules, typ filters the rules
+// by "alert" or "record". The rules evaluated by the other ts-sql have no state here.
+func (s *Service) Rules(typ string) *RuleDiscovery {
+	res := &RuleDiscovery{RuleGroups: make([]*RuleGroup, 0, len(s.groups))}
+	for _, g := range s.groups {
+		lastEvaluation, evaluationDuration := g.evaluation()
+		rg := &RuleGroup{
+			Name:           g.name,
+			File:           g.file,
+			Rules:          []interface{}{},
+			Interval:       g.interval.Seconds(),
+			EvaluationTime: evaluationDuration.Seconds(),
+			LastEvaluation: lastEvaluation,
+		}
+		for _, r := range g.rules {
+			var lastError string
+			if err := r.LastError(); err != nil {
+				lastError = err.Error()
+			}
+			switch rule := r.(type) {
+			case *rules.AlertingRule:
+				if typ != "" && typ != "alert" {
+					continue
+				}
+				rg.Rules = append(rg.Rules, &AlertingRule{
+					State:          rule.State().String(),
+					Name:           rule.Name(),
+					Query:          rule.Query().String(),
+					Duration:       rule.HoldDuration().Seconds(),
+					Labels:         rule.Labels(),
+					Annotations:    rule.Annotations(),
+					Alerts:         newAlerts(rule),
+					Health:         string(rule.Health()),
+					LastError:      lastError,
+					EvaluationTime: rule.GetEvaluationDuration().Seconds(),
+					LastEvaluation: rule.GetEvaluationTimestamp(),
+					Type:           RuleTypeAlerting,
+				})
+			case *rules.RecordingRule:
+				if typ != "" && typ != "record" {
+					continue
+				}
+				rg.Rules = append(rg.Rules, &RecordingRule{
+					Name:           rule.Name(),
+					Query:          rule.Query().String(),
+					Labels:         rule.Labels(),
+					Health:         string(rule.Health()),
+					LastError:      lastError,
+					EvaluationTime: rule.GetEvaluationDuration().Seconds(),
+					LastEvaluation: rule.GetEvaluationTimestamp(),
+					Type:           RuleTypeRecording,
+				})
+			}
+		}
+		res.RuleGroups = append(res.RuleGroups, rg)
+	}
+	return res
+}
+
+// Alerts returns the active alerts evaluated by this ts-sql.
+func (s *Service) Alerts() *AlertDiscovery {
+	res := &AlertDiscovery{Alerts: []*Alert{}}
+	for _, g := range s.groups {
+		for _, r := range g.rules {
+			if rule, ok := r.(*rules.AlertingRule); ok {
+				res.Alerts = append(res.Alerts, newAlerts(rule)...)
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rule
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/rules"
+)
+
+// Group is a set of rules evaluated sequentially at the same interval.
+type Group struct {
+	name     string
+	file     string
+	interval time.Duration
+	rules    []rules.Rule
+
+	mu                 sync.RWMutex
+	lastEvaluation     time.Time
+	evaluationDuration time.Duration
+}
+
+// Key identifies the group among all the rule files, it is also the name of the group lease.
+func (g *Group) Key() string {
+	return g.file + ";" + g.name
+}
+
+// shouldEvaluate returns true if the group hasn't been evaluated in the last interval.
+func (g *Group) shouldEvaluate(now time.Time) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.lastEvaluation.IsZero() || !now.Before(g.lastEvaluation.Add(g.interval))
+}
+
+func (g *Group) setEvaluation(ts time.Time, d time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.lastEvaluation = ts
+	g.evaluationDuration = d
+}
+
+func (g *Group) evaluation() (time.Time, time.Duration) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.lastEvaluation, g.evaluationDuration
+}
+
+// LoadGroups parses the Prometheus rule files, the file name may contain the glob pattern.
+func LoadGroups(patterns []string, interval time.Duration, externalURL string) ([]*Group, error) {
+	var files []string
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule file pattern %q: %w", pattern, err)
+		}
+		files = append(files, matches...)
+	}
+	sort.Strings(files)
+
+	var groups []*Group
+	for _, file := range files {
+		rgs, errs := rulefmt.ParseFile(file)
+		if len(errs) > 0 {
+			return nil, fmt.Errorf("load rule file %q failed: %w", file, errs[0])
+		}
+		for _, rg := range rgs.Groups {
+			g, err := newGroup(file, rg, interval, externalURL)
+			if err != nil {
+				return nil, fmt.Errorf("load rule file %q failed: %w", file, err)
+			}
+			groups = append(groups, g)
+		}
+	}
+	return groups, nil
+}
+
+func newGroup(file string, rg rulefmt.RuleGroup, interval time.Duration, externalURL string) (*Group, error) {
+	g := &Group{
+		name:     rg.Name,
+		file:     file,
+		interval: time.Duration(rg.Interval),
+	}
+	if g.interval == 0 {
+		g.interval = interval
+	}
+	for _, r := range rg.Rules {
+		expr, err := parser.ParseExpr(r.Expr.Value)
+		if err != nil {
+			return nil, fmt.Errorf("group %q: %w", rg.Name, err)
+		}
+		if r.Alert.Value != "" {
+			g.rules = append(g.rules, rules.NewAlertingRule(
+				r.Alert.Value, expr, time.Duration(r.For),
+				labels.FromMap(r.Labels), labels.FromMap(r.Annotations), nil, externalURL,
+				true, log.NewNopLogger(),
+			))
+			continue
+		}
+		g.rules = append(g.rules, rules.NewRecordingRule(r.Record.Value, expr, labels.FromMap(r.Labels)))
+	}
+	return g, nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rule
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/prometheus/prometheus/rules"
+)
+
+const defaultNotifyTimeout = 10 * time.Second
+
+// alertmanagerAlert is the alert accepted by the Alertmanager API v2.
+type alertmanagerAlert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt,omitempty"`
+	EndsAt       time.Time         `json:"endsAt,omitempty"`
+	GeneratorURL string            `json:"generatorURL,omitempty"`
+}
+
+// notifier posts the firing and resolved alerts to an Alertmanager-compatible webhook.
+type notifier struct {
+	url         string
+	resendDelay time.Duration
+	client      *http.Client
+}
+
+func newNotifier(url string, resendDelay time.Duration) *notifier {
+	return &notifier{
+		url:         url,
+		resendDelay: resendDelay,
+		client:      &http.Client{Timeout: defaultNotifyTimeout},
+	}
+}
+
+// needsSending is the same as the Prometheus, pending alerts are never sent,
+// the resolved alerts are sent once and the firing alerts are resent after resendDelay.
+func (n *notifier) needsSending(a *rules.Alert, ts time.Time) bool {
+	if a.State == rules.StatePending {
+		return false
+	}
+	if a.ResolvedAt.After(a.LastSentAt) {
+		return true
+	}
+	return a.LastSentAt.Add(n.resendDelay).Before(ts)
+}
+
+// collect returns the alerts of the rule which should be sent at ts.
+func (n *notifier) collect(r *rules.AlertingRule, ts time.Time, interval time.Duration, externalURL *url.URL) []alertmanagerAlert {
+	var alerts []alertmanagerAlert
+	r.ForEachActiveAlert(func(a *rules.Alert) {
+		if !n.needsSending(a, ts) {
+			return
+		}
+		a.LastSentAt = ts
+		// Allow for two Eval or Alertmanager send failures.
+		delta := n.resendDelay
+		if interval > delta {
+			delta = interval
+		}
+		a.ValidUntil = ts.Add(4 * delta)
+
+		alert := alertmanagerAlert{
+			Labels:       a.Labels.Map(),
+			Annotations:  a.Annotations.Map(),
+			StartsAt:     a.FiredAt,
+			EndsAt:       a.ValidUntil,
+			GeneratorURL: generatorURL(externalURL, r.Query().String()),
+		}
+		if !a.ResolvedAt.IsZero() {
+			alert.EndsAt = a.ResolvedAt
+		}
+		alerts = append(alerts, alert)
+	})
+	return alerts
+}
+
+func (n *notifier) send(ctx context.Context, alerts []alertmanagerAlert) error {
+	if len(alerts) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(alerts)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("bad response status %s", resp.Status)
+	}
+	return nil
+}
+
+// generatorURL links the alert to the table view of the expression.
+func generatorURL(externalURL *url.URL, expr string) string {
+	if externalURL == nil {
+		return ""
+	}
+	return externalURL.String() + "/graph?g0.expr=" + url.QueryEscape(expr) + "&g0.tab=1"
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/op"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// promTimeValuer returns the evaluation time for the time() function of the expression without series.
+type promTimeValuer struct {
+	ts time.Time
+}
+
+func (v promTimeValuer) Value(key string) (interface{}, bool) {
+	if key == promql2influxql.ArgNameOfTimeFunc {
+		return float64(v.ts.Unix()), true
+	}
+	return nil, false
+}
+
+func (v promTimeValuer) SetValuer(_ influxql.Valuer, _ int) {
+}
+
+// instantQuery executes the PromQL instant query at ts through the transpiler and the query executor.
+// It converts scalar into vector results as the rules expect.
+func (s *Service) instantQuery(ctx context.Context, qs string, ts time.Time) (promql.Vector, error) {
+	expr, err := parser.ParseExpr(qs)
+	if err != nil {
+		return nil, err
+	}
+	cmd := promql2influxql.PromCommand{
+		Cmd:             qs,
+		Database:        s.conf.Database,
+		RetentionPolicy: s.conf.RetentionPolicy,
+		Evaluation:      &ts,
+		LookBackDelta:   promql2influxql.DefaultLookBackDelta,
+	}
+	transpiler := &promql2influxql.Transpiler{PromCommand: cmd}
+	node, err := transpiler.Transpile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	switch stmt := node.(type) {
+	case *influxql.SelectStatement:
+		result, err := s.executeQuery(ctx, stmt)
+		if err != nil {
+			return nil, err
+		}
+		r := &promql2influxql.Receiver{DropMetric: transpiler.DropMetric(), RemoveTableName: transpiler.RemoveTableName()}
+		res, err := r.InfluxResultToPromQLValue(result, expr, cmd)
+		if err != nil {
+			return nil, err
+		}
+		return toVector(res.Result, ts)
+	case *influxql.Call, *influxql.BinaryExpr, *influxql.IntegerLiteral, *influxql.NumberLiteral:
+		// the expression without series, such as vector(1) and time()
+		valuer := influxql.ValuerEval{
+			Valuer: influxql.MultiValuer(
+				op.Valuer{},
+				query.MathValuer{},
+				query.StringValuer{},
+				executor.PromTimeValuer{},
+				promTimeValuer{ts: ts},
+			),
+			IntegerFloatDivision: true,
+		}
+		v, ok := valuer.Eval(stmt.(influxql.Expr)).(float64)
+		if !ok {
+			return nil, fmt.Errorf("invalid result of the expression %s", qs)
+		}
+		return promql.Vector{{Point: promql.Point{T: timestamp.FromTime(ts), V: v}, Metric: labels.Labels{}}}, nil
+	default:
+		return nil, fmt.Errorf("invalid the select statement for promql")
+	}
+}
+
+func (s *Service) executeQuery(ctx context.Context, stmt *influxql.SelectStatement) (*query.Result, error) {
+	closing := make(chan struct{})
+	defer close(closing)
+
+	opts := query.ExecutionOptions{
+		Database:        s.conf.Database,
+		RetentionPolicy: s.conf.RetentionPolicy,
+		ChunkSize:       DefaultChunkSize,
+		InnerChunkSize:  DefaultInnerChunkSize,
+		AbortCh:         closing,
+		Quiet:           true,
+		IsPromQuery:     true,
+	}
+	q := &influxql.Query{Statements: influxql.Statements{stmt}}
+	results := s.QueryExecutor.ExecuteQuery(q, opts, closing, nil)
+
+	// results of the same statement are merged, the receiver groups the rows by series.
+	var result *query.Result
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-results:
+			if !ok {
+				return result, nil
+			}
+			if r == nil {
+				continue
+			}
+			if r.Err != nil {
+				return nil, r.Err
+			}
+			if result == nil {
+				result = r
+				continue
+			}
+			result.Series = append(result.Series, r.Series...)
+		}
+	}
+}
+
+func toVector(v interface{}, ts time.Time) (promql.Vector, error) {
+	switch res := v.(type) {
+	case nil:
+		return promql.Vector{}, nil
+	case promql.Vector:
+		return res, nil
+	case promql.Scalar:
+		return promql.Vector{{Point: promql.Point(res), Metric: labels.Labels{}}}, nil
+	case *promql.Scalar:
+		return promql.Vector{{Point: promql.Point(*res), Metric: labels.Labels{}}}, nil
+	default:
+		return nil, fmt.Errorf("rule result is not a vector or scalar at %s", ts)
+	}
+}
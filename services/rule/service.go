@@ -0,0 +1,311 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rule
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/syscontrol"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/rules"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultChunkSize      = 10000
+	DefaultInnerChunkSize = 1024
+
+	// checkInterval is the interval to check which rule groups should be evaluated.
+	checkInterval = time.Second
+)
+
+type MetaClient interface {
+	SendSql2MetaHeartbeat(host string) error
+	WaitForDataChanged() chan struct{}
+	GetMaxCQChangeID() uint64
+	GetRuleLease(host string, groups []string) ([]string, error)
+}
+
+type QueryExecutor interface {
+	ExecuteQuery(query *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, qDuration *statistics.SQLSlowQueryStatistics) <-chan *query.Result
+}
+
+type PointsWriter interface {
+	RetryWritePointRows(database, retentionPolicy string, points []influx.Row) error
+}
+
+// Service evaluates the Prometheus recording and alerting rules. The rule groups are loaded from the
+// rule files of every ts-sql, and each group is evaluated by the ts-sql holding its lease from ts-meta.
+type Service struct {
+	base services.Base
+
+	hostname string
+	conf     config.RuleConfig
+	wg       sync.WaitGroup
+	closing  chan struct{}
+	logger   *logger.Logger
+
+	MetaClient    MetaClient
+	QueryExecutor QueryExecutor
+	PointsWriter  PointsWriter
+
+	groups      []*Group
+	externalURL *url.URL
+	notifier    *notifier
+
+	mu     sync.RWMutex
+	leased map[string]struct{} // the keys of the groups evaluated by this ts-sql
+
+	leaseInit      bool
+	maxCQChangedID uint64 // the lease of the groups changes with the cq lease
+	metaChangedCh  chan struct{}
+}
+
+// NewService creates a new Service instance and loads the rule files.
+func NewService(hostname string, conf config.RuleConfig) (*Service, error) {
+	s := &Service{
+		hostname: hostname,
+		conf:     conf,
+		closing:  make(chan struct{}),
+		logger:   logger.NewLogger(errno.ModuleUnknown),
+		leased:   make(map[string]struct{}),
+	}
+	if conf.ExternalURL != "" {
+		u, err := url.Parse(conf.ExternalURL)
+		if err != nil {
+			return nil, err
+		}
+		s.externalURL = u
+	}
+	if conf.AlertmanagerURL != "" {
+		s.notifier = newNotifier(conf.AlertmanagerURL, time.Duration(conf.ResendDelay))
+	}
+
+	groups, err := LoadGroups(conf.RuleFiles, time.Duration(conf.EvaluationInterval), conf.ExternalURL)
+	if err != nil {
+		return nil, err
+	}
+	s.groups = groups
+	s.base.Init("rule", checkInterval, s.handle)
+	return s, nil
+}
+
+func (s *Service) WithLogger(logger *logger.Logger) {
+	s.logger = logger.With(zap.String("service", "rule"))
+}
+
+func (s *Service) Open() error {
+	if err := s.base.Open(); err != nil {
+		return err
+	}
+	s.logger.Info("rule groups loaded", zap.Int("groups", len(s.groups)), zap.Strings("files", s.conf.RuleFiles))
+
+	s.wg.Add(1)
+	go s.sendHeartbeat2Meta()
+	return nil
+}
+
+func (s *Service) sendHeartbeat2Meta() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.closing:
+			return
+		case <-ticker.C:
+			if err := s.MetaClient.SendSql2MetaHeartbeat(s.hostname); err != nil {
+				s.logger.Warn("sql node send heartbeat to meta node failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// checkLeaseIsChanged returns true if the sql nodes have changed, the groups should be leased again.
+func (s *Service) checkLeaseIsChanged() bool {
+	if s.metaChangedCh == nil {
+		s.metaChangedCh = s.MetaClient.WaitForDataChanged()
+	}
+	select {
+	case <-s.metaChangedCh:
+		s.metaChangedCh = s.MetaClient.WaitForDataChanged()
+
+		maxCQChangeID := s.MetaClient.GetMaxCQChangeID()
+		if maxCQChangeID > s.maxCQChangedID {
+			s.maxCQChangedID = maxCQChangeID
+			return true
+		}
+	default:
+	}
+	return false
+}
+
+func (s *Service) updateLease() {
+	keys := make([]string, 0, len(s.groups))
+	for _, g := range s.groups {
+		keys = append(keys, g.Key())
+	}
+	leased, err := s.MetaClient.GetRuleLease(s.hostname, keys)
+	if err != nil {
+		s.logger.Error("rule service get rule lease failed", zap.Error(err))
+		return
+	}
+	s.logger.Debug("get rule lease info", zap.Strings("groups", leased))
+
+	lease := make(map[string]struct{}, len(leased))
+	for _, key := range leased {
+		lease[key] = struct{}{}
+	}
+	s.mu.Lock()
+	s.leased = lease
+	s.mu.Unlock()
+	s.leaseInit = len(leased) > 0
+}
+
+func (s *Service) isLeased(g *Group) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.leased[g.Key()]
+	return ok
+}
+
+func (s *Service) handle() {
+	if syscontrol.IsReadonly() || len(s.groups) == 0 {
+		return
+	}
+	if s.checkLeaseIsChanged() || !s.leaseInit {
+		s.updateLease()
+	}
+
+	now := time.Now()
+	var wg sync.WaitGroup
+	for _, g := range s.groups {
+		if !s.isLeased(g) || !g.shouldEvaluate(now) {
+			continue
+		}
+		wg.Add(1)
+		go func(g *Group) {
+			defer wg.Done()
+			s.evalGroup(g, now)
+		}(g)
+	}
+	wg.Wait()
+}
+
+// evalGroup evaluates the rules of the group in order and writes the results, so that the later
+// rules can use the results of the former ones.
+func (s *Service) evalGroup(g *Group, ts time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.interval)
+	defer cancel()
+
+	start := time.Now()
+	for _, r := range g.rules {
+		s.evalRule(ctx, g, r, ts)
+	}
+	g.setEvaluation(ts, time.Since(start))
+}
+
+func (s *Service) evalRule(ctx context.Context, g *Group, r rules.Rule, ts time.Time) {
+	start := time.Now()
+	defer func() {
+		r.SetEvaluationDuration(time.Since(start))
+		r.SetEvaluationTimestamp(ts)
+	}()
+
+	vector, err := r.Eval(ctx, ts, s.instantQuery, s.externalURL)
+	if err != nil {
+		r.SetHealth(rules.HealthBad)
+		r.SetLastError(err)
+		s.logger.Warn("evaluate rule failed", zap.String("group", g.Key()), zap.String("rule", r.Name()), zap.Error(err))
+		return
+	}
+
+	if len(vector) > 0 {
+		err = s.PointsWriter.RetryWritePointRows(s.conf.Database, s.conf.RetentionPolicy, vectorToRows(vector))
+	}
+	if err != nil {
+		r.SetHealth(rules.HealthBad)
+		r.SetLastError(err)
+		s.logger.Warn("write rule result failed", zap.String("group", g.Key()), zap.String("rule", r.Name()), zap.Error(err))
+		return
+	}
+	r.SetHealth(rules.HealthGood)
+	r.SetLastError(nil)
+
+	if ar, ok := r.(*rules.AlertingRule); ok && s.notifier != nil {
+		alerts := s.notifier.collect(ar, ts, g.interval, s.externalURL)
+		if err = s.notifier.send(ctx, alerts); err != nil {
+			s.logger.Warn("send alerts failed", zap.String("rule", r.Name()), zap.Int("alerts", len(alerts)), zap.Error(err))
+		}
+	}
+}
+
+// vectorToRows converts the samples to rows in the same way as the Prometheus remote write.
+func vectorToRows(vector promql.Vector) []influx.Row {
+	rows := make([]influx.Row, 0, len(vector))
+	for _, sample := range vector {
+		tags := make(influx.PointTags, 0, len(sample.Metric))
+		for _, l := range sample.Metric {
+			tags = append(tags, influx.Tag{Key: l.Name, Value: l.Value})
+		}
+		name := sample.Metric.Get(labels.MetricName)
+		if name == "" {
+			name = promql2influxql.DefaultMeasurementName
+		}
+		rows = append(rows, influx.Row{
+			Name:      name,
+			Tags:      tags,
+			Timestamp: sample.T * int64(time.Millisecond),
+			Fields: []influx.Field{{
+				Key:      promql2influxql.DefaultFieldKey,
+				Type:     influx.Field_Type_Float,
+				NumValue: sample.V,
+			}},
+		})
+	}
+	return rows
+}
+
+func (s *Service) Close() error {
+	if s.closing == nil {
+		return nil
+	}
+
+	if err := s.base.Close(); err != nil {
+		return err
+	}
+	close(s.closing)
+
+	s.wg.Wait()
+	s.closing = nil
+	return nil
+}
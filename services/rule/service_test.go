@@ -0,0 +1,287 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rule
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/prometheus/prometheus/rules"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const testRules = `
+groups:
+  - name: up
+    interval: 30s
+    rules:
+      - record: job:up:sum
+        expr: sum by (job) (up)
+        labels:
+          source: rule
+  - name: alerts
+    rules:
+      - alert: InstanceDown
+        expr: up == 0
+        labels:
+          severity: page
+        annotations:
+          summary: "instance {{ $labels.instance }} down"
+      - record: one
+        expr: vector(1)
+`
+
+type mockMetaClient struct {
+	GetRuleLeaseFn func(host string, groups []string) ([]string, error)
+}
+
+func (mc *mockMetaClient) SendSql2MetaHeartbeat(host string) error {
+	return nil
+}
+
+func (mc *mockMetaClient) WaitForDataChanged() chan struct{} {
+	return make(chan struct{})
+}
+
+func (mc *mockMetaClient) GetMaxCQChangeID() uint64 {
+	return 0
+}
+
+func (mc *mockMetaClient) GetRuleLease(host string, groups []string) ([]string, error) {
+	return mc.GetRuleLeaseFn(host, groups)
+}
+
+type mockQueryExecutor struct {
+	rows models.Rows
+	err  error
+}
+
+func (e *mockQueryExecutor) ExecuteQuery(q *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, qDuration *statistics.SQLSlowQueryStatistics) <-chan *query.Result {
+	res := make(chan *query.Result, 1)
+	res <- &query.Result{Series: e.rows, Err: e.err}
+	close(res)
+	return res
+}
+
+type mockPointsWriter struct {
+	mu   sync.Mutex
+	db   string
+	rows []influx.Row
+}
+
+func (w *mockPointsWriter) RetryWritePointRows(database, retentionPolicy string, points []influx.Row) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.db = database
+	w.rows = append(w.rows, points...)
+	return nil
+}
+
+func writeRuleFile(t *testing.T) string {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "rules.yml")
+	require.NoError(t, os.WriteFile(file, []byte(testRules), 0600))
+	return file
+}
+
+func newTestService(t *testing.T, conf config.RuleConfig) (*Service, *mockPointsWriter) {
+	s, err := NewService("127.0.0.1:8086", conf)
+	require.NoError(t, err)
+	s.WithLogger(logger.NewLogger(errno.ModuleUnknown).SetZapLogger(zap.NewNop()))
+	s.MetaClient = &mockMetaClient{GetRuleLeaseFn: func(host string, groups []string) ([]string, error) {
+		return groups, nil
+	}}
+	w := &mockPointsWriter{}
+	s.PointsWriter = w
+	return s, w
+}
+
+func TestLoadGroups(t *testing.T) {
+	file := writeRuleFile(t)
+	groups, err := LoadGroups([]string{filepath.Join(filepath.Dir(file), "*.yml")}, time.Minute, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(groups))
+
+	assert.Equal(t, file+";up", groups[0].Key())
+	assert.Equal(t, 30*time.Second, groups[0].interval)
+	assert.Equal(t, time.Minute, groups[1].interval)
+	assert.Equal(t, 2, len(groups[1].rules))
+
+	_, err = LoadGroups([]string{"[-"}, time.Minute, "")
+	assert.Error(t, err)
+
+	bad := filepath.Join(t.TempDir(), "bad.yml")
+	require.NoError(t, os.WriteFile(bad, []byte("groups:\n  - name: a\n    rules:\n      - record: a\n        expr: sum(\n"), 0600))
+	_, err = LoadGroups([]string{bad}, time.Minute, "")
+	assert.Error(t, err)
+}
+
+func TestService_RecordingRule(t *testing.T) {
+	conf := config.NewRuleConfig()
+	conf.Enabled = true
+	conf.RuleFiles = []string{writeRuleFile(t)}
+	s, w := newTestService(t, conf)
+
+	now := time.Now().Truncate(time.Second)
+	s.QueryExecutor = &mockQueryExecutor{rows: models.Rows{{
+		Name:    "up",
+		Tags:    map[string]string{"job": "node"},
+		Columns: []string{"time", "value"},
+		Values:  [][]interface{}{{now, float64(3)}},
+	}}}
+	s.handle()
+
+	require.Equal(t, "prometheus", w.db)
+	var row *influx.Row
+	for i := range w.rows {
+		if w.rows[i].Name == "job:up:sum" {
+			row = &w.rows[i]
+		}
+	}
+	require.NotNil(t, row)
+	assert.Equal(t, influx.PointTags{
+		{Key: "__name__", Value: "job:up:sum"},
+		{Key: "job", Value: "node"},
+		{Key: "source", Value: "rule"},
+	}, row.Tags)
+	assert.Equal(t, now.UnixNano(), row.Timestamp)
+	assert.Equal(t, float64(3), row.Fields[0].NumValue)
+
+	res := s.Rules("record")
+	require.Equal(t, 2, len(res.RuleGroups))
+	rr, ok := res.RuleGroups[0].Rules[0].(*RecordingRule)
+	require.True(t, ok)
+	assert.Equal(t, string(rules.HealthGood), rr.Health)
+	assert.Equal(t, "sum by(job) (up)", rr.Query)
+	assert.Equal(t, 1, len(res.RuleGroups[1].Rules))
+
+	// the group is not evaluated again in the interval
+	w.rows = nil
+	s.handle()
+	assert.Equal(t, 0, len(w.rows))
+}
+
+func TestService_AlertingRule(t *testing.T) {
+	var mu sync.Mutex
+	var received []alertmanagerAlert
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var alerts []alertmanagerAlert
+		_ = json.Unmarshal(body, &alerts)
+		mu.Lock()
+		received = append(received, alerts...)
+		mu.Unlock()
+	}))
+	defer ts.Close()
+
+	conf := config.NewRuleConfig()
+	conf.Enabled = true
+	conf.RuleFiles = []string{writeRuleFile(t)}
+	conf.AlertmanagerURL = ts.URL
+	conf.ExternalURL = "http://127.0.0.1:8086"
+	conf.ResendDelay = toml.Duration(time.Minute)
+	s, _ := newTestService(t, conf)
+
+	now := time.Now().Truncate(time.Second)
+	s.QueryExecutor = &mockQueryExecutor{rows: models.Rows{{
+		Name:    "up",
+		Tags:    map[string]string{"instance": "host1"},
+		Columns: []string{"time", "value"},
+		Values:  [][]interface{}{{now, float64(0)}},
+	}}}
+	s.handle()
+
+	alerts := s.Alerts()
+	require.Equal(t, 1, len(alerts.Alerts))
+	assert.Equal(t, "firing", alerts.Alerts[0].State)
+	assert.Equal(t, "instance host1 down", alerts.Alerts[0].Annotations.Get("summary"))
+	assert.Equal(t, "page", alerts.Alerts[0].Labels.Get("severity"))
+
+	mu.Lock()
+	require.Equal(t, 1, len(received))
+	assert.Equal(t, "InstanceDown", received[0].Labels["alertname"])
+	assert.Contains(t, received[0].GeneratorURL, "http://127.0.0.1:8086/graph?g0.expr=")
+	mu.Unlock()
+
+	res := s.Rules("alert")
+	require.Equal(t, 1, len(res.RuleGroups[1].Rules))
+	ar, ok := res.RuleGroups[1].Rules[0].(*AlertingRule)
+	require.True(t, ok)
+	assert.Equal(t, "firing", ar.State)
+	assert.Equal(t, 0, len(res.RuleGroups[0].Rules))
+}
+
+func TestService_NotLeased(t *testing.T) {
+	conf := config.NewRuleConfig()
+	conf.RuleFiles = []string{writeRuleFile(t)}
+	s, w := newTestService(t, conf)
+	s.MetaClient = &mockMetaClient{GetRuleLeaseFn: func(host string, groups []string) ([]string, error) {
+		return nil, errors.New("no lease")
+	}}
+	s.QueryExecutor = &mockQueryExecutor{}
+	s.handle()
+	assert.Equal(t, 0, len(w.rows))
+	assert.Equal(t, 0, len(s.Alerts().Alerts))
+}
+
+func TestService_QueryError(t *testing.T) {
+	conf := config.NewRuleConfig()
+	conf.RuleFiles = []string{writeRuleFile(t)}
+	s, w := newTestService(t, conf)
+	s.QueryExecutor = &mockQueryExecutor{err: errors.New("query failed")}
+	s.handle()
+
+	// only the vector(1) is evaluated without the query executor
+	require.Equal(t, 1, len(w.rows))
+	assert.Equal(t, "one", w.rows[0].Name)
+
+	res := s.Rules("")
+	rr := res.RuleGroups[0].Rules[0].(*RecordingRule)
+	assert.Equal(t, string(rules.HealthBad), rr.Health)
+	assert.Equal(t, "query failed", rr.LastError)
+}
+
+func TestService_OpenClose(t *testing.T) {
+	conf := config.NewRuleConfig()
+	s, _ := newTestService(t, conf)
+	require.NoError(t, s.Open())
+	require.NoError(t, s.Close())
+	require.NoError(t, s.Close())
+
+	conf.RuleFiles = []string{filepath.Join(t.TempDir(), "[-")}
+	_, err := NewService("127.0.0.1:8086", conf)
+	assert.Error(t, err)
+}